@@ -91,6 +91,7 @@ func TestBaseApp_BlockGas(t *testing.T) {
 			require.Equal(t, feeCoin.Amount, app.BankKeeper.GetBalance(ctx, addr1, feeCoin.Denom).Amount)
 			seq, _ := app.AccountKeeper.GetSequence(ctx, addr1)
 			require.Equal(t, uint64(0), seq)
+			accNum := app.AccountKeeper.GetAccount(ctx, addr1).GetAccountNumber()
 
 			// msg and signatures
 			msg := testdata.NewTestMsg(addr1)
@@ -100,7 +101,7 @@ func TestBaseApp_BlockGas(t *testing.T) {
 			txBuilder.SetFeeAmount(feeAmount)
 			txBuilder.SetGasLimit(txtypes.MaxGasWanted) // tx validation checks that gasLimit can't be bigger than this
 
-			privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{8}, []uint64{0}
+			privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{accNum}, []uint64{0}
 			_, txBytes, err := createTestTx(encCfg.TxConfig, txBuilder, privs, accNums, accSeqs, ctx.ChainID())
 			require.NoError(t, err)
 
@@ -19,16 +19,28 @@ type Keeper interface {
 	IsInactiveAddr(address sdk.AccAddress) bool
 
 	InitializeBankPlus(ctx sdk.Context)
+
+	AppendSendRestriction(restriction SendRestrictionFn)
+}
+
+// SendRestrictionFn checks a transfer of coins, rejecting it by returning an error.
+// toAddr is empty for the inputs of a multi-send, whose recipients are not paired with the senders.
+type SendRestrictionFn func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+
+// sendRestriction holds the restrictions so that the copies of the keeper share them.
+type sendRestriction struct {
+	fns []SendRestrictionFn
 }
 
 type BaseKeeper struct {
 	bankkeeper.BaseKeeper
 
-	ak             types.AccountKeeper
-	cdc            codec.Codec
-	storeKey       sdk.StoreKey
-	inactiveAddrs  map[string]bool
-	deactMultiSend bool
+	ak              types.AccountKeeper
+	cdc             codec.Codec
+	storeKey        sdk.StoreKey
+	inactiveAddrs   map[string]bool
+	deactMultiSend  bool
+	sendRestriction *sendRestriction
 }
 
 func NewBaseKeeper(
@@ -40,9 +52,26 @@ func NewBaseKeeper(
 		ak:             ak,
 		cdc:            cdc,
 		storeKey:       storeKey,
-		inactiveAddrs:  map[string]bool{},
-		deactMultiSend: deactMultiSend,
+		inactiveAddrs:   map[string]bool{},
+		deactMultiSend:  deactMultiSend,
+		sendRestriction: &sendRestriction{},
+	}
+}
+
+// AppendSendRestriction adds a restriction which every transfer of coins must pass,
+// after the existing ones.
+func (keeper BaseKeeper) AppendSendRestriction(restriction SendRestrictionFn) {
+	keeper.sendRestriction.fns = append(keeper.sendRestriction.fns, restriction)
+}
+
+func (keeper BaseKeeper) checkSendRestriction(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	for _, fn := range keeper.sendRestriction.fns {
+		if err := fn(ctx, fromAddr, toAddr, amt); err != nil {
+			return err
+		}
 	}
+
+	return nil
 }
 
 func (keeper BaseKeeper) InitializeBankPlus(ctx sdk.Context) {
@@ -110,6 +139,10 @@ func (keeper BaseKeeper) SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddr
 		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", toAddr)
 	}
 
+	if err := keeper.checkSendRestriction(ctx, fromAddr, toAddr, amt); err != nil {
+		return err
+	}
+
 	return keeper.BaseSendKeeper.SendCoins(ctx, fromAddr, toAddr, amt)
 }
 
@@ -147,5 +180,16 @@ func (keeper BaseKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		}
 	}
 
+	for _, in := range inputs {
+		inAddress, err := sdk.AccAddressFromBech32(in.Address)
+		if err != nil {
+			return err
+		}
+
+		if err := keeper.checkSendRestriction(ctx, inAddress, nil, in.Coins); err != nil {
+			return err
+		}
+	}
+
 	return keeper.BaseSendKeeper.InputOutputCoins(ctx, inputs, outputs)
 }
@@ -306,6 +306,47 @@ func (suite *IntegrationTestSuite) TestInputOutputCoins() {
 	}
 }
 
+func (suite *IntegrationTestSuite) TestSendRestriction() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+	appCodec := app.AppCodec()
+
+	authKeeper := authkeeper.NewAccountKeeper(
+		appCodec, app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName),
+		authtypes.ProtoBaseAccount, simapp.GetMaccPerms(),
+	)
+	keeper := bankpluskeeper.NewBaseKeeper(
+		appCodec, app.GetKey(types.StoreKey), authKeeper,
+		app.GetSubspace(types.ModuleName), make(map[string]bool), false,
+	)
+
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	suite.Require().NoError(keeper.MintCoins(ctx, minttypes.ModuleName, initCoins))
+	suite.Require().NoError(keeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr1, initCoins))
+
+	errRestricted := sdkerrors.ErrUnauthorized.Wrap("restricted")
+	restricted := map[string]bool{}
+	keeper.AppendSendRestriction(func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+		if restricted[fromAddr.String()] {
+			return errRestricted
+		}
+		return nil
+	})
+
+	// the copies of the keeper share the restrictions
+	copied := keeper
+	restricted[addr1.String()] = true
+	suite.Require().ErrorIs(copied.SendCoins(ctx, addr1, addr2, initCoins), errRestricted)
+	input := []types.Input{types.NewInput(addr1, initCoins)}
+	output := []types.Output{types.NewOutput(addr2, initCoins)}
+	suite.Require().ErrorIs(copied.InputOutputCoins(ctx, input, output), errRestricted)
+
+	restricted[addr1.String()] = false
+	suite.Require().NoError(copied.SendCoins(ctx, addr1, addr2, initCoins))
+	suite.Require().Equal(initCoins, keeper.GetAllBalances(ctx, addr2))
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
@@ -14,6 +14,7 @@ import (
 
 const (
 	FlagTokenID = "token-id"
+	FlagClassID = "class-id"
 )
 
 // NewQueryCmd returns the cli query commands for this module
@@ -37,6 +38,10 @@ func NewQueryCmd() *cobra.Command {
 		NewQueryCmdNFTBurnt(),
 		NewQueryCmdContract(),
 		NewQueryCmdToken(),
+		NewQueryCmdNFTsByTrait(),
+		NewQueryCmdNFTsByOwner(),
+		NewQueryCmdNFTsByClass(),
+		NewQueryCmdOwnersOfClass(),
 		NewQueryCmdTokenType(),
 		NewQueryCmdRoot(),
 		NewQueryCmdParent(),
@@ -44,6 +49,8 @@ func NewQueryCmd() *cobra.Command {
 		NewQueryCmdGranteeGrants(),
 		NewQueryCmdIsOperatorFor(),
 		NewQueryCmdHoldersByOperator(),
+		NewQueryCmdRoyalty(),
+		NewQueryCmdRoyalties(),
 	)
 
 	return queryCmd
@@ -464,6 +471,188 @@ func NewQueryCmdToken() *cobra.Command {
 	return cmd
 }
 
+func NewQueryCmdNFTsByTrait() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "nfts-by-trait [contract-id] [class-id] [key] [value]",
+		Args:    cobra.ExactArgs(4),
+		Short:   "query all the nfts of a token class having the trait",
+		Example: fmt.Sprintf(`$ %s query %s nfts-by-trait [contract-id] [class-id] [key] [value]`, version.AppName, collection.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractID := args[0]
+			if err := collection.ValidateContractID(contractID); err != nil {
+				return err
+			}
+
+			classID := args[1]
+			if err := collection.ValidateClassID(classID); err != nil {
+				return err
+			}
+
+			queryClient := collection.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &collection.QueryNFTsByTraitRequest{
+				ContractId: contractID,
+				ClassId:    classID,
+				Key:        args[2],
+				Value:      args[3],
+				Pagination: pageReq,
+			}
+			res, err := queryClient.NFTsByTrait(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "nfts")
+	return cmd
+}
+
+func NewQueryCmdNFTsByOwner() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "nfts-by-owner [contract-id] [owner]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "query all the nfts held by an owner",
+		Example: fmt.Sprintf(`$ %s query %s nfts-by-owner [contract-id] [owner]`, version.AppName, collection.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractID := args[0]
+			if err := collection.ValidateContractID(contractID); err != nil {
+				return err
+			}
+
+			owner := args[1]
+			if _, err := sdk.AccAddressFromBech32(owner); err != nil {
+				return err
+			}
+
+			queryClient := collection.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &collection.QueryNFTsByOwnerRequest{
+				ContractId: contractID,
+				Owner:      owner,
+				Pagination: pageReq,
+			}
+			res, err := queryClient.NFTsByOwner(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "nfts")
+	return cmd
+}
+
+func NewQueryCmdNFTsByClass() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "nfts-by-class [contract-id] [class-id]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "query all the nfts of a token class",
+		Example: fmt.Sprintf(`$ %s query %s nfts-by-class [contract-id] [class-id]`, version.AppName, collection.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractID := args[0]
+			if err := collection.ValidateContractID(contractID); err != nil {
+				return err
+			}
+
+			classID := args[1]
+			if err := collection.ValidateClassID(classID); err != nil {
+				return err
+			}
+
+			queryClient := collection.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &collection.QueryNFTsByClassRequest{
+				ContractId: contractID,
+				ClassId:    classID,
+				Pagination: pageReq,
+			}
+			res, err := queryClient.NFTsByClass(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "nfts")
+	return cmd
+}
+
+func NewQueryCmdOwnersOfClass() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "owners-of-class [contract-id] [class-id]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "query all the owners of the nfts of a token class",
+		Example: fmt.Sprintf(`$ %s query %s owners-of-class [contract-id] [class-id]`, version.AppName, collection.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractID := args[0]
+			if err := collection.ValidateContractID(contractID); err != nil {
+				return err
+			}
+
+			classID := args[1]
+			if err := collection.ValidateClassID(classID); err != nil {
+				return err
+			}
+
+			queryClient := collection.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &collection.QueryOwnersOfClassRequest{
+				ContractId: contractID,
+				ClassId:    classID,
+				Pagination: pageReq,
+			}
+			res, err := queryClient.OwnersOfClass(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "owners")
+	return cmd
+}
+
 func NewQueryCmdRoot() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "root [contract-id] [token-id]",
@@ -715,3 +904,87 @@ func NewQueryCmdHoldersByOperator() *cobra.Command {
 	flags.AddPaginationFlagsToCmd(cmd, "approvers")
 	return cmd
 }
+
+func NewQueryCmdRoyalty() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "royalty [contract-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "query the royalty applied on a class",
+		Example: fmt.Sprintf(`$ %s query %s royalty [contract-id] [--class-id [class-id]]`, version.AppName, collection.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractID := args[0]
+			if err := collection.ValidateContractID(contractID); err != nil {
+				return err
+			}
+
+			classID, err := cmd.Flags().GetString(FlagClassID)
+			if err != nil {
+				return err
+			}
+			if len(classID) != 0 {
+				if err := collection.ValidateClassID(classID); err != nil {
+					return err
+				}
+			}
+
+			queryClient := collection.NewQueryClient(clientCtx)
+			req := &collection.QueryRoyaltyRequest{
+				ContractId: contractID,
+				ClassId:    classID,
+			}
+			res, err := queryClient.Royalty(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagClassID, "", "Class ID to query for (contract default if empty)")
+	return cmd
+}
+
+func NewQueryCmdRoyalties() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "royalties [contract-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "query all the royalties set on a contract",
+		Example: fmt.Sprintf(`$ %s query %s royalties [contract-id]`, version.AppName, collection.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractID := args[0]
+			if err := collection.ValidateContractID(contractID); err != nil {
+				return err
+			}
+
+			queryClient := collection.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &collection.QueryRoyaltiesRequest{
+				ContractId: contractID,
+				Pagination: pageReq,
+			}
+			res, err := queryClient.Royalties(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "royalties")
+	return cmd
+}
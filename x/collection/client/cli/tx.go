@@ -29,6 +29,14 @@ const (
 	FlagTo       = "to"
 	FlagSupply   = "supply"
 
+	// flag for non-fungible token classes
+	FlagNonTransferable = "non-transferable"
+
+	// flag for non-fungible tokens
+	FlagURI     = "uri"
+	FlagURIHash = "uri-hash"
+	FlagTraits  = "traits"
+
 	DefaultDecimals = 8
 	DefaultSupply   = "0"
 )
@@ -48,6 +56,8 @@ func NewTxCmd() *cobra.Command {
 		NewTxCmdOperatorSendFT(),
 		NewTxCmdSendNFT(),
 		NewTxCmdOperatorSendNFT(),
+		NewTxCmdSellNFT(),
+		NewTxCmdBatchSend(),
 		NewTxCmdCreateContract(),
 		NewTxCmdIssueFT(),
 		NewTxCmdIssueNFT(),
@@ -62,6 +72,7 @@ func NewTxCmd() *cobra.Command {
 		NewTxCmdAuthorizeOperator(),
 		NewTxCmdRevokeOperator(),
 		NewTxCmdModify(),
+		NewTxCmdSetRoyalty(),
 	)
 
 	return txCmd
@@ -225,6 +236,117 @@ func NewTxCmdOperatorSendNFT() *cobra.Command {
 	return cmd
 }
 
+func NewTxCmdSellNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sell-nft [contract-id] [seller] [buyer] [token-id] [price]",
+		Args:  cobra.ExactArgs(5),
+		Short: "sell a non-fungible token for coins, paying the royalty (requires the signatures of both the seller and the buyer)",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s sell-nft [contract-id] [seller] [buyer] [token-id] [price]`, version.AppName, collection.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			seller := args[1]
+			if err := cmd.Flags().Set(flags.FlagFrom, seller); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			price, err := sdk.ParseCoinsNormalized(args[4])
+			if err != nil {
+				return err
+			}
+
+			msg := collection.MsgSellNFT{
+				ContractId: args[0],
+				Seller:     seller,
+				Buyer:      args[2],
+				TokenId:    args[3],
+				Price:      price,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdBatchSend() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-send [contract-id] [from] [entry] [entry]...",
+		Args:  cobra.MinimumNArgs(3),
+		Short: "send tokens to many accounts at once",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s batch-send [contract-id] [from] [to]=[item],[item]... [to]=[item],[item]...
+where each item is either fungible tokens ([ft-id]:[amount]) or a non-fungible token ([nft-id])`, version.AppName, collection.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			from := args[1]
+			if err := cmd.Flags().Set(flags.FlagFrom, from); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			entries := make([]collection.BatchSendEntry, len(args[2:]))
+			for i, entryStr := range args[2:] {
+				entry, err := parseBatchSendEntry(entryStr)
+				if err != nil {
+					return err
+				}
+				entries[i] = *entry
+			}
+
+			msg := collection.MsgBatchSend{
+				ContractId: args[0],
+				From:       from,
+				Entries:    entries,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseBatchSendEntry parses an entry of the form [to]=[item],[item]...
+func parseBatchSendEntry(str string) (*collection.BatchSendEntry, error) {
+	to, itemsStr, found := strings.Cut(str, "=")
+	if !found {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("missing items: %s", str)
+	}
+
+	entry := collection.BatchSendEntry{To: to}
+	for _, item := range strings.Split(itemsStr, ",") {
+		if !strings.Contains(item, ":") {
+			entry.TokenIds = append(entry.TokenIds, item)
+			continue
+		}
+
+		coin, err := collection.ParseCoin(item)
+		if err != nil {
+			return nil, err
+		}
+		entry.Amount = append(entry.Amount, *coin)
+	}
+
+	return &entry, nil
+}
+
 func NewTxCmdCreateContract() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create-contract [creator]",
@@ -392,11 +514,17 @@ func NewTxCmdIssueNFT() *cobra.Command {
 				return err
 			}
 
+			nonTransferable, err := cmd.Flags().GetBool(FlagNonTransferable)
+			if err != nil {
+				return err
+			}
+
 			msg := collection.MsgIssueNFT{
-				ContractId: args[0],
-				Owner:      operator,
-				Name:       name,
-				Meta:       meta,
+				ContractId:      args[0],
+				Owner:           operator,
+				Name:            name,
+				Meta:            meta,
+				NonTransferable: nonTransferable,
 			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -408,6 +536,7 @@ func NewTxCmdIssueNFT() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.Flags().String(FlagName, "", "set name")
 	cmd.Flags().String(FlagMeta, "", "set meta")
+	cmd.Flags().Bool(FlagNonTransferable, false, "set non-transferable")
 
 	return cmd
 }
@@ -484,10 +613,36 @@ func NewTxCmdMintNFT() *cobra.Command {
 				return err
 			}
 
+			uri, err := cmd.Flags().GetString(FlagURI)
+			if err != nil {
+				return err
+			}
+
+			uriHash, err := cmd.Flags().GetString(FlagURIHash)
+			if err != nil {
+				return err
+			}
+
+			traitsStr, err := cmd.Flags().GetStringSlice(FlagTraits)
+			if err != nil {
+				return err
+			}
+			traits := make([]collection.Trait, len(traitsStr))
+			for i, traitStr := range traitsStr {
+				trait, err := parseTrait(traitStr)
+				if err != nil {
+					return err
+				}
+				traits[i] = *trait
+			}
+
 			params := []collection.MintNFTParam{{
 				TokenType: args[3],
 				Name:      name,
 				Meta:      meta,
+				Uri:       uri,
+				UriHash:   uriHash,
+				Traits:    traits,
 			}}
 
 			msg := collection.MsgMintNFT{
@@ -506,11 +661,40 @@ func NewTxCmdMintNFT() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.Flags().String(FlagName, "", "set name")
 	cmd.Flags().String(FlagMeta, "", "set meta")
+	cmd.Flags().String(FlagURI, "", "set uri")
+	cmd.Flags().String(FlagURIHash, "", "set uri hash")
+	cmd.Flags().StringSlice(FlagTraits, nil, "set traits in the form of [key]=[value]:[type], where type is one of string, number and bool")
 	_ = cmd.MarkFlagRequired(FlagName)
 
 	return cmd
 }
 
+// parseTrait parses a trait of the form [key]=[value]:[type].
+func parseTrait(str string) (*collection.Trait, error) {
+	key, rest, found := strings.Cut(str, "=")
+	if !found {
+		return nil, collection.ErrInvalidTrait.Wrapf("missing value: %s", str)
+	}
+
+	sep := strings.LastIndex(rest, ":")
+	if sep < 0 {
+		return nil, collection.ErrInvalidTrait.Wrapf("missing type: %s", str)
+	}
+	value, typeName := rest[:sep], rest[sep+1:]
+
+	traitType := collection.TraitType(collection.TraitType_value["TRAIT_TYPE_"+strings.ToUpper(typeName)])
+	trait := collection.Trait{
+		Key:   key,
+		Value: value,
+		Type:  traitType,
+	}
+	if err := trait.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return &trait, nil
+}
+
 func NewTxCmdBurnFT() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "burn-ft [contract-id] [from] [amount]",
@@ -706,6 +890,61 @@ func NewTxCmdModify() *cobra.Command {
 	return cmd
 }
 
+func NewTxCmdSetRoyalty() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-royalty [contract-id] [operator] [rate] [recipient]",
+		Args:  cobra.RangeArgs(3, 4),
+		Short: "set the royalty of a class, or the default one of the contract if no class given (zero rate removes it)",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s set-royalty [contract-id] [operator] [rate] [recipient] [--class-id [class-id]]`, version.AppName, collection.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			operator := args[1]
+			if err := cmd.Flags().Set(flags.FlagFrom, operator); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			classID, err := cmd.Flags().GetString(FlagClassID)
+			if err != nil {
+				return err
+			}
+
+			rate, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+
+			var recipient string
+			if len(args) > 3 {
+				recipient = args[3]
+			}
+
+			msg := collection.MsgSetRoyalty{
+				ContractId: args[0],
+				Operator:   operator,
+				Royalty: collection.Royalty{
+					ClassId:   classID,
+					Recipient: recipient,
+					Rate:      rate,
+				},
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagClassID, "", "class id of the royalty")
+	return cmd
+}
+
 func NewTxCmdAttach() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "attach [contract-id] [holder] [subject] [target]",
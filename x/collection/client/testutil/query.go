@@ -739,6 +739,151 @@ func (s *IntegrationTestSuite) TestNewQueryCmdToken() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestNewQueryCmdNFTsByTrait() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%d", flags.FlagHeight, s.setupHeight),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	testCases := map[string]struct {
+		args     []string
+		valid    bool
+		expected proto.Message
+	}{
+		"valid query": {
+			[]string{
+				s.contractID,
+				s.nftClassID,
+				"species",
+				"fox",
+			},
+			true,
+			&collection.QueryNFTsByTraitResponse{
+				Tokens:     []collection.NFT{},
+				Pagination: &query.PageResponse{},
+			},
+		},
+		"extra args": {
+			[]string{
+				s.contractID,
+				s.nftClassID,
+				"species",
+				"fox",
+				"extra",
+			},
+			false,
+			nil,
+		},
+		"not enough args": {
+			[]string{
+				s.contractID,
+				s.nftClassID,
+				"species",
+			},
+			false,
+			nil,
+		},
+		"invalid class id": {
+			[]string{
+				s.contractID,
+				"",
+				"species",
+				"fox",
+			},
+			false,
+			nil,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewQueryCmdNFTsByTrait()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var actual collection.QueryNFTsByTraitResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &actual), out.String())
+			s.Require().Equal(tc.expected, &actual)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewQueryCmdOwnersOfClass() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%d", flags.FlagHeight, s.setupHeight),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	testCases := map[string]struct {
+		args     []string
+		valid    bool
+		expected []string
+	}{
+		"valid query": {
+			[]string{
+				s.contractID,
+				s.nftClassID,
+			},
+			true,
+			[]string{
+				s.customer.String(),
+				s.operator.String(),
+				s.vendor.String(),
+				s.stranger.String(),
+			},
+		},
+		"extra args": {
+			[]string{
+				s.contractID,
+				s.nftClassID,
+				"extra",
+			},
+			false,
+			nil,
+		},
+		"not enough args": {
+			[]string{
+				s.contractID,
+			},
+			false,
+			nil,
+		},
+		"invalid class id": {
+			[]string{
+				s.contractID,
+				"",
+			},
+			false,
+			nil,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewQueryCmdOwnersOfClass()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var actual collection.QueryOwnersOfClassResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &actual), out.String())
+			s.Require().ElementsMatch(tc.expected, actual.Owners)
+		})
+	}
+}
 func (s *IntegrationTestSuite) TestNewQueryCmdRoot() {
 	val := s.network.Validators[0]
 	commonArgs := []string{
@@ -763,6 +908,7 @@ func (s *IntegrationTestSuite) TestNewQueryCmdRoot() {
 				Root: collection.NFT{
 					TokenId: collection.NewNFTID(s.nftClassID, 1),
 					Name:    "arctic fox",
+					Traits:  []collection.Trait{},
 				},
 			},
 		},
@@ -835,6 +981,7 @@ func (s *IntegrationTestSuite) TestNewQueryCmdParent() {
 				Parent: collection.NFT{
 					TokenId: collection.NewNFTID(s.nftClassID, 1),
 					Name:    "arctic fox",
+					Traits:  []collection.Trait{},
 				},
 			},
 		},
@@ -907,6 +1054,7 @@ func (s *IntegrationTestSuite) TestNewQueryCmdChildren() {
 				Children: []collection.NFT{{
 					TokenId: collection.NewNFTID(s.nftClassID, 2),
 					Name:    "arctic fox",
+					Traits:  []collection.Trait{},
 				}},
 				Pagination: &query.PageResponse{},
 			},
@@ -1159,3 +1307,140 @@ func (s *IntegrationTestSuite) TestNewQueryCmdHoldersByOperator() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestNewQueryCmdRoyalty() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%d", flags.FlagHeight, s.setupHeight),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	royalty := collection.Royalty{
+		Recipient: s.vendor.String(),
+		Rate:      sdk.NewDecWithPrec(1, 1),
+	}
+
+	testCases := map[string]struct {
+		args     []string
+		valid    bool
+		expected proto.Message
+	}{
+		"valid query": {
+			[]string{
+				s.contractID,
+			},
+			true,
+			&collection.QueryRoyaltyResponse{
+				Royalty: royalty,
+			},
+		},
+		"valid query with class id": {
+			[]string{
+				s.contractID,
+				fmt.Sprintf("--%s=%s", cli.FlagClassID, s.nftClassID),
+			},
+			true,
+			&collection.QueryRoyaltyResponse{
+				Royalty: royalty,
+			},
+		},
+		"extra args": {
+			[]string{
+				s.contractID,
+				"extra",
+			},
+			false,
+			nil,
+		},
+		"not enough args": {
+			[]string{},
+			false,
+			nil,
+		},
+		"invalid class id": {
+			[]string{
+				s.contractID,
+				fmt.Sprintf("--%s=%s", cli.FlagClassID, "invalid"),
+			},
+			false,
+			nil,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewQueryCmdRoyalty()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var actual collection.QueryRoyaltyResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &actual), out.String())
+			s.Require().Equal(tc.expected, &actual)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewQueryCmdRoyalties() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%d", flags.FlagHeight, s.setupHeight),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	testCases := map[string]struct {
+		args     []string
+		valid    bool
+		expected proto.Message
+	}{
+		"valid query": {
+			[]string{
+				s.contractID,
+			},
+			true,
+			&collection.QueryRoyaltiesResponse{
+				Royalties: []collection.Royalty{{
+					Recipient: s.vendor.String(),
+					Rate:      sdk.NewDecWithPrec(1, 1),
+				}},
+				Pagination: &query.PageResponse{},
+			},
+		},
+		"extra args": {
+			[]string{
+				s.contractID,
+				"extra",
+			},
+			false,
+			nil,
+		},
+		"not enough args": {
+			[]string{},
+			false,
+			nil,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewQueryCmdRoyalties()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var actual collection.QueryRoyaltiesResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &actual), out.String())
+			s.Require().Equal(tc.expected, &actual)
+		})
+	}
+}
@@ -123,6 +123,9 @@ func (s *IntegrationTestSuite) SetupSuite() {
 		s.grant(s.contractID, s.vendor, s.operator, permission)
 	}
 
+	// vendor sets the default royalty of the contract
+	s.setRoyalty(s.contractID, s.vendor, "", s.vendor, sdk.NewDecWithPrec(1, 1))
+
 	// customer and vendor approves the operator to manipulate its tokens, so vendor can do OperatorXXX (Send or Burn) later.
 	s.authorizeOperator(s.contractID, s.customer, s.operator)
 	s.authorizeOperator(s.contractID, s.vendor, s.operator)
@@ -307,6 +310,24 @@ func (s *IntegrationTestSuite) grant(contractID string, granter, grantee sdk.Acc
 	s.Require().EqualValues(0, res.Code, out.String())
 }
 
+func (s *IntegrationTestSuite) setRoyalty(contractID string, operator sdk.AccAddress, classID string, recipient sdk.AccAddress, rate sdk.Dec) {
+	val := s.network.Validators[0]
+	args := append([]string{
+		contractID,
+		operator.String(),
+		rate.String(),
+		recipient.String(),
+		fmt.Sprintf("--%s=%s", cli.FlagClassID, classID),
+	}, commonArgs...)
+
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.NewTxCmdSetRoyalty(), args)
+	s.Require().NoError(err)
+
+	var res sdk.TxResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+	s.Require().EqualValues(0, res.Code, out.String())
+}
+
 // creates an account and send some coins to it for the future transactions.
 func (s *IntegrationTestSuite) createAccount(uid string) sdk.AccAddress {
 	val := s.network.Validators[0]
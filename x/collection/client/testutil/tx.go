@@ -336,6 +336,165 @@ func (s *IntegrationTestSuite) TestNewTxCmdOperatorSendNFT() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestNewTxCmdSellNFT() {
+	val := s.network.Validators[0]
+	// the msg requires the signatures of both the seller and the buyer
+	commonArgs := []string{
+		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+	}
+
+	tokenID := collection.NewNFTID(s.nftClassID, 1)
+	price := sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(100))).String()
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid transaction": {
+			[]string{
+				s.contractID,
+				s.customer.String(),
+				s.stranger.String(),
+				tokenID,
+				price,
+			},
+			true,
+		},
+		"extra args": {
+			[]string{
+				s.contractID,
+				s.customer.String(),
+				s.stranger.String(),
+				tokenID,
+				price,
+				"extra",
+			},
+			false,
+		},
+		"not enough args": {
+			[]string{
+				s.contractID,
+				s.customer.String(),
+				s.stranger.String(),
+				tokenID,
+			},
+			false,
+		},
+		"invalid price": {
+			[]string{
+				s.contractID,
+				s.customer.String(),
+				s.stranger.String(),
+				tokenID,
+				"invalid",
+			},
+			false,
+		},
+		"invalid contract id": {
+			[]string{
+				"",
+				s.customer.String(),
+				s.stranger.String(),
+				tokenID,
+				price,
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewTxCmdSellNFT()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			tx, err := val.ClientCtx.TxConfig.TxJSONDecoder()(out.Bytes())
+			s.Require().NoError(err, out.String())
+			s.Require().Len(tx.GetMsgs(), 1)
+			s.Require().IsType(&collection.MsgSellNFT{}, tx.GetMsgs()[0])
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewTxCmdBatchSend() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+	}
+
+	ftAmount := collection.NewFTCoin(s.ftClassID, sdk.OneInt()).String()
+	tokenID := collection.NewNFTID(s.nftClassID, 1)
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid transaction": {
+			[]string{
+				s.contractID,
+				s.customer.String(),
+				fmt.Sprintf("%s=%s,%s", s.vendor, ftAmount, tokenID),
+				fmt.Sprintf("%s=%s", s.stranger, ftAmount),
+			},
+			true,
+		},
+		"not enough args": {
+			[]string{
+				s.contractID,
+				s.customer.String(),
+			},
+			false,
+		},
+		"missing items": {
+			[]string{
+				s.contractID,
+				s.customer.String(),
+				s.vendor.String(),
+			},
+			false,
+		},
+		"amount out of range": {
+			[]string{
+				s.contractID,
+				s.customer.String(),
+				fmt.Sprintf("%s=%s:1%0127d", s.vendor, collection.NewFTID(s.ftClassID), 0),
+			},
+			false,
+		},
+		"invalid contract id": {
+			[]string{
+				"",
+				s.customer.String(),
+				fmt.Sprintf("%s=%s", s.vendor, ftAmount),
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewTxCmdBatchSend()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			tx, err := val.ClientCtx.TxConfig.TxJSONDecoder()(out.Bytes())
+			s.Require().NoError(err, out.String())
+			s.Require().Len(tx.GetMsgs(), 1)
+			s.Require().IsType(&collection.MsgBatchSend{}, tx.GetMsgs()[0])
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestNewTxCmdCreateContract() {
 	val := s.network.Validators[0]
 	commonArgs := []string{
@@ -615,6 +774,19 @@ func (s *IntegrationTestSuite) TestNewTxCmdMintNFT() {
 			},
 			true,
 		},
+		"valid transaction with metadata": {
+			[]string{
+				s.contractID,
+				s.operator.String(),
+				s.customer.String(),
+				s.nftClassID,
+				fmt.Sprintf("--%s=%s", cli.FlagName, "arctic fox"),
+				fmt.Sprintf("--%s=%s", cli.FlagURI, "ipfs://arctic-fox"),
+				fmt.Sprintf("--%s=%s", cli.FlagURIHash, "deadbeef"),
+				fmt.Sprintf("--%s=%s", cli.FlagTraits, "species=fox:string,level=1:number,rare=true:bool"),
+			},
+			true,
+		},
 		"extra args": {
 			[]string{
 				s.contractID,
@@ -625,6 +797,17 @@ func (s *IntegrationTestSuite) TestNewTxCmdMintNFT() {
 			},
 			false,
 		},
+		"invalid trait": {
+			[]string{
+				s.contractID,
+				s.operator.String(),
+				s.customer.String(),
+				s.nftClassID,
+				fmt.Sprintf("--%s=%s", cli.FlagName, "arctic fox"),
+				fmt.Sprintf("--%s=%s", cli.FlagTraits, "rare=yes:bool"),
+			},
+			false,
+		},
 		"not enough args": {
 			[]string{
 				s.contractID,
@@ -1012,6 +1195,93 @@ func (s *IntegrationTestSuite) TestNewTxCmdModify() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestNewTxCmdSetRoyalty() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid transaction": {
+			[]string{
+				s.contractID,
+				s.operator.String(),
+				"0.05",
+				s.operator.String(),
+				fmt.Sprintf("--%s=%s", cli.FlagClassID, s.nftClassID),
+			},
+			true,
+		},
+		"valid removal": {
+			[]string{
+				s.contractID,
+				s.operator.String(),
+				"0",
+				fmt.Sprintf("--%s=%s", cli.FlagClassID, s.nftClassID),
+			},
+			true,
+		},
+		"extra args": {
+			[]string{
+				s.contractID,
+				s.operator.String(),
+				"0.05",
+				s.operator.String(),
+				"extra",
+			},
+			false,
+		},
+		"not enough args": {
+			[]string{
+				s.contractID,
+				s.operator.String(),
+			},
+			false,
+		},
+		"invalid rate": {
+			[]string{
+				s.contractID,
+				s.operator.String(),
+				"1.5",
+				s.operator.String(),
+			},
+			false,
+		},
+		"invalid contract id": {
+			[]string{
+				"",
+				s.operator.String(),
+				"0.05",
+				s.operator.String(),
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewTxCmdSetRoyalty()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res sdk.TxResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+			s.Require().EqualValues(0, res.Code, out.String())
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestNewTxCmdAttach() {
 	val := s.network.Validators[0]
 	commonArgs := []string{
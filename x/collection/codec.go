@@ -18,6 +18,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgOperatorSendFT{}, "lbm-sdk/MsgOperatorSendFT")
 	legacy.RegisterAminoMsg(cdc, &MsgSendNFT{}, "lbm-sdk/MsgSendNFT")
 	legacy.RegisterAminoMsg(cdc, &MsgOperatorSendNFT{}, "lbm-sdk/MsgOperatorSendNFT")
+	legacy.RegisterAminoMsg(cdc, &MsgSellNFT{}, "lbm-sdk/MsgSellNFT")
+	legacy.RegisterAminoMsg(cdc, &MsgBatchSend{}, "lbm-sdk/collection/MsgBatchSend")                 // Changed msgName due to conflict with `x/token`
 	legacy.RegisterAminoMsg(cdc, &MsgAuthorizeOperator{}, "lbm-sdk/collection/MsgAuthorizeOperator") // Changed msgName due to conflict with `x/token`
 	legacy.RegisterAminoMsg(cdc, &MsgRevokeOperator{}, "lbm-sdk/collection/MsgRevokeOperator")       // Changed msgName due to conflict with `x/token`
 	legacy.RegisterAminoMsg(cdc, &MsgCreateContract{}, "lbm-sdk/MsgCreateContract")
@@ -32,6 +34,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgModify{}, "lbm-sdk/collection/MsgModify")                     // Changed msgName due to conflict with `x/token`
 	legacy.RegisterAminoMsg(cdc, &MsgGrantPermission{}, "lbm-sdk/collection/MsgGrantPermission")   // Changed msgName due to conflict with `x/token`
 	legacy.RegisterAminoMsg(cdc, &MsgRevokePermission{}, "lbm-sdk/collection/MsgRevokePermission") // Changed msgName due to conflict with `x/token`
+	legacy.RegisterAminoMsg(cdc, &MsgSetRoyalty{}, "lbm-sdk/MsgSetRoyalty")
 	legacy.RegisterAminoMsg(cdc, &MsgAttach{}, "lbm-sdk/MsgAttach")
 	legacy.RegisterAminoMsg(cdc, &MsgDetach{}, "lbm-sdk/MsgDetach")
 	legacy.RegisterAminoMsg(cdc, &MsgOperatorAttach{}, "lbm-sdk/MsgOperatorAttach")
@@ -51,6 +54,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgOperatorSendFT{},
 		&MsgSendNFT{},
 		&MsgOperatorSendNFT{},
+		&MsgSellNFT{},
+		&MsgBatchSend{},
 		&MsgAuthorizeOperator{},
 		&MsgRevokeOperator{},
 		&MsgBurnFT{},
@@ -58,6 +63,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgBurnNFT{},
 		&MsgOperatorBurnNFT{},
 		&MsgModify{},
+		&MsgSetRoyalty{},
 		&MsgGrantPermission{},
 		&MsgRevokePermission{},
 		&MsgOperatorAttach{},
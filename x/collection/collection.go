@@ -9,6 +9,7 @@ import (
 
 	codectypes "github.com/Finschia/finschia-sdk/codec/types"
 	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 )
 
 const (
@@ -144,6 +145,68 @@ func (c NFTClass) ValidateBasic() error {
 	return nil
 }
 
+// ----------------------------------------------------------------------------
+// Royalty
+func (r Royalty) ValidateBasic() error {
+	if r.ClassId != "" {
+		if err := ValidateClassID(r.ClassId); err != nil {
+			return ErrInvalidTokenType.Wrap(err.Error())
+		}
+	}
+
+	if r.Rate.IsNil() || r.Rate.IsNegative() || r.Rate.GT(sdk.OneDec()) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("royalty rate must be >= 0 and <= 1: %s", r.Rate)
+	}
+
+	// zero rate means no royalty
+	if r.Rate.IsZero() {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(r.Recipient); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", r.Recipient)
+	}
+
+	return nil
+}
+
+// Split splits the price into the royalty and the remainder going to the
+// seller. The royalty is truncated.
+func (r Royalty) Split(price sdk.Coins) (royalty, remainder sdk.Coins) {
+	royalty, _ = sdk.NewDecCoinsFromCoins(price...).MulDecTruncate(r.Rate).TruncateDecimal()
+	return royalty, price.Sub(royalty)
+}
+
+// ----------------------------------------------------------------------------
+// Trait
+func (t Trait) ValidateBasic() error {
+	if len(t.Key) == 0 {
+		return ErrInvalidTrait.Wrap("empty key")
+	}
+	// the limits are in bytes, as the trait goes into the store key
+	if length := len(t.Key); length > traitKeyLengthLimit {
+		return ErrInvalidTrait.Wrapf("key cannot exceed %d bytes: current %d", traitKeyLengthLimit, length)
+	}
+	if length := len(t.Value); length > traitValueLengthLimit {
+		return ErrInvalidTrait.Wrapf("value of %s cannot exceed %d bytes: current %d", t.Key, traitValueLengthLimit, length)
+	}
+
+	switch t.Type {
+	case TraitTypeString:
+	case TraitTypeNumber:
+		if _, err := sdk.NewDecFromStr(t.Value); err != nil {
+			return ErrInvalidTrait.Wrapf("invalid number of %s: %s", t.Key, t.Value)
+		}
+	case TraitTypeBool:
+		if t.Value != "true" && t.Value != "false" {
+			return ErrInvalidTrait.Wrapf("invalid bool of %s: %s", t.Key, t.Value)
+		}
+	default:
+		return ErrInvalidTrait.Wrapf("invalid type of %s: %s", t.Key, t.Type)
+	}
+
+	return nil
+}
+
 // ----------------------------------------------------------------------------
 // Coin
 func NewFTCoin(classID string, amount sdk.Int) Coin {
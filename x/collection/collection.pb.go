@@ -103,6 +103,42 @@ func (LegacyPermission) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_bb15fea9f4c37044, []int{1}
 }
 
+// TraitType enumerates the valid types of a trait value.
+type TraitType int32
+
+const (
+	// unspecified defines the default type which is invalid.
+	TraitTypeUnspecified TraitType = 0
+	// TRAIT_TYPE_STRING defines a value of an arbitrary string.
+	TraitTypeString TraitType = 1
+	// TRAIT_TYPE_NUMBER defines a value of a decimal number.
+	TraitTypeNumber TraitType = 2
+	// TRAIT_TYPE_BOOL defines a value of either "true" or "false".
+	TraitTypeBool TraitType = 3
+)
+
+var TraitType_name = map[int32]string{
+	0: "TRAIT_TYPE_UNSPECIFIED",
+	1: "TRAIT_TYPE_STRING",
+	2: "TRAIT_TYPE_NUMBER",
+	3: "TRAIT_TYPE_BOOL",
+}
+
+var TraitType_value = map[string]int32{
+	"TRAIT_TYPE_UNSPECIFIED": 0,
+	"TRAIT_TYPE_STRING":      1,
+	"TRAIT_TYPE_NUMBER":      2,
+	"TRAIT_TYPE_BOOL":        3,
+}
+
+func (x TraitType) String() string {
+	return proto.EnumName(TraitType_name, int32(x))
+}
+
+func (TraitType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_bb15fea9f4c37044, []int{2}
+}
+
 // Params defines the parameters for the collection module.
 type Params struct {
 	DepthLimit uint32 `protobuf:"varint,1,opt,name=depth_limit,json=depthLimit,proto3" json:"depth_limit,omitempty"` // Deprecated: Do not use.
@@ -286,6 +322,9 @@ type NFTClass struct {
 	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	// meta is a brief description of the token class.
 	Meta string `protobuf:"bytes,3,opt,name=meta,proto3" json:"meta,omitempty"`
+	// non_transferable makes the tokens of the class soulbound, which means
+	// they cannot be moved from their owners once minted.
+	NonTransferable bool `protobuf:"varint,4,opt,name=non_transferable,json=nonTransferable,proto3" json:"non_transferable,omitempty"`
 }
 
 func (m *NFTClass) Reset()         { *m = NFTClass{} }
@@ -342,6 +381,13 @@ func (m *NFTClass) GetMeta() string {
 	return ""
 }
 
+func (m *NFTClass) GetNonTransferable() bool {
+	if m != nil {
+		return m.NonTransferable
+	}
+	return false
+}
+
 // NFT defines the information of non-fungible token.
 //
 // Since: 0.46.0 (finschia)
@@ -352,6 +398,12 @@ type NFT struct {
 	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	// meta is a brief description of the token.
 	Meta string `protobuf:"bytes,3,opt,name=meta,proto3" json:"meta,omitempty"`
+	// uri is the uri of the resource of the token, stored off-chain.
+	Uri string `protobuf:"bytes,4,opt,name=uri,proto3" json:"uri,omitempty"`
+	// uri_hash is a hash of the document pointed by uri.
+	UriHash string `protobuf:"bytes,5,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
+	// traits are the typed attributes of the token.
+	Traits []Trait `protobuf:"bytes,6,rep,name=traits,proto3" json:"traits,omitempty"`
 }
 
 func (m *NFT) Reset()         { *m = NFT{} }
@@ -401,6 +453,12 @@ type OwnerNFT struct {
 	Meta string `protobuf:"bytes,4,opt,name=meta,proto3" json:"meta,omitempty"`
 	// owner of the token.
 	Owner string `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
+	// uri is the uri of the resource of the token, stored off-chain.
+	Uri string `protobuf:"bytes,6,opt,name=uri,proto3" json:"uri,omitempty"`
+	// uri_hash is a hash of the document pointed by uri.
+	UriHash string `protobuf:"bytes,7,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
+	// traits are the typed attributes of the token.
+	Traits []Trait `protobuf:"bytes,8,rep,name=traits,proto3" json:"traits,omitempty"`
 }
 
 func (m *OwnerNFT) Reset()         { *m = OwnerNFT{} }
@@ -503,6 +561,8 @@ type TokenType struct {
 	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	// meta is a brief description of the token type.
 	Meta string `protobuf:"bytes,4,opt,name=meta,proto3" json:"meta,omitempty"`
+	// non_transferable is true if the tokens of the type cannot be transferred.
+	NonTransferable bool `protobuf:"varint,5,opt,name=non_transferable,json=nonTransferable,proto3" json:"non_transferable,omitempty"`
 }
 
 func (m *TokenType) Reset()         { *m = TokenType{} }
@@ -621,6 +681,51 @@ func (m *Grant) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Grant proto.InternalMessageInfo
 
+// Royalty defines the royalty paid to the recipient on the sales of non-fungible tokens by Msg/SellNFT.
+type Royalty struct {
+	// class id of the non-fungible token class which the royalty applies to.
+	// empty means the default royalty of the contract, which applies to the classes
+	// without their own royalties.
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// address of the royalty recipient.
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// the ratio of the price paid to the recipient.
+	Rate github_com_Finschia_finschia_sdk_types.Dec `protobuf:"bytes,3,opt,name=rate,proto3,customtype=github.com/Finschia/finschia-sdk/types.Dec" json:"rate"`
+}
+
+func (m *Royalty) Reset()         { *m = Royalty{} }
+func (m *Royalty) String() string { return proto.CompactTextString(m) }
+func (*Royalty) ProtoMessage()    {}
+func (*Royalty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb15fea9f4c37044, []int{10}
+}
+func (m *Royalty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Royalty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Royalty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Royalty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Royalty.Merge(m, src)
+}
+func (m *Royalty) XXX_Size() int {
+	return m.Size()
+}
+func (m *Royalty) XXX_DiscardUnknown() {
+	xxx_messageInfo_Royalty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Royalty proto.InternalMessageInfo
+
 // Authorization defines an authorization given to the operator on tokens of the holder.
 //
 // Since: 0.46.0 (finschia)
@@ -635,7 +740,7 @@ func (m *Authorization) Reset()         { *m = Authorization{} }
 func (m *Authorization) String() string { return proto.CompactTextString(m) }
 func (*Authorization) ProtoMessage()    {}
 func (*Authorization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb15fea9f4c37044, []int{10}
+	return fileDescriptor_bb15fea9f4c37044, []int{11}
 }
 func (m *Authorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -676,7 +781,7 @@ func (m *Attribute) Reset()         { *m = Attribute{} }
 func (m *Attribute) String() string { return proto.CompactTextString(m) }
 func (*Attribute) ProtoMessage()    {}
 func (*Attribute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb15fea9f4c37044, []int{11}
+	return fileDescriptor_bb15fea9f4c37044, []int{12}
 }
 func (m *Attribute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -705,9 +810,53 @@ func (m *Attribute) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Attribute proto.InternalMessageInfo
 
+// Trait defines a typed attribute of a non-fungible token.
+type Trait struct {
+	// key of the trait.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// value of the trait, which must conform to the type.
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// type of the value.
+	Type TraitType `protobuf:"varint,3,opt,name=type,proto3,enum=lbm.collection.v1.TraitType" json:"type,omitempty"`
+}
+
+func (m *Trait) Reset()         { *m = Trait{} }
+func (m *Trait) String() string { return proto.CompactTextString(m) }
+func (*Trait) ProtoMessage()    {}
+func (*Trait) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb15fea9f4c37044, []int{13}
+}
+func (m *Trait) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Trait) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Trait.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Trait) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Trait.Merge(m, src)
+}
+func (m *Trait) XXX_Size() int {
+	return m.Size()
+}
+func (m *Trait) XXX_DiscardUnknown() {
+	xxx_messageInfo_Trait.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Trait proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("lbm.collection.v1.Permission", Permission_name, Permission_value)
 	proto.RegisterEnum("lbm.collection.v1.LegacyPermission", LegacyPermission_name, LegacyPermission_value)
+	proto.RegisterEnum("lbm.collection.v1.TraitType", TraitType_name, TraitType_value)
 	proto.RegisterType((*Params)(nil), "lbm.collection.v1.Params")
 	proto.RegisterType((*Contract)(nil), "lbm.collection.v1.Contract")
 	proto.RegisterType((*FTClass)(nil), "lbm.collection.v1.FTClass")
@@ -718,8 +867,10 @@ func init() {
 	proto.RegisterType((*TokenType)(nil), "lbm.collection.v1.TokenType")
 	proto.RegisterType((*Coin)(nil), "lbm.collection.v1.Coin")
 	proto.RegisterType((*Grant)(nil), "lbm.collection.v1.Grant")
+	proto.RegisterType((*Royalty)(nil), "lbm.collection.v1.Royalty")
 	proto.RegisterType((*Authorization)(nil), "lbm.collection.v1.Authorization")
 	proto.RegisterType((*Attribute)(nil), "lbm.collection.v1.Attribute")
+	proto.RegisterType((*Trait)(nil), "lbm.collection.v1.Trait")
 }
 
 func init() {
@@ -727,63 +878,79 @@ func init() {
 }
 
 var fileDescriptor_bb15fea9f4c37044 = []byte{
-	// 886 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x4f, 0x6f, 0xe3, 0xd4,
-	0x17, 0xb5, 0xf3, 0xaf, 0xc9, 0xad, 0xa6, 0x93, 0xf1, 0x2f, 0xbf, 0xe2, 0x06, 0xd5, 0x89, 0xcc,
-	0x82, 0xa1, 0xa8, 0x89, 0x66, 0x06, 0x10, 0xaa, 0xc4, 0xa2, 0xc9, 0x24, 0x83, 0x51, 0x9b, 0x56,
-	0x4e, 0x8a, 0x34, 0x6c, 0x82, 0x63, 0xbf, 0x26, 0x4f, 0xb5, 0xfd, 0x22, 0xfb, 0xa5, 0x25, 0x7c,
-	0x82, 0x51, 0x36, 0xb0, 0x64, 0x13, 0xa9, 0x12, 0x2c, 0x66, 0xc3, 0x6e, 0xd6, 0xac, 0xbb, 0x1c,
-	0xcd, 0x0a, 0xb1, 0x18, 0x41, 0xbb, 0x61, 0xcf, 0x17, 0x40, 0xef, 0xd9, 0x71, 0x4c, 0x12, 0x66,
-	0x46, 0x20, 0xb1, 0xbb, 0xf7, 0xbe, 0x73, 0xce, 0xbd, 0x3e, 0xd7, 0xf6, 0x03, 0xd5, 0xee, 0x39,
-	0x55, 0x93, 0xd8, 0x36, 0x32, 0x29, 0x26, 0x6e, 0xf5, 0xfc, 0x5e, 0x2c, 0xab, 0x0c, 0x3d, 0x42,
-	0x89, 0x74, 0xc7, 0xee, 0x39, 0x95, 0x58, 0xf5, 0xfc, 0x5e, 0xb1, 0xd0, 0x27, 0x7d, 0xc2, 0x4f,
-	0xab, 0x2c, 0x0a, 0x80, 0xc5, 0x2d, 0x93, 0xf8, 0x0e, 0xf1, 0xbb, 0xc1, 0x41, 0x90, 0x04, 0x47,
-	0xaa, 0x0e, 0x99, 0x63, 0xc3, 0x33, 0x1c, 0x5f, 0x7a, 0x07, 0xd6, 0x2d, 0x34, 0xa4, 0x83, 0xae,
-	0x8d, 0x1d, 0x4c, 0x65, 0xb1, 0x2c, 0xde, 0xbd, 0x55, 0x4b, 0xc8, 0xa2, 0x0e, 0xbc, 0x7c, 0xc0,
-	0xaa, 0x0c, 0x74, 0x81, 0xad, 0x08, 0x94, 0x98, 0x83, 0x78, 0x99, 0x83, 0xd4, 0x0e, 0x64, 0xeb,
-	0xc4, 0xa5, 0x9e, 0x61, 0x52, 0x69, 0x03, 0x12, 0xd8, 0xe2, 0x62, 0x39, 0x3d, 0x81, 0x2d, 0x49,
-	0x82, 0x94, 0x6b, 0x38, 0x88, 0x33, 0x73, 0x3a, 0x8f, 0x59, 0xcd, 0x41, 0xd4, 0x90, 0x93, 0x41,
-	0x8d, 0xc5, 0x52, 0x1e, 0x92, 0x23, 0x0f, 0xcb, 0x29, 0x5e, 0x62, 0xa1, 0xfa, 0x8d, 0x08, 0x6b,
-	0xcd, 0x4e, 0xdd, 0x36, 0x7c, 0xff, 0x1f, 0xab, 0x16, 0x21, 0x6b, 0x21, 0x13, 0x3b, 0x86, 0xed,
-	0x73, 0xe9, 0xb4, 0x1e, 0xe5, 0xec, 0xcc, 0xc1, 0x2e, 0x35, 0x7a, 0x36, 0x92, 0xd3, 0x65, 0xf1,
-	0x6e, 0x56, 0x8f, 0xf2, 0xbd, 0xc2, 0x93, 0xcb, 0x92, 0xf8, 0xe2, 0xd9, 0x2e, 0x74, 0xc8, 0x19,
-	0x72, 0xf9, 0x0c, 0xb2, 0xa8, 0x7e, 0x0e, 0xd9, 0xd6, 0xbf, 0x9c, 0x68, 0x4f, 0x5a, 0x56, 0x56,
-	0x3f, 0x85, 0x64, 0xab, 0xd9, 0x91, 0xb6, 0x20, 0x4b, 0x59, 0xb1, 0x1b, 0x09, 0xaf, 0xf1, 0x5c,
-	0x7b, 0x63, 0x75, 0xe6, 0x59, 0xf6, 0xe8, 0xc2, 0x45, 0x1e, 0xd3, 0x2b, 0xc1, 0xba, 0x19, 0xae,
-	0x65, 0x2e, 0x09, 0xb3, 0x92, 0x66, 0xfd, 0xa5, 0x61, 0x62, 0x75, 0xc3, 0xe4, 0x8a, 0x86, 0xa9,
-	0x98, 0xc1, 0x05, 0x48, 0x13, 0xd6, 0x8f, 0x3b, 0x98, 0xd3, 0x83, 0x64, 0x2f, 0xf7, 0xe2, 0xd9,
-	0x6e, 0x9a, 0x3f, 0xa0, 0xfa, 0xa3, 0x08, 0x89, 0xff, 0x68, 0x96, 0xf8, 0xb2, 0xd3, 0xaf, 0x58,
-	0x76, 0x66, 0x61, 0xd9, 0xeb, 0xd1, 0xb4, 0xb2, 0xa8, 0xfa, 0x90, 0xe3, 0x61, 0x67, 0x3c, 0x44,
-	0xaf, 0x9f, 0x7a, 0x1b, 0x20, 0x98, 0x9a, 0x8e, 0x87, 0xb3, 0xed, 0xe4, 0x68, 0xc4, 0x7f, 0xc3,
-	0xc9, 0xd5, 0x0b, 0x48, 0xd5, 0x09, 0x76, 0x5f, 0xf5, 0x06, 0x7c, 0x06, 0x19, 0xc3, 0x21, 0x23,
-	0x37, 0xf8, 0x06, 0x73, 0xb5, 0xfb, 0x57, 0x2f, 0x4b, 0xc2, 0x2f, 0x2f, 0x4b, 0x3b, 0x7d, 0x4c,
-	0x07, 0xa3, 0x5e, 0xc5, 0x24, 0x4e, 0xb5, 0x89, 0x5d, 0xdf, 0x1c, 0x60, 0xa3, 0x7a, 0x1a, 0x06,
-	0xbb, 0xbe, 0x75, 0x56, 0x65, 0xa3, 0xf9, 0x15, 0xcd, 0xa5, 0x7a, 0xa8, 0xb0, 0x97, 0xfd, 0xee,
-	0xb2, 0x24, 0xfc, 0x7e, 0x59, 0x12, 0xd5, 0x2f, 0x21, 0xfd, 0xc8, 0x33, 0x5c, 0x2a, 0xc9, 0xb0,
-	0xd6, 0x67, 0x01, 0x42, 0xb3, 0xc6, 0x61, 0x2a, 0x7d, 0x02, 0x30, 0x44, 0x9e, 0x83, 0x7d, 0x1f,
-	0x13, 0x97, 0x37, 0xdf, 0xb8, 0xbf, 0x5d, 0x59, 0xfa, 0x13, 0x55, 0x8e, 0x23, 0x90, 0x1e, 0x23,
-	0xa8, 0x75, 0xb8, 0xb5, 0x3f, 0xa2, 0x03, 0xe2, 0xe1, 0xaf, 0x0d, 0x06, 0x95, 0x36, 0x21, 0x33,
-	0x20, 0xb6, 0x85, 0xbc, 0xb0, 0x51, 0x98, 0xb1, 0x0d, 0x91, 0x21, 0xf2, 0x0c, 0x4a, 0xbc, 0xd0,
-	0xc8, 0x28, 0x57, 0x1f, 0x40, 0x6e, 0x9f, 0x52, 0x0f, 0xf7, 0x46, 0x14, 0xb1, 0x3f, 0xc5, 0x19,
-	0x1a, 0x87, 0x6c, 0x16, 0xb2, 0x97, 0xf0, 0xdc, 0xb0, 0x47, 0xb3, 0x05, 0x04, 0xc9, 0xce, 0x1f,
-	0x22, 0xc0, 0x7c, 0x28, 0xe9, 0x43, 0xd8, 0x3c, 0x6e, 0xe8, 0x87, 0x5a, 0xbb, 0xad, 0x1d, 0xb5,
-	0xba, 0x27, 0xad, 0xf6, 0x71, 0xa3, 0xae, 0x35, 0xb5, 0xc6, 0xc3, 0xbc, 0x50, 0xdc, 0x9a, 0x4c,
-	0xcb, 0xff, 0x9f, 0x63, 0x4f, 0x5c, 0x7f, 0x88, 0x4c, 0x7c, 0x8a, 0x91, 0x25, 0xbd, 0x07, 0xf9,
-	0x18, 0x4d, 0x6b, 0xb7, 0x4f, 0x1a, 0x79, 0xb1, 0xf8, 0xbf, 0xc9, 0xb4, 0x7c, 0x7b, 0x4e, 0xd0,
-	0x7c, 0x7f, 0x84, 0xa4, 0xf7, 0xe1, 0x4e, 0x0c, 0x7a, 0x78, 0xf4, 0x50, 0x6b, 0x3e, 0xce, 0x27,
-	0x8a, 0x85, 0xc9, 0xb4, 0x9c, 0x9f, 0x63, 0x0f, 0x89, 0x85, 0x4f, 0xc7, 0xd2, 0xbb, 0x70, 0x3b,
-	0x0e, 0xd6, 0x5a, 0x9d, 0x7c, 0xb2, 0x28, 0x4d, 0xa6, 0xe5, 0x8d, 0x18, 0x14, 0xbb, 0x74, 0x01,
-	0x58, 0x3b, 0xd1, 0x5b, 0xf9, 0xd4, 0x22, 0xb0, 0x36, 0xf2, 0xdc, 0x62, 0xea, 0xc9, 0xf7, 0x8a,
-	0xb0, 0xf3, 0x53, 0x02, 0xf2, 0x07, 0xa8, 0x6f, 0x98, 0xe3, 0xd8, 0xb3, 0xd7, 0x60, 0xfb, 0xa0,
-	0xf1, 0x68, 0xbf, 0xfe, 0xb8, 0xfb, 0xb7, 0x16, 0x94, 0x26, 0xd3, 0xf2, 0xdb, 0x8b, 0xc4, 0xb8,
-	0x11, 0x1f, 0xc1, 0x5b, 0xcb, 0x1a, 0x33, 0x3f, 0xb8, 0x81, 0x8b, 0xec, 0xc0, 0x95, 0x8f, 0x41,
-	0x5e, 0xe6, 0x45, 0xe6, 0x14, 0x27, 0xd3, 0xf2, 0xe6, 0x22, 0x31, 0xb4, 0xe8, 0x03, 0xd8, 0x5c,
-	0xc1, 0x0c, 0x9c, 0x92, 0x27, 0xd3, 0x72, 0x61, 0x89, 0xc7, 0xfc, 0x5a, 0xc9, 0x0a, 0x6d, 0x5b,
-	0xc9, 0xe2, 0xe6, 0x65, 0x99, 0x79, 0x4f, 0x7f, 0x50, 0x84, 0xda, 0xd1, 0xd5, 0x6f, 0x8a, 0xf0,
-	0xf4, 0x5a, 0x11, 0xae, 0xae, 0x15, 0xf1, 0xf9, 0xb5, 0x22, 0xfe, 0x7a, 0xad, 0x88, 0xdf, 0xde,
-	0x28, 0xc2, 0xf3, 0x1b, 0x45, 0xf8, 0xf9, 0x46, 0x11, 0xbe, 0xd8, 0x7d, 0xed, 0x27, 0xf7, 0x55,
-	0xec, 0xee, 0xee, 0x65, 0xf8, 0xc5, 0xfb, 0xe0, 0xcf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x96, 0x09,
-	0xbe, 0x0d, 0xe2, 0x07, 0x00, 0x00,
+	// 1144 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x8f, 0xdb, 0x44,
+	0x14, 0x8e, 0xf3, 0x3b, 0x6f, 0xd5, 0xae, 0xd7, 0x2c, 0x8b, 0x1b, 0xda, 0x24, 0x32, 0x12, 0x94,
+	0x85, 0x4d, 0x68, 0x0b, 0x08, 0xad, 0xc4, 0x61, 0x93, 0x26, 0xc5, 0xd5, 0x6e, 0xb2, 0x72, 0xbc,
+	0x87, 0x72, 0x09, 0x8e, 0x33, 0x9b, 0x8c, 0x6a, 0x7b, 0x22, 0x7b, 0xd2, 0x12, 0x6e, 0xdc, 0xaa,
+	0x5c, 0xe0, 0xc8, 0x25, 0xa8, 0x12, 0x1c, 0x7a, 0xe1, 0xd6, 0x33, 0x12, 0x27, 0x7a, 0xac, 0x7a,
+	0x42, 0x1c, 0x56, 0xb0, 0x7b, 0x41, 0x5c, 0xf9, 0x07, 0xd0, 0x8c, 0x1d, 0xc7, 0x64, 0xb3, 0x6d,
+	0x61, 0x25, 0x6e, 0xf3, 0x9e, 0xbf, 0xef, 0xcd, 0xfb, 0x3e, 0xbf, 0x67, 0x19, 0x14, 0xab, 0x6b,
+	0x57, 0x4c, 0x62, 0x59, 0xc8, 0xa4, 0x98, 0x38, 0x95, 0x7b, 0xd7, 0x22, 0x51, 0x79, 0xe8, 0x12,
+	0x4a, 0xa4, 0x35, 0xab, 0x6b, 0x97, 0x23, 0xd9, 0x7b, 0xd7, 0xf2, 0xeb, 0x7d, 0xd2, 0x27, 0xfc,
+	0x69, 0x85, 0x9d, 0x7c, 0x60, 0xfe, 0x92, 0x49, 0x3c, 0x9b, 0x78, 0x1d, 0xff, 0x81, 0x1f, 0xf8,
+	0x8f, 0x14, 0x0d, 0xd2, 0xfb, 0x86, 0x6b, 0xd8, 0x9e, 0xf4, 0x06, 0xac, 0xf4, 0xd0, 0x90, 0x0e,
+	0x3a, 0x16, 0xb6, 0x31, 0x95, 0x85, 0x92, 0x70, 0xf5, 0x42, 0x35, 0x2e, 0x0b, 0x1a, 0xf0, 0xf4,
+	0x2e, 0xcb, 0x32, 0xd0, 0x7d, 0xdc, 0x0b, 0x41, 0xf1, 0x39, 0x88, 0xa7, 0x39, 0x48, 0xd1, 0x21,
+	0x5b, 0x23, 0x0e, 0x75, 0x0d, 0x93, 0x4a, 0x17, 0x21, 0x8e, 0x7b, 0xbc, 0x58, 0x4e, 0x8b, 0xe3,
+	0x9e, 0x24, 0x41, 0xd2, 0x31, 0x6c, 0xc4, 0x99, 0x39, 0x8d, 0x9f, 0x59, 0xce, 0x46, 0xd4, 0x90,
+	0x13, 0x7e, 0x8e, 0x9d, 0x25, 0x11, 0x12, 0x23, 0x17, 0xcb, 0x49, 0x9e, 0x62, 0x47, 0xe5, 0x2b,
+	0x01, 0x32, 0x0d, 0xbd, 0x66, 0x19, 0x9e, 0xf7, 0x9f, 0xab, 0xe6, 0x21, 0xdb, 0x43, 0x26, 0xb6,
+	0x0d, 0xcb, 0xe3, 0xa5, 0x53, 0x5a, 0x18, 0xb3, 0x67, 0x36, 0x76, 0xa8, 0xd1, 0xb5, 0x90, 0x9c,
+	0x2a, 0x09, 0x57, 0xb3, 0x5a, 0x18, 0x6f, 0xaf, 0x3f, 0x78, 0x58, 0x14, 0x9e, 0x3d, 0xde, 0x02,
+	0x9d, 0xdc, 0x45, 0x0e, 0xef, 0x41, 0x16, 0x94, 0x2f, 0x05, 0xc8, 0x36, 0xcf, 0xdb, 0xd2, 0xdb,
+	0x20, 0x3a, 0xc4, 0xe9, 0x50, 0xd7, 0x70, 0xbc, 0x43, 0xe4, 0xf2, 0xeb, 0x93, 0xfc, 0xfa, 0x55,
+	0x87, 0x38, 0x7a, 0x24, 0xbd, 0x2d, 0x9d, 0xee, 0x42, 0xf9, 0x49, 0x80, 0x44, 0xb3, 0xa1, 0x4b,
+	0x97, 0x20, 0x4b, 0x59, 0xb6, 0x13, 0x36, 0x91, 0xe1, 0xb1, 0x7a, 0x0e, 0xcb, 0x59, 0xd1, 0x91,
+	0x8b, 0x3b, 0x03, 0xc3, 0x1b, 0x70, 0x4b, 0x72, 0x5a, 0x66, 0xe4, 0xe2, 0x4f, 0x0c, 0x6f, 0x20,
+	0xdd, 0x86, 0x34, 0x75, 0x0d, 0x4c, 0x3d, 0x39, 0x5d, 0x4a, 0x5c, 0x5d, 0xb9, 0x2e, 0x97, 0x4f,
+	0x0d, 0x63, 0x59, 0x67, 0x80, 0xaa, 0xfc, 0xe4, 0xa8, 0x18, 0xfb, 0xf3, 0xa8, 0x28, 0xfa, 0xf8,
+	0x77, 0x89, 0x8d, 0x29, 0xb2, 0x87, 0x74, 0xac, 0x05, 0x15, 0x94, 0x07, 0x71, 0xc8, 0xb6, 0xee,
+	0x3b, 0xc8, 0x65, 0x42, 0x8a, 0xb0, 0x62, 0x06, 0xc3, 0x33, 0xd7, 0x02, 0xb3, 0x94, 0xda, 0xfb,
+	0x87, 0xd2, 0xf8, 0x72, 0xa5, 0x89, 0x25, 0x4a, 0x93, 0x11, 0xa5, 0xeb, 0x90, 0x22, 0xec, 0xbe,
+	0x40, 0x94, 0x1f, 0xcc, 0xf4, 0xa7, 0x97, 0xeb, 0xcf, 0x9c, 0xa5, 0x3f, 0x7b, 0x5e, 0xfd, 0xdb,
+	0xb9, 0x67, 0x8f, 0xb7, 0x52, 0xfc, 0x9d, 0x2a, 0x3f, 0x08, 0x10, 0xff, 0x9f, 0x4c, 0x88, 0xee,
+	0x42, 0xea, 0x39, 0xbb, 0x90, 0x5e, 0xd8, 0x85, 0x95, 0xb0, 0x5b, 0x59, 0x50, 0xbe, 0x15, 0x20,
+	0xc7, 0xcf, 0xfa, 0x78, 0x88, 0x5e, 0xdc, 0xf6, 0x15, 0x00, 0xbf, 0x6d, 0x3a, 0x1e, 0xce, 0x06,
+	0x32, 0x47, 0x43, 0xfe, 0xcb, 0xb6, 0xbe, 0x6c, 0x67, 0x52, 0x4b, 0x77, 0x46, 0xb9, 0x0f, 0xc9,
+	0x1a, 0xc1, 0xce, 0xf3, 0xf6, 0xe3, 0x36, 0xa4, 0x0d, 0x9b, 0x8c, 0x1c, 0xff, 0x73, 0x96, 0xab,
+	0x5e, 0x67, 0x2f, 0xec, 0xd7, 0xa3, 0xe2, 0x66, 0x1f, 0xd3, 0xc1, 0xa8, 0x5b, 0x36, 0x89, 0x5d,
+	0x69, 0x60, 0xc7, 0x33, 0x07, 0xd8, 0xa8, 0x1c, 0x06, 0x87, 0x2d, 0xaf, 0x77, 0xb7, 0xc2, 0x54,
+	0x78, 0x65, 0xd5, 0xa1, 0x5a, 0x50, 0x61, 0x3b, 0xfb, 0xcd, 0xc3, 0x62, 0xec, 0x8f, 0x87, 0x45,
+	0x41, 0xf9, 0x0c, 0x52, 0xb7, 0x5c, 0xc3, 0xa1, 0x92, 0x0c, 0x99, 0x3e, 0x3b, 0x20, 0x34, 0xbb,
+	0x38, 0x08, 0xa5, 0x8f, 0x01, 0x86, 0xc8, 0xb5, 0xb1, 0xe7, 0x61, 0xe2, 0xf0, 0xcb, 0x2f, 0x5e,
+	0xbf, 0xb2, 0x64, 0x8e, 0xf6, 0x43, 0x90, 0x16, 0x21, 0x28, 0x13, 0x01, 0x32, 0x1a, 0x19, 0x1b,
+	0x16, 0x1d, 0x33, 0x79, 0x26, 0xfb, 0x1e, 0x44, 0xe4, 0xf1, 0x58, 0xed, 0x49, 0x97, 0x21, 0xe7,
+	0x22, 0x13, 0x0f, 0x31, 0x9a, 0x29, 0xd4, 0xe6, 0x09, 0xa9, 0x01, 0x49, 0xd7, 0xa0, 0x81, 0xe5,
+	0xff, 0x5a, 0xfa, 0x4d, 0x64, 0x6a, 0x9c, 0xaf, 0xd4, 0xe0, 0xc2, 0xce, 0x88, 0x0e, 0x88, 0x8b,
+	0xbf, 0x30, 0x58, 0xdf, 0xd2, 0x06, 0xa4, 0x07, 0xc4, 0xea, 0x21, 0x37, 0xe8, 0x27, 0x88, 0xd8,
+	0x68, 0x91, 0x21, 0x72, 0x0d, 0x4a, 0xdc, 0xa0, 0x9b, 0x30, 0x56, 0x6e, 0x40, 0x6e, 0x87, 0x52,
+	0x17, 0x77, 0x47, 0x14, 0xb1, 0x75, 0xbc, 0x8b, 0xc6, 0x01, 0x9b, 0x1d, 0xd9, 0xda, 0xde, 0x33,
+	0xac, 0xd1, 0x6c, 0x70, 0xfc, 0x40, 0x31, 0x20, 0xc5, 0x17, 0xed, 0x65, 0x09, 0xd2, 0x7b, 0x90,
+	0xe4, 0xe3, 0x97, 0xe0, 0x86, 0x5f, 0x3e, 0x6b, 0x71, 0xd9, 0x44, 0x6a, 0x1c, 0xb9, 0xf9, 0x97,
+	0x00, 0x30, 0x7f, 0x09, 0xd2, 0x07, 0xb0, 0xb1, 0x5f, 0xd7, 0xf6, 0xd4, 0x76, 0x5b, 0x6d, 0x35,
+	0x3b, 0x07, 0xcd, 0xf6, 0x7e, 0xbd, 0xa6, 0x36, 0xd4, 0xfa, 0x4d, 0x31, 0x96, 0xbf, 0x34, 0x99,
+	0x96, 0x5e, 0x9d, 0x63, 0x0f, 0x1c, 0x6f, 0x88, 0x4c, 0x7c, 0x88, 0x51, 0x8f, 0x4d, 0x6d, 0x84,
+	0xa6, 0xb6, 0xdb, 0x07, 0x75, 0x51, 0xc8, 0xbf, 0x32, 0x99, 0x96, 0x56, 0xe7, 0x04, 0xd5, 0xf3,
+	0x46, 0x48, 0x7a, 0x07, 0xd6, 0x22, 0xd0, 0xbd, 0xd6, 0x4d, 0xb5, 0x71, 0x47, 0x8c, 0xe7, 0xd7,
+	0x27, 0xd3, 0x92, 0x38, 0xc7, 0xee, 0x91, 0x1e, 0x3e, 0x1c, 0x4b, 0x6f, 0xc1, 0x6a, 0x14, 0xac,
+	0x36, 0x75, 0x31, 0x91, 0x97, 0x26, 0xd3, 0xd2, 0xc5, 0x08, 0x14, 0x3b, 0x74, 0x01, 0x58, 0x3d,
+	0xd0, 0x9a, 0x62, 0x72, 0x11, 0x58, 0x1d, 0xb9, 0x4e, 0x3e, 0xf9, 0xe0, 0xbb, 0x42, 0x6c, 0xf3,
+	0xc7, 0x38, 0x88, 0xbb, 0xa8, 0x6f, 0x98, 0xe3, 0x88, 0xf6, 0x2a, 0x5c, 0xd9, 0xad, 0xdf, 0xda,
+	0xa9, 0xdd, 0xe9, 0x9c, 0x69, 0x41, 0x71, 0x32, 0x2d, 0xbd, 0xbe, 0x48, 0x8c, 0x1a, 0xf1, 0x21,
+	0xbc, 0x76, 0xba, 0xc6, 0xcc, 0x0f, 0x6e, 0xe0, 0x22, 0xdb, 0x77, 0xe5, 0x23, 0x90, 0x4f, 0xf3,
+	0x42, 0x73, 0xf2, 0x93, 0x69, 0x69, 0x63, 0x91, 0x18, 0x58, 0xf4, 0x3e, 0x6c, 0x2c, 0x61, 0xfa,
+	0x4e, 0xc9, 0x93, 0x69, 0x69, 0xfd, 0x14, 0x8f, 0xf9, 0xb5, 0x94, 0x15, 0xd8, 0xb6, 0x94, 0xc5,
+	0xcd, 0xcb, 0x32, 0xf3, 0x1e, 0x7d, 0x5f, 0x88, 0x6d, 0xfe, 0xcc, 0x3e, 0x8e, 0xb3, 0x51, 0x62,
+	0xd5, 0x74, 0x6d, 0x47, 0xd5, 0x3b, 0xfa, 0x9d, 0xfd, 0xfa, 0x82, 0x65, 0xbc, 0x5a, 0x08, 0x8d,
+	0x7a, 0xb5, 0x09, 0x6b, 0x11, 0x56, 0x5b, 0xd7, 0xd4, 0xe6, 0xad, 0xd9, 0xd4, 0x84, 0x84, 0x36,
+	0x75, 0xb1, 0xd3, 0x5f, 0xc0, 0x36, 0x0f, 0xf6, 0xaa, 0x75, 0x4d, 0x8c, 0x2f, 0x60, 0x9b, 0x23,
+	0xbb, 0x8b, 0x5c, 0xe9, 0x4d, 0x58, 0x8d, 0x60, 0xab, 0xad, 0xd6, 0xae, 0x98, 0xc8, 0xaf, 0x4d,
+	0xa6, 0xa5, 0x0b, 0x21, 0xb2, 0x4a, 0x88, 0xe5, 0x8f, 0x42, 0xb5, 0xf5, 0xe4, 0xf7, 0x42, 0xec,
+	0xd1, 0x71, 0x21, 0xf6, 0xe4, 0xb8, 0x20, 0x3c, 0x3d, 0x2e, 0x08, 0xbf, 0x1d, 0x17, 0x84, 0xaf,
+	0x4f, 0x0a, 0xb1, 0xa7, 0x27, 0x85, 0xd8, 0x2f, 0x27, 0x85, 0xd8, 0xa7, 0x5b, 0x2f, 0xfc, 0x62,
+	0x7c, 0x1e, 0xf9, 0x81, 0xed, 0xa6, 0xf9, 0xdf, 0xe7, 0x8d, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff,
+	0x66, 0xfe, 0x78, 0xba, 0xe7, 0x0a, 0x00, 0x00,
 }
 
 func (this *Coin) Equal(that interface{}) bool {
@@ -976,6 +1143,16 @@ func (m *NFTClass) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NonTransferable {
+		i--
+		if m.NonTransferable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Meta) > 0 {
 		i -= len(m.Meta)
 		copy(dAtA[i:], m.Meta)
@@ -1020,6 +1197,34 @@ func (m *NFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Traits) > 0 {
+		for iNdEx := len(m.Traits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Traits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCollection(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.UriHash) > 0 {
+		i -= len(m.UriHash)
+		copy(dAtA[i:], m.UriHash)
+		i = encodeVarintCollection(dAtA, i, uint64(len(m.UriHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Uri) > 0 {
+		i -= len(m.Uri)
+		copy(dAtA[i:], m.Uri)
+		i = encodeVarintCollection(dAtA, i, uint64(len(m.Uri)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Meta) > 0 {
 		i -= len(m.Meta)
 		copy(dAtA[i:], m.Meta)
@@ -1064,6 +1269,34 @@ func (m *OwnerNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Traits) > 0 {
+		for iNdEx := len(m.Traits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Traits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCollection(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.UriHash) > 0 {
+		i -= len(m.UriHash)
+		copy(dAtA[i:], m.UriHash)
+		i = encodeVarintCollection(dAtA, i, uint64(len(m.UriHash)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Uri) > 0 {
+		i -= len(m.Uri)
+		copy(dAtA[i:], m.Uri)
+		i = encodeVarintCollection(dAtA, i, uint64(len(m.Uri)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Owner) > 0 {
 		i -= len(m.Owner)
 		copy(dAtA[i:], m.Owner)
@@ -1188,6 +1421,16 @@ func (m *TokenType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NonTransferable {
+		i--
+		if m.NonTransferable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.Meta) > 0 {
 		i -= len(m.Meta)
 		copy(dAtA[i:], m.Meta)
@@ -1294,6 +1537,53 @@ func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Royalty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Royalty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Royalty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCollection(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintCollection(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintCollection(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Authorization) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1368,6 +1658,48 @@ func (m *Attribute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Trait) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Trait) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Trait) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Type != 0 {
+		i = encodeVarintCollection(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintCollection(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintCollection(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCollection(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCollection(v)
 	base := offset
@@ -1464,6 +1796,9 @@ func (m *NFTClass) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCollection(uint64(l))
 	}
+	if m.NonTransferable {
+		n += 2
+	}
 	return n
 }
 
@@ -1485,6 +1820,20 @@ func (m *NFT) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCollection(uint64(l))
 	}
+	l = len(m.Uri)
+	if l > 0 {
+		n += 1 + l + sovCollection(uint64(l))
+	}
+	l = len(m.UriHash)
+	if l > 0 {
+		n += 1 + l + sovCollection(uint64(l))
+	}
+	if len(m.Traits) > 0 {
+		for _, e := range m.Traits {
+			l = e.Size()
+			n += 1 + l + sovCollection(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1514,6 +1863,20 @@ func (m *OwnerNFT) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCollection(uint64(l))
 	}
+	l = len(m.Uri)
+	if l > 0 {
+		n += 1 + l + sovCollection(uint64(l))
+	}
+	l = len(m.UriHash)
+	if l > 0 {
+		n += 1 + l + sovCollection(uint64(l))
+	}
+	if len(m.Traits) > 0 {
+		for _, e := range m.Traits {
+			l = e.Size()
+			n += 1 + l + sovCollection(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1570,6 +1933,9 @@ func (m *TokenType) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCollection(uint64(l))
 	}
+	if m.NonTransferable {
+		n += 2
+	}
 	return n
 }
 
@@ -1604,6 +1970,25 @@ func (m *Grant) Size() (n int) {
 	return n
 }
 
+func (m *Royalty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovCollection(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovCollection(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovCollection(uint64(l))
+	return n
+}
+
 func (m *Authorization) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1638,6 +2023,26 @@ func (m *Attribute) Size() (n int) {
 	return n
 }
 
+func (m *Trait) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovCollection(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovCollection(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovCollection(uint64(m.Type))
+	}
+	return n
+}
+
 func sovCollection(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2220,6 +2625,26 @@ func (m *NFTClass) Unmarshal(dAtA []byte) error {
 			}
 			m.Meta = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonTransferable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NonTransferable = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCollection(dAtA[iNdEx:])
@@ -2366,13 +2791,111 @@ func (m *NFT) Unmarshal(dAtA []byte) error {
 			}
 			m.Meta = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCollection(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UriHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UriHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Traits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Traits = append(m.Traits, Trait{})
+			if err := m.Traits[len(m.Traits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCollection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthCollection
 			}
 			if (iNdEx + skippy) > l {
@@ -2576,6 +3099,104 @@ func (m *OwnerNFT) Unmarshal(dAtA []byte) error {
 			}
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UriHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UriHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Traits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Traits = append(m.Traits, Trait{})
+			if err := m.Traits[len(m.Traits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCollection(dAtA[iNdEx:])
@@ -2971,6 +3592,26 @@ func (m *TokenType) Unmarshal(dAtA []byte) error {
 			}
 			m.Meta = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonTransferable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NonTransferable = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCollection(dAtA[iNdEx:])
@@ -3209,6 +3850,154 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Royalty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCollection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Royalty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Royalty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCollection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Authorization) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3437,6 +4226,139 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Trait) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCollection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Trait: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Trait: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= TraitType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCollection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCollection(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -53,4 +53,6 @@ var (
 	ErrCompositionTooDeep            = sdkerrors.Register(collectionCodespace, 45, "cannot attach token (composition too deep)")
 	ErrCompositionTooWide            = sdkerrors.Register(collectionCodespace, 46, "cannot attach token (composition too wide)")
 	ErrBurnNonRootNFT                = sdkerrors.Register(collectionCodespace, 47, "cannot burn non-root NFTs")
+	ErrTokenNotTransferable          = sdkerrors.Register(collectionCodespace, 48, "token is not transferable")
+	ErrInvalidTrait                  = sdkerrors.Register(collectionCodespace, 49, "invalid trait")
 )
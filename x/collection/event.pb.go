@@ -5,6 +5,8 @@ package collection
 
 import (
 	fmt "fmt"
+	github_com_Finschia_finschia_sdk_types "github.com/Finschia/finschia-sdk/types"
+	types "github.com/Finschia/finschia-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -33,6 +35,7 @@ const (
 	// deprecated: use ATTRIBUTE_KEY_URI
 	AttributeKeyBaseImgURI AttributeKey = 8
 	AttributeKeyURI        AttributeKey = 20
+	AttributeKeyURIHash    AttributeKey = 21
 )
 
 var AttributeKey_name = map[int32]string{
@@ -41,6 +44,7 @@ var AttributeKey_name = map[int32]string{
 	2:  "ATTRIBUTE_KEY_META",
 	8:  "ATTRIBUTE_KEY_BASE_IMG_URI",
 	20: "ATTRIBUTE_KEY_URI",
+	21: "ATTRIBUTE_KEY_URI_HASH",
 }
 
 var AttributeKey_value = map[string]int32{
@@ -49,6 +53,7 @@ var AttributeKey_value = map[string]int32{
 	"ATTRIBUTE_KEY_META":         2,
 	"ATTRIBUTE_KEY_BASE_IMG_URI": 8,
 	"ATTRIBUTE_KEY_URI":          20,
+	"ATTRIBUTE_KEY_URI_HASH":     21,
 }
 
 func (AttributeKey) EnumDescriptor() ([]byte, []int) {
@@ -139,6 +144,106 @@ func (m *EventSent) GetAmount() []Coin {
 	return nil
 }
 
+// EventSoldNFT is emitted when a non-fungible token is sold.
+type EventSoldNFT struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// address of the seller.
+	Seller string `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	// address of the buyer.
+	Buyer string `protobuf:"bytes,3,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// token id of the token sold.
+	TokenId string `protobuf:"bytes,4,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	// the price paid by the buyer.
+	Price github_com_Finschia_finschia_sdk_types.Coins `protobuf:"bytes,5,rep,name=price,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coins" json:"price"`
+	// address of the royalty recipient.
+	RoyaltyRecipient string `protobuf:"bytes,6,opt,name=royalty_recipient,json=royaltyRecipient,proto3" json:"royalty_recipient,omitempty"`
+	// the portion of the price paid to the royalty recipient.
+	Royalty github_com_Finschia_finschia_sdk_types.Coins `protobuf:"bytes,7,rep,name=royalty,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coins" json:"royalty"`
+}
+
+func (m *EventSoldNFT) Reset()         { *m = EventSoldNFT{} }
+func (m *EventSoldNFT) String() string { return proto.CompactTextString(m) }
+func (*EventSoldNFT) ProtoMessage()    {}
+func (*EventSoldNFT) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478cfab12ea1b00e, []int{1}
+}
+func (m *EventSoldNFT) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSoldNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSoldNFT.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSoldNFT) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSoldNFT.Merge(m, src)
+}
+func (m *EventSoldNFT) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSoldNFT) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSoldNFT.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSoldNFT proto.InternalMessageInfo
+
+func (m *EventSoldNFT) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
+}
+
+func (m *EventSoldNFT) GetSeller() string {
+	if m != nil {
+		return m.Seller
+	}
+	return ""
+}
+
+func (m *EventSoldNFT) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
+}
+
+func (m *EventSoldNFT) GetTokenId() string {
+	if m != nil {
+		return m.TokenId
+	}
+	return ""
+}
+
+func (m *EventSoldNFT) GetPrice() github_com_Finschia_finschia_sdk_types.Coins {
+	if m != nil {
+		return m.Price
+	}
+	return nil
+}
+
+func (m *EventSoldNFT) GetRoyaltyRecipient() string {
+	if m != nil {
+		return m.RoyaltyRecipient
+	}
+	return ""
+}
+
+func (m *EventSoldNFT) GetRoyalty() github_com_Finschia_finschia_sdk_types.Coins {
+	if m != nil {
+		return m.Royalty
+	}
+	return nil
+}
+
 // EventAuthorizedOperator is emitted when a holder authorizes an operator to manipulate its tokens.
 //
 // Since: 0.46.0 (finschia)
@@ -155,7 +260,7 @@ func (m *EventAuthorizedOperator) Reset()         { *m = EventAuthorizedOperator
 func (m *EventAuthorizedOperator) String() string { return proto.CompactTextString(m) }
 func (*EventAuthorizedOperator) ProtoMessage()    {}
 func (*EventAuthorizedOperator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{1}
+	return fileDescriptor_478cfab12ea1b00e, []int{2}
 }
 func (m *EventAuthorizedOperator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -221,7 +326,7 @@ func (m *EventRevokedOperator) Reset()         { *m = EventRevokedOperator{} }
 func (m *EventRevokedOperator) String() string { return proto.CompactTextString(m) }
 func (*EventRevokedOperator) ProtoMessage()    {}
 func (*EventRevokedOperator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{2}
+	return fileDescriptor_478cfab12ea1b00e, []int{3}
 }
 func (m *EventRevokedOperator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -291,7 +396,7 @@ func (m *EventCreatedContract) Reset()         { *m = EventCreatedContract{} }
 func (m *EventCreatedContract) String() string { return proto.CompactTextString(m) }
 func (*EventCreatedContract) ProtoMessage()    {}
 func (*EventCreatedContract) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{3}
+	return fileDescriptor_478cfab12ea1b00e, []int{4}
 }
 func (m *EventCreatedContract) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -381,7 +486,7 @@ func (m *EventCreatedFTClass) Reset()         { *m = EventCreatedFTClass{} }
 func (m *EventCreatedFTClass) String() string { return proto.CompactTextString(m) }
 func (*EventCreatedFTClass) ProtoMessage()    {}
 func (*EventCreatedFTClass) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{4}
+	return fileDescriptor_478cfab12ea1b00e, []int{5}
 }
 func (m *EventCreatedFTClass) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -474,13 +579,15 @@ type EventCreatedNFTClass struct {
 	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
 	// metadata of the token class.
 	Meta string `protobuf:"bytes,5,opt,name=meta,proto3" json:"meta,omitempty"`
+	// whether the tokens of the class are non-transferable.
+	NonTransferable bool `protobuf:"varint,6,opt,name=non_transferable,json=nonTransferable,proto3" json:"non_transferable,omitempty"`
 }
 
 func (m *EventCreatedNFTClass) Reset()         { *m = EventCreatedNFTClass{} }
 func (m *EventCreatedNFTClass) String() string { return proto.CompactTextString(m) }
 func (*EventCreatedNFTClass) ProtoMessage()    {}
 func (*EventCreatedNFTClass) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{5}
+	return fileDescriptor_478cfab12ea1b00e, []int{6}
 }
 func (m *EventCreatedNFTClass) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -544,6 +651,13 @@ func (m *EventCreatedNFTClass) GetMeta() string {
 	return ""
 }
 
+func (m *EventCreatedNFTClass) GetNonTransferable() bool {
+	if m != nil {
+		return m.NonTransferable
+	}
+	return false
+}
+
 // EventGranted is emitted when a granter grants its permission to a grantee.
 //
 // Info: `granter` would be empty if the permission is granted by an issuance.
@@ -564,7 +678,7 @@ func (m *EventGranted) Reset()         { *m = EventGranted{} }
 func (m *EventGranted) String() string { return proto.CompactTextString(m) }
 func (*EventGranted) ProtoMessage()    {}
 func (*EventGranted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{6}
+	return fileDescriptor_478cfab12ea1b00e, []int{7}
 }
 func (m *EventGranted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -637,7 +751,7 @@ func (m *EventRenounced) Reset()         { *m = EventRenounced{} }
 func (m *EventRenounced) String() string { return proto.CompactTextString(m) }
 func (*EventRenounced) ProtoMessage()    {}
 func (*EventRenounced) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{7}
+	return fileDescriptor_478cfab12ea1b00e, []int{8}
 }
 func (m *EventRenounced) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -707,7 +821,7 @@ func (m *EventMintedFT) Reset()         { *m = EventMintedFT{} }
 func (m *EventMintedFT) String() string { return proto.CompactTextString(m) }
 func (*EventMintedFT) ProtoMessage()    {}
 func (*EventMintedFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{8}
+	return fileDescriptor_478cfab12ea1b00e, []int{9}
 }
 func (m *EventMintedFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -782,7 +896,7 @@ func (m *EventMintedNFT) Reset()         { *m = EventMintedNFT{} }
 func (m *EventMintedNFT) String() string { return proto.CompactTextString(m) }
 func (*EventMintedNFT) ProtoMessage()    {}
 func (*EventMintedNFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{9}
+	return fileDescriptor_478cfab12ea1b00e, []int{10}
 }
 func (m *EventMintedNFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -857,7 +971,7 @@ func (m *EventBurned) Reset()         { *m = EventBurned{} }
 func (m *EventBurned) String() string { return proto.CompactTextString(m) }
 func (*EventBurned) ProtoMessage()    {}
 func (*EventBurned) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{10}
+	return fileDescriptor_478cfab12ea1b00e, []int{11}
 }
 func (m *EventBurned) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -932,7 +1046,7 @@ func (m *EventModifiedContract) Reset()         { *m = EventModifiedContract{} }
 func (m *EventModifiedContract) String() string { return proto.CompactTextString(m) }
 func (*EventModifiedContract) ProtoMessage()    {}
 func (*EventModifiedContract) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{11}
+	return fileDescriptor_478cfab12ea1b00e, []int{12}
 }
 func (m *EventModifiedContract) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1004,7 +1118,7 @@ func (m *EventModifiedTokenClass) Reset()         { *m = EventModifiedTokenClass
 func (m *EventModifiedTokenClass) String() string { return proto.CompactTextString(m) }
 func (*EventModifiedTokenClass) ProtoMessage()    {}
 func (*EventModifiedTokenClass) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{12}
+	return fileDescriptor_478cfab12ea1b00e, []int{13}
 }
 func (m *EventModifiedTokenClass) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1087,7 +1201,7 @@ func (m *EventModifiedNFT) Reset()         { *m = EventModifiedNFT{} }
 func (m *EventModifiedNFT) String() string { return proto.CompactTextString(m) }
 func (*EventModifiedNFT) ProtoMessage()    {}
 func (*EventModifiedNFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{13}
+	return fileDescriptor_478cfab12ea1b00e, []int{14}
 }
 func (m *EventModifiedNFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1166,7 +1280,7 @@ func (m *EventAttached) Reset()         { *m = EventAttached{} }
 func (m *EventAttached) String() string { return proto.CompactTextString(m) }
 func (*EventAttached) ProtoMessage()    {}
 func (*EventAttached) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{14}
+	return fileDescriptor_478cfab12ea1b00e, []int{15}
 }
 func (m *EventAttached) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1252,7 +1366,7 @@ func (m *EventDetached) Reset()         { *m = EventDetached{} }
 func (m *EventDetached) String() string { return proto.CompactTextString(m) }
 func (*EventDetached) ProtoMessage()    {}
 func (*EventDetached) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{15}
+	return fileDescriptor_478cfab12ea1b00e, []int{16}
 }
 func (m *EventDetached) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1316,6 +1430,70 @@ func (m *EventDetached) GetPreviousParent() string {
 	return ""
 }
 
+// EventSetRoyalty is emitted when the royalty of a contract or a class is set.
+type EventSetRoyalty struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// address which triggered the set.
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	// the royalty set.
+	Royalty Royalty `protobuf:"bytes,3,opt,name=royalty,proto3" json:"royalty"`
+}
+
+func (m *EventSetRoyalty) Reset()         { *m = EventSetRoyalty{} }
+func (m *EventSetRoyalty) String() string { return proto.CompactTextString(m) }
+func (*EventSetRoyalty) ProtoMessage()    {}
+func (*EventSetRoyalty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_478cfab12ea1b00e, []int{17}
+}
+func (m *EventSetRoyalty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSetRoyalty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSetRoyalty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSetRoyalty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSetRoyalty.Merge(m, src)
+}
+func (m *EventSetRoyalty) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSetRoyalty) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSetRoyalty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSetRoyalty proto.InternalMessageInfo
+
+func (m *EventSetRoyalty) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
+}
+
+func (m *EventSetRoyalty) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *EventSetRoyalty) GetRoyalty() Royalty {
+	if m != nil {
+		return m.Royalty
+	}
+	return Royalty{}
+}
+
 // EventOwnerChanged is emitted when the owner of token is changed by operation applied to its ancestor.
 //
 // Since: 0.46.0 (finschia)
@@ -1336,7 +1514,7 @@ func (m *EventOwnerChanged) Reset()         { *m = EventOwnerChanged{} }
 func (m *EventOwnerChanged) String() string { return proto.CompactTextString(m) }
 func (*EventOwnerChanged) ProtoMessage()    {}
 func (*EventOwnerChanged) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{16}
+	return fileDescriptor_478cfab12ea1b00e, []int{18}
 }
 func (m *EventOwnerChanged) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1413,7 +1591,7 @@ func (m *EventRootChanged) Reset()         { *m = EventRootChanged{} }
 func (m *EventRootChanged) String() string { return proto.CompactTextString(m) }
 func (*EventRootChanged) ProtoMessage()    {}
 func (*EventRootChanged) Descriptor() ([]byte, []int) {
-	return fileDescriptor_478cfab12ea1b00e, []int{17}
+	return fileDescriptor_478cfab12ea1b00e, []int{19}
 }
 func (m *EventRootChanged) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1473,6 +1651,7 @@ func (m *EventRootChanged) GetTo() string {
 func init() {
 	proto.RegisterEnum("lbm.collection.v1.AttributeKey", AttributeKey_name, AttributeKey_value)
 	proto.RegisterType((*EventSent)(nil), "lbm.collection.v1.EventSent")
+	proto.RegisterType((*EventSoldNFT)(nil), "lbm.collection.v1.EventSoldNFT")
 	proto.RegisterType((*EventAuthorizedOperator)(nil), "lbm.collection.v1.EventAuthorizedOperator")
 	proto.RegisterType((*EventRevokedOperator)(nil), "lbm.collection.v1.EventRevokedOperator")
 	proto.RegisterType((*EventCreatedContract)(nil), "lbm.collection.v1.EventCreatedContract")
@@ -1488,6 +1667,7 @@ func init() {
 	proto.RegisterType((*EventModifiedNFT)(nil), "lbm.collection.v1.EventModifiedNFT")
 	proto.RegisterType((*EventAttached)(nil), "lbm.collection.v1.EventAttached")
 	proto.RegisterType((*EventDetached)(nil), "lbm.collection.v1.EventDetached")
+	proto.RegisterType((*EventSetRoyalty)(nil), "lbm.collection.v1.EventSetRoyalty")
 	proto.RegisterType((*EventOwnerChanged)(nil), "lbm.collection.v1.EventOwnerChanged")
 	proto.RegisterType((*EventRootChanged)(nil), "lbm.collection.v1.EventRootChanged")
 }
@@ -1495,70 +1675,83 @@ func init() {
 func init() { proto.RegisterFile("lbm/collection/v1/event.proto", fileDescriptor_478cfab12ea1b00e) }
 
 var fileDescriptor_478cfab12ea1b00e = []byte{
-	// 998 bytes of a gzipped FileDescriptorProto
+	// 1209 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x97, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xc0, 0x3d, 0xb6, 0xe3, 0x3f, 0x2f, 0x25, 0xdd, 0x6c, 0x42, 0xb2, 0xdd, 0x12, 0xd7, 0xda,
-	0x0b, 0x51, 0x45, 0x6d, 0xb5, 0xc0, 0x25, 0x82, 0x83, 0xed, 0x3a, 0xd1, 0xaa, 0xb2, 0x1b, 0x6d,
-	0xec, 0x03, 0x5c, 0xac, 0xf5, 0x7a, 0x62, 0x2f, 0xf1, 0xce, 0x58, 0xbb, 0x63, 0x97, 0xf0, 0x09,
-	0x90, 0xb9, 0x20, 0x10, 0x42, 0x42, 0xca, 0x85, 0xf6, 0x50, 0x89, 0x2f, 0xd2, 0x63, 0xb8, 0x71,
-	0x42, 0x28, 0xf9, 0x22, 0x68, 0x67, 0x77, 0x9c, 0x75, 0x6c, 0x91, 0x18, 0xb7, 0x70, 0x9b, 0xf7,
-	0xe6, 0xbd, 0x99, 0xdf, 0x7b, 0xf3, 0xe6, 0xcd, 0x2e, 0xec, 0xf4, 0xdb, 0x4e, 0xd1, 0xa2, 0xfd,
-	0x3e, 0xb6, 0x98, 0x4d, 0x49, 0x71, 0xf4, 0xb8, 0x88, 0x47, 0x98, 0xb0, 0xc2, 0xc0, 0xa5, 0x8c,
-	0xca, 0xeb, 0xfd, 0xb6, 0x53, 0xb8, 0x9a, 0x2e, 0x8c, 0x1e, 0xab, 0x9b, 0x5d, 0xda, 0xa5, 0x7c,
-	0xb6, 0xe8, 0x8f, 0x02, 0x43, 0x55, 0x9b, 0x5d, 0x27, 0xe2, 0xc6, 0x6d, 0xb4, 0x97, 0x08, 0xb2,
-	0x55, 0x7f, 0xf1, 0x23, 0x4c, 0x98, 0xfc, 0x00, 0x56, 0x2d, 0x4a, 0x98, 0x6b, 0x5a, 0xac, 0x65,
-	0x77, 0x14, 0x94, 0x47, 0xbb, 0x59, 0x03, 0x84, 0x4a, 0xef, 0xc8, 0x2a, 0x64, 0xe8, 0x00, 0xbb,
-	0x26, 0xa3, 0xae, 0x12, 0xe7, 0xb3, 0x13, 0x59, 0x96, 0x21, 0x79, 0xec, 0x52, 0x47, 0x49, 0x70,
-	0x3d, 0x1f, 0xcb, 0x6b, 0x10, 0x67, 0x54, 0x49, 0x72, 0x4d, 0x9c, 0x51, 0xf9, 0x53, 0x48, 0x99,
-	0x0e, 0x1d, 0x12, 0xa6, 0xac, 0xe4, 0x13, 0xbb, 0xab, 0x4f, 0xb6, 0x0b, 0x33, 0xc1, 0x14, 0x2a,
-	0xd4, 0x26, 0xe5, 0xe4, 0x9b, 0x3f, 0x1f, 0xc4, 0x8c, 0xd0, 0x58, 0x23, 0xb0, 0xcd, 0x21, 0x4b,
-	0x43, 0xd6, 0xa3, 0xae, 0xfd, 0x0d, 0xee, 0x3c, 0x17, 0xbb, 0xde, 0x88, 0xbc, 0x05, 0xa9, 0x1e,
-	0xed, 0x77, 0xb0, 0x00, 0x0e, 0xa5, 0xa9, 0x50, 0x12, 0xd3, 0xa1, 0x68, 0x27, 0xb0, 0xc9, 0xf7,
-	0x33, 0xf0, 0x88, 0x9e, 0xbc, 0xeb, 0xcd, 0xbe, 0x43, 0xe1, 0x6e, 0x15, 0x17, 0x9b, 0x0c, 0x77,
-	0x2a, 0xe1, 0x72, 0xb2, 0x02, 0x69, 0xcb, 0x57, 0x51, 0x37, 0xdc, 0x49, 0x88, 0xd7, 0x39, 0xe2,
-	0x33, 0x1c, 0x32, 0x24, 0x89, 0xe9, 0x60, 0x71, 0x16, 0xfe, 0xd8, 0xd7, 0x39, 0x98, 0x99, 0xe1,
-	0x69, 0xf0, 0xb1, 0x2c, 0x41, 0x62, 0xe8, 0xda, 0xca, 0x0a, 0x57, 0xf9, 0x43, 0xed, 0x77, 0x04,
-	0x1b, 0x51, 0x9a, 0xfd, 0x46, 0xa5, 0x6f, 0x7a, 0xde, 0x72, 0xa5, 0x71, 0x0f, 0x32, 0x8c, 0x9e,
-	0x60, 0xe2, 0x7b, 0x06, 0x48, 0x69, 0x2e, 0x47, 0x48, 0x93, 0x73, 0x48, 0x57, 0x22, 0xa4, 0x2a,
-	0x64, 0x3a, 0xd8, 0xb2, 0x1d, 0xb3, 0xef, 0x29, 0xa9, 0x3c, 0xda, 0x5d, 0x31, 0x26, 0xb2, 0x3f,
-	0xe7, 0xd8, 0x84, 0x99, 0xed, 0x3e, 0x56, 0xd2, 0x79, 0xb4, 0x9b, 0x31, 0x26, 0xf2, 0x5e, 0x5c,
-	0x41, 0xda, 0x2f, 0xd7, 0x32, 0x5c, 0x7f, 0x2b, 0x41, 0xed, 0x00, 0x04, 0x41, 0xb1, 0xd3, 0x81,
-	0xc8, 0x74, 0x96, 0x6b, 0x1a, 0xa7, 0x03, 0x7c, 0xdb, 0xc0, 0xb4, 0x5f, 0x11, 0xdc, 0xe1, 0x70,
-	0x07, 0xae, 0x49, 0x18, 0xee, 0xdc, 0x0c, 0xa5, 0x40, 0xba, 0xcb, 0x6d, 0x05, 0x93, 0x10, 0xaf,
-	0x66, 0x04, 0x8f, 0x10, 0xe5, 0xcf, 0x01, 0x06, 0xd8, 0x75, 0x6c, 0xcf, 0xb3, 0x29, 0xe1, 0x4c,
-	0x6b, 0x4f, 0x76, 0xe6, 0x5c, 0xbe, 0xc3, 0x89, 0x91, 0x11, 0x71, 0xd0, 0xc6, 0x08, 0xd6, 0xc2,
-	0x1b, 0x41, 0xe8, 0x90, 0x58, 0x0b, 0x61, 0xe2, 0x69, 0xcc, 0xeb, 0x30, 0x89, 0x45, 0x61, 0x7e,
-	0x46, 0xf0, 0x1e, 0x87, 0xa9, 0xd9, 0x84, 0x57, 0xe8, 0x72, 0xe7, 0x18, 0xf4, 0xa8, 0xc4, 0x9c,
-	0x1e, 0x95, 0x5c, 0xa0, 0x47, 0xf1, 0x42, 0xfb, 0x51, 0xa4, 0x29, 0x20, 0xab, 0xbf, 0x6d, 0xb4,
-	0x4f, 0x20, 0xc5, 0x0b, 0xcc, 0x0b, 0xd1, 0xb6, 0xe6, 0xa0, 0xd5, 0xf7, 0x1b, 0x82, 0x2c, 0xb0,
-	0xd5, 0x7e, 0x42, 0xb0, 0xca, 0xa9, 0xca, 0x43, 0x97, 0xdc, 0xe6, 0xe4, 0x16, 0xed, 0xf2, 0xff,
-	0x2e, 0x63, 0xda, 0x0f, 0x08, 0xde, 0x0f, 0xb2, 0x45, 0x3b, 0xf6, 0xb1, 0x1d, 0xe9, 0x7c, 0x4b,
-	0x11, 0x7e, 0x06, 0x69, 0xab, 0x67, 0x92, 0x2e, 0xf6, 0x94, 0x04, 0xc7, 0xf9, 0x60, 0x0e, 0x4e,
-	0x89, 0x31, 0xd7, 0x6e, 0x0f, 0x19, 0x0e, 0x99, 0x84, 0x8b, 0x76, 0x8e, 0xc2, 0xb7, 0x46, 0x40,
-	0x35, 0xfc, 0x24, 0xbe, 0xfb, 0x76, 0x11, 0xa1, 0x4e, 0x2e, 0x4c, 0x2d, 0xdf, 0x87, 0xac, 0xbf,
-	0x6c, 0x8b, 0x77, 0x9c, 0xa0, 0xbb, 0x64, 0x7c, 0x45, 0xdd, 0x74, 0xb0, 0xf6, 0x1a, 0x81, 0x34,
-	0x15, 0xd2, 0xd2, 0x75, 0xf9, 0x0f, 0xfd, 0x7c, 0xa9, 0x38, 0xfc, 0x4e, 0x1d, 0x5c, 0xed, 0x12,
-	0x63, 0xa6, 0xd5, 0x5b, 0xb6, 0x58, 0xaf, 0x9e, 0xe3, 0xc4, 0xd4, 0x73, 0xac, 0x40, 0xda, 0x1b,
-	0xb6, 0xbf, 0xc2, 0x16, 0x0b, 0xdb, 0xb3, 0x10, 0x7d, 0x0f, 0x66, 0xba, 0x5d, 0xcc, 0xc2, 0x2c,
-	0x86, 0x12, 0xbf, 0xdd, 0xbf, 0x09, 0xb8, 0xa7, 0xf8, 0xff, 0x81, 0xfb, 0x10, 0xee, 0x0e, 0x5c,
-	0x3c, 0xb2, 0xe9, 0xd0, 0x6b, 0x0d, 0x4c, 0x17, 0x13, 0x41, 0xb9, 0x26, 0xd4, 0x87, 0x5c, 0xcb,
-	0x69, 0x5f, 0xc0, 0x3a, 0x87, 0x7d, 0xfe, 0x82, 0x60, 0xb7, 0xc2, 0xf3, 0x7b, 0x0b, 0xe0, 0xe8,
-	0xc9, 0xc6, 0x67, 0x5e, 0xea, 0x9b, 0xbe, 0xef, 0xf8, 0xc6, 0xa3, 0xb0, 0xda, 0x0c, 0x4a, 0xd9,
-	0x7f, 0xb8, 0xef, 0xc3, 0x57, 0x71, 0xb8, 0x33, 0x29, 0xac, 0x67, 0xf8, 0x54, 0xde, 0x83, 0x7b,
-	0xa5, 0x46, 0xc3, 0xd0, 0xcb, 0xcd, 0x46, 0xb5, 0xf5, 0xac, 0xfa, 0x45, 0xab, 0x59, 0x3f, 0x3a,
-	0xac, 0x56, 0xf4, 0x7d, 0xbd, 0xfa, 0x54, 0x8a, 0xa9, 0xf7, 0xc7, 0x67, 0xf9, 0xed, 0xa8, 0x43,
-	0x93, 0x78, 0x03, 0x6c, 0xf1, 0x1b, 0x22, 0x7f, 0x04, 0xf2, 0xb4, 0x6f, 0xbd, 0x54, 0xab, 0x4a,
-	0x48, 0xdd, 0x1c, 0x9f, 0xe5, 0xa5, 0xa8, 0x93, 0x7f, 0xc3, 0x66, 0xad, 0x6b, 0xd5, 0x46, 0x49,
-	0x8a, 0xcf, 0x5a, 0xd7, 0xfc, 0x4f, 0x99, 0x3d, 0x50, 0xa7, 0xad, 0xcb, 0xa5, 0xa3, 0x6a, 0x4b,
-	0xaf, 0x1d, 0xb4, 0x9a, 0x86, 0x2e, 0x65, 0x54, 0x75, 0x7c, 0x96, 0xdf, 0x8a, 0x7a, 0x95, 0x4d,
-	0x0f, 0xeb, 0x4e, 0xb7, 0x69, 0xe8, 0xf2, 0x43, 0x58, 0xbf, 0x16, 0x93, 0xa1, 0x4b, 0x9b, 0xea,
-	0xc6, 0xf8, 0x2c, 0x7f, 0x77, 0x2a, 0x16, 0x43, 0x57, 0x33, 0xdf, 0xbe, 0xcc, 0xc5, 0x5e, 0xbf,
-	0xca, 0xc5, 0xb4, 0x64, 0x26, 0x21, 0xa5, 0xb5, 0x64, 0x26, 0x2b, 0x6d, 0x94, 0x0f, 0xde, 0x5c,
-	0xe4, 0xd0, 0xf9, 0x45, 0x0e, 0xfd, 0x75, 0x91, 0x43, 0xdf, 0x5f, 0xe6, 0x62, 0xe7, 0x97, 0xb9,
-	0xd8, 0x1f, 0x97, 0xb9, 0xd8, 0x97, 0x8f, 0xba, 0x36, 0xeb, 0x0d, 0xdb, 0x05, 0x8b, 0x3a, 0xc5,
-	0x7d, 0x9b, 0x78, 0x56, 0xcf, 0x36, 0x8b, 0xc7, 0xe1, 0xe0, 0x91, 0xd7, 0x39, 0x29, 0x7e, 0x1d,
-	0xf9, 0x81, 0x68, 0xa7, 0xf8, 0x1f, 0xc4, 0xc7, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0xab, 0x08,
-	0x5b, 0x3e, 0xaf, 0x0c, 0x00, 0x00,
+	0x14, 0xc0, 0xbd, 0xfe, 0x9f, 0x97, 0x92, 0x6c, 0x36, 0x69, 0xb2, 0xdd, 0x52, 0xd7, 0xda, 0x0b,
+	0xa5, 0xb4, 0xb6, 0xd2, 0x96, 0x4b, 0x04, 0x07, 0xdb, 0x75, 0x5a, 0xab, 0x8a, 0x1b, 0x6d, 0x9c,
+	0x03, 0x5c, 0xac, 0xf5, 0x7a, 0x62, 0x2f, 0xd9, 0x9d, 0xb1, 0x76, 0xc7, 0x2e, 0xe6, 0x13, 0x20,
+	0x73, 0x41, 0x20, 0x84, 0x84, 0x94, 0x0b, 0xe5, 0x50, 0xc1, 0xe7, 0x40, 0xea, 0x31, 0xdc, 0xe0,
+	0x02, 0x28, 0xf9, 0x10, 0x5c, 0xd1, 0xce, 0xce, 0x24, 0xeb, 0xd8, 0x22, 0x09, 0x6e, 0xe0, 0x36,
+	0xef, 0xcd, 0x7b, 0x33, 0xbf, 0xf7, 0xe6, 0xcd, 0x3f, 0xb8, 0xe5, 0xb4, 0xdc, 0xa2, 0x45, 0x1c,
+	0x07, 0x59, 0xd4, 0x26, 0xb8, 0x38, 0x58, 0x2f, 0xa2, 0x01, 0xc2, 0xb4, 0xd0, 0xf3, 0x08, 0x25,
+	0xca, 0x92, 0xd3, 0x72, 0x0b, 0xa7, 0xdd, 0x85, 0xc1, 0xba, 0xb6, 0xd2, 0x21, 0x1d, 0xc2, 0x7a,
+	0x8b, 0x41, 0x2b, 0x34, 0xd4, 0x72, 0x16, 0xf1, 0x5d, 0xe2, 0x17, 0x5b, 0xa6, 0x8f, 0x8a, 0x83,
+	0xf5, 0x16, 0xa2, 0xe6, 0x7a, 0xd1, 0x22, 0x36, 0xe6, 0xfd, 0xfa, 0xe4, 0x3c, 0x91, 0x61, 0x99,
+	0x8d, 0xfe, 0x52, 0x82, 0xb9, 0x6a, 0x30, 0xf9, 0x0e, 0xc2, 0x54, 0xb9, 0x0d, 0xf3, 0x16, 0xc1,
+	0xd4, 0x33, 0x2d, 0xda, 0xb4, 0xdb, 0xaa, 0x94, 0x97, 0xee, 0xcc, 0x19, 0x20, 0x54, 0xb5, 0xb6,
+	0xa2, 0x41, 0x96, 0xf4, 0x90, 0x67, 0x52, 0xe2, 0xa9, 0x71, 0xd6, 0x7b, 0x22, 0x2b, 0x0a, 0x24,
+	0xf7, 0x3c, 0xe2, 0xaa, 0x09, 0xa6, 0x67, 0x6d, 0x65, 0x01, 0xe2, 0x94, 0xa8, 0x49, 0xa6, 0x89,
+	0x53, 0xa2, 0xbc, 0x0f, 0x69, 0xd3, 0x25, 0x7d, 0x4c, 0xd5, 0x54, 0x3e, 0x71, 0x67, 0xfe, 0xc1,
+	0x5a, 0x61, 0x22, 0xd8, 0x42, 0x85, 0xd8, 0xb8, 0x9c, 0x7c, 0xfd, 0xfb, 0xed, 0x98, 0xc1, 0x8d,
+	0xf5, 0xbf, 0xe2, 0x70, 0x2d, 0xa4, 0x24, 0x4e, 0xbb, 0xbe, 0xd9, 0x38, 0x1f, 0x74, 0x15, 0xd2,
+	0x3e, 0x72, 0x1c, 0x24, 0x30, 0xb9, 0xa4, 0xac, 0x40, 0xaa, 0xd5, 0x1f, 0x22, 0x8f, 0x53, 0x86,
+	0x82, 0x72, 0x03, 0xb2, 0x94, 0xec, 0x23, 0x1c, 0x8c, 0x15, 0xc2, 0x66, 0x98, 0x5c, 0x6b, 0x2b,
+	0x08, 0x52, 0x3d, 0xcf, 0xb6, 0x10, 0x07, 0xbe, 0x51, 0x08, 0x93, 0x5e, 0x08, 0x92, 0x5e, 0xe0,
+	0x49, 0x0f, 0x91, 0x1f, 0x05, 0xc8, 0x3f, 0xfe, 0x71, 0xfb, 0x5e, 0xc7, 0xa6, 0xdd, 0x7e, 0xab,
+	0x60, 0x11, 0xb7, 0xb8, 0x69, 0x63, 0xdf, 0xea, 0xda, 0x66, 0x71, 0x8f, 0x37, 0xee, 0xfb, 0xed,
+	0xfd, 0x22, 0x1d, 0xf6, 0x90, 0xcf, 0x9c, 0x7c, 0x23, 0x1c, 0x5d, 0x79, 0x0f, 0x96, 0x3c, 0x32,
+	0x34, 0x1d, 0x3a, 0x6c, 0x7a, 0xc8, 0xb2, 0x7b, 0x36, 0xc2, 0x54, 0x4d, 0x33, 0x14, 0x99, 0x77,
+	0x18, 0x42, 0xaf, 0xd8, 0x90, 0xe1, 0x3a, 0x35, 0x73, 0x35, 0x54, 0x62, 0x7c, 0x1d, 0xc3, 0x1a,
+	0x4b, 0x7c, 0xa9, 0x4f, 0xbb, 0xc4, 0xb3, 0x3f, 0x43, 0xed, 0xe7, 0x62, 0xbd, 0x2f, 0xb2, 0x06,
+	0x5d, 0xe2, 0xb4, 0x4f, 0xd7, 0x20, 0x94, 0xc6, 0x8a, 0x28, 0x31, 0x5e, 0x44, 0xfa, 0x3e, 0xac,
+	0xb0, 0xf9, 0x0c, 0x34, 0x20, 0xfb, 0x57, 0x3d, 0xd9, 0x17, 0x12, 0x9f, 0xad, 0xe2, 0x21, 0x93,
+	0xa2, 0x76, 0x85, 0x0f, 0xa7, 0xa8, 0x90, 0xb1, 0x02, 0x15, 0xf1, 0xf8, 0x4c, 0x42, 0x3c, 0xcb,
+	0x11, 0x9f, 0xe0, 0x50, 0x20, 0x89, 0x4d, 0x17, 0x89, 0x5d, 0x10, 0xb4, 0x03, 0x9d, 0x8b, 0xa8,
+	0xc9, 0x4b, 0x8b, 0xb5, 0x15, 0x19, 0x12, 0x7d, 0xcf, 0x56, 0x53, 0x4c, 0x15, 0x34, 0xf5, 0x5f,
+	0x24, 0x58, 0x8e, 0xd2, 0x6c, 0x36, 0x2a, 0x8e, 0xe9, 0xfb, 0xb3, 0x6d, 0xca, 0x68, 0x65, 0x27,
+	0xc6, 0x2b, 0x5b, 0x90, 0x26, 0xa7, 0x90, 0xa6, 0x22, 0xa4, 0x1a, 0x64, 0xdb, 0xc8, 0xb2, 0x5d,
+	0xd3, 0xf1, 0x59, 0x45, 0xa6, 0x8c, 0x13, 0x39, 0xe8, 0x73, 0x6d, 0x4c, 0xcd, 0x96, 0x83, 0xd4,
+	0x4c, 0x5e, 0xba, 0x93, 0x35, 0x4e, 0xe4, 0x8d, 0xb8, 0x2a, 0xe9, 0x3f, 0x9f, 0xc9, 0x70, 0xfd,
+	0x8d, 0x04, 0x75, 0x0b, 0x20, 0x0c, 0x2a, 0x28, 0x59, 0x1e, 0xd6, 0x1c, 0xd3, 0x34, 0x86, 0x3d,
+	0x74, 0xe1, 0xc0, 0xde, 0x05, 0x19, 0x13, 0xdc, 0xa4, 0x9e, 0x89, 0xfd, 0x3d, 0xe4, 0xb1, 0x20,
+	0xd2, 0x2c, 0x88, 0x45, 0x4c, 0x70, 0x23, 0xa2, 0xd6, 0xbf, 0x97, 0xf8, 0x01, 0xf4, 0xc4, 0x33,
+	0x31, 0x45, 0xed, 0xf3, 0xf9, 0x55, 0xc8, 0x74, 0x98, 0xad, 0xc0, 0x17, 0xe2, 0x69, 0x8f, 0x40,
+	0x17, 0xa2, 0xf2, 0x21, 0x40, 0x0f, 0x79, 0xae, 0xed, 0xfb, 0x36, 0xc1, 0x0c, 0x7f, 0xe1, 0xc1,
+	0xad, 0x29, 0x27, 0xe4, 0xf6, 0x89, 0x91, 0x11, 0x71, 0xd0, 0x47, 0x12, 0x2c, 0xf0, 0xcd, 0x83,
+	0x49, 0x1f, 0x5b, 0x97, 0xc2, 0x44, 0xe3, 0x98, 0x67, 0x61, 0x12, 0x97, 0x85, 0xf9, 0x56, 0x82,
+	0xb7, 0x18, 0xcc, 0x96, 0x8d, 0x59, 0x31, 0xcf, 0xb6, 0xe4, 0xe1, 0x45, 0x92, 0x98, 0x72, 0x91,
+	0x24, 0x2f, 0x71, 0x91, 0xb0, 0x9a, 0xfc, 0x5a, 0xa4, 0x29, 0x24, 0xab, 0xbf, 0x69, 0xb4, 0x47,
+	0x90, 0x66, 0xb5, 0xe8, 0x73, 0xb4, 0xd5, 0x29, 0x68, 0xf5, 0xcd, 0x86, 0x20, 0x0b, 0x6d, 0xf5,
+	0x6f, 0x24, 0x98, 0x67, 0x54, 0xe5, 0xbe, 0x87, 0x2f, 0xb2, 0x72, 0x97, 0xbd, 0x8a, 0xff, 0x5d,
+	0xc6, 0xf4, 0xaf, 0x24, 0xb8, 0x1e, 0x66, 0x8b, 0xb4, 0xed, 0x3d, 0x3b, 0x72, 0x48, 0xce, 0x44,
+	0xf8, 0x01, 0x64, 0xac, 0xae, 0x89, 0x3b, 0xc8, 0x57, 0x13, 0x0c, 0xe7, 0xed, 0x29, 0x38, 0x25,
+	0x4a, 0x3d, 0xbb, 0xd5, 0xa7, 0x88, 0x33, 0x09, 0x17, 0xfd, 0x50, 0xe2, 0xd7, 0x92, 0x80, 0x6a,
+	0x04, 0x49, 0xbc, 0xfa, 0x93, 0x25, 0x42, 0x9d, 0xbc, 0x34, 0xb5, 0x72, 0x13, 0xe6, 0x82, 0x61,
+	0x9b, 0xec, 0x70, 0x0a, 0x0f, 0xa2, 0x6c, 0xa0, 0xa8, 0x9b, 0x2e, 0xd2, 0x5f, 0x49, 0x20, 0x8f,
+	0x85, 0x34, 0x73, 0x5d, 0xfe, 0xc3, 0xd1, 0x3f, 0x53, 0x1c, 0xfa, 0x77, 0x62, 0x6b, 0x97, 0x28,
+	0x35, 0xad, 0xee, 0xac, 0xc5, 0x7a, 0x7a, 0x73, 0x27, 0xc6, 0x6e, 0x6e, 0x15, 0x32, 0x7e, 0xbf,
+	0xf5, 0x09, 0xb2, 0xa8, 0x78, 0x93, 0x71, 0x31, 0xf0, 0xa0, 0xa6, 0xd7, 0x41, 0x94, 0x67, 0x91,
+	0x4b, 0x6c, 0x77, 0xff, 0x24, 0xe0, 0x1e, 0xa3, 0xff, 0x07, 0xee, 0x1d, 0x58, 0xec, 0x79, 0x68,
+	0x60, 0x93, 0xbe, 0xdf, 0xec, 0x99, 0x1e, 0xc2, 0x82, 0x72, 0x41, 0xa8, 0xb7, 0x99, 0x96, 0xd1,
+	0x8e, 0x24, 0x58, 0xe4, 0xcf, 0x6f, 0x6a, 0x84, 0x4f, 0xae, 0xd9, 0x78, 0x37, 0x4e, 0x9f, 0x86,
+	0x01, 0xf0, 0xfc, 0x03, 0x6d, 0xca, 0xca, 0xf2, 0x99, 0xc4, 0xba, 0x8a, 0xb7, 0xde, 0x0b, 0x58,
+	0x62, 0x2c, 0xcf, 0x5f, 0x60, 0xe4, 0x55, 0xd8, 0x62, 0x5f, 0x20, 0x7b, 0xd1, 0x32, 0x8b, 0x4f,
+	0xbc, 0x30, 0xce, 0xfb, 0x11, 0xb0, 0x2c, 0x0c, 0x78, 0xe9, 0x1b, 0x84, 0xd0, 0xff, 0x70, 0xde,
+	0xbb, 0xbf, 0xc5, 0xe1, 0xda, 0x49, 0x95, 0x3f, 0x43, 0x43, 0x65, 0x03, 0x6e, 0x94, 0x1a, 0x0d,
+	0xa3, 0x56, 0xde, 0x6d, 0x54, 0x9b, 0xcf, 0xaa, 0x1f, 0x35, 0x77, 0xeb, 0x3b, 0xdb, 0xd5, 0x4a,
+	0x6d, 0xb3, 0x56, 0x7d, 0x2c, 0xc7, 0xb4, 0x9b, 0xa3, 0x83, 0xfc, 0x5a, 0xd4, 0x61, 0x17, 0xfb,
+	0x3d, 0x64, 0xb1, 0xed, 0xaa, 0xdc, 0x03, 0x65, 0xdc, 0xb7, 0x5e, 0xda, 0xaa, 0xca, 0x92, 0xb6,
+	0x32, 0x3a, 0xc8, 0xcb, 0x51, 0xa7, 0x60, 0xbb, 0x4f, 0x5a, 0x6f, 0x55, 0x1b, 0x25, 0x39, 0x3e,
+	0x69, 0xbd, 0x15, 0xbc, 0x54, 0x36, 0x40, 0x1b, 0xb7, 0x2e, 0x97, 0x76, 0xaa, 0xcd, 0xda, 0xd6,
+	0x93, 0xe6, 0xae, 0x51, 0x93, 0xb3, 0x9a, 0x36, 0x3a, 0xc8, 0xaf, 0x46, 0xbd, 0xca, 0xa6, 0x8f,
+	0x6a, 0x6e, 0x67, 0xd7, 0xa8, 0x29, 0x77, 0x61, 0xe9, 0x4c, 0x4c, 0x46, 0x4d, 0x5e, 0xd1, 0x96,
+	0x47, 0x07, 0xf9, 0xc5, 0xb1, 0x58, 0x8c, 0x9a, 0xf2, 0x10, 0x56, 0x27, 0x6c, 0x9b, 0x4f, 0x4b,
+	0x3b, 0x4f, 0xe5, 0xeb, 0xda, 0xda, 0xe8, 0x20, 0xbf, 0x7c, 0xc6, 0xe1, 0xa9, 0xe9, 0x77, 0xb5,
+	0xec, 0xe7, 0x2f, 0x73, 0xb1, 0x57, 0x3f, 0xe4, 0x62, 0x7a, 0x32, 0x9b, 0x90, 0x33, 0x7a, 0x32,
+	0x3b, 0x27, 0x2f, 0x97, 0x9f, 0xbc, 0x3e, 0xca, 0x49, 0x87, 0x47, 0x39, 0xe9, 0xcf, 0xa3, 0x9c,
+	0xf4, 0xe5, 0x71, 0x2e, 0x76, 0x78, 0x9c, 0x8b, 0xfd, 0x7a, 0x9c, 0x8b, 0x7d, 0x7c, 0xff, 0xdc,
+	0x9f, 0xc8, 0xa7, 0x91, 0x7f, 0x6a, 0x2b, 0xcd, 0x3e, 0xaa, 0x0f, 0xff, 0x0e, 0x00, 0x00, 0xff,
+	0xff, 0xc3, 0x0c, 0x42, 0x64, 0x36, 0x0f, 0x00, 0x00,
 }
 
 func (m *EventSent) Marshal() (dAtA []byte, err error) {
@@ -1626,6 +1819,92 @@ func (m *EventSent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventSoldNFT) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSoldNFT) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSoldNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Royalty) > 0 {
+		for iNdEx := len(m.Royalty) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Royalty[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.RoyaltyRecipient) > 0 {
+		i -= len(m.RoyaltyRecipient)
+		copy(dAtA[i:], m.RoyaltyRecipient)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.RoyaltyRecipient)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Price) > 0 {
+		for iNdEx := len(m.Price) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Price[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventAuthorizedOperator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1865,6 +2144,16 @@ func (m *EventCreatedNFTClass) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NonTransferable {
+		i--
+		if m.NonTransferable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.Meta) > 0 {
 		i -= len(m.Meta)
 		copy(dAtA[i:], m.Meta)
@@ -2458,6 +2747,53 @@ func (m *EventDetached) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventSetRoyalty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetRoyalty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetRoyalty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Royalty.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventOwnerChanged) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2602,6 +2938,47 @@ func (m *EventSent) Size() (n int) {
 	return n
 }
 
+func (m *EventSoldNFT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.Price) > 0 {
+		for _, e := range m.Price {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	l = len(m.RoyaltyRecipient)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.Royalty) > 0 {
+		for _, e := range m.Royalty {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *EventAuthorizedOperator) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2734,6 +3111,9 @@ func (m *EventCreatedNFTClass) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvent(uint64(l))
 	}
+	if m.NonTransferable {
+		n += 2
+	}
 	return n
 }
 
@@ -3001,6 +3381,25 @@ func (m *EventDetached) Size() (n int) {
 	return n
 }
 
+func (m *EventSetRoyalty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Royalty.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
 func (m *EventOwnerChanged) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3048,16 +3447,228 @@ func (m *EventRootChanged) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvent(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventSent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovEvent(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvent(x uint64) (n int) {
-	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *EventSent) Unmarshal(dAtA []byte) error {
+func (m *EventSoldNFT) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3080,10 +3691,10 @@ func (m *EventSent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSent: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSoldNFT: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSoldNFT: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3120,7 +3731,7 @@ func (m *EventSent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3148,11 +3759,11 @@ func (m *EventSent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.Seller = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3180,11 +3791,11 @@ func (m *EventSent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			m.Buyer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3212,11 +3823,11 @@ func (m *EventSent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
+			m.TokenId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3243,8 +3854,74 @@ func (m *EventSent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Price = append(m.Price, types.Coin{})
+			if err := m.Price[len(m.Price)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoyaltyRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Royalty = append(m.Royalty, types.Coin{})
+			if err := m.Royalty[len(m.Royalty)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4209,6 +4886,26 @@ func (m *EventCreatedNFTClass) Unmarshal(dAtA []byte) error {
 			}
 			m.Meta = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonTransferable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NonTransferable = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -6028,6 +6725,153 @@ func (m *EventDetached) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventSetRoyalty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSetRoyalty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSetRoyalty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Royalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventOwnerChanged) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -10,4 +10,10 @@ type (
 		NewID(ctx sdk.Context) string
 		HasID(ctx sdk.Context, id string) bool
 	}
+
+	// BankKeeper defines the bank module interface contract needed by the
+	// collection module.
+	BankKeeper interface {
+		SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+	}
 )
@@ -39,6 +39,8 @@ func ValidateGenesis(data GenesisState) error {
 		}
 	}
 
+	// non-transferable classes by contract
+	nonTransferables := map[string]map[string]bool{}
 	for _, contractClasses := range data.Classes {
 		if err := ValidateContractID(contractClasses.ContractId); err != nil {
 			return err
@@ -53,6 +55,13 @@ func ValidateGenesis(data GenesisState) error {
 			if err := class.ValidateBasic(); err != nil {
 				return err
 			}
+
+			if nftClass, ok := class.(*NFTClass); ok && nftClass.NonTransferable {
+				if nonTransferables[contractClasses.ContractId] == nil {
+					nonTransferables[contractClasses.ContractId] = map[string]bool{}
+				}
+				nonTransferables[contractClasses.ContractId][nftClass.Id] = true
+			}
 		}
 	}
 
@@ -110,6 +119,15 @@ func ValidateGenesis(data GenesisState) error {
 			if err := validateMeta(token.Meta); err != nil {
 				return err
 			}
+			if err := validateURI(token.Uri); err != nil {
+				return err
+			}
+			if err := validateURIHash(token.UriHash); err != nil {
+				return err
+			}
+			if err := validateTraits(token.Traits); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -128,6 +146,11 @@ func ValidateGenesis(data GenesisState) error {
 			if err := ValidateTokenID(relation.Other); err != nil {
 				return err
 			}
+
+			// a non-transferable token would move along with its root
+			if nonTransferables[contractParents.ContractId][SplitTokenID(relation.Self)] {
+				return ErrTokenNotTransferable.Wrapf("non-transferable token cannot be a child: %s", relation.Self)
+			}
 		}
 	}
 
@@ -167,6 +190,30 @@ func ValidateGenesis(data GenesisState) error {
 		}
 	}
 
+	for _, contractRoyalties := range data.Royalties {
+		if err := ValidateContractID(contractRoyalties.ContractId); err != nil {
+			return err
+		}
+
+		if len(contractRoyalties.Royalties) == 0 {
+			return sdkerrors.ErrInvalidRequest.Wrap("royalties cannot be empty")
+		}
+		seenClassIDs := map[string]bool{}
+		for _, royalty := range contractRoyalties.Royalties {
+			if err := royalty.ValidateBasic(); err != nil {
+				return err
+			}
+			if royalty.Rate.IsZero() {
+				return sdkerrors.ErrInvalidRequest.Wrap("royalty rate must be positive")
+			}
+
+			if seenClassIDs[royalty.ClassId] {
+				return sdkerrors.ErrInvalidRequest.Wrapf("duplicate royalty of class: %s", royalty.ClassId)
+			}
+			seenClassIDs[royalty.ClassId] = true
+		}
+	}
+
 	for _, contractSupplies := range data.Supplies {
 		if err := ValidateContractID(contractSupplies.ContractId); err != nil {
 			return err
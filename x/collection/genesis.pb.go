@@ -52,6 +52,8 @@ type GenesisState struct {
 	Supplies []ContractStatistics `protobuf:"bytes,11,rep,name=supplies,proto3" json:"supplies"`
 	// burnts represents the total amount of burnt tokens.
 	Burnts []ContractStatistics `protobuf:"bytes,12,rep,name=burnts,proto3" json:"burnts"`
+	// royalties defines the royalties of the contracts and the classes.
+	Royalties []ContractRoyalties `protobuf:"bytes,13,rep,name=royalties,proto3" json:"royalties"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -172,6 +174,13 @@ func (m *GenesisState) GetBurnts() []ContractStatistics {
 	return nil
 }
 
+func (m *GenesisState) GetRoyalties() []ContractRoyalties {
+	if m != nil {
+		return m.Royalties
+	}
+	return nil
+}
+
 // ContractBalances defines balances belong to a contract.
 // genesis state.
 type ContractBalances struct {
@@ -604,6 +613,61 @@ func (m *ContractGrants) GetGrants() []Grant {
 	return nil
 }
 
+// ContractRoyalties defines royalties belong to a contract.
+type ContractRoyalties struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// royalties
+	Royalties []Royalty `protobuf:"bytes,2,rep,name=royalties,proto3" json:"royalties"`
+}
+
+func (m *ContractRoyalties) Reset()         { *m = ContractRoyalties{} }
+func (m *ContractRoyalties) String() string { return proto.CompactTextString(m) }
+func (*ContractRoyalties) ProtoMessage()    {}
+func (*ContractRoyalties) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b8b3f666cffb1ec, []int{9}
+}
+func (m *ContractRoyalties) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractRoyalties) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractRoyalties.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractRoyalties) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractRoyalties.Merge(m, src)
+}
+func (m *ContractRoyalties) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractRoyalties) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractRoyalties.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractRoyalties proto.InternalMessageInfo
+
+func (m *ContractRoyalties) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
+}
+
+func (m *ContractRoyalties) GetRoyalties() []Royalty {
+	if m != nil {
+		return m.Royalties
+	}
+	return nil
+}
+
 // NextClassIDs defines the next class ids of the contract.
 type NextClassIDs struct {
 	// contract id associated with the contract.
@@ -618,7 +682,7 @@ func (m *NextClassIDs) Reset()         { *m = NextClassIDs{} }
 func (m *NextClassIDs) String() string { return proto.CompactTextString(m) }
 func (*NextClassIDs) ProtoMessage()    {}
 func (*NextClassIDs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b8b3f666cffb1ec, []int{9}
+	return fileDescriptor_2b8b3f666cffb1ec, []int{10}
 }
 func (m *NextClassIDs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -664,7 +728,7 @@ func (m *ContractNextTokenIDs) Reset()         { *m = ContractNextTokenIDs{} }
 func (m *ContractNextTokenIDs) String() string { return proto.CompactTextString(m) }
 func (*ContractNextTokenIDs) ProtoMessage()    {}
 func (*ContractNextTokenIDs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b8b3f666cffb1ec, []int{10}
+	return fileDescriptor_2b8b3f666cffb1ec, []int{11}
 }
 func (m *ContractNextTokenIDs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -719,7 +783,7 @@ func (m *NextTokenID) Reset()         { *m = NextTokenID{} }
 func (m *NextTokenID) String() string { return proto.CompactTextString(m) }
 func (*NextTokenID) ProtoMessage()    {}
 func (*NextTokenID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b8b3f666cffb1ec, []int{11}
+	return fileDescriptor_2b8b3f666cffb1ec, []int{12}
 }
 func (m *NextTokenID) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -769,7 +833,7 @@ func (m *ContractTokenRelations) Reset()         { *m = ContractTokenRelations{}
 func (m *ContractTokenRelations) String() string { return proto.CompactTextString(m) }
 func (*ContractTokenRelations) ProtoMessage()    {}
 func (*ContractTokenRelations) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b8b3f666cffb1ec, []int{12}
+	return fileDescriptor_2b8b3f666cffb1ec, []int{13}
 }
 func (m *ContractTokenRelations) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -826,7 +890,7 @@ func (m *TokenRelation) Reset()         { *m = TokenRelation{} }
 func (m *TokenRelation) String() string { return proto.CompactTextString(m) }
 func (*TokenRelation) ProtoMessage()    {}
 func (*TokenRelation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b8b3f666cffb1ec, []int{13}
+	return fileDescriptor_2b8b3f666cffb1ec, []int{14}
 }
 func (m *TokenRelation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -879,6 +943,7 @@ func init() {
 	proto.RegisterType((*ContractNFTs)(nil), "lbm.collection.v1.ContractNFTs")
 	proto.RegisterType((*ContractAuthorizations)(nil), "lbm.collection.v1.ContractAuthorizations")
 	proto.RegisterType((*ContractGrants)(nil), "lbm.collection.v1.ContractGrants")
+	proto.RegisterType((*ContractRoyalties)(nil), "lbm.collection.v1.ContractRoyalties")
 	proto.RegisterType((*NextClassIDs)(nil), "lbm.collection.v1.NextClassIDs")
 	proto.RegisterType((*ContractNextTokenIDs)(nil), "lbm.collection.v1.ContractNextTokenIDs")
 	proto.RegisterType((*NextTokenID)(nil), "lbm.collection.v1.NextTokenID")
@@ -889,66 +954,69 @@ func init() {
 func init() { proto.RegisterFile("lbm/collection/v1/genesis.proto", fileDescriptor_2b8b3f666cffb1ec) }
 
 var fileDescriptor_2b8b3f666cffb1ec = []byte{
-	// 940 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0xb3, 0x8e, 0xe3, 0x1f, 0xcf, 0x6e, 0x0a, 0xa3, 0xa8, 0x6c, 0x82, 0x64, 0x87, 0x45,
-	0x88, 0x02, 0xca, 0x2e, 0x4d, 0x05, 0x88, 0x0a, 0xa8, 0x62, 0x97, 0x84, 0x50, 0xa9, 0x02, 0x37,
-	0x80, 0xc4, 0xc5, 0x5a, 0xef, 0x8e, 0x9d, 0x51, 0xd7, 0x33, 0x66, 0x67, 0x1c, 0x25, 0xbd, 0x70,
-	0xe0, 0xc4, 0x8d, 0x3f, 0x81, 0x33, 0x67, 0xfe, 0x88, 0x8a, 0x53, 0x8f, 0xa8, 0x87, 0x82, 0x12,
-	0x21, 0xf1, 0x67, 0xa0, 0xf9, 0xb1, 0x9b, 0xb5, 0xbd, 0xf5, 0xd2, 0xdc, 0x76, 0x77, 0xde, 0xf7,
-	0xf3, 0x7d, 0x33, 0x7e, 0xef, 0x8d, 0xa1, 0x1d, 0x0d, 0xc6, 0x5e, 0xc0, 0xa2, 0x08, 0x07, 0x82,
-	0x30, 0xea, 0x9d, 0xdc, 0xf2, 0x46, 0x98, 0x62, 0x4e, 0xb8, 0x3b, 0x89, 0x99, 0x60, 0xe8, 0xd5,
-	0x68, 0x30, 0x76, 0x2f, 0x03, 0xdc, 0x93, 0x5b, 0x5b, 0x9b, 0x23, 0xc6, 0x46, 0x11, 0xf6, 0x54,
-	0xc0, 0x60, 0x3a, 0xf4, 0x7c, 0x7a, 0xa6, 0xa3, 0xb7, 0x36, 0x46, 0x6c, 0xc4, 0xd4, 0xa3, 0x27,
-	0x9f, 0xcc, 0xd7, 0xcd, 0x80, 0xf1, 0x31, 0xe3, 0x7d, 0xbd, 0xa0, 0x5f, 0xcc, 0x92, 0xb3, 0xe8,
-	0x9f, 0x31, 0x53, 0x31, 0xce, 0x3f, 0x15, 0x68, 0x1e, 0xe8, 0xa4, 0x1e, 0x0a, 0x5f, 0x60, 0xf4,
-	0x11, 0x54, 0x26, 0x7e, 0xec, 0x8f, 0xb9, 0x6d, 0x6d, 0x5b, 0x37, 0x1b, 0xbb, 0x9b, 0xee, 0x42,
-	0x92, 0xee, 0x57, 0x2a, 0xa0, 0x53, 0x7e, 0xf2, 0xbc, 0xbd, 0xd2, 0x33, 0xe1, 0xe8, 0x2e, 0xd4,
-	0x03, 0x46, 0x45, 0xec, 0x07, 0x82, 0xdb, 0xa5, 0xed, 0xd5, 0x9b, 0x8d, 0xdd, 0xd7, 0x73, 0xb4,
-	0x5d, 0x13, 0x63, 0xd4, 0x97, 0x1a, 0x74, 0x1f, 0xd6, 0x29, 0x3e, 0x15, 0xfd, 0x20, 0xf2, 0x39,
-	0xef, 0x93, 0x90, 0xdb, 0xab, 0x8a, 0xd2, 0xce, 0xa1, 0x3c, 0xc0, 0xa7, 0xa2, 0x2b, 0xe3, 0x0e,
-	0xef, 0x25, 0x79, 0x34, 0x69, 0xfa, 0x2d, 0xe4, 0xa8, 0x03, 0x55, 0xc5, 0xc1, 0xdc, 0x2e, 0x2b,
-	0x8a, 0xb3, 0x24, 0x97, 0xae, 0x8e, 0x34, 0xa0, 0x44, 0x88, 0x1e, 0x9a, 0x84, 0x04, 0x7b, 0x84,
-	0xa9, 0x4a, 0x68, 0x4d, 0xa1, 0xde, 0x5e, 0x82, 0x92, 0x89, 0x1d, 0xc9, 0xf8, 0xb9, 0xc4, 0xf4,
-	0xb7, 0x90, 0xa3, 0xcf, 0xa1, 0x36, 0xf0, 0x23, 0x9f, 0x06, 0x98, 0xdb, 0x15, 0x85, 0x7b, 0x73,
-	0xd9, 0x29, 0x99, 0x50, 0x83, 0x4a, 0xa5, 0xe8, 0x63, 0x28, 0xd3, 0xa1, 0xe0, 0x76, 0xf5, 0x85,
-	0x47, 0x94, 0x66, 0xb4, 0x7f, 0x94, 0xc8, 0x95, 0x04, 0xdd, 0x87, 0xea, 0xc4, 0x8f, 0x31, 0x15,
-	0xdc, 0xae, 0x29, 0xf5, 0x3b, 0x4b, 0xd4, 0x2a, 0xef, 0x1e, 0x8e, 0x7c, 0xb9, 0xc0, 0x3b, 0x15,
-	0xc9, 0xb1, 0xad, 0x5e, 0x42, 0x40, 0x77, 0xa1, 0x32, 0x8a, 0x7d, 0xc9, 0xaa, 0x2b, 0xd6, 0x1b,
-	0x4b, 0x58, 0x07, 0x2a, 0x30, 0x29, 0x1b, 0x2d, 0x43, 0xdf, 0xc1, 0xba, 0x3f, 0x15, 0xc7, 0x2c,
-	0x26, 0x8f, 0xb5, 0x87, 0x0d, 0x85, 0x49, 0xed, 0xcd, 0x08, 0x0c, 0x70, 0x0e, 0x83, 0x0e, 0xa0,
-	0xc6, 0xa7, 0x93, 0x49, 0x44, 0x30, 0xb7, 0x1b, 0x0a, 0xf9, 0xd6, 0x12, 0xa4, 0x2c, 0x7e, 0xc2,
-	0x05, 0x09, 0xd2, 0xa3, 0x4e, 0xc4, 0xa8, 0x0b, 0x95, 0xc1, 0x34, 0x96, 0x5b, 0x6c, 0xbe, 0x3c,
-	0xc6, 0x48, 0x9d, 0x1f, 0xe0, 0x95, 0xf9, 0xdf, 0x14, 0xb5, 0xa1, 0x91, 0x54, 0x7f, 0x9f, 0x84,
-	0xaa, 0xdf, 0xea, 0x3d, 0x48, 0x3e, 0x1d, 0x86, 0xe8, 0x93, 0x4c, 0xad, 0xe8, 0x8e, 0xda, 0xca,
-	0xf1, 0x36, 0xbc, 0xf9, 0x12, 0x71, 0x7e, 0x04, 0xb4, 0x98, 0x56, 0xb1, 0xe9, 0x17, 0x00, 0x3c,
-	0x0d, 0x37, 0xb6, 0xb9, 0xcd, 0x23, 0xbb, 0x64, 0x61, 0xbf, 0x19, 0xad, 0x73, 0x0a, 0xd7, 0xe7,
-	0x82, 0xd0, 0x26, 0xd4, 0x92, 0xf6, 0x36, 0xd6, 0xba, 0xdb, 0x0e, 0x43, 0xf4, 0x25, 0x54, 0xfc,
-	0x31, 0x9b, 0x52, 0x61, 0x97, 0xe4, 0x42, 0x67, 0x57, 0xf2, 0x9e, 0x3d, 0x6f, 0xbf, 0x3b, 0x22,
-	0xe2, 0x78, 0x3a, 0x70, 0x03, 0x36, 0xf6, 0xf6, 0x09, 0xe5, 0xc1, 0x31, 0xf1, 0xbd, 0xa1, 0x79,
-	0xd8, 0xe1, 0xe1, 0x23, 0x4f, 0x9c, 0x4d, 0x30, 0x77, 0x0f, 0xa9, 0xe8, 0x19, 0x82, 0x43, 0xa0,
-	0x6a, 0x4e, 0x05, 0xd9, 0x50, 0xf5, 0xc3, 0x30, 0xc6, 0x9c, 0x27, 0x86, 0xe6, 0x15, 0x7d, 0x96,
-	0x31, 0x94, 0x9b, 0x7c, 0x2d, 0xf7, 0x77, 0x25, 0xb4, 0x73, 0x4d, 0x66, 0xf2, 0xdb, 0x5f, 0xed,
-	0x35, 0xf9, 0xc6, 0x13, 0x93, 0x3b, 0xe5, 0x7f, 0x7f, 0x6d, 0x5b, 0xce, 0x09, 0x5c, 0x9f, 0x1b,
-	0x23, 0xc5, 0x47, 0x9c, 0x19, 0x4e, 0xda, 0x7a, 0xc3, 0xd5, 0x63, 0xdf, 0x4d, 0xc6, 0xbe, 0xbb,
-	0x47, 0xcf, 0x3a, 0x48, 0xfa, 0xfe, 0xf1, 0xfb, 0x0e, 0xa8, 0x26, 0x54, 0xf4, 0x74, 0x38, 0x39,
-	0x3e, 0x34, 0xb3, 0x1d, 0x5e, 0x6c, 0xfa, 0xbe, 0x99, 0x18, 0xda, 0xf1, 0x46, 0xde, 0x50, 0xdd,
-	0x3f, 0xca, 0x0e, 0x0a, 0xe7, 0x67, 0x0b, 0x6e, 0xe4, 0xb7, 0x5c, 0xb1, 0xdb, 0x83, 0x85, 0xb6,
-	0xd6, 0xbe, 0xdb, 0x39, 0xbe, 0x33, 0xec, 0xfc, 0x6e, 0x76, 0x08, 0xac, 0xcf, 0x8e, 0x91, 0xe2,
-	0x14, 0x3e, 0x4c, 0x47, 0x93, 0xb6, 0xb6, 0x73, 0xac, 0x15, 0x6b, 0x76, 0x22, 0x39, 0xcf, 0x2c,
-	0x68, 0x66, 0xef, 0x97, 0x62, 0xa7, 0xaf, 0xa1, 0x36, 0x9c, 0xd2, 0x11, 0x19, 0x44, 0xd8, 0x14,
-	0xef, 0x07, 0xa6, 0x78, 0xdf, 0xfb, 0x9f, 0xc5, 0xfb, 0x0d, 0xa1, 0xc2, 0xb6, 0x7a, 0x29, 0x06,
-	0x7d, 0x0b, 0x4d, 0xca, 0x68, 0x3f, 0xc5, 0xae, 0x2a, 0xec, 0xed, 0x2b, 0x60, 0x7b, 0x0d, 0xca,
-	0xe8, 0xbe, 0xe1, 0x38, 0x8f, 0x61, 0x23, 0xef, 0xaa, 0x2a, 0xde, 0xe3, 0x1e, 0xd4, 0x2f, 0xef,
-	0x41, 0x7d, 0xa0, 0xad, 0x17, 0x5c, 0xcc, 0x06, 0x9a, 0x0c, 0x24, 0x61, 0xae, 0x3e, 0x67, 0x0c,
-	0x8d, 0xcc, 0xf2, 0xb2, 0x59, 0xd0, 0x85, 0x12, 0x09, 0xcd, 0x51, 0x5e, 0x69, 0xcf, 0x25, 0x12,
-	0x3a, 0x3f, 0x65, 0xca, 0x77, 0xf6, 0x1a, 0x2b, 0xde, 0xed, 0x3d, 0xa8, 0xc7, 0x49, 0xf4, 0x92,
-	0xca, 0x9d, 0xc1, 0x26, 0xff, 0x68, 0x52, 0xe1, 0x9d, 0x92, 0x6d, 0x39, 0x9f, 0xc2, 0xb5, 0x99,
-	0x28, 0x84, 0xa0, 0xcc, 0x71, 0x34, 0x34, 0xa6, 0xea, 0x19, 0x6d, 0xc0, 0x1a, 0x13, 0xc7, 0x38,
-	0xd6, 0x5b, 0xee, 0xe9, 0x17, 0x29, 0xef, 0x1c, 0x3c, 0x39, 0x6f, 0x59, 0x4f, 0xcf, 0x5b, 0xd6,
-	0xdf, 0xe7, 0x2d, 0xeb, 0x97, 0x8b, 0xd6, 0xca, 0xd3, 0x8b, 0xd6, 0xca, 0x9f, 0x17, 0xad, 0x95,
-	0xef, 0x77, 0x0a, 0xcf, 0xe3, 0x34, 0xf3, 0x77, 0x6f, 0x50, 0x51, 0xa3, 0xe5, 0xf6, 0x7f, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0xa8, 0x3f, 0x52, 0xda, 0x95, 0x0a, 0x00, 0x00,
+	// 982 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0x4f, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xc7, 0xb3, 0x8e, 0xe3, 0x3f, 0x8f, 0x9d, 0xf4, 0xd7, 0x51, 0xd4, 0xdf, 0x26, 0x48, 0x76,
+	0x58, 0x40, 0x14, 0x50, 0xd6, 0x34, 0x15, 0x20, 0x2a, 0x68, 0x15, 0xbb, 0x24, 0x0d, 0x95, 0x2a,
+	0x70, 0x03, 0x48, 0x5c, 0xac, 0xf5, 0xee, 0xd8, 0x19, 0x75, 0x3d, 0x63, 0x76, 0xc6, 0x51, 0xdc,
+	0x0b, 0x07, 0x4e, 0xdc, 0x78, 0x09, 0x9c, 0xb9, 0x70, 0xe1, 0x45, 0x54, 0x9c, 0x7a, 0x44, 0x3d,
+	0x14, 0x94, 0x5c, 0x78, 0x19, 0x68, 0xfe, 0xec, 0x66, 0x6d, 0x6f, 0xbc, 0xd0, 0xdb, 0xee, 0xce,
+	0xf3, 0x7c, 0xbe, 0xcf, 0x3c, 0x9e, 0xe7, 0x3b, 0x86, 0x66, 0xd8, 0x1f, 0xb5, 0x7c, 0x16, 0x86,
+	0xd8, 0x17, 0x84, 0xd1, 0xd6, 0xe9, 0xad, 0xd6, 0x10, 0x53, 0xcc, 0x09, 0x77, 0xc7, 0x11, 0x13,
+	0x0c, 0x5d, 0x0f, 0xfb, 0x23, 0xf7, 0x32, 0xc0, 0x3d, 0xbd, 0xb5, 0xbd, 0x35, 0x64, 0x6c, 0x18,
+	0xe2, 0x96, 0x0a, 0xe8, 0x4f, 0x06, 0x2d, 0x8f, 0x4e, 0x75, 0xf4, 0xf6, 0xe6, 0x90, 0x0d, 0x99,
+	0x7a, 0x6c, 0xc9, 0x27, 0xf3, 0x75, 0xcb, 0x67, 0x7c, 0xc4, 0x78, 0x4f, 0x2f, 0xe8, 0x17, 0xb3,
+	0xe4, 0x2c, 0xea, 0xa7, 0xc4, 0x54, 0x8c, 0xf3, 0x6b, 0x19, 0xea, 0x87, 0xba, 0xa8, 0xc7, 0xc2,
+	0x13, 0x18, 0x7d, 0x04, 0xa5, 0xb1, 0x17, 0x79, 0x23, 0x6e, 0x5b, 0x3b, 0xd6, 0xcd, 0xda, 0xde,
+	0x96, 0xbb, 0x50, 0xa4, 0xfb, 0x85, 0x0a, 0x68, 0x17, 0x9f, 0xbd, 0x6c, 0xae, 0x74, 0x4d, 0x38,
+	0xba, 0x07, 0x55, 0x9f, 0x51, 0x11, 0x79, 0xbe, 0xe0, 0x76, 0x61, 0x67, 0xf5, 0x66, 0x6d, 0xef,
+	0xb5, 0x8c, 0xdc, 0x8e, 0x89, 0x31, 0xd9, 0x97, 0x39, 0xe8, 0x21, 0x6c, 0x50, 0x7c, 0x26, 0x7a,
+	0x7e, 0xe8, 0x71, 0xde, 0x23, 0x01, 0xb7, 0x57, 0x15, 0xa5, 0x99, 0x41, 0x79, 0x84, 0xcf, 0x44,
+	0x47, 0xc6, 0x1d, 0xdd, 0x8f, 0xeb, 0xa8, 0xd3, 0xe4, 0x5b, 0xc0, 0x51, 0x1b, 0xca, 0x8a, 0x83,
+	0xb9, 0x5d, 0x54, 0x14, 0x67, 0x49, 0x2d, 0x1d, 0x1d, 0x69, 0x40, 0x71, 0x22, 0x7a, 0x6c, 0x0a,
+	0x12, 0xec, 0x09, 0xa6, 0xaa, 0xa0, 0x35, 0x85, 0x7a, 0x7b, 0x09, 0x4a, 0x16, 0x76, 0x2c, 0xe3,
+	0xe7, 0x0a, 0xd3, 0xdf, 0x02, 0x8e, 0x3e, 0x83, 0x4a, 0xdf, 0x0b, 0x3d, 0xea, 0x63, 0x6e, 0x97,
+	0x14, 0xee, 0x8d, 0x65, 0x5d, 0x32, 0xa1, 0x06, 0x95, 0xa4, 0xa2, 0x8f, 0xa1, 0x48, 0x07, 0x82,
+	0xdb, 0xe5, 0x2b, 0x5b, 0x94, 0x54, 0x74, 0x70, 0x1c, 0xa7, 0xab, 0x14, 0xf4, 0x10, 0xca, 0x63,
+	0x2f, 0xc2, 0x54, 0x70, 0xbb, 0xa2, 0xb2, 0xdf, 0x59, 0x92, 0xad, 0xea, 0xee, 0xe2, 0xd0, 0x93,
+	0x0b, 0xbc, 0x5d, 0x92, 0x1c, 0xdb, 0xea, 0xc6, 0x04, 0x74, 0x0f, 0x4a, 0xc3, 0xc8, 0x93, 0xac,
+	0xaa, 0x62, 0xbd, 0xbe, 0x84, 0x75, 0xa8, 0x02, 0xe3, 0x63, 0xa3, 0xd3, 0xd0, 0x37, 0xb0, 0xe1,
+	0x4d, 0xc4, 0x09, 0x8b, 0xc8, 0x53, 0xad, 0x61, 0x43, 0x6e, 0x51, 0xfb, 0x33, 0x09, 0x06, 0x38,
+	0x87, 0x41, 0x87, 0x50, 0xe1, 0x93, 0xf1, 0x38, 0x24, 0x98, 0xdb, 0x35, 0x85, 0x7c, 0x6b, 0x09,
+	0x52, 0x1e, 0x7e, 0xc2, 0x05, 0xf1, 0x93, 0x56, 0xc7, 0xc9, 0xa8, 0x03, 0xa5, 0xfe, 0x24, 0x92,
+	0x5b, 0xac, 0xff, 0x77, 0x8c, 0x49, 0x45, 0x0f, 0xa0, 0x1a, 0xb1, 0xa9, 0x17, 0x0a, 0x59, 0xce,
+	0xba, 0xe2, 0xbc, 0xb9, 0x84, 0xd3, 0x8d, 0x63, 0xe3, 0x31, 0x49, 0x92, 0x9d, 0xef, 0xe0, 0x7f,
+	0xf3, 0xa7, 0x03, 0x35, 0xa1, 0x16, 0xcf, 0x51, 0x8f, 0x04, 0x6a, 0x72, 0xab, 0x5d, 0x88, 0x3f,
+	0x1d, 0x05, 0xe8, 0x93, 0xd4, 0xa9, 0xd3, 0xb3, 0xb9, 0x9d, 0xa1, 0x6e, 0x78, 0xf3, 0x87, 0xcd,
+	0xf9, 0x1e, 0xd0, 0xe2, 0x06, 0xf3, 0x45, 0x1f, 0x00, 0xf0, 0x24, 0xdc, 0xc8, 0x66, 0x8e, 0xa1,
+	0x9c, 0xb7, 0x85, 0xce, 0xa5, 0x72, 0x9d, 0x33, 0xb8, 0x36, 0x17, 0x84, 0xb6, 0xa0, 0x12, 0x1b,
+	0x85, 0x91, 0xd6, 0x73, 0x7b, 0x14, 0xa0, 0xcf, 0xa1, 0xe4, 0x8d, 0xd8, 0x84, 0x0a, 0xbb, 0x20,
+	0x17, 0xda, 0x7b, 0x92, 0xf7, 0xe2, 0x65, 0xf3, 0xdd, 0x21, 0x11, 0x27, 0x93, 0xbe, 0xeb, 0xb3,
+	0x51, 0xeb, 0x80, 0x50, 0xee, 0x9f, 0x10, 0xaf, 0x35, 0x30, 0x0f, 0xbb, 0x3c, 0x78, 0xd2, 0x12,
+	0xd3, 0x31, 0xe6, 0xee, 0x11, 0x15, 0x5d, 0x43, 0x70, 0x08, 0x94, 0x4d, 0x57, 0x90, 0x0d, 0x65,
+	0x2f, 0x08, 0x22, 0xcc, 0x79, 0x2c, 0x68, 0x5e, 0xd1, 0xdd, 0x94, 0xa0, 0xdc, 0xe4, 0xff, 0x33,
+	0x7f, 0x59, 0x42, 0xdb, 0xeb, 0xb2, 0x92, 0x5f, 0xfe, 0x6c, 0xae, 0xc9, 0x37, 0x1e, 0x8b, 0xdc,
+	0x29, 0xfe, 0xfd, 0x73, 0xd3, 0x72, 0x4e, 0xe1, 0xda, 0x9c, 0x21, 0xe5, 0xb7, 0x38, 0x65, 0x73,
+	0x5a, 0x7a, 0xd3, 0xd5, 0x17, 0x88, 0x1b, 0x5f, 0x20, 0xee, 0x3e, 0x9d, 0xb6, 0x91, 0xd4, 0xfd,
+	0xfd, 0xb7, 0x5d, 0x50, 0xe3, 0xac, 0xe8, 0x89, 0xcd, 0x39, 0x1e, 0xd4, 0xd3, 0x5e, 0x91, 0x2f,
+	0xfa, 0xbe, 0xf1, 0x1e, 0xad, 0x78, 0x23, 0xcb, 0x9e, 0x0f, 0x8e, 0xd3, 0x96, 0xe3, 0xfc, 0x68,
+	0xc1, 0x8d, 0xec, 0xe1, 0xcd, 0x57, 0x7b, 0xb4, 0x60, 0x10, 0x5a, 0x77, 0x27, 0x43, 0x77, 0x86,
+	0x9d, 0xed, 0x0b, 0x0e, 0x81, 0x8d, 0x59, 0x43, 0xca, 0x2f, 0xe1, 0xc3, 0xc4, 0xe4, 0xb4, 0xb4,
+	0x9d, 0x21, 0xad, 0x58, 0xb3, 0xde, 0xe6, 0x08, 0xb8, 0xbe, 0x30, 0xd0, 0xf9, 0x6a, 0x77, 0xd3,
+	0x56, 0x71, 0xf5, 0xb0, 0x6a, 0xe2, 0x74, 0xd1, 0x20, 0x5e, 0x58, 0x50, 0x4f, 0xdf, 0x8f, 0xf9,
+	0x8a, 0x5f, 0x42, 0x65, 0x30, 0xa1, 0x43, 0xd2, 0x0f, 0xb1, 0x19, 0x99, 0x0f, 0xcc, 0xc8, 0xbc,
+	0xf7, 0x2f, 0x47, 0xe6, 0x2b, 0x42, 0x85, 0x6d, 0x75, 0x13, 0x0c, 0xfa, 0x1a, 0xea, 0x94, 0xd1,
+	0x5e, 0x82, 0x5d, 0x55, 0xd8, 0xdb, 0xaf, 0x80, 0xed, 0xd6, 0x28, 0xa3, 0x07, 0x86, 0xe3, 0x3c,
+	0x85, 0xcd, 0xac, 0xab, 0x36, 0x7f, 0x8f, 0xfb, 0x50, 0xbd, 0xbc, 0xc7, 0x75, 0x57, 0x1b, 0x57,
+	0xfc, 0xb1, 0x30, 0xd0, 0xd8, 0x06, 0x85, 0xb9, 0xba, 0x9d, 0x11, 0xd4, 0x52, 0xcb, 0xcb, 0x1c,
+	0xa8, 0x03, 0x05, 0x12, 0x98, 0x56, 0xbe, 0xd2, 0x9e, 0x0b, 0x24, 0x70, 0x7e, 0x48, 0x0d, 0xcd,
+	0xec, 0x35, 0x9c, 0xbf, 0xdb, 0xfb, 0x50, 0x8d, 0xe2, 0xe8, 0x25, 0xf3, 0x32, 0x83, 0x4d, 0x4e,
+	0x52, 0x9c, 0x78, 0xa7, 0x60, 0x5b, 0xce, 0xa7, 0xb0, 0x3e, 0x13, 0x85, 0x10, 0x14, 0x39, 0x0e,
+	0x07, 0x46, 0x54, 0x3d, 0xa3, 0x4d, 0x58, 0x63, 0xe2, 0x04, 0x47, 0x7a, 0xcb, 0x5d, 0xfd, 0x22,
+	0xd3, 0xdb, 0x87, 0xcf, 0xce, 0x1b, 0xd6, 0xf3, 0xf3, 0x86, 0xf5, 0xd7, 0x79, 0xc3, 0xfa, 0xe9,
+	0xa2, 0xb1, 0xf2, 0xfc, 0xa2, 0xb1, 0xf2, 0xc7, 0x45, 0x63, 0xe5, 0xdb, 0xdd, 0xdc, 0x7e, 0x9c,
+	0xa5, 0xfe, 0xae, 0xf6, 0x4b, 0xca, 0xd0, 0x6e, 0xff, 0x13, 0x00, 0x00, 0xff, 0xff, 0x13, 0x6b,
+	0xa5, 0x10, 0x55, 0x0b, 0x00, 0x00,
 }
 
 func (this *Balance) Equal(that interface{}) bool {
@@ -1003,6 +1071,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Royalties) > 0 {
+		for iNdEx := len(m.Royalties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Royalties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
 	if len(m.Burnts) > 0 {
 		for iNdEx := len(m.Burnts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1518,6 +1600,50 @@ func (m *ContractGrants) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ContractRoyalties) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractRoyalties) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractRoyalties) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Royalties) > 0 {
+		for iNdEx := len(m.Royalties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Royalties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *NextClassIDs) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1818,6 +1944,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Royalties) > 0 {
+		for _, e := range m.Royalties {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1969,6 +2101,25 @@ func (m *ContractGrants) Size() (n int) {
 	return n
 }
 
+func (m *ContractRoyalties) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Royalties) > 0 {
+		for _, e := range m.Royalties {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *NextClassIDs) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2498,6 +2649,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Royalties = append(m.Royalties, ContractRoyalties{})
+			if err := m.Royalties[len(m.Royalties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3447,6 +3632,122 @@ func (m *ContractGrants) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ContractRoyalties) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractRoyalties: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractRoyalties: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Royalties = append(m.Royalties, Royalty{})
+			if err := m.Royalties[len(m.Royalties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *NextClassIDs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -238,6 +238,36 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			false,
 		},
+		"contract nfts of invalid uri hash": {
+			&collection.GenesisState{
+				Nfts: []collection.ContractNFTs{{
+					ContractId: "deadbeef",
+					Nfts: []collection.NFT{{
+						TokenId: collection.NewNFTID("deadbeef", 1),
+						Name:    "tibetian fox",
+						UriHash: string(make([]rune, 129)),
+					}},
+				}},
+			},
+			false,
+		},
+		"contract nfts of invalid trait": {
+			&collection.GenesisState{
+				Nfts: []collection.ContractNFTs{{
+					ContractId: "deadbeef",
+					Nfts: []collection.NFT{{
+						TokenId: collection.NewNFTID("deadbeef", 1),
+						Name:    "tibetian fox",
+						Traits: []collection.Trait{{
+							Key:   "level",
+							Value: "one",
+							Type:  collection.TraitTypeNumber,
+						}},
+					}},
+				}},
+			},
+			false,
+		},
 		"contract parents of invalid contract id": {
 			&collection.GenesisState{
 				Parents: []collection.ContractTokenRelations{{
@@ -279,6 +309,50 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			false,
 		},
+		"non-transferable parent": {
+			&collection.GenesisState{
+				Classes: []collection.ContractClasses{{
+					ContractId: "deadbeef",
+					Classes: []codectypes.Any{
+						*collection.TokenClassToAny(&collection.NFTClass{
+							Id:              "deadbeef",
+							Name:            "tibetian fox",
+							NonTransferable: true,
+						}),
+					},
+				}},
+				Parents: []collection.ContractTokenRelations{{
+					ContractId: "deadbeef",
+					Relations: []collection.TokenRelation{{
+						Self:  collection.NewNFTID("fee1dead", 1),
+						Other: collection.NewNFTID("deadbeef", 1),
+					}},
+				}},
+			},
+			true,
+		},
+		"contract parents of non-transferable token": {
+			&collection.GenesisState{
+				Classes: []collection.ContractClasses{{
+					ContractId: "deadbeef",
+					Classes: []codectypes.Any{
+						*collection.TokenClassToAny(&collection.NFTClass{
+							Id:              "deadbeef",
+							Name:            "tibetian fox",
+							NonTransferable: true,
+						}),
+					},
+				}},
+				Parents: []collection.ContractTokenRelations{{
+					ContractId: "deadbeef",
+					Relations: []collection.TokenRelation{{
+						Self:  collection.NewNFTID("deadbeef", 1),
+						Other: collection.NewNFTID("fee1dead", 1),
+					}},
+				}},
+			},
+			false,
+		},
 		"contract authorizations of invalid contract id": {
 			&collection.GenesisState{
 				Authorizations: []collection.ContractAuthorizations{{
@@ -361,6 +435,85 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			false,
 		},
+		"valid royalties": {
+			&collection.GenesisState{
+				Royalties: []collection.ContractRoyalties{{
+					ContractId: "deadbeef",
+					Royalties: []collection.Royalty{
+						{
+							Recipient: addr.String(),
+							Rate:      sdk.NewDecWithPrec(1, 1),
+						},
+						{
+							ClassId:   "deadbeef",
+							Recipient: addr.String(),
+							Rate:      sdk.NewDecWithPrec(5, 2),
+						},
+					},
+				}},
+			},
+			true,
+		},
+		"contract royalties of invalid contract id": {
+			&collection.GenesisState{
+				Royalties: []collection.ContractRoyalties{{
+					Royalties: []collection.Royalty{{
+						Recipient: addr.String(),
+						Rate:      sdk.NewDecWithPrec(1, 1),
+					}},
+				}},
+			},
+			false,
+		},
+		"contract royalties of empty royalties": {
+			&collection.GenesisState{
+				Royalties: []collection.ContractRoyalties{{
+					ContractId: "deadbeef",
+				}},
+			},
+			false,
+		},
+		"contract royalties of invalid recipient": {
+			&collection.GenesisState{
+				Royalties: []collection.ContractRoyalties{{
+					ContractId: "deadbeef",
+					Royalties: []collection.Royalty{{
+						Rate: sdk.NewDecWithPrec(1, 1),
+					}},
+				}},
+			},
+			false,
+		},
+		"contract royalties of zero rate": {
+			&collection.GenesisState{
+				Royalties: []collection.ContractRoyalties{{
+					ContractId: "deadbeef",
+					Royalties: []collection.Royalty{{
+						Recipient: addr.String(),
+						Rate:      sdk.ZeroDec(),
+					}},
+				}},
+			},
+			false,
+		},
+		"contract royalties of duplicate classes": {
+			&collection.GenesisState{
+				Royalties: []collection.ContractRoyalties{{
+					ContractId: "deadbeef",
+					Royalties: []collection.Royalty{
+						{
+							Recipient: addr.String(),
+							Rate:      sdk.NewDecWithPrec(1, 1),
+						},
+						{
+							Recipient: addr.String(),
+							Rate:      sdk.NewDecWithPrec(5, 2),
+						},
+					},
+				}},
+			},
+			false,
+		},
 		"contract supplies of invalid contract id": {
 			&collection.GenesisState{
 				Supplies: []collection.ContractStatistics{{
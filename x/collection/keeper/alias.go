@@ -77,6 +77,27 @@ func (k Keeper) iterateClassesImpl(ctx sdk.Context, prefix []byte, fn func(class
 	}
 }
 
+func (k Keeper) iterateContractRoyalties(ctx sdk.Context, contractID string, fn func(royalty collection.Royalty) (stop bool)) {
+	k.iterateRoyaltiesImpl(ctx, royaltyKeyPrefixByContractID(contractID), fn)
+}
+
+func (k Keeper) iterateRoyaltiesImpl(ctx sdk.Context, prefix []byte, fn func(royalty collection.Royalty) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var royalty collection.Royalty
+		k.cdc.MustUnmarshal(iterator.Value(), &royalty)
+
+		stop := fn(royalty)
+		if stop {
+			break
+		}
+	}
+}
+
 func (k Keeper) iterateContractGrants(ctx sdk.Context, contractID string, fn func(grant collection.Grant) (stop bool)) {
 	k.iterateGrantsImpl(ctx, grantKeyPrefixByContractID(contractID), func(_ string, grant collection.Grant) (stop bool) {
 		return fn(grant)
@@ -180,6 +180,15 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *collection.GenesisState) {
 		reporter.Tick()
 	}
 
+	reporter = newProgressReporter(k.Logger(ctx), "import royalties", len(data.Royalties))
+	for _, contractRoyalties := range data.Royalties {
+		for _, royalty := range contractRoyalties.Royalties {
+			k.setRoyalty(ctx, contractRoyalties.ContractId, royalty)
+		}
+
+		reporter.Tick()
+	}
+
 	reporter = newProgressReporter(k.Logger(ctx), "import statistics (burnt)", len(data.Burnts))
 	for _, contractBurnts := range data.Burnts {
 		contractID := contractBurnts.ContractId
@@ -223,6 +232,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *collection.GenesisState {
 		Authorizations: k.getAuthorizations(ctx, contracts),
 		Supplies:       k.getSupplies(ctx, contracts),
 		Burnts:         k.getBurnts(ctx, contracts),
+		Royalties:      k.getRoyalties(ctx, contracts),
 	}
 }
 
@@ -411,6 +421,26 @@ func (k Keeper) getGrants(ctx sdk.Context, contracts []collection.Contract) []co
 	return grants
 }
 
+func (k Keeper) getRoyalties(ctx sdk.Context, contracts []collection.Contract) []collection.ContractRoyalties {
+	var royalties []collection.ContractRoyalties
+	for _, contract := range contracts {
+		contractID := contract.Id
+		contractRoyalties := collection.ContractRoyalties{
+			ContractId: contractID,
+		}
+
+		k.iterateContractRoyalties(ctx, contractID, func(royalty collection.Royalty) (stop bool) {
+			contractRoyalties.Royalties = append(contractRoyalties.Royalties, royalty)
+			return false
+		})
+		if len(contractRoyalties.Royalties) != 0 {
+			royalties = append(royalties, contractRoyalties)
+		}
+	}
+
+	return royalties
+}
+
 func (k Keeper) getSupplies(ctx sdk.Context, contracts []collection.Contract) []collection.ContractStatistics {
 	return k.getStatistics(ctx, contracts, k.iterateContractSupplies)
 }
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	sdk "github.com/Finschia/finschia-sdk/types"
 	"github.com/Finschia/finschia-sdk/x/collection"
 )
 
@@ -21,6 +22,9 @@ func (s *KeeperTestSuite) TestImportExportGenesis() {
 
 	s.keeper.Abandon(s.ctx, s.contractID, s.vendor, collection.PermissionMint)
 
+	err = s.keeper.SetRoyalty(s.ctx, s.contractID, collection.Royalty{Rate: sdk.ZeroDec()})
+	s.Require().NoError(err)
+
 	// restore
 	s.keeper.InitGenesis(s.ctx, genesis)
 
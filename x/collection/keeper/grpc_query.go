@@ -302,6 +302,58 @@ func (s queryServer) Contract(c context.Context, req *collection.QueryContractRe
 	return &collection.QueryContractResponse{Contract: *contract}, nil
 }
 
+// Royalty queries the royalty applied on a class.
+func (s queryServer) Royalty(c context.Context, req *collection.QueryRoyaltyRequest) (*collection.QueryRoyaltyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := collection.ValidateContractID(req.ContractId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.ClassId != "" {
+		if err := collection.ValidateClassID(req.ClassId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	royalty, err := s.keeper.GetRoyalty(ctx, req.ContractId, req.ClassId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &collection.QueryRoyaltyResponse{Royalty: *royalty}, nil
+}
+
+// Royalties queries all the royalties set on a contract.
+func (s queryServer) Royalties(c context.Context, req *collection.QueryRoyaltiesRequest) (*collection.QueryRoyaltiesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := collection.ValidateContractID(req.ContractId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(s.keeper.storeKey)
+	royaltyStore := prefix.NewStore(store, royaltyKeyPrefixByContractID(req.ContractId))
+	var royalties []collection.Royalty
+	pageRes, err := query.Paginate(royaltyStore, req.Pagination, func(_, value []byte) error {
+		var royalty collection.Royalty
+		s.keeper.cdc.MustUnmarshal(value, &royalty)
+		royalties = append(royalties, royalty)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &collection.QueryRoyaltiesResponse{Royalties: royalties, Pagination: pageRes}, nil
+}
+
 // TokenClassTypeName queries the fully qualified message type name of a token class based on its class id.
 func (s queryServer) TokenClassTypeName(c context.Context, req *collection.QueryTokenClassTypeNameRequest) (*collection.QueryTokenClassTypeNameResponse, error) {
 	if req == nil {
@@ -352,10 +404,11 @@ func (s queryServer) TokenType(c context.Context, req *collection.QueryTokenType
 	}
 
 	tokenType := collection.TokenType{
-		ContractId: req.ContractId,
-		TokenType:  nftClass.Id,
-		Name:       nftClass.Name,
-		Meta:       nftClass.Meta,
+		ContractId:      req.ContractId,
+		TokenType:       nftClass.Id,
+		Name:            nftClass.Name,
+		Meta:            nftClass.Meta,
+		NonTransferable: nftClass.NonTransferable,
 	}
 
 	return &collection.QueryTokenTypeResponse{TokenType: tokenType}, nil
@@ -376,6 +429,9 @@ func (s queryServer) getToken(ctx sdk.Context, contractID, tokenID string) (coll
 			Name:       token.Name,
 			Meta:       token.Meta,
 			Owner:      owner.String(),
+			Uri:        token.Uri,
+			UriHash:    token.UriHash,
+			Traits:     token.Traits,
 		}, nil
 	case collection.ValidateFTID(tokenID) == nil:
 		classID := collection.SplitTokenID(tokenID)
@@ -429,6 +485,134 @@ func (s queryServer) Token(c context.Context, req *collection.QueryTokenRequest)
 	return &collection.QueryTokenResponse{Token: *any}, nil
 }
 
+func (s queryServer) NFTsByTrait(c context.Context, req *collection.QueryNFTsByTraitRequest) (*collection.QueryNFTsByTraitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := collection.ValidateContractID(req.ContractId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := collection.ValidateClassID(req.ClassId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if len(req.Key) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty trait key")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(s.keeper.storeKey)
+	traitStore := prefix.NewStore(store, traitKeyPrefixByValue(req.ContractId, req.ClassId, req.Key, req.Value))
+	var tokens []collection.NFT
+	pageRes, err := query.Paginate(traitStore, req.Pagination, func(key, _ []byte) error {
+		token, err := s.keeper.GetNFT(ctx, req.ContractId, string(key))
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		tokens = append(tokens, *token)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &collection.QueryNFTsByTraitResponse{Tokens: tokens, Pagination: pageRes}, nil
+}
+
+func (s queryServer) NFTsByOwner(c context.Context, req *collection.QueryNFTsByOwnerRequest) (*collection.QueryNFTsByOwnerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := collection.ValidateContractID(req.ContractId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	owner, err := s.addressFromBech32GRPC(req.Owner, "owner")
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(s.keeper.storeKey)
+	ownedStore := prefix.NewStore(store, ownedNFTKeyPrefixByOwner(req.ContractId, owner))
+	var tokens []collection.NFT
+	pageRes, err := query.Paginate(ownedStore, req.Pagination, func(key, _ []byte) error {
+		token, err := s.keeper.GetNFT(ctx, req.ContractId, string(key))
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		tokens = append(tokens, *token)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &collection.QueryNFTsByOwnerResponse{Tokens: tokens, Pagination: pageRes}, nil
+}
+
+func (s queryServer) NFTsByClass(c context.Context, req *collection.QueryNFTsByClassRequest) (*collection.QueryNFTsByClassResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := collection.ValidateContractID(req.ContractId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := collection.ValidateClassID(req.ClassId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// the token id of an nft starts with its class id
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(s.keeper.storeKey)
+	nftStore := prefix.NewStore(store, nftKey(req.ContractId, req.ClassId))
+	var tokens []collection.NFT
+	pageRes, err := query.Paginate(nftStore, req.Pagination, func(_, value []byte) error {
+		var token collection.NFT
+		s.keeper.cdc.MustUnmarshal(value, &token)
+		tokens = append(tokens, token)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &collection.QueryNFTsByClassResponse{Tokens: tokens, Pagination: pageRes}, nil
+}
+
+func (s queryServer) OwnersOfClass(c context.Context, req *collection.QueryOwnersOfClassRequest) (*collection.QueryOwnersOfClassResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := collection.ValidateContractID(req.ContractId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := collection.ValidateClassID(req.ClassId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(s.keeper.storeKey)
+	ownerStore := prefix.NewStore(store, classOwnerKeyPrefixByClassID(req.ContractId, req.ClassId))
+	var owners []string
+	pageRes, err := query.Paginate(ownerStore, req.Pagination, func(key, _ []byte) error {
+		owners = append(owners, sdk.AccAddress(key).String())
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &collection.QueryOwnersOfClassResponse{Owners: owners, Pagination: pageRes}, nil
+}
+
 func (s queryServer) Root(c context.Context, req *collection.QueryRootRequest) (*collection.QueryRootResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -785,6 +785,319 @@ func (s *KeeperTestSuite) TestQueryToken() {
 	}
 }
 
+func (s *KeeperTestSuite) TestQueryNFTsByTrait() {
+	// empty request
+	_, err := s.queryServer.NFTsByTrait(s.goCtx, nil)
+	s.Require().Error(err)
+
+	ctx, _ := s.ctx.CacheContext()
+	newParam := func(species string) collection.MintNFTParam {
+		return collection.MintNFTParam{
+			TokenType: s.nftClassID,
+			Name:      species,
+			Traits: []collection.Trait{{
+				Key:   "species",
+				Value: species,
+				Type:  collection.TraitTypeString,
+			}},
+		}
+	}
+	params := []collection.MintNFTParam{
+		newParam("fox"),
+		newParam("fox"),
+		newParam("fox"),
+		newParam("wolf"),
+	}
+	tokens, err := s.keeper.MintNFT(ctx, s.contractID, s.customer, params)
+	s.Require().NoError(err)
+
+	// burn one of the foxes, which must be removed from the index
+	burnt := tokens[2].TokenId
+	_, err = s.keeper.BurnCoins(ctx, s.contractID, s.customer, collection.NewCoins(collection.NewCoin(burnt, sdk.OneInt())))
+	s.Require().NoError(err)
+
+	testCases := map[string]struct {
+		contractID string
+		classID    string
+		key        string
+		value      string
+		pagination *query.PageRequest
+		valid      bool
+		postTest   func(res *collection.QueryNFTsByTraitResponse)
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			classID:    s.nftClassID,
+			key:        "species",
+			value:      "fox",
+			valid:      true,
+			postTest: func(res *collection.QueryNFTsByTraitResponse) {
+				s.Require().Equal([]collection.NFT{tokens[0], tokens[1]}, res.Tokens)
+			},
+		},
+		"valid request with limit": {
+			contractID: s.contractID,
+			classID:    s.nftClassID,
+			key:        "species",
+			value:      "fox",
+			pagination: &query.PageRequest{
+				Limit: 1,
+			},
+			valid: true,
+			postTest: func(res *collection.QueryNFTsByTraitResponse) {
+				s.Require().Equal([]collection.NFT{tokens[0]}, res.Tokens)
+			},
+		},
+		"no such a value": {
+			contractID: s.contractID,
+			classID:    s.nftClassID,
+			key:        "species",
+			value:      "tiger",
+			valid:      true,
+			postTest: func(res *collection.QueryNFTsByTraitResponse) {
+				s.Require().Empty(res.Tokens)
+			},
+		},
+		"invalid contract id": {
+			classID: s.nftClassID,
+			key:     "species",
+		},
+		"invalid class id": {
+			contractID: s.contractID,
+			key:        "species",
+		},
+		"empty key": {
+			contractID: s.contractID,
+			classID:    s.nftClassID,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			req := &collection.QueryNFTsByTraitRequest{
+				ContractId: tc.contractID,
+				ClassId:    tc.classID,
+				Key:        tc.key,
+				Value:      tc.value,
+				Pagination: tc.pagination,
+			}
+			res, err := s.queryServer.NFTsByTrait(sdk.WrapSDKContext(ctx), req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+			tc.postTest(res)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryNFTsByOwner() {
+	// empty request
+	_, err := s.queryServer.NFTsByOwner(s.goCtx, nil)
+	s.Require().Error(err)
+
+	testCases := map[string]struct {
+		contractID string
+		owner      sdk.AccAddress
+		pagination *query.PageRequest
+		valid      bool
+		postTest   func(res *collection.QueryNFTsByOwnerResponse)
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			owner:      s.customer,
+			valid:      true,
+			postTest: func(res *collection.QueryNFTsByOwnerResponse) {
+				s.Require().Equal(s.numRoots, len(res.Tokens))
+				for _, token := range res.Tokens {
+					s.Require().Equal(s.customer, s.keeper.GetRootOwner(s.ctx, s.contractID, token.TokenId))
+				}
+			},
+		},
+		"valid request with limit": {
+			contractID: s.contractID,
+			owner:      s.customer,
+			pagination: &query.PageRequest{
+				Limit: 1,
+			},
+			valid: true,
+			postTest: func(res *collection.QueryNFTsByOwnerResponse) {
+				s.Require().Equal(1, len(res.Tokens))
+			},
+		},
+		"no nfts": {
+			contractID: s.contractID,
+			owner:      s.stranger,
+			valid:      true,
+			postTest: func(res *collection.QueryNFTsByOwnerResponse) {
+				s.Require().Empty(res.Tokens)
+			},
+		},
+		"invalid contract id": {
+			owner: s.customer,
+		},
+		"invalid owner": {
+			contractID: s.contractID,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			req := &collection.QueryNFTsByOwnerRequest{
+				ContractId: tc.contractID,
+				Owner:      tc.owner.String(),
+				Pagination: tc.pagination,
+			}
+			res, err := s.queryServer.NFTsByOwner(s.goCtx, req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+			tc.postTest(res)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryNFTsByClass() {
+	// empty request
+	_, err := s.queryServer.NFTsByClass(s.goCtx, nil)
+	s.Require().Error(err)
+
+	testCases := map[string]struct {
+		contractID string
+		classID    string
+		pagination *query.PageRequest
+		valid      bool
+		postTest   func(res *collection.QueryNFTsByClassResponse)
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			classID:    s.nftClassID,
+			valid:      true,
+			postTest: func(res *collection.QueryNFTsByClassResponse) {
+				s.Require().Equal(3*s.numNFTs, len(res.Tokens))
+				for i, token := range res.Tokens {
+					s.Require().Equal(collection.NewNFTID(s.nftClassID, i+1), token.TokenId)
+				}
+			},
+		},
+		"valid request with limit": {
+			contractID: s.contractID,
+			classID:    s.nftClassID,
+			pagination: &query.PageRequest{
+				Limit: 1,
+			},
+			valid: true,
+			postTest: func(res *collection.QueryNFTsByClassResponse) {
+				s.Require().Equal(1, len(res.Tokens))
+			},
+		},
+		"class not found": {
+			contractID: s.contractID,
+			classID:    "deadbeef",
+			valid:      true,
+			postTest: func(res *collection.QueryNFTsByClassResponse) {
+				s.Require().Empty(res.Tokens)
+			},
+		},
+		"invalid contract id": {
+			classID: s.nftClassID,
+		},
+		"invalid class id": {
+			contractID: s.contractID,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			req := &collection.QueryNFTsByClassRequest{
+				ContractId: tc.contractID,
+				ClassId:    tc.classID,
+				Pagination: tc.pagination,
+			}
+			res, err := s.queryServer.NFTsByClass(s.goCtx, req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+			tc.postTest(res)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryOwnersOfClass() {
+	// empty request
+	_, err := s.queryServer.OwnersOfClass(s.goCtx, nil)
+	s.Require().Error(err)
+
+	testCases := map[string]struct {
+		contractID string
+		classID    string
+		pagination *query.PageRequest
+		valid      bool
+		postTest   func(res *collection.QueryOwnersOfClassResponse)
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			classID:    s.nftClassID,
+			valid:      true,
+			postTest: func(res *collection.QueryOwnersOfClassResponse) {
+				expected := []string{s.customer.String(), s.operator.String(), s.vendor.String()}
+				s.Require().ElementsMatch(expected, res.Owners)
+			},
+		},
+		"valid request with limit": {
+			contractID: s.contractID,
+			classID:    s.nftClassID,
+			pagination: &query.PageRequest{
+				Limit: 1,
+			},
+			valid: true,
+			postTest: func(res *collection.QueryOwnersOfClassResponse) {
+				s.Require().Equal(1, len(res.Owners))
+			},
+		},
+		"class not found": {
+			contractID: s.contractID,
+			classID:    "deadbeef",
+			valid:      true,
+			postTest: func(res *collection.QueryOwnersOfClassResponse) {
+				s.Require().Empty(res.Owners)
+			},
+		},
+		"invalid contract id": {
+			classID: s.nftClassID,
+		},
+		"invalid class id": {
+			contractID: s.contractID,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			req := &collection.QueryOwnersOfClassRequest{
+				ContractId: tc.contractID,
+				ClassId:    tc.classID,
+				Pagination: tc.pagination,
+			}
+			res, err := s.queryServer.OwnersOfClass(s.goCtx, req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+			tc.postTest(res)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestQueryRoot() {
 	// empty request
 	_, err := s.queryServer.Root(s.goCtx, nil)
@@ -1232,3 +1545,128 @@ func (s *KeeperTestSuite) TestQueryHoldersByOperator() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestQueryRoyalty() {
+	// empty request
+	_, err := s.queryServer.Royalty(s.goCtx, nil)
+	s.Require().Error(err)
+
+	testCases := map[string]struct {
+		contractID string
+		classID    string
+		valid      bool
+		postTest   func(res *collection.QueryRoyaltyResponse)
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			valid:      true,
+			postTest: func(res *collection.QueryRoyaltyResponse) {
+				s.Require().Equal("", res.Royalty.ClassId)
+				s.Require().Equal(s.vendor.String(), res.Royalty.Recipient)
+				s.Require().Equal(sdk.NewDecWithPrec(1, 1), res.Royalty.Rate)
+			},
+		},
+		"valid request (fallback to the default)": {
+			contractID: s.contractID,
+			classID:    s.nftClassID,
+			valid:      true,
+			postTest: func(res *collection.QueryRoyaltyResponse) {
+				s.Require().Equal("", res.Royalty.ClassId)
+				s.Require().Equal(s.vendor.String(), res.Royalty.Recipient)
+			},
+		},
+		"invalid contract id": {
+			classID: s.nftClassID,
+		},
+		"invalid class id": {
+			contractID: s.contractID,
+			classID:    "invalid",
+		},
+		"royalty not found": {
+			contractID: "deadbeef",
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			req := &collection.QueryRoyaltyRequest{
+				ContractId: tc.contractID,
+				ClassId:    tc.classID,
+			}
+			res, err := s.queryServer.Royalty(s.goCtx, req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+			tc.postTest(res)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryRoyalties() {
+	// empty request
+	_, err := s.queryServer.Royalties(s.goCtx, nil)
+	s.Require().Error(err)
+
+	ctx, _ := s.ctx.CacheContext()
+	err = s.keeper.SetRoyalty(ctx, s.contractID, collection.Royalty{
+		ClassId:   s.nftClassID,
+		Recipient: s.operator.String(),
+		Rate:      sdk.NewDecWithPrec(5, 2),
+	})
+	s.Require().NoError(err)
+
+	testCases := map[string]struct {
+		contractID string
+		pagination *query.PageRequest
+		valid      bool
+		postTest   func(res *collection.QueryRoyaltiesResponse)
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			valid:      true,
+			postTest: func(res *collection.QueryRoyaltiesResponse) {
+				s.Require().Equal(2, len(res.Royalties))
+				s.Require().Equal("", res.Royalties[0].ClassId)
+				s.Require().Equal(s.nftClassID, res.Royalties[1].ClassId)
+			},
+		},
+		"valid request with limit": {
+			contractID: s.contractID,
+			pagination: &query.PageRequest{
+				Limit: 1,
+			},
+			valid: true,
+			postTest: func(res *collection.QueryRoyaltiesResponse) {
+				s.Require().Equal(1, len(res.Royalties))
+			},
+		},
+		"collection not found": {
+			contractID: "deadbeef",
+			valid:      true,
+			postTest: func(res *collection.QueryRoyaltiesResponse) {
+				s.Require().Equal(0, len(res.Royalties))
+			},
+		},
+		"invalid contract id": {},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			req := &collection.QueryRoyaltiesRequest{
+				ContractId: tc.contractID,
+				Pagination: tc.pagination,
+			}
+			res, err := s.queryServer.Royalties(sdk.WrapSDKContext(ctx), req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+			tc.postTest(res)
+		})
+	}
+}
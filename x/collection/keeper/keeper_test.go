@@ -10,6 +10,7 @@ import (
 	"github.com/Finschia/finschia-sdk/crypto/keys/secp256k1"
 	"github.com/Finschia/finschia-sdk/simapp"
 	sdk "github.com/Finschia/finschia-sdk/types"
+	bankkeeper "github.com/Finschia/finschia-sdk/x/bank/keeper"
 	"github.com/Finschia/finschia-sdk/x/collection"
 	"github.com/Finschia/finschia-sdk/x/collection/keeper"
 )
@@ -22,6 +23,7 @@ type KeeperTestSuite struct {
 	keeper      keeper.Keeper
 	queryServer collection.QueryServer
 	msgServer   collection.MsgServer
+	bankKeeper  bankkeeper.Keeper
 
 	vendor   sdk.AccAddress
 	operator sdk.AccAddress
@@ -64,6 +66,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	s.ctx = app.BaseApp.NewContext(checkTx, tmproto.Header{})
 	s.goCtx = sdk.WrapSDKContext(s.ctx)
 	s.keeper = app.CollectionKeeper
+	s.bankKeeper = app.BankKeeper
 
 	s.queryServer = keeper.NewQueryServer(s.keeper)
 	s.msgServer = keeper.NewMsgServer(s.keeper)
@@ -170,6 +173,19 @@ func (s *KeeperTestSuite) SetupTest() {
 	err = s.keeper.AuthorizeOperator(s.ctx, s.contractID, s.customer, s.stranger)
 	s.Require().NoError(err)
 
+	// set the default royalty of the contract
+	err = s.keeper.SetRoyalty(s.ctx, s.contractID, collection.Royalty{
+		Recipient: s.vendor.String(),
+		Rate:      sdk.NewDecWithPrec(1, 1),
+	})
+	s.Require().NoError(err)
+
+	// fund the accounts to buy nfts
+	for _, address := range []sdk.AccAddress{s.customer, s.operator} {
+		err := simapp.FundAccount(app, s.ctx, address, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, s.balance)))
+		s.Require().NoError(err)
+	}
+
 	// not token contract
 	notTokenContractID := app.ClassKeeper.NewID(s.ctx)
 	err = keeper.ValidateLegacyContract(s.keeper, s.ctx, notTokenContractID)
@@ -12,12 +12,16 @@ var (
 	classKeyPrefix       = []byte{0x11}
 	nextClassIDKeyPrefix = []byte{0x12}
 	nextTokenIDKeyPrefix = []byte{0x13}
+	royaltyKeyPrefix     = []byte{0x14}
 
-	balanceKeyPrefix = []byte{0x20}
-	ownerKeyPrefix   = []byte{0x21}
-	nftKeyPrefix     = []byte{0x22}
-	parentKeyPrefix  = []byte{0x23}
-	childKeyPrefix   = []byte{0x24}
+	balanceKeyPrefix    = []byte{0x20}
+	ownerKeyPrefix      = []byte{0x21}
+	nftKeyPrefix        = []byte{0x22}
+	parentKeyPrefix     = []byte{0x23}
+	childKeyPrefix      = []byte{0x24}
+	traitKeyPrefix      = []byte{0x25}
+	ownedNFTKeyPrefix   = []byte{0x26}
+	classOwnerKeyPrefix = []byte{0x27}
 
 	authorizationKeyPrefix = []byte{0x30}
 	grantKeyPrefix         = []byte{0x31}
@@ -113,6 +117,90 @@ func ownerKeyPrefixByContractID(contractID string) []byte {
 	return key
 }
 
+// ownedNFTKey returns the key indexing the nft by its owner.
+func ownedNFTKey(contractID string, owner sdk.AccAddress, tokenID string) []byte {
+	prefix := ownedNFTKeyPrefixByOwner(contractID, owner)
+	key := make([]byte, len(prefix)+len(tokenID))
+
+	copy(key, prefix)
+	copy(key[len(prefix):], tokenID)
+
+	return key
+}
+
+func ownedNFTKeyPrefixByOwner(contractID string, owner sdk.AccAddress) []byte {
+	prefix := ownedNFTKeyPrefixByContractID(contractID)
+	key := make([]byte, len(prefix)+1+len(owner))
+
+	begin := 0
+	copy(key, prefix)
+
+	begin += len(prefix)
+	key[begin] = byte(len(owner))
+
+	begin++
+	copy(key[begin:], owner)
+
+	return key
+}
+
+func ownedNFTKeyPrefixByContractID(contractID string) []byte {
+	key := make([]byte, len(ownedNFTKeyPrefix)+1+len(contractID))
+
+	begin := 0
+	copy(key, ownedNFTKeyPrefix)
+
+	begin += len(ownedNFTKeyPrefix)
+	key[begin] = byte(len(contractID))
+
+	begin++
+	copy(key[begin:], contractID)
+
+	return key
+}
+
+// classOwnerKey returns the key of the number of nfts of the class held by the owner.
+func classOwnerKey(contractID, classID string, owner sdk.AccAddress) []byte {
+	prefix := classOwnerKeyPrefixByClassID(contractID, classID)
+	key := make([]byte, len(prefix)+len(owner))
+
+	copy(key, prefix)
+	copy(key[len(prefix):], owner)
+
+	return key
+}
+
+func classOwnerKeyPrefixByClassID(contractID, classID string) []byte {
+	prefix := classOwnerKeyPrefixByContractID(contractID)
+	key := make([]byte, len(prefix)+1+len(classID))
+
+	begin := 0
+	copy(key, prefix)
+
+	begin += len(prefix)
+	key[begin] = byte(len(classID))
+
+	begin++
+	copy(key[begin:], classID)
+
+	return key
+}
+
+func classOwnerKeyPrefixByContractID(contractID string) []byte {
+	key := make([]byte, len(classOwnerKeyPrefix)+1+len(contractID))
+
+	begin := 0
+	copy(key, classOwnerKeyPrefix)
+
+	begin += len(classOwnerKeyPrefix)
+	key[begin] = byte(len(contractID))
+
+	begin++
+	copy(key[begin:], contractID)
+
+	return key
+}
+
 // ----------------------------------------------------------------------------
 // nft
 func nftKey(contractID, tokenID string) []byte {
@@ -247,6 +335,61 @@ func splitChildKey(key []byte) (contractID, tokenID, childID string) {
 	return
 }
 
+// ----------------------------------------------------------------------------
+// trait
+func traitKey(contractID, classID string, trait collection.Trait, tokenID string) []byte {
+	prefix := traitKeyPrefixByValue(contractID, classID, trait.Key, trait.Value)
+	key := make([]byte, len(prefix)+len(tokenID))
+
+	copy(key, prefix)
+	copy(key[len(prefix):], tokenID)
+
+	return key
+}
+
+func traitKeyPrefixByValue(contractID, classID, traitKey, value string) []byte {
+	prefix := traitKeyPrefixByContractID(contractID)
+	key := make([]byte, len(prefix)+1+len(classID)+1+len(traitKey)+1+len(value))
+
+	begin := 0
+	copy(key, prefix)
+
+	begin += len(prefix)
+	key[begin] = byte(len(classID))
+
+	begin++
+	copy(key[begin:], classID)
+
+	begin += len(classID)
+	key[begin] = byte(len(traitKey))
+
+	begin++
+	copy(key[begin:], traitKey)
+
+	begin += len(traitKey)
+	key[begin] = byte(len(value))
+
+	begin++
+	copy(key[begin:], value)
+
+	return key
+}
+
+func traitKeyPrefixByContractID(contractID string) []byte {
+	key := make([]byte, len(traitKeyPrefix)+1+len(contractID))
+
+	begin := 0
+	copy(key, traitKeyPrefix)
+
+	begin += len(traitKeyPrefix)
+	key[begin] = byte(len(contractID))
+
+	begin++
+	copy(key[begin:], contractID)
+
+	return key
+}
+
 // ----------------------------------------------------------------------------
 func contractKey(contractID string) []byte {
 	key := make([]byte, len(contractKeyPrefix)+len(contractID))
@@ -327,6 +470,33 @@ func nextClassIDKey(contractID string) []byte {
 	return key
 }
 
+// royaltyKey returns the key of the royalty of the class.
+// The contract-wide default royalty is stored under the empty class id.
+func royaltyKey(contractID, classID string) []byte {
+	prefix := royaltyKeyPrefixByContractID(contractID)
+	key := make([]byte, len(prefix)+len(classID))
+
+	copy(key, prefix)
+	copy(key[len(prefix):], classID)
+
+	return key
+}
+
+func royaltyKeyPrefixByContractID(contractID string) []byte {
+	key := make([]byte, len(royaltyKeyPrefix)+1+len(contractID))
+
+	begin := 0
+	copy(key, royaltyKeyPrefix)
+
+	begin += len(royaltyKeyPrefix)
+	key[begin] = byte(len(contractID))
+
+	begin++
+	copy(key[begin:], contractID)
+
+	return key
+}
+
 // ----------------------------------------------------------------------------
 func authorizationKey(contractID string, operator, holder sdk.AccAddress) []byte {
 	prefix := authorizationKeyPrefixByOperator(contractID, operator)
@@ -5,6 +5,7 @@ import (
 	"github.com/Finschia/finschia-sdk/types/module"
 	"github.com/Finschia/finschia-sdk/x/collection"
 	v2 "github.com/Finschia/finschia-sdk/x/collection/keeper/migrations/v2"
+	v3 "github.com/Finschia/finschia-sdk/x/collection/keeper/migrations/v3"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -22,6 +23,9 @@ func (m Migrator) Register(register func(moduleName string, fromVersion uint64,
 		1: func(ctx sdk.Context) error {
 			return v2.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
 		},
+		2: func(ctx sdk.Context) error {
+			return v3.MigrateStore(ctx, m.keeper.storeKey)
+		},
 	} {
 		if err := register(collection.ModuleName, fromVersion, handler); err != nil {
 			return err
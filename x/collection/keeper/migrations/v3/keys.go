@@ -0,0 +1,129 @@
+package v3
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+)
+
+var (
+	ownerKeyPrefix      = []byte{0x21}
+	ownedNFTKeyPrefix   = []byte{0x26}
+	classOwnerKeyPrefix = []byte{0x27}
+)
+
+func OwnerKey(contractID, tokenID string) []byte {
+	prefix := ownerKeyPrefixByContractID(contractID)
+	key := make([]byte, len(prefix)+len(tokenID))
+
+	copy(key, prefix)
+	copy(key[len(prefix):], tokenID)
+
+	return key
+}
+
+func ownerKeyPrefixByContractID(contractID string) []byte {
+	key := make([]byte, len(ownerKeyPrefix)+1+len(contractID))
+
+	begin := 0
+	copy(key, ownerKeyPrefix)
+
+	begin += len(ownerKeyPrefix)
+	key[begin] = byte(len(contractID))
+
+	begin++
+	copy(key[begin:], contractID)
+
+	return key
+}
+
+func splitOwnerKey(key []byte) (contractID, tokenID string) {
+	begin := len(ownerKeyPrefix) + 1
+	end := begin + int(key[begin-1])
+	contractID = string(key[begin:end])
+
+	begin = end
+	tokenID = string(key[begin:])
+
+	return
+}
+
+func OwnedNFTKey(contractID string, owner sdk.AccAddress, tokenID string) []byte {
+	prefix := ownedNFTKeyPrefixByOwner(contractID, owner)
+	key := make([]byte, len(prefix)+len(tokenID))
+
+	copy(key, prefix)
+	copy(key[len(prefix):], tokenID)
+
+	return key
+}
+
+func ownedNFTKeyPrefixByOwner(contractID string, owner sdk.AccAddress) []byte {
+	prefix := ownedNFTKeyPrefixByContractID(contractID)
+	key := make([]byte, len(prefix)+1+len(owner))
+
+	begin := 0
+	copy(key, prefix)
+
+	begin += len(prefix)
+	key[begin] = byte(len(owner))
+
+	begin++
+	copy(key[begin:], owner)
+
+	return key
+}
+
+func ownedNFTKeyPrefixByContractID(contractID string) []byte {
+	key := make([]byte, len(ownedNFTKeyPrefix)+1+len(contractID))
+
+	begin := 0
+	copy(key, ownedNFTKeyPrefix)
+
+	begin += len(ownedNFTKeyPrefix)
+	key[begin] = byte(len(contractID))
+
+	begin++
+	copy(key[begin:], contractID)
+
+	return key
+}
+
+func ClassOwnerKey(contractID, classID string, owner sdk.AccAddress) []byte {
+	prefix := classOwnerKeyPrefixByClassID(contractID, classID)
+	key := make([]byte, len(prefix)+len(owner))
+
+	copy(key, prefix)
+	copy(key[len(prefix):], owner)
+
+	return key
+}
+
+func classOwnerKeyPrefixByClassID(contractID, classID string) []byte {
+	prefix := classOwnerKeyPrefixByContractID(contractID)
+	key := make([]byte, len(prefix)+1+len(classID))
+
+	begin := 0
+	copy(key, prefix)
+
+	begin += len(prefix)
+	key[begin] = byte(len(classID))
+
+	begin++
+	copy(key[begin:], classID)
+
+	return key
+}
+
+func classOwnerKeyPrefixByContractID(contractID string) []byte {
+	key := make([]byte, len(classOwnerKeyPrefix)+1+len(contractID))
+
+	begin := 0
+	copy(key, classOwnerKeyPrefix)
+
+	begin += len(classOwnerKeyPrefix)
+	key[begin] = byte(len(contractID))
+
+	begin++
+	copy(key[begin:], contractID)
+
+	return key
+}
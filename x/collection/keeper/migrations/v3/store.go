@@ -0,0 +1,61 @@
+package v3
+
+import (
+	storetypes "github.com/Finschia/finschia-sdk/store/types"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/collection"
+)
+
+// MigrateStore performs in-place store migrations from v2 to v3.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey) error {
+	store := ctx.KVStore(storeKey)
+
+	// build the ownership indexes
+	if err := indexOwners(store); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func indexOwners(store storetypes.KVStore) error {
+	iterator := sdk.KVStorePrefixIterator(store, ownerKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		contractID, tokenID := splitOwnerKey(iterator.Key())
+
+		var owner sdk.AccAddress
+		if err := owner.Unmarshal(iterator.Value()); err != nil {
+			return err
+		}
+
+		store.Set(OwnedNFTKey(contractID, owner, tokenID), []byte{})
+
+		classID := collection.SplitTokenID(tokenID)
+		if err := incrClassOwnerCount(store, contractID, classID, owner); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func incrClassOwnerCount(store storetypes.KVStore, contractID, classID string, owner sdk.AccAddress) error {
+	key := ClassOwnerKey(contractID, classID, owner)
+
+	count := sdk.ZeroUint()
+	if bz := store.Get(key); bz != nil {
+		if err := count.Unmarshal(bz); err != nil {
+			return err
+		}
+	}
+
+	bz, err := count.Incr().Marshal()
+	if err != nil {
+		return err
+	}
+	store.Set(key, bz)
+
+	return nil
+}
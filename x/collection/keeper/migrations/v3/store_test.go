@@ -0,0 +1,89 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/finschia-sdk/testutil"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/collection"
+	"github.com/Finschia/finschia-sdk/x/collection/keeper/migrations/v3"
+)
+
+func TestMigrateStore(t *testing.T) {
+	collectionKey := sdk.NewKVStoreKey(collection.StoreKey)
+	newKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(collectionKey, newKey)
+
+	// set state
+	store := ctx.KVStore(collectionKey)
+
+	contractID := "deadbeef"
+	classID := "10000001"
+	fennec := sdk.AccAddress("fennec")
+	penguin := sdk.AccAddress("penguin")
+	owners := map[string]sdk.AccAddress{
+		collection.NewNFTID(classID, 1): fennec,
+		collection.NewNFTID(classID, 2): fennec,
+		collection.NewNFTID(classID, 3): penguin,
+	}
+	for tokenID, owner := range owners {
+		bz, err := owner.Marshal()
+		require.NoError(t, err)
+		store.Set(v3.OwnerKey(contractID, tokenID), bz)
+	}
+
+	for name, tc := range map[string]struct {
+		malleate func(ctx sdk.Context)
+		valid    bool
+		counts   map[string]uint64
+	}{
+		"valid": {
+			valid: true,
+			counts: map[string]uint64{
+				fennec.String():  2,
+				penguin.String(): 1,
+			},
+		},
+		"count unmarshal failed": {
+			malleate: func(ctx sdk.Context) {
+				store := ctx.KVStore(collectionKey)
+				store.Set(v3.ClassOwnerKey(contractID, classID, fennec), []byte("invalid"))
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			if tc.malleate != nil {
+				tc.malleate(ctx)
+			}
+
+			// migrate
+			err := v3.MigrateStore(ctx, collectionKey)
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			store := ctx.KVStore(collectionKey)
+
+			// nfts by owner
+			for tokenID, owner := range owners {
+				require.True(t, store.Has(v3.OwnedNFTKey(contractID, owner, tokenID)))
+			}
+
+			// owners of class
+			for _, owner := range []sdk.AccAddress{fennec, penguin} {
+				bz := store.Get(v3.ClassOwnerKey(contractID, classID, owner))
+				require.NotNil(t, bz)
+
+				var count sdk.Uint
+				err := count.Unmarshal(bz)
+				require.NoError(t, err)
+				require.Equal(t, tc.counts[owner.String()], count.Uint64())
+			}
+		})
+	}
+}
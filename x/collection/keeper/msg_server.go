@@ -10,6 +10,8 @@ import (
 	"github.com/Finschia/finschia-sdk/x/collection"
 )
 
+const gasCostPerIteration = uint64(20)
+
 type msgServer struct {
 	keeper Keeper
 }
@@ -100,15 +102,9 @@ func (s msgServer) SendNFT(c context.Context, req *collection.MsgSendNFT) (*coll
 		amount[i] = collection.Coin{TokenId: id, Amount: sdk.OneInt()}
 
 		// legacy
-		if err := s.keeper.hasNFT(ctx, req.ContractId, id); err != nil {
+		if err := s.keeper.validateSendable(ctx, req.ContractId, fromAddr, id); err != nil {
 			return nil, err
 		}
-		if _, err := s.keeper.GetParent(ctx, req.ContractId, id); err == nil {
-			return nil, collection.ErrTokenCannotTransferChildToken.Wrap(id)
-		}
-		if !s.keeper.getOwner(ctx, req.ContractId, id).Equals(fromAddr) {
-			return nil, collection.ErrTokenNotOwnedBy.Wrapf("%s does not have %s", fromAddr, id)
-		}
 	}
 
 	toAddr := sdk.MustAccAddressFromBech32(req.To)
@@ -150,15 +146,9 @@ func (s msgServer) OperatorSendNFT(c context.Context, req *collection.MsgOperato
 		amount[i] = collection.Coin{TokenId: id, Amount: sdk.OneInt()}
 
 		// legacy
-		if err := s.keeper.hasNFT(ctx, req.ContractId, id); err != nil {
+		if err := s.keeper.validateSendable(ctx, req.ContractId, fromAddr, id); err != nil {
 			return nil, err
 		}
-		if _, err := s.keeper.GetParent(ctx, req.ContractId, id); err == nil {
-			return nil, collection.ErrTokenCannotTransferChildToken.Wrap(id)
-		}
-		if !s.keeper.getOwner(ctx, req.ContractId, id).Equals(fromAddr) {
-			return nil, collection.ErrTokenNotOwnedBy.Wrapf("%s does not have %s", fromAddr, id)
-		}
 	}
 
 	toAddr := sdk.MustAccAddressFromBech32(req.To)
@@ -181,6 +171,92 @@ func (s msgServer) OperatorSendNFT(c context.Context, req *collection.MsgOperato
 	return &collection.MsgOperatorSendNFTResponse{}, nil
 }
 
+func (s msgServer) SellNFT(c context.Context, req *collection.MsgSellNFT) (*collection.MsgSellNFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ValidateLegacyContract(s.keeper, ctx, req.ContractId); err != nil {
+		return nil, err
+	}
+
+	sellerAddr := sdk.MustAccAddressFromBech32(req.Seller)
+	buyerAddr := sdk.MustAccAddressFromBech32(req.Buyer)
+
+	royalty, royaltyAmount, err := s.keeper.SellNFT(ctx, req.ContractId, sellerAddr, buyerAddr, req.TokenId, req.Price)
+	if err != nil {
+		return nil, err
+	}
+
+	sent := collection.EventSent{
+		ContractId: req.ContractId,
+		Operator:   req.Seller,
+		From:       req.Seller,
+		To:         req.Buyer,
+		Amount:     []collection.Coin{collection.NewCoin(req.TokenId, sdk.OneInt())},
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&sent); err != nil {
+		panic(err)
+	}
+
+	event := collection.EventSoldNFT{
+		ContractId: req.ContractId,
+		Seller:     req.Seller,
+		Buyer:      req.Buyer,
+		TokenId:    req.TokenId,
+		Price:      req.Price,
+		Royalty:    royaltyAmount,
+	}
+	if royalty != nil {
+		event.RoyaltyRecipient = royalty.Recipient
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		panic(err)
+	}
+
+	return &collection.MsgSellNFTResponse{}, nil
+}
+
+func (s msgServer) BatchSend(c context.Context, req *collection.MsgBatchSend) (*collection.MsgBatchSendResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ValidateLegacyContract(s.keeper, ctx, req.ContractId); err != nil {
+		return nil, err
+	}
+
+	fromAddr := sdk.MustAccAddressFromBech32(req.From)
+
+	for _, entry := range req.Entries {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "batch send")
+
+		amount := make([]collection.Coin, 0, len(entry.Amount)+len(entry.TokenIds))
+		amount = append(amount, entry.Amount...)
+		for _, id := range entry.TokenIds {
+			if err := s.keeper.validateSendable(ctx, req.ContractId, fromAddr, id); err != nil {
+				return nil, err
+			}
+			amount = append(amount, collection.NewCoin(id, sdk.OneInt()))
+		}
+
+		toAddr := sdk.MustAccAddressFromBech32(entry.To)
+
+		if err := s.keeper.SendCoins(ctx, req.ContractId, fromAddr, toAddr, amount); err != nil {
+			return nil, err
+		}
+
+		event := collection.EventSent{
+			ContractId: req.ContractId,
+			Operator:   req.From,
+			From:       req.From,
+			To:         entry.To,
+			Amount:     amount,
+		}
+		if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+			panic(err)
+		}
+	}
+
+	return &collection.MsgBatchSendResponse{}, nil
+}
+
 func (s msgServer) AuthorizeOperator(c context.Context, req *collection.MsgAuthorizeOperator) (*collection.MsgAuthorizeOperatorResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
@@ -320,8 +396,9 @@ func (s msgServer) IssueNFT(c context.Context, req *collection.MsgIssueNFT) (*co
 	}
 
 	class := &collection.NFTClass{
-		Name: req.Name,
-		Meta: req.Meta,
+		Name:            req.Name,
+		Meta:            req.Meta,
+		NonTransferable: req.NonTransferable,
 	}
 	id, err := s.keeper.CreateTokenClass(ctx, req.ContractId, class)
 	if err != nil {
@@ -329,11 +406,12 @@ func (s msgServer) IssueNFT(c context.Context, req *collection.MsgIssueNFT) (*co
 	}
 
 	event := collection.EventCreatedNFTClass{
-		ContractId: req.ContractId,
-		Operator:   req.Owner,
-		TokenType:  *id,
-		Name:       class.Name,
-		Meta:       class.Meta,
+		ContractId:      req.ContractId,
+		Operator:        req.Owner,
+		TokenType:       *id,
+		Name:            class.Name,
+		Meta:            class.Meta,
+		NonTransferable: class.NonTransferable,
 	}
 	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
 		panic(err)
@@ -679,6 +757,35 @@ func (s msgServer) Modify(c context.Context, req *collection.MsgModify) (*collec
 	return &collection.MsgModifyResponse{}, nil
 }
 
+func (s msgServer) SetRoyalty(c context.Context, req *collection.MsgSetRoyalty) (*collection.MsgSetRoyaltyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ValidateLegacyContract(s.keeper, ctx, req.ContractId); err != nil {
+		return nil, err
+	}
+
+	operator := sdk.MustAccAddressFromBech32(req.Operator)
+
+	if _, err := s.keeper.GetGrant(ctx, req.ContractId, operator, collection.PermissionModify); err != nil {
+		return nil, collection.ErrTokenNoPermission.Wrap(err.Error())
+	}
+
+	if err := s.keeper.SetRoyalty(ctx, req.ContractId, req.Royalty); err != nil {
+		return nil, err
+	}
+
+	event := collection.EventSetRoyalty{
+		ContractId: req.ContractId,
+		Operator:   req.Operator,
+		Royalty:    req.Royalty,
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		panic(err)
+	}
+
+	return &collection.MsgSetRoyaltyResponse{}, nil
+}
+
 func (s msgServer) GrantPermission(c context.Context, req *collection.MsgGrantPermission) (*collection.MsgGrantPermissionResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
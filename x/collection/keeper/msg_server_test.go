@@ -5,6 +5,7 @@ import (
 
 	"github.com/Finschia/finschia-sdk/testutil"
 	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 	"github.com/Finschia/finschia-sdk/types/query"
 	"github.com/Finschia/finschia-sdk/x/collection"
 	"github.com/Finschia/finschia-sdk/x/token/class"
@@ -411,6 +412,187 @@ func (s *KeeperTestSuite) TestMsgOperatorSendNFT() {
 	}
 }
 
+func (s *KeeperTestSuite) TestMsgSellNFT() {
+	price := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+
+	testCases := map[string]struct {
+		contractID string
+		tokenID    string
+		price      sdk.Coins
+		royalty    sdk.Coins
+		err        error
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			tokenID:    collection.NewNFTID(s.nftClassID, 1),
+			price:      price,
+			royalty:    sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+		},
+		"contract not found": {
+			contractID: "deadbeef",
+			tokenID:    collection.NewNFTID(s.nftClassID, 1),
+			price:      price,
+			err:        class.ErrContractNotExist,
+		},
+		"not found": {
+			contractID: s.contractID,
+			tokenID:    collection.NewNFTID("deadbeef", 1),
+			price:      price,
+			err:        collection.ErrTokenNotExist,
+		},
+		"child": {
+			contractID: s.contractID,
+			tokenID:    collection.NewNFTID(s.nftClassID, 2),
+			price:      price,
+			err:        collection.ErrTokenCannotTransferChildToken,
+		},
+		"not owned by": {
+			contractID: s.contractID,
+			tokenID:    collection.NewNFTID(s.nftClassID, s.numNFTs+1),
+			price:      price,
+			err:        collection.ErrTokenNotOwnedBy,
+		},
+		"insufficient funds": {
+			contractID: s.contractID,
+			tokenID:    collection.NewNFTID(s.nftClassID, 1),
+			price:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, s.balance.AddRaw(1))),
+			err:        sdkerrors.ErrInsufficientFunds,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			req := &collection.MsgSellNFT{
+				ContractId: tc.contractID,
+				Seller:     s.customer.String(),
+				Buyer:      s.operator.String(),
+				TokenId:    tc.tokenID,
+				Price:      tc.price,
+			}
+			res, err := s.msgServer.SellNFT(sdk.WrapSDKContext(ctx), req)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+			s.Require().NotNil(res)
+
+			s.Require().Equal(s.operator, s.keeper.GetRootOwner(ctx, tc.contractID, tc.tokenID))
+
+			// the royalty goes to the vendor
+			initial := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, s.balance))
+			s.Require().True(tc.royalty.Equal(s.bankKeeper.GetAllBalances(ctx, s.vendor)))
+			s.Require().Equal(initial.Add(tc.price.Sub(tc.royalty)...), s.bankKeeper.GetAllBalances(ctx, s.customer))
+			s.Require().Equal(initial.Sub(tc.price), s.bankKeeper.GetAllBalances(ctx, s.operator))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgBatchSend() {
+	ftID := collection.NewFTID(s.ftClassID)
+	rootNFTID := collection.NewNFTID(s.nftClassID, 1)
+
+	testCases := map[string]struct {
+		contractID string
+		ftAmount   sdk.Int
+		tokenID    string
+		err        error
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			ftAmount:   sdk.OneInt(),
+			tokenID:    rootNFTID,
+		},
+		"contract not found": {
+			contractID: "deadbeef",
+			ftAmount:   sdk.OneInt(),
+			tokenID:    rootNFTID,
+			err:        class.ErrContractNotExist,
+		},
+		"child": {
+			contractID: s.contractID,
+			ftAmount:   sdk.OneInt(),
+			tokenID:    collection.NewNFTID(s.nftClassID, 2),
+			err:        collection.ErrTokenCannotTransferChildToken,
+		},
+		"not owned by": {
+			contractID: s.contractID,
+			ftAmount:   sdk.OneInt(),
+			tokenID:    collection.NewNFTID(s.nftClassID, s.numNFTs+1),
+			err:        collection.ErrTokenNotOwnedBy,
+		},
+		"insufficient funds": {
+			contractID: s.contractID,
+			ftAmount:   s.balance,
+			tokenID:    rootNFTID,
+			err:        collection.ErrInsufficientToken,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			req := &collection.MsgBatchSend{
+				ContractId: tc.contractID,
+				From:       s.customer.String(),
+				Entries: []collection.BatchSendEntry{
+					{
+						To:       s.vendor.String(),
+						Amount:   collection.NewCoins(collection.NewCoin(ftID, sdk.OneInt())),
+						TokenIds: []string{tc.tokenID},
+					},
+					{
+						To:     s.operator.String(),
+						Amount: collection.NewCoins(collection.NewCoin(ftID, tc.ftAmount)),
+					},
+				},
+			}
+			res, err := s.msgServer.BatchSend(sdk.WrapSDKContext(ctx), req)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			s.Require().NotNil(res)
+
+			// one EventSent per entry
+			var sent sdk.Events
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == "lbm.collection.v1.EventSent" {
+					sent = append(sent, event)
+				}
+			}
+			expected := sdk.Events{
+				sdk.Event{
+					Type: "lbm.collection.v1.EventSent",
+					Attributes: []abci.EventAttribute{
+						{Key: []byte("amount"), Value: testutil.MustJSONMarshal([]collection.Coin{collection.NewCoin(ftID, sdk.OneInt()), collection.NewCoin(tc.tokenID, sdk.OneInt())}), Index: false},
+						{Key: []byte("contract_id"), Value: testutil.W(s.contractID), Index: false},
+						{Key: []byte("from"), Value: testutil.W(s.customer.String()), Index: false},
+						{Key: []byte("operator"), Value: testutil.W(s.customer.String()), Index: false},
+						{Key: []byte("to"), Value: testutil.W(s.vendor.String()), Index: false},
+					},
+				},
+				sdk.Event{
+					Type: "lbm.collection.v1.EventSent",
+					Attributes: []abci.EventAttribute{
+						{Key: []byte("amount"), Value: testutil.MustJSONMarshal([]collection.Coin{collection.NewCoin(ftID, tc.ftAmount)}), Index: false},
+						{Key: []byte("contract_id"), Value: testutil.W(s.contractID), Index: false},
+						{Key: []byte("from"), Value: testutil.W(s.customer.String()), Index: false},
+						{Key: []byte("operator"), Value: testutil.W(s.customer.String()), Index: false},
+						{Key: []byte("to"), Value: testutil.W(s.operator.String()), Index: false},
+					},
+				},
+			}
+			s.Require().Equal(expected, sent)
+
+			s.Require().Equal(s.vendor, s.keeper.GetRootOwner(ctx, s.contractID, tc.tokenID))
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestMsgAuthorizeOperator() {
 	testCases := map[string]struct {
 		isNegativeCase bool
@@ -745,46 +927,57 @@ func (s *KeeperTestSuite) TestMsgIssueFT() {
 
 func (s *KeeperTestSuite) TestMsgIssueNFT() {
 	expectedTokenType := "10000002"
+	expectedEvents := func(nonTransferable bool) sdk.Events {
+		return sdk.Events{
+			sdk.Event{
+				Type: "lbm.collection.v1.EventCreatedNFTClass",
+				Attributes: []abci.EventAttribute{
+					{Key: []byte("contract_id"), Value: testutil.W(s.contractID), Index: false},
+					{Key: []byte("meta"), Value: testutil.W(""), Index: false},
+					{Key: []byte("name"), Value: testutil.W(""), Index: false},
+					{Key: []byte("non_transferable"), Value: testutil.MustJSONMarshal(nonTransferable), Index: false},
+					{Key: []byte("operator"), Value: testutil.W(s.vendor.String()), Index: false},
+					{Key: []byte("token_type"), Value: testutil.W(expectedTokenType), Index: false},
+				},
+			},
+			sdk.Event{
+				Type: "lbm.collection.v1.EventGranted",
+				Attributes: []abci.EventAttribute{
+					{Key: []byte("contract_id"), Value: testutil.W(s.contractID), Index: false},
+					{Key: []byte("grantee"), Value: testutil.W(s.vendor.String()), Index: false},
+					{Key: []byte("granter"), Value: testutil.W(""), Index: false},
+					{Key: []byte("permission"), Value: testutil.W(collection.Permission(collection.LegacyPermissionMint).String()), Index: false},
+				},
+			},
+			sdk.Event{
+				Type: "lbm.collection.v1.EventGranted",
+				Attributes: []abci.EventAttribute{
+					{Key: []byte("contract_id"), Value: testutil.W(s.contractID), Index: false},
+					{Key: []byte("grantee"), Value: testutil.W(s.vendor.String()), Index: false},
+					{Key: []byte("granter"), Value: testutil.W(""), Index: false},
+					{Key: []byte("permission"), Value: testutil.W(collection.Permission(collection.LegacyPermissionBurn).String()), Index: false},
+				},
+			},
+		}
+	}
 
 	testCases := map[string]struct {
-		contractID string
-		owner      sdk.AccAddress
-		err        error
-		events     sdk.Events
+		contractID      string
+		owner           sdk.AccAddress
+		nonTransferable bool
+		err             error
+		events          sdk.Events
 	}{
 		"valid request": {
 			contractID: s.contractID,
 			owner:      s.vendor,
-			events: sdk.Events{
-				sdk.Event{
-					Type: "lbm.collection.v1.EventCreatedNFTClass",
-					Attributes: []abci.EventAttribute{
-						{Key: []byte("contract_id"), Value: testutil.W(s.contractID), Index: false},
-						{Key: []byte("meta"), Value: testutil.W(""), Index: false},
-						{Key: []byte("name"), Value: testutil.W(""), Index: false},
-						{Key: []byte("operator"), Value: testutil.W(s.vendor.String()), Index: false},
-						{Key: []byte("token_type"), Value: testutil.W(expectedTokenType), Index: false},
-					},
-				},
-				sdk.Event{
-					Type: "lbm.collection.v1.EventGranted",
-					Attributes: []abci.EventAttribute{
-						{Key: []byte("contract_id"), Value: testutil.W(s.contractID), Index: false},
-						{Key: []byte("grantee"), Value: testutil.W(s.vendor.String()), Index: false},
-						{Key: []byte("granter"), Value: testutil.W(""), Index: false},
-						{Key: []byte("permission"), Value: testutil.W(collection.Permission(collection.LegacyPermissionMint).String()), Index: false},
-					},
-				},
-				sdk.Event{
-					Type: "lbm.collection.v1.EventGranted",
-					Attributes: []abci.EventAttribute{
-						{Key: []byte("contract_id"), Value: testutil.W(s.contractID), Index: false},
-						{Key: []byte("grantee"), Value: testutil.W(s.vendor.String()), Index: false},
-						{Key: []byte("granter"), Value: testutil.W(""), Index: false},
-						{Key: []byte("permission"), Value: testutil.W(collection.Permission(collection.LegacyPermissionBurn).String()), Index: false},
-					},
-				},
-			},
+			events:     expectedEvents(false),
+		},
+		"valid request (non-transferable)": {
+			contractID:      s.contractID,
+			owner:           s.vendor,
+			nonTransferable: true,
+			events:          expectedEvents(true),
 		},
 		"contract not found": {
 			contractID: "deadbeef",
@@ -803,8 +996,9 @@ func (s *KeeperTestSuite) TestMsgIssueNFT() {
 			ctx, _ := s.ctx.CacheContext()
 
 			req := &collection.MsgIssueNFT{
-				ContractId: tc.contractID,
-				Owner:      tc.owner.String(),
+				ContractId:      tc.contractID,
+				Owner:           tc.owner.String(),
+				NonTransferable: tc.nonTransferable,
 			}
 			res, err := s.msgServer.IssueNFT(sdk.WrapSDKContext(ctx), req)
 			s.Require().ErrorIs(err, tc.err)
@@ -814,6 +1008,10 @@ func (s *KeeperTestSuite) TestMsgIssueNFT() {
 
 			s.Require().NotNil(res)
 			s.Require().Equal(tc.events, ctx.EventManager().Events())
+
+			class, err := s.keeper.GetTokenClass(ctx, tc.contractID, res.TokenType)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.nonTransferable, class.(*collection.NFTClass).NonTransferable)
 		})
 	}
 }
@@ -1022,14 +1220,16 @@ func (s *KeeperTestSuite) TestMsgMintNFT() {
 		TokenType: s.nftClassID,
 		Name:      "tester",
 		Meta:      "Mint NFT",
+		Uri:       "ipfs://tester",
+		UriHash:   "deadbeef",
+		Traits: []collection.Trait{{
+			Key:   "level",
+			Value: "1",
+			Type:  collection.TraitTypeNumber,
+		}},
 	}}
-	expectedTokens := []collection.NFT{
-		{
-			TokenId: "1000000100000016",
-			Name:    params[0].Name,
-			Meta:    params[0].Meta,
-		},
-	}
+	// the enum value of the trait type is emitted as its name
+	expectedTokens := []byte(`[{"token_id":"1000000100000016","name":"tester","meta":"Mint NFT","uri":"ipfs://tester","uri_hash":"deadbeef","traits":[{"key":"level","value":"1","type":"TRAIT_TYPE_NUMBER"}]}]`)
 
 	testCases := map[string]struct {
 		contractID string
@@ -1049,7 +1249,7 @@ func (s *KeeperTestSuite) TestMsgMintNFT() {
 						{Key: []byte("contract_id"), Value: testutil.W(s.contractID), Index: false},
 						{Key: []byte("operator"), Value: testutil.W(s.vendor.String()), Index: false},
 						{Key: []byte("to"), Value: testutil.W(s.customer.String()), Index: false},
-						{Key: []byte("tokens"), Value: testutil.MustJSONMarshal(expectedTokens), Index: false},
+						{Key: []byte("tokens"), Value: expectedTokens, Index: false},
 					},
 				},
 			},
@@ -1094,6 +1294,21 @@ func (s *KeeperTestSuite) TestMsgMintNFT() {
 
 			s.Require().NotNil(res)
 			s.Require().Equal(tc.events, ctx.EventManager().Events())
+
+			for _, param := range tc.params {
+				for _, trait := range param.Traits {
+					res, err := s.queryServer.NFTsByTrait(sdk.WrapSDKContext(ctx), &collection.QueryNFTsByTraitRequest{
+						ContractId: tc.contractID,
+						ClassId:    param.TokenType,
+						Key:        trait.Key,
+						Value:      trait.Value,
+					})
+					s.Require().NoError(err)
+					s.Require().Len(res.Tokens, 1)
+					s.Require().Equal(param.Uri, res.Tokens[0].Uri)
+					s.Require().Equal(param.UriHash, res.Tokens[0].UriHash)
+				}
+			}
 		})
 	}
 }
@@ -1651,6 +1866,93 @@ func (s *KeeperTestSuite) TestMsgModify() {
 	}
 }
 
+func (s *KeeperTestSuite) TestMsgSetRoyalty() {
+	royalty := collection.Royalty{
+		ClassId:   s.nftClassID,
+		Recipient: s.operator.String(),
+		Rate:      sdk.NewDecWithPrec(5, 2),
+	}
+
+	testCases := map[string]struct {
+		contractID string
+		operator   sdk.AccAddress
+		royalty    collection.Royalty
+		err        error
+		events     sdk.Events
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			operator:   s.vendor,
+			royalty:    royalty,
+			events: sdk.Events{
+				sdk.Event{
+					Type: "lbm.collection.v1.EventSetRoyalty",
+					Attributes: []abci.EventAttribute{
+						{Key: []byte("contract_id"), Value: testutil.W(s.contractID), Index: false},
+						{Key: []byte("operator"), Value: testutil.W(s.vendor.String()), Index: false},
+						{Key: []byte("royalty"), Value: testutil.MustJSONMarshal(royalty), Index: false},
+					},
+				},
+			},
+		},
+		"contract not found": {
+			contractID: "deadbeef",
+			operator:   s.vendor,
+			royalty:    royalty,
+			err:        class.ErrContractNotExist,
+		},
+		"no permission": {
+			contractID: s.contractID,
+			operator:   s.customer,
+			royalty:    royalty,
+			err:        collection.ErrTokenNoPermission,
+		},
+		"class not found": {
+			contractID: s.contractID,
+			operator:   s.vendor,
+			royalty: collection.Royalty{
+				ClassId:   "deadbeef",
+				Recipient: s.operator.String(),
+				Rate:      sdk.NewDecWithPrec(5, 2),
+			},
+			err: collection.ErrTokenTypeNotExist,
+		},
+		"not a class of nft": {
+			contractID: s.contractID,
+			operator:   s.vendor,
+			royalty: collection.Royalty{
+				ClassId:   s.ftClassID,
+				Recipient: s.operator.String(),
+				Rate:      sdk.NewDecWithPrec(5, 2),
+			},
+			err: collection.ErrTokenNotNFT,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			req := &collection.MsgSetRoyalty{
+				ContractId: tc.contractID,
+				Operator:   tc.operator.String(),
+				Royalty:    tc.royalty,
+			}
+			res, err := s.msgServer.SetRoyalty(sdk.WrapSDKContext(ctx), req)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			s.Require().NotNil(res)
+			s.Require().Equal(tc.events, ctx.EventManager().Events())
+
+			stored, err := s.keeper.GetRoyalty(ctx, tc.contractID, tc.royalty.ClassId)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.royalty, *stored)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestMsgGrantPermission() {
 	testCases := map[string]struct {
 		contractID string
@@ -55,11 +55,31 @@ func (k Keeper) setNFT(ctx sdk.Context, contractID string, token collection.NFT)
 		panic(err)
 	}
 	store.Set(key, bz)
+
+	// the traits never change after the mint,
+	// so the index needs no cleanup here.
+	classID := collection.SplitTokenID(token.TokenId)
+	for _, trait := range token.Traits {
+		store.Set(traitKey(contractID, classID, trait, token.TokenId), []byte{})
+	}
 }
 
 func (k Keeper) deleteNFT(ctx sdk.Context, contractID, tokenID string) {
 	store := ctx.KVStore(k.storeKey)
 	key := nftKey(contractID, tokenID)
+	bz := store.Get(key)
+	if bz == nil {
+		return
+	}
+
+	var token collection.NFT
+	k.cdc.MustUnmarshal(bz, &token)
+
+	classID := collection.SplitTokenID(tokenID)
+	for _, trait := range token.Traits {
+		store.Delete(traitKey(contractID, classID, trait, tokenID))
+	}
+
 	store.Delete(key)
 }
 
@@ -77,6 +97,37 @@ func (k Keeper) pruneNFT(ctx sdk.Context, contractID, tokenID string) []string {
 	return burnt
 }
 
+// validateTransferable returns an error if the nft belongs to a
+// non-transferable class.
+func (k Keeper) validateTransferable(ctx sdk.Context, contractID, tokenID string) error {
+	class, err := k.GetTokenClass(ctx, contractID, collection.SplitTokenID(tokenID))
+	if err != nil {
+		return err
+	}
+
+	if nftClass, ok := class.(*collection.NFTClass); ok && nftClass.NonTransferable {
+		return collection.ErrTokenNotTransferable.Wrap(tokenID)
+	}
+
+	return nil
+}
+
+// validateSendable returns an error if the nft cannot be sent by the
+// given owner.
+func (k Keeper) validateSendable(ctx sdk.Context, contractID string, owner sdk.AccAddress, tokenID string) error {
+	if err := k.hasNFT(ctx, contractID, tokenID); err != nil {
+		return err
+	}
+	if _, err := k.GetParent(ctx, contractID, tokenID); err == nil {
+		return collection.ErrTokenCannotTransferChildToken.Wrap(tokenID)
+	}
+	if !k.getOwner(ctx, contractID, tokenID).Equals(owner) {
+		return collection.ErrTokenNotOwnedBy.Wrapf("%s does not have %s", owner, tokenID)
+	}
+
+	return k.validateTransferable(ctx, contractID, tokenID)
+}
+
 func (k Keeper) Attach(ctx sdk.Context, contractID string, owner sdk.AccAddress, subject, target string) error {
 	// validate subject
 	if err := k.hasNFT(ctx, contractID, subject); err != nil {
@@ -91,6 +142,11 @@ func (k Keeper) Attach(ctx sdk.Context, contractID string, owner sdk.AccAddress,
 		return collection.ErrTokenNotOwnedBy.Wrapf("%s is not owner of %s", owner, subject)
 	}
 
+	// the subject would move along with the root of the target
+	if err := k.validateTransferable(ctx, contractID, subject); err != nil {
+		return err
+	}
+
 	// validate target
 	if err := k.hasNFT(ctx, contractID, target); err != nil {
 		return err
@@ -204,6 +260,9 @@ func (k Keeper) getOwner(ctx sdk.Context, contractID, tokenID string) sdk.AccAdd
 }
 
 func (k Keeper) setOwner(ctx sdk.Context, contractID, tokenID string, owner sdk.AccAddress) {
+	// remove the previous owner from the indexes
+	k.deleteOwner(ctx, contractID, tokenID)
+
 	store := ctx.KVStore(k.storeKey)
 	key := ownerKey(contractID, tokenID)
 
@@ -212,12 +271,65 @@ func (k Keeper) setOwner(ctx sdk.Context, contractID, tokenID string, owner sdk.
 		panic(err)
 	}
 	store.Set(key, bz)
+
+	// update the indexes
+	store.Set(ownedNFTKey(contractID, owner, tokenID), []byte{})
+
+	classID := collection.SplitTokenID(tokenID)
+	k.setClassOwnerCount(ctx, contractID, classID, owner, k.getClassOwnerCount(ctx, contractID, classID, owner).Incr())
 }
 
 func (k Keeper) deleteOwner(ctx sdk.Context, contractID, tokenID string) {
 	store := ctx.KVStore(k.storeKey)
 	key := ownerKey(contractID, tokenID)
+	bz := store.Get(key)
+	if bz == nil {
+		return
+	}
+
+	var owner sdk.AccAddress
+	if err := owner.Unmarshal(bz); err != nil {
+		panic(err)
+	}
 	store.Delete(key)
+
+	// update the indexes
+	store.Delete(ownedNFTKey(contractID, owner, tokenID))
+
+	classID := collection.SplitTokenID(tokenID)
+	k.setClassOwnerCount(ctx, contractID, classID, owner, k.getClassOwnerCount(ctx, contractID, classID, owner).Decr())
+}
+
+// getClassOwnerCount returns the number of nfts of the class held by the owner.
+func (k Keeper) getClassOwnerCount(ctx sdk.Context, contractID, classID string, owner sdk.AccAddress) sdk.Uint {
+	store := ctx.KVStore(k.storeKey)
+	key := classOwnerKey(contractID, classID, owner)
+	bz := store.Get(key)
+	if bz == nil {
+		return sdk.ZeroUint()
+	}
+
+	var count sdk.Uint
+	if err := count.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return count
+}
+
+func (k Keeper) setClassOwnerCount(ctx sdk.Context, contractID, classID string, owner sdk.AccAddress, count sdk.Uint) {
+	store := ctx.KVStore(k.storeKey)
+	key := classOwnerKey(contractID, classID, owner)
+
+	if count.IsZero() {
+		store.Delete(key)
+		return
+	}
+
+	bz, err := count.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(key, bz)
 }
 
 func (k Keeper) GetParent(ctx sdk.Context, contractID, tokenID string) (*string, error) {
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	sdk "github.com/Finschia/finschia-sdk/types"
 	"github.com/Finschia/finschia-sdk/x/collection"
 )
 
@@ -140,3 +141,84 @@ func (s *KeeperTestSuite) TestGetNFT() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestNonTransferable() {
+	ctx, _ := s.ctx.CacheContext()
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	// issue a non-transferable class and mint its tokens to the customer
+	res, err := s.msgServer.IssueNFT(goCtx, &collection.MsgIssueNFT{
+		ContractId:      s.contractID,
+		Owner:           s.vendor.String(),
+		NonTransferable: true,
+	})
+	s.Require().NoError(err)
+	classID := res.TokenType
+
+	params := make([]collection.MintNFTParam, 3)
+	for i := range params {
+		params[i] = collection.MintNFTParam{TokenType: classID}
+	}
+	tokens, err := s.keeper.MintNFT(ctx, s.contractID, s.customer, params)
+	s.Require().NoError(err)
+	tokenID := tokens[0].TokenId
+
+	tokenType, err := s.queryServer.TokenType(goCtx, &collection.QueryTokenTypeRequest{
+		ContractId: s.contractID,
+		TokenType:  classID,
+	})
+	s.Require().NoError(err)
+	s.Require().True(tokenType.TokenType.NonTransferable)
+
+	// transfers are rejected
+	_, err = s.msgServer.SendNFT(goCtx, &collection.MsgSendNFT{
+		ContractId: s.contractID,
+		From:       s.customer.String(),
+		To:         s.vendor.String(),
+		TokenIds:   []string{tokenID},
+	})
+	s.Require().ErrorIs(err, collection.ErrTokenNotTransferable)
+
+	_, err = s.msgServer.OperatorSendNFT(goCtx, &collection.MsgOperatorSendNFT{
+		ContractId: s.contractID,
+		Operator:   s.operator.String(),
+		From:       s.customer.String(),
+		To:         s.vendor.String(),
+		TokenIds:   []string{tokenID},
+	})
+	s.Require().ErrorIs(err, collection.ErrTokenNotTransferable)
+
+	_, err = s.msgServer.SellNFT(goCtx, &collection.MsgSellNFT{
+		ContractId: s.contractID,
+		Seller:     s.customer.String(),
+		Buyer:      s.operator.String(),
+		TokenId:    tokenID,
+	})
+	s.Require().ErrorIs(err, collection.ErrTokenNotTransferable)
+
+	// it cannot be attached, because it would move along with the root
+	err = s.keeper.Attach(ctx, s.contractID, s.customer, tokenID, collection.NewNFTID(s.nftClassID, s.depthLimit+1))
+	s.Require().ErrorIs(err, collection.ErrTokenNotTransferable)
+
+	// while it can be a target of attach
+	err = s.keeper.Attach(ctx, s.contractID, s.customer, collection.NewNFTID(s.nftClassID, s.depthLimit+1), tokenID)
+	s.Require().NoError(err)
+
+	// burn by the owner
+	s.keeper.Grant(ctx, s.contractID, s.vendor, s.customer, collection.PermissionBurn)
+	_, err = s.msgServer.BurnNFT(goCtx, &collection.MsgBurnNFT{
+		ContractId: s.contractID,
+		From:       s.customer.String(),
+		TokenIds:   []string{tokens[1].TokenId},
+	})
+	s.Require().NoError(err)
+
+	// burn by the operator with the burn permission
+	_, err = s.msgServer.OperatorBurnNFT(goCtx, &collection.MsgOperatorBurnNFT{
+		ContractId: s.contractID,
+		Operator:   s.operator.String(),
+		From:       s.customer.String(),
+		TokenIds:   []string{tokens[2].TokenId},
+	})
+	s.Require().NoError(err)
+}
@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/collection"
+)
+
+// SetRoyalty sets the royalty of the class, or the contract-wide default one
+// if the class id is empty. A zero rate removes the royalty.
+func (k Keeper) SetRoyalty(ctx sdk.Context, contractID string, royalty collection.Royalty) error {
+	if royalty.ClassId != "" {
+		class, err := k.GetTokenClass(ctx, contractID, royalty.ClassId)
+		if err != nil {
+			return collection.ErrTokenTypeNotExist.Wrap(royalty.ClassId)
+		}
+		if _, ok := class.(*collection.NFTClass); !ok {
+			return collection.ErrTokenNotNFT.Wrapf("not a class of non-fungible token: %s", royalty.ClassId)
+		}
+	}
+
+	if royalty.Rate.IsZero() {
+		k.deleteRoyalty(ctx, contractID, royalty.ClassId)
+		return nil
+	}
+
+	k.setRoyalty(ctx, contractID, royalty)
+
+	return nil
+}
+
+// GetRoyalty returns the royalty applied on the class. It falls back to the
+// contract-wide default royalty if the class has no royalty of its own.
+func (k Keeper) GetRoyalty(ctx sdk.Context, contractID, classID string) (*collection.Royalty, error) {
+	if royalty := k.getRoyalty(ctx, contractID, classID); royalty != nil {
+		return royalty, nil
+	}
+
+	if royalty := k.getRoyalty(ctx, contractID, ""); royalty != nil {
+		return royalty, nil
+	}
+
+	return nil, sdkerrors.ErrNotFound.Wrapf("no royalty for class %s of contract %s", classID, contractID)
+}
+
+func (k Keeper) getRoyalty(ctx sdk.Context, contractID, classID string) *collection.Royalty {
+	store := ctx.KVStore(k.storeKey)
+	key := royaltyKey(contractID, classID)
+	bz := store.Get(key)
+	if bz == nil {
+		return nil
+	}
+
+	var royalty collection.Royalty
+	k.cdc.MustUnmarshal(bz, &royalty)
+
+	return &royalty
+}
+
+func (k Keeper) setRoyalty(ctx sdk.Context, contractID string, royalty collection.Royalty) {
+	store := ctx.KVStore(k.storeKey)
+	key := royaltyKey(contractID, royalty.ClassId)
+
+	bz, err := royalty.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(key, bz)
+}
+
+func (k Keeper) deleteRoyalty(ctx sdk.Context, contractID, classID string) {
+	store := ctx.KVStore(k.storeKey)
+	key := royaltyKey(contractID, classID)
+	store.Delete(key)
+}
+
+// SellNFT transfers the nft from the seller to the buyer, who pays the price
+// in bank coins. The royalty applied on the class of the nft is deducted from
+// the price and paid to its recipient.
+func (k Keeper) SellNFT(ctx sdk.Context, contractID string, seller, buyer sdk.AccAddress, tokenID string, price sdk.Coins) (*collection.Royalty, sdk.Coins, error) {
+	if err := k.validateSendable(ctx, contractID, seller, tokenID); err != nil {
+		return nil, nil, err
+	}
+
+	remainder := price
+	var royaltyAmount sdk.Coins
+	royalty, err := k.GetRoyalty(ctx, contractID, collection.SplitTokenID(tokenID))
+	if err == nil {
+		royaltyAmount, remainder = royalty.Split(price)
+
+		if !royaltyAmount.IsZero() {
+			recipient := sdk.MustAccAddressFromBech32(royalty.Recipient)
+			if err := k.bankKeeper.SendCoins(ctx, buyer, recipient, royaltyAmount); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	if !remainder.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, buyer, seller, remainder); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	amount := []collection.Coin{collection.NewCoin(tokenID, sdk.OneInt())}
+	if err := k.SendCoins(ctx, contractID, seller, buyer, amount); err != nil {
+		return nil, nil, err
+	}
+
+	return royalty, royaltyAmount, nil
+}
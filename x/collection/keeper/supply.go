@@ -220,6 +220,9 @@ func (k Keeper) MintNFT(ctx sdk.Context, contractID string, to sdk.AccAddress, p
 			TokenId: tokenID,
 			Name:    param.Name,
 			Meta:    param.Meta,
+			Uri:     param.Uri,
+			UriHash: param.UriHash,
+			Traits:  param.Traits,
 		}
 		k.setNFT(ctx, contractID, token)
 
@@ -372,6 +375,12 @@ func (k Keeper) ModifyNFT(ctx sdk.Context, contractID, tokenID string, operator
 		collection.AttributeKeyMeta: func(meta string) {
 			token.Meta = meta
 		},
+		collection.AttributeKeyURI: func(uri string) {
+			token.Uri = uri
+		},
+		collection.AttributeKeyURIHash: func(uriHash string) {
+			token.UriHash = uriHash
+		},
 	}
 	for _, change := range changes {
 		key := collection.AttributeKeyFromString(change.Key)
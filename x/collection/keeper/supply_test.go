@@ -330,6 +330,8 @@ func (s *KeeperTestSuite) TestModifyNFT() {
 	changes := []collection.Attribute{
 		{Key: collection.AttributeKeyName.String(), Value: "fennec fox 1"},
 		{Key: collection.AttributeKeyMeta.String(), Value: "Fennec Fox 1"},
+		{Key: collection.AttributeKeyURI.String(), Value: "ipfs://fennec-fox-1"},
+		{Key: collection.AttributeKeyURIHash.String(), Value: "deadbeef"},
 	}
 
 	for tokenID, tokenDesc := range tokenDescriptions {
@@ -349,6 +351,8 @@ func (s *KeeperTestSuite) TestModifyNFT() {
 
 			s.Require().Equal(changes[0].Value, nft.Name)
 			s.Require().Equal(changes[1].Value, nft.Meta)
+			s.Require().Equal(changes[2].Value, nft.Uri)
+			s.Require().Equal(changes[3].Value, nft.UriHash)
 		})
 	}
 }
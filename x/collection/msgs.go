@@ -17,6 +17,11 @@ const (
 	uriLengthLimit  = 1000
 	metaLengthLimit = 1000
 	changesLimit    = 100
+
+	uriHashLengthLimit    = 128
+	traitKeyLengthLimit   = 64
+	traitValueLengthLimit = 128
+	traitsLimit           = 100
 )
 
 var (
@@ -169,6 +174,30 @@ func validateMeta(meta string) error {
 	return nil
 }
 
+func validateURIHash(uriHash string) error {
+	return validateStringSize(uriHash, uriHashLengthLimit, "uri hash")
+}
+
+func validateTraits(traits []Trait) error {
+	if len(traits) > traitsLimit {
+		return ErrInvalidTrait.Wrapf("the number of traits exceeds the limit: %d > %d", len(traits), traitsLimit)
+	}
+
+	seenKeys := map[string]bool{}
+	for _, trait := range traits {
+		if err := trait.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if seenKeys[trait.Key] {
+			return ErrInvalidTrait.Wrapf("duplicate keys: %s", trait.Key)
+		}
+		seenKeys[trait.Key] = true
+	}
+
+	return nil
+}
+
 func validateStringSize(str string, limit int, name string) error {
 	if length := utf8.RuneCountInString(str); length > limit {
 		return sdkerrors.ErrInvalidRequest.Wrapf("%s cannot exceed %d in length: current %d", name, limit, length)
@@ -214,6 +243,17 @@ func validateTokenClassChange(change Attribute) error {
 	return validateChange(change, validators)
 }
 
+func validateNFTChange(change Attribute) error {
+	validators := map[string]func(string) error{
+		AttributeKeyName.String():    validateName,
+		AttributeKeyMeta.String():    validateMeta,
+		AttributeKeyURI.String():     validateURI,
+		AttributeKeyURIHash.String(): validateURIHash,
+	}
+
+	return validateChange(change, validators)
+}
+
 func validateChange(change Attribute, validators map[string]func(string) error) error {
 	validator, ok := validators[change.Key]
 	if !ok {
@@ -420,6 +460,122 @@ func (m MsgOperatorSendNFT) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
 }
 
+var _ sdk.Msg = (*MsgSellNFT)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgSellNFT) ValidateBasic() error {
+	if err := ValidateContractID(m.ContractId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Seller); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid seller address: %s", m.Seller)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Buyer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid buyer address: %s", m.Buyer)
+	}
+	if m.Seller == m.Buyer {
+		return sdkerrors.ErrInvalidRequest.Wrap("seller and buyer cannot be the same")
+	}
+
+	if err := ValidateNFTID(m.TokenId); err != nil {
+		return err
+	}
+
+	if err := m.Price.Validate(); err != nil {
+		return sdkerrors.ErrInvalidCoins.Wrap(err.Error())
+	}
+	if m.Price.Empty() {
+		return sdkerrors.ErrInvalidCoins.Wrap("empty price")
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgSellNFT) GetSigners() []sdk.AccAddress {
+	seller, _ := sdk.AccAddressFromBech32(m.Seller)
+	buyer, _ := sdk.AccAddressFromBech32(m.Buyer)
+	return []sdk.AccAddress{seller, buyer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgSellNFT) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgSellNFT) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgSellNFT) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+var _ sdk.Msg = (*MsgBatchSend)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgBatchSend) ValidateBasic() error {
+	if err := ValidateContractID(m.ContractId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.From); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid from address: %s", m.From)
+	}
+
+	if len(m.Entries) == 0 {
+		return ErrEmptyField.Wrap("entries cannot be empty")
+	}
+	seenIDs := map[string]bool{}
+	for i, entry := range m.Entries {
+		if _, err := sdk.AccAddressFromBech32(entry.To); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid to address of entry %d: %s", i, entry.To)
+		}
+
+		if len(entry.Amount) == 0 && len(entry.TokenIds) == 0 {
+			return ErrEmptyField.Wrapf("entry %d has nothing to send", i)
+		}
+		if err := validateFTCoins(entry.Amount); err != nil {
+			return err
+		}
+		for _, id := range entry.TokenIds {
+			if err := ValidateNFTID(id); err != nil {
+				return err
+			}
+			if seenIDs[id] {
+				return sdkerrors.ErrInvalidRequest.Wrapf("duplicate token id: %s", id)
+			}
+			seenIDs[id] = true
+		}
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgBatchSend) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.From)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgBatchSend) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgBatchSend) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgBatchSend) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
 var _ sdk.Msg = (*MsgAuthorizeOperator)(nil)
 
 // ValidateBasic implements Msg.
@@ -737,6 +893,16 @@ func (m MsgMintNFT) ValidateBasic() error {
 		if err := validateMeta(param.Meta); err != nil {
 			return err
 		}
+
+		if err := validateURI(param.Uri); err != nil {
+			return err
+		}
+		if err := validateURIHash(param.UriHash); err != nil {
+			return err
+		}
+		if err := validateTraits(param.Traits); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -981,6 +1147,8 @@ func (m MsgModify) ValidateBasic() error {
 		} else {
 			return ErrTokenIndexWithoutType.Wrap("token index without type")
 		}
+	} else if len(m.TokenIndex) != 0 && ValidateNFTID(m.TokenType+m.TokenIndex) == nil {
+		validator = validateNFTChange
 	}
 	if len(m.Changes) == 0 {
 		return ErrEmptyChanges.Wrap("empty changes")
@@ -1039,6 +1207,46 @@ func (m MsgModify) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
 }
 
+var _ sdk.Msg = (*MsgSetRoyalty)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgSetRoyalty) ValidateBasic() error {
+	if err := ValidateContractID(m.ContractId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Operator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid operator address: %s", m.Operator)
+	}
+
+	if err := m.Royalty.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgSetRoyalty) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Operator)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgSetRoyalty) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgSetRoyalty) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgSetRoyalty) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
 var _ sdk.Msg = (*MsgGrantPermission)(nil)
 
 // ValidateBasic implements Msg.
@@ -394,6 +394,208 @@ func TestMsgOperatorSendNFT(t *testing.T) {
 	}
 }
 
+func TestMsgSellNFT(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 2)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	id := collection.NewNFTID("deadbeef", 1)
+	price := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+
+	testCases := map[string]struct {
+		contractID string
+		seller     sdk.AccAddress
+		buyer      sdk.AccAddress
+		id         string
+		price      sdk.Coins
+		err        error
+	}{
+		"valid msg": {
+			contractID: "deadbeef",
+			seller:     addrs[0],
+			buyer:      addrs[1],
+			id:         id,
+			price:      price,
+		},
+		"empty price": {
+			contractID: "deadbeef",
+			seller:     addrs[0],
+			buyer:      addrs[1],
+			id:         id,
+			err:        sdkerrors.ErrInvalidCoins,
+		},
+		"invalid contract id": {
+			seller: addrs[0],
+			buyer:  addrs[1],
+			id:     id,
+			price:  price,
+			err:    class.ErrInvalidContractID,
+		},
+		"invalid seller": {
+			contractID: "deadbeef",
+			buyer:      addrs[1],
+			id:         id,
+			price:      price,
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"invalid buyer": {
+			contractID: "deadbeef",
+			seller:     addrs[0],
+			id:         id,
+			price:      price,
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"seller as buyer": {
+			contractID: "deadbeef",
+			seller:     addrs[0],
+			buyer:      addrs[0],
+			id:         id,
+			price:      price,
+			err:        sdkerrors.ErrInvalidRequest,
+		},
+		"invalid id": {
+			contractID: "deadbeef",
+			seller:     addrs[0],
+			buyer:      addrs[1],
+			id:         "",
+			price:      price,
+			err:        collection.ErrInvalidTokenID,
+		},
+		"invalid price": {
+			contractID: "deadbeef",
+			seller:     addrs[0],
+			buyer:      addrs[1],
+			id:         id,
+			price:      sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.ZeroInt()}},
+			err:        sdkerrors.ErrInvalidCoins,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := collection.MsgSellNFT{
+				ContractId: tc.contractID,
+				Seller:     tc.seller.String(),
+				Buyer:      tc.buyer.String(),
+				TokenId:    tc.id,
+				Price:      tc.price,
+			}
+
+			require.ErrorIs(t, msg.ValidateBasic(), tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{tc.seller, tc.buyer}, msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgBatchSend(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 3)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	amount := collection.NewCoins(collection.NewFTCoin("00bab10c", sdk.OneInt()))
+	ids := []string{collection.NewNFTID("deadbeef", 1)}
+
+	testCases := map[string]struct {
+		contractID string
+		from       sdk.AccAddress
+		entries    []collection.BatchSendEntry
+		err        error
+	}{
+		"valid msg": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			entries: []collection.BatchSendEntry{
+				{To: addrs[1].String(), Amount: amount, TokenIds: ids},
+				{To: addrs[2].String(), Amount: amount},
+			},
+		},
+		"invalid contract id": {
+			from: addrs[0],
+			entries: []collection.BatchSendEntry{
+				{To: addrs[1].String(), Amount: amount},
+			},
+			err: class.ErrInvalidContractID,
+		},
+		"invalid from": {
+			contractID: "deadbeef",
+			entries: []collection.BatchSendEntry{
+				{To: addrs[1].String(), Amount: amount},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		"empty entries": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			err:        collection.ErrEmptyField,
+		},
+		"invalid to": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			entries: []collection.BatchSendEntry{
+				{To: addrs[1].String(), Amount: amount},
+				{Amount: amount},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		"empty entry": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			entries: []collection.BatchSendEntry{
+				{To: addrs[1].String()},
+			},
+			err: collection.ErrEmptyField,
+		},
+		"invalid amount": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			entries: []collection.BatchSendEntry{
+				{To: addrs[1].String(), Amount: []collection.Coin{{TokenId: collection.NewFTID("00bab10c"), Amount: sdk.ZeroInt()}}},
+			},
+			err: collection.ErrInvalidAmount,
+		},
+		"FT ids": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			entries: []collection.BatchSendEntry{
+				{To: addrs[1].String(), TokenIds: []string{collection.NewFTID("deadbeef")}},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		"duplicate token ids": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			entries: []collection.BatchSendEntry{
+				{To: addrs[1].String(), TokenIds: ids},
+				{To: addrs[2].String(), TokenIds: ids},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := collection.MsgBatchSend{
+				ContractId: tc.contractID,
+				From:       tc.from.String(),
+				Entries:    tc.entries,
+			}
+
+			require.ErrorIs(t, msg.ValidateBasic(), tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{tc.from}, msg.GetSigners())
+		})
+	}
+}
+
 func TestMsgAuthorizeOperator(t *testing.T) {
 	addrs := make([]string, 2)
 	for i := range addrs {
@@ -907,6 +1109,25 @@ func TestMsgMintNFT(t *testing.T) {
 		TokenType: "deadbeef",
 		Name:      "tibetian fox",
 		Meta:      "Tibetian Fox",
+		Uri:       "ipfs://tibetian-fox",
+		UriHash:   "deadbeef",
+		Traits: []collection.Trait{
+			{
+				Key:   "species",
+				Value: "fox",
+				Type:  collection.TraitTypeString,
+			},
+			{
+				Key:   "level",
+				Value: "1.5",
+				Type:  collection.TraitTypeNumber,
+			},
+			{
+				Key:   "rare",
+				Value: "true",
+				Type:  collection.TraitTypeBool,
+			},
+		},
 	}}
 	testCases := map[string]struct {
 		contractID string
@@ -984,6 +1205,97 @@ func TestMsgMintNFT(t *testing.T) {
 			}},
 			err: collection.ErrInvalidMetaLength,
 		},
+		"param of too long uri hash": {
+			contractID: "deadbeef",
+			operator:   addrs[0],
+			to:         addrs[1],
+			params: []collection.MintNFTParam{{
+				TokenType: "deadbeef",
+				Name:      "tibetian fox",
+				UriHash:   string(make([]rune, 129)),
+			}},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		"param of trait with empty key": {
+			contractID: "deadbeef",
+			operator:   addrs[0],
+			to:         addrs[1],
+			params: []collection.MintNFTParam{{
+				TokenType: "deadbeef",
+				Name:      "tibetian fox",
+				Traits: []collection.Trait{{
+					Value: "fox",
+					Type:  collection.TraitTypeString,
+				}},
+			}},
+			err: collection.ErrInvalidTrait,
+		},
+		"param of trait with unspecified type": {
+			contractID: "deadbeef",
+			operator:   addrs[0],
+			to:         addrs[1],
+			params: []collection.MintNFTParam{{
+				TokenType: "deadbeef",
+				Name:      "tibetian fox",
+				Traits: []collection.Trait{{
+					Key:   "species",
+					Value: "fox",
+				}},
+			}},
+			err: collection.ErrInvalidTrait,
+		},
+		"param of trait with invalid number": {
+			contractID: "deadbeef",
+			operator:   addrs[0],
+			to:         addrs[1],
+			params: []collection.MintNFTParam{{
+				TokenType: "deadbeef",
+				Name:      "tibetian fox",
+				Traits: []collection.Trait{{
+					Key:   "level",
+					Value: "one",
+					Type:  collection.TraitTypeNumber,
+				}},
+			}},
+			err: collection.ErrInvalidTrait,
+		},
+		"param of trait with invalid bool": {
+			contractID: "deadbeef",
+			operator:   addrs[0],
+			to:         addrs[1],
+			params: []collection.MintNFTParam{{
+				TokenType: "deadbeef",
+				Name:      "tibetian fox",
+				Traits: []collection.Trait{{
+					Key:   "rare",
+					Value: "yes",
+					Type:  collection.TraitTypeBool,
+				}},
+			}},
+			err: collection.ErrInvalidTrait,
+		},
+		"param of duplicate traits": {
+			contractID: "deadbeef",
+			operator:   addrs[0],
+			to:         addrs[1],
+			params: []collection.MintNFTParam{{
+				TokenType: "deadbeef",
+				Name:      "tibetian fox",
+				Traits: []collection.Trait{
+					{
+						Key:   "rare",
+						Value: "true",
+						Type:  collection.TraitTypeBool,
+					},
+					{
+						Key:   "rare",
+						Value: "false",
+						Type:  collection.TraitTypeBool,
+					},
+				},
+			}},
+			err: collection.ErrInvalidTrait,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -1350,6 +1662,31 @@ func TestMsgModify(t *testing.T) {
 			owner:      addrs[0],
 			changes:    changes,
 		},
+		"valid nft uri modification": {
+			contractID: "deadbeef",
+			tokenType:  "deadbeef",
+			tokenIndex: "deadbeef",
+			owner:      addrs[0],
+			changes: []collection.Attribute{
+				{Key: collection.AttributeKeyURI.String(), Value: "ipfs://tibetian-fox"},
+				{Key: collection.AttributeKeyURIHash.String(), Value: "deadbeef"},
+			},
+		},
+		"invalid uri hash of nft modification": {
+			contractID: "deadbeef",
+			tokenType:  "deadbeef",
+			tokenIndex: "deadbeef",
+			owner:      addrs[0],
+			changes:    []collection.Attribute{{Key: collection.AttributeKeyURIHash.String(), Value: string(make([]rune, 129))}},
+			err:        sdkerrors.ErrInvalidRequest,
+		},
+		"uri hash of token class modification": {
+			contractID: "deadbeef",
+			tokenType:  "deadbeef",
+			owner:      addrs[0],
+			changes:    []collection.Attribute{{Key: collection.AttributeKeyURIHash.String(), Value: "deadbeef"}},
+			err:        collection.ErrInvalidChangesField,
+		},
 		"invalid contract id": {
 			owner:   addrs[0],
 			changes: changes,
@@ -1414,6 +1751,113 @@ func TestMsgModify(t *testing.T) {
 	}
 }
 
+func TestMsgSetRoyalty(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 2)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := map[string]struct {
+		contractID string
+		operator   sdk.AccAddress
+		royalty    collection.Royalty
+		err        error
+	}{
+		"valid msg": {
+			contractID: "deadbeef",
+			operator:   addrs[0],
+			royalty: collection.Royalty{
+				ClassId:   "deadbeef",
+				Recipient: addrs[1].String(),
+				Rate:      sdk.NewDecWithPrec(5, 2),
+			},
+		},
+		"valid msg (contract default)": {
+			contractID: "deadbeef",
+			operator:   addrs[0],
+			royalty: collection.Royalty{
+				Recipient: addrs[1].String(),
+				Rate:      sdk.NewDecWithPrec(5, 2),
+			},
+		},
+		"valid msg (removal)": {
+			contractID: "deadbeef",
+			operator:   addrs[0],
+			royalty: collection.Royalty{
+				Rate: sdk.ZeroDec(),
+			},
+		},
+		"invalid contract id": {
+			operator: addrs[0],
+			royalty: collection.Royalty{
+				Recipient: addrs[1].String(),
+				Rate:      sdk.NewDecWithPrec(5, 2),
+			},
+			err: class.ErrInvalidContractID,
+		},
+		"invalid operator": {
+			contractID: "deadbeef",
+			royalty: collection.Royalty{
+				Recipient: addrs[1].String(),
+				Rate:      sdk.NewDecWithPrec(5, 2),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		"invalid class id": {
+			contractID: "deadbeef",
+			operator:   addrs[0],
+			royalty: collection.Royalty{
+				ClassId:   "invalid",
+				Recipient: addrs[1].String(),
+				Rate:      sdk.NewDecWithPrec(5, 2),
+			},
+			err: collection.ErrInvalidTokenType,
+		},
+		"invalid recipient": {
+			contractID: "deadbeef",
+			operator:   addrs[0],
+			royalty: collection.Royalty{
+				Rate: sdk.NewDecWithPrec(5, 2),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		"empty rate": {
+			contractID: "deadbeef",
+			operator:   addrs[0],
+			royalty: collection.Royalty{
+				Recipient: addrs[1].String(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		"rate exceeding one": {
+			contractID: "deadbeef",
+			operator:   addrs[0],
+			royalty: collection.Royalty{
+				Recipient: addrs[1].String(),
+				Rate:      sdk.NewDecWithPrec(11, 1),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := collection.MsgSetRoyalty{
+				ContractId: tc.contractID,
+				Operator:   tc.operator.String(),
+				Royalty:    tc.royalty,
+			}
+
+			require.ErrorIs(t, msg.ValidateBasic(), tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{tc.operator}, msg.GetSigners())
+		})
+	}
+}
+
 func TestMsgGrantPermission(t *testing.T) {
 	addrs := make([]sdk.AccAddress, 2)
 	for i := range addrs {
@@ -1884,6 +2328,29 @@ func TestAminoJSON(t *testing.T) {
 			"/lbm.collection.v1.MsgOperatorSendNFT",
 			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgOperatorSendNFT\",\"value\":{\"contract_id\":\"deadbeef\",\"from\":\"%s\",\"operator\":\"%s\",\"to\":\"%s\",\"token_ids\":[\"deadbeef00000001\"]}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[1].String(), addrs[0].String(), addrs[2].String()),
 		},
+		"MsgSellNFT": {
+			&collection.MsgSellNFT{
+				ContractId: contractId,
+				Seller:     addrs[0].String(),
+				Buyer:      addrs[1].String(),
+				TokenId:    tokenIds[0],
+				Price:      sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)),
+			},
+			"/lbm.collection.v1.MsgSellNFT",
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgSellNFT\",\"value\":{\"buyer\":\"%s\",\"contract_id\":\"deadbeef\",\"price\":[{\"amount\":\"1000\",\"denom\":\"stake\"}],\"seller\":\"%s\",\"token_id\":\"deadbeef00000001\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[1].String(), addrs[0].String()),
+		},
+		"MsgBatchSend": {
+			&collection.MsgBatchSend{
+				ContractId: contractId,
+				From:       addrs[0].String(),
+				Entries: []collection.BatchSendEntry{
+					{To: addrs[1].String(), Amount: ftAmount},
+					{To: addrs[2].String(), TokenIds: tokenIds},
+				},
+			},
+			"/lbm.collection.v1.MsgBatchSend",
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/collection/MsgBatchSend\",\"value\":{\"contract_id\":\"deadbeef\",\"entries\":[{\"amount\":[{\"amount\":\"1000000\",\"token_id\":\"00bab10c00000000\"}],\"to\":\"%s\"},{\"amount\":null,\"to\":\"%s\",\"token_ids\":[\"deadbeef00000001\"]}],\"from\":\"%s\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[1].String(), addrs[2].String(), addrs[0].String()),
+		},
 		"MsgAuthorizeOperator": {
 			&collection.MsgAuthorizeOperator{
 				ContractId: contractId,
@@ -2005,6 +2472,19 @@ func TestAminoJSON(t *testing.T) {
 			"/lbm.collection.v1.MsgModify",
 			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/collection/MsgModify\",\"value\":{\"changes\":[{\"key\":\"name\",\"value\":\"New test\"}],\"contract_id\":\"deadbeef\",\"owner\":\"%s\",\"token_index\":\"deadbeef\",\"token_type\":\"NewType\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String()),
 		},
+		"MsgSetRoyalty": {
+			&collection.MsgSetRoyalty{
+				ContractId: contractId,
+				Operator:   addrs[0].String(),
+				Royalty: collection.Royalty{
+					ClassId:   "deadbeef",
+					Recipient: addrs[1].String(),
+					Rate:      sdk.NewDecWithPrec(5, 2),
+				},
+			},
+			"/lbm.collection.v1.MsgSetRoyalty",
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgSetRoyalty\",\"value\":{\"contract_id\":\"deadbeef\",\"operator\":\"%s\",\"royalty\":{\"class_id\":\"deadbeef\",\"rate\":\"0.050000000000000000\",\"recipient\":\"%s\"}}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String(), addrs[1].String()),
+		},
 		"MsgGrantPermission": {
 			&collection.MsgGrantPermission{
 				ContractId: contractId,
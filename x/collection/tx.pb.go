@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	github_com_Finschia_finschia_sdk_types "github.com/Finschia/finschia-sdk/types"
+	types "github.com/Finschia/finschia-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -497,6 +498,291 @@ func (m *MsgOperatorSendNFTResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgOperatorSendNFTResponse proto.InternalMessageInfo
 
+// MsgSellNFT is the Msg/SellNFT request type.
+type MsgSellNFT struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// the address of the seller, who owns the token.
+	Seller string `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	// the address of the buyer, who pays the price.
+	Buyer string `protobuf:"bytes,3,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// the token id to sell.
+	TokenId string `protobuf:"bytes,4,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	// the price of the token, which must not be empty.
+	Price github_com_Finschia_finschia_sdk_types.Coins `protobuf:"bytes,5,rep,name=price,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coins" json:"price"`
+}
+
+func (m *MsgSellNFT) Reset()         { *m = MsgSellNFT{} }
+func (m *MsgSellNFT) String() string { return proto.CompactTextString(m) }
+func (*MsgSellNFT) ProtoMessage()    {}
+func (*MsgSellNFT) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{8}
+}
+func (m *MsgSellNFT) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSellNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSellNFT.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSellNFT) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSellNFT.Merge(m, src)
+}
+func (m *MsgSellNFT) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSellNFT) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSellNFT.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSellNFT proto.InternalMessageInfo
+
+func (m *MsgSellNFT) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
+}
+
+func (m *MsgSellNFT) GetSeller() string {
+	if m != nil {
+		return m.Seller
+	}
+	return ""
+}
+
+func (m *MsgSellNFT) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
+}
+
+func (m *MsgSellNFT) GetTokenId() string {
+	if m != nil {
+		return m.TokenId
+	}
+	return ""
+}
+
+func (m *MsgSellNFT) GetPrice() github_com_Finschia_finschia_sdk_types.Coins {
+	if m != nil {
+		return m.Price
+	}
+	return nil
+}
+
+// MsgSellNFTResponse is the Msg/SellNFT response type.
+type MsgSellNFTResponse struct {
+}
+
+func (m *MsgSellNFTResponse) Reset()         { *m = MsgSellNFTResponse{} }
+func (m *MsgSellNFTResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSellNFTResponse) ProtoMessage()    {}
+func (*MsgSellNFTResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{9}
+}
+func (m *MsgSellNFTResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSellNFTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSellNFTResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSellNFTResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSellNFTResponse.Merge(m, src)
+}
+func (m *MsgSellNFTResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSellNFTResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSellNFTResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSellNFTResponse proto.InternalMessageInfo
+
+// MsgBatchSend is the Msg/BatchSend request type.
+type MsgBatchSend struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// the address which the transfers are from.
+	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// the transfers to execute.
+	Entries []BatchSendEntry `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *MsgBatchSend) Reset()         { *m = MsgBatchSend{} }
+func (m *MsgBatchSend) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchSend) ProtoMessage()    {}
+func (*MsgBatchSend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{10}
+}
+func (m *MsgBatchSend) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchSend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchSend.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBatchSend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchSend.Merge(m, src)
+}
+func (m *MsgBatchSend) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchSend) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchSend.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBatchSend proto.InternalMessageInfo
+
+func (m *MsgBatchSend) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
+}
+
+func (m *MsgBatchSend) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *MsgBatchSend) GetEntries() []BatchSendEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+// BatchSendEntry defines a single transfer of MsgBatchSend.
+type BatchSendEntry struct {
+	// the address which the transfer is to.
+	To string `protobuf:"bytes,1,opt,name=to,proto3" json:"to,omitempty"`
+	// the amount of fungible tokens to transfer.
+	// Note: amount may be empty.
+	Amount []Coin `protobuf:"bytes,2,rep,name=amount,proto3" json:"amount"`
+	// the non-fungible token ids to transfer.
+	TokenIds []string `protobuf:"bytes,3,rep,name=token_ids,json=tokenIds,proto3" json:"token_ids,omitempty"`
+}
+
+func (m *BatchSendEntry) Reset()         { *m = BatchSendEntry{} }
+func (m *BatchSendEntry) String() string { return proto.CompactTextString(m) }
+func (*BatchSendEntry) ProtoMessage()    {}
+func (*BatchSendEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{11}
+}
+func (m *BatchSendEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchSendEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchSendEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchSendEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchSendEntry.Merge(m, src)
+}
+func (m *BatchSendEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchSendEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchSendEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchSendEntry proto.InternalMessageInfo
+
+func (m *BatchSendEntry) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *BatchSendEntry) GetAmount() []Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *BatchSendEntry) GetTokenIds() []string {
+	if m != nil {
+		return m.TokenIds
+	}
+	return nil
+}
+
+// MsgBatchSendResponse is the Msg/BatchSend response type.
+type MsgBatchSendResponse struct {
+}
+
+func (m *MsgBatchSendResponse) Reset()         { *m = MsgBatchSendResponse{} }
+func (m *MsgBatchSendResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchSendResponse) ProtoMessage()    {}
+func (*MsgBatchSendResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{12}
+}
+func (m *MsgBatchSendResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchSendResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchSendResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBatchSendResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchSendResponse.Merge(m, src)
+}
+func (m *MsgBatchSendResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchSendResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchSendResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBatchSendResponse proto.InternalMessageInfo
+
 // MsgAuthorizeOperator is the Msg/AuthorizeOperator request type.
 type MsgAuthorizeOperator struct {
 	// contract id associated with the contract.
@@ -511,7 +797,7 @@ func (m *MsgAuthorizeOperator) Reset()         { *m = MsgAuthorizeOperator{} }
 func (m *MsgAuthorizeOperator) String() string { return proto.CompactTextString(m) }
 func (*MsgAuthorizeOperator) ProtoMessage()    {}
 func (*MsgAuthorizeOperator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{8}
+	return fileDescriptor_eaee77977a3cfe12, []int{13}
 }
 func (m *MsgAuthorizeOperator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -569,7 +855,7 @@ func (m *MsgAuthorizeOperatorResponse) Reset()         { *m = MsgAuthorizeOperat
 func (m *MsgAuthorizeOperatorResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAuthorizeOperatorResponse) ProtoMessage()    {}
 func (*MsgAuthorizeOperatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{9}
+	return fileDescriptor_eaee77977a3cfe12, []int{14}
 }
 func (m *MsgAuthorizeOperatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -612,7 +898,7 @@ func (m *MsgRevokeOperator) Reset()         { *m = MsgRevokeOperator{} }
 func (m *MsgRevokeOperator) String() string { return proto.CompactTextString(m) }
 func (*MsgRevokeOperator) ProtoMessage()    {}
 func (*MsgRevokeOperator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{10}
+	return fileDescriptor_eaee77977a3cfe12, []int{15}
 }
 func (m *MsgRevokeOperator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -670,7 +956,7 @@ func (m *MsgRevokeOperatorResponse) Reset()         { *m = MsgRevokeOperatorResp
 func (m *MsgRevokeOperatorResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRevokeOperatorResponse) ProtoMessage()    {}
 func (*MsgRevokeOperatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{11}
+	return fileDescriptor_eaee77977a3cfe12, []int{16}
 }
 func (m *MsgRevokeOperatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -717,7 +1003,7 @@ func (m *MsgCreateContract) Reset()         { *m = MsgCreateContract{} }
 func (m *MsgCreateContract) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateContract) ProtoMessage()    {}
 func (*MsgCreateContract) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{12}
+	return fileDescriptor_eaee77977a3cfe12, []int{17}
 }
 func (m *MsgCreateContract) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -784,7 +1070,7 @@ func (m *MsgCreateContractResponse) Reset()         { *m = MsgCreateContractResp
 func (m *MsgCreateContractResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateContractResponse) ProtoMessage()    {}
 func (*MsgCreateContractResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{13}
+	return fileDescriptor_eaee77977a3cfe12, []int{18}
 }
 func (m *MsgCreateContractResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -850,7 +1136,7 @@ func (m *MsgIssueFT) Reset()         { *m = MsgIssueFT{} }
 func (m *MsgIssueFT) String() string { return proto.CompactTextString(m) }
 func (*MsgIssueFT) ProtoMessage()    {}
 func (*MsgIssueFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{14}
+	return fileDescriptor_eaee77977a3cfe12, []int{19}
 }
 func (m *MsgIssueFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -940,7 +1226,7 @@ func (m *MsgIssueFTResponse) Reset()         { *m = MsgIssueFTResponse{} }
 func (m *MsgIssueFTResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgIssueFTResponse) ProtoMessage()    {}
 func (*MsgIssueFTResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{15}
+	return fileDescriptor_eaee77977a3cfe12, []int{20}
 }
 func (m *MsgIssueFTResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -988,13 +1274,15 @@ type MsgIssueNFT struct {
 	Meta string `protobuf:"bytes,3,opt,name=meta,proto3" json:"meta,omitempty"`
 	// the address of the grantee which must have the permission to issue a token.
 	Owner string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	// non_transferable makes the tokens of the type soulbound.
+	NonTransferable bool `protobuf:"varint,5,opt,name=non_transferable,json=nonTransferable,proto3" json:"non_transferable,omitempty"`
 }
 
 func (m *MsgIssueNFT) Reset()         { *m = MsgIssueNFT{} }
 func (m *MsgIssueNFT) String() string { return proto.CompactTextString(m) }
 func (*MsgIssueNFT) ProtoMessage()    {}
 func (*MsgIssueNFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{16}
+	return fileDescriptor_eaee77977a3cfe12, []int{21}
 }
 func (m *MsgIssueNFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1051,6 +1339,13 @@ func (m *MsgIssueNFT) GetOwner() string {
 	return ""
 }
 
+func (m *MsgIssueNFT) GetNonTransferable() bool {
+	if m != nil {
+		return m.NonTransferable
+	}
+	return false
+}
+
 // MsgIssueNFTResponse is the Msg/IssueNFT response type.
 type MsgIssueNFTResponse struct {
 	// id of the new token type.
@@ -1062,7 +1357,7 @@ func (m *MsgIssueNFTResponse) Reset()         { *m = MsgIssueNFTResponse{} }
 func (m *MsgIssueNFTResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgIssueNFTResponse) ProtoMessage()    {}
 func (*MsgIssueNFTResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{17}
+	return fileDescriptor_eaee77977a3cfe12, []int{22}
 }
 func (m *MsgIssueNFTResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1119,7 +1414,7 @@ func (m *MsgMintFT) Reset()         { *m = MsgMintFT{} }
 func (m *MsgMintFT) String() string { return proto.CompactTextString(m) }
 func (*MsgMintFT) ProtoMessage()    {}
 func (*MsgMintFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{18}
+	return fileDescriptor_eaee77977a3cfe12, []int{23}
 }
 func (m *MsgMintFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1186,7 +1481,7 @@ func (m *MsgMintFTResponse) Reset()         { *m = MsgMintFTResponse{} }
 func (m *MsgMintFTResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMintFTResponse) ProtoMessage()    {}
 func (*MsgMintFTResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{19}
+	return fileDescriptor_eaee77977a3cfe12, []int{24}
 }
 func (m *MsgMintFTResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1233,7 +1528,7 @@ func (m *MsgMintNFT) Reset()         { *m = MsgMintNFT{} }
 func (m *MsgMintNFT) String() string { return proto.CompactTextString(m) }
 func (*MsgMintNFT) ProtoMessage()    {}
 func (*MsgMintNFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{20}
+	return fileDescriptor_eaee77977a3cfe12, []int{25}
 }
 func (m *MsgMintNFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1300,7 +1595,7 @@ func (m *MsgMintNFTResponse) Reset()         { *m = MsgMintNFTResponse{} }
 func (m *MsgMintNFTResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMintNFTResponse) ProtoMessage()    {}
 func (*MsgMintNFTResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{21}
+	return fileDescriptor_eaee77977a3cfe12, []int{26}
 }
 func (m *MsgMintNFTResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1348,13 +1643,22 @@ type MintNFTParam struct {
 	// meta is a brief description of the nft.
 	// Note: it has an app-specific limit in length.
 	Meta string `protobuf:"bytes,3,opt,name=meta,proto3" json:"meta,omitempty"`
+	// uri is the uri of the resource of the nft, stored off-chain.
+	// Note: it has an app-specific limit in length.
+	Uri string `protobuf:"bytes,4,opt,name=uri,proto3" json:"uri,omitempty"`
+	// uri_hash is a hash of the document pointed by uri.
+	// Note: it has an app-specific limit in length.
+	UriHash string `protobuf:"bytes,5,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
+	// traits are the typed attributes of the nft.
+	// Note: the keys must be unique.
+	Traits []Trait `protobuf:"bytes,6,rep,name=traits,proto3" json:"traits,omitempty"`
 }
 
 func (m *MintNFTParam) Reset()         { *m = MintNFTParam{} }
 func (m *MintNFTParam) String() string { return proto.CompactTextString(m) }
 func (*MintNFTParam) ProtoMessage()    {}
 func (*MintNFTParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{22}
+	return fileDescriptor_eaee77977a3cfe12, []int{27}
 }
 func (m *MintNFTParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1404,6 +1708,27 @@ func (m *MintNFTParam) GetMeta() string {
 	return ""
 }
 
+func (m *MintNFTParam) GetUri() string {
+	if m != nil {
+		return m.Uri
+	}
+	return ""
+}
+
+func (m *MintNFTParam) GetUriHash() string {
+	if m != nil {
+		return m.UriHash
+	}
+	return ""
+}
+
+func (m *MintNFTParam) GetTraits() []Trait {
+	if m != nil {
+		return m.Traits
+	}
+	return nil
+}
+
 // MsgBurnFT is the Msg/BurnFT request type.
 //
 // Deprecated: Do not use.
@@ -1422,7 +1747,7 @@ func (m *MsgBurnFT) Reset()         { *m = MsgBurnFT{} }
 func (m *MsgBurnFT) String() string { return proto.CompactTextString(m) }
 func (*MsgBurnFT) ProtoMessage()    {}
 func (*MsgBurnFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{23}
+	return fileDescriptor_eaee77977a3cfe12, []int{28}
 }
 func (m *MsgBurnFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1482,7 +1807,7 @@ func (m *MsgBurnFTResponse) Reset()         { *m = MsgBurnFTResponse{} }
 func (m *MsgBurnFTResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgBurnFTResponse) ProtoMessage()    {}
 func (*MsgBurnFTResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{24}
+	return fileDescriptor_eaee77977a3cfe12, []int{29}
 }
 func (m *MsgBurnFTResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1532,7 +1857,7 @@ func (m *MsgOperatorBurnFT) Reset()         { *m = MsgOperatorBurnFT{} }
 func (m *MsgOperatorBurnFT) String() string { return proto.CompactTextString(m) }
 func (*MsgOperatorBurnFT) ProtoMessage()    {}
 func (*MsgOperatorBurnFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{25}
+	return fileDescriptor_eaee77977a3cfe12, []int{30}
 }
 func (m *MsgOperatorBurnFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1599,7 +1924,7 @@ func (m *MsgOperatorBurnFTResponse) Reset()         { *m = MsgOperatorBurnFTResp
 func (m *MsgOperatorBurnFTResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgOperatorBurnFTResponse) ProtoMessage()    {}
 func (*MsgOperatorBurnFTResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{26}
+	return fileDescriptor_eaee77977a3cfe12, []int{31}
 }
 func (m *MsgOperatorBurnFTResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1644,7 +1969,7 @@ func (m *MsgBurnNFT) Reset()         { *m = MsgBurnNFT{} }
 func (m *MsgBurnNFT) String() string { return proto.CompactTextString(m) }
 func (*MsgBurnNFT) ProtoMessage()    {}
 func (*MsgBurnNFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{27}
+	return fileDescriptor_eaee77977a3cfe12, []int{32}
 }
 func (m *MsgBurnNFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1702,7 +2027,7 @@ func (m *MsgBurnNFTResponse) Reset()         { *m = MsgBurnNFTResponse{} }
 func (m *MsgBurnNFTResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgBurnNFTResponse) ProtoMessage()    {}
 func (*MsgBurnNFTResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{28}
+	return fileDescriptor_eaee77977a3cfe12, []int{33}
 }
 func (m *MsgBurnNFTResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1750,7 +2075,7 @@ func (m *MsgOperatorBurnNFT) Reset()         { *m = MsgOperatorBurnNFT{} }
 func (m *MsgOperatorBurnNFT) String() string { return proto.CompactTextString(m) }
 func (*MsgOperatorBurnNFT) ProtoMessage()    {}
 func (*MsgOperatorBurnNFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{29}
+	return fileDescriptor_eaee77977a3cfe12, []int{34}
 }
 func (m *MsgOperatorBurnNFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1815,7 +2140,7 @@ func (m *MsgOperatorBurnNFTResponse) Reset()         { *m = MsgOperatorBurnNFTRe
 func (m *MsgOperatorBurnNFTResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgOperatorBurnNFTResponse) ProtoMessage()    {}
 func (*MsgOperatorBurnNFTResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{30}
+	return fileDescriptor_eaee77977a3cfe12, []int{35}
 }
 func (m *MsgOperatorBurnNFTResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1868,7 +2193,7 @@ func (m *MsgModify) Reset()         { *m = MsgModify{} }
 func (m *MsgModify) String() string { return proto.CompactTextString(m) }
 func (*MsgModify) ProtoMessage()    {}
 func (*MsgModify) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{31}
+	return fileDescriptor_eaee77977a3cfe12, []int{36}
 }
 func (m *MsgModify) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1940,7 +2265,7 @@ func (m *MsgModifyResponse) Reset()         { *m = MsgModifyResponse{} }
 func (m *MsgModifyResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgModifyResponse) ProtoMessage()    {}
 func (*MsgModifyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{32}
+	return fileDescriptor_eaee77977a3cfe12, []int{37}
 }
 func (m *MsgModifyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1969,8 +2294,109 @@ func (m *MsgModifyResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgModifyResponse proto.InternalMessageInfo
 
-// MsgGrantPermission is the Msg/GrantPermission request type.
-type MsgGrantPermission struct {
+// MsgSetRoyalty is the Msg/SetRoyalty request type.
+type MsgSetRoyalty struct {
+	// contract id associated with the contract.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// the address of the operator, which must have the modify permission.
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	// the royalty to set.
+	Royalty Royalty `protobuf:"bytes,3,opt,name=royalty,proto3" json:"royalty"`
+}
+
+func (m *MsgSetRoyalty) Reset()         { *m = MsgSetRoyalty{} }
+func (m *MsgSetRoyalty) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRoyalty) ProtoMessage()    {}
+func (*MsgSetRoyalty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{38}
+}
+func (m *MsgSetRoyalty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRoyalty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRoyalty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRoyalty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRoyalty.Merge(m, src)
+}
+func (m *MsgSetRoyalty) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRoyalty) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRoyalty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRoyalty proto.InternalMessageInfo
+
+func (m *MsgSetRoyalty) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
+}
+
+func (m *MsgSetRoyalty) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *MsgSetRoyalty) GetRoyalty() Royalty {
+	if m != nil {
+		return m.Royalty
+	}
+	return Royalty{}
+}
+
+// MsgSetRoyaltyResponse is the Msg/SetRoyalty response type.
+type MsgSetRoyaltyResponse struct {
+}
+
+func (m *MsgSetRoyaltyResponse) Reset()         { *m = MsgSetRoyaltyResponse{} }
+func (m *MsgSetRoyaltyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRoyaltyResponse) ProtoMessage()    {}
+func (*MsgSetRoyaltyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eaee77977a3cfe12, []int{39}
+}
+func (m *MsgSetRoyaltyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRoyaltyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRoyaltyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRoyaltyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRoyaltyResponse.Merge(m, src)
+}
+func (m *MsgSetRoyaltyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRoyaltyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRoyaltyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRoyaltyResponse proto.InternalMessageInfo
+
+// MsgGrantPermission is the Msg/GrantPermission request type.
+type MsgGrantPermission struct {
 	// contract id associated with the contract.
 	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
 	// address of the granter which must have the permission to give.
@@ -1985,7 +2411,7 @@ func (m *MsgGrantPermission) Reset()         { *m = MsgGrantPermission{} }
 func (m *MsgGrantPermission) String() string { return proto.CompactTextString(m) }
 func (*MsgGrantPermission) ProtoMessage()    {}
 func (*MsgGrantPermission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{33}
+	return fileDescriptor_eaee77977a3cfe12, []int{40}
 }
 func (m *MsgGrantPermission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2050,7 +2476,7 @@ func (m *MsgGrantPermissionResponse) Reset()         { *m = MsgGrantPermissionRe
 func (m *MsgGrantPermissionResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgGrantPermissionResponse) ProtoMessage()    {}
 func (*MsgGrantPermissionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{34}
+	return fileDescriptor_eaee77977a3cfe12, []int{41}
 }
 func (m *MsgGrantPermissionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2093,7 +2519,7 @@ func (m *MsgRevokePermission) Reset()         { *m = MsgRevokePermission{} }
 func (m *MsgRevokePermission) String() string { return proto.CompactTextString(m) }
 func (*MsgRevokePermission) ProtoMessage()    {}
 func (*MsgRevokePermission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{35}
+	return fileDescriptor_eaee77977a3cfe12, []int{42}
 }
 func (m *MsgRevokePermission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2151,7 +2577,7 @@ func (m *MsgRevokePermissionResponse) Reset()         { *m = MsgRevokePermission
 func (m *MsgRevokePermissionResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRevokePermissionResponse) ProtoMessage()    {}
 func (*MsgRevokePermissionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{36}
+	return fileDescriptor_eaee77977a3cfe12, []int{43}
 }
 func (m *MsgRevokePermissionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2200,7 +2626,7 @@ func (m *MsgAttach) Reset()         { *m = MsgAttach{} }
 func (m *MsgAttach) String() string { return proto.CompactTextString(m) }
 func (*MsgAttach) ProtoMessage()    {}
 func (*MsgAttach) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{37}
+	return fileDescriptor_eaee77977a3cfe12, []int{44}
 }
 func (m *MsgAttach) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2267,7 +2693,7 @@ func (m *MsgAttachResponse) Reset()         { *m = MsgAttachResponse{} }
 func (m *MsgAttachResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAttachResponse) ProtoMessage()    {}
 func (*MsgAttachResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{38}
+	return fileDescriptor_eaee77977a3cfe12, []int{45}
 }
 func (m *MsgAttachResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2314,7 +2740,7 @@ func (m *MsgDetach) Reset()         { *m = MsgDetach{} }
 func (m *MsgDetach) String() string { return proto.CompactTextString(m) }
 func (*MsgDetach) ProtoMessage()    {}
 func (*MsgDetach) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{39}
+	return fileDescriptor_eaee77977a3cfe12, []int{46}
 }
 func (m *MsgDetach) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2374,7 +2800,7 @@ func (m *MsgDetachResponse) Reset()         { *m = MsgDetachResponse{} }
 func (m *MsgDetachResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDetachResponse) ProtoMessage()    {}
 func (*MsgDetachResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{40}
+	return fileDescriptor_eaee77977a3cfe12, []int{47}
 }
 func (m *MsgDetachResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2423,7 +2849,7 @@ func (m *MsgOperatorAttach) Reset()         { *m = MsgOperatorAttach{} }
 func (m *MsgOperatorAttach) String() string { return proto.CompactTextString(m) }
 func (*MsgOperatorAttach) ProtoMessage()    {}
 func (*MsgOperatorAttach) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{41}
+	return fileDescriptor_eaee77977a3cfe12, []int{48}
 }
 func (m *MsgOperatorAttach) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2497,7 +2923,7 @@ func (m *MsgOperatorAttachResponse) Reset()         { *m = MsgOperatorAttachResp
 func (m *MsgOperatorAttachResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgOperatorAttachResponse) ProtoMessage()    {}
 func (*MsgOperatorAttachResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{42}
+	return fileDescriptor_eaee77977a3cfe12, []int{49}
 }
 func (m *MsgOperatorAttachResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2544,7 +2970,7 @@ func (m *MsgOperatorDetach) Reset()         { *m = MsgOperatorDetach{} }
 func (m *MsgOperatorDetach) String() string { return proto.CompactTextString(m) }
 func (*MsgOperatorDetach) ProtoMessage()    {}
 func (*MsgOperatorDetach) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{43}
+	return fileDescriptor_eaee77977a3cfe12, []int{50}
 }
 func (m *MsgOperatorDetach) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2611,7 +3037,7 @@ func (m *MsgOperatorDetachResponse) Reset()         { *m = MsgOperatorDetachResp
 func (m *MsgOperatorDetachResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgOperatorDetachResponse) ProtoMessage()    {}
 func (*MsgOperatorDetachResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eaee77977a3cfe12, []int{44}
+	return fileDescriptor_eaee77977a3cfe12, []int{51}
 }
 func (m *MsgOperatorDetachResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2649,6 +3075,11 @@ func init() {
 	proto.RegisterType((*MsgSendNFTResponse)(nil), "lbm.collection.v1.MsgSendNFTResponse")
 	proto.RegisterType((*MsgOperatorSendNFT)(nil), "lbm.collection.v1.MsgOperatorSendNFT")
 	proto.RegisterType((*MsgOperatorSendNFTResponse)(nil), "lbm.collection.v1.MsgOperatorSendNFTResponse")
+	proto.RegisterType((*MsgSellNFT)(nil), "lbm.collection.v1.MsgSellNFT")
+	proto.RegisterType((*MsgSellNFTResponse)(nil), "lbm.collection.v1.MsgSellNFTResponse")
+	proto.RegisterType((*MsgBatchSend)(nil), "lbm.collection.v1.MsgBatchSend")
+	proto.RegisterType((*BatchSendEntry)(nil), "lbm.collection.v1.BatchSendEntry")
+	proto.RegisterType((*MsgBatchSendResponse)(nil), "lbm.collection.v1.MsgBatchSendResponse")
 	proto.RegisterType((*MsgAuthorizeOperator)(nil), "lbm.collection.v1.MsgAuthorizeOperator")
 	proto.RegisterType((*MsgAuthorizeOperatorResponse)(nil), "lbm.collection.v1.MsgAuthorizeOperatorResponse")
 	proto.RegisterType((*MsgRevokeOperator)(nil), "lbm.collection.v1.MsgRevokeOperator")
@@ -2674,6 +3105,8 @@ func init() {
 	proto.RegisterType((*MsgOperatorBurnNFTResponse)(nil), "lbm.collection.v1.MsgOperatorBurnNFTResponse")
 	proto.RegisterType((*MsgModify)(nil), "lbm.collection.v1.MsgModify")
 	proto.RegisterType((*MsgModifyResponse)(nil), "lbm.collection.v1.MsgModifyResponse")
+	proto.RegisterType((*MsgSetRoyalty)(nil), "lbm.collection.v1.MsgSetRoyalty")
+	proto.RegisterType((*MsgSetRoyaltyResponse)(nil), "lbm.collection.v1.MsgSetRoyaltyResponse")
 	proto.RegisterType((*MsgGrantPermission)(nil), "lbm.collection.v1.MsgGrantPermission")
 	proto.RegisterType((*MsgGrantPermissionResponse)(nil), "lbm.collection.v1.MsgGrantPermissionResponse")
 	proto.RegisterType((*MsgRevokePermission)(nil), "lbm.collection.v1.MsgRevokePermission")
@@ -2691,92 +3124,113 @@ func init() {
 func init() { proto.RegisterFile("lbm/collection/v1/tx.proto", fileDescriptor_eaee77977a3cfe12) }
 
 var fileDescriptor_eaee77977a3cfe12 = []byte{
-	// 1357 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0xaf, 0xe3, 0x24, 0x4d, 0x5f, 0xa1, 0xb4, 0xde, 0x8a, 0xcd, 0xa6, 0x6d, 0x52, 0x59, 0x74,
-	0xa9, 0x50, 0x9b, 0xa8, 0x5d, 0xb8, 0xa0, 0x05, 0xa9, 0x2d, 0x2a, 0x2a, 0xd0, 0xee, 0x36, 0x94,
-	0x0b, 0x07, 0x2a, 0x27, 0x99, 0x26, 0xde, 0xc6, 0x9e, 0xc8, 0x9e, 0x94, 0x16, 0x0e, 0x48, 0xec,
-	0x05, 0x89, 0xcb, 0x0a, 0x71, 0x45, 0x1c, 0xb8, 0x20, 0x2e, 0x7c, 0x05, 0x8e, 0x7b, 0xdc, 0x23,
-	0xe2, 0xb0, 0xa0, 0xf6, 0x8b, 0x20, 0x8f, 0xc7, 0x13, 0x8f, 0xed, 0x49, 0xdc, 0x6e, 0x76, 0x6f,
-	0xb6, 0xdf, 0x9b, 0xf9, 0xfd, 0xde, 0x9f, 0x79, 0xef, 0x8d, 0xa1, 0xd4, 0x6d, 0x58, 0xb5, 0x26,
-	0xee, 0x76, 0x51, 0x93, 0x98, 0xd8, 0xae, 0x9d, 0x6d, 0xd4, 0xc8, 0x79, 0xb5, 0xe7, 0x60, 0x82,
-	0xb5, 0xb9, 0x6e, 0xc3, 0xaa, 0x0e, 0x64, 0xd5, 0xb3, 0x8d, 0xd2, 0x7c, 0x1b, 0xb7, 0x31, 0x95,
-	0xd6, 0xbc, 0x27, 0x5f, 0xb1, 0xa4, 0xc7, 0x37, 0x09, 0x2d, 0xa3, 0x3a, 0xfa, 0x8f, 0x0a, 0x4c,
-	0xed, 0xbb, 0xed, 0xcf, 0x91, 0xdd, 0xda, 0x3d, 0xd2, 0x2a, 0x30, 0xdd, 0xc4, 0x36, 0x71, 0x8c,
-	0x26, 0x39, 0x36, 0x5b, 0x45, 0x65, 0x59, 0x59, 0x9d, 0xaa, 0x43, 0xf0, 0x69, 0xaf, 0xa5, 0x69,
-	0x90, 0x3d, 0x71, 0xb0, 0x55, 0xcc, 0x50, 0x09, 0x7d, 0xd6, 0x66, 0x20, 0x43, 0x70, 0x51, 0xa5,
-	0x5f, 0x32, 0x04, 0x6b, 0xef, 0x41, 0xde, 0xb0, 0x70, 0xdf, 0x26, 0xc5, 0xec, 0xb2, 0xba, 0x3a,
-	0xbd, 0x79, 0xbb, 0x1a, 0x23, 0x5c, 0xdd, 0xc1, 0xa6, 0xbd, 0x9d, 0x7d, 0xfa, 0xbc, 0x32, 0x51,
-	0x67, 0xca, 0xef, 0x67, 0x8a, 0x8a, 0x7e, 0x1b, 0xe6, 0x38, 0x99, 0x3a, 0x72, 0x7b, 0xd8, 0x76,
-	0x11, 0x15, 0xfc, 0xa9, 0x50, 0xc9, 0x83, 0x1e, 0x72, 0x0c, 0x82, 0x9d, 0xb4, 0x74, 0x4b, 0x50,
-	0xc0, 0x6c, 0x09, 0xa3, 0xcc, 0xdf, 0xb9, 0x29, 0x6a, 0xcc, 0x94, 0x6c, 0x82, 0x29, 0xb9, 0xeb,
-	0x9a, 0x52, 0x81, 0x3b, 0x31, 0xc2, 0x82, 0x49, 0x36, 0x00, 0xb3, 0xf5, 0x60, 0x5c, 0x9e, 0x5f,
-	0x80, 0x29, 0x82, 0x4f, 0x91, 0x7d, 0x6c, 0xb6, 0x5c, 0xea, 0xfc, 0xa9, 0x7a, 0x81, 0x7e, 0xd8,
-	0x6b, 0xb9, 0xfa, 0x3c, 0x68, 0x03, 0xbc, 0x80, 0x89, 0xfe, 0x93, 0x42, 0x3f, 0x87, 0x79, 0x1e,
-	0xbc, 0x0a, 0xcf, 0x0a, 0x54, 0x73, 0x11, 0xaa, 0x8b, 0x50, 0x8a, 0x73, 0xe2, 0x94, 0x4f, 0x61,
-	0x7e, 0xdf, 0x6d, 0x6f, 0xf5, 0x49, 0x07, 0x3b, 0xe6, 0x37, 0x28, 0x50, 0x1b, 0xcd, 0xf9, 0x4d,
-	0xc8, 0x77, 0x70, 0xb7, 0x85, 0x02, 0xc6, 0xec, 0x4d, 0xb0, 0x45, 0x15, 0x6d, 0xd1, 0xcb, 0xb0,
-	0x98, 0x04, 0xc6, 0xc9, 0x74, 0x68, 0x5e, 0xd6, 0xd1, 0x19, 0x3e, 0x7d, 0xc9, 0x4c, 0x16, 0x68,
-	0x42, 0x89, 0x48, 0x9c, 0x46, 0x93, 0xd2, 0xd8, 0x71, 0x90, 0x41, 0xd0, 0x0e, 0xc3, 0xd1, 0xe6,
-	0x21, 0x87, 0xbf, 0xb6, 0x91, 0xc3, 0x08, 0xf8, 0x2f, 0x5e, 0x74, 0x6c, 0xc3, 0x42, 0x41, 0x22,
-	0x79, 0xcf, 0xda, 0x2c, 0xa8, 0x7d, 0xc7, 0x64, 0x90, 0xde, 0xa3, 0xa7, 0x65, 0x21, 0x62, 0xb0,
-	0x88, 0xd1, 0x67, 0xfd, 0x3e, 0x65, 0x20, 0x82, 0x04, 0x0c, 0x46, 0xda, 0xac, 0x3f, 0xce, 0xd0,
-	0x84, 0xdf, 0x73, 0xdd, 0x3e, 0x4a, 0x99, 0xf0, 0x31, 0x9e, 0x01, 0x2b, 0x75, 0xc0, 0xca, 0xf3,
-	0x59, 0x0b, 0x35, 0x4d, 0xcb, 0xe8, 0xba, 0x94, 0x6d, 0xae, 0xce, 0xdf, 0x3d, 0x99, 0x65, 0xda,
-	0xc4, 0x68, 0x74, 0x51, 0x31, 0xb7, 0xac, 0xac, 0x16, 0xea, 0xfc, 0x7d, 0xe0, 0x9d, 0x7c, 0xd8,
-	0x3b, 0x7e, 0x9e, 0x4e, 0xf2, 0x3c, 0xfd, 0x84, 0x57, 0x80, 0x82, 0xf7, 0x6d, 0x7b, 0xd3, 0x3b,
-	0xe8, 0xff, 0x3c, 0xaf, 0xbc, 0xd3, 0x36, 0x49, 0xa7, 0xdf, 0xa8, 0x36, 0xb1, 0x55, 0xdb, 0x35,
-	0x6d, 0xb7, 0xd9, 0x31, 0x8d, 0xda, 0x09, 0x7b, 0x58, 0x77, 0x5b, 0xa7, 0x35, 0x72, 0xd1, 0x43,
-	0x6e, 0x75, 0xcf, 0x26, 0x42, 0x59, 0xb8, 0x47, 0x8f, 0x1b, 0x73, 0x02, 0x77, 0xde, 0x1d, 0x28,
-	0x04, 0xa7, 0x81, 0x79, 0x62, 0x92, 0x1d, 0x06, 0xba, 0xa8, 0x0b, 0xd3, 0xc1, 0xa2, 0x83, 0x71,
-	0xba, 0x8e, 0xbb, 0x20, 0x1b, 0x72, 0x81, 0xfe, 0x2e, 0xdc, 0x0a, 0xa1, 0x71, 0x8e, 0x4b, 0x00,
-	0x3e, 0x47, 0xcf, 0x30, 0x06, 0xea, 0x9f, 0xe1, 0xa3, 0x8b, 0x1e, 0xd2, 0x7f, 0xf6, 0x1b, 0xc9,
-	0xbe, 0x69, 0x93, 0x71, 0x95, 0xb3, 0x0f, 0xd3, 0x36, 0x92, 0xd7, 0xbd, 0xa0, 0xfc, 0xf1, 0x6f,
-	0x25, 0xe7, 0xbd, 0xb9, 0x09, 0x1d, 0xc5, 0x67, 0x25, 0x94, 0xdf, 0x27, 0x0a, 0x4d, 0x47, 0x4f,
-	0x32, 0xb6, 0xfa, 0xfb, 0x01, 0xe4, 0x7b, 0x86, 0x63, 0x58, 0x2e, 0x23, 0x5c, 0x49, 0x20, 0xcc,
-	0x00, 0x1f, 0x7a, 0x7a, 0x41, 0xdb, 0xf0, 0x17, 0xe9, 0x1b, 0x34, 0x37, 0x98, 0x02, 0xf7, 0xbb,
-	0x50, 0x29, 0x95, 0x48, 0xa5, 0xfc, 0x02, 0x5e, 0x0b, 0x6f, 0x38, 0x22, 0x48, 0x69, 0x13, 0x43,
-	0xff, 0x96, 0xc6, 0x72, 0xbb, 0xef, 0xd8, 0x37, 0x75, 0xcd, 0xa0, 0x73, 0xaa, 0x37, 0x1b, 0x02,
-	0x7c, 0x70, 0x21, 0x64, 0xbf, 0x8a, 0x43, 0x40, 0x5a, 0x7a, 0xd7, 0x6d, 0x55, 0x2f, 0x30, 0xbf,
-	0x88, 0x4d, 0x3f, 0xc1, 0x84, 0xaf, 0x68, 0xd2, 0x79, 0x82, 0x1b, 0x27, 0x9d, 0x90, 0x0f, 0x6a,
-	0x62, 0x93, 0x67, 0xfb, 0xf3, 0xee, 0xf0, 0xbd, 0xd8, 0xe4, 0x53, 0xc3, 0x5f, 0xd7, 0x73, 0x43,
-	0xe7, 0x0f, 0xb1, 0xa9, 0x47, 0x29, 0xfe, 0xc5, 0xca, 0x07, 0x6e, 0x99, 0x27, 0x17, 0xa3, 0x99,
-	0xf1, 0xca, 0x95, 0x09, 0x17, 0x6f, 0x31, 0xfb, 0xd5, 0x68, 0xf6, 0x57, 0x60, 0x9a, 0xd1, 0xb3,
-	0x5b, 0xe8, 0x9c, 0x15, 0x3d, 0x7f, 0xc5, 0x9e, 0xf7, 0x45, 0xbb, 0x0f, 0x93, 0xcd, 0x8e, 0x61,
-	0xb7, 0x91, 0xcb, 0xe6, 0xbd, 0xc5, 0x84, 0xd0, 0x6f, 0x11, 0xe2, 0x98, 0x8d, 0x3e, 0x41, 0x2c,
-	0xfe, 0xc1, 0x12, 0xfd, 0x96, 0x5f, 0x6a, 0xa8, 0x05, 0xdc, 0xae, 0x0b, 0xea, 0xf9, 0x8f, 0x1d,
-	0xc3, 0x26, 0x0f, 0x91, 0x63, 0x99, 0xae, 0x6b, 0x62, 0x7b, 0x3c, 0xd5, 0xa6, 0x0c, 0xd0, 0xe3,
-	0x5b, 0x06, 0xd6, 0x0c, 0xbe, 0x30, 0x87, 0x47, 0xa0, 0x39, 0xb1, 0x47, 0xb4, 0xca, 0xfb, 0xe3,
-	0xc4, 0x8b, 0x32, 0x13, 0x99, 0xa8, 0x31, 0x26, 0x4b, 0xb0, 0x90, 0x80, 0xc5, 0xa9, 0x7c, 0x47,
-	0x43, 0xbf, 0x45, 0x88, 0xd1, 0xec, 0xdc, 0x8c, 0x40, 0xb8, 0x7f, 0xaa, 0x42, 0xff, 0xd4, 0xca,
-	0x5e, 0xd0, 0x8f, 0xb9, 0x34, 0x1b, 0x24, 0xc5, 0x51, 0xa8, 0xbf, 0xfa, 0x15, 0xc7, 0x27, 0x20,
-	0x1c, 0xd7, 0x63, 0xca, 0xec, 0x23, 0xf4, 0x32, 0x98, 0x85, 0x90, 0x7d, 0x00, 0x01, 0xf9, 0x37,
-	0xb1, 0xd6, 0xa5, 0x75, 0xce, 0x75, 0x4f, 0x6c, 0x98, 0x5e, 0x76, 0xa8, 0xe3, 0x72, 0x49, 0x8e,
-	0x13, 0xeb, 0x5d, 0x82, 0x03, 0x1f, 0x8b, 0x66, 0xa4, 0xf5, 0xe4, 0xf8, 0xcc, 0x48, 0xa0, 0x19,
-	0xf7, 0xf6, 0xe6, 0x2f, 0xb3, 0xa0, 0xee, 0xbb, 0x6d, 0xed, 0x10, 0xf2, 0xec, 0x6a, 0x99, 0x74,
-	0xf2, 0xf9, 0xd5, 0xb4, 0xf4, 0xd6, 0x30, 0x29, 0xcf, 0x6b, 0xf5, 0x87, 0x8c, 0xa2, 0x99, 0x30,
-	0x13, 0xb9, 0xb5, 0x4a, 0x16, 0x8b, 0x5a, 0xa5, 0xb5, 0x34, 0x5a, 0x22, 0xd4, 0x03, 0x98, 0x0c,
-	0xee, 0x6f, 0x4b, 0x72, 0x82, 0x07, 0xbb, 0x47, 0xa5, 0x95, 0xa1, 0x62, 0x3e, 0x7a, 0xb4, 0xe1,
-	0x8d, 0xe8, 0xc5, 0x70, 0x65, 0x34, 0x2d, 0x0f, 0x60, 0x3d, 0x95, 0x1a, 0x07, 0xb2, 0x60, 0x2e,
-	0x7e, 0x9f, 0x7b, 0x3b, 0x79, 0x8f, 0x98, 0x62, 0xa9, 0x96, 0x52, 0x91, 0xc3, 0xb5, 0x60, 0x26,
-	0x72, 0x63, 0x93, 0xc4, 0x44, 0xd4, 0x92, 0xc5, 0x24, 0xf9, 0x4e, 0xe6, 0xa1, 0x44, 0x2e, 0x64,
-	0x12, 0x14, 0x51, 0x4b, 0x86, 0x22, 0xb9, 0x77, 0x1d, 0xc1, 0x64, 0x70, 0xa5, 0x92, 0x04, 0x9d,
-	0x89, 0x65, 0x41, 0x8f, 0xdc, 0x45, 0xfc, 0x54, 0xaa, 0x43, 0x81, 0x5f, 0x37, 0xca, 0x43, 0xd6,
-	0x79, 0xb1, 0xbe, 0x3b, 0x5c, 0xce, 0x99, 0x1e, 0x42, 0x9e, 0xdd, 0x0e, 0x24, 0x87, 0xcb, 0x97,
-	0xca, 0x0e, 0x97, 0x38, 0xc3, 0xf3, 0x8c, 0x0f, 0x06, 0xf8, 0x25, 0xf9, 0xaa, 0x21, 0x19, 0x1f,
-	0x1d, 0xb6, 0x0f, 0x21, 0xcf, 0xc6, 0x4a, 0x09, 0x47, 0x5f, 0x2a, 0xe3, 0x28, 0x4e, 0x7c, 0xb1,
-	0x02, 0xc0, 0xb6, 0x1e, 0x51, 0x00, 0x18, 0xc4, 0x5a, 0x1a, 0xad, 0x98, 0x3b, 0x82, 0xd9, 0x6e,
-	0x49, 0x4e, 0x70, 0x88, 0x3b, 0x22, 0x53, 0x59, 0xb8, 0x00, 0x04, 0x1b, 0xaf, 0x8c, 0xa6, 0x95,
-	0xa2, 0x00, 0x44, 0x81, 0x3e, 0x83, 0x3c, 0x1b, 0xfd, 0x64, 0xb9, 0x41, 0xa5, 0xd2, 0xdc, 0x10,
-	0x86, 0x2e, 0x8f, 0x76, 0x74, 0xe2, 0x92, 0xd0, 0x8e, 0xa8, 0xc9, 0x68, 0x4b, 0x86, 0x28, 0xed,
-	0x11, 0xcc, 0xc6, 0x26, 0xa8, 0xbb, 0xc3, 0x8a, 0x44, 0x08, 0xaa, 0x9a, 0x4e, 0x2f, 0x9c, 0x9a,
-	0x6c, 0x0a, 0x90, 0xb8, 0xc8, 0x97, 0xca, 0x5c, 0x24, 0x36, 0x67, 0x3f, 0x5f, 0x0e, 0x21, 0xcf,
-	0x3a, 0xb2, 0x64, 0x4b, 0x5f, 0x2a, 0xdb, 0x52, 0x6c, 0xa4, 0xb1, 0x6c, 0x67, 0x6c, 0x47, 0x64,
-	0x3b, 0x63, 0xbd, 0x96, 0x46, 0x4b, 0x0a, 0xc5, 0xac, 0x18, 0x01, 0xc5, 0xac, 0x59, 0x4b, 0xa3,
-	0x25, 0x40, 0x6d, 0x7f, 0xfa, 0xfb, 0x65, 0x79, 0xe2, 0xe9, 0x65, 0x59, 0x79, 0x76, 0x59, 0x56,
-	0xfe, 0xbb, 0x2c, 0x2b, 0x4f, 0xae, 0xca, 0x13, 0xcf, 0xae, 0xca, 0x13, 0x7f, 0x5f, 0x95, 0x27,
-	0xbe, 0x5c, 0x1f, 0xf9, 0x2f, 0xe8, 0x3c, 0xf4, 0xe3, 0xbd, 0x91, 0xa7, 0x7f, 0xde, 0xef, 0xfd,
-	0x1f, 0x00, 0x00, 0xff, 0xff, 0x50, 0xa5, 0x9b, 0x1f, 0xe4, 0x17, 0x00, 0x00,
+	// 1690 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xc1, 0x6f, 0x1b, 0x45,
+	0x17, 0xcf, 0xda, 0x89, 0x9d, 0xbc, 0x7c, 0x4d, 0xd3, 0x6d, 0xbe, 0xd6, 0x71, 0x1b, 0xbb, 0xdf,
+	0xea, 0x6b, 0x09, 0x28, 0xb5, 0x95, 0xb4, 0x5c, 0xaa, 0x82, 0x94, 0x14, 0x0a, 0x01, 0x92, 0x36,
+	0xc6, 0x48, 0x88, 0x03, 0xd1, 0xda, 0x9e, 0xd8, 0xdb, 0x78, 0x77, 0xac, 0xdd, 0x71, 0xa8, 0xe1,
+	0x80, 0x44, 0x25, 0x54, 0x89, 0x4b, 0x85, 0x90, 0x38, 0xf6, 0xc0, 0x05, 0x71, 0xe1, 0x5f, 0xe0,
+	0xd8, 0x13, 0xea, 0x11, 0x21, 0x51, 0x50, 0x7a, 0xe3, 0xaf, 0x40, 0x3b, 0x33, 0x3b, 0xde, 0xd9,
+	0xdd, 0xb1, 0x37, 0x69, 0xca, 0x6d, 0x77, 0xde, 0x9b, 0x79, 0xbf, 0xf7, 0xf6, 0xf7, 0xde, 0xbc,
+	0x99, 0x85, 0x62, 0xb7, 0x61, 0x57, 0x9b, 0xb8, 0xdb, 0x45, 0x4d, 0x62, 0x61, 0xa7, 0x7a, 0xb0,
+	0x5a, 0x25, 0xf7, 0x2b, 0x3d, 0x17, 0x13, 0xac, 0x9f, 0xe9, 0x36, 0xec, 0xca, 0x50, 0x56, 0x39,
+	0x58, 0x2d, 0x2e, 0xb4, 0x71, 0x1b, 0x53, 0x69, 0xd5, 0x7f, 0x62, 0x8a, 0xc5, 0x52, 0x13, 0x7b,
+	0x36, 0xf6, 0xaa, 0x0d, 0xd3, 0x43, 0xd5, 0x83, 0xd5, 0x06, 0x22, 0xe6, 0x6a, 0xb5, 0x89, 0x2d,
+	0x87, 0xcb, 0x8d, 0xb8, 0x91, 0xd0, 0xb2, 0x54, 0xc7, 0xf8, 0x46, 0x83, 0x99, 0x2d, 0xaf, 0xfd,
+	0x21, 0x72, 0x5a, 0xb7, 0xeb, 0x7a, 0x19, 0x66, 0x9b, 0xd8, 0x21, 0xae, 0xd9, 0x24, 0xbb, 0x56,
+	0xab, 0xa0, 0x5d, 0xd2, 0x96, 0x67, 0x6a, 0x10, 0x0c, 0x6d, 0xb6, 0x74, 0x1d, 0x26, 0xf7, 0x5c,
+	0x6c, 0x17, 0x32, 0x54, 0x42, 0x9f, 0xf5, 0x39, 0xc8, 0x10, 0x5c, 0xc8, 0xd2, 0x91, 0x0c, 0xc1,
+	0xfa, 0xeb, 0x90, 0x33, 0x6d, 0xdc, 0x77, 0x48, 0x61, 0xf2, 0x52, 0x76, 0x79, 0x76, 0xed, 0x7c,
+	0x25, 0xe6, 0x50, 0xe5, 0x16, 0xb6, 0x9c, 0x8d, 0xc9, 0x27, 0xcf, 0xca, 0x13, 0x35, 0xae, 0x7c,
+	0x23, 0x53, 0xd0, 0x8c, 0xf3, 0x70, 0x46, 0x80, 0xa9, 0x21, 0xaf, 0x87, 0x1d, 0x0f, 0x51, 0xc1,
+	0xcf, 0x1a, 0x95, 0xdc, 0xe9, 0x21, 0xd7, 0x24, 0xd8, 0x4d, 0x0b, 0xb7, 0x08, 0xd3, 0x98, 0x4f,
+	0xe1, 0x90, 0xc5, 0xbb, 0x70, 0x25, 0x1b, 0x73, 0x65, 0x32, 0xc1, 0x95, 0xa9, 0xa3, 0xba, 0x52,
+	0x86, 0xc5, 0x18, 0x60, 0xc9, 0x25, 0x07, 0x80, 0xfb, 0xba, 0x7d, 0x52, 0x91, 0xbf, 0x00, 0x33,
+	0x04, 0xef, 0x23, 0x67, 0xd7, 0x6a, 0x79, 0x34, 0xf8, 0x33, 0xb5, 0x69, 0x3a, 0xb0, 0xd9, 0xf2,
+	0x8c, 0x05, 0xd0, 0x87, 0xf6, 0x02, 0x24, 0xc6, 0xb7, 0x1a, 0x1d, 0x0e, 0xe3, 0xdc, 0xfe, 0x37,
+	0x22, 0x2b, 0x41, 0x9d, 0x8a, 0x40, 0xbd, 0x08, 0xc5, 0x38, 0x26, 0x01, 0xf9, 0x0f, 0x8d, 0x47,
+	0xae, 0xdb, 0x4d, 0x05, 0xf5, 0x1c, 0xe4, 0x3c, 0xd4, 0xed, 0xa2, 0x00, 0x28, 0x7f, 0xd3, 0x17,
+	0x60, 0xaa, 0xd1, 0x1f, 0x20, 0x97, 0xe3, 0x64, 0x2f, 0xfa, 0x22, 0x4c, 0x07, 0xc0, 0x38, 0xdc,
+	0x3c, 0xc7, 0xa5, 0x23, 0x98, 0xea, 0xb9, 0x56, 0x13, 0x71, 0x32, 0x2c, 0x56, 0x58, 0xfe, 0x55,
+	0xfc, 0xfc, 0xab, 0xf0, 0xfc, 0x63, 0x74, 0xb8, 0xee, 0xd3, 0xe1, 0xa7, 0x3f, 0xcb, 0x2b, 0x6d,
+	0x8b, 0x74, 0xfa, 0x8d, 0x4a, 0x13, 0xdb, 0xd5, 0xdb, 0x96, 0xe3, 0x35, 0x3b, 0x96, 0x59, 0xdd,
+	0xe3, 0x0f, 0x57, 0xbd, 0xd6, 0x7e, 0x95, 0x0c, 0x7a, 0xc8, 0xa3, 0x93, 0xbc, 0x1a, 0x5b, 0x5d,
+	0x7c, 0x28, 0xea, 0x9e, 0xf0, 0xfa, 0x6b, 0x0d, 0xfe, 0xb3, 0xe5, 0xb5, 0x37, 0x4c, 0xd2, 0xec,
+	0xf8, 0x11, 0x39, 0x1e, 0x63, 0xd6, 0x21, 0x8f, 0x1c, 0xe2, 0x5a, 0xc8, 0x2b, 0x64, 0xa9, 0x13,
+	0xff, 0x4b, 0x60, 0xb4, 0xb0, 0xf1, 0xb6, 0x43, 0xdc, 0x01, 0xe7, 0x76, 0x30, 0xcf, 0x20, 0x30,
+	0x27, 0x2b, 0xf0, 0x6f, 0xab, 0x25, 0x64, 0x4d, 0xe6, 0x08, 0x59, 0x23, 0x53, 0x22, 0x1b, 0xa1,
+	0xc4, 0x39, 0x58, 0x08, 0x7b, 0x2f, 0xc2, 0xb2, 0x4f, 0xc7, 0xd7, 0xfb, 0xa4, 0x83, 0x5d, 0xeb,
+	0x73, 0x14, 0x70, 0x26, 0x15, 0x2b, 0x3a, 0xb8, 0xdb, 0x1a, 0xb2, 0x82, 0xbd, 0x49, 0xc4, 0xce,
+	0xca, 0xc4, 0x36, 0x4a, 0x70, 0x31, 0xc9, 0x98, 0x00, 0xd3, 0xa1, 0x45, 0xaa, 0x86, 0x0e, 0xf0,
+	0xfe, 0x4b, 0x46, 0x72, 0x81, 0x56, 0x17, 0xd9, 0x92, 0x80, 0xd1, 0xa4, 0x30, 0x6e, 0xb9, 0xc8,
+	0x24, 0xe8, 0x16, 0xb7, 0xe3, 0xb3, 0x1d, 0x7f, 0xe6, 0x20, 0x97, 0x03, 0x60, 0x2f, 0x3e, 0x47,
+	0x1c, 0xd3, 0x46, 0x01, 0x47, 0xfc, 0x67, 0x7d, 0x1e, 0xb2, 0x7d, 0xd7, 0xe2, 0x26, 0xfd, 0x47,
+	0x5f, 0xcb, 0x46, 0xc4, 0xe4, 0xf9, 0x40, 0x9f, 0x8d, 0x9b, 0x14, 0x81, 0x6c, 0x24, 0x40, 0x30,
+	0xd6, 0x67, 0xe3, 0x41, 0x86, 0xe6, 0xf0, 0xa6, 0xe7, 0xf5, 0x51, 0xca, 0xea, 0x17, 0xc3, 0x19,
+	0xa0, 0xca, 0x0e, 0x51, 0xf9, 0x31, 0x6b, 0xa1, 0xa6, 0x65, 0x9b, 0x5d, 0x8f, 0xa2, 0x9d, 0xaa,
+	0x89, 0x77, 0x5f, 0x66, 0x5b, 0x0e, 0x31, 0x1b, 0x5d, 0x3f, 0x83, 0xb5, 0xe5, 0xe9, 0x9a, 0x78,
+	0x1f, 0x46, 0x27, 0x17, 0x8e, 0x0e, 0x23, 0x76, 0x5e, 0x10, 0xfb, 0x3d, 0x41, 0xec, 0x69, 0x7f,
+	0x6c, 0x63, 0xcd, 0xe7, 0xef, 0xef, 0xcf, 0xca, 0xaf, 0xa5, 0x4c, 0xf3, 0x4d, 0x87, 0x48, 0x7b,
+	0xc4, 0x35, 0x9a, 0xe9, 0x3c, 0x08, 0x22, 0x78, 0xe1, 0x0a, 0xa4, 0x49, 0x15, 0x88, 0x4e, 0xfa,
+	0x5e, 0x83, 0xd9, 0x60, 0xd6, 0xf6, 0x49, 0xc6, 0x4e, 0xc4, 0x60, 0x32, 0x1c, 0x83, 0x57, 0x61,
+	0xde, 0xc1, 0xce, 0x2e, 0x71, 0x4d, 0xc7, 0xdb, 0x43, 0x6e, 0x28, 0x7a, 0xa7, 0x1d, 0xec, 0xd4,
+	0x43, 0xc3, 0xc6, 0x75, 0x38, 0x1b, 0x02, 0x26, 0xfc, 0x59, 0x02, 0x60, 0xfe, 0xf8, 0x41, 0xe0,
+	0xf8, 0x58, 0xa6, 0xd7, 0x07, 0x3d, 0x64, 0x7c, 0xc7, 0x3a, 0x90, 0x2d, 0xcb, 0x21, 0x27, 0xb5,
+	0x0f, 0xbe, 0x99, 0xb6, 0x03, 0x39, 0xc5, 0xeb, 0xf4, 0x14, 0x2b, 0xc0, 0xf1, 0x56, 0x84, 0xa1,
+	0x92, 0xf6, 0xed, 0x47, 0x6c, 0xfb, 0xf1, 0x25, 0x27, 0xb6, 0x71, 0xbf, 0x01, 0xb9, 0x9e, 0xe9,
+	0x9a, 0xb6, 0xc7, 0x01, 0x97, 0x13, 0x00, 0x73, 0x83, 0x77, 0x7d, 0xbd, 0xa0, 0x72, 0xb2, 0x49,
+	0xc6, 0x2a, 0xe5, 0x11, 0x57, 0x10, 0x71, 0x97, 0xea, 0xa9, 0x16, 0xa9, 0xa7, 0xbf, 0xfa, 0xdb,
+	0x49, 0x68, 0xc5, 0x31, 0x5f, 0x29, 0x35, 0x89, 0x78, 0xf1, 0x98, 0x1c, 0x16, 0x8f, 0x45, 0x98,
+	0xee, 0xbb, 0xd6, 0x6e, 0xc7, 0xf4, 0x3a, 0x94, 0x38, 0x33, 0xb5, 0x7c, 0xdf, 0xb5, 0xde, 0x35,
+	0xbd, 0x8e, 0x9f, 0x4f, 0xc4, 0x35, 0x2d, 0xe2, 0x15, 0x72, 0xd4, 0xed, 0x42, 0x82, 0xdb, 0x75,
+	0x5f, 0x61, 0xa3, 0xe0, 0xfb, 0xfb, 0xf7, 0xb3, 0xf2, 0x3c, 0xd3, 0x5f, 0xc1, 0xb6, 0x45, 0x90,
+	0xdd, 0x23, 0x83, 0x1a, 0x5f, 0xc1, 0xf8, 0x82, 0xb2, 0x68, 0xa3, 0xef, 0x3a, 0xc7, 0xfd, 0x28,
+	0xc3, 0x6d, 0x2b, 0x7b, 0xbc, 0xbe, 0x95, 0x19, 0x97, 0xc8, 0xf2, 0x58, 0xee, 0x5b, 0xd3, 0xc2,
+	0x3b, 0x6a, 0x77, 0xf5, 0x02, 0x2d, 0xb7, 0xdc, 0xa7, 0x26, 0xb8, 0xf0, 0x29, 0xa5, 0xbb, 0x2f,
+	0x38, 0x36, 0xdd, 0x47, 0xee, 0xec, 0xac, 0xdd, 0xe1, 0xeb, 0x8b, 0x3d, 0xec, 0x2b, 0xb9, 0x2f,
+	0x4d, 0x6d, 0xfe, 0xa8, 0x91, 0x1b, 0xd9, 0x32, 0xcb, 0x7d, 0x68, 0x14, 0xe2, 0x2f, 0xbc, 0x70,
+	0xe1, 0x96, 0xb5, 0x37, 0x18, 0x8f, 0x4c, 0x94, 0xd7, 0x4c, 0xb8, 0xbc, 0xca, 0x69, 0x97, 0x8d,
+	0xa6, 0x5d, 0x19, 0x66, 0x39, 0x3c, 0xa7, 0x85, 0xee, 0xf3, 0xb4, 0x62, 0x33, 0x36, 0xfd, 0x11,
+	0xfd, 0x26, 0xe4, 0x9b, 0x1d, 0xd3, 0x69, 0x23, 0x8f, 0x77, 0xa5, 0x17, 0x13, 0x3e, 0xfd, 0x3a,
+	0x21, 0xae, 0xd5, 0xe8, 0x13, 0x14, 0xf4, 0x72, 0x7c, 0x8a, 0x71, 0x96, 0x15, 0x39, 0xea, 0x81,
+	0xf0, 0xeb, 0xa1, 0x06, 0xa7, 0x68, 0x03, 0x4a, 0x6a, 0x78, 0x60, 0x76, 0xc9, 0xe0, 0xc5, 0xa2,
+	0x7e, 0x03, 0xf2, 0x2e, 0x5b, 0x87, 0xba, 0x37, 0xbb, 0x56, 0x4c, 0x40, 0xc8, 0x2d, 0x05, 0xf8,
+	0xf8, 0x04, 0xe3, 0x3c, 0xfc, 0x57, 0x42, 0x22, 0x30, 0x0e, 0x28, 0x3b, 0xde, 0x71, 0x4d, 0x87,
+	0xdc, 0x45, 0xae, 0x6d, 0x79, 0x9e, 0x85, 0x9d, 0x93, 0xa9, 0xc5, 0x25, 0x80, 0x9e, 0x58, 0x32,
+	0x88, 0xf8, 0x70, 0x84, 0x93, 0x22, 0x62, 0x5a, 0x00, 0xbb, 0x47, 0xf7, 0x40, 0xd6, 0x98, 0xbd,
+	0x28, 0x32, 0x19, 0x49, 0x36, 0x86, 0x64, 0x09, 0x2e, 0x24, 0xd8, 0x12, 0x50, 0xbe, 0xa4, 0xf4,
+	0x5c, 0x27, 0xc4, 0x6c, 0x76, 0x8e, 0x07, 0x20, 0xdc, 0x89, 0x64, 0xe5, 0xb3, 0x50, 0xc9, 0x27,
+	0xe6, 0x6e, 0xe4, 0xa4, 0x34, 0x43, 0x70, 0x3d, 0xd4, 0xa9, 0xb0, 0xaa, 0xc8, 0x00, 0x48, 0x25,
+	0x65, 0x97, 0x22, 0x7b, 0x0b, 0xbd, 0x0c, 0x64, 0x21, 0xcb, 0xcc, 0x80, 0x64, 0xf9, 0x07, 0xb9,
+	0x1e, 0xa7, 0x0d, 0xce, 0x51, 0xab, 0xca, 0x88, 0x43, 0x64, 0x24, 0x70, 0x53, 0x49, 0x81, 0x93,
+	0x6b, 0x72, 0x42, 0x00, 0x1f, 0xc8, 0x6e, 0xa4, 0x8d, 0xe4, 0xc9, 0xb9, 0x91, 0x00, 0x33, 0x1e,
+	0xed, 0xb5, 0xc7, 0x3a, 0x64, 0xb7, 0xbc, 0xb6, 0xbe, 0x03, 0x39, 0x7e, 0x63, 0x93, 0x54, 0x9d,
+	0xc4, 0x8d, 0x4f, 0xf1, 0xff, 0xa3, 0xa4, 0x82, 0xd7, 0xd9, 0x87, 0x19, 0x4d, 0xb7, 0x60, 0x2e,
+	0x72, 0x19, 0xa4, 0x98, 0x2c, 0x6b, 0x15, 0x57, 0xd2, 0x68, 0xc9, 0xa6, 0xee, 0x40, 0x3e, 0xb8,
+	0x16, 0x59, 0x52, 0x03, 0xdc, 0xbe, 0x5d, 0x2f, 0x5e, 0x1e, 0x29, 0x16, 0x8d, 0x59, 0x1b, 0x4e,
+	0x47, 0xef, 0x5b, 0x2e, 0x8f, 0x87, 0xe5, 0x1b, 0xb8, 0x9a, 0x4a, 0x4d, 0x18, 0xa2, 0xc8, 0xd9,
+	0x2d, 0x89, 0x12, 0x39, 0x15, 0xab, 0x91, 0x4b, 0x97, 0x10, 0xfa, 0x47, 0x30, 0x13, 0xba, 0x80,
+	0x48, 0x9e, 0x23, 0x14, 0x8a, 0xaf, 0x8c, 0x51, 0x10, 0xcb, 0xda, 0x70, 0x26, 0x7e, 0x82, 0x57,
+	0xcc, 0x8e, 0x29, 0x16, 0xab, 0x29, 0x15, 0x85, 0xb9, 0x16, 0xcc, 0x45, 0xce, 0xe8, 0x0a, 0xee,
+	0xc8, 0x5a, 0x2a, 0xee, 0x24, 0x9f, 0xc2, 0x7d, 0x2b, 0x91, 0x23, 0xb8, 0xc2, 0x8a, 0xac, 0xa5,
+	0xb2, 0xa2, 0x38, 0x69, 0xd7, 0x21, 0x1f, 0x1c, 0xa2, 0x15, 0x9f, 0x98, 0x8b, 0x55, 0x9f, 0x38,
+	0x72, 0xfa, 0x64, 0x94, 0xaf, 0xc1, 0xb4, 0x38, 0x5f, 0x96, 0x46, 0xcc, 0xf3, 0xa9, 0x73, 0x65,
+	0xb4, 0x5c, 0x20, 0xdd, 0x81, 0x1c, 0x3f, 0xe3, 0x29, 0x8a, 0x00, 0x93, 0xaa, 0x8a, 0x80, 0x7c,
+	0x12, 0x13, 0x99, 0x19, 0x1c, 0xc3, 0x96, 0xd4, 0xb3, 0x46, 0xf0, 0x3b, 0x7a, 0x64, 0xda, 0x81,
+	0x1c, 0x6f, 0xd1, 0x15, 0x18, 0x99, 0x54, 0x85, 0x51, 0xee, 0x9e, 0x63, 0x85, 0x8a, 0x2f, 0x3d,
+	0xa6, 0x50, 0x71, 0x13, 0x2b, 0x69, 0xb4, 0x62, 0xe1, 0x08, 0xfa, 0xe4, 0x25, 0x35, 0xc0, 0x11,
+	0xe1, 0x88, 0x74, 0xb8, 0xe1, 0x42, 0x15, 0x2c, 0x7c, 0x79, 0x3c, 0xac, 0x14, 0x85, 0x2a, 0x6a,
+	0xe8, 0x03, 0xc8, 0xf1, 0x36, 0x5a, 0xc5, 0x0d, 0x2a, 0x55, 0x72, 0x43, 0x6a, 0x60, 0xf5, 0x8f,
+	0x01, 0x42, 0xcd, 0xeb, 0x25, 0x55, 0x69, 0x0b, 0x34, 0x8a, 0xcb, 0xe3, 0x34, 0xc2, 0x01, 0x89,
+	0xf6, 0x9c, 0x8a, 0x80, 0x44, 0xd4, 0x54, 0x01, 0x51, 0xb4, 0x91, 0xfa, 0x3d, 0x98, 0x8f, 0xf5,
+	0x90, 0x57, 0x46, 0x95, 0x9f, 0x90, 0xa9, 0x4a, 0x3a, 0xbd, 0x30, 0xe9, 0x79, 0x1f, 0xa4, 0x08,
+	0x3e, 0x93, 0xaa, 0x82, 0x2f, 0xb7, 0x27, 0x8c, 0x89, 0x3b, 0x90, 0xe3, 0x3d, 0x89, 0x62, 0x49,
+	0x26, 0x55, 0x2d, 0x29, 0xb7, 0x12, 0xb1, 0x3c, 0xe2, 0x68, 0xc7, 0xe4, 0x11, 0x47, 0xbd, 0x92,
+	0x46, 0x4b, 0x69, 0x8a, 0x7b, 0x31, 0xc6, 0x14, 0xf7, 0x66, 0x25, 0x8d, 0x96, 0x64, 0x6a, 0xe3,
+	0xfd, 0x1f, 0x0f, 0x4b, 0x13, 0x4f, 0x0e, 0x4b, 0xda, 0xd3, 0xc3, 0x92, 0xf6, 0xd7, 0x61, 0x49,
+	0x7b, 0xf4, 0xbc, 0x34, 0xf1, 0xf4, 0x79, 0x69, 0xe2, 0xb7, 0xe7, 0xa5, 0x89, 0x4f, 0xae, 0x8e,
+	0xbd, 0x57, 0xbc, 0x1f, 0xfa, 0xa3, 0xd7, 0xc8, 0xd1, 0x5f, 0x7a, 0xd7, 0xfe, 0x09, 0x00, 0x00,
+	0xff, 0xff, 0xbb, 0xb5, 0x6e, 0x2a, 0x5d, 0x1c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2813,6 +3267,24 @@ type MsgClient interface {
 	// - transfer_nft_from (deprecated, not typed)
 	// - operation_transfer_nft (deprecated, not typed)
 	OperatorSendNFT(ctx context.Context, in *MsgOperatorSendNFT, opts ...grpc.CallOption) (*MsgOperatorSendNFTResponse, error)
+	// SellNFT defines a method to sell a non-fungible token. It sends the token to the buyer,
+	// and pays the price to the seller and the royalty recipient at once.
+	// Note: the royalties apply only to the sales by this method, not to the other transfers of the tokens.
+	// Fires:
+	// - EventSent
+	// - EventSoldNFT
+	// Throws:
+	// - ErrTokenNotOwnedBy: the seller does not own the token.
+	// - ErrInsufficientFunds: the buyer does not have enough coins to pay the price.
+	SellNFT(ctx context.Context, in *MsgSellNFT, opts ...grpc.CallOption) (*MsgSellNFTResponse, error)
+	// BatchSend defines a method to send tokens from one account to many accounts at once.
+	// All the entries succeed or fail together, and the gas consumed grows linearly with the number of entries.
+	// Fires:
+	// - EventSent (one per entry)
+	// Throws:
+	// - ErrTokenNotOwnedBy: the sender does not own one of the non-fungible tokens.
+	// - ErrInsufficientFunds: the sender does not have enough fungible tokens.
+	BatchSend(ctx context.Context, in *MsgBatchSend, opts ...grpc.CallOption) (*MsgBatchSendResponse, error)
 	// AuthorizeOperator allows one to send tokens on behalf of the holder.
 	// Fires:
 	// - EventAuthorizedOperator
@@ -2889,6 +3361,14 @@ type MsgClient interface {
 	// - modify_token (deprecated, not typed)
 	// - EventModifiedNFT
 	Modify(ctx context.Context, in *MsgModify, opts ...grpc.CallOption) (*MsgModifyResponse, error)
+	// SetRoyalty defines a method to set the royalty of a contract or a non-fungible token class.
+	// A zero rate removes the royalty.
+	// Fires:
+	// - EventSetRoyalty
+	// Throws:
+	// - ErrTokenNoPermission: the operator does not have the modify permission.
+	// - ErrTokenNotExist: the class does not exist.
+	SetRoyalty(ctx context.Context, in *MsgSetRoyalty, opts ...grpc.CallOption) (*MsgSetRoyaltyResponse, error)
 	// GrantPermission allows one to mint or burn tokens or modify metadata.
 	// Fires:
 	// - EventGranted
@@ -2971,6 +3451,24 @@ func (c *msgClient) OperatorSendNFT(ctx context.Context, in *MsgOperatorSendNFT,
 	return out, nil
 }
 
+func (c *msgClient) SellNFT(ctx context.Context, in *MsgSellNFT, opts ...grpc.CallOption) (*MsgSellNFTResponse, error) {
+	out := new(MsgSellNFTResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/SellNFT", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BatchSend(ctx context.Context, in *MsgBatchSend, opts ...grpc.CallOption) (*MsgBatchSendResponse, error) {
+	out := new(MsgBatchSendResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/BatchSend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) AuthorizeOperator(ctx context.Context, in *MsgAuthorizeOperator, opts ...grpc.CallOption) (*MsgAuthorizeOperatorResponse, error) {
 	out := new(MsgAuthorizeOperatorResponse)
 	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/AuthorizeOperator", in, out, opts...)
@@ -3083,6 +3581,15 @@ func (c *msgClient) Modify(ctx context.Context, in *MsgModify, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *msgClient) SetRoyalty(ctx context.Context, in *MsgSetRoyalty, opts ...grpc.CallOption) (*MsgSetRoyaltyResponse, error) {
+	out := new(MsgSetRoyaltyResponse)
+	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/SetRoyalty", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) GrantPermission(ctx context.Context, in *MsgGrantPermission, opts ...grpc.CallOption) (*MsgGrantPermissionResponse, error) {
 	out := new(MsgGrantPermissionResponse)
 	err := c.cc.Invoke(ctx, "/lbm.collection.v1.Msg/GrantPermission", in, out, opts...)
@@ -3165,6 +3672,24 @@ type MsgServer interface {
 	// - transfer_nft_from (deprecated, not typed)
 	// - operation_transfer_nft (deprecated, not typed)
 	OperatorSendNFT(context.Context, *MsgOperatorSendNFT) (*MsgOperatorSendNFTResponse, error)
+	// SellNFT defines a method to sell a non-fungible token. It sends the token to the buyer,
+	// and pays the price to the seller and the royalty recipient at once.
+	// Note: the royalties apply only to the sales by this method, not to the other transfers of the tokens.
+	// Fires:
+	// - EventSent
+	// - EventSoldNFT
+	// Throws:
+	// - ErrTokenNotOwnedBy: the seller does not own the token.
+	// - ErrInsufficientFunds: the buyer does not have enough coins to pay the price.
+	SellNFT(context.Context, *MsgSellNFT) (*MsgSellNFTResponse, error)
+	// BatchSend defines a method to send tokens from one account to many accounts at once.
+	// All the entries succeed or fail together, and the gas consumed grows linearly with the number of entries.
+	// Fires:
+	// - EventSent (one per entry)
+	// Throws:
+	// - ErrTokenNotOwnedBy: the sender does not own one of the non-fungible tokens.
+	// - ErrInsufficientFunds: the sender does not have enough fungible tokens.
+	BatchSend(context.Context, *MsgBatchSend) (*MsgBatchSendResponse, error)
 	// AuthorizeOperator allows one to send tokens on behalf of the holder.
 	// Fires:
 	// - EventAuthorizedOperator
@@ -3241,6 +3766,14 @@ type MsgServer interface {
 	// - modify_token (deprecated, not typed)
 	// - EventModifiedNFT
 	Modify(context.Context, *MsgModify) (*MsgModifyResponse, error)
+	// SetRoyalty defines a method to set the royalty of a contract or a non-fungible token class.
+	// A zero rate removes the royalty.
+	// Fires:
+	// - EventSetRoyalty
+	// Throws:
+	// - ErrTokenNoPermission: the operator does not have the modify permission.
+	// - ErrTokenNotExist: the class does not exist.
+	SetRoyalty(context.Context, *MsgSetRoyalty) (*MsgSetRoyaltyResponse, error)
 	// GrantPermission allows one to mint or burn tokens or modify metadata.
 	// Fires:
 	// - EventGranted
@@ -3293,6 +3826,12 @@ func (*UnimplementedMsgServer) SendNFT(ctx context.Context, req *MsgSendNFT) (*M
 func (*UnimplementedMsgServer) OperatorSendNFT(ctx context.Context, req *MsgOperatorSendNFT) (*MsgOperatorSendNFTResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OperatorSendNFT not implemented")
 }
+func (*UnimplementedMsgServer) SellNFT(ctx context.Context, req *MsgSellNFT) (*MsgSellNFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SellNFT not implemented")
+}
+func (*UnimplementedMsgServer) BatchSend(ctx context.Context, req *MsgBatchSend) (*MsgBatchSendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchSend not implemented")
+}
 func (*UnimplementedMsgServer) AuthorizeOperator(ctx context.Context, req *MsgAuthorizeOperator) (*MsgAuthorizeOperatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AuthorizeOperator not implemented")
 }
@@ -3329,6 +3868,9 @@ func (*UnimplementedMsgServer) OperatorBurnNFT(ctx context.Context, req *MsgOper
 func (*UnimplementedMsgServer) Modify(ctx context.Context, req *MsgModify) (*MsgModifyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Modify not implemented")
 }
+func (*UnimplementedMsgServer) SetRoyalty(ctx context.Context, req *MsgSetRoyalty) (*MsgSetRoyaltyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRoyalty not implemented")
+}
 func (*UnimplementedMsgServer) GrantPermission(ctx context.Context, req *MsgGrantPermission) (*MsgGrantPermissionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GrantPermission not implemented")
 }
@@ -3424,6 +3966,42 @@ func _Msg_OperatorSendNFT_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SellNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSellNFT)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SellNFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Msg/SellNFT",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SellNFT(ctx, req.(*MsgSellNFT))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BatchSend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchSend)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchSend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Msg/BatchSend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchSend(ctx, req.(*MsgBatchSend))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_AuthorizeOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgAuthorizeOperator)
 	if err := dec(in); err != nil {
@@ -3640,6 +4218,24 @@ func _Msg_Modify_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetRoyalty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRoyalty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRoyalty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.collection.v1.Msg/SetRoyalty",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRoyalty(ctx, req.(*MsgSetRoyalty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_GrantPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgGrantPermission)
 	if err := dec(in); err != nil {
@@ -3768,6 +4364,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "OperatorSendNFT",
 			Handler:    _Msg_OperatorSendNFT_Handler,
 		},
+		{
+			MethodName: "SellNFT",
+			Handler:    _Msg_SellNFT_Handler,
+		},
+		{
+			MethodName: "BatchSend",
+			Handler:    _Msg_BatchSend_Handler,
+		},
 		{
 			MethodName: "AuthorizeOperator",
 			Handler:    _Msg_AuthorizeOperator_Handler,
@@ -3816,6 +4420,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Modify",
 			Handler:    _Msg_Modify_Handler,
 		},
+		{
+			MethodName: "SetRoyalty",
+			Handler:    _Msg_SetRoyalty_Handler,
+		},
 		{
 			MethodName: "GrantPermission",
 			Handler:    _Msg_GrantPermission_Handler,
@@ -4173,7 +4781,7 @@ func (m *MsgOperatorSendNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAuthorizeOperator) Marshal() (dAtA []byte, err error) {
+func (m *MsgSellNFT) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4183,27 +4791,48 @@ func (m *MsgAuthorizeOperator) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgAuthorizeOperator) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSellNFT) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAuthorizeOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSellNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+	if len(m.Price) > 0 {
+		for iNdEx := len(m.Price) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Price[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Buyer)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Holder) > 0 {
-		i -= len(m.Holder)
-		copy(dAtA[i:], m.Holder)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Holder)))
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Seller)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -4217,7 +4846,7 @@ func (m *MsgAuthorizeOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAuthorizeOperatorResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSellNFTResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4227,12 +4856,12 @@ func (m *MsgAuthorizeOperatorResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgAuthorizeOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSellNFTResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAuthorizeOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSellNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4240,7 +4869,7 @@ func (m *MsgAuthorizeOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRevokeOperator) Marshal() (dAtA []byte, err error) {
+func (m *MsgBatchSend) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4250,27 +4879,34 @@ func (m *MsgRevokeOperator) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRevokeOperator) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgBatchSend) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRevokeOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgBatchSend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Operator) > 0 {
-		i -= len(m.Operator)
-		copy(dAtA[i:], m.Operator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
-		i--
-		dAtA[i] = 0x1a
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if len(m.Holder) > 0 {
-		i -= len(m.Holder)
-		copy(dAtA[i:], m.Holder)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Holder)))
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -4284,7 +4920,7 @@ func (m *MsgRevokeOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRevokeOperatorResponse) Marshal() (dAtA []byte, err error) {
+func (m *BatchSendEntry) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4294,20 +4930,50 @@ func (m *MsgRevokeOperatorResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRevokeOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *BatchSendEntry) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRevokeOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BatchSendEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.TokenIds) > 0 {
+		for iNdEx := len(m.TokenIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TokenIds[iNdEx])
+			copy(dAtA[i:], m.TokenIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.TokenIds[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateContract) Marshal() (dAtA []byte, err error) {
+func (m *MsgBatchSendResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4317,22 +4983,179 @@ func (m *MsgCreateContract) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCreateContract) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgBatchSendResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgBatchSendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Meta) > 0 {
-		i -= len(m.Meta)
-		copy(dAtA[i:], m.Meta)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Meta)))
-		i--
-		dAtA[i] = 0x22
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAuthorizeOperator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAuthorizeOperator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAuthorizeOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAuthorizeOperatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAuthorizeOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAuthorizeOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeOperator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeOperator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeOperatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Meta) > 0 {
+		i -= len(m.Meta)
+		copy(dAtA[i:], m.Meta)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Meta)))
+		i--
+		dAtA[i] = 0x22
 	}
 	if len(m.Uri) > 0 {
 		i -= len(m.Uri)
@@ -4521,6 +5344,16 @@ func (m *MsgIssueNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NonTransferable {
+		i--
+		if m.NonTransferable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.Owner) > 0 {
 		i -= len(m.Owner)
 		copy(dAtA[i:], m.Owner)
@@ -4773,6 +5606,34 @@ func (m *MintNFTParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Traits) > 0 {
+		for iNdEx := len(m.Traits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Traits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.UriHash) > 0 {
+		i -= len(m.UriHash)
+		copy(dAtA[i:], m.UriHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UriHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Uri) > 0 {
+		i -= len(m.Uri)
+		copy(dAtA[i:], m.Uri)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Uri)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Meta) > 0 {
 		i -= len(m.Meta)
 		copy(dAtA[i:], m.Meta)
@@ -5185,6 +6046,76 @@ func (m *MsgModifyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetRoyalty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRoyalty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRoyalty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Royalty.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRoyaltyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRoyaltyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRoyaltyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgGrantPermission) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5785,7 +6716,7 @@ func (m *MsgOperatorSendNFTResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgAuthorizeOperator) Size() (n int) {
+func (m *MsgSellNFT) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5795,11 +6726,108 @@ func (m *MsgAuthorizeOperator) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.Holder)
+	l = len(m.Seller)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.Operator)
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Price) > 0 {
+		for _, e := range m.Price {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSellNFTResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBatchSend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BatchSendEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.TokenIds) > 0 {
+		for _, s := range m.TokenIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgBatchSendResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAuthorizeOperator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
@@ -5955,6 +6983,9 @@ func (m *MsgIssueNFT) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.NonTransferable {
+		n += 2
+	}
 	return n
 }
 
@@ -6067,6 +7098,20 @@ func (m *MintNFTParam) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.Uri)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.UriHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Traits) > 0 {
+		for _, e := range m.Traits {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -6246,6 +7291,34 @@ func (m *MsgModifyResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetRoyalty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Royalty.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetRoyaltyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgGrantPermission) Size() (n int) {
 	if m == nil {
 		return 0
@@ -7432,7 +8505,7 @@ func (m *MsgOperatorSendNFTResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAuthorizeOperator) Unmarshal(dAtA []byte) error {
+func (m *MsgSellNFT) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7455,10 +8528,10 @@ func (m *MsgAuthorizeOperator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAuthorizeOperator: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSellNFT: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAuthorizeOperator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSellNFT: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7495,7 +8568,7 @@ func (m *MsgAuthorizeOperator) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7523,11 +8596,11 @@ func (m *MsgAuthorizeOperator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Holder = string(dAtA[iNdEx:postIndex])
+			m.Seller = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7555,7 +8628,73 @@ func (m *MsgAuthorizeOperator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = append(m.Price, types.Coin{})
+			if err := m.Price[len(m.Price)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7578,7 +8717,7 @@ func (m *MsgAuthorizeOperator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAuthorizeOperatorResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSellNFTResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7601,10 +8740,10 @@ func (m *MsgAuthorizeOperatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAuthorizeOperatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSellNFTResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAuthorizeOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSellNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7628,7 +8767,7 @@ func (m *MsgAuthorizeOperatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevokeOperator) Unmarshal(dAtA []byte) error {
+func (m *MsgBatchSend) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7651,10 +8790,10 @@ func (m *MsgRevokeOperator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevokeOperator: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBatchSend: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevokeOperator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBatchSend: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7691,7 +8830,7 @@ func (m *MsgRevokeOperator) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7719,13 +8858,13 @@ func (m *MsgRevokeOperator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Holder = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7735,23 +8874,25 @@ func (m *MsgRevokeOperator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.Entries = append(m.Entries, BatchSendEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7774,7 +8915,7 @@ func (m *MsgRevokeOperator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevokeOperatorResponse) Unmarshal(dAtA []byte) error {
+func (m *BatchSendEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7797,25 +8938,565 @@ func (m *MsgRevokeOperatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevokeOperatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchSendEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevokeOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchSendEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIds = append(m.TokenIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBatchSendResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBatchSendResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBatchSendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAuthorizeOperator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAuthorizeOperator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAuthorizeOperator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAuthorizeOperatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAuthorizeOperatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAuthorizeOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeOperator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeOperator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeOperator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeOperatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeOperatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
 
@@ -8606,6 +10287,26 @@ func (m *MsgIssueNFT) Unmarshal(dAtA []byte) error {
 			}
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonTransferable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NonTransferable = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9211,28 +10912,92 @@ func (m *MintNFTParam) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowTx
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintNFTParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintNFTParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MintNFTParam: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MintNFTParam: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9260,11 +11025,11 @@ func (m *MintNFTParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenType = string(dAtA[iNdEx:postIndex])
+			m.Meta = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9292,11 +11057,11 @@ func (m *MintNFTParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Uri = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UriHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9324,7 +11089,41 @@ func (m *MintNFTParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Meta = string(dAtA[iNdEx:postIndex])
+			m.UriHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Traits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Traits = append(m.Traits, Trait{})
+			if err := m.Traits[len(m.Traits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10461,6 +12260,203 @@ func (m *MsgModifyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetRoyalty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRoyalty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRoyalty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Royalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRoyaltyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRoyaltyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRoyaltyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgGrantPermission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
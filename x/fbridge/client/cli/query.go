@@ -14,6 +14,8 @@ import (
 
 const (
 	flagSequences = "sequences"
+	flagAddress   = "address"
+	flagDenom     = "denom"
 )
 
 // NewQueryCmd returns the query commands for fbridge module
@@ -27,6 +29,17 @@ func NewQueryCmd() *cobra.Command {
 		NewQueryParamsCmd(),
 		NewQueryNextSeqSendCmd(),
 		NewQuerySeqToBlocknumsCmd(),
+		NewQueryTransferUsageCmd(),
+		NewQueryDenomsCmd(),
+		NewQueryDenomCmd(),
+		NewQuerySeqsByDenomCmd(),
+		NewQueryConfirmedSeqsByDenomCmd(),
+		NewQueryGreatestSeqByOperatorCmd(),
+		NewQueryGreatestConsecutiveConfirmedSeqCmd(),
+		NewQuerySubmittedProvisionCmd(),
+		NewQueryConfirmedProvisionCmd(),
+		NewQueryNeededSubmissionSeqsCmd(),
+		NewQueryCommitmentsCmd(),
 		NewQueryMembersCmd(),
 		NewQueryMemberCmd(),
 		NewQueryProposalsCmd(),
@@ -124,6 +137,346 @@ func NewQuerySeqToBlocknumsCmd() *cobra.Command {
 	return cmd
 }
 
+func NewQueryTransferUsageCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "transfer-usage",
+		Short:   "Query the outflow of a denom during its current transfer window",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query %s transfer-usage --denom=cony --address=link1...", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			qc := types.NewQueryClient(clientCtx)
+
+			address, err := cmd.Flags().GetString(flagAddress)
+			if err != nil {
+				return err
+			}
+
+			denom, err := cmd.Flags().GetString(flagDenom)
+			if err != nil {
+				return err
+			}
+
+			res, err := qc.TransferUsage(cmd.Context(), &types.QueryTransferUsageRequest{Address: address, Denom: denom})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagAddress, "", "the sender address to query the outflow of")
+	cmd.Flags().String(flagDenom, "", "the denom to query the outflow of (defaults to the target denom)")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewQueryDenomsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denoms",
+		Short: "Query the registry of bridgeable denoms",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			qc := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := qc.Denoms(cmd.Context(), &types.QueryDenomsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "denoms")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewQueryDenomCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "denom [denom]",
+		Short:   "Query a bridgeable denom",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query %s denom cony", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			qc := types.NewQueryClient(clientCtx)
+
+			res, err := qc.Denom(cmd.Context(), &types.QueryDenomRequest{Denom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewQuerySeqsByDenomCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "seqs-by-denom [denom]",
+		Short:   "Query the sequence numbers of bridge requests of a specific denom",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query %s seqs-by-denom cony", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			qc := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := qc.SeqsByDenom(cmd.Context(), &types.QuerySeqsByDenomRequest{Denom: args[0], Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "seqs-by-denom")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewQueryConfirmedSeqsByDenomCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "confirmed-seqs-by-denom [denom]",
+		Short:   "Query the sequence numbers of confirmed provisions of a specific denom",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query %s confirmed-seqs-by-denom cony", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			qc := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := qc.ConfirmedSeqsByDenom(cmd.Context(), &types.QueryConfirmedSeqsByDenomRequest{Denom: args[0], Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "confirmed-seqs-by-denom")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewQueryGreatestSeqByOperatorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "greatest-seq-by-operator [operator]",
+		Short:   "Query the greatest sequence number submitted by a specific operator",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query %s greatest-seq-by-operator link1...", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			qc := types.NewQueryClient(clientCtx)
+
+			res, err := qc.GreatestSeqByOperator(cmd.Context(), &types.QueryGreatestSeqByOperatorRequest{Operator: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewQueryGreatestConsecutiveConfirmedSeqCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "greatest-confirmed-seq",
+		Short:   "Query the greatest consecutive sequence number confirmed by n-of-m operators",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query %s greatest-confirmed-seq", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			qc := types.NewQueryClient(clientCtx)
+
+			res, err := qc.GreatestConsecutiveConfirmedSeq(cmd.Context(), &types.QueryGreatestConsecutiveConfirmedSeqRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewQuerySubmittedProvisionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "submitted-provision [operator] [seq]",
+		Short:   "Query the provision of a specific sequence submitted by a specific operator",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query %s submitted-provision link1... 1", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			qc := types.NewQueryClient(clientCtx)
+
+			seq, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := qc.SubmittedProvision(cmd.Context(), &types.QuerySubmittedProvisionRequest{Operator: args[0], Seq: seq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewQueryConfirmedProvisionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "confirmed-provision [seq]",
+		Short:   "Query the provision of a specific sequence confirmed by n-of-m operators",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query %s confirmed-provision 1", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			qc := types.NewQueryClient(clientCtx)
+
+			seq, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := qc.ConfirmedProvision(cmd.Context(), &types.QueryConfirmedProvisionRequest{Seq: seq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewQueryNeededSubmissionSeqsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "needed-submission-seqs [operator] [range]",
+		Short:   "Query the sequence numbers which a specific operator needs to submit",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query %s needed-submission-seqs link1... 100", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			qc := types.NewQueryClient(clientCtx)
+
+			searchRange, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := qc.NeededSubmissionSeqs(cmd.Context(), &types.QueryNeededSubmissionSeqsRequest{Operator: args[0], Range: searchRange})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewQueryCommitmentsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "commitments [seq]",
+		Short:   "Query the commitments submitted by operators for a specific sequence",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query %s commitments 1", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			qc := types.NewQueryClient(clientCtx)
+
+			seq, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := qc.Commitments(cmd.Context(), &types.QueryCommitmentsRequest{Seq: seq, Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "commitments")
+	return cmd
+}
+
 func NewQueryMembersCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "members [role]",
@@ -27,6 +27,12 @@ func NewTxCmd() *cobra.Command {
 
 	TxCmd.AddCommand(
 		NewTransferTxCmd(),
+		NewProvisionTxCmd(),
+		NewHoldTransferTxCmd(),
+		NewReleaseTransferTxCmd(),
+		NewRemoveProvisionTxCmd(),
+		NewClaimBatchTxCmd(),
+		NewClaimTxCmd(),
 		NewSuggestRoleTxCmd(),
 		NewAddVoteForRoleTxCmd(),
 		NewSetBridgeStatusTxCmd(),
@@ -63,6 +69,224 @@ func NewTransferTxCmd() *cobra.Command {
 				Sender:   fromAddr,
 				Receiver: toAddr,
 				Amount:   coins[0].Amount,
+				Denom:    coins[0].Denom,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewProvisionTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "provision [seq] [sender] [receiver] [amount]",
+		Short:   `Submit a provision of the bridge request from counterparty chain (operator only)`,
+		Example: fmt.Sprintf("%s tx %s provision 1 0xf7bAc63fc7CEaCf0589F25454Ecf5C2ce904997c link1... 1000 --from operatorkey", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			from := clientCtx.GetFromAddress().String()
+			if _, err := sdk.AccAddressFromBech32(from); err != nil {
+				return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", from)
+			}
+			seq, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("invalid sequence: %s", args[0])
+			}
+			amount, ok := sdk.NewIntFromString(args[3])
+			if !ok {
+				return sdkerrors.ErrInvalidRequest.Wrapf("invalid amount: %s", args[3])
+			}
+			denom, err := cmd.Flags().GetString(flagDenom)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgProvision{
+				From:     from,
+				Seq:      seq,
+				Sender:   args[1],
+				Receiver: args[2],
+				Amount:   amount,
+				Denom:    denom,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(flagDenom, "", "the denom of token to be claimed (defaults to the target denom)")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewHoldTransferTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "hold-transfer [seq]",
+		Short:   `Hold the confirmed provision of a specific sequence until it is released (judge only)`,
+		Example: fmt.Sprintf("%s tx %s hold-transfer 1 --from judgekey", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			from := clientCtx.GetFromAddress().String()
+			if _, err := sdk.AccAddressFromBech32(from); err != nil {
+				return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", from)
+			}
+			seq, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("invalid sequence: %s", args[0])
+			}
+
+			msg := types.MsgHoldTransfer{
+				From: from,
+				Seq:  seq,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewReleaseTransferTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "release-transfer [seq]",
+		Short:   `Release the timelock of the confirmed provision of a specific sequence (guardian only)`,
+		Example: fmt.Sprintf("%s tx %s release-transfer 1 --from guardiankey", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			from := clientCtx.GetFromAddress().String()
+			if _, err := sdk.AccAddressFromBech32(from); err != nil {
+				return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", from)
+			}
+			seq, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("invalid sequence: %s", args[0])
+			}
+
+			msg := types.MsgReleaseTransfer{
+				From: from,
+				Seq:  seq,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewRemoveProvisionTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove-provision [seq]",
+		Short:   `Vote to remove the confirmed provision of a specific sequence (judge only)`,
+		Example: fmt.Sprintf("%s tx %s remove-provision 1 --from judgekey", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			from := clientCtx.GetFromAddress().String()
+			if _, err := sdk.AccAddressFromBech32(from); err != nil {
+				return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", from)
+			}
+			seq, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("invalid sequence: %s", args[0])
+			}
+
+			msg := types.MsgRemoveProvision{
+				From: from,
+				Seq:  seq,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewClaimBatchTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "claim-batch [max_claims]",
+		Short:   `Claim the claimable provisions up to max_claims in ascending order of the sequence`,
+		Example: fmt.Sprintf("%s tx %s claim-batch 10 --from mykey", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			from := clientCtx.GetFromAddress().String()
+			if _, err := sdk.AccAddressFromBech32(from); err != nil {
+				return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", from)
+			}
+			maxClaims, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("invalid max claims: %s", args[0])
+			}
+
+			msg := types.MsgClaimBatch{
+				From:      from,
+				MaxClaims: maxClaims,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewClaimTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "claim [seq]",
+		Short:   `Claim the confirmed provision of a specific sequence`,
+		Example: fmt.Sprintf("%s tx %s claim 1 --from mykey", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			from := clientCtx.GetFromAddress().String()
+			if _, err := sdk.AccAddressFromBech32(from); err != nil {
+				return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", from)
+			}
+			seq, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("invalid sequence: %s", args[0])
+			}
+
+			msg := types.MsgClaim{
+				From: from,
+				Seq:  seq,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
@@ -10,6 +10,9 @@ import (
 func (k Keeper) BeginBlocker(ctx sdk.Context) {
 	k.InitMemStore(ctx)
 
+	k.deleteBlockOutflows(ctx)
+	k.updateTransferWindow(ctx)
+
 	proposals := k.GetRoleProposals(ctx)
 	for _, proposal := range proposals {
 		if ctx.BlockTime().After(proposal.ExpiredAt) {
@@ -19,6 +22,8 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) {
 }
 
 func (k Keeper) EndBlocker(ctx sdk.Context) {
+	k.checkOutflowAnomaly(ctx)
+
 	guardianTrustLevel := k.GetParams(ctx).GuardianTrustLevel
 	proposals := k.GetRoleProposals(ctx)
 	for _, proposal := range proposals {
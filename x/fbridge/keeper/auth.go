@@ -65,6 +65,9 @@ func (k Keeper) updateRole(ctx sdk.Context, role types.Role, addr sdk.AccAddress
 		return sdkerrors.ErrInvalidRequest.Wrap("target already has same role")
 	}
 
+	prevStatus := k.GetBridgeStatus(ctx)
+	defer k.emitBridgeStatusTransition(ctx, prevStatus, types.BridgeStatusReasonRoleUpdate)
+
 	roleMeta := k.GetRoleMetadata(ctx)
 	nInactive := k.GetBridgeInactiveCounter(ctx)
 
@@ -91,6 +94,8 @@ func (k Keeper) updateRole(ctx sdk.Context, role types.Role, addr sdk.AccAddress
 
 	if role == types.RoleEmpty {
 		k.deleteRole(ctx, addr)
+		k.setRoleMetadata(ctx, roleMeta)
+		k.setBridgeInactiveCounter(ctx, nInactive)
 		return nil
 	} else {
 		if err := k.setRole(ctx, role, addr); err != nil {
@@ -123,6 +128,7 @@ func (k Keeper) updateBridgeSwitch(ctx sdk.Context, guardian sdk.AccAddress, sta
 		return err
 	}
 
+	prevStatus := k.GetBridgeStatus(ctx)
 	nInactive := k.GetBridgeInactiveCounter(ctx)
 	switch status {
 	case types.StatusActive:
@@ -138,9 +144,29 @@ func (k Keeper) updateBridgeSwitch(ctx sdk.Context, guardian sdk.AccAddress, sta
 		return err
 	}
 
+	k.emitBridgeStatusTransition(ctx, prevStatus, types.BridgeStatusReasonGuardianVote)
+
 	return nil
 }
 
+// tripCircuitBreaker halts the bridge by turning off the bridge switches of all guardians.
+// The bridge resumes once enough guardians turn their switches on again.
+func (k Keeper) tripCircuitBreaker(ctx sdk.Context, reason string) {
+	prevStatus := k.GetBridgeStatus(ctx)
+	for _, sw := range k.GetBridgeSwitches(ctx) {
+		if sw.Status == types.StatusInactive {
+			continue
+		}
+
+		if err := k.setBridgeSwitch(ctx, sdk.MustAccAddressFromBech32(sw.Guardian), types.StatusInactive); err != nil {
+			panic(err)
+		}
+	}
+	k.setBridgeInactiveCounter(ctx, k.GetRoleMetadata(ctx).Guardian)
+
+	k.emitBridgeStatusTransition(ctx, prevStatus, reason)
+}
+
 func (k Keeper) setNextProposalID(ctx sdk.Context, seq uint64) {
 	store := ctx.KVStore(k.storeKey)
 	bz := make([]byte, 8)
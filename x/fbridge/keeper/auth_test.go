@@ -3,16 +3,19 @@ package keeper
 import (
 	"testing"
 
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/Finschia/finschia-sdk/types"
 	"github.com/Finschia/finschia-sdk/x/fbridge/testutil"
 	"github.com/Finschia/finschia-sdk/x/fbridge/types"
 )
 
 func TestAssignRole(t *testing.T) {
-	key, memKey, ctx, encCfg, authKeeper, bankKeeper, addrs := testutil.PrepareFbridgeTest(t, 3)
+	key, memKey, ctx, encCfg, authKeeper, bankKeeper, foundationKeeper, addrs := testutil.PrepareFbridgeTest(t, 3)
 	auth := types.DefaultAuthority()
-	k := NewKeeper(encCfg.Codec, key, memKey, authKeeper, bankKeeper, auth.String())
+	k := NewKeeper(encCfg.Codec, key, memKey, authKeeper, bankKeeper, foundationKeeper, auth.String())
 	err := k.InitGenesis(ctx, types.DefaultGenesisState())
 	require.NoError(t, err)
 
@@ -59,9 +62,9 @@ func TestAssignRole(t *testing.T) {
 }
 
 func TestBridgeHaltAndResume(t *testing.T) {
-	key, memKey, ctx, encCfg, authKeeper, bankKeeper, addrs := testutil.PrepareFbridgeTest(t, 3)
+	key, memKey, ctx, encCfg, authKeeper, bankKeeper, foundationKeeper, addrs := testutil.PrepareFbridgeTest(t, 3)
 	auth := types.DefaultAuthority()
-	k := NewKeeper(encCfg.Codec, key, memKey, authKeeper, bankKeeper, auth.String())
+	k := NewKeeper(encCfg.Codec, key, memKey, authKeeper, bankKeeper, foundationKeeper, auth.String())
 	err := k.InitGenesis(ctx, types.DefaultGenesisState())
 	require.NoError(t, err)
 	for _, addr := range addrs {
@@ -87,3 +90,83 @@ func TestBridgeHaltAndResume(t *testing.T) {
 	require.Equal(t, types.StatusActive, k.GetBridgeStatus(ctx), "bridge status must be active (2/3)")
 	require.Equal(t, types.BridgeStatusMetadata{Active: 2, Inactive: 1}, k.GetBridgeStatusMetadata(ctx))
 }
+
+func TestBridgeStatusTransition(t *testing.T) {
+	key, memKey, ctx, encCfg, authKeeper, bankKeeper, foundationKeeper, addrs := testutil.PrepareFbridgeTest(t, 4)
+	ctx = ctx.WithBlockHeight(1)
+	k := NewKeeper(encCfg.Codec, key, memKey, authKeeper, bankKeeper, foundationKeeper, types.DefaultAuthority().String())
+	require.NoError(t, k.InitGenesis(ctx, types.DefaultGenesisState()))
+
+	lastTransition := func(ctx sdk.Context) *types.EventBridgeStatusChanged {
+		var found *types.EventBridgeStatusChanged
+		for _, e := range ctx.EventManager().ABCIEvents() {
+			if e.Type != proto.MessageName(&types.EventBridgeStatusChanged{}) {
+				continue
+			}
+			msg, err := sdk.ParseTypedEvent(e)
+			require.NoError(t, err)
+			found = msg.(*types.EventBridgeStatusChanged)
+		}
+		return found
+	}
+
+	require.Equal(t, types.StatusInactive, k.GetBridgeStatus(ctx), "bridge is inactive without guardians")
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	guardians := addrs[:3]
+	for _, g := range guardians {
+		require.NoError(t, k.updateRole(ctx, types.RoleGuardian, g))
+	}
+	require.Equal(t, types.StatusActive, k.GetBridgeStatus(ctx))
+	require.Equal(t, &types.EventBridgeStatusChanged{PreviousStatus: types.StatusInactive, Status: types.StatusActive, Reason: types.BridgeStatusReasonRoleUpdate}, lastTransition(ctx))
+
+	// 2 of 3 guardians are needed to halt the bridge
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.updateBridgeSwitch(ctx, guardians[0], types.StatusInactive))
+	require.Equal(t, types.StatusActive, k.GetBridgeStatus(ctx))
+	require.Nil(t, lastTransition(ctx))
+	require.NoError(t, k.updateBridgeSwitch(ctx, guardians[1], types.StatusInactive))
+	require.Equal(t, types.StatusInactive, k.GetBridgeStatus(ctx))
+	require.Equal(t, &types.EventBridgeStatusChanged{PreviousStatus: types.StatusActive, Status: types.StatusInactive, Reason: types.BridgeStatusReasonGuardianVote}, lastTransition(ctx))
+
+	// removing a halting guardian resumes the bridge
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.updateRole(ctx, types.RoleEmpty, guardians[1]))
+	require.Equal(t, types.RoleMetadata{Guardian: 2}, k.GetRoleMetadata(ctx))
+	require.Equal(t, types.BridgeStatusMetadata{Inactive: 1, Active: 1}, k.GetBridgeStatusMetadata(ctx))
+	require.Equal(t, types.StatusActive, k.GetBridgeStatus(ctx))
+	require.Equal(t, &types.EventBridgeStatusChanged{PreviousStatus: types.StatusInactive, Status: types.StatusActive, Reason: types.BridgeStatusReasonRoleUpdate}, lastTransition(ctx))
+
+	// an outflow anomaly trips the circuit breaker
+	params := k.GetParams(ctx)
+	params.BlockOutflowThreshold = sdk.NewInt(1000)
+	require.NoError(t, k.SetParams(ctx, params))
+	bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).AnyTimes()
+	bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).AnyTimes()
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	k.BeginBlocker(ctx)
+	_, _, err := k.handleBridgeTransfer(ctx, addrs[3], sdk.DefaultBondDenom, sdk.NewInt(1000))
+	require.NoError(t, err)
+	k.EndBlocker(ctx)
+	require.Equal(t, types.StatusActive, k.GetBridgeStatus(ctx), "outflow at the threshold is not an anomaly")
+
+	ctx = ctx.WithBlockHeight(2).WithEventManager(sdk.NewEventManager())
+	k.BeginBlocker(ctx)
+	require.Equal(t, sdk.ZeroInt(), k.GetBlockOutflow(ctx, sdk.DefaultBondDenom))
+	_, _, err = k.handleBridgeTransfer(ctx, addrs[3], sdk.DefaultBondDenom, sdk.NewInt(600))
+	require.NoError(t, err)
+	_, _, err = k.handleBridgeTransfer(ctx, addrs[3], sdk.DefaultBondDenom, sdk.NewInt(401))
+	require.NoError(t, err)
+	k.EndBlocker(ctx)
+	require.Equal(t, types.StatusInactive, k.GetBridgeStatus(ctx))
+	require.Equal(t, &types.EventBridgeStatusChanged{PreviousStatus: types.StatusActive, Status: types.StatusInactive, Reason: types.BridgeStatusReasonOutflowAnomaly}, lastTransition(ctx))
+	for _, sw := range k.GetBridgeSwitches(ctx) {
+		require.Equal(t, types.StatusInactive, sw.Status)
+	}
+
+	// guardians resume the bridge (1 of 2 inactive switches does not meet the trust level)
+	require.NoError(t, k.updateBridgeSwitch(ctx, guardians[0], types.StatusActive))
+	require.Equal(t, types.StatusActive, k.GetBridgeStatus(ctx))
+}
@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/fbridge/types"
+)
+
+// resolveDenom returns the target denom if the denom is not specified.
+func (k Keeper) resolveDenom(ctx sdk.Context, denom string) string {
+	if len(denom) == 0 {
+		return k.GetParams(ctx).TargetDenom
+	}
+
+	return denom
+}
+
+func (k Keeper) updateBridgeDenom(ctx sdk.Context, denom types.BridgeDenom) error {
+	if err := denom.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if err := denom.ValidateTransferWindow(k.GetParams(ctx).TransferWindowPeriod); err != nil {
+		return err
+	}
+
+	k.setBridgeDenom(ctx, denom)
+	return nil
+}
+
+func (k Keeper) setBridgeDenom(ctx sdk.Context, denom types.BridgeDenom) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&denom)
+	store.Set(types.BridgeDenomKey(denom.Denom), bz)
+}
+
+func (k Keeper) GetBridgeDenom(ctx sdk.Context, denom string) (types.BridgeDenom, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.BridgeDenomKey(denom))
+	if bz == nil {
+		return types.BridgeDenom{}, types.ErrUnsupportedDenom.Wrapf("%s is not registered", denom)
+	}
+
+	var bd types.BridgeDenom
+	k.cdc.MustUnmarshal(bz, &bd)
+	return bd, nil
+}
+
+func (k Keeper) IterateBridgeDenoms(ctx sdk.Context, cb func(denom types.BridgeDenom) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyBridgeDenomPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var bd types.BridgeDenom
+		k.cdc.MustUnmarshal(iterator.Value(), &bd)
+		if cb(bd) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetBridgeDenoms(ctx sdk.Context) []types.BridgeDenom {
+	denoms := make([]types.BridgeDenom, 0)
+	k.IterateBridgeDenoms(ctx, func(denom types.BridgeDenom) bool {
+		denoms = append(denoms, denom)
+		return false
+	})
+
+	return denoms
+}
+
+func (k Keeper) setDenomSeq(ctx sdk.Context, denom string, seq uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.DenomSeqKey(denom, seq), []byte{})
+}
+
+func (k Keeper) getAllDenomSeqs(ctx sdk.Context) []types.DenomSeq {
+	denomSeqs := make([]types.DenomSeq, 0)
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyDenomSeqPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		denom, seq := types.SplitDenomSeqKey(iterator.Key())
+		denomSeqs = append(denomSeqs, types.DenomSeq{Denom: denom, Seq: seq})
+	}
+
+	return denomSeqs
+}
+
+func (k Keeper) setConfirmedDenomSeq(ctx sdk.Context, denom string, seq uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ConfirmedDenomSeqKey(denom, seq), []byte{})
+}
+
+func (k Keeper) deleteConfirmedDenomSeq(ctx sdk.Context, denom string, seq uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConfirmedDenomSeqKey(denom, seq))
+}
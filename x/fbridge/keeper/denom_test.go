@@ -0,0 +1,221 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/types/query"
+	"github.com/Finschia/finschia-sdk/x/fbridge/testutil"
+	"github.com/Finschia/finschia-sdk/x/fbridge/types"
+)
+
+func TestMultiDenomTransfer(t *testing.T) {
+	key, memKey, ctx, encCfg, authKeeper, bankKeeper, foundationKeeper, addrs := testutil.PrepareFbridgeTest(t, 2)
+	ctx = ctx.WithBlockHeight(1)
+	k := NewKeeper(encCfg.Codec, key, memKey, authKeeper, bankKeeper, foundationKeeper, types.DefaultAuthority().String())
+	require.NoError(t, k.InitGenesis(ctx, types.DefaultGenesisState()))
+	require.NoError(t, k.updateRole(ctx, types.RoleGuardian, addrs[1]))
+	msgServer := NewMsgServer(k)
+	sender := addrs[0]
+
+	_, err := msgServer.UpdateDenom(sdk.WrapSDKContext(ctx), &types.MsgUpdateDenom{
+		Authority: sender.String(),
+		Denom:     types.DefaultBridgeDenom("kaia"),
+	})
+	require.Error(t, err, "only the authority can update the registry")
+
+	_, err = msgServer.UpdateDenom(sdk.WrapSDKContext(ctx), &types.MsgUpdateDenom{
+		Authority: k.GetAuthority(),
+		Denom:     types.BridgeDenom{Denom: "kaia", MinAmount: sdk.NewInt(10), MaxAmount: sdk.NewInt(5)},
+	})
+	require.Error(t, err, "max amount must not be less than min amount")
+
+	kaia := types.DefaultBridgeDenom("kaia")
+	kaia.Enabled = false
+	kaia.MinAmount, kaia.MaxAmount, kaia.Fee = sdk.NewInt(10), sdk.NewInt(1000), sdk.NewInt(3)
+	_, err = msgServer.UpdateDenom(sdk.WrapSDKContext(ctx), &types.MsgUpdateDenom{Authority: k.GetAuthority(), Denom: kaia})
+	require.NoError(t, err)
+
+	_, _, err = k.handleBridgeTransfer(ctx, sender, "unknown", sdk.NewInt(100))
+	require.ErrorIs(t, err, types.ErrUnsupportedDenom)
+	_, _, err = k.handleBridgeTransfer(ctx, sender, "kaia", sdk.NewInt(100))
+	require.ErrorIs(t, err, types.ErrUnsupportedDenom, "disabled denom")
+
+	kaia.Enabled = true
+	require.NoError(t, k.updateBridgeDenom(ctx, kaia))
+	_, _, err = k.handleBridgeTransfer(ctx, sender, "kaia", sdk.NewInt(9))
+	require.Error(t, err, "less than the minimum")
+	_, _, err = k.handleBridgeTransfer(ctx, sender, "kaia", sdk.NewInt(1001))
+	require.ErrorIs(t, err, types.ErrExceedTransferLimit, "more than the maximum")
+
+	token := sdk.Coins{sdk.Coin{Denom: "kaia", Amount: sdk.NewInt(100)}}
+	bankKeeper.EXPECT().IsSendEnabledCoins(ctx, token).Return(nil)
+	bankKeeper.EXPECT().SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, token).Return(nil)
+	foundationKeeper.EXPECT().FundTreasury(ctx, authKeeper.GetModuleAddress(types.ModuleName), sdk.Coins{sdk.Coin{Denom: "kaia", Amount: sdk.NewInt(3)}}).Return(nil)
+	bankKeeper.EXPECT().BurnCoins(ctx, types.ModuleName, sdk.Coins{sdk.Coin{Denom: "kaia", Amount: sdk.NewInt(97)}}).Return(nil)
+	seq, fee, err := k.handleBridgeTransfer(ctx, sender, "kaia", sdk.NewInt(100))
+	require.NoError(t, err)
+	require.EqualValues(t, 1, seq)
+	require.Equal(t, sdk.NewInt(3), fee)
+	require.Equal(t, sdk.NewInt(100), k.GetTransferWindow(ctx, "kaia").Outflow)
+	require.Equal(t, sdk.ZeroInt(), k.GetTransferWindow(ctx, sdk.DefaultBondDenom).Outflow, "the outflow is tracked per denom")
+
+	bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), gomock.Any()).Return(nil)
+	bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), sender, types.ModuleName, gomock.Any()).Return(nil)
+	bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil)
+	_, err = msgServer.Transfer(sdk.WrapSDKContext(ctx), &types.MsgTransfer{Sender: sender.String(), Receiver: testEthAddr, Amount: sdk.NewInt(50)})
+	require.NoError(t, err, "the target denom is used if the denom is not specified")
+
+	res, err := k.SeqsByDenom(sdk.WrapSDKContext(ctx), &types.QuerySeqsByDenomRequest{Denom: "kaia"})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1}, res.Seqs)
+	res, err = k.SeqsByDenom(sdk.WrapSDKContext(ctx), &types.QuerySeqsByDenomRequest{Denom: sdk.DefaultBondDenom})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2}, res.Seqs)
+
+	denomsRes, err := k.Denoms(sdk.WrapSDKContext(ctx), &types.QueryDenomsRequest{Pagination: &query.PageRequest{CountTotal: true}})
+	require.NoError(t, err)
+	require.Equal(t, []types.BridgeDenom{kaia, types.DefaultBridgeDenom(sdk.DefaultBondDenom)}, denomsRes.Denoms)
+	denomRes, err := k.Denom(sdk.WrapSDKContext(ctx), &types.QueryDenomRequest{Denom: "kaia"})
+	require.NoError(t, err)
+	require.Equal(t, kaia, denomRes.Denom)
+
+	gs := k.ExportGenesis(ctx)
+	require.NoError(t, types.ValidateGenesis(*gs))
+	require.Equal(t, []types.DenomSeq{{Denom: "kaia", Seq: 1}, {Denom: sdk.DefaultBondDenom, Seq: 2}}, gs.SendingState.DenomSeqs)
+	require.Equal(t, denomsRes.Denoms, gs.Denoms)
+}
+
+func TestMultiDenomProvision(t *testing.T) {
+	k, ctx, bankKeeper, operators, users, judge, _ := prepareInboundTest(t)
+	require.NoError(t, k.updateBridgeDenom(ctx, types.DefaultBridgeDenom("kaia")))
+
+	data := types.ProvisionData{Seq: 1, Amount: sdk.NewInt(100), Sender: testEthAddr, Receiver: users[0].String(), Denom: "kaia"}
+	legacy := types.ProvisionData{Seq: 2, Amount: sdk.NewInt(100), Sender: testEthAddr, Receiver: users[0].String()}
+	for _, d := range []types.ProvisionData{data, legacy} {
+		for _, op := range operators[:2] {
+			require.NoError(t, k.handleProvision(ctx, op, d))
+		}
+	}
+
+	res, err := k.ConfirmedSeqsByDenom(sdk.WrapSDKContext(ctx), &types.QueryConfirmedSeqsByDenomRequest{Denom: "kaia"})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1}, res.Seqs)
+	res, err = k.ConfirmedSeqsByDenom(sdk.WrapSDKContext(ctx), &types.QueryConfirmedSeqsByDenomRequest{Denom: sdk.DefaultBondDenom})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2}, res.Seqs)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(48 * 60 * 60 * 1e9))
+	token := sdk.Coins{sdk.Coin{Denom: "kaia", Amount: data.Amount}}
+	bankKeeper.EXPECT().MintCoins(ctx, types.ModuleName, token).Return(nil)
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, types.ModuleName, users[0], token).Return(nil)
+	_, err = k.claim(ctx, data.Seq)
+	require.NoError(t, err)
+
+	// removing a provision drops it from the index
+	removed, err := k.removeProvision(ctx, judge, legacy.Seq)
+	require.NoError(t, err)
+	require.True(t, removed)
+	res, err = k.ConfirmedSeqsByDenom(sdk.WrapSDKContext(ctx), &types.QueryConfirmedSeqsByDenomRequest{Denom: sdk.DefaultBondDenom})
+	require.NoError(t, err)
+	require.Empty(t, res.Seqs)
+
+	// the index is rebuilt from the provisions at genesis
+	gs := k.ExportGenesis(ctx)
+	key, memKey, ctx2, encCfg, authKeeper, bankKeeper2, foundationKeeper, _ := testutil.PrepareFbridgeTest(t, 0)
+	k2 := NewKeeper(encCfg.Codec, key, memKey, authKeeper, bankKeeper2, foundationKeeper, types.DefaultAuthority().String())
+	require.NoError(t, k2.InitGenesis(ctx2, gs))
+	res, err = k2.ConfirmedSeqsByDenom(sdk.WrapSDKContext(ctx2), &types.QueryConfirmedSeqsByDenomRequest{Denom: "kaia"})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1}, res.Seqs)
+}
+
+func TestProvisionDisabledDenom(t *testing.T) {
+	k, ctx, _, operators, users, _, _ := prepareInboundTest(t)
+	msgServer := NewMsgServer(k)
+	kaia := types.DefaultBridgeDenom("kaia")
+	kaia.Enabled = false
+	require.NoError(t, k.updateBridgeDenom(ctx, kaia))
+
+	msg := &types.MsgProvision{From: operators[0].String(), Seq: 1, Sender: testEthAddr, Receiver: users[0].String(), Amount: sdk.NewInt(100), Denom: "kaia"}
+	_, err := msgServer.Provision(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorIs(t, err, types.ErrUnsupportedDenom)
+
+	kaia.Enabled = true
+	require.NoError(t, k.updateBridgeDenom(ctx, kaia))
+	_, err = msgServer.Provision(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+}
+
+func TestSingleDenomGenesisMigration(t *testing.T) {
+	key, memKey, ctx, encCfg, authKeeper, bankKeeper, foundationKeeper, _ := testutil.PrepareFbridgeTest(t, 0)
+	k := NewKeeper(encCfg.Codec, key, memKey, authKeeper, bankKeeper, foundationKeeper, types.DefaultAuthority().String())
+
+	gs := types.DefaultGenesisState()
+	gs.Params.TargetDenom = "cony"
+	gs.SendingState = types.SendingState{
+		NextSeq:       3,
+		SeqToBlocknum: []types.BlockSeqInfo{{Seq: 1, Blocknum: 10}, {Seq: 2, Blocknum: 20}},
+	}
+	require.NoError(t, types.ValidateGenesis(*gs))
+	require.NoError(t, k.InitGenesis(ctx, gs))
+
+	exported := k.ExportGenesis(ctx)
+	require.Equal(t, []types.BridgeDenom{types.DefaultBridgeDenom("cony")}, exported.Denoms)
+	require.Equal(t, []types.DenomSeq{{Denom: "cony", Seq: 1}, {Denom: "cony", Seq: 2}}, exported.SendingState.DenomSeqs)
+}
+
+func TestMigrate1to2(t *testing.T) {
+	key, memKey, ctx, encCfg, authKeeper, bankKeeper, foundationKeeper, _ := testutil.PrepareFbridgeTest(t, 0)
+	k := NewKeeper(encCfg.Codec, key, memKey, authKeeper, bankKeeper, foundationKeeper, types.DefaultAuthority().String())
+
+	// the state of a single-denom bridge
+	params := types.Params{
+		GuardianTrustLevel: types.Fraction{Numerator: 2, Denominator: 3},
+		OperatorTrustLevel: types.Fraction{Numerator: 2, Denominator: 3},
+		JudgeTrustLevel:    types.Fraction{Numerator: 1, Denominator: 1},
+		TimelockPeriod:     10,
+		ProposalPeriod:     10,
+		TargetDenom:        "cony",
+	}
+	require.NoError(t, k.SetParams(ctx, params))
+	k.setNextSequence(ctx, 2)
+	k.setSeqToBlocknum(ctx, 1, 10)
+
+	require.NoError(t, NewMigrator(k).Migrate1to2(ctx))
+
+	migrated := k.GetParams(ctx)
+	require.Equal(t, sdk.ZeroInt(), migrated.TransferMaxPerTx)
+	require.Equal(t, types.DefaultParams().TransferWindowPeriod, migrated.TransferWindowPeriod)
+	bd, err := k.GetBridgeDenom(ctx, "cony")
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultBridgeDenom("cony"), bd)
+	require.Equal(t, []types.DenomSeq{{Denom: "cony", Seq: 1}}, k.getAllDenomSeqs(ctx))
+}
+
+func TestBridgeDenomWindowCapWithoutWindowPeriod(t *testing.T) {
+	k, ctx, _, _, _, _, _ := prepareInboundTest(t)
+	params := k.GetParams(ctx)
+	params.TransferWindowPeriod = 0
+	require.NoError(t, k.SetParams(ctx, params))
+
+	kaia := types.DefaultBridgeDenom("kaia")
+	require.NoError(t, k.updateBridgeDenom(ctx, kaia))
+
+	kaia.WindowCap = sdk.NewInt(100)
+	require.Error(t, k.updateBridgeDenom(ctx, kaia))
+	kaia.WindowCap = sdk.ZeroInt()
+	kaia.AddressCap = sdk.NewInt(100)
+	require.Error(t, k.updateBridgeDenom(ctx, kaia))
+
+	gs := k.ExportGenesis(ctx)
+	require.NoError(t, types.ValidateGenesis(*gs))
+	link := types.DefaultBridgeDenom("link")
+	require.NoError(t, link.ValidateBasic())
+	link.WindowCap = sdk.NewInt(100)
+	gs.Denoms = append(gs.Denoms, link)
+	require.Error(t, types.ValidateGenesis(*gs))
+}
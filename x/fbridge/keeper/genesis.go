@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"encoding/binary"
+	"encoding/hex"
+	"fmt"
 
 	sdk "github.com/Finschia/finschia-sdk/types"
 	"github.com/Finschia/finschia-sdk/x/fbridge/types"
@@ -17,6 +19,35 @@ func (k Keeper) InitGenesis(ctx sdk.Context, gs *types.GenesisState) error {
 		k.setSeqToBlocknum(ctx, info.Seq, info.Blocknum)
 	}
 
+	// genesis of a single-denom bridge has no registry; the target denom is the only bridgeable denom.
+	denoms := gs.Denoms
+	if len(denoms) == 0 {
+		denoms = []types.BridgeDenom{types.DefaultBridgeDenom(gs.Params.TargetDenom)}
+	}
+	for _, denom := range denoms {
+		k.setBridgeDenom(ctx, denom)
+	}
+
+	// bridge requests without denom have been made in the target denom.
+	denomSeqs := make(map[uint64]string, len(gs.SendingState.DenomSeqs))
+	for _, ds := range gs.SendingState.DenomSeqs {
+		denomSeqs[ds.Seq] = ds.Denom
+	}
+	for _, info := range gs.SendingState.SeqToBlocknum {
+		denom, ok := denomSeqs[info.Seq]
+		if !ok {
+			denom = gs.Params.TargetDenom
+		}
+		k.setDenomSeq(ctx, denom, info.Seq)
+	}
+
+	for _, window := range gs.SendingState.TransferWindows {
+		k.setTransferWindow(ctx, window)
+	}
+	for _, outflow := range gs.SendingState.AddressOutflows {
+		k.setAddressOutflow(ctx, outflow.Denom, sdk.MustAccAddressFromBech32(outflow.Address), outflow.Outflow)
+	}
+
 	for _, pair := range gs.Roles {
 		if err := k.setRole(ctx, pair.Role, sdk.MustAccAddressFromBech32(pair.Address)); err != nil {
 			panic(err)
@@ -38,7 +69,9 @@ func (k Keeper) InitGenesis(ctx sdk.Context, gs *types.GenesisState) error {
 		k.setVote(ctx, vote.ProposalId, sdk.MustAccAddressFromBech32(vote.Voter), vote.Option)
 	}
 
-	// TODO: we initialize the appropriate genesis parameters whenever the feature is added
+	if err := k.initReceivingState(ctx, gs.ReceivingState); err != nil {
+		return err
+	}
 
 	k.InitMemStore(ctx)
 
@@ -49,15 +82,19 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
 		Params: k.GetParams(ctx),
 		SendingState: types.SendingState{
-			NextSeq:       k.GetNextSequence(ctx),
-			SeqToBlocknum: k.getAllSeqToBlocknums(ctx),
+			NextSeq:         k.GetNextSequence(ctx),
+			SeqToBlocknum:   k.getAllSeqToBlocknums(ctx),
+			TransferWindows: k.getAllTransferWindows(ctx),
+			AddressOutflows: k.getAllAddressOutflows(ctx),
+			DenomSeqs:       k.getAllDenomSeqs(ctx),
 		},
-		ReceivingState:     types.ReceivingState{},
+		ReceivingState:     k.exportReceivingState(ctx),
 		NextRoleProposalId: k.GetNextProposalID(ctx),
 		RoleProposals:      k.GetRoleProposals(ctx),
 		Votes:              k.GetAllVotes(ctx),
 		Roles:              k.GetRolePairs(ctx),
 		BridgeSwitches:     k.GetBridgeSwitches(ctx),
+		Denoms:             k.GetBridgeDenoms(ctx),
 	}
 }
 
@@ -77,6 +114,134 @@ func (k Keeper) getAllSeqToBlocknums(ctx sdk.Context) []types.BlockSeqInfo {
 	return infos
 }
 
+func (k Keeper) initReceivingState(ctx sdk.Context, state types.ReceivingState) error {
+	for _, info := range state.GreatestSeqByOperator {
+		k.setGreatestSeqByOperator(ctx, sdk.MustAccAddressFromBech32(info.Operator), info.Seq)
+	}
+
+	for _, info := range state.GreatestConsecutiveSeqByOperator {
+		k.setGreatestConsecutiveSeqByOperator(ctx, sdk.MustAccAddressFromBech32(info.Operator), info.Seq)
+	}
+
+	k.setGreatestConsecutiveConfirmedSeq(ctx, state.GreatestConsecutiveSeq)
+
+	for _, c := range state.Commitments {
+		commitment, err := hex.DecodeString(c.Commitment)
+		if err != nil {
+			return err
+		}
+		k.setCommitment(ctx, c.Seq, sdk.MustAccAddressFromBech32(c.Operator), commitment)
+	}
+
+	for _, p := range state.Provisions {
+		commitment, err := hex.DecodeString(p.Commitment)
+		if err != nil {
+			return err
+		}
+		k.setProvision(ctx, commitment, *p.Data)
+		k.setProvisionStatus(ctx, commitment, *p.Status)
+	}
+
+	for _, cp := range state.ConfirmedSeqToCommitment {
+		commitment, err := hex.DecodeString(cp.Commitment)
+		if err != nil {
+			return err
+		}
+		k.setConfirmedCommitment(ctx, cp.Seq, commitment)
+
+		data, found := k.GetProvision(ctx, commitment)
+		if !found {
+			return fmt.Errorf("provision of confirmed seq %d not found", cp.Seq)
+		}
+		k.setConfirmedDenomSeq(ctx, k.resolveDenom(ctx, data.Denom), cp.Seq)
+	}
+
+	for _, seq := range state.PendingClaimSeqs {
+		k.setPendingClaimSeq(ctx, seq)
+	}
+
+	for _, v := range state.RemovalVotes {
+		k.setRemovalVote(ctx, v.Seq, sdk.MustAccAddressFromBech32(v.Judge))
+	}
+
+	return nil
+}
+
+func (k Keeper) exportReceivingState(ctx sdk.Context) types.ReceivingState {
+	state := types.ReceivingState{
+		GreatestConsecutiveSeq: k.GetGreatestConsecutiveConfirmedSeq(ctx),
+	}
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyGreatestSeqByOperatorPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		operator := types.SplitOperatorSeqKey(iterator.Key())
+		seq := binary.BigEndian.Uint64(iterator.Value())
+		state.GreatestSeqByOperator = append(state.GreatestSeqByOperator, &types.OperatorSeqInfo{Operator: operator.String(), Seq: seq})
+	}
+	iterator.Close()
+
+	iterator = sdk.KVStorePrefixIterator(store, types.KeyGreatestConsecutiveSeqByOperatorPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		operator := types.SplitOperatorSeqKey(iterator.Key())
+		seq := binary.BigEndian.Uint64(iterator.Value())
+		state.GreatestConsecutiveSeqByOperator = append(state.GreatestConsecutiveSeqByOperator, &types.OperatorSeqInfo{Operator: operator.String(), Seq: seq})
+	}
+	iterator.Close()
+
+	iterator = sdk.KVStorePrefixIterator(store, types.KeyCommitmentPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		seq, operator := types.SplitCommitmentKey(iterator.Key())
+		state.Commitments = append(state.Commitments, &types.Commitment{
+			Operator:   operator.String(),
+			Seq:        seq,
+			Commitment: hex.EncodeToString(iterator.Value()),
+		})
+	}
+	iterator.Close()
+
+	iterator = sdk.KVStorePrefixIterator(store, types.KeyProvisionPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		commitment := iterator.Key()[1:]
+		var data types.ProvisionData
+		k.cdc.MustUnmarshal(iterator.Value(), &data)
+		status, found := k.GetProvisionStatus(ctx, commitment)
+		if !found {
+			panic("provision status must exist")
+		}
+		state.Provisions = append(state.Provisions, &types.Provision{
+			Commitment: hex.EncodeToString(commitment),
+			Data:       &data,
+			Status:     &status,
+		})
+	}
+	iterator.Close()
+
+	iterator = sdk.KVStorePrefixIterator(store, types.KeyConfirmedSeqToCommitmentPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		seq := binary.BigEndian.Uint64(iterator.Key()[1:])
+		state.ConfirmedSeqToCommitment = append(state.ConfirmedSeqToCommitment, &types.ConfirmedProvision{
+			Seq:        seq,
+			Commitment: hex.EncodeToString(iterator.Value()),
+		})
+	}
+	iterator.Close()
+
+	k.IteratePendingClaimSeqs(ctx, func(seq uint64) bool {
+		state.PendingClaimSeqs = append(state.PendingClaimSeqs, seq)
+		return false
+	})
+
+	iterator = sdk.KVStorePrefixIterator(store, types.KeyRemovalVotePrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		seq, judge := types.SplitRemovalVoteKey(iterator.Key())
+		state.RemovalVotes = append(state.RemovalVotes, types.RemovalVote{Seq: seq, Judge: judge.String()})
+	}
+	iterator.Close()
+
+	return state
+}
+
 // IterateVotes iterates over the all the votes for role proposals and performs a callback function
 func (k Keeper) IterateVotes(ctx sdk.Context, cb func(proposal types.Vote) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
@@ -2,14 +2,16 @@ package keeper
 
 import (
 	"context"
+	"encoding/binary"
+	"encoding/hex"
 	"fmt"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/Finschia/finschia-sdk/store/prefix"
 	sdk "github.com/Finschia/finschia-sdk/types"
-	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 	"github.com/Finschia/finschia-sdk/types/query"
 	"github.com/Finschia/finschia-sdk/x/fbridge/types"
 )
@@ -62,28 +64,223 @@ func (k Keeper) SeqToBlocknums(goCtx context.Context, req *types.QuerySeqToBlock
 	return &types.QuerySeqToBlocknumsResponse{Blocknums: bhList}, nil
 }
 
-func (k Keeper) GreatestSeqByOperator(ctx context.Context, request *types.QueryGreatestSeqByOperatorRequest) (*types.QueryGreatestSeqByOperatorResponse, error) {
-	panic(sdkerrors.ErrNotSupported)
+func (k Keeper) TransferUsage(goCtx context.Context, req *types.QueryTransferUsageRequest) (*types.QueryTransferUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	denom := k.resolveDenom(ctx, req.Denom)
+	addressOutflow := sdk.ZeroInt()
+	if req.Address != "" {
+		addr, err := sdk.AccAddressFromBech32(req.Address)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		addressOutflow = k.GetAddressOutflow(ctx, denom, addr)
+	}
+
+	window := k.GetTransferWindow(ctx, denom)
+	period := time.Duration(k.GetParams(ctx).TransferWindowPeriod)
+
+	return &types.QueryTransferUsageResponse{
+		WindowStart:    window.Start,
+		WindowEnd:      window.Start.Add(period),
+		Outflow:        window.Outflow,
+		AddressOutflow: addressOutflow,
+	}, nil
+}
+
+func (k Keeper) Denoms(goCtx context.Context, req *types.QueryDenomsRequest) (*types.QueryDenomsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyBridgeDenomPrefix)
+	denoms := make([]types.BridgeDenom, 0)
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var denom types.BridgeDenom
+		k.cdc.MustUnmarshal(value, &denom)
+		denoms = append(denoms, denom)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDenomsResponse{Denoms: denoms, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Denom(goCtx context.Context, req *types.QueryDenomRequest) (*types.QueryDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	denom, err := k.GetBridgeDenom(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryDenomResponse{Denom: denom}, nil
+}
+
+func (k Keeper) SeqsByDenom(goCtx context.Context, req *types.QuerySeqsByDenomRequest) (*types.QuerySeqsByDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomSeqsKey(req.Denom))
+	seqs, pageRes, err := paginateSeqs(store, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySeqsByDenomResponse{Seqs: seqs, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ConfirmedSeqsByDenom(goCtx context.Context, req *types.QueryConfirmedSeqsByDenomRequest) (*types.QueryConfirmedSeqsByDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ConfirmedDenomSeqsKey(req.Denom))
+	seqs, pageRes, err := paginateSeqs(store, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryConfirmedSeqsByDenomResponse{Seqs: seqs, Pagination: pageRes}, nil
+}
+
+// paginateSeqs paginates the store whose keys are sequence numbers.
+func paginateSeqs(store prefix.Store, pageReq *query.PageRequest) ([]uint64, *query.PageResponse, error) {
+	seqs := make([]uint64, 0)
+	pageRes, err := query.Paginate(store, pageReq, func(key, _ []byte) error {
+		seqs = append(seqs, binary.BigEndian.Uint64(key))
+		return nil
+	})
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return seqs, pageRes, nil
 }
 
-func (k Keeper) GreatestConsecutiveConfirmedSeq(ctx context.Context, request *types.QueryGreatestConsecutiveConfirmedSeqRequest) (*types.QueryGreatestConsecutiveConfirmedSeqResponse, error) {
-	panic(sdkerrors.ErrNotSupported)
+func (k Keeper) GreatestSeqByOperator(goCtx context.Context, req *types.QueryGreatestSeqByOperatorRequest) (*types.QueryGreatestSeqByOperatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	operator, err := sdk.AccAddressFromBech32(req.Operator)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	seq := k.GetGreatestSeqByOperator(ctx, operator)
+
+	return &types.QueryGreatestSeqByOperatorResponse{Seq: seq}, nil
 }
 
-func (k Keeper) SubmittedProvision(ctx context.Context, request *types.QuerySubmittedProvisionRequest) (*types.QuerySubmittedProvisionResponse, error) {
-	panic(sdkerrors.ErrNotSupported)
+func (k Keeper) GreatestConsecutiveConfirmedSeq(goCtx context.Context, req *types.QueryGreatestConsecutiveConfirmedSeqRequest) (*types.QueryGreatestConsecutiveConfirmedSeqResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	seq := k.GetGreatestConsecutiveConfirmedSeq(ctx)
+
+	return &types.QueryGreatestConsecutiveConfirmedSeqResponse{Seq: seq}, nil
 }
 
-func (k Keeper) ConfirmedProvision(ctx context.Context, request *types.QueryConfirmedProvisionRequest) (*types.QueryConfirmedProvisionResponse, error) {
-	panic(sdkerrors.ErrNotSupported)
+func (k Keeper) SubmittedProvision(goCtx context.Context, req *types.QuerySubmittedProvisionRequest) (*types.QuerySubmittedProvisionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	operator, err := sdk.AccAddressFromBech32(req.Operator)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	commitment, found := k.GetCommitment(ctx, req.Seq, operator)
+	if !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("provision of seq %d submitted by %s", req.Seq, req.Operator))
+	}
+
+	data, pStatus := k.mustGetProvisionAndStatus(ctx, commitment)
+	return &types.QuerySubmittedProvisionResponse{Data: data, Status: pStatus}, nil
 }
 
-func (k Keeper) NeededSubmissionSeqs(ctx context.Context, request *types.QueryNeededSubmissionSeqsRequest) (*types.QueryNeededSubmissionSeqsResponse, error) {
-	panic(sdkerrors.ErrNotSupported)
+func (k Keeper) ConfirmedProvision(goCtx context.Context, req *types.QueryConfirmedProvisionRequest) (*types.QueryConfirmedProvisionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	commitment, found := k.GetConfirmedCommitment(ctx, req.Seq)
+	if !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("confirmed provision of seq %d", req.Seq))
+	}
+
+	data, pStatus := k.mustGetProvisionAndStatus(ctx, commitment)
+	return &types.QueryConfirmedProvisionResponse{Data: data, Status: pStatus}, nil
 }
 
-func (k Keeper) Commitments(ctx context.Context, request *types.QueryCommitmentsRequest) (*types.QueryCommitmentsResponse, error) {
-	panic(sdkerrors.ErrNotSupported)
+func (k Keeper) NeededSubmissionSeqs(goCtx context.Context, req *types.QueryNeededSubmissionSeqsRequest) (*types.QueryNeededSubmissionSeqsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	const lowerBound uint64 = 1
+	const upperBound uint64 = 1000
+	if req.Range < lowerBound || req.Range > upperBound {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("range must be between %d and %d", lowerBound, upperBound))
+	}
+
+	operator, err := sdk.AccAddressFromBech32(req.Operator)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if k.GetRole(ctx, operator) != types.RoleOperator {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("%s is not an operator", req.Operator))
+	}
+
+	return &types.QueryNeededSubmissionSeqsResponse{Seqs: k.getNeededSubmissionSeqs(ctx, operator, req.Range)}, nil
+}
+
+func (k Keeper) Commitments(goCtx context.Context, req *types.QueryCommitmentsRequest) (*types.QueryCommitmentsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CommitmentsKey(req.Seq))
+	commitments := make([]string, 0)
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		commitments = append(commitments, hex.EncodeToString(value))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCommitmentsResponse{Commitments: commitments, Pagination: pageRes}, nil
 }
 
 func (k Keeper) Members(goCtx context.Context, req *types.QueryMembersRequest) (*types.QueryMembersResponse, error) {
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/types/query"
+	"github.com/Finschia/finschia-sdk/x/fbridge/testutil"
+	"github.com/Finschia/finschia-sdk/x/fbridge/types"
+)
+
+func TestReceivingQueries(t *testing.T) {
+	k, ctx, _, operators, users, _, _ := prepareInboundTest(t)
+
+	// operators[0] submits 1..5, operators[1] submits 1, 2 and 4, operators[2] submits nothing
+	provisions := make(map[uint64]types.ProvisionData)
+	for seq := uint64(1); seq <= 5; seq++ {
+		data := types.ProvisionData{Seq: seq, Amount: sdk.NewInt(int64(seq) * 100), Sender: testEthAddr, Receiver: users[0].String(), Denom: sdk.DefaultBondDenom}
+		provisions[seq] = data
+		require.NoError(t, k.handleProvision(ctx, operators[0], data))
+		if seq == 1 || seq == 2 || seq == 4 {
+			require.NoError(t, k.handleProvision(ctx, operators[1], data))
+		}
+	}
+
+	assertQueries := func(t *testing.T, k Keeper, ctx sdk.Context) {
+		goCtx := sdk.WrapSDKContext(ctx)
+
+		res, err := k.GreatestSeqByOperator(goCtx, &types.QueryGreatestSeqByOperatorRequest{Operator: operators[1].String()})
+		require.NoError(t, err)
+		require.EqualValues(t, 4, res.Seq)
+
+		_, err = k.GreatestSeqByOperator(goCtx, &types.QueryGreatestSeqByOperatorRequest{Operator: "invalid"})
+		require.Error(t, err)
+
+		gcRes, err := k.GreatestConsecutiveConfirmedSeq(goCtx, &types.QueryGreatestConsecutiveConfirmedSeqRequest{})
+		require.NoError(t, err)
+		require.EqualValues(t, 2, gcRes.Seq)
+
+		spRes, err := k.SubmittedProvision(goCtx, &types.QuerySubmittedProvisionRequest{Operator: operators[0].String(), Seq: 3})
+		require.NoError(t, err)
+		require.Equal(t, provisions[3], spRes.Data)
+		require.EqualValues(t, 1, spRes.Status.ConfirmCounts)
+
+		_, err = k.SubmittedProvision(goCtx, &types.QuerySubmittedProvisionRequest{Operator: operators[1].String(), Seq: 3})
+		require.Error(t, err)
+
+		cpRes, err := k.ConfirmedProvision(goCtx, &types.QueryConfirmedProvisionRequest{Seq: 4})
+		require.NoError(t, err)
+		require.Equal(t, provisions[4], cpRes.Data)
+		require.EqualValues(t, 2, cpRes.Status.ConfirmCounts)
+
+		_, err = k.ConfirmedProvision(goCtx, &types.QueryConfirmedProvisionRequest{Seq: 3})
+		require.Error(t, err)
+
+		nsRes, err := k.NeededSubmissionSeqs(goCtx, &types.QueryNeededSubmissionSeqsRequest{Operator: operators[1].String(), Range: 10})
+		require.NoError(t, err)
+		require.Equal(t, []uint64{3, 5}, nsRes.Seqs)
+
+		nsRes, err = k.NeededSubmissionSeqs(goCtx, &types.QueryNeededSubmissionSeqsRequest{Operator: operators[2].String(), Range: 2})
+		require.NoError(t, err)
+		require.Equal(t, []uint64{3, 4}, nsRes.Seqs, "a new operator starts from the greatest consecutive confirmed seq")
+
+		_, err = k.NeededSubmissionSeqs(goCtx, &types.QueryNeededSubmissionSeqsRequest{Operator: operators[2].String(), Range: 0})
+		require.Error(t, err)
+
+		_, err = k.NeededSubmissionSeqs(goCtx, &types.QueryNeededSubmissionSeqsRequest{Operator: users[0].String(), Range: 10})
+		require.Error(t, err, "only operators need to submit provisions")
+
+		cRes, err := k.Commitments(goCtx, &types.QueryCommitmentsRequest{Seq: 1, Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+		require.NoError(t, err)
+		require.Equal(t, []string{hex.EncodeToString(provisions[1].Commitment())}, cRes.Commitments)
+		require.EqualValues(t, 2, cRes.Pagination.Total)
+	}
+
+	assertQueries(t, k, ctx)
+
+	// the queries must give the same answers after export/import
+	gs := k.ExportGenesis(ctx)
+	key, memKey, ctx2, encCfg, authKeeper, bankKeeper, foundationKeeper, _ := testutil.PrepareFbridgeTest(t, 0)
+	k2 := NewKeeper(encCfg.Codec, key, memKey, authKeeper, bankKeeper, foundationKeeper, types.DefaultAuthority().String())
+	require.NoError(t, k2.InitGenesis(ctx2, gs))
+	assertQueries(t, k2, ctx2)
+}
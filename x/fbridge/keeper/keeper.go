@@ -13,11 +13,12 @@ import (
 )
 
 type Keeper struct {
-	storeKey   sdk.StoreKey
-	memKey     sdk.StoreKey
-	cdc        codec.BinaryCodec
-	authKeeper types.AccountKeeper
-	bankKeeper types.BankKeeper
+	storeKey         sdk.StoreKey
+	memKey           sdk.StoreKey
+	cdc              codec.BinaryCodec
+	authKeeper       types.AccountKeeper
+	bankKeeper       types.BankKeeper
+	foundationKeeper types.FoundationKeeper
 
 	// authority can give a role to a specific address like guardian
 	authority string
@@ -28,6 +29,7 @@ func NewKeeper(
 	key, memKey sdk.StoreKey,
 	authKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
+	foundationKeeper types.FoundationKeeper,
 	authority string,
 ) Keeper {
 	if addr := authKeeper.GetModuleAddress(types.ModuleName); addr == nil {
@@ -47,12 +49,13 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		storeKey:   key,
-		memKey:     memKey,
-		cdc:        cdc,
-		authKeeper: authKeeper,
-		bankKeeper: bankKeeper,
-		authority:  authority,
+		storeKey:         key,
+		memKey:           memKey,
+		cdc:              cdc,
+		authKeeper:       authKeeper,
+		bankKeeper:       bankKeeper,
+		foundationKeeper: foundationKeeper,
+		authority:        authority,
 	}
 }
 
@@ -142,6 +145,22 @@ func (k Keeper) GetBridgeStatus(ctx sdk.Context) types.BridgeStatus {
 	return types.StatusInactive
 }
 
+// emitBridgeStatusTransition emits an event if the bridge status differs from the previous one.
+func (k Keeper) emitBridgeStatusTransition(ctx sdk.Context, prev types.BridgeStatus, reason string) {
+	status := k.GetBridgeStatus(ctx)
+	if status == prev {
+		return
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventBridgeStatusChanged{
+		PreviousStatus: prev,
+		Status:         status,
+		Reason:         reason,
+	}); err != nil {
+		panic(err)
+	}
+}
+
 func (k Keeper) setBridgeInactiveCounter(ctx sdk.Context, nInactive uint64) {
 	memStore := ctx.KVStore(k.memKey)
 	bz := make([]byte, 8)
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/fbridge/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the store of a single-denom bridge to the denom registry.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	k := m.keeper
+
+	// fill in the parameters added since v1
+	params := k.GetParams(ctx)
+	for _, limit := range []*sdk.Int{&params.TransferMaxPerTx, &params.TransferWindowCap, &params.TransferAddressCap, &params.BlockOutflowThreshold} {
+		if limit.IsNil() {
+			*limit = sdk.ZeroInt()
+		}
+	}
+	if params.TransferWindowPeriod == 0 {
+		params.TransferWindowPeriod = uint64(time.Hour * 24)
+	}
+	if err := k.SetParams(ctx, params); err != nil {
+		return err
+	}
+
+	// the target denom has been the only bridgeable denom
+	if _, err := k.GetBridgeDenom(ctx, params.TargetDenom); err != nil {
+		k.setBridgeDenom(ctx, types.DefaultBridgeDenom(params.TargetDenom))
+	}
+
+	for _, info := range k.getAllSeqToBlocknums(ctx) {
+		k.setDenomSeq(ctx, params.TargetDenom, info.Seq)
+	}
+
+	for _, cp := range k.exportReceivingState(ctx).ConfirmedSeqToCommitment {
+		k.setConfirmedDenomSeq(ctx, params.TargetDenom, cp.Seq)
+	}
+
+	return nil
+}
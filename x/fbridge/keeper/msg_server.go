@@ -28,9 +28,11 @@ func (m msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 			m.Keeper.GetAuthority(), msg.Authority)
 	}
 
+	prevStatus := m.Keeper.GetBridgeStatus(ctx)
 	if err := m.Keeper.SetParams(ctx, msg.Params); err != nil {
 		return nil, err
 	}
+	m.Keeper.emitBridgeStatusTransition(ctx, prevStatus, types.BridgeStatusReasonParamsUpdate)
 
 	if err := ctx.EventManager().EmitTypedEvent(&types.EventUpdateParams{
 		Params: msg.Params,
@@ -57,7 +59,8 @@ func (m msgServer) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*typ
 		return nil, sdkerrors.Wrap(err, "invalid receiver address")
 	}
 
-	seq, err := m.handleBridgeTransfer(ctx, from, msg.Amount)
+	denom := m.resolveDenom(ctx, msg.Denom)
+	seq, fee, err := m.handleBridgeTransfer(ctx, from, denom, msg.Amount)
 	if err != nil {
 		return nil, err
 	}
@@ -65,37 +68,223 @@ func (m msgServer) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*typ
 	if err := ctx.EventManager().EmitTypedEvent(&types.EventTransfer{
 		Sender:   msg.Sender,
 		Receiver: msg.Receiver,
-		Amount:   msg.Amount.String(),
+		Amount:   msg.Amount.Sub(fee).String(),
 		Seq:      seq,
+		Denom:    denom,
+		Fee:      fee.String(),
 	}); err != nil {
 		panic(err)
 	}
 
-	return &types.MsgTransferResponse{}, nil
+	return &types.MsgTransferResponse{Fee: fee}, nil
 }
 
-func (m msgServer) Provision(ctx context.Context, msg *types.MsgProvision) (*types.MsgProvisionResponse, error) {
-	panic(sdkerrors.ErrNotSupported)
+func (m msgServer) Provision(goCtx context.Context, msg *types.MsgProvision) (*types.MsgProvisionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if m.IsBridgeHalted(ctx) {
+		return nil, types.ErrInactiveBridge
+	}
+
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+
+	if err := IsValidEthereumAddress(msg.Sender); err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Receiver); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address (%s)", err)
+	}
+
+	denom := m.resolveDenom(ctx, msg.Denom)
+	bd, err := m.GetBridgeDenom(ctx, denom)
+	if err != nil {
+		return nil, err
+	}
+	if !bd.Enabled {
+		return nil, types.ErrUnsupportedDenom.Wrapf("provision of %s is disabled", denom)
+	}
+
+	data := types.ProvisionData{
+		Seq:      msg.Seq,
+		Amount:   msg.Amount,
+		Sender:   msg.Sender,
+		Receiver: msg.Receiver,
+		Denom:    msg.Denom,
+	}
+	if err := m.handleProvision(ctx, from, data); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventProvision{
+		Seq:      msg.Seq,
+		Sender:   msg.Sender,
+		Receiver: msg.Receiver,
+		Amount:   msg.Amount.String(),
+		Operator: msg.From,
+		Denom:    denom,
+	}); err != nil {
+		panic(err)
+	}
+
+	return &types.MsgProvisionResponse{}, nil
 }
 
-func (m msgServer) HoldTransfer(ctx context.Context, msg *types.MsgHoldTransfer) (*types.MsgHoldTransferResponse, error) {
-	panic(sdkerrors.ErrNotSupported)
+func (m msgServer) HoldTransfer(goCtx context.Context, msg *types.MsgHoldTransfer) (*types.MsgHoldTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid judge address (%s)", err)
+	}
+
+	if err := m.holdTransfer(ctx, from, msg.Seq); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventHoldTransfer{
+		Seq:   msg.Seq,
+		Judge: msg.From,
+	}); err != nil {
+		panic(err)
+	}
+
+	return &types.MsgHoldTransferResponse{}, nil
 }
 
-func (m msgServer) ReleaseTransfer(ctx context.Context, msg *types.MsgReleaseTransfer) (*types.MsgReleaseTransferResponse, error) {
-	panic(sdkerrors.ErrNotSupported)
+func (m msgServer) ReleaseTransfer(goCtx context.Context, msg *types.MsgReleaseTransfer) (*types.MsgReleaseTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid guardian address (%s)", err)
+	}
+
+	if err := m.releaseTransfer(ctx, from, msg.Seq); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventReleaseTransfer{
+		Seq:      msg.Seq,
+		Guardian: msg.From,
+	}); err != nil {
+		panic(err)
+	}
+
+	return &types.MsgReleaseTransferResponse{}, nil
 }
 
-func (m msgServer) RemoveProvision(ctx context.Context, msg *types.MsgRemoveProvision) (*types.MsgRemoveProvisionResponse, error) {
-	panic(sdkerrors.ErrNotSupported)
+func (m msgServer) RemoveProvision(goCtx context.Context, msg *types.MsgRemoveProvision) (*types.MsgRemoveProvisionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid judge address (%s)", err)
+	}
+
+	removed, err := m.removeProvision(ctx, from, msg.Seq)
+	if err != nil {
+		return nil, err
+	}
+
+	if removed {
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventRemoveProvision{
+			Seq: msg.Seq,
+		}); err != nil {
+			panic(err)
+		}
+	}
+
+	return &types.MsgRemoveProvisionResponse{}, nil
 }
 
-func (m msgServer) ClaimBatch(ctx context.Context, msg *types.MsgClaimBatch) (*types.MsgClaimBatchResponse, error) {
-	panic(sdkerrors.ErrNotSupported)
+func (m msgServer) ClaimBatch(goCtx context.Context, msg *types.MsgClaimBatch) (*types.MsgClaimBatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if m.IsBridgeHalted(ctx) {
+		return nil, types.ErrInactiveBridge
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.From); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid claimer address (%s)", err)
+	}
+
+	if msg.MaxClaims == 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("max claims must be positive")
+	}
+
+	claimed, err := m.claimBatch(ctx, msg.MaxClaims)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, data := range claimed {
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventClaim{
+			Seq:      data.Seq,
+			Sender:   data.Sender,
+			Receiver: data.Receiver,
+			Amount:   data.Amount.String(),
+			Denom:    m.resolveDenom(ctx, data.Denom),
+		}); err != nil {
+			panic(err)
+		}
+	}
+
+	return &types.MsgClaimBatchResponse{}, nil
+}
+
+func (m msgServer) Claim(goCtx context.Context, msg *types.MsgClaim) (*types.MsgClaimResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if m.IsBridgeHalted(ctx) {
+		return nil, types.ErrInactiveBridge
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.From); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid claimer address (%s)", err)
+	}
+
+	data, err := m.claim(ctx, msg.Seq)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventClaim{
+		Seq:      data.Seq,
+		Sender:   data.Sender,
+		Receiver: data.Receiver,
+		Amount:   data.Amount.String(),
+		Denom:    m.resolveDenom(ctx, data.Denom),
+	}); err != nil {
+		panic(err)
+	}
+
+	return &types.MsgClaimResponse{}, nil
 }
 
-func (m msgServer) Claim(ctx context.Context, msg *types.MsgClaim) (*types.MsgClaimResponse, error) {
-	panic(sdkerrors.ErrNotSupported)
+func (m msgServer) UpdateDenom(goCtx context.Context, msg *types.MsgUpdateDenom) (*types.MsgUpdateDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != m.Keeper.GetAuthority() {
+		return nil, fmt.Errorf(
+			"invalid authority; expected %s, got %s",
+			m.Keeper.GetAuthority(), msg.Authority)
+	}
+
+	if err := m.updateBridgeDenom(ctx, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventUpdateDenom{
+		Denom: msg.Denom,
+	}); err != nil {
+		panic(err)
+	}
+
+	return &types.MsgUpdateDenomResponse{}, nil
 }
 
 func (m msgServer) SuggestRole(goCtx context.Context, msg *types.MsgSuggestRole) (*types.MsgSuggestRoleResponse, error) {
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 	"github.com/Finschia/finschia-sdk/x/fbridge/types"
 )
 
@@ -10,6 +11,10 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 		return err
 	}
 
+	if len(params.FeeRecipient) != 0 && k.authKeeper.GetModuleAddress(params.FeeRecipient) == nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("fee recipient %s is not a module account", params.FeeRecipient)
+	}
+
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshal(&params)
 	store.Set(types.KeyParams, bz)
@@ -5,13 +5,14 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/Finschia/finschia-sdk/types"
 	"github.com/Finschia/finschia-sdk/x/fbridge/testutil"
 	"github.com/Finschia/finschia-sdk/x/fbridge/types"
 )
 
 func TestSetParams(t *testing.T) {
-	key, memKey, ctx, encCfg, authKeeper, bankKeeper, _ := testutil.PrepareFbridgeTest(t, 0)
-	keeper := NewKeeper(encCfg.Codec, key, memKey, authKeeper, bankKeeper, types.DefaultAuthority().String())
+	key, memKey, ctx, encCfg, authKeeper, bankKeeper, foundationKeeper, _ := testutil.PrepareFbridgeTest(t, 0)
+	keeper := NewKeeper(encCfg.Codec, key, memKey, authKeeper, bankKeeper, foundationKeeper, types.DefaultAuthority().String())
 
 	tcs := map[string]struct {
 		malleate func() types.Params
@@ -80,6 +81,23 @@ func TestSetParams(t *testing.T) {
 			},
 			isErr: true,
 		},
+		"negative transfer limit": {
+			malleate: func() types.Params {
+				params := types.DefaultParams()
+				params.TransferMaxPerTx = sdk.NewInt(-1)
+				return params
+			},
+			isErr: true,
+		},
+		"window cap without window period": {
+			malleate: func() types.Params {
+				params := types.DefaultParams()
+				params.TransferWindowCap = sdk.NewInt(100)
+				params.TransferWindowPeriod = 0
+				return params
+			},
+			isErr: true,
+		},
 		"missing some fields": {
 			malleate: func() types.Params {
 				params := types.Params{}
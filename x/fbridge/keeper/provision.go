@@ -0,0 +1,557 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"math"
+	"time"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/fbridge/types"
+)
+
+func (k Keeper) handleProvision(ctx sdk.Context, operator sdk.AccAddress, data types.ProvisionData) error {
+	if k.GetRole(ctx, operator) != types.RoleOperator {
+		return sdkerrors.ErrUnauthorized.Wrap("only operator can execute this action")
+	}
+
+	if err := data.ValidateBasic(); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	// The denom is resolved so that an omitted denom and the explicit target denom
+	// end up in the same commitment.
+	data.Denom = k.resolveDenom(ctx, data.Denom)
+
+	if _, found := k.GetCommitment(ctx, data.Seq, operator); found {
+		return sdkerrors.ErrInvalidRequest.Wrapf("operator already submitted a provision for seq %d", data.Seq)
+	}
+
+	commitment := data.Commitment()
+	status, found := k.GetProvisionStatus(ctx, commitment)
+	if !found {
+		k.setProvision(ctx, commitment, data)
+	}
+	status.ConfirmCounts++
+	k.setProvisionStatus(ctx, commitment, status)
+	k.setCommitment(ctx, data.Seq, operator, commitment)
+	k.updateOperatorSeqs(ctx, operator, data.Seq)
+
+	if _, confirmed := k.GetConfirmedCommitment(ctx, data.Seq); confirmed {
+		return nil
+	}
+
+	nOperators := k.GetRoleMetadata(ctx).Operator
+	if !types.CheckTrustLevelThreshold(nOperators, uint64(status.ConfirmCounts), k.GetParams(ctx).OperatorTrustLevel) {
+		return nil
+	}
+
+	k.confirmProvision(ctx, data.Seq, commitment)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventConfirmProvision{
+		Seq: data.Seq,
+	}); err != nil {
+		panic(err)
+	}
+
+	return nil
+}
+
+// confirmProvision starts the timelock of the provision agreed by n-of-m operators and marks it claimable.
+func (k Keeper) confirmProvision(ctx sdk.Context, seq uint64, commitment []byte) {
+	status, found := k.GetProvisionStatus(ctx, commitment)
+	if !found {
+		panic("provision status must exist before confirmation")
+	}
+
+	status.ConfirmedAt = uint64(ctx.BlockTime().Unix())
+	status.TimelockEnd = k.timelockEnd(ctx, status)
+	k.setProvisionStatus(ctx, commitment, status)
+	k.setConfirmedCommitment(ctx, seq, commitment)
+	k.setPendingClaimSeq(ctx, seq)
+
+	data, found := k.GetProvision(ctx, commitment)
+	if !found {
+		panic("provision data must exist before confirmation")
+	}
+	k.setConfirmedDenomSeq(ctx, k.resolveDenom(ctx, data.Denom), seq)
+
+	greatest := k.GetGreatestConsecutiveConfirmedSeq(ctx)
+	if seq == greatest+1 {
+		for {
+			if _, found := k.GetConfirmedCommitment(ctx, greatest+1); !found {
+				break
+			}
+			greatest++
+		}
+		k.setGreatestConsecutiveConfirmedSeq(ctx, greatest)
+	}
+}
+
+func (k Keeper) updateOperatorSeqs(ctx sdk.Context, operator sdk.AccAddress, seq uint64) {
+	if seq > k.GetGreatestSeqByOperator(ctx, operator) {
+		k.setGreatestSeqByOperator(ctx, operator, seq)
+	}
+
+	greatest := k.GetGreatestConsecutiveSeqByOperator(ctx, operator)
+	if seq == greatest+1 {
+		for {
+			if _, found := k.GetCommitment(ctx, greatest+1, operator); !found {
+				break
+			}
+			greatest++
+		}
+		k.setGreatestConsecutiveSeqByOperator(ctx, operator, greatest)
+	}
+}
+
+func (k Keeper) holdTransfer(ctx sdk.Context, judge sdk.AccAddress, seq uint64) error {
+	if k.GetRole(ctx, judge) != types.RoleJudge {
+		return sdkerrors.ErrUnauthorized.Wrap("only judge can execute this action")
+	}
+
+	commitment, status, err := k.getClaimableProvisionStatus(ctx, seq)
+	if err != nil {
+		return err
+	}
+
+	status.TimelockEnd = math.MaxUint64
+	k.setProvisionStatus(ctx, commitment, status)
+
+	return nil
+}
+
+func (k Keeper) releaseTransfer(ctx sdk.Context, guardian sdk.AccAddress, seq uint64) error {
+	if k.GetRole(ctx, guardian) != types.RoleGuardian {
+		return sdkerrors.ErrUnauthorized.Wrap("only guardian can execute this action")
+	}
+
+	commitment, status, err := k.getClaimableProvisionStatus(ctx, seq)
+	if err != nil {
+		return err
+	}
+
+	if status.TimelockEnd != math.MaxUint64 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("seq %d has not been held", seq)
+	}
+
+	status.TimelockEnd = k.timelockEnd(ctx, status)
+	k.setProvisionStatus(ctx, commitment, status)
+
+	return nil
+}
+
+// timelockEnd returns the time the confirmed provision can be claimed at, unless it has been held.
+func (k Keeper) timelockEnd(ctx sdk.Context, status types.ProvisionStatus) uint64 {
+	timelockPeriod := time.Duration(k.GetParams(ctx).TimelockPeriod)
+	return uint64(time.Unix(int64(status.ConfirmedAt), 0).Add(timelockPeriod).Unix())
+}
+
+// removeProvision records the judge's vote to remove the confirmed provision.
+// It returns true if the provision has been removed as the votes meet the judge trust level.
+func (k Keeper) removeProvision(ctx sdk.Context, judge sdk.AccAddress, seq uint64) (bool, error) {
+	if k.GetRole(ctx, judge) != types.RoleJudge {
+		return false, sdkerrors.ErrUnauthorized.Wrap("only judge can execute this action")
+	}
+
+	if _, _, err := k.getClaimableProvisionStatus(ctx, seq); err != nil {
+		return false, err
+	}
+
+	if k.hasRemovalVote(ctx, seq, judge) {
+		return false, sdkerrors.ErrInvalidRequest.Wrapf("%s already voted to remove seq %d", judge, seq)
+	}
+	k.setRemovalVote(ctx, seq, judge)
+
+	nVotes := uint64(len(k.GetRemovalVotes(ctx, seq)))
+	if !types.CheckTrustLevelThreshold(k.GetRoleMetadata(ctx).Judge, nVotes, k.GetParams(ctx).JudgeTrustLevel) {
+		return false, nil
+	}
+
+	k.resetSeq(ctx, seq)
+
+	return true, nil
+}
+
+// resetSeq removes every provision and commitment of the sequence number so that operators can submit it again.
+func (k Keeper) resetSeq(ctx sdk.Context, seq uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	if commitment, found := k.GetConfirmedCommitment(ctx, seq); found {
+		if data, found := k.GetProvision(ctx, commitment); found {
+			k.deleteConfirmedDenomSeq(ctx, k.resolveDenom(ctx, data.Denom), seq)
+		}
+	}
+
+	commitments := k.GetCommitments(ctx, seq)
+	for _, c := range commitments {
+		operator := sdk.MustAccAddressFromBech32(c.Operator)
+		if k.GetGreatestConsecutiveSeqByOperator(ctx, operator) >= seq {
+			k.setGreatestConsecutiveSeqByOperator(ctx, operator, seq-1)
+		}
+		store.Delete(types.CommitmentKey(seq, operator))
+
+		commitment, err := hex.DecodeString(c.Commitment)
+		if err != nil {
+			panic(err)
+		}
+		store.Delete(types.ProvisionKey(commitment))
+		store.Delete(types.ProvisionStatusKey(commitment))
+	}
+
+	for _, judge := range k.GetRemovalVotes(ctx, seq) {
+		store.Delete(types.RemovalVoteKey(seq, judge))
+	}
+
+	store.Delete(types.ConfirmedSeqToCommitmentKey(seq))
+	store.Delete(types.PendingClaimSeqKey(seq))
+
+	if k.GetGreatestConsecutiveConfirmedSeq(ctx) >= seq {
+		k.setGreatestConsecutiveConfirmedSeq(ctx, seq-1)
+	}
+}
+
+func (k Keeper) claim(ctx sdk.Context, seq uint64) (types.ProvisionData, error) {
+	commitment, status, err := k.getClaimableProvisionStatus(ctx, seq)
+	if err != nil {
+		return types.ProvisionData{}, err
+	}
+
+	if status.TimelockEnd > uint64(ctx.BlockTime().Unix()) {
+		return types.ProvisionData{}, types.ErrTimelockNotExpired.Wrapf("seq %d can be claimed after %d", seq, status.TimelockEnd)
+	}
+
+	data, found := k.GetProvision(ctx, commitment)
+	if !found {
+		panic("confirmed provision must exist")
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return types.ProvisionData{}, sdkerrors.ErrInvalidAddress.Wrapf("invalid receiver address (%s)", err)
+	}
+
+	token := sdk.Coins{sdk.Coin{Denom: k.resolveDenom(ctx, data.Denom), Amount: data.Amount}}
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, token); err != nil {
+		panic(err)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, token); err != nil {
+		return types.ProvisionData{}, err
+	}
+
+	status.IsClaimed = true
+	k.setProvisionStatus(ctx, commitment, status)
+	k.deletePendingClaimSeq(ctx, seq)
+
+	return data, nil
+}
+
+// claimBatch claims the claimable provisions in ascending order of the sequence number up to maxClaims.
+// A provision failing to be claimed (e.g. its receiver cannot receive coins) is skipped and left pending,
+// so that it does not block the claims of the others.
+func (k Keeper) claimBatch(ctx sdk.Context, maxClaims uint64) ([]types.ProvisionData, error) {
+	claimed := make([]types.ProvisionData, 0)
+
+	after := uint64(0)
+	for remaining := maxClaims; remaining > 0; remaining = maxClaims - uint64(len(claimed)) {
+		seqs := k.getClaimableSeqs(ctx, after, remaining)
+		if len(seqs) == 0 {
+			break
+		}
+		after = seqs[len(seqs)-1]
+
+		for _, seq := range seqs {
+			// Caching context so that we don't update the store in case of failure.
+			cacheCtx, flush := ctx.CacheContext()
+			data, err := k.claim(cacheCtx, seq)
+			if err != nil {
+				k.Logger(ctx).Error("failed to claim the provision", "seq", seq, "cause", err)
+				continue
+			}
+			flush()
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+			claimed = append(claimed, data)
+		}
+	}
+
+	return claimed, nil
+}
+
+// getClaimableSeqs returns at most limit sequence numbers greater than after, whose timelock has expired.
+func (k Keeper) getClaimableSeqs(ctx sdk.Context, after, limit uint64) []uint64 {
+	now := uint64(ctx.BlockTime().Unix())
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.PendingClaimSeqKey(after+1), sdk.PrefixEndBytes(types.KeyPendingClaimSeqPrefix))
+	defer iterator.Close()
+
+	var seqs []uint64
+	for ; iterator.Valid() && uint64(len(seqs)) < limit; iterator.Next() {
+		seq := binary.BigEndian.Uint64(iterator.Key()[1:])
+
+		commitment, found := k.GetConfirmedCommitment(ctx, seq)
+		if !found {
+			panic("pending claim must have been confirmed")
+		}
+		status, _ := k.GetProvisionStatus(ctx, commitment)
+		if status.TimelockEnd <= now {
+			seqs = append(seqs, seq)
+		}
+	}
+
+	return seqs
+}
+
+// getNeededSubmissionSeqs returns the sequence numbers which the operator has not submitted yet.
+// The search starts right after the greatest consecutive sequence number of the operator, or of n-of-m operators if
+// the operator lags behind them (e.g. newly added), and ends at the greatest sequence number known by any operator.
+func (k Keeper) getNeededSubmissionSeqs(ctx sdk.Context, operator sdk.AccAddress, searchRange uint64) []uint64 {
+	start := k.GetGreatestConsecutiveSeqByOperator(ctx, operator)
+	if confirmed := k.GetGreatestConsecutiveConfirmedSeq(ctx); confirmed > start {
+		start = confirmed
+	}
+	start++
+
+	end := start + searchRange - 1
+	if greatest := k.getGreatestSeqAmongOperators(ctx); greatest < end {
+		end = greatest
+	}
+
+	seqs := make([]uint64, 0)
+	for seq := start; seq <= end; seq++ {
+		if _, found := k.GetCommitment(ctx, seq, operator); !found {
+			seqs = append(seqs, seq)
+		}
+	}
+
+	return seqs
+}
+
+func (k Keeper) getGreatestSeqAmongOperators(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyGreatestSeqByOperatorPrefix)
+	defer iterator.Close()
+
+	greatest := uint64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		if seq := binary.BigEndian.Uint64(iterator.Value()); seq > greatest {
+			greatest = seq
+		}
+	}
+
+	return greatest
+}
+
+func (k Keeper) mustGetProvisionAndStatus(ctx sdk.Context, commitment []byte) (types.ProvisionData, types.ProvisionStatus) {
+	data, found := k.GetProvision(ctx, commitment)
+	if !found {
+		panic("provision of the commitment must exist")
+	}
+
+	status, found := k.GetProvisionStatus(ctx, commitment)
+	if !found {
+		panic("provision status of the commitment must exist")
+	}
+
+	return data, status
+}
+
+// getClaimableProvisionStatus returns the status of the confirmed provision which has not been claimed yet.
+func (k Keeper) getClaimableProvisionStatus(ctx sdk.Context, seq uint64) ([]byte, types.ProvisionStatus, error) {
+	commitment, found := k.GetConfirmedCommitment(ctx, seq)
+	if !found {
+		return nil, types.ProvisionStatus{}, types.ErrUnknownProvision.Wrapf("no confirmed provision for seq %d", seq)
+	}
+
+	status, found := k.GetProvisionStatus(ctx, commitment)
+	if !found {
+		panic("confirmed provision must have its status")
+	}
+
+	if status.IsClaimed {
+		return nil, types.ProvisionStatus{}, types.ErrProvisionClaimed.Wrapf("seq %d", seq)
+	}
+
+	return commitment, status, nil
+}
+
+func (k Keeper) setGreatestSeqByOperator(ctx sdk.Context, operator sdk.AccAddress, seq uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GreatestSeqByOperatorKey(operator), types.GetSeqBytes(seq))
+}
+
+func (k Keeper) GetGreatestSeqByOperator(ctx sdk.Context, operator sdk.AccAddress) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GreatestSeqByOperatorKey(operator))
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) setGreatestConsecutiveSeqByOperator(ctx sdk.Context, operator sdk.AccAddress, seq uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GreatestConsecutiveSeqByOperatorKey(operator), types.GetSeqBytes(seq))
+}
+
+func (k Keeper) GetGreatestConsecutiveSeqByOperator(ctx sdk.Context, operator sdk.AccAddress) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GreatestConsecutiveSeqByOperatorKey(operator))
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) setGreatestConsecutiveConfirmedSeq(ctx sdk.Context, seq uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyGreatestConsecutiveConfirmedSeq, types.GetSeqBytes(seq))
+}
+
+func (k Keeper) GetGreatestConsecutiveConfirmedSeq(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyGreatestConsecutiveConfirmedSeq)
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) setCommitment(ctx sdk.Context, seq uint64, operator sdk.AccAddress, commitment []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.CommitmentKey(seq, operator), commitment)
+}
+
+// GetCommitments returns the commitments submitted by the operators for the sequence number.
+func (k Keeper) GetCommitments(ctx sdk.Context, seq uint64) []types.Commitment {
+	store := ctx.KVStore(k.storeKey)
+	commitments := make([]types.Commitment, 0)
+	iterator := sdk.KVStorePrefixIterator(store, types.CommitmentsKey(seq))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		_, operator := types.SplitCommitmentKey(iterator.Key())
+		commitments = append(commitments, types.Commitment{
+			Operator:   operator.String(),
+			Seq:        seq,
+			Commitment: hex.EncodeToString(iterator.Value()),
+		})
+	}
+
+	return commitments
+}
+
+// GetCommitment returns the commitment of the provision submitted by the operator.
+func (k Keeper) GetCommitment(ctx sdk.Context, seq uint64, operator sdk.AccAddress) ([]byte, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.CommitmentKey(seq, operator))
+	if bz == nil {
+		return nil, false
+	}
+
+	return bz, true
+}
+
+func (k Keeper) setProvision(ctx sdk.Context, commitment []byte, data types.ProvisionData) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&data)
+	store.Set(types.ProvisionKey(commitment), bz)
+}
+
+func (k Keeper) GetProvision(ctx sdk.Context, commitment []byte) (data types.ProvisionData, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ProvisionKey(commitment))
+	if bz == nil {
+		return data, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &data)
+	return data, true
+}
+
+func (k Keeper) setProvisionStatus(ctx sdk.Context, commitment []byte, status types.ProvisionStatus) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&status)
+	store.Set(types.ProvisionStatusKey(commitment), bz)
+}
+
+func (k Keeper) GetProvisionStatus(ctx sdk.Context, commitment []byte) (status types.ProvisionStatus, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ProvisionStatusKey(commitment))
+	if bz == nil {
+		return status, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &status)
+	return status, true
+}
+
+func (k Keeper) setConfirmedCommitment(ctx sdk.Context, seq uint64, commitment []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ConfirmedSeqToCommitmentKey(seq), commitment)
+}
+
+// GetConfirmedCommitment returns the commitment of the provision confirmed by n-of-m operators.
+func (k Keeper) GetConfirmedCommitment(ctx sdk.Context, seq uint64) ([]byte, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConfirmedSeqToCommitmentKey(seq))
+	if bz == nil {
+		return nil, false
+	}
+
+	return bz, true
+}
+
+func (k Keeper) setPendingClaimSeq(ctx sdk.Context, seq uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PendingClaimSeqKey(seq), []byte{0x01})
+}
+
+func (k Keeper) deletePendingClaimSeq(ctx sdk.Context, seq uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PendingClaimSeqKey(seq))
+}
+
+// IteratePendingClaimSeqs iterates over the sequence numbers to be claimed in ascending order and performs a callback function
+func (k Keeper) IteratePendingClaimSeqs(ctx sdk.Context, cb func(seq uint64) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPendingClaimSeqPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		seq := binary.BigEndian.Uint64(iterator.Key()[1:])
+		if cb(seq) {
+			break
+		}
+	}
+}
+
+func (k Keeper) setRemovalVote(ctx sdk.Context, seq uint64, judge sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.RemovalVoteKey(seq, judge), []byte{0x01})
+}
+
+func (k Keeper) hasRemovalVote(ctx sdk.Context, seq uint64, judge sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.RemovalVoteKey(seq, judge))
+}
+
+// GetRemovalVotes returns the judges who voted to remove the provision of the sequence number
+func (k Keeper) GetRemovalVotes(ctx sdk.Context, seq uint64) []sdk.AccAddress {
+	store := ctx.KVStore(k.storeKey)
+	judges := make([]sdk.AccAddress, 0)
+	iterator := sdk.KVStorePrefixIterator(store, types.RemovalVotesKey(seq))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		_, judge := types.SplitRemovalVoteKey(iterator.Key())
+		judges = append(judges, judge)
+	}
+
+	return judges
+}
@@ -0,0 +1,321 @@
+package keeper
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/fbridge/testutil"
+	"github.com/Finschia/finschia-sdk/x/fbridge/types"
+)
+
+const testEthAddr = "0xf7bAc63fc7CEaCf0589F25454Ecf5C2ce904997c"
+
+func prepareInboundTest(t *testing.T) (Keeper, sdk.Context, *testutil.MockBankKeeper, []sdk.AccAddress, []sdk.AccAddress, sdk.AccAddress, sdk.AccAddress) {
+	key, memKey, ctx, encCfg, authKeeper, bankKeeper, foundationKeeper, addrs := testutil.PrepareFbridgeTest(t, 6)
+	k := NewKeeper(encCfg.Codec, key, memKey, authKeeper, bankKeeper, foundationKeeper, types.DefaultAuthority().String())
+	err := k.InitGenesis(ctx, types.DefaultGenesisState())
+	require.NoError(t, err)
+
+	operators, judge, guardian := addrs[:3], addrs[3], addrs[4]
+	for _, op := range operators {
+		require.NoError(t, k.updateRole(ctx, types.RoleOperator, op))
+	}
+	require.NoError(t, k.updateRole(ctx, types.RoleJudge, judge))
+	require.NoError(t, k.updateRole(ctx, types.RoleGuardian, guardian))
+
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0).UTC())
+	return k, ctx, bankKeeper, operators, addrs[5:], judge, guardian
+}
+
+func TestHandleProvision(t *testing.T) {
+	k, ctx, _, operators, users, _, _ := prepareInboundTest(t)
+
+	data := types.ProvisionData{Seq: 1, Amount: sdk.NewInt(100), Sender: testEthAddr, Receiver: users[0].String(), Denom: sdk.DefaultBondDenom}
+	commitment := data.Commitment()
+
+	err := k.handleProvision(ctx, users[0], data)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized, "only operator can submit a provision")
+
+	// 1. the first submission is not enough to confirm the provision (1/3)
+	require.NoError(t, k.handleProvision(ctx, operators[0], data))
+	_, confirmed := k.GetConfirmedCommitment(ctx, data.Seq)
+	require.False(t, confirmed)
+	status, found := k.GetProvisionStatus(ctx, commitment)
+	require.True(t, found)
+	require.EqualValues(t, 1, status.ConfirmCounts)
+	require.EqualValues(t, 1, k.GetGreatestSeqByOperator(ctx, operators[0]))
+	require.EqualValues(t, 1, k.GetGreatestConsecutiveSeqByOperator(ctx, operators[0]))
+	require.Error(t, k.handleProvision(ctx, operators[0], data), "an operator cannot submit the same seq twice")
+
+	// 2. the second submission confirms the provision (2/3)
+	require.NoError(t, k.handleProvision(ctx, operators[1], data))
+	c, confirmed := k.GetConfirmedCommitment(ctx, data.Seq)
+	require.True(t, confirmed)
+	require.Equal(t, commitment, c)
+	status, _ = k.GetProvisionStatus(ctx, commitment)
+	require.EqualValues(t, 2, status.ConfirmCounts)
+	timelockEnd := ctx.BlockTime().Add(time.Duration(k.GetParams(ctx).TimelockPeriod)).Unix()
+	require.EqualValues(t, timelockEnd, status.TimelockEnd)
+	require.EqualValues(t, 1, k.GetGreatestConsecutiveConfirmedSeq(ctx))
+
+	// 3. a non-consecutive provision does not advance the consecutive sequences
+	data3 := types.ProvisionData{Seq: 3, Amount: sdk.NewInt(300), Sender: testEthAddr, Receiver: users[0].String()}
+	require.NoError(t, k.handleProvision(ctx, operators[0], data3))
+	require.NoError(t, k.handleProvision(ctx, operators[1], data3))
+	require.EqualValues(t, 3, k.GetGreatestSeqByOperator(ctx, operators[0]))
+	require.EqualValues(t, 1, k.GetGreatestConsecutiveSeqByOperator(ctx, operators[0]))
+	require.EqualValues(t, 1, k.GetGreatestConsecutiveConfirmedSeq(ctx))
+
+	data2 := types.ProvisionData{Seq: 2, Amount: sdk.NewInt(200), Sender: testEthAddr, Receiver: users[0].String()}
+	require.NoError(t, k.handleProvision(ctx, operators[0], data2))
+	require.NoError(t, k.handleProvision(ctx, operators[1], data2))
+	require.EqualValues(t, 3, k.GetGreatestConsecutiveSeqByOperator(ctx, operators[0]))
+	require.EqualValues(t, 3, k.GetGreatestConsecutiveConfirmedSeq(ctx))
+
+	// 4. the late submission is recorded without changing the confirmed provision
+	require.NoError(t, k.handleProvision(ctx, operators[2], data))
+	status, _ = k.GetProvisionStatus(ctx, commitment)
+	require.EqualValues(t, 3, status.ConfirmCounts)
+	require.Len(t, k.GetCommitments(ctx, data.Seq), 3)
+}
+
+func TestHandleProvisionWithDefaultDenom(t *testing.T) {
+	k, ctx, _, operators, users, _, _ := prepareInboundTest(t)
+
+	// an omitted denom and the explicit target denom refer to the same provision
+	omitted := types.ProvisionData{Seq: 1, Amount: sdk.NewInt(100), Sender: testEthAddr, Receiver: users[0].String()}
+	explicit := omitted
+	explicit.Denom = k.GetParams(ctx).TargetDenom
+
+	require.NoError(t, k.handleProvision(ctx, operators[0], omitted))
+	require.NoError(t, k.handleProvision(ctx, operators[1], explicit))
+
+	c, confirmed := k.GetConfirmedCommitment(ctx, omitted.Seq)
+	require.True(t, confirmed)
+	require.Equal(t, explicit.Commitment(), c)
+	status, found := k.GetProvisionStatus(ctx, c)
+	require.True(t, found)
+	require.EqualValues(t, 2, status.ConfirmCounts)
+	require.Len(t, k.GetCommitments(ctx, omitted.Seq), 2)
+	stored, found := k.GetProvision(ctx, c)
+	require.True(t, found)
+	require.Equal(t, explicit.Denom, stored.Denom)
+}
+
+func TestHoldAndReleaseTransfer(t *testing.T) {
+	k, ctx, bankKeeper, operators, users, judge, guardian := prepareInboundTest(t)
+
+	data := types.ProvisionData{Seq: 1, Amount: sdk.NewInt(100), Sender: testEthAddr, Receiver: users[0].String(), Denom: sdk.DefaultBondDenom}
+	require.ErrorIs(t, k.holdTransfer(ctx, judge, data.Seq), types.ErrUnknownProvision)
+	for _, op := range operators[:2] {
+		require.NoError(t, k.handleProvision(ctx, op, data))
+	}
+
+	_, err := k.claim(ctx, data.Seq)
+	require.ErrorIs(t, err, types.ErrTimelockNotExpired)
+
+	// a guardian cannot skip the timelock of the transfer which has not been held
+	before, _ := k.GetProvisionStatus(ctx, data.Commitment())
+	require.Error(t, k.releaseTransfer(ctx, guardian, data.Seq), "only held transfer can be released")
+	after, _ := k.GetProvisionStatus(ctx, data.Commitment())
+	require.Equal(t, before, after)
+
+	require.Error(t, k.holdTransfer(ctx, guardian, data.Seq), "only judge can hold a transfer")
+	require.NoError(t, k.holdTransfer(ctx, judge, data.Seq))
+	status, _ := k.GetProvisionStatus(ctx, data.Commitment())
+	require.EqualValues(t, uint64(math.MaxUint64), status.TimelockEnd)
+
+	_, err = k.claim(ctx, data.Seq)
+	require.ErrorIs(t, err, types.ErrTimelockNotExpired)
+
+	require.Error(t, k.releaseTransfer(ctx, judge, data.Seq), "only guardian can release a transfer")
+	require.NoError(t, k.releaseTransfer(ctx, guardian, data.Seq))
+	status, _ = k.GetProvisionStatus(ctx, data.Commitment())
+	require.Equal(t, before.TimelockEnd, status.TimelockEnd)
+
+	// the released transfer is claimable after the timelock
+	_, err = k.claim(ctx, data.Seq)
+	require.ErrorIs(t, err, types.ErrTimelockNotExpired)
+	ctx = ctx.WithBlockTime(time.Unix(int64(status.TimelockEnd), 0))
+
+	token := sdk.NewCoins(sdk.NewCoin(k.GetParams(ctx).TargetDenom, data.Amount))
+	bankKeeper.EXPECT().MintCoins(ctx, types.ModuleName, token).Return(nil)
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, types.ModuleName, users[0], token).Return(nil)
+	claimed, err := k.claim(ctx, data.Seq)
+	require.NoError(t, err)
+	require.Equal(t, data, claimed)
+
+	status, _ = k.GetProvisionStatus(ctx, data.Commitment())
+	require.True(t, status.IsClaimed)
+	_, err = k.claim(ctx, data.Seq)
+	require.ErrorIs(t, err, types.ErrProvisionClaimed)
+	require.ErrorIs(t, k.holdTransfer(ctx, judge, data.Seq), types.ErrProvisionClaimed)
+}
+
+func TestRemoveProvision(t *testing.T) {
+	k, ctx, _, operators, users, judge, _ := prepareInboundTest(t)
+
+	for seq := uint64(1); seq <= 2; seq++ {
+		data := types.ProvisionData{Seq: seq, Amount: sdk.NewInt(100), Sender: testEthAddr, Receiver: users[0].String()}
+		for _, op := range operators[:2] {
+			require.NoError(t, k.handleProvision(ctx, op, data))
+		}
+	}
+	require.EqualValues(t, 2, k.GetGreatestConsecutiveConfirmedSeq(ctx))
+
+	_, err := k.removeProvision(ctx, operators[0], 2)
+	require.Error(t, err, "only judge can remove a provision")
+
+	removed, err := k.removeProvision(ctx, judge, 2)
+	require.NoError(t, err)
+	require.True(t, removed)
+
+	_, confirmed := k.GetConfirmedCommitment(ctx, 2)
+	require.False(t, confirmed)
+	require.Empty(t, k.GetCommitments(ctx, 2))
+	require.Empty(t, k.GetRemovalVotes(ctx, 2))
+	require.EqualValues(t, 1, k.GetGreatestConsecutiveConfirmedSeq(ctx))
+	require.EqualValues(t, 1, k.GetGreatestConsecutiveSeqByOperator(ctx, operators[0]))
+
+	// operators can submit the removed sequence again
+	data := types.ProvisionData{Seq: 2, Amount: sdk.NewInt(200), Sender: testEthAddr, Receiver: users[0].String(), Denom: sdk.DefaultBondDenom}
+	for _, op := range operators[:2] {
+		require.NoError(t, k.handleProvision(ctx, op, data))
+	}
+	c, confirmed := k.GetConfirmedCommitment(ctx, 2)
+	require.True(t, confirmed)
+	require.Equal(t, data.Commitment(), c)
+}
+
+func TestClaimBatch(t *testing.T) {
+	k, ctx, bankKeeper, operators, users, judge, _ := prepareInboundTest(t)
+
+	for seq := uint64(1); seq <= 3; seq++ {
+		data := types.ProvisionData{Seq: seq, Amount: sdk.NewInt(100), Sender: testEthAddr, Receiver: users[0].String()}
+		for _, op := range operators[:2] {
+			require.NoError(t, k.handleProvision(ctx, op, data))
+		}
+	}
+	require.NoError(t, k.holdTransfer(ctx, judge, 2))
+
+	claimed, err := k.claimBatch(ctx, 10)
+	require.NoError(t, err)
+	require.Empty(t, claimed, "nothing can be claimed before the timelock ends")
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Duration(k.GetParams(ctx).TimelockPeriod)))
+	token := sdk.NewCoins(sdk.NewCoin(k.GetParams(ctx).TargetDenom, sdk.NewInt(100)))
+	bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, token).Return(nil).Times(2)
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, users[0], token).Return(nil).Times(2)
+
+	claimed, err = k.claimBatch(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, claimed, 2)
+	require.EqualValues(t, 1, claimed[0].Seq)
+	require.EqualValues(t, 3, claimed[1].Seq)
+
+	var pending []uint64
+	k.IteratePendingClaimSeqs(ctx, func(seq uint64) bool {
+		pending = append(pending, seq)
+		return false
+	})
+	require.Equal(t, []uint64{2}, pending, "the held provision must remain pending")
+}
+
+func TestClaimBatchSkipsFailures(t *testing.T) {
+	testCases := map[string]struct {
+		maxClaims uint64
+		failing   uint64
+		claimed   []uint64
+	}{
+		"failure in the middle": {
+			maxClaims: 10,
+			failing:   2,
+			claimed:   []uint64{1, 3},
+		},
+		"failure not counted toward the max claims": {
+			maxClaims: 1,
+			failing:   1,
+			claimed:   []uint64{2},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			k, ctx, bankKeeper, operators, users, _, blocked := prepareInboundTest(t)
+
+			for seq := uint64(1); seq <= 3; seq++ {
+				receiver := users[0]
+				if seq == tc.failing {
+					receiver = blocked
+				}
+				data := types.ProvisionData{Seq: seq, Amount: sdk.NewInt(100), Sender: testEthAddr, Receiver: receiver.String()}
+				for _, op := range operators[:2] {
+					require.NoError(t, k.handleProvision(ctx, op, data))
+				}
+			}
+
+			ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Duration(k.GetParams(ctx).TimelockPeriod)))
+			token := sdk.NewCoins(sdk.NewCoin(k.GetParams(ctx).TargetDenom, sdk.NewInt(100)))
+			bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, token).Return(nil).AnyTimes()
+			bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, users[0], token).Return(nil).AnyTimes()
+			bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, blocked, token).Return(sdkerrors.ErrUnauthorized).AnyTimes()
+
+			claimed, err := k.claimBatch(ctx, tc.maxClaims)
+			require.NoError(t, err)
+			seqs := make([]uint64, len(claimed))
+			for i, data := range claimed {
+				seqs[i] = data.Seq
+			}
+			require.Equal(t, tc.claimed, seqs)
+
+			// the failing provision remains pending
+			commitment, _ := k.GetConfirmedCommitment(ctx, tc.failing)
+			status, _ := k.GetProvisionStatus(ctx, commitment)
+			require.False(t, status.IsClaimed)
+			var pending []uint64
+			k.IteratePendingClaimSeqs(ctx, func(seq uint64) bool {
+				pending = append(pending, seq)
+				return false
+			})
+			require.Contains(t, pending, tc.failing)
+		})
+	}
+}
+
+func TestReceivingStateGenesis(t *testing.T) {
+	k, ctx, _, operators, users, judge, _ := prepareInboundTest(t)
+
+	for seq := uint64(1); seq <= 3; seq++ {
+		data := types.ProvisionData{Seq: seq, Amount: sdk.NewInt(100), Sender: testEthAddr, Receiver: users[0].String()}
+		for _, op := range operators[:2] {
+			require.NoError(t, k.handleProvision(ctx, op, data))
+		}
+	}
+	data := types.ProvisionData{Seq: 4, Amount: sdk.NewInt(100), Sender: testEthAddr, Receiver: users[0].String()}
+	require.NoError(t, k.handleProvision(ctx, operators[2], data))
+
+	require.NoError(t, k.updateRole(ctx, types.RoleJudge, users[0]))
+	removed, err := k.removeProvision(ctx, judge, 3)
+	require.NoError(t, err)
+	require.False(t, removed, "a single judge vote must not meet the judge trust level (1/2 < 1/1)")
+
+	gs := k.ExportGenesis(ctx)
+	require.NoError(t, types.ValidateGenesis(*gs))
+	require.Len(t, gs.ReceivingState.Commitments, 7)
+	require.Len(t, gs.ReceivingState.Provisions, 4)
+	require.Len(t, gs.ReceivingState.ConfirmedSeqToCommitment, 3)
+	require.Equal(t, []uint64{1, 2, 3}, gs.ReceivingState.PendingClaimSeqs)
+	require.Len(t, gs.ReceivingState.RemovalVotes, 1)
+	require.EqualValues(t, 3, gs.ReceivingState.GreatestConsecutiveSeq)
+
+	key, memKey, ctx2, encCfg, authKeeper, bankKeeper, foundationKeeper, _ := testutil.PrepareFbridgeTest(t, 0)
+	k2 := NewKeeper(encCfg.Codec, key, memKey, authKeeper, bankKeeper, foundationKeeper, types.DefaultAuthority().String())
+	require.NoError(t, k2.InitGenesis(ctx2, gs))
+	require.Equal(t, gs, k2.ExportGenesis(ctx2))
+}
@@ -8,6 +8,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"golang.org/x/crypto/sha3"
 
@@ -16,25 +17,300 @@ import (
 	"github.com/Finschia/finschia-sdk/x/fbridge/types"
 )
 
-func (k Keeper) handleBridgeTransfer(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Int) (uint64, error) {
-	token := sdk.Coins{sdk.Coin{Denom: k.GetParams(ctx).TargetDenom, Amount: amount}}
+// handleBridgeTransfer burns the token of the sender except the fee, and returns the sequence number of the
+// bridge request and the fee deducted from the amount.
+func (k Keeper) handleBridgeTransfer(ctx sdk.Context, sender sdk.AccAddress, denom string, amount sdk.Int) (uint64, sdk.Int, error) {
+	params := k.GetParams(ctx)
+	bd, err := k.GetBridgeDenom(ctx, denom)
+	if err != nil {
+		return 0, sdk.Int{}, err
+	}
+
+	if !bd.Enabled {
+		return 0, sdk.Int{}, types.ErrUnsupportedDenom.Wrapf("transfer of %s is disabled", denom)
+	}
+
+	if err := bd.ValidateAmount(amount); err != nil {
+		return 0, sdk.Int{}, err
+	}
+
+	token := sdk.Coins{sdk.Coin{Denom: denom, Amount: amount}}
 	if err := k.bankKeeper.IsSendEnabledCoins(ctx, token...); err != nil {
-		return 0, err
+		return 0, sdk.Int{}, err
+	}
+
+	if err := k.checkTransferLimits(ctx, getTransferCaps(params, bd), denom, sender, amount); err != nil {
+		return 0, sdk.Int{}, err
 	}
 
 	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, token); err != nil {
 		panic(err)
 	}
 
-	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, token); err != nil {
+	fee := bd.CalcFee(amount)
+	if fee.IsPositive() {
+		k.sendTransferFee(ctx, params.FeeRecipient, sdk.Coins{sdk.Coin{Denom: denom, Amount: fee}})
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.Coins{sdk.Coin{Denom: denom, Amount: amount.Sub(fee)}}); err != nil {
 		panic(fmt.Errorf("cannot burn coins after a successful send to a module account: %v", err))
 	}
 
 	seq := k.GetNextSequence(ctx)
 	k.setNextSequence(ctx, seq+1)
 	k.setSeqToBlocknum(ctx, seq, uint64(ctx.BlockHeight()))
+	k.setDenomSeq(ctx, denom, seq)
+	k.addTransferOutflow(ctx, denom, sender, amount)
+
+	return seq, fee, nil
+}
+
+// sendTransferFee sends the fee held by the module account to the recipient module account,
+// or to the foundation treasury if the recipient is not specified.
+func (k Keeper) sendTransferFee(ctx sdk.Context, recipient string, fee sdk.Coins) {
+	if len(recipient) == 0 {
+		if err := k.foundationKeeper.FundTreasury(ctx, k.authKeeper.GetModuleAddress(types.ModuleName), fee); err != nil {
+			panic(fmt.Errorf("cannot send the fee to the treasury: %v", err))
+		}
+		return
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, recipient, fee); err != nil {
+		panic(fmt.Errorf("cannot send the fee to %s: %v", recipient, err))
+	}
+}
+
+// transferCaps are the caps which apply to the transfer requests of a denom.
+type transferCaps struct {
+	maxPerTx     sdk.Int
+	window       sdk.Int
+	address      sdk.Int
+	blockOutflow sdk.Int
+}
+
+// getTransferCaps returns the caps of the denom. The caps in the params also apply to the target denom.
+func getTransferCaps(params types.Params, bd types.BridgeDenom) transferCaps {
+	caps := transferCaps{
+		maxPerTx:     bd.MaxAmount,
+		window:       bd.WindowCap,
+		address:      bd.AddressCap,
+		blockOutflow: bd.BlockOutflowThreshold,
+	}
+	if bd.Denom == params.TargetDenom {
+		caps.maxPerTx = types.MinTransferLimit(caps.maxPerTx, params.TransferMaxPerTx)
+		caps.window = types.MinTransferLimit(caps.window, params.TransferWindowCap)
+		caps.address = types.MinTransferLimit(caps.address, params.TransferAddressCap)
+		caps.blockOutflow = types.MinTransferLimit(caps.blockOutflow, params.BlockOutflowThreshold)
+	}
+
+	return caps
+}
+
+func (k Keeper) checkTransferLimits(ctx sdk.Context, caps transferCaps, denom string, sender sdk.AccAddress, amount sdk.Int) error {
+	if types.IsTransferLimitSet(caps.maxPerTx) && amount.GT(caps.maxPerTx) {
+		return types.ErrExceedTransferLimit.Wrapf("amount %s exceeds the limit per tx %s of %s", amount, caps.maxPerTx, denom)
+	}
+
+	if types.IsTransferLimitSet(caps.window) {
+		outflow := k.GetTransferWindow(ctx, denom).Outflow
+		if outflow.Add(amount).GT(caps.window) {
+			return types.ErrExceedTransferLimit.Wrapf("outflow %s of the current window plus %s exceeds the window cap %s of %s", outflow, amount, caps.window, denom)
+		}
+	}
+
+	if types.IsTransferLimitSet(caps.address) {
+		outflow := k.GetAddressOutflow(ctx, denom, sender)
+		if outflow.Add(amount).GT(caps.address) {
+			return types.ErrExceedTransferLimit.Wrapf("outflow %s of %s plus %s exceeds the address cap %s of %s", outflow, sender, amount, caps.address, denom)
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) addTransferOutflow(ctx sdk.Context, denom string, sender sdk.AccAddress, amount sdk.Int) {
+	window := k.GetTransferWindow(ctx, denom)
+	window.Outflow = window.Outflow.Add(amount)
+	k.setTransferWindow(ctx, window)
+	k.setAddressOutflow(ctx, denom, sender, k.GetAddressOutflow(ctx, denom, sender).Add(amount))
+	k.setBlockOutflow(ctx, denom, k.GetBlockOutflow(ctx, denom).Add(amount))
+}
+
+// checkOutflowAnomaly halts the bridge if the outflow of any denom in the current block exceeds its threshold.
+func (k Keeper) checkOutflowAnomaly(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	for _, outflow := range k.getAllBlockOutflows(ctx) {
+		bd, err := k.GetBridgeDenom(ctx, outflow.Denom)
+		if err != nil {
+			bd = types.BridgeDenom{Denom: outflow.Denom}
+		}
+
+		threshold := getTransferCaps(params, bd).blockOutflow
+		if types.IsTransferLimitSet(threshold) && outflow.Amount.GT(threshold) {
+			k.Logger(ctx).Error("outflow anomaly detected; halting the bridge", "denom", outflow.Denom, "outflow", outflow.Amount, "threshold", threshold)
+			k.tripCircuitBreaker(ctx, types.BridgeStatusReasonOutflowAnomaly)
+			return
+		}
+	}
+}
+
+func (k Keeper) GetBlockOutflow(ctx sdk.Context, denom string) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.BlockOutflowKey(denom))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	var outflow sdk.Int
+	if err := outflow.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return outflow
+}
+
+func (k Keeper) setBlockOutflow(ctx sdk.Context, denom string, outflow sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := outflow.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.BlockOutflowKey(denom), bz)
+}
+
+// getAllBlockOutflows returns the outflow of each denom in the current block, in the order of the denoms.
+func (k Keeper) getAllBlockOutflows(ctx sdk.Context) []sdk.Coin {
+	outflows := []sdk.Coin{}
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyBlockOutflowPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		denom := string(iterator.Key()[len(types.KeyBlockOutflowPrefix)+1:])
+		var outflow sdk.Int
+		if err := outflow.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		outflows = append(outflows, sdk.Coin{Denom: denom, Amount: outflow})
+	}
+
+	return outflows
+}
+
+func (k Keeper) deleteBlockOutflows(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	for _, outflow := range k.getAllBlockOutflows(ctx) {
+		store.Delete(types.BlockOutflowKey(outflow.Denom))
+	}
+}
+
+// updateTransferWindow ends the transfer windows which have expired. A new window of the denom starts
+// on its next transfer request.
+func (k Keeper) updateTransferWindow(ctx sdk.Context) {
+	period := time.Duration(k.GetParams(ctx).TransferWindowPeriod)
+	for _, window := range k.getAllTransferWindows(ctx) {
+		if period != 0 && ctx.BlockTime().Before(window.Start.Add(period)) {
+			continue
+		}
+
+		for _, outflow := range k.getAddressOutflows(ctx, window.Denom) {
+			k.deleteAddressOutflow(ctx, window.Denom, sdk.MustAccAddressFromBech32(outflow.Address))
+		}
+		k.deleteTransferWindow(ctx, window.Denom)
+	}
+}
+
+// GetTransferWindow returns the current transfer window of the denom. If there is no window in effect,
+// it returns an empty window starting at the current block time.
+func (k Keeper) GetTransferWindow(ctx sdk.Context, denom string) types.TransferWindow {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.TransferWindowKey(denom))
+	if bz == nil {
+		return types.TransferWindow{Start: ctx.BlockTime(), Outflow: sdk.ZeroInt(), Denom: denom}
+	}
+
+	var window types.TransferWindow
+	k.cdc.MustUnmarshal(bz, &window)
+	return window
+}
+
+func (k Keeper) setTransferWindow(ctx sdk.Context, window types.TransferWindow) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&window)
+	store.Set(types.TransferWindowKey(window.Denom), bz)
+}
+
+func (k Keeper) deleteTransferWindow(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.TransferWindowKey(denom))
+}
+
+func (k Keeper) getAllTransferWindows(ctx sdk.Context) []types.TransferWindow {
+	windows := make([]types.TransferWindow, 0)
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyTransferWindowPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var window types.TransferWindow
+		k.cdc.MustUnmarshal(iterator.Value(), &window)
+		windows = append(windows, window)
+	}
+
+	return windows
+}
+
+func (k Keeper) GetAddressOutflow(ctx sdk.Context, denom string, addr sdk.AccAddress) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.AddressOutflowKey(denom, addr))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	var outflow sdk.Int
+	if err := outflow.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return outflow
+}
+
+func (k Keeper) setAddressOutflow(ctx sdk.Context, denom string, addr sdk.AccAddress, outflow sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := outflow.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.AddressOutflowKey(denom, addr), bz)
+}
+
+func (k Keeper) deleteAddressOutflow(ctx sdk.Context, denom string, addr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.AddressOutflowKey(denom, addr))
+}
+
+func (k Keeper) getAddressOutflows(ctx sdk.Context, denom string) []types.AddressOutflow {
+	return k.iterateAddressOutflows(ctx, types.AddressOutflowsKey(denom))
+}
+
+func (k Keeper) getAllAddressOutflows(ctx sdk.Context) []types.AddressOutflow {
+	return k.iterateAddressOutflows(ctx, types.KeyAddressOutflowPrefix)
+}
+
+func (k Keeper) iterateAddressOutflows(ctx sdk.Context, prefix []byte) []types.AddressOutflow {
+	outflows := make([]types.AddressOutflow, 0)
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		denom, addr := types.SplitAddressOutflowKey(iterator.Key())
+		var outflow sdk.Int
+		if err := outflow.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		outflows = append(outflows, types.AddressOutflow{Address: addr.String(), Outflow: outflow, Denom: denom})
+	}
 
-	return seq, nil
+	return outflows
 }
 
 func (k Keeper) setSeqToBlocknum(ctx sdk.Context, seq, height uint64) {
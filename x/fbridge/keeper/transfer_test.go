@@ -3,7 +3,9 @@ package keeper
 import (
 	"encoding/binary"
 	"testing"
+	"time"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/Finschia/finschia-sdk/types"
@@ -12,7 +14,7 @@ import (
 )
 
 func TestHandleBridgeTransfer(t *testing.T) {
-	key, memKey, ctx, encCfg, authKeeper, bankKeeper, _ := testutil.PrepareFbridgeTest(t, 0)
+	key, memKey, ctx, encCfg, authKeeper, bankKeeper, foundationKeeper, _ := testutil.PrepareFbridgeTest(t, 0)
 
 	sender := sdk.AccAddress("test")
 	amt := sdk.NewInt(1000000)
@@ -23,7 +25,7 @@ func TestHandleBridgeTransfer(t *testing.T) {
 	bankKeeper.EXPECT().SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, token).Return(nil)
 	bankKeeper.EXPECT().BurnCoins(ctx, types.ModuleName, token).Return(nil)
 
-	k := NewKeeper(encCfg.Codec, key, memKey, authKeeper, bankKeeper, types.DefaultAuthority().String())
+	k := NewKeeper(encCfg.Codec, key, memKey, authKeeper, bankKeeper, foundationKeeper, types.DefaultAuthority().String())
 	params := types.DefaultParams()
 	params.TargetDenom = denom
 	err := k.SetParams(ctx, params)
@@ -33,7 +35,9 @@ func TestHandleBridgeTransfer(t *testing.T) {
 	binary.BigEndian.PutUint64(bz, targetSeq)
 	ctx.KVStore(key).Set(types.KeyNextSeqSend, bz)
 
-	handledSeq, err := k.handleBridgeTransfer(ctx, sender, amt)
+	k.setBridgeDenom(ctx, types.DefaultBridgeDenom(denom))
+
+	handledSeq, _, err := k.handleBridgeTransfer(ctx, sender, denom, amt)
 	require.NoError(t, err)
 	require.Equal(t, targetSeq, handledSeq)
 	afterSeq := k.GetNextSequence(ctx)
@@ -43,6 +47,126 @@ func TestHandleBridgeTransfer(t *testing.T) {
 	require.Equal(t, uint64(ctx.BlockHeight()), h)
 }
 
+func TestTransferLimits(t *testing.T) {
+	key, memKey, ctx, encCfg, authKeeper, bankKeeper, foundationKeeper, addrs := testutil.PrepareFbridgeTest(t, 2)
+	ctx = ctx.WithBlockHeight(1).WithBlockTime(time.Unix(1_700_000_000, 0).UTC())
+
+	bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).AnyTimes()
+	bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).AnyTimes()
+
+	k := NewKeeper(encCfg.Codec, key, memKey, authKeeper, bankKeeper, foundationKeeper, types.DefaultAuthority().String())
+	require.NoError(t, k.InitGenesis(ctx, types.DefaultGenesisState()))
+	params := types.DefaultParams()
+	params.TransferMaxPerTx = sdk.NewInt(100)
+	params.TransferWindowCap = sdk.NewInt(250)
+	params.TransferAddressCap = sdk.NewInt(150)
+	params.TransferWindowPeriod = uint64(time.Hour)
+	require.NoError(t, k.SetParams(ctx, params))
+	k.BeginBlocker(ctx)
+
+	_, _, err := k.handleBridgeTransfer(ctx, addrs[0], sdk.DefaultBondDenom, sdk.NewInt(101))
+	require.ErrorIs(t, err, types.ErrExceedTransferLimit, "per tx limit")
+
+	_, _, err = k.handleBridgeTransfer(ctx, addrs[0], sdk.DefaultBondDenom, sdk.NewInt(100))
+	require.NoError(t, err)
+	_, _, err = k.handleBridgeTransfer(ctx, addrs[0], sdk.DefaultBondDenom, sdk.NewInt(51))
+	require.ErrorIs(t, err, types.ErrExceedTransferLimit, "address cap")
+	_, _, err = k.handleBridgeTransfer(ctx, addrs[0], sdk.DefaultBondDenom, sdk.NewInt(50))
+	require.NoError(t, err)
+
+	_, _, err = k.handleBridgeTransfer(ctx, addrs[1], sdk.DefaultBondDenom, sdk.NewInt(100))
+	require.NoError(t, err)
+	_, _, err = k.handleBridgeTransfer(ctx, addrs[1], sdk.DefaultBondDenom, sdk.NewInt(1))
+	require.ErrorIs(t, err, types.ErrExceedTransferLimit, "window cap")
+
+	res, err := k.TransferUsage(sdk.WrapSDKContext(ctx), &types.QueryTransferUsageRequest{Address: addrs[0].String()})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(250), res.Outflow)
+	require.Equal(t, sdk.NewInt(150), res.AddressOutflow)
+	require.Equal(t, ctx.BlockTime(), res.WindowStart)
+	require.Equal(t, ctx.BlockTime().Add(time.Hour), res.WindowEnd)
+
+	// the window remains until its period has elapsed
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour - time.Second))
+	k.BeginBlocker(ctx)
+	_, _, err = k.handleBridgeTransfer(ctx, addrs[1], sdk.DefaultBondDenom, sdk.NewInt(1))
+	require.ErrorIs(t, err, types.ErrExceedTransferLimit)
+
+	// a new window starts
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second))
+	k.BeginBlocker(ctx)
+	require.Equal(t, sdk.ZeroInt(), k.GetTransferWindow(ctx, sdk.DefaultBondDenom).Outflow)
+	require.Equal(t, sdk.ZeroInt(), k.GetAddressOutflow(ctx, sdk.DefaultBondDenom, addrs[0]))
+	require.Empty(t, k.getAllAddressOutflows(ctx))
+	_, _, err = k.handleBridgeTransfer(ctx, addrs[0], sdk.DefaultBondDenom, sdk.NewInt(100))
+	require.NoError(t, err)
+
+	gs := k.ExportGenesis(ctx)
+	require.NoError(t, types.ValidateGenesis(*gs))
+	require.Equal(t, []types.AddressOutflow{{Address: addrs[0].String(), Outflow: sdk.NewInt(100), Denom: sdk.DefaultBondDenom}}, gs.SendingState.AddressOutflows)
+	require.Equal(t, []types.TransferWindow{{Start: ctx.BlockTime(), Outflow: sdk.NewInt(100), Denom: sdk.DefaultBondDenom}}, gs.SendingState.TransferWindows)
+}
+
+func TestMultiDenomTransferLimits(t *testing.T) {
+	key, memKey, ctx, encCfg, authKeeper, bankKeeper, foundationKeeper, addrs := testutil.PrepareFbridgeTest(t, 2)
+	ctx = ctx.WithBlockHeight(1).WithBlockTime(time.Unix(1_700_000_000, 0).UTC())
+
+	bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).AnyTimes()
+	bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).AnyTimes()
+
+	k := NewKeeper(encCfg.Codec, key, memKey, authKeeper, bankKeeper, foundationKeeper, types.DefaultAuthority().String())
+	require.NoError(t, k.InitGenesis(ctx, types.DefaultGenesisState()))
+	params := types.DefaultParams()
+	params.TransferWindowCap = sdk.NewInt(1000)
+	params.TransferWindowPeriod = uint64(time.Hour)
+	require.NoError(t, k.SetParams(ctx, params))
+	require.NoError(t, k.updateRole(ctx, types.RoleGuardian, addrs[1]))
+
+	kaia := types.DefaultBridgeDenom("kaia")
+	kaia.WindowCap = sdk.NewInt(250)
+	kaia.AddressCap = sdk.NewInt(150)
+	kaia.BlockOutflowThreshold = sdk.NewInt(200)
+	require.NoError(t, k.updateBridgeDenom(ctx, kaia))
+	k.BeginBlocker(ctx)
+
+	_, _, err := k.handleBridgeTransfer(ctx, addrs[0], "kaia", sdk.NewInt(150))
+	require.NoError(t, err)
+	_, _, err = k.handleBridgeTransfer(ctx, addrs[0], "kaia", sdk.NewInt(1))
+	require.ErrorIs(t, err, types.ErrExceedTransferLimit, "address cap")
+	_, _, err = k.handleBridgeTransfer(ctx, addrs[1], "kaia", sdk.NewInt(101))
+	require.ErrorIs(t, err, types.ErrExceedTransferLimit, "window cap")
+	_, _, err = k.handleBridgeTransfer(ctx, addrs[1], sdk.DefaultBondDenom, sdk.NewInt(500))
+	require.NoError(t, err, "the caps of a denom do not apply to the others")
+
+	res, err := k.TransferUsage(sdk.WrapSDKContext(ctx), &types.QueryTransferUsageRequest{Address: addrs[0].String(), Denom: "kaia"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(150), res.Outflow)
+	require.Equal(t, sdk.NewInt(150), res.AddressOutflow)
+
+	// the outflow of the block is within the threshold of each denom
+	k.EndBlocker(ctx)
+	require.Equal(t, types.StatusActive, k.GetBridgeStatus(ctx))
+
+	ctx = ctx.WithBlockHeight(2)
+	k.BeginBlocker(ctx)
+	require.Equal(t, sdk.ZeroInt(), k.GetBlockOutflow(ctx, "kaia"))
+	_, _, err = k.handleBridgeTransfer(ctx, addrs[1], sdk.DefaultBondDenom, sdk.NewInt(10))
+	require.NoError(t, err)
+	_, _, err = k.handleBridgeTransfer(ctx, addrs[1], "kaia", sdk.NewInt(100))
+	require.NoError(t, err)
+	// the outflows are checked in the order of the denoms regardless of the order of the transfers
+	require.Equal(t, []sdk.Coin{sdk.NewInt64Coin("kaia", 100), sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)}, k.getAllBlockOutflows(ctx))
+	k.EndBlocker(ctx)
+	require.Equal(t, types.StatusActive, k.GetBridgeStatus(ctx))
+
+	kaia.BlockOutflowThreshold = sdk.NewInt(50)
+	require.NoError(t, k.updateBridgeDenom(ctx, kaia))
+	k.EndBlocker(ctx)
+	require.Equal(t, types.StatusInactive, k.GetBridgeStatus(ctx), "the outflow of a second denom trips the circuit breaker")
+}
+
 func TestIsValidEthereumAddress(t *testing.T) {
 	tcs := map[string]struct {
 		isErr   bool
@@ -64,3 +188,44 @@ func TestIsValidEthereumAddress(t *testing.T) {
 		})
 	}
 }
+
+func TestTransferFee(t *testing.T) {
+	key, memKey, ctx, encCfg, authKeeper, bankKeeper, foundationKeeper, addrs := testutil.PrepareFbridgeTest(t, 2)
+	ctx = ctx.WithBlockHeight(1)
+	k := NewKeeper(encCfg.Codec, key, memKey, authKeeper, bankKeeper, foundationKeeper, types.DefaultAuthority().String())
+	require.NoError(t, k.InitGenesis(ctx, types.DefaultGenesisState()))
+	require.NoError(t, k.updateRole(ctx, types.RoleGuardian, addrs[1]))
+	msgServer := NewMsgServer(k)
+	sender := addrs[0]
+
+	bd := types.DefaultBridgeDenom(sdk.DefaultBondDenom)
+	bd.Fee = sdk.NewInt(1)
+	bd.FeeRate = types.Fraction{Numerator: 1, Denominator: 100}
+	require.Error(t, k.updateBridgeDenom(ctx, bd), "fixed fee and fee rate are exclusive")
+	bd.Fee = sdk.ZeroInt()
+	require.NoError(t, k.updateBridgeDenom(ctx, bd))
+
+	// the fee is sent to the configured module account
+	params := k.GetParams(ctx)
+	params.FeeRecipient = "unknown"
+	authKeeper.EXPECT().GetModuleAddress("unknown").Return(nil)
+	require.Error(t, k.SetParams(ctx, params))
+	params.FeeRecipient = "fee_collector"
+	authKeeper.EXPECT().GetModuleAddress("fee_collector").Return(sdk.AccAddress("fee_collector")).AnyTimes()
+	require.NoError(t, k.SetParams(ctx, params))
+
+	bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), gomock.Any()).Return(nil)
+	bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), sender, types.ModuleName, gomock.Any()).Return(nil)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), types.ModuleName, "fee_collector", sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 11))).Return(nil)
+	bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1188))).Return(nil)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	res, err := msgServer.Transfer(sdk.WrapSDKContext(ctx), &types.MsgTransfer{Sender: sender.String(), Receiver: testEthAddr, Amount: sdk.NewInt(1199)})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(11), res.Fee, "the fee is rounded down")
+
+	events := ctx.EventManager().ABCIEvents()
+	msg, err := sdk.ParseTypedEvent(events[len(events)-1])
+	require.NoError(t, err)
+	require.Equal(t, &types.EventTransfer{Seq: 1, Sender: sender.String(), Receiver: testEthAddr, Amount: "1188", Denom: sdk.DefaultBondDenom, Fee: "11"}, msg)
+}
@@ -63,7 +63,7 @@ func MakeTestEncodingConfig() TestEncodingConfig {
 	return encCfg
 }
 
-func PrepareFbridgeTest(tb testing.TB, n int) (*sdk.KVStoreKey, *sdk.MemoryStoreKey, sdk.Context, TestEncodingConfig, *MockAccountKeeper, *MockBankKeeper, []sdk.AccAddress) {
+func PrepareFbridgeTest(tb testing.TB, n int) (*sdk.KVStoreKey, *sdk.MemoryStoreKey, sdk.Context, TestEncodingConfig, *MockAccountKeeper, *MockBankKeeper, *MockFoundationKeeper, []sdk.AccAddress) {
 	tb.Helper()
 
 	ctrl := gomock.NewController(tb)
@@ -75,11 +75,12 @@ func PrepareFbridgeTest(tb testing.TB, n int) (*sdk.KVStoreKey, *sdk.MemoryStore
 	authKeeper := NewMockAccountKeeper(ctrl)
 	authKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(authtypes.NewEmptyModuleAccount("fbridge").GetAddress()).AnyTimes()
 	bankKeeper := NewMockBankKeeper(ctrl)
+	foundationKeeper := NewMockFoundationKeeper(ctrl)
 
 	addrs := make([]sdk.AccAddress, n)
 	for i := 0; i < n; i++ {
 		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
 	}
 
-	return key, memKey, ctx, encCfg, authKeeper, bankKeeper, addrs
+	return key, memKey, ctx, encCfg, authKeeper, bankKeeper, foundationKeeper, addrs
 }
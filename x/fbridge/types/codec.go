@@ -21,6 +21,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgRemoveProvision{}, "lbm-sdk/MsgRemoveProvision")
 	legacy.RegisterAminoMsg(cdc, &MsgClaimBatch{}, "lbm-sdk/MsgClaimBatch")
 	legacy.RegisterAminoMsg(cdc, &MsgClaim{}, "lbm-sdk/MsgClaim")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateDenom{}, "lbm-sdk/MsgUpdateDenom")
 	legacy.RegisterAminoMsg(cdc, &MsgSuggestRole{}, "lbm-sdk/MsgSuggestRole")
 	legacy.RegisterAminoMsg(cdc, &MsgAddVoteForRole{}, "lbm-sdk/MsgAddVoteForRole")
 	legacy.RegisterAminoMsg(cdc, &MsgSetBridgeStatus{}, "lbm-sdk/MsgSetBridgeStatus")
@@ -37,6 +38,7 @@ func RegisterInterfaces(registrar types.InterfaceRegistry) {
 		&MsgRemoveProvision{},
 		&MsgClaimBatch{},
 		&MsgClaim{},
+		&MsgUpdateDenom{},
 		&MsgSuggestRole{},
 		&MsgAddVoteForRole{},
 		&MsgSetBridgeStatus{},
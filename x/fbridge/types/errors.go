@@ -3,7 +3,12 @@ package types
 import sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 
 var (
-	ErrUnknownProposal = sdkerrors.Register(ModuleName, 2, "unknown proposal")
-	ErrUnknownVote     = sdkerrors.Register(ModuleName, 3, "unknown vote")
-	ErrInactiveBridge  = sdkerrors.Register(ModuleName, 4, "the bridge has halted")
+	ErrUnknownProposal     = sdkerrors.Register(ModuleName, 2, "unknown proposal")
+	ErrUnknownVote         = sdkerrors.Register(ModuleName, 3, "unknown vote")
+	ErrInactiveBridge      = sdkerrors.Register(ModuleName, 4, "the bridge has halted")
+	ErrUnknownProvision    = sdkerrors.Register(ModuleName, 5, "unknown provision")
+	ErrProvisionClaimed    = sdkerrors.Register(ModuleName, 6, "provision already claimed")
+	ErrTimelockNotExpired  = sdkerrors.Register(ModuleName, 7, "timelock period has not expired")
+	ErrExceedTransferLimit = sdkerrors.Register(ModuleName, 8, "transfer limit exceeded")
+	ErrUnsupportedDenom    = sdkerrors.Register(ModuleName, 9, "denom is not bridgeable")
 )
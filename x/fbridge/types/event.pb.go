@@ -74,8 +74,12 @@ type EventTransfer struct {
 	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
 	// the recipient address on the destination chain
 	Receiver string `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
-	// the amount of token to be transferred
+	// the amount of token to be transferred, excluding the fee
 	Amount string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// the denom of token to be transferred
+	Denom string `protobuf:"bytes,5,opt,name=denom,proto3" json:"denom,omitempty"`
+	// the fee deducted from the requested amount
+	Fee string `protobuf:"bytes,6,opt,name=fee,proto3" json:"fee,omitempty"`
 }
 
 func (m *EventTransfer) Reset()         { *m = EventTransfer{} }
@@ -139,6 +143,64 @@ func (m *EventTransfer) GetAmount() string {
 	return ""
 }
 
+func (m *EventTransfer) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventTransfer) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
+
+type EventUpdateDenom struct {
+	Denom BridgeDenom `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom"`
+}
+
+func (m *EventUpdateDenom) Reset()         { *m = EventUpdateDenom{} }
+func (m *EventUpdateDenom) String() string { return proto.CompactTextString(m) }
+func (*EventUpdateDenom) ProtoMessage()    {}
+func (*EventUpdateDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a36aa6e56f2275b8, []int{2}
+}
+func (m *EventUpdateDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUpdateDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUpdateDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUpdateDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpdateDenom.Merge(m, src)
+}
+func (m *EventUpdateDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUpdateDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpdateDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUpdateDenom proto.InternalMessageInfo
+
+func (m *EventUpdateDenom) GetDenom() BridgeDenom {
+	if m != nil {
+		return m.Denom
+	}
+	return BridgeDenom{}
+}
+
 type EventSuggestRole struct {
 	Proposal RoleProposal `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal"`
 }
@@ -147,7 +209,7 @@ func (m *EventSuggestRole) Reset()         { *m = EventSuggestRole{} }
 func (m *EventSuggestRole) String() string { return proto.CompactTextString(m) }
 func (*EventSuggestRole) ProtoMessage()    {}
 func (*EventSuggestRole) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a36aa6e56f2275b8, []int{2}
+	return fileDescriptor_a36aa6e56f2275b8, []int{3}
 }
 func (m *EventSuggestRole) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -196,7 +258,7 @@ func (m *EventAddVoteForRole) Reset()         { *m = EventAddVoteForRole{} }
 func (m *EventAddVoteForRole) String() string { return proto.CompactTextString(m) }
 func (*EventAddVoteForRole) ProtoMessage()    {}
 func (*EventAddVoteForRole) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a36aa6e56f2275b8, []int{3}
+	return fileDescriptor_a36aa6e56f2275b8, []int{4}
 }
 func (m *EventAddVoteForRole) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -257,13 +319,15 @@ type EventProvision struct {
 	Amount string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
 	// the address of the operator
 	Operator string `protobuf:"bytes,5,opt,name=operator,proto3" json:"operator,omitempty"`
+	// the denom of token to be claimed
+	Denom string `protobuf:"bytes,6,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *EventProvision) Reset()         { *m = EventProvision{} }
 func (m *EventProvision) String() string { return proto.CompactTextString(m) }
 func (*EventProvision) ProtoMessage()    {}
 func (*EventProvision) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a36aa6e56f2275b8, []int{4}
+	return fileDescriptor_a36aa6e56f2275b8, []int{5}
 }
 func (m *EventProvision) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -327,6 +391,13 @@ func (m *EventProvision) GetOperator() string {
 	return ""
 }
 
+func (m *EventProvision) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 type EventConfirmProvision struct {
 	// the sequence number of the bridge request
 	Seq uint64 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
@@ -336,7 +407,7 @@ func (m *EventConfirmProvision) Reset()         { *m = EventConfirmProvision{} }
 func (m *EventConfirmProvision) String() string { return proto.CompactTextString(m) }
 func (*EventConfirmProvision) ProtoMessage()    {}
 func (*EventConfirmProvision) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a36aa6e56f2275b8, []int{5}
+	return fileDescriptor_a36aa6e56f2275b8, []int{6}
 }
 func (m *EventConfirmProvision) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -372,6 +443,159 @@ func (m *EventConfirmProvision) GetSeq() uint64 {
 	return 0
 }
 
+type EventHoldTransfer struct {
+	// the sequence number of the bridge request
+	Seq uint64 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	// the judge address who holds the transfer
+	Judge string `protobuf:"bytes,2,opt,name=judge,proto3" json:"judge,omitempty"`
+}
+
+func (m *EventHoldTransfer) Reset()         { *m = EventHoldTransfer{} }
+func (m *EventHoldTransfer) String() string { return proto.CompactTextString(m) }
+func (*EventHoldTransfer) ProtoMessage()    {}
+func (*EventHoldTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a36aa6e56f2275b8, []int{7}
+}
+func (m *EventHoldTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventHoldTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventHoldTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventHoldTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventHoldTransfer.Merge(m, src)
+}
+func (m *EventHoldTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventHoldTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventHoldTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventHoldTransfer proto.InternalMessageInfo
+
+func (m *EventHoldTransfer) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *EventHoldTransfer) GetJudge() string {
+	if m != nil {
+		return m.Judge
+	}
+	return ""
+}
+
+type EventReleaseTransfer struct {
+	// the sequence number of the bridge request
+	Seq uint64 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	// the guardian address who releases the transfer
+	Guardian string `protobuf:"bytes,2,opt,name=guardian,proto3" json:"guardian,omitempty"`
+}
+
+func (m *EventReleaseTransfer) Reset()         { *m = EventReleaseTransfer{} }
+func (m *EventReleaseTransfer) String() string { return proto.CompactTextString(m) }
+func (*EventReleaseTransfer) ProtoMessage()    {}
+func (*EventReleaseTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a36aa6e56f2275b8, []int{8}
+}
+func (m *EventReleaseTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventReleaseTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventReleaseTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventReleaseTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventReleaseTransfer.Merge(m, src)
+}
+func (m *EventReleaseTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventReleaseTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventReleaseTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventReleaseTransfer proto.InternalMessageInfo
+
+func (m *EventReleaseTransfer) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *EventReleaseTransfer) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
+	}
+	return ""
+}
+
+type EventRemoveProvision struct {
+	// the sequence number of the bridge request
+	Seq uint64 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+}
+
+func (m *EventRemoveProvision) Reset()         { *m = EventRemoveProvision{} }
+func (m *EventRemoveProvision) String() string { return proto.CompactTextString(m) }
+func (*EventRemoveProvision) ProtoMessage()    {}
+func (*EventRemoveProvision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a36aa6e56f2275b8, []int{9}
+}
+func (m *EventRemoveProvision) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRemoveProvision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRemoveProvision.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRemoveProvision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRemoveProvision.Merge(m, src)
+}
+func (m *EventRemoveProvision) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRemoveProvision) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRemoveProvision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRemoveProvision proto.InternalMessageInfo
+
+func (m *EventRemoveProvision) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
 type EventClaim struct {
 	// the sequence number of the bridge request
 	Seq uint64 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
@@ -381,13 +605,15 @@ type EventClaim struct {
 	Receiver string `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
 	// the amount of token to be claimed
 	Amount string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// the denom of token to be claimed
+	Denom string `protobuf:"bytes,5,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *EventClaim) Reset()         { *m = EventClaim{} }
 func (m *EventClaim) String() string { return proto.CompactTextString(m) }
 func (*EventClaim) ProtoMessage()    {}
 func (*EventClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a36aa6e56f2275b8, []int{6}
+	return fileDescriptor_a36aa6e56f2275b8, []int{10}
 }
 func (m *EventClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -444,6 +670,13 @@ func (m *EventClaim) GetAmount() string {
 	return ""
 }
 
+func (m *EventClaim) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 type EventSetBridgeStatus struct {
 	// the guardian address who modifies the bridge status (a.k.a. bridge switch)
 	Guardian string `protobuf:"bytes,1,opt,name=guardian,proto3" json:"guardian,omitempty"`
@@ -455,7 +688,7 @@ func (m *EventSetBridgeStatus) Reset()         { *m = EventSetBridgeStatus{} }
 func (m *EventSetBridgeStatus) String() string { return proto.CompactTextString(m) }
 func (*EventSetBridgeStatus) ProtoMessage()    {}
 func (*EventSetBridgeStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a36aa6e56f2275b8, []int{7}
+	return fileDescriptor_a36aa6e56f2275b8, []int{11}
 }
 func (m *EventSetBridgeStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -498,52 +731,129 @@ func (m *EventSetBridgeStatus) GetStatus() BridgeStatus {
 	return StatusEmpty
 }
 
+type EventBridgeStatusChanged struct {
+	// the previous status of the bridge
+	PreviousStatus BridgeStatus `protobuf:"varint,1,opt,name=previous_status,json=previousStatus,proto3,enum=lbm.fbridge.v1.BridgeStatus" json:"previous_status,omitempty"`
+	// the new status of the bridge
+	Status BridgeStatus `protobuf:"varint,2,opt,name=status,proto3,enum=lbm.fbridge.v1.BridgeStatus" json:"status,omitempty"`
+	// the cause of the transition (guardian_vote, role_update, params_update, outflow_anomaly)
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EventBridgeStatusChanged) Reset()         { *m = EventBridgeStatusChanged{} }
+func (m *EventBridgeStatusChanged) String() string { return proto.CompactTextString(m) }
+func (*EventBridgeStatusChanged) ProtoMessage()    {}
+func (*EventBridgeStatusChanged) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a36aa6e56f2275b8, []int{12}
+}
+func (m *EventBridgeStatusChanged) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBridgeStatusChanged) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBridgeStatusChanged.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBridgeStatusChanged) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBridgeStatusChanged.Merge(m, src)
+}
+func (m *EventBridgeStatusChanged) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBridgeStatusChanged) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBridgeStatusChanged.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBridgeStatusChanged proto.InternalMessageInfo
+
+func (m *EventBridgeStatusChanged) GetPreviousStatus() BridgeStatus {
+	if m != nil {
+		return m.PreviousStatus
+	}
+	return StatusEmpty
+}
+
+func (m *EventBridgeStatusChanged) GetStatus() BridgeStatus {
+	if m != nil {
+		return m.Status
+	}
+	return StatusEmpty
+}
+
+func (m *EventBridgeStatusChanged) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventUpdateParams)(nil), "lbm.fbridge.v1.EventUpdateParams")
 	proto.RegisterType((*EventTransfer)(nil), "lbm.fbridge.v1.EventTransfer")
+	proto.RegisterType((*EventUpdateDenom)(nil), "lbm.fbridge.v1.EventUpdateDenom")
 	proto.RegisterType((*EventSuggestRole)(nil), "lbm.fbridge.v1.EventSuggestRole")
 	proto.RegisterType((*EventAddVoteForRole)(nil), "lbm.fbridge.v1.EventAddVoteForRole")
 	proto.RegisterType((*EventProvision)(nil), "lbm.fbridge.v1.EventProvision")
 	proto.RegisterType((*EventConfirmProvision)(nil), "lbm.fbridge.v1.EventConfirmProvision")
+	proto.RegisterType((*EventHoldTransfer)(nil), "lbm.fbridge.v1.EventHoldTransfer")
+	proto.RegisterType((*EventReleaseTransfer)(nil), "lbm.fbridge.v1.EventReleaseTransfer")
+	proto.RegisterType((*EventRemoveProvision)(nil), "lbm.fbridge.v1.EventRemoveProvision")
 	proto.RegisterType((*EventClaim)(nil), "lbm.fbridge.v1.EventClaim")
 	proto.RegisterType((*EventSetBridgeStatus)(nil), "lbm.fbridge.v1.EventSetBridgeStatus")
+	proto.RegisterType((*EventBridgeStatusChanged)(nil), "lbm.fbridge.v1.EventBridgeStatusChanged")
 }
 
 func init() { proto.RegisterFile("lbm/fbridge/v1/event.proto", fileDescriptor_a36aa6e56f2275b8) }
 
 var fileDescriptor_a36aa6e56f2275b8 = []byte{
-	// 481 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0x41, 0x6f, 0xd3, 0x4c,
-	0x10, 0x8d, 0xbf, 0xa6, 0x51, 0x3b, 0xd5, 0x17, 0x15, 0x13, 0x2a, 0xcb, 0xaa, 0xdc, 0xca, 0xa7,
-	0x72, 0xc0, 0xa6, 0x81, 0x33, 0x12, 0x41, 0x54, 0x2a, 0x17, 0x22, 0x17, 0x38, 0x70, 0x41, 0xeb,
-	0x78, 0xe3, 0x2c, 0xd8, 0x1e, 0xb3, 0xbb, 0xb6, 0xe0, 0xc6, 0x99, 0x13, 0x3f, 0xab, 0xc7, 0x1e,
-	0x39, 0x21, 0x94, 0xfc, 0x11, 0xb4, 0xe3, 0x4d, 0x04, 0x41, 0xe2, 0xd6, 0xdb, 0x3c, 0xcf, 0x7b,
-	0x6f, 0xde, 0x7a, 0x76, 0xc1, 0x2f, 0xd2, 0x32, 0x9e, 0xa7, 0x52, 0x64, 0x39, 0x8f, 0xdb, 0xf3,
-	0x98, 0xb7, 0xbc, 0xd2, 0x51, 0x2d, 0x51, 0xa3, 0x3b, 0x2c, 0xd2, 0x32, 0xb2, 0xbd, 0xa8, 0x3d,
-	0xf7, 0x47, 0x39, 0xe6, 0x48, 0xad, 0xd8, 0x54, 0x1d, 0xcb, 0x3f, 0xde, 0x72, 0x58, 0x0b, 0xa8,
-	0x1b, 0x5e, 0xc2, 0x9d, 0xe7, 0xc6, 0xf2, 0x75, 0x9d, 0x31, 0xcd, 0xa7, 0x4c, 0xb2, 0x52, 0xb9,
-	0x8f, 0x61, 0x50, 0x53, 0xe5, 0x39, 0xa7, 0xce, 0xd9, 0xc1, 0xf8, 0x28, 0xfa, 0x73, 0x52, 0xd4,
-	0xf1, 0x26, 0xfd, 0xeb, 0x1f, 0x27, 0xbd, 0xc4, 0x72, 0xc3, 0x12, 0xfe, 0x27, 0xab, 0x57, 0x92,
-	0x55, 0x6a, 0xce, 0xa5, 0x7b, 0x08, 0x3b, 0x8a, 0x7f, 0x24, 0x8f, 0x7e, 0x62, 0x4a, 0xf7, 0x08,
-	0x06, 0x8a, 0x57, 0x19, 0x97, 0xde, 0x7f, 0xa7, 0xce, 0xd9, 0x7e, 0x62, 0x91, 0xeb, 0xc3, 0x9e,
-	0xe4, 0x33, 0x2e, 0x5a, 0x2e, 0xbd, 0x1d, 0xea, 0x6c, 0xb0, 0xd1, 0xb0, 0x12, 0x9b, 0x4a, 0x7b,
-	0xfd, 0x4e, 0xd3, 0xa1, 0x30, 0x81, 0x43, 0x1a, 0x77, 0xd5, 0xe4, 0x39, 0x57, 0x3a, 0xc1, 0x82,
-	0xbb, 0x4f, 0x60, 0xaf, 0x96, 0x58, 0xa3, 0x62, 0x85, 0x8d, 0x7e, 0xbc, 0x1d, 0xdd, 0xf0, 0xa6,
-	0x96, 0x63, 0x0f, 0xb0, 0xd1, 0x84, 0x5f, 0x1c, 0xb8, 0x4b, 0xa6, 0x4f, 0xb3, 0xec, 0x0d, 0x6a,
-	0x7e, 0x81, 0x92, 0x7c, 0x47, 0xb0, 0xdb, 0xa2, 0xe6, 0x92, 0x4c, 0xf7, 0x93, 0x0e, 0xb8, 0x27,
-	0x70, 0xb0, 0x56, 0xbe, 0x13, 0x19, 0x1d, 0xa9, 0x9f, 0xc0, 0xfa, 0xd3, 0x65, 0xe6, 0x8e, 0x61,
-	0x80, 0xb5, 0x16, 0x58, 0xd1, 0xa1, 0x86, 0x63, 0x7f, 0x3b, 0x8c, 0x99, 0xf1, 0x92, 0x18, 0x89,
-	0x65, 0x86, 0x5f, 0x1d, 0x18, 0x52, 0x84, 0xa9, 0xc4, 0x56, 0x28, 0x81, 0xd5, 0xed, 0xfe, 0x47,
-	0xa3, 0xc1, 0x9a, 0x4b, 0xa6, 0x51, 0x7a, 0xbb, 0x9d, 0x66, 0x8d, 0xc3, 0xfb, 0x70, 0x8f, 0xb2,
-	0x3c, 0xc3, 0x6a, 0x2e, 0x64, 0xf9, 0x8f, 0x48, 0xe1, 0x7b, 0x80, 0x8e, 0x5a, 0x30, 0x51, 0xde,
-	0xf2, 0xea, 0x17, 0x30, 0xea, 0x56, 0xcf, 0xf5, 0x84, 0xfe, 0xe5, 0x95, 0x66, 0xba, 0x51, 0xc6,
-	0x2b, 0x6f, 0x98, 0xcc, 0x04, 0xab, 0xec, 0xa6, 0x36, 0xd8, 0xdc, 0x69, 0x45, 0x2c, 0x9a, 0x3f,
-	0xfc, 0xfb, 0x62, 0xfc, 0xee, 0x94, 0x58, 0xee, 0xe4, 0xc5, 0xf5, 0x32, 0x70, 0x6e, 0x96, 0x81,
-	0xf3, 0x73, 0x19, 0x38, 0xdf, 0x56, 0x41, 0xef, 0x66, 0x15, 0xf4, 0xbe, 0xaf, 0x82, 0xde, 0xdb,
-	0x87, 0xb9, 0xd0, 0x8b, 0x26, 0x8d, 0x66, 0x58, 0xc6, 0x17, 0xa2, 0x52, 0xb3, 0x85, 0x60, 0xf1,
-	0xdc, 0x16, 0x0f, 0x54, 0xf6, 0x21, 0xfe, 0xb4, 0x79, 0x75, 0xfa, 0x73, 0xcd, 0x55, 0x3a, 0xa0,
-	0x17, 0xf7, 0xe8, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x04, 0x84, 0xa9, 0x5c, 0xd3, 0x03, 0x00,
-	0x00,
+	// 629 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0xcd, 0x7c, 0x4d, 0xa2, 0xf4, 0x56, 0x5f, 0x28, 0x26, 0x54, 0x96, 0xa9, 0xd2, 0xca, 0xab,
+	0xb2, 0x20, 0xa6, 0x05, 0x89, 0x05, 0x12, 0x12, 0xfd, 0x13, 0x85, 0x05, 0x95, 0x0b, 0x2c, 0xd8,
+	0x54, 0x93, 0xce, 0x8d, 0x33, 0x60, 0x7b, 0xcc, 0xcc, 0xd8, 0x82, 0x5d, 0x1f, 0x81, 0x0d, 0x7b,
+	0xde, 0x80, 0xd7, 0xe8, 0xb2, 0x4b, 0x56, 0x08, 0xb5, 0x2f, 0x82, 0x3c, 0x9e, 0x98, 0x34, 0x48,
+	0x11, 0x42, 0x42, 0xec, 0xee, 0xf1, 0x3d, 0xe7, 0xcc, 0xb9, 0x33, 0xe3, 0x01, 0x2f, 0x1e, 0x26,
+	0xc1, 0x68, 0x28, 0x39, 0x8b, 0x30, 0x28, 0x36, 0x03, 0x2c, 0x30, 0xd5, 0x83, 0x4c, 0x0a, 0x2d,
+	0x9c, 0x6e, 0x3c, 0x4c, 0x06, 0xb6, 0x37, 0x28, 0x36, 0xbd, 0x5e, 0x24, 0x22, 0x61, 0x5a, 0x41,
+	0x59, 0x55, 0x2c, 0x6f, 0x75, 0xc6, 0x61, 0x22, 0x30, 0x5d, 0xff, 0x00, 0xae, 0xef, 0x95, 0x96,
+	0x2f, 0x33, 0x46, 0x35, 0x1e, 0x52, 0x49, 0x13, 0xe5, 0xdc, 0x87, 0x76, 0x66, 0x2a, 0x97, 0xac,
+	0x93, 0x8d, 0xa5, 0xad, 0x95, 0xc1, 0xd5, 0x95, 0x06, 0x15, 0x6f, 0xbb, 0x79, 0xf6, 0x6d, 0xad,
+	0x11, 0x5a, 0xae, 0xff, 0x89, 0xc0, 0xff, 0xc6, 0xeb, 0x85, 0xa4, 0xa9, 0x1a, 0xa1, 0x74, 0x96,
+	0x61, 0x41, 0xe1, 0x3b, 0x63, 0xd2, 0x0c, 0xcb, 0xd2, 0x59, 0x81, 0xb6, 0xc2, 0x94, 0xa1, 0x74,
+	0xff, 0x5b, 0x27, 0x1b, 0x8b, 0xa1, 0x45, 0x8e, 0x07, 0x1d, 0x89, 0x27, 0xc8, 0x0b, 0x94, 0xee,
+	0x82, 0xe9, 0xd4, 0xb8, 0xd4, 0xd0, 0x44, 0xe4, 0xa9, 0x76, 0x9b, 0x95, 0xa6, 0x42, 0x4e, 0x0f,
+	0x5a, 0x0c, 0x53, 0x91, 0xb8, 0x2d, 0xf3, 0xb9, 0x02, 0xe5, 0x9a, 0x23, 0x44, 0xb7, 0x6d, 0xbe,
+	0x95, 0xa5, 0xff, 0x0c, 0x96, 0xa7, 0x46, 0xdc, 0x35, 0xac, 0x07, 0x13, 0x6d, 0x35, 0xe0, 0xad,
+	0xd9, 0x01, 0xb7, 0x4d, 0x65, 0xb8, 0x76, 0xca, 0x8a, 0xef, 0x87, 0xd6, 0xec, 0x28, 0x8f, 0x22,
+	0x54, 0x3a, 0x14, 0x31, 0x3a, 0x8f, 0xa0, 0x93, 0x49, 0x91, 0x09, 0x45, 0x63, 0xeb, 0xb7, 0x3a,
+	0xeb, 0x57, 0xf2, 0x0e, 0x2d, 0xc7, 0x1a, 0xd6, 0x1a, 0xff, 0x94, 0xc0, 0x0d, 0x63, 0xfa, 0x98,
+	0xb1, 0x57, 0x42, 0xe3, 0xbe, 0x90, 0xc6, 0xb7, 0x07, 0xad, 0x42, 0x68, 0x94, 0xc6, 0x74, 0x31,
+	0xac, 0x80, 0xb3, 0x06, 0x4b, 0x13, 0xe5, 0x31, 0x67, 0x66, 0x1f, 0x9b, 0x21, 0x4c, 0x3e, 0x1d,
+	0x30, 0x67, 0x0b, 0xda, 0x22, 0xd3, 0x5c, 0xa4, 0x66, 0x27, 0xbb, 0x5b, 0xde, 0x6c, 0x98, 0x72,
+	0x8d, 0xe7, 0x86, 0x11, 0x5a, 0xa6, 0xff, 0x99, 0x40, 0xd7, 0x44, 0x38, 0x94, 0xa2, 0xe0, 0x8a,
+	0x8b, 0xf4, 0x2f, 0x1f, 0x9e, 0x07, 0x1d, 0x91, 0xa1, 0xa4, 0x5a, 0x48, 0x7b, 0x7e, 0x35, 0xfe,
+	0x79, 0xb0, 0xed, 0xa9, 0x83, 0xf5, 0x6f, 0xc3, 0x4d, 0x93, 0x70, 0x47, 0xa4, 0x23, 0x2e, 0x93,
+	0x39, 0x41, 0xfd, 0x87, 0xf6, 0x52, 0x3f, 0x11, 0x31, 0x9b, 0x73, 0x19, 0x7b, 0xd0, 0x7a, 0x93,
+	0xb3, 0x08, 0xed, 0x38, 0x15, 0xf0, 0x77, 0xa1, 0x67, 0xc4, 0x21, 0xc6, 0x48, 0x15, 0xce, 0xd1,
+	0x7b, 0xd0, 0x89, 0x72, 0x2a, 0x19, 0xa7, 0xa9, 0xb5, 0xa8, 0xb1, 0xbf, 0x51, 0xbb, 0x24, 0xa2,
+	0xc0, 0x79, 0x61, 0x4f, 0x09, 0x40, 0x35, 0x58, 0x4c, 0x79, 0xf2, 0x2f, 0xfe, 0x19, 0x7f, 0x6c,
+	0xc3, 0x1e, 0xa1, 0xae, 0x2e, 0xfe, 0x91, 0xa6, 0x3a, 0x57, 0x57, 0x06, 0x24, 0x57, 0x07, 0x2c,
+	0xdf, 0x08, 0x65, 0x58, 0x26, 0x55, 0xf7, 0xd7, 0x2b, 0x3f, 0xed, 0x14, 0x5a, 0xae, 0xff, 0x85,
+	0x80, 0x6b, 0x96, 0x9a, 0xee, 0xee, 0x8c, 0x69, 0x1a, 0x21, 0x73, 0xf6, 0xe0, 0x5a, 0x26, 0xb1,
+	0xe0, 0x22, 0x57, 0xc7, 0xd6, 0x9b, 0xfc, 0x86, 0x77, 0x77, 0x22, 0xb2, 0xa9, 0xff, 0x28, 0x59,
+	0xb9, 0x63, 0x12, 0xa9, 0xb2, 0x7f, 0xcd, 0x62, 0x68, 0xd1, 0xf6, 0xd3, 0xb3, 0x8b, 0x3e, 0x39,
+	0xbf, 0xe8, 0x93, 0xef, 0x17, 0x7d, 0xf2, 0xf1, 0xb2, 0xdf, 0x38, 0xbf, 0xec, 0x37, 0xbe, 0x5e,
+	0xf6, 0x1b, 0xaf, 0xef, 0x46, 0x5c, 0x8f, 0xf3, 0xe1, 0xe0, 0x44, 0x24, 0xc1, 0x3e, 0x4f, 0xd5,
+	0xc9, 0x98, 0xd3, 0x60, 0x64, 0x8b, 0x3b, 0x8a, 0xbd, 0x0d, 0xde, 0xd7, 0xef, 0xae, 0xfe, 0x90,
+	0xa1, 0x1a, 0xb6, 0xcd, 0x9b, 0x7b, 0xef, 0x47, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7e, 0x57, 0xb0,
+	0x42, 0xd5, 0x05, 0x00, 0x00,
 }
 
 func (m *EventUpdateParams) Marshal() (dAtA []byte, err error) {
@@ -599,6 +909,20 @@ func (m *EventTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Fee) > 0 {
+		i -= len(m.Fee)
+		copy(dAtA[i:], m.Fee)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Fee)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Amount) > 0 {
 		i -= len(m.Amount)
 		copy(dAtA[i:], m.Amount)
@@ -628,6 +952,39 @@ func (m *EventTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventUpdateDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Denom.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *EventSuggestRole) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -721,6 +1078,13 @@ func (m *EventProvision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Operator) > 0 {
 		i -= len(m.Operator)
 		copy(dAtA[i:], m.Operator)
@@ -785,7 +1149,7 @@ func (m *EventConfirmProvision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventClaim) Marshal() (dAtA []byte, err error) {
+func (m *EventHoldTransfer) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -795,34 +1159,20 @@ func (m *EventClaim) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventClaim) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventHoldTransfer) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventHoldTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Sender)))
+	if len(m.Judge) > 0 {
+		i -= len(m.Judge)
+		copy(dAtA[i:], m.Judge)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Judge)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -834,7 +1184,7 @@ func (m *EventClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventSetBridgeStatus) Marshal() (dAtA []byte, err error) {
+func (m *EventReleaseTransfer) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -844,19 +1194,138 @@ func (m *EventSetBridgeStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventSetBridgeStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventReleaseTransfer) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventSetBridgeStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventReleaseTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Status != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.Status))
-		i--
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Seq != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRemoveProvision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRemoveProvision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRemoveProvision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Seq != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSetBridgeStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetBridgeStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetBridgeStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Status))
+		i--
 		dAtA[i] = 0x10
 	}
 	if len(m.Guardian) > 0 {
@@ -869,6 +1338,46 @@ func (m *EventSetBridgeStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventBridgeStatusChanged) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBridgeStatusChanged) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBridgeStatusChanged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Status != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PreviousStatus != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.PreviousStatus))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvent(v)
 	base := offset
@@ -912,6 +1421,25 @@ func (m *EventTransfer) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvent(uint64(l))
 	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Fee)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventUpdateDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Denom.Size()
+	n += 1 + l + sovEvent(uint64(l))
 	return n
 }
 
@@ -970,6 +1498,10 @@ func (m *EventProvision) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvent(uint64(l))
 	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
 	return n
 }
 
@@ -985,6 +1517,50 @@ func (m *EventConfirmProvision) Size() (n int) {
 	return n
 }
 
+func (m *EventHoldTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		n += 1 + sovEvent(uint64(m.Seq))
+	}
+	l = len(m.Judge)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventReleaseTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		n += 1 + sovEvent(uint64(m.Seq))
+	}
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRemoveProvision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		n += 1 + sovEvent(uint64(m.Seq))
+	}
+	return n
+}
+
 func (m *EventClaim) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1006,6 +1582,10 @@ func (m *EventClaim) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvent(uint64(l))
 	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
 	return n
 }
 
@@ -1025,6 +1605,25 @@ func (m *EventSetBridgeStatus) Size() (n int) {
 	return n
 }
 
+func (m *EventBridgeStatusChanged) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PreviousStatus != 0 {
+		n += 1 + sovEvent(uint64(m.PreviousStatus))
+	}
+	if m.Status != 0 {
+		n += 1 + sovEvent(uint64(m.Status))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
 func sovEvent(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1258,21 +1857,168 @@ func (m *EventTransfer) Unmarshal(dAtA []byte) error {
 			}
 			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvent(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUpdateDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Denom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
@@ -1444,9 +2190,307 @@ func (m *EventAddVoteForRole) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventProvision) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventProvision: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventProvision: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventConfirmProvision) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventConfirmProvision: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventConfirmProvision: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
 			}
-			m.Option = 0
+			m.Seq = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1456,7 +2500,7 @@ func (m *EventAddVoteForRole) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Option |= VoteOption(b&0x7F) << shift
+				m.Seq |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1482,7 +2526,7 @@ func (m *EventAddVoteForRole) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventProvision) Unmarshal(dAtA []byte) error {
+func (m *EventHoldTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1505,10 +2549,10 @@ func (m *EventProvision) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventProvision: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventHoldTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventProvision: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventHoldTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1532,7 +2576,7 @@ func (m *EventProvision) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Judge", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1560,45 +2604,63 @@ func (m *EventProvision) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Judge = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventReleaseTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventReleaseTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventReleaseTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1608,27 +2670,14 @@ func (m *EventProvision) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Seq |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1656,7 +2705,7 @@ func (m *EventProvision) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.Guardian = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1679,7 +2728,7 @@ func (m *EventProvision) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventConfirmProvision) Unmarshal(dAtA []byte) error {
+func (m *EventRemoveProvision) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1702,10 +2751,10 @@ func (m *EventConfirmProvision) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventConfirmProvision: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRemoveProvision: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventConfirmProvision: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRemoveProvision: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1892,6 +2941,38 @@ func (m *EventClaim) Unmarshal(dAtA []byte) error {
 			}
 			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -2014,6 +3095,126 @@ func (m *EventSetBridgeStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventBridgeStatusChanged) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBridgeStatusChanged: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBridgeStatusChanged: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStatus", wireType)
+			}
+			m.PreviousStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousStatus |= BridgeStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BridgeStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvent(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,6 +2,19 @@ package types
 
 import (
 	"errors"
+
+	"golang.org/x/crypto/sha3"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+)
+
+// causes of the bridge status transition
+const (
+	BridgeStatusReasonGuardianVote   = "guardian_vote"
+	BridgeStatusReasonRoleUpdate     = "role_update"
+	BridgeStatusReasonParamsUpdate   = "params_update"
+	BridgeStatusReasonOutflowAnomaly = "outflow_anomaly"
 )
 
 var QueryParamToRole = map[string]Role{
@@ -37,3 +50,136 @@ func IsValidBridgeStatus(status BridgeStatus) error {
 
 	return errors.New("unsupported bridge status")
 }
+
+// Commitment returns the hash value of the provision data.
+// A provision is identified by its commitment among those submitted by the operators,
+// so the denom must be resolved to the target denom before computing it.
+func (m ProvisionData) Commitment() []byte {
+	bz, err := m.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write(bz)
+	return hasher.Sum(nil)
+}
+
+func (m ProvisionData) ValidateBasic() error {
+	if m.Seq == 0 {
+		return errors.New("sequence must be positive")
+	}
+
+	if !m.Amount.IsPositive() {
+		return errors.New("amount must be positive")
+	}
+
+	if len(m.Sender) == 0 {
+		return errors.New("empty sender")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Receiver); err != nil {
+		return err
+	}
+
+	if len(m.Denom) != 0 {
+		if err := sdk.ValidateDenom(m.Denom); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m BridgeDenom) ValidateBasic() error {
+	if err := sdk.ValidateDenom(m.Denom); err != nil {
+		return err
+	}
+
+	if !m.MinAmount.IsNil() && m.MinAmount.IsNegative() {
+		return errors.New("min amount cannot be negative")
+	}
+
+	if !m.MaxAmount.IsNil() && m.MaxAmount.IsNegative() {
+		return errors.New("max amount cannot be negative")
+	}
+
+	if !m.Fee.IsNil() && m.Fee.IsNegative() {
+		return errors.New("fee cannot be negative")
+	}
+
+	for _, limit := range []sdk.Int{m.WindowCap, m.AddressCap, m.BlockOutflowThreshold} {
+		if err := validateTransferLimit(limit); err != nil {
+			return err
+		}
+	}
+
+	if IsTransferLimitSet(m.MaxAmount) && !m.MinAmount.IsNil() && m.MaxAmount.LT(m.MinAmount) {
+		return errors.New("max amount must be greater than or equal to min amount")
+	}
+
+	if m.FeeRate.Numerator != 0 || m.FeeRate.Denominator != 0 {
+		if m.FeeRate.Denominator == 0 || m.FeeRate.Numerator >= m.FeeRate.Denominator {
+			return errors.New("fee rate must be less than 1")
+		}
+
+		if !m.Fee.IsNil() && m.Fee.IsPositive() {
+			return errors.New("fixed fee and fee rate cannot be set at the same time")
+		}
+	}
+
+	return nil
+}
+
+// ValidateTransferWindow checks that the window caps of the denom are not set without the transfer window period.
+func (m BridgeDenom) ValidateTransferWindow(period uint64) error {
+	if period == 0 && (IsTransferLimitSet(m.WindowCap) || IsTransferLimitSet(m.AddressCap)) {
+		return errors.New("window cap cannot be set if the transfer window period is 0")
+	}
+
+	return nil
+}
+
+// CalcFee returns the fee of a transfer request of the amount. The fee rate is rounded down.
+func (m BridgeDenom) CalcFee(amount sdk.Int) sdk.Int {
+	if !m.Fee.IsNil() && m.Fee.IsPositive() {
+		return m.Fee
+	}
+
+	if m.FeeRate.Denominator == 0 {
+		return sdk.ZeroInt()
+	}
+
+	return amount.Mul(sdk.NewIntFromUint64(m.FeeRate.Numerator)).Quo(sdk.NewIntFromUint64(m.FeeRate.Denominator))
+}
+
+// ValidateAmount checks whether the amount of a transfer request meets the constraints of the denom.
+func (m BridgeDenom) ValidateAmount(amount sdk.Int) error {
+	if !m.MinAmount.IsNil() && amount.LT(m.MinAmount) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("amount %s is less than the minimum %s of %s", amount, m.MinAmount, m.Denom)
+	}
+
+	if IsTransferLimitSet(m.MaxAmount) && amount.GT(m.MaxAmount) {
+		return ErrExceedTransferLimit.Wrapf("amount %s exceeds the maximum %s of %s", amount, m.MaxAmount, m.Denom)
+	}
+
+	if fee := m.CalcFee(amount); !amount.GT(fee) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("amount %s must be greater than the fee %s of %s", amount, fee, m.Denom)
+	}
+
+	return nil
+}
+
+// DefaultBridgeDenom returns the registry entry of the target denom of a single-denom bridge.
+func DefaultBridgeDenom(denom string) BridgeDenom {
+	return BridgeDenom{
+		Denom:                 denom,
+		Enabled:               true,
+		MinAmount:             sdk.ZeroInt(),
+		MaxAmount:             sdk.ZeroInt(),
+		Fee:                   sdk.ZeroInt(),
+		WindowCap:             sdk.ZeroInt(),
+		AddressCap:            sdk.ZeroInt(),
+		BlockOutflowThreshold: sdk.ZeroInt(),
+	}
+}
@@ -135,7 +135,22 @@ type Params struct {
 	// default period of the proposal to update the role
 	ProposalPeriod uint64 `protobuf:"varint,5,opt,name=proposal_period,json=proposalPeriod,proto3" json:"proposal_period,omitempty"`
 	// target denom of the bridge module. This is the base denom of Finschia normally.
+	// It is used when a request does not specify the denom, and the transfer caps below apply to it
+	// in addition to the caps of its registry entry.
 	TargetDenom string `protobuf:"bytes,6,opt,name=target_denom,json=targetDenom,proto3" json:"target_denom,omitempty"`
+	// maximum amount of a single transfer request. zero means no limit.
+	TransferMaxPerTx github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,7,opt,name=transfer_max_per_tx,json=transferMaxPerTx,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"transfer_max_per_tx"`
+	// maximum total amount of transfer requests during a transfer window. zero means no limit.
+	TransferWindowCap github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,8,opt,name=transfer_window_cap,json=transferWindowCap,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"transfer_window_cap"`
+	// maximum total amount of transfer requests from a single address during a transfer window. zero means no limit.
+	TransferAddressCap github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,9,opt,name=transfer_address_cap,json=transferAddressCap,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"transfer_address_cap"`
+	// length of a transfer window (nanoseconds). zero disables the window caps.
+	TransferWindowPeriod uint64 `protobuf:"varint,10,opt,name=transfer_window_period,json=transferWindowPeriod,proto3" json:"transfer_window_period,omitempty"`
+	// total amount of transfer requests in a single block above which the bridge is halted automatically.
+	// zero disables the circuit breaker.
+	BlockOutflowThreshold github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,11,opt,name=block_outflow_threshold,json=blockOutflowThreshold,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"block_outflow_threshold"`
+	// name of the module account which receives the transfer fees. empty means the foundation treasury.
+	FeeRecipient string `protobuf:"bytes,12,opt,name=fee_recipient,json=feeRecipient,proto3" json:"fee_recipient,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -213,6 +228,20 @@ func (m *Params) GetTargetDenom() string {
 	return ""
 }
 
+func (m *Params) GetTransferWindowPeriod() uint64 {
+	if m != nil {
+		return m.TransferWindowPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetFeeRecipient() string {
+	if m != nil {
+		return m.FeeRecipient
+	}
+	return ""
+}
+
 // Provision is a struct that represents a provision internally.
 type ProvisionData struct {
 	// the sequence number of the bridge request
@@ -223,6 +252,8 @@ type ProvisionData struct {
 	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
 	// the recipient address on the destination chain
 	Receiver string `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// the denom of the token to be claimed. empty means the target denom.
+	Denom string `protobuf:"bytes,5,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *ProvisionData) Reset()         { *m = ProvisionData{} }
@@ -279,6 +310,13 @@ func (m *ProvisionData) GetReceiver() string {
 	return ""
 }
 
+func (m *ProvisionData) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 // ProvisionStatus is a struct that represents the status of a provision.
 // To optimize computational cost, we have collected frequently changing values from provision.
 type ProvisionStatus struct {
@@ -288,6 +326,8 @@ type ProvisionStatus struct {
 	ConfirmCounts int32 `protobuf:"varint,2,opt,name=confirm_counts,json=confirmCounts,proto3" json:"confirm_counts,omitempty"`
 	// whether the provision has been claimed
 	IsClaimed bool `protobuf:"varint,3,opt,name=is_claimed,json=isClaimed,proto3" json:"is_claimed,omitempty"`
+	// the unix timestamp the provision has been confirmed by n-of-m operators
+	ConfirmedAt uint64 `protobuf:"varint,4,opt,name=confirmed_at,json=confirmedAt,proto3" json:"confirmed_at,omitempty"`
 }
 
 func (m *ProvisionStatus) Reset()         { *m = ProvisionStatus{} }
@@ -344,6 +384,148 @@ func (m *ProvisionStatus) GetIsClaimed() bool {
 	return false
 }
 
+func (m *ProvisionStatus) GetConfirmedAt() uint64 {
+	if m != nil {
+		return m.ConfirmedAt
+	}
+	return 0
+}
+
+// BridgeDenom defines a denom which can be bridged and its constraints.
+type BridgeDenom struct {
+	// the bank denom
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// whether transfer requests of the denom are accepted
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// minimum amount of a single transfer request
+	MinAmount github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,3,opt,name=min_amount,json=minAmount,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"min_amount"`
+	// maximum amount of a single transfer request. zero means no limit.
+	MaxAmount github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,4,opt,name=max_amount,json=maxAmount,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"max_amount"`
+	// fixed fee deducted from each transfer request and sent to the fee recipient
+	Fee github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,5,opt,name=fee,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"fee"`
+	// ratio of each transfer request deducted as the fee. It cannot be used together with the fixed fee.
+	FeeRate Fraction `protobuf:"bytes,6,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate"`
+	// maximum total amount of transfer requests of the denom during a transfer window. zero means no limit.
+	WindowCap github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,7,opt,name=window_cap,json=windowCap,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"window_cap"`
+	// maximum total amount of transfer requests of the denom from a single address during a transfer window.
+	// zero means no limit.
+	AddressCap github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,8,opt,name=address_cap,json=addressCap,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"address_cap"`
+	// total amount of transfer requests of the denom in a single block above which the bridge is halted automatically.
+	// zero means no limit.
+	BlockOutflowThreshold github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,9,opt,name=block_outflow_threshold,json=blockOutflowThreshold,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"block_outflow_threshold"`
+}
+
+func (m *BridgeDenom) Reset()         { *m = BridgeDenom{} }
+func (m *BridgeDenom) String() string { return proto.CompactTextString(m) }
+func (*BridgeDenom) ProtoMessage()    {}
+func (*BridgeDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62374d75fc6aa1ba, []int{3}
+}
+func (m *BridgeDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgeDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeDenom.Merge(m, src)
+}
+func (m *BridgeDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeDenom proto.InternalMessageInfo
+
+func (m *BridgeDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *BridgeDenom) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *BridgeDenom) GetFeeRate() Fraction {
+	if m != nil {
+		return m.FeeRate
+	}
+	return Fraction{}
+}
+
+// TransferWindow is the period in which the outflow of the bridge is accumulated to enforce the transfer caps.
+type TransferWindow struct {
+	// the time the window has started
+	Start time.Time `protobuf:"bytes,1,opt,name=start,proto3,stdtime" json:"start"`
+	// the total amount of transfer requests during the window
+	Outflow github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,2,opt,name=outflow,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"outflow"`
+	// the denom of the transfer requests
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *TransferWindow) Reset()         { *m = TransferWindow{} }
+func (m *TransferWindow) String() string { return proto.CompactTextString(m) }
+func (*TransferWindow) ProtoMessage()    {}
+func (*TransferWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62374d75fc6aa1ba, []int{4}
+}
+func (m *TransferWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferWindow.Merge(m, src)
+}
+func (m *TransferWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferWindow proto.InternalMessageInfo
+
+func (m *TransferWindow) GetStart() time.Time {
+	if m != nil {
+		return m.Start
+	}
+	return time.Time{}
+}
+
+func (m *TransferWindow) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 // Fraction defines the protobuf message type for tmmath.Fraction that only
 // supports positive values.
 type Fraction struct {
@@ -355,7 +537,7 @@ func (m *Fraction) Reset()         { *m = Fraction{} }
 func (m *Fraction) String() string { return proto.CompactTextString(m) }
 func (*Fraction) ProtoMessage()    {}
 func (*Fraction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62374d75fc6aa1ba, []int{3}
+	return fileDescriptor_62374d75fc6aa1ba, []int{5}
 }
 func (m *Fraction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -407,7 +589,7 @@ func (m *RolePair) Reset()         { *m = RolePair{} }
 func (m *RolePair) String() string { return proto.CompactTextString(m) }
 func (*RolePair) ProtoMessage()    {}
 func (*RolePair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62374d75fc6aa1ba, []int{4}
+	return fileDescriptor_62374d75fc6aa1ba, []int{6}
 }
 func (m *RolePair) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -470,7 +652,7 @@ func (m *RoleProposal) Reset()         { *m = RoleProposal{} }
 func (m *RoleProposal) String() string { return proto.CompactTextString(m) }
 func (*RoleProposal) ProtoMessage()    {}
 func (*RoleProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62374d75fc6aa1ba, []int{5}
+	return fileDescriptor_62374d75fc6aa1ba, []int{7}
 }
 func (m *RoleProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -545,7 +727,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62374d75fc6aa1ba, []int{6}
+	return fileDescriptor_62374d75fc6aa1ba, []int{8}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -609,7 +791,7 @@ func (m *RoleMetadata) Reset()         { *m = RoleMetadata{} }
 func (m *RoleMetadata) String() string { return proto.CompactTextString(m) }
 func (*RoleMetadata) ProtoMessage()    {}
 func (*RoleMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62374d75fc6aa1ba, []int{7}
+	return fileDescriptor_62374d75fc6aa1ba, []int{9}
 }
 func (m *RoleMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -671,7 +853,7 @@ func (m *BridgeStatusMetadata) Reset()         { *m = BridgeStatusMetadata{} }
 func (m *BridgeStatusMetadata) String() string { return proto.CompactTextString(m) }
 func (*BridgeStatusMetadata) ProtoMessage()    {}
 func (*BridgeStatusMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62374d75fc6aa1ba, []int{8}
+	return fileDescriptor_62374d75fc6aa1ba, []int{10}
 }
 func (m *BridgeStatusMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -721,6 +903,8 @@ func init() {
 	proto.RegisterType((*Params)(nil), "lbm.fbridge.v1.Params")
 	proto.RegisterType((*ProvisionData)(nil), "lbm.fbridge.v1.ProvisionData")
 	proto.RegisterType((*ProvisionStatus)(nil), "lbm.fbridge.v1.ProvisionStatus")
+	proto.RegisterType((*BridgeDenom)(nil), "lbm.fbridge.v1.BridgeDenom")
+	proto.RegisterType((*TransferWindow)(nil), "lbm.fbridge.v1.TransferWindow")
 	proto.RegisterType((*Fraction)(nil), "lbm.fbridge.v1.Fraction")
 	proto.RegisterType((*RolePair)(nil), "lbm.fbridge.v1.RolePair")
 	proto.RegisterType((*RoleProposal)(nil), "lbm.fbridge.v1.RoleProposal")
@@ -732,69 +916,90 @@ func init() {
 func init() { proto.RegisterFile("lbm/fbridge/v1/fbridge.proto", fileDescriptor_62374d75fc6aa1ba) }
 
 var fileDescriptor_62374d75fc6aa1ba = []byte{
-	// 990 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x4f, 0x8f, 0x22, 0x45,
-	0x14, 0xa7, 0xd9, 0x1e, 0x84, 0xc7, 0x0c, 0x83, 0x15, 0xb2, 0x62, 0x67, 0x05, 0x24, 0x31, 0x4e,
-	0x26, 0xda, 0xb8, 0x78, 0xf3, 0x06, 0x03, 0x33, 0x81, 0x28, 0x90, 0x1a, 0x66, 0x92, 0x35, 0x26,
-	0xa4, 0xa1, 0x6b, 0xd8, 0x72, 0xe9, 0x2e, 0xac, 0x2e, 0x70, 0xd7, 0x0f, 0x60, 0xcc, 0x9c, 0xf6,
-	0x0b, 0x90, 0xac, 0xf1, 0x93, 0x78, 0xdb, 0xe3, 0x1e, 0x8d, 0x87, 0xd5, 0xcc, 0x5c, 0xbc, 0xf8,
-	0x1d, 0x4c, 0x55, 0x75, 0xf5, 0xc0, 0xc6, 0x64, 0xf7, 0x56, 0xef, 0xd5, 0xef, 0xfd, 0xde, 0xef,
-	0xfd, 0xa9, 0x6e, 0x78, 0xb0, 0x98, 0x06, 0x8d, 0xab, 0x29, 0xa7, 0xfe, 0x9c, 0x34, 0xd6, 0x0f,
-	0xcd, 0xd1, 0x5d, 0x72, 0x26, 0x18, 0x2a, 0x2c, 0xa6, 0x81, 0x6b, 0x5c, 0xeb, 0x87, 0x4e, 0x75,
-	0xce, 0xd8, 0x7c, 0x41, 0x1a, 0xea, 0x76, 0xba, 0xba, 0x6a, 0x08, 0x1a, 0x90, 0x48, 0x78, 0xc1,
-	0x52, 0x07, 0x38, 0xa5, 0x39, 0x9b, 0x33, 0x75, 0x6c, 0xc8, 0x93, 0xf6, 0xd6, 0xff, 0x4d, 0x43,
-	0x66, 0xe4, 0x71, 0x2f, 0x88, 0xd0, 0x08, 0x4a, 0x6c, 0x49, 0xb8, 0x27, 0x18, 0x9f, 0x08, 0xbe,
-	0x8a, 0xc4, 0x64, 0x41, 0xd6, 0x64, 0x51, 0xb6, 0x6a, 0xd6, 0x51, 0xbe, 0x59, 0x76, 0x77, 0x13,
-	0xba, 0xa7, 0xdc, 0x9b, 0x09, 0xca, 0xc2, 0xb6, 0xfd, 0xf2, 0x75, 0x35, 0x85, 0x91, 0x89, 0x1d,
-	0xcb, 0xd0, 0xaf, 0x65, 0xa4, 0x64, 0x9c, 0xaf, 0x3c, 0xee, 0x53, 0x2f, 0xdc, 0x61, 0x4c, 0xbf,
-	0x1b, 0xa3, 0x89, 0xdd, 0x62, 0xec, 0xc3, 0xfb, 0xdf, 0xaf, 0xfc, 0x39, 0xd9, 0xa1, 0xbb, 0xf7,
-	0x4e, 0x74, 0x87, 0x2a, 0x70, 0x8b, 0xeb, 0x53, 0x38, 0x94, 0x3d, 0x5a, 0xb0, 0xd9, 0x93, 0xc9,
-	0x92, 0x70, 0xca, 0xfc, 0xb2, 0x5d, 0xb3, 0x8e, 0x6c, 0x5c, 0x30, 0xee, 0x91, 0xf2, 0x4a, 0xe0,
-	0x92, 0xb3, 0x25, 0x8b, 0xbc, 0x85, 0x01, 0xee, 0x69, 0xa0, 0x71, 0xc7, 0xc0, 0x8f, 0x61, 0x5f,
-	0x78, 0x7c, 0x4e, 0xc4, 0xc4, 0x27, 0x21, 0x0b, 0xca, 0x99, 0x9a, 0x75, 0x94, 0xc3, 0x79, 0xed,
-	0xeb, 0x48, 0x57, 0xfd, 0x57, 0x0b, 0x0e, 0x46, 0x9c, 0xad, 0x69, 0x44, 0x59, 0xd8, 0xf1, 0x84,
-	0x87, 0x8a, 0x70, 0x2f, 0x22, 0x3f, 0xa8, 0x2e, 0xdb, 0x58, 0x1e, 0x51, 0x1f, 0x32, 0x5e, 0xc0,
-	0x56, 0xa1, 0x50, 0x8d, 0xca, 0xb5, 0x9b, 0x52, 0xff, 0x9f, 0xaf, 0xab, 0xc7, 0x73, 0x2a, 0x1e,
-	0xaf, 0xa6, 0xee, 0x8c, 0x05, 0x8d, 0x53, 0x1a, 0x46, 0xb3, 0xc7, 0xd4, 0x6b, 0x5c, 0xc5, 0x87,
-	0xcf, 0x23, 0xff, 0x49, 0x43, 0x3c, 0x5b, 0x92, 0xc8, 0xed, 0x85, 0x02, 0xc7, 0x0c, 0xe8, 0x3e,
-	0x64, 0x22, 0x12, 0xfa, 0x84, 0xab, 0x2e, 0xe5, 0x70, 0x6c, 0x21, 0x07, 0xb2, 0x9c, 0xcc, 0x08,
-	0x5d, 0x13, 0xae, 0xaa, 0xce, 0xe1, 0xc4, 0xae, 0xff, 0x04, 0x87, 0x89, 0xc4, 0x73, 0xe1, 0x89,
-	0x55, 0xa4, 0x2a, 0x33, 0xbd, 0x22, 0xa1, 0x1f, 0xab, 0xcd, 0x1b, 0x5f, 0x37, 0xf4, 0xd1, 0x27,
-	0x50, 0x98, 0xb1, 0xf0, 0x8a, 0xf2, 0x60, 0x32, 0x93, 0xa9, 0x23, 0xa5, 0x7e, 0x0f, 0x1f, 0xc4,
-	0xde, 0x13, 0xe5, 0x44, 0x1f, 0x01, 0xd0, 0x68, 0x32, 0x5b, 0x78, 0x34, 0x20, 0xbe, 0x12, 0x95,
-	0xc5, 0x39, 0x1a, 0x9d, 0x68, 0x47, 0xbd, 0x0f, 0x59, 0x33, 0x37, 0xf4, 0x00, 0x72, 0xe1, 0x2a,
-	0xd0, 0x5b, 0x15, 0x67, 0xbc, 0x73, 0xa0, 0x1a, 0xe4, 0x55, 0x97, 0x69, 0xa8, 0xee, 0xd3, 0x5a,
-	0xd1, 0x96, 0xab, 0x3e, 0x80, 0x2c, 0x66, 0x0b, 0x32, 0xf2, 0x28, 0x47, 0x65, 0x78, 0xcf, 0xf3,
-	0x7d, 0x4e, 0xa2, 0x48, 0x31, 0xe5, 0xb0, 0x31, 0xd1, 0x11, 0xd8, 0x9c, 0x2d, 0x88, 0x22, 0x28,
-	0x34, 0x4b, 0x6f, 0x6e, 0x91, 0x64, 0xc0, 0x0a, 0x51, 0xff, 0xdd, 0x82, 0x7d, 0x45, 0x18, 0x4f,
-	0x1d, 0x15, 0x20, 0x4d, 0x4d, 0x2f, 0xd2, 0xd4, 0x97, 0x4d, 0xd5, 0x1b, 0x41, 0xb4, 0x9e, 0x1c,
-	0x4e, 0x6c, 0x39, 0x08, 0xbd, 0x07, 0x66, 0x10, 0xda, 0x4a, 0xd2, 0xdb, 0x6f, 0x4b, 0x8f, 0x4e,
-	0x00, 0xc8, 0xd3, 0x25, 0xe5, 0xc4, 0x9f, 0x78, 0x42, 0x6d, 0x60, 0xbe, 0xe9, 0xb8, 0xfa, 0xd9,
-	0xbb, 0xe6, 0xd9, 0xbb, 0x63, 0xf3, 0xec, 0xdb, 0x59, 0xb9, 0x36, 0xcf, 0xff, 0xaa, 0x5a, 0x38,
-	0x17, 0xc7, 0xb5, 0x44, 0xfd, 0x47, 0xb0, 0x2f, 0x99, 0x20, 0xa8, 0x0a, 0xf9, 0x64, 0xa7, 0x93,
-	0x1a, 0xc0, 0xb8, 0x7a, 0x3e, 0x2a, 0xc1, 0xde, 0x9a, 0x89, 0xa4, 0x10, 0x6d, 0xa0, 0x26, 0x64,
-	0xd8, 0x52, 0x0e, 0x47, 0x55, 0x51, 0x68, 0x3a, 0x6f, 0xea, 0x95, 0xe4, 0x43, 0x85, 0xc0, 0x31,
-	0xf2, 0x2b, 0xfb, 0x9f, 0x17, 0xd5, 0x54, 0xfd, 0x3b, 0xdd, 0xbb, 0x6f, 0x88, 0xf0, 0x7c, 0xb9,
-	0xf6, 0x0e, 0x64, 0xcd, 0xfb, 0x8e, 0xb3, 0x27, 0xb6, 0xbc, 0x33, 0x5f, 0x93, 0x78, 0xae, 0x89,
-	0x2d, 0x75, 0xa9, 0x87, 0xac, 0x04, 0xd8, 0x58, 0x1b, 0xf5, 0x3e, 0x94, 0xda, 0x4a, 0x83, 0xde,
-	0xd7, 0xed, 0x2c, 0x34, 0x94, 0xeb, 0xb4, 0x26, 0x26, 0x8b, 0xb1, 0xe5, 0x44, 0xe2, 0x1b, 0x9d,
-	0x23, 0xb6, 0x8e, 0x7f, 0xb6, 0xc0, 0x96, 0x52, 0x51, 0x05, 0xf2, 0x17, 0x83, 0xf3, 0x51, 0xf7,
-	0xa4, 0x77, 0xda, 0xeb, 0x76, 0x8a, 0x29, 0xe7, 0xe0, 0x7a, 0x53, 0xcb, 0xc9, 0xab, 0x6e, 0xb0,
-	0x14, 0xcf, 0x50, 0x05, 0xb2, 0x67, 0x17, 0x2d, 0xdc, 0xe9, 0xb5, 0x06, 0x45, 0xcb, 0x29, 0x5e,
-	0x6f, 0x6a, 0xaa, 0xc4, 0x33, 0x53, 0x46, 0x05, 0xb2, 0xc3, 0x51, 0x17, 0xb7, 0xc6, 0x43, 0x5c,
-	0x4c, 0xdf, 0xdd, 0x0f, 0x4d, 0x29, 0x65, 0xd8, 0xeb, 0x5f, 0x74, 0xce, 0xba, 0xc5, 0x7b, 0x77,
-	0xcc, 0x7d, 0x59, 0x8e, 0x63, 0xff, 0xf2, 0x5b, 0x25, 0x25, 0x85, 0xc0, 0x5d, 0x3f, 0xd1, 0x67,
-	0xf0, 0xc1, 0xe5, 0x70, 0xdc, 0x9d, 0x0c, 0x47, 0xe3, 0xde, 0x70, 0x30, 0xd9, 0x95, 0x76, 0x78,
-	0xbd, 0xa9, 0xe5, 0x35, 0x50, 0x8b, 0xab, 0xc3, 0xe1, 0x36, 0xfa, 0x51, 0xf7, 0xbc, 0x68, 0xe9,
-	0x34, 0x1a, 0xf5, 0x88, 0x44, 0xa8, 0x06, 0x85, 0x6d, 0xcc, 0x60, 0x58, 0x4c, 0x3b, 0xfb, 0xd7,
-	0x9b, 0x5a, 0x56, 0x43, 0x06, 0x2c, 0x16, 0xf2, 0xc2, 0x82, 0xfd, 0xed, 0xf6, 0x22, 0x17, 0x3e,
-	0x6c, 0xe3, 0x5e, 0xe7, 0xac, 0x3b, 0x39, 0x1f, 0xb7, 0xc6, 0x17, 0xe7, 0xff, 0x27, 0x46, 0x43,
-	0xb5, 0x98, 0x63, 0x28, 0xed, 0xe2, 0x5b, 0x27, 0xe3, 0xde, 0x65, 0xd7, 0x74, 0x4d, 0x43, 0x5b,
-	0x7a, 0x2c, 0x2e, 0xdc, 0xdf, 0xc5, 0xf6, 0x06, 0x31, 0x3a, 0xed, 0xa0, 0xeb, 0x4d, 0xad, 0xa0,
-	0xd1, 0xbd, 0x78, 0x8c, 0x5a, 0x62, 0xbb, 0xff, 0xf2, 0xa6, 0x62, 0xbd, 0xba, 0xa9, 0x58, 0x7f,
-	0xdf, 0x54, 0xac, 0xe7, 0xb7, 0x95, 0xd4, 0xab, 0xdb, 0x4a, 0xea, 0x8f, 0xdb, 0x4a, 0xea, 0xdb,
-	0x2f, 0xde, 0xfa, 0xd5, 0x7c, 0x9a, 0xfc, 0x65, 0xd5, 0xf7, 0x73, 0x9a, 0x51, 0x8f, 0xe9, 0xcb,
-	0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xfb, 0x54, 0x04, 0x35, 0x81, 0x07, 0x00, 0x00,
+	// 1316 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x8e, 0x1a, 0xc7,
+	0x13, 0x66, 0x58, 0x60, 0xa1, 0xd8, 0x65, 0x71, 0xff, 0xf8, 0xd9, 0x04, 0x39, 0x2c, 0x21, 0x8a,
+	0xb2, 0xb2, 0x12, 0x88, 0x37, 0xb9, 0xc4, 0x37, 0x76, 0xc1, 0x2b, 0x90, 0x0d, 0x64, 0x96, 0x75,
+	0xe4, 0x28, 0xd2, 0xa8, 0x61, 0x1a, 0x76, 0x6c, 0x66, 0x7a, 0x32, 0xd3, 0xb0, 0xf8, 0x05, 0xa2,
+	0x68, 0x4f, 0x7e, 0x81, 0x95, 0x2c, 0xe5, 0x05, 0x72, 0xcb, 0x39, 0x37, 0x1f, 0x7d, 0x8c, 0x72,
+	0x70, 0x12, 0xfb, 0x92, 0x47, 0xc8, 0x31, 0xea, 0x7f, 0xb3, 0x60, 0x45, 0xb1, 0x83, 0x73, 0x9b,
+	0xaa, 0xfe, 0xfa, 0xab, 0xaf, 0xba, 0xba, 0xaa, 0x01, 0xae, 0x4f, 0x87, 0x6e, 0x7d, 0x3c, 0x0c,
+	0x1c, 0x7b, 0x42, 0xea, 0xf3, 0x9b, 0xfa, 0xb3, 0xe6, 0x07, 0x94, 0x51, 0x94, 0x9b, 0x0e, 0xdd,
+	0x9a, 0x76, 0xcd, 0x6f, 0x96, 0x76, 0x27, 0x94, 0x4e, 0xa6, 0xa4, 0x2e, 0x56, 0x87, 0xb3, 0x71,
+	0x9d, 0x39, 0x2e, 0x09, 0x19, 0x76, 0x7d, 0xb9, 0xa1, 0x54, 0x98, 0xd0, 0x09, 0x15, 0x9f, 0x75,
+	0xfe, 0x25, 0xbd, 0xd5, 0xdf, 0x53, 0x90, 0xea, 0xe3, 0x00, 0xbb, 0x21, 0xea, 0x43, 0x81, 0xfa,
+	0x24, 0xc0, 0x8c, 0x06, 0x16, 0x0b, 0x66, 0x21, 0xb3, 0xa6, 0x64, 0x4e, 0xa6, 0x45, 0xa3, 0x62,
+	0xec, 0x65, 0xf7, 0x8b, 0xb5, 0xd5, 0x80, 0xb5, 0xdb, 0x01, 0x1e, 0x31, 0x87, 0x7a, 0x07, 0x89,
+	0xa7, 0xcf, 0x77, 0x63, 0x26, 0xd2, 0x7b, 0x07, 0x7c, 0xeb, 0x1d, 0xbe, 0x93, 0x33, 0x4e, 0x66,
+	0x38, 0xb0, 0x1d, 0xec, 0xad, 0x30, 0xc6, 0xdf, 0x8c, 0x51, 0xef, 0x5d, 0x62, 0xec, 0xc0, 0x95,
+	0x07, 0x33, 0x7b, 0x42, 0x56, 0xe8, 0x36, 0xde, 0x88, 0x6e, 0x47, 0x6c, 0x5c, 0xe2, 0xfa, 0x10,
+	0x76, 0xf8, 0x19, 0x4d, 0xe9, 0xe8, 0xa1, 0xe5, 0x93, 0xc0, 0xa1, 0x76, 0x31, 0x51, 0x31, 0xf6,
+	0x12, 0x66, 0x4e, 0xbb, 0xfb, 0xc2, 0xcb, 0x81, 0x7e, 0x40, 0x7d, 0x1a, 0xe2, 0xa9, 0x06, 0x26,
+	0x25, 0x50, 0xbb, 0x15, 0xf0, 0x3d, 0xd8, 0x62, 0x38, 0x98, 0x10, 0x66, 0xd9, 0xc4, 0xa3, 0x6e,
+	0x31, 0x55, 0x31, 0xf6, 0x32, 0x66, 0x56, 0xfa, 0x9a, 0xdc, 0x85, 0x30, 0xfc, 0x8f, 0x05, 0xd8,
+	0x0b, 0xc7, 0x24, 0xb0, 0x5c, 0xbc, 0xe0, 0x7c, 0x16, 0x5b, 0x14, 0x37, 0x39, 0xf2, 0x60, 0x9f,
+	0x0b, 0xfd, 0xe5, 0xf9, 0xee, 0x8d, 0x89, 0xc3, 0x4e, 0x67, 0xc3, 0xda, 0x88, 0xba, 0xf5, 0xdb,
+	0x8e, 0x17, 0x8e, 0x4e, 0x1d, 0x5c, 0x1f, 0xab, 0x8f, 0x8f, 0x43, 0xfb, 0x61, 0x9d, 0x3d, 0xf2,
+	0x49, 0x58, 0x6b, 0x7b, 0xcc, 0xcc, 0x6b, 0xba, 0xbb, 0x78, 0xd1, 0x27, 0xc1, 0x60, 0x81, 0x86,
+	0x4b, 0x21, 0xce, 0x1c, 0xcf, 0xa6, 0x67, 0xd6, 0x08, 0xfb, 0xc5, 0xf4, 0xda, 0x21, 0xae, 0x68,
+	0xba, 0x2f, 0x05, 0xdb, 0x21, 0xf6, 0x91, 0x0d, 0x85, 0x28, 0x06, 0xb6, 0xed, 0x80, 0x84, 0xa1,
+	0x08, 0x92, 0x59, 0x3b, 0x08, 0xd2, 0x7c, 0x0d, 0x49, 0xc7, 0xa3, 0x7c, 0x06, 0x57, 0x5f, 0xcd,
+	0x44, 0x9d, 0x3f, 0x88, 0xf3, 0x2f, 0xac, 0x0a, 0x53, 0x55, 0x78, 0x00, 0xd7, 0x86, 0xa2, 0xa8,
+	0x74, 0xc6, 0xc6, 0x53, 0x7a, 0x66, 0xb1, 0xd3, 0x80, 0x84, 0xa7, 0x74, 0x6a, 0x17, 0xb3, 0x6b,
+	0xcb, 0xfb, 0xbf, 0xa0, 0xec, 0x49, 0xc6, 0x81, 0x26, 0x44, 0xef, 0xc3, 0xf6, 0x98, 0x10, 0x2b,
+	0x20, 0x23, 0xc7, 0x77, 0x88, 0xc7, 0x8a, 0x5b, 0xa2, 0xe4, 0x5b, 0x63, 0x42, 0x4c, 0xed, 0xab,
+	0xfe, 0x68, 0xc0, 0x76, 0x3f, 0xa0, 0x73, 0x27, 0x74, 0xa8, 0xd7, 0xc4, 0x0c, 0xa3, 0x3c, 0x6c,
+	0x84, 0xe4, 0x1b, 0xd1, 0x59, 0x09, 0x93, 0x7f, 0xa2, 0x0e, 0xa4, 0xb0, 0x4b, 0x67, 0x1e, 0x13,
+	0xcd, 0xb1, 0x9e, 0x46, 0xc5, 0x80, 0xae, 0x42, 0x2a, 0x24, 0x9e, 0x4d, 0x02, 0xd1, 0x19, 0x19,
+	0x53, 0x59, 0xa8, 0x04, 0xe9, 0x80, 0x8c, 0x88, 0x33, 0x27, 0x81, 0xb8, 0xe9, 0x19, 0x33, 0xb2,
+	0x51, 0x01, 0x92, 0xf2, 0xce, 0x26, 0xc5, 0x82, 0x34, 0xaa, 0x17, 0x06, 0xec, 0x44, 0xca, 0x8f,
+	0x19, 0x66, 0xb3, 0x50, 0x5c, 0x72, 0xdd, 0x36, 0xc4, 0xb3, 0x55, 0x12, 0x59, 0xed, 0x6b, 0x79,
+	0x36, 0xfa, 0x00, 0x72, 0x23, 0xea, 0x8d, 0x9d, 0xc0, 0xb5, 0x46, 0x5c, 0x51, 0x28, 0x92, 0x4a,
+	0x9a, 0xdb, 0xca, 0x7b, 0x28, 0x9c, 0xe8, 0x5d, 0x00, 0x27, 0xb4, 0x46, 0x53, 0xec, 0xb8, 0xc4,
+	0x16, 0x5a, 0xd3, 0x66, 0xc6, 0x09, 0x0f, 0xa5, 0x83, 0x07, 0x52, 0x78, 0x62, 0x5b, 0x98, 0xa9,
+	0xe6, 0xcc, 0x46, 0xbe, 0x06, 0xab, 0xfe, 0x99, 0x80, 0xec, 0x81, 0x68, 0x78, 0xd9, 0x5d, 0x51,
+	0x16, 0xc6, 0x52, 0x16, 0xa8, 0x08, 0x9b, 0xc4, 0xc3, 0xc3, 0x29, 0xb1, 0x85, 0x8e, 0xb4, 0xa9,
+	0x4d, 0xf4, 0x05, 0x80, 0xeb, 0x78, 0x96, 0x3a, 0xf9, 0x8d, 0xb5, 0x4f, 0x3e, 0xe3, 0x3a, 0x5e,
+	0x43, 0x1e, 0x3e, 0xa7, 0xc4, 0x0b, 0x4d, 0x99, 0x78, 0x0b, 0x4a, 0xbc, 0x50, 0x94, 0x4d, 0xd8,
+	0x18, 0x13, 0x22, 0x2b, 0xb3, 0x16, 0x17, 0xdf, 0x8e, 0x3e, 0x87, 0xb4, 0xb8, 0xaa, 0x98, 0x11,
+	0x31, 0x98, 0x5e, 0x3f, 0x31, 0x37, 0xf9, 0x2d, 0xc6, 0x8c, 0xf0, 0x9c, 0x96, 0x06, 0xc9, 0xfa,
+	0xb3, 0x2a, 0x73, 0x16, 0x0d, 0x90, 0x63, 0xc8, 0x2e, 0xcf, 0x8d, 0xf5, 0x87, 0x13, 0xe0, 0xcb,
+	0x79, 0xf1, 0x0f, 0x9d, 0x9f, 0xf9, 0x8f, 0x3b, 0xbf, 0xfa, 0x83, 0x01, 0xb9, 0xc1, 0xca, 0xf8,
+	0x41, 0xb7, 0x20, 0x19, 0x32, 0x1c, 0x30, 0xf5, 0x62, 0x96, 0x6a, 0xf2, 0x49, 0xae, 0xe9, 0x27,
+	0xb9, 0x36, 0xd0, 0x4f, 0xf2, 0x41, 0x9a, 0x0b, 0x79, 0xfc, 0xeb, 0xae, 0x61, 0xca, 0x2d, 0xe8,
+	0x0e, 0x6c, 0x2a, 0xd1, 0x6f, 0x31, 0x00, 0x34, 0xc5, 0x65, 0x1f, 0x6c, 0x2c, 0x77, 0x73, 0x07,
+	0xd2, 0xba, 0xc2, 0xe8, 0x3a, 0x64, 0xbc, 0x99, 0x2b, 0x5f, 0x6c, 0xd5, 0xc2, 0x97, 0x0e, 0x54,
+	0x81, 0xac, 0xd8, 0xe2, 0x78, 0x62, 0x3d, 0x2e, 0x3b, 0x6f, 0xc9, 0x55, 0xed, 0x42, 0xda, 0xa4,
+	0x53, 0xd2, 0xc7, 0x4e, 0xc0, 0xfb, 0x4b, 0x15, 0x41, 0xf5, 0x9d, 0x36, 0xd1, 0x1e, 0x24, 0x02,
+	0x3a, 0x25, 0x82, 0x20, 0xb7, 0x5f, 0x78, 0xf5, 0xbe, 0x71, 0x06, 0x53, 0x20, 0xaa, 0x3f, 0x19,
+	0xb0, 0x25, 0x08, 0xd5, 0x8b, 0x8a, 0x72, 0x10, 0x77, 0xf4, 0x70, 0x89, 0x3b, 0x36, 0x1f, 0x5e,
+	0xf2, 0xb5, 0x25, 0x52, 0x4f, 0xc6, 0x8c, 0x6c, 0x3e, 0xf0, 0xe4, 0x1b, 0xab, 0x07, 0x9e, 0xb4,
+	0xa2, 0xf0, 0x89, 0xd7, 0x85, 0x47, 0x87, 0x00, 0x64, 0xe1, 0x3b, 0x81, 0x9c, 0x34, 0xc9, 0x7f,
+	0x51, 0xbf, 0x8c, 0xda, 0xd7, 0x60, 0xd5, 0x33, 0x48, 0xdc, 0xa3, 0x8c, 0xa0, 0x5d, 0xc8, 0x46,
+	0xbf, 0x17, 0xa2, 0x1c, 0x40, 0xbb, 0xda, 0x36, 0x2f, 0xcf, 0x9c, 0xb2, 0x28, 0x11, 0x69, 0xa0,
+	0x7d, 0x48, 0x51, 0x9f, 0x17, 0x47, 0x64, 0x91, 0xdb, 0x2f, 0xbd, 0xaa, 0x97, 0x93, 0xf7, 0x04,
+	0xc2, 0x54, 0xc8, 0x5b, 0x89, 0x3f, 0x9e, 0xec, 0xc6, 0xaa, 0x5f, 0xcb, 0xb3, 0xbb, 0x4b, 0x18,
+	0xb6, 0xf9, 0xf3, 0x52, 0x82, 0xb4, 0xfe, 0xed, 0xa4, 0xa2, 0x47, 0x36, 0x5f, 0xd3, 0xbf, 0xd4,
+	0x54, 0x5d, 0x23, 0x9b, 0xeb, 0x12, 0x3f, 0x92, 0x84, 0x80, 0x84, 0x29, 0x8d, 0x6a, 0x07, 0x0a,
+	0x72, 0xc6, 0xca, 0x07, 0x60, 0x39, 0x8a, 0xe3, 0xf1, 0xeb, 0x34, 0x27, 0x3a, 0x8a, 0xb6, 0x79,
+	0x45, 0xd4, 0x8a, 0x8c, 0xa1, 0xac, 0x1b, 0xdf, 0x1a, 0x90, 0xe0, 0x52, 0x51, 0x19, 0xb2, 0x27,
+	0xdd, 0xe3, 0x7e, 0xeb, 0xb0, 0x7d, 0xbb, 0xdd, 0x6a, 0xe6, 0x63, 0xa5, 0xed, 0xf3, 0x8b, 0x4a,
+	0x86, 0x2f, 0xb5, 0x5c, 0x9f, 0x3d, 0x42, 0x65, 0x48, 0x1f, 0x9d, 0x34, 0xcc, 0x66, 0xbb, 0xd1,
+	0xcd, 0x1b, 0xa5, 0xfc, 0xf9, 0x45, 0x45, 0xa4, 0x78, 0xa4, 0xd3, 0x28, 0x43, 0xba, 0xd7, 0x6f,
+	0x99, 0x8d, 0x41, 0xcf, 0xcc, 0xc7, 0x2f, 0xd7, 0x7b, 0x3a, 0x95, 0x22, 0x24, 0x3b, 0x27, 0xcd,
+	0xa3, 0x56, 0x7e, 0xe3, 0x92, 0xb9, 0xc3, 0xd3, 0x29, 0x25, 0xbe, 0xfb, 0xbe, 0x1c, 0xe3, 0x42,
+	0xe0, 0xf2, 0x3c, 0xd1, 0x47, 0x70, 0xed, 0x5e, 0x6f, 0xd0, 0xb2, 0x7a, 0xfd, 0x41, 0xbb, 0xd7,
+	0xb5, 0x56, 0xa5, 0xed, 0x9c, 0x5f, 0x54, 0xb2, 0x12, 0x28, 0xc5, 0x55, 0x61, 0x67, 0x19, 0x7d,
+	0xbf, 0x75, 0x9c, 0x37, 0x64, 0x18, 0x89, 0xba, 0x4f, 0x42, 0x54, 0x81, 0xdc, 0x32, 0xa6, 0xdb,
+	0xcb, 0xc7, 0x4b, 0x5b, 0xe7, 0x17, 0x95, 0xb4, 0x84, 0x74, 0xa9, 0x12, 0xf2, 0xc4, 0x80, 0xad,
+	0xe5, 0xe3, 0x45, 0x35, 0x78, 0xe7, 0xc0, 0x6c, 0x37, 0x8f, 0x5a, 0xd6, 0xf1, 0xa0, 0x31, 0x38,
+	0x39, 0xfe, 0x3b, 0x31, 0x12, 0x2a, 0xc5, 0xdc, 0x80, 0xc2, 0x2a, 0xbe, 0x71, 0x38, 0x68, 0xdf,
+	0x6b, 0xe9, 0x53, 0x93, 0xd0, 0x86, 0x2c, 0x4b, 0x0d, 0xae, 0xae, 0x62, 0xdb, 0x5d, 0x85, 0x8e,
+	0x97, 0xd0, 0xf9, 0x45, 0x25, 0x27, 0xd1, 0x6d, 0x55, 0x46, 0x29, 0xf1, 0xa0, 0xf3, 0xf4, 0x45,
+	0xd9, 0x78, 0xf6, 0xa2, 0x6c, 0xfc, 0xf6, 0xa2, 0x6c, 0x3c, 0x7e, 0x59, 0x8e, 0x3d, 0x7b, 0x59,
+	0x8e, 0xfd, 0xfc, 0xb2, 0x1c, 0xfb, 0xea, 0x93, 0xd7, 0x0e, 0xa7, 0x45, 0xf4, 0x0f, 0x46, 0x8c,
+	0xa9, 0x61, 0x4a, 0x34, 0xd3, 0xa7, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x74, 0x58, 0x1c,
+	0xdd, 0x0c, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -817,6 +1022,58 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeeRecipient) > 0 {
+		i -= len(m.FeeRecipient)
+		copy(dAtA[i:], m.FeeRecipient)
+		i = encodeVarintFbridge(dAtA, i, uint64(len(m.FeeRecipient)))
+		i--
+		dAtA[i] = 0x62
+	}
+	{
+		size := m.BlockOutflowThreshold.Size()
+		i -= size
+		if _, err := m.BlockOutflowThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFbridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.TransferWindowPeriod != 0 {
+		i = encodeVarintFbridge(dAtA, i, uint64(m.TransferWindowPeriod))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.TransferAddressCap.Size()
+		i -= size
+		if _, err := m.TransferAddressCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFbridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.TransferWindowCap.Size()
+		i -= size
+		if _, err := m.TransferWindowCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFbridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.TransferMaxPerTx.Size()
+		i -= size
+		if _, err := m.TransferMaxPerTx.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFbridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	if len(m.TargetDenom) > 0 {
 		i -= len(m.TargetDenom)
 		copy(dAtA[i:], m.TargetDenom)
@@ -887,6 +1144,13 @@ func (m *ProvisionData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFbridge(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Receiver) > 0 {
 		i -= len(m.Receiver)
 		copy(dAtA[i:], m.Receiver)
@@ -939,6 +1203,11 @@ func (m *ProvisionStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ConfirmedAt != 0 {
+		i = encodeVarintFbridge(dAtA, i, uint64(m.ConfirmedAt))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.IsClaimed {
 		i--
 		if m.IsClaimed {
@@ -962,6 +1231,164 @@ func (m *ProvisionStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BridgeDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BlockOutflowThreshold.Size()
+		i -= size
+		if _, err := m.BlockOutflowThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFbridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.AddressCap.Size()
+		i -= size
+		if _, err := m.AddressCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFbridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.WindowCap.Size()
+		i -= size
+		if _, err := m.WindowCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFbridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.FeeRate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFbridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Fee.Size()
+		i -= size
+		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFbridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxAmount.Size()
+		i -= size
+		if _, err := m.MaxAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFbridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MinAmount.Size()
+		i -= size
+		if _, err := m.MinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFbridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFbridge(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFbridge(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Outflow.Size()
+		i -= size
+		if _, err := m.Outflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFbridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Start):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintFbridge(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Fraction) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1050,12 +1477,12 @@ func (m *RoleProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExpiredAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpiredAt):])
-	if err4 != nil {
-		return 0, err4
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExpiredAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpiredAt):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n4
-	i = encodeVarintFbridge(dAtA, i, uint64(n4))
+	i -= n6
+	i = encodeVarintFbridge(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0x2a
 	if m.Role != 0 {
@@ -1229,11 +1656,26 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovFbridge(uint64(l))
 	}
-	return n
-}
-
-func (m *ProvisionData) Size() (n int) {
-	if m == nil {
+	l = m.TransferMaxPerTx.Size()
+	n += 1 + l + sovFbridge(uint64(l))
+	l = m.TransferWindowCap.Size()
+	n += 1 + l + sovFbridge(uint64(l))
+	l = m.TransferAddressCap.Size()
+	n += 1 + l + sovFbridge(uint64(l))
+	if m.TransferWindowPeriod != 0 {
+		n += 1 + sovFbridge(uint64(m.TransferWindowPeriod))
+	}
+	l = m.BlockOutflowThreshold.Size()
+	n += 1 + l + sovFbridge(uint64(l))
+	l = len(m.FeeRecipient)
+	if l > 0 {
+		n += 1 + l + sovFbridge(uint64(l))
+	}
+	return n
+}
+
+func (m *ProvisionData) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -1251,6 +1693,10 @@ func (m *ProvisionData) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovFbridge(uint64(l))
 	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFbridge(uint64(l))
+	}
 	return n
 }
 
@@ -1269,6 +1715,56 @@ func (m *ProvisionStatus) Size() (n int) {
 	if m.IsClaimed {
 		n += 2
 	}
+	if m.ConfirmedAt != 0 {
+		n += 1 + sovFbridge(uint64(m.ConfirmedAt))
+	}
+	return n
+}
+
+func (m *BridgeDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFbridge(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	l = m.MinAmount.Size()
+	n += 1 + l + sovFbridge(uint64(l))
+	l = m.MaxAmount.Size()
+	n += 1 + l + sovFbridge(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovFbridge(uint64(l))
+	l = m.FeeRate.Size()
+	n += 1 + l + sovFbridge(uint64(l))
+	l = m.WindowCap.Size()
+	n += 1 + l + sovFbridge(uint64(l))
+	l = m.AddressCap.Size()
+	n += 1 + l + sovFbridge(uint64(l))
+	l = m.BlockOutflowThreshold.Size()
+	n += 1 + l + sovFbridge(uint64(l))
+	return n
+}
+
+func (m *TransferWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Start)
+	n += 1 + l + sovFbridge(uint64(l))
+	l = m.Outflow.Size()
+	n += 1 + l + sovFbridge(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFbridge(uint64(l))
+	}
 	return n
 }
 
@@ -1584,6 +2080,193 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.TargetDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferMaxPerTx", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TransferMaxPerTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferWindowCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TransferWindowCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferAddressCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TransferAddressCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferWindowPeriod", wireType)
+			}
+			m.TransferWindowPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransferWindowPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockOutflowThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockOutflowThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFbridge(dAtA[iNdEx:])
@@ -1751,6 +2434,38 @@ func (m *ProvisionData) Unmarshal(dAtA []byte) error {
 			}
 			m.Receiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFbridge(dAtA[iNdEx:])
@@ -1859,6 +2574,513 @@ func (m *ProvisionStatus) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsClaimed = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmedAt", wireType)
+			}
+			m.ConfirmedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfirmedAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFbridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgeDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFbridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WindowCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AddressCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockOutflowThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockOutflowThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFbridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFbridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Outflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFbridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFbridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFbridge(dAtA[iNdEx:])
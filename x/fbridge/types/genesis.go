@@ -1,7 +1,9 @@
 package types
 
 import (
+	"encoding/hex"
 	"errors"
+	"fmt"
 
 	sdk "github.com/Finschia/finschia-sdk/types"
 	authtypes "github.com/Finschia/finschia-sdk/x/auth/types"
@@ -40,6 +42,10 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
+	if err := validateReceivingState(data.ReceivingState); err != nil {
+		return err
+	}
+
 	if data.NextRoleProposalId < 1 {
 		return errors.New("next role proposal ID must be positive")
 	}
@@ -79,6 +85,23 @@ func ValidateGenesis(data GenesisState) error {
 		}
 	}
 
+	chkDenom := make(map[string]struct{})
+	for _, v := range data.Denoms {
+		if err := v.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if err := v.ValidateTransferWindow(data.Params.TransferWindowPeriod); err != nil {
+			return fmt.Errorf("bridge denom %s: %w", v.Denom, err)
+		}
+
+		if _, ok := chkDenom[v.Denom]; ok {
+			return fmt.Errorf("duplicate bridge denom %s", v.Denom)
+		}
+
+		chkDenom[v.Denom] = struct{}{}
+	}
+
 	return nil
 }
 
@@ -104,5 +127,122 @@ func validateSendingState(state SendingState) error {
 		chkSeq[v.Seq] = struct{}{}
 	}
 
+	chkWindow := make(map[string]struct{})
+	for _, v := range state.TransferWindows {
+		if err := sdk.ValidateDenom(v.Denom); err != nil {
+			return err
+		}
+
+		if v.Outflow.IsNil() || v.Outflow.IsNegative() {
+			return errors.New("transfer window outflow cannot be negative")
+		}
+
+		if _, ok := chkWindow[v.Denom]; ok {
+			return errors.New("duplicate transfer window")
+		}
+
+		chkWindow[v.Denom] = struct{}{}
+	}
+
+	chkDenomSeq := make(map[uint64]struct{})
+	for _, v := range state.DenomSeqs {
+		if err := sdk.ValidateDenom(v.Denom); err != nil {
+			return err
+		}
+
+		if _, ok := chkSeq[v.Seq]; !ok {
+			return fmt.Errorf("denom of unknown sequence %d", v.Seq)
+		}
+
+		if _, ok := chkDenomSeq[v.Seq]; ok {
+			return errors.New("duplicate sequence in denom mapping")
+		}
+
+		chkDenomSeq[v.Seq] = struct{}{}
+	}
+
+	chkAddr := make(map[string]struct{})
+	for _, v := range state.AddressOutflows {
+		sdk.MustAccAddressFromBech32(v.Address)
+		if err := sdk.ValidateDenom(v.Denom); err != nil {
+			return err
+		}
+
+		if v.Outflow.IsNil() || !v.Outflow.IsPositive() {
+			return errors.New("address outflow must be positive")
+		}
+
+		key := v.Denom + "/" + v.Address
+		if _, ok := chkAddr[key]; ok {
+			return errors.New("duplicate address outflow")
+		}
+
+		chkAddr[key] = struct{}{}
+	}
+
+	return nil
+}
+
+func validateReceivingState(state ReceivingState) error {
+	for _, v := range state.GreatestSeqByOperator {
+		sdk.MustAccAddressFromBech32(v.Operator)
+	}
+
+	for _, v := range state.GreatestConsecutiveSeqByOperator {
+		sdk.MustAccAddressFromBech32(v.Operator)
+	}
+
+	for _, v := range state.Commitments {
+		sdk.MustAccAddressFromBech32(v.Operator)
+		if v.Seq == 0 {
+			return errors.New("commitment seq must be positive")
+		}
+		if _, err := hex.DecodeString(v.Commitment); err != nil {
+			return fmt.Errorf("invalid commitment: %w", err)
+		}
+	}
+
+	provisions := make(map[string]*Provision)
+	for _, v := range state.Provisions {
+		if v.Data == nil || v.Status == nil {
+			return errors.New("provision data and status must be provided")
+		}
+		if err := v.Data.ValidateBasic(); err != nil {
+			return err
+		}
+		if hex.EncodeToString(v.Data.Commitment()) != v.Commitment {
+			return fmt.Errorf("commitment mismatch for provision of seq %d", v.Data.Seq)
+		}
+		provisions[v.Commitment] = v
+	}
+
+	confirmed := make(map[uint64]struct{})
+	for _, v := range state.ConfirmedSeqToCommitment {
+		p, ok := provisions[v.Commitment]
+		if !ok {
+			return fmt.Errorf("provision of confirmed seq %d not found", v.Seq)
+		}
+		if p.Data.Seq != v.Seq {
+			return fmt.Errorf("confirmed seq %d does not match the provision", v.Seq)
+		}
+		if _, ok := confirmed[v.Seq]; ok {
+			return errors.New("duplicate confirmed sequence")
+		}
+		confirmed[v.Seq] = struct{}{}
+	}
+
+	for _, seq := range state.PendingClaimSeqs {
+		if _, ok := confirmed[seq]; !ok {
+			return fmt.Errorf("pending claim seq %d has not been confirmed", seq)
+		}
+	}
+
+	for _, v := range state.RemovalVotes {
+		sdk.MustAccAddressFromBech32(v.Judge)
+		if _, ok := confirmed[v.Seq]; !ok {
+			return fmt.Errorf("removal vote for seq %d which has not been confirmed", v.Seq)
+		}
+	}
+
 	return nil
 }
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_Finschia_finschia_sdk_types "github.com/Finschia/finschia-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -41,6 +42,8 @@ type GenesisState struct {
 	Roles []RolePair `protobuf:"bytes,7,rep,name=roles,proto3" json:"roles"`
 	// bridge_switches defines the status of whether each guardian has allowed the bridge to operate.
 	BridgeSwitches []BridgeSwitch `protobuf:"bytes,8,rep,name=bridge_switches,json=bridgeSwitches,proto3" json:"bridge_switches"`
+	// denoms defines the registry of bridgeable denoms.
+	Denoms []BridgeDenom `protobuf:"bytes,9,rep,name=denoms,proto3" json:"denoms"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -132,11 +135,24 @@ func (m *GenesisState) GetBridgeSwitches() []BridgeSwitch {
 	return nil
 }
 
+func (m *GenesisState) GetDenoms() []BridgeDenom {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
 type SendingState struct {
 	// the next sequence number of the bridge request (greatest sequence number + 1)
 	NextSeq uint64 `protobuf:"varint,1,opt,name=next_seq,json=nextSeq,proto3" json:"next_seq,omitempty"`
 	// sequence-per-block number mapping
 	SeqToBlocknum []BlockSeqInfo `protobuf:"bytes,2,rep,name=seq_to_blocknum,json=seqToBlocknum,proto3" json:"seq_to_blocknum"`
+	// the current transfer window of each denom
+	TransferWindows []TransferWindow `protobuf:"bytes,3,rep,name=transfer_windows,json=transferWindows,proto3" json:"transfer_windows"`
+	// the outflow of each address during the current transfer window
+	AddressOutflows []AddressOutflow `protobuf:"bytes,4,rep,name=address_outflows,json=addressOutflows,proto3" json:"address_outflows"`
+	// the denom of each bridge request
+	DenomSeqs []DenomSeq `protobuf:"bytes,5,rep,name=denom_seqs,json=denomSeqs,proto3" json:"denom_seqs"`
 }
 
 func (m *SendingState) Reset()         { *m = SendingState{} }
@@ -172,6 +188,116 @@ func (m *SendingState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SendingState proto.InternalMessageInfo
 
+type DenomSeq struct {
+	// the denom of the bridge request
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// the sequence number of the bridge request
+	Seq uint64 `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+}
+
+func (m *DenomSeq) Reset()         { *m = DenomSeq{} }
+func (m *DenomSeq) String() string { return proto.CompactTextString(m) }
+func (*DenomSeq) ProtoMessage()    {}
+func (*DenomSeq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fc3cc4535a29f6d, []int{2}
+}
+func (m *DenomSeq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomSeq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomSeq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomSeq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomSeq.Merge(m, src)
+}
+func (m *DenomSeq) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomSeq) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomSeq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomSeq proto.InternalMessageInfo
+
+func (m *DenomSeq) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomSeq) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+type AddressOutflow struct {
+	// the sender address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// the total amount of transfer requests from the address during the current transfer window
+	Outflow github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,2,opt,name=outflow,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"outflow"`
+	// the denom of the transfer requests
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *AddressOutflow) Reset()         { *m = AddressOutflow{} }
+func (m *AddressOutflow) String() string { return proto.CompactTextString(m) }
+func (*AddressOutflow) ProtoMessage()    {}
+func (*AddressOutflow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fc3cc4535a29f6d, []int{3}
+}
+func (m *AddressOutflow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressOutflow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddressOutflow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddressOutflow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressOutflow.Merge(m, src)
+}
+func (m *AddressOutflow) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressOutflow) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressOutflow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressOutflow proto.InternalMessageInfo
+
+func (m *AddressOutflow) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AddressOutflow) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 type BlockSeqInfo struct {
 	Seq      uint64 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
 	Blocknum uint64 `protobuf:"varint,2,opt,name=blocknum,proto3" json:"blocknum,omitempty"`
@@ -181,7 +307,7 @@ func (m *BlockSeqInfo) Reset()         { *m = BlockSeqInfo{} }
 func (m *BlockSeqInfo) String() string { return proto.CompactTextString(m) }
 func (*BlockSeqInfo) ProtoMessage()    {}
 func (*BlockSeqInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fc3cc4535a29f6d, []int{2}
+	return fileDescriptor_0fc3cc4535a29f6d, []int{4}
 }
 func (m *BlockSeqInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -240,13 +366,15 @@ type ReceivingState struct {
 	Provisions []*Provision `protobuf:"bytes,7,rep,name=provisions,proto3" json:"provisions,omitempty"`
 	// map the sequence number confirmed by n-of-m operators with commitment
 	ConfirmedSeqToCommitment []*ConfirmedProvision `protobuf:"bytes,8,rep,name=confirmed_seq_to_commitment,json=confirmedSeqToCommitment,proto3" json:"confirmed_seq_to_commitment,omitempty"`
+	// the judges who agreed to remove the confirmed provision of each sequence number
+	RemovalVotes []RemovalVote `protobuf:"bytes,9,rep,name=removal_votes,json=removalVotes,proto3" json:"removal_votes"`
 }
 
 func (m *ReceivingState) Reset()         { *m = ReceivingState{} }
 func (m *ReceivingState) String() string { return proto.CompactTextString(m) }
 func (*ReceivingState) ProtoMessage()    {}
 func (*ReceivingState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fc3cc4535a29f6d, []int{3}
+	return fileDescriptor_0fc3cc4535a29f6d, []int{5}
 }
 func (m *ReceivingState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -286,7 +414,7 @@ func (m *OperatorSeqInfo) Reset()         { *m = OperatorSeqInfo{} }
 func (m *OperatorSeqInfo) String() string { return proto.CompactTextString(m) }
 func (*OperatorSeqInfo) ProtoMessage()    {}
 func (*OperatorSeqInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fc3cc4535a29f6d, []int{4}
+	return fileDescriptor_0fc3cc4535a29f6d, []int{6}
 }
 func (m *OperatorSeqInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -342,7 +470,7 @@ func (m *Commitment) Reset()         { *m = Commitment{} }
 func (m *Commitment) String() string { return proto.CompactTextString(m) }
 func (*Commitment) ProtoMessage()    {}
 func (*Commitment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fc3cc4535a29f6d, []int{5}
+	return fileDescriptor_0fc3cc4535a29f6d, []int{7}
 }
 func (m *Commitment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -402,7 +530,7 @@ func (m *Provision) Reset()         { *m = Provision{} }
 func (m *Provision) String() string { return proto.CompactTextString(m) }
 func (*Provision) ProtoMessage()    {}
 func (*Provision) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fc3cc4535a29f6d, []int{6}
+	return fileDescriptor_0fc3cc4535a29f6d, []int{8}
 }
 func (m *Provision) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -463,7 +591,7 @@ func (m *ConfirmedProvision) Reset()         { *m = ConfirmedProvision{} }
 func (m *ConfirmedProvision) String() string { return proto.CompactTextString(m) }
 func (*ConfirmedProvision) ProtoMessage()    {}
 func (*ConfirmedProvision) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fc3cc4535a29f6d, []int{7}
+	return fileDescriptor_0fc3cc4535a29f6d, []int{9}
 }
 func (m *ConfirmedProvision) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -506,6 +634,60 @@ func (m *ConfirmedProvision) GetCommitment() string {
 	return ""
 }
 
+type RemovalVote struct {
+	// the sequence number of the bridge request
+	Seq uint64 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	// the judge address
+	Judge string `protobuf:"bytes,2,opt,name=judge,proto3" json:"judge,omitempty"`
+}
+
+func (m *RemovalVote) Reset()         { *m = RemovalVote{} }
+func (m *RemovalVote) String() string { return proto.CompactTextString(m) }
+func (*RemovalVote) ProtoMessage()    {}
+func (*RemovalVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fc3cc4535a29f6d, []int{10}
+}
+func (m *RemovalVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemovalVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemovalVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemovalVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemovalVote.Merge(m, src)
+}
+func (m *RemovalVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemovalVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemovalVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemovalVote proto.InternalMessageInfo
+
+func (m *RemovalVote) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *RemovalVote) GetJudge() string {
+	if m != nil {
+		return m.Judge
+	}
+	return ""
+}
+
 type BridgeSwitch struct {
 	// the guardian address
 	Guardian string       `protobuf:"bytes,1,opt,name=guardian,proto3" json:"guardian,omitempty"`
@@ -516,7 +698,7 @@ func (m *BridgeSwitch) Reset()         { *m = BridgeSwitch{} }
 func (m *BridgeSwitch) String() string { return proto.CompactTextString(m) }
 func (*BridgeSwitch) ProtoMessage()    {}
 func (*BridgeSwitch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fc3cc4535a29f6d, []int{8}
+	return fileDescriptor_0fc3cc4535a29f6d, []int{11}
 }
 func (m *BridgeSwitch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -562,70 +744,86 @@ func (m *BridgeSwitch) GetStatus() BridgeStatus {
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "lbm.fbridge.v1.GenesisState")
 	proto.RegisterType((*SendingState)(nil), "lbm.fbridge.v1.SendingState")
+	proto.RegisterType((*DenomSeq)(nil), "lbm.fbridge.v1.DenomSeq")
+	proto.RegisterType((*AddressOutflow)(nil), "lbm.fbridge.v1.AddressOutflow")
 	proto.RegisterType((*BlockSeqInfo)(nil), "lbm.fbridge.v1.BlockSeqInfo")
 	proto.RegisterType((*ReceivingState)(nil), "lbm.fbridge.v1.ReceivingState")
 	proto.RegisterType((*OperatorSeqInfo)(nil), "lbm.fbridge.v1.OperatorSeqInfo")
 	proto.RegisterType((*Commitment)(nil), "lbm.fbridge.v1.Commitment")
 	proto.RegisterType((*Provision)(nil), "lbm.fbridge.v1.Provision")
 	proto.RegisterType((*ConfirmedProvision)(nil), "lbm.fbridge.v1.ConfirmedProvision")
+	proto.RegisterType((*RemovalVote)(nil), "lbm.fbridge.v1.RemovalVote")
 	proto.RegisterType((*BridgeSwitch)(nil), "lbm.fbridge.v1.BridgeSwitch")
 }
 
 func init() { proto.RegisterFile("lbm/fbridge/v1/genesis.proto", fileDescriptor_0fc3cc4535a29f6d) }
 
 var fileDescriptor_0fc3cc4535a29f6d = []byte{
-	// 808 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xcf, 0x8f, 0xdb, 0x44,
-	0x14, 0x8e, 0x13, 0x6f, 0xba, 0x7d, 0x4d, 0x93, 0x6a, 0xd4, 0x56, 0x6e, 0x28, 0x4e, 0x64, 0x71,
-	0xc8, 0x01, 0x92, 0x06, 0x2a, 0xf1, 0x43, 0x95, 0x90, 0xb2, 0xa8, 0xd5, 0x16, 0x21, 0x2a, 0x1b,
-	0x21, 0xd4, 0x8b, 0x19, 0xdb, 0x13, 0xef, 0xa8, 0xb1, 0xc7, 0xf1, 0x4c, 0x42, 0x57, 0xfc, 0x03,
-	0xdc, 0xe0, 0xc6, 0x95, 0x0b, 0xff, 0x4b, 0x8f, 0x7b, 0xe4, 0x84, 0xd0, 0xee, 0x3f, 0x82, 0x66,
-	0x32, 0x76, 0x1c, 0x27, 0x91, 0xe0, 0x36, 0x33, 0xef, 0x7b, 0xdf, 0xfb, 0xe6, 0xf9, 0xf3, 0x1b,
-	0x78, 0xbc, 0x08, 0x92, 0xc9, 0x3c, 0xc8, 0x69, 0x14, 0x93, 0xc9, 0x7a, 0x3a, 0x89, 0x49, 0x4a,
-	0x38, 0xe5, 0xe3, 0x2c, 0x67, 0x82, 0xa1, 0xee, 0x22, 0x48, 0xc6, 0x3a, 0x3a, 0x5e, 0x4f, 0xfb,
-	0xf7, 0x63, 0x16, 0x33, 0x15, 0x9a, 0xc8, 0xd5, 0x06, 0xd5, 0xaf, 0x73, 0x14, 0x09, 0x2a, 0xea,
-	0xfc, 0x6a, 0x42, 0xe7, 0xc5, 0x86, 0xd5, 0x13, 0x58, 0x10, 0xf4, 0x14, 0xda, 0x19, 0xce, 0x71,
-	0xc2, 0x2d, 0x63, 0x68, 0x8c, 0xee, 0x7c, 0xfc, 0x70, 0xbc, 0x5b, 0x65, 0xfc, 0x4a, 0x45, 0x67,
-	0xe6, 0xbb, 0xbf, 0x07, 0x0d, 0x57, 0x63, 0xd1, 0x0b, 0xb8, 0xcb, 0x49, 0x1a, 0xd1, 0x34, 0xf6,
-	0xb9, 0xa4, 0xb1, 0x9a, 0x2a, 0xf9, 0x71, 0x3d, 0xd9, 0xdb, 0x80, 0x54, 0x29, 0x4d, 0xd1, 0xe1,
-	0x95, 0x33, 0xf4, 0x0d, 0xf4, 0x72, 0x12, 0x12, 0xba, 0xde, 0x52, 0xb5, 0x14, 0x95, 0x5d, 0xa7,
-	0x72, 0x0b, 0x58, 0x95, 0xac, 0x9b, 0xef, 0x9c, 0xa2, 0x29, 0x3c, 0x48, 0xc9, 0x5b, 0xe1, 0xe7,
-	0x6c, 0x41, 0xfc, 0x2c, 0x67, 0x19, 0xe3, 0x78, 0xe1, 0xd3, 0xc8, 0x32, 0x87, 0xc6, 0xc8, 0x74,
-	0x91, 0x0c, 0xba, 0x6c, 0x41, 0x5e, 0xe9, 0xd0, 0x79, 0x84, 0xce, 0xa1, 0xbb, 0x83, 0xe6, 0xd6,
-	0xc9, 0xb0, 0x75, 0xe8, 0x2e, 0xd5, 0x3c, 0x5d, 0xfe, 0x6e, 0x5e, 0x39, 0xe3, 0xe8, 0x09, 0x9c,
-	0xac, 0x99, 0x20, 0xdc, 0x6a, 0x2b, 0x86, 0xfb, 0x75, 0x86, 0xef, 0x59, 0x29, 0x7c, 0x03, 0x44,
-	0x4f, 0xe1, 0x44, 0x52, 0x70, 0xeb, 0x96, 0xca, 0xb0, 0x0e, 0xd6, 0xc4, 0x34, 0x2f, 0xb2, 0x14,
-	0x18, 0x7d, 0x0d, 0xbd, 0x0d, 0xc4, 0xe7, 0x3f, 0x51, 0x11, 0x5e, 0x10, 0x6e, 0x9d, 0x1e, 0xd6,
-	0x3c, 0x53, 0x2b, 0x4f, 0xa1, 0x8a, 0x96, 0x05, 0x95, 0x33, 0xc2, 0x9d, 0x9f, 0xa1, 0x53, 0xfd,
-	0x4a, 0xe8, 0x11, 0x9c, 0xaa, 0x16, 0x72, 0xb2, 0x54, 0x96, 0x30, 0xdd, 0x5b, 0x72, 0xef, 0x91,
-	0x25, 0x7a, 0x09, 0x3d, 0x4e, 0x96, 0xbe, 0x60, 0x7e, 0xb0, 0x60, 0xe1, 0x9b, 0x74, 0x95, 0x58,
-	0xcd, 0x23, 0x75, 0x65, 0xdc, 0x23, 0xcb, 0xf3, 0x74, 0xce, 0x8a, 0x5e, 0x71, 0xb2, 0xfc, 0x8e,
-	0xcd, 0x74, 0xe2, 0x17, 0xe6, 0x2f, 0x7f, 0x0c, 0x1a, 0xce, 0x33, 0xe8, 0x54, 0xa1, 0xe8, 0x1e,
-	0xb4, 0xb6, 0x75, 0xe5, 0x12, 0xf5, 0xe1, 0xb4, 0x52, 0x4c, 0x1e, 0x97, 0x7b, 0xe7, 0x4f, 0x13,
-	0xba, 0xbb, 0xb6, 0x40, 0x0c, 0x3e, 0x88, 0x73, 0x82, 0x05, 0xe1, 0xc2, 0x0f, 0x59, 0xca, 0x49,
-	0xb8, 0x12, 0x74, 0x4d, 0xe4, 0x6d, 0xfc, 0xe0, 0xd2, 0x67, 0x19, 0xc9, 0xb1, 0x60, 0xb9, 0x65,
-	0x28, 0xdd, 0x83, 0xba, 0xee, 0x6f, 0x75, 0x5c, 0xeb, 0x71, 0x87, 0x05, 0xd9, 0xd9, 0x96, 0xcb,
-	0x23, 0xcb, 0xd9, 0x65, 0x01, 0x44, 0x3f, 0x80, 0x55, 0x16, 0xac, 0x17, 0x69, 0xfe, 0xb7, 0x22,
-	0x0f, 0x0a, 0x82, 0x5d, 0xe6, 0xcf, 0x2a, 0xcc, 0xb5, 0xab, 0xa8, 0x7f, 0xc4, 0x74, 0x1f, 0x1e,
-	0x56, 0x87, 0x3e, 0x04, 0x94, 0xe9, 0xbf, 0x33, 0x5c, 0x60, 0x9a, 0xc8, 0x14, 0x6e, 0x99, 0xc3,
-	0xd6, 0xc8, 0x74, 0xef, 0xe9, 0xc8, 0x99, 0x0c, 0x78, 0x64, 0xc9, 0xd1, 0x33, 0xb8, 0x13, 0xb2,
-	0x24, 0xa1, 0x22, 0x21, 0xa9, 0x28, 0xbc, 0xdb, 0xaf, 0x8b, 0x3e, 0x2b, 0x21, 0x6e, 0x15, 0x8e,
-	0x3e, 0x07, 0xc8, 0x72, 0xb6, 0xa6, 0x9c, 0xb2, 0xb4, 0xb0, 0xf1, 0xa3, 0xbd, 0x19, 0x52, 0x20,
-	0xdc, 0x0a, 0x18, 0x61, 0x78, 0x2f, 0x64, 0xe9, 0x9c, 0xe6, 0x09, 0x89, 0x7c, 0x6d, 0xac, 0x2d,
-	0xb5, 0xb6, 0xb4, 0xb3, 0x2f, 0x44, 0xa7, 0x6c, 0x49, 0xad, 0x92, 0xc6, 0x93, 0x1e, 0xdb, 0x4a,
-	0xd5, 0x2e, 0xfb, 0x12, 0x7a, 0xb5, 0x9e, 0x4b, 0x5b, 0x55, 0xbc, 0x60, 0x8c, 0x6e, 0xbb, 0xe5,
-	0xbe, 0x30, 0x61, 0xb3, 0x34, 0xa1, 0xf3, 0x1a, 0x60, 0x4b, 0xfa, 0xff, 0x72, 0x91, 0x0d, 0x50,
-	0xb9, 0x54, 0x4b, 0xe1, 0x2b, 0x27, 0xce, 0xef, 0x06, 0xdc, 0x2e, 0xaf, 0x52, 0x43, 0x1b, 0x75,
-	0x34, 0x9a, 0x82, 0x19, 0x61, 0x81, 0xf5, 0xbc, 0x7d, 0xff, 0x68, 0xa3, 0xbf, 0xc2, 0x02, 0xbb,
-	0x0a, 0x8a, 0x3e, 0x85, 0xb6, 0x1c, 0xac, 0x2b, 0xae, 0x27, 0xeb, 0xe0, 0x68, 0x92, 0xa7, 0x60,
-	0xae, 0x86, 0x3b, 0xcf, 0x01, 0xed, 0x37, 0xfb, 0xc0, 0x2f, 0xba, 0xab, 0xb9, 0xb9, 0x77, 0xc3,
-	0x1f, 0xa1, 0x53, 0x9d, 0x43, 0xb2, 0x7f, 0xf1, 0x0a, 0xe7, 0x11, 0xc5, 0x69, 0xd1, 0xbf, 0x62,
-	0x2f, 0x9f, 0x23, 0x2d, 0x56, 0xf2, 0x74, 0x8f, 0x4e, 0xb4, 0x1d, 0xa5, 0xb3, 0x97, 0xef, 0xae,
-	0x6d, 0xe3, 0xea, 0xda, 0x36, 0xfe, 0xb9, 0xb6, 0x8d, 0xdf, 0x6e, 0xec, 0xc6, 0xd5, 0x8d, 0xdd,
-	0xf8, 0xeb, 0xc6, 0x6e, 0xbc, 0x7e, 0x12, 0x53, 0x71, 0xb1, 0x0a, 0xc6, 0x21, 0x4b, 0x26, 0xcf,
-	0x69, 0xca, 0xc3, 0x0b, 0x8a, 0x27, 0x73, 0xbd, 0xf8, 0x88, 0x47, 0x6f, 0x26, 0x6f, 0xcb, 0xc7,
-	0x52, 0x5c, 0x66, 0x84, 0x07, 0x6d, 0xf5, 0x50, 0x7e, 0xf2, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xd3, 0xf5, 0xbb, 0x75, 0x8c, 0x07, 0x00, 0x00,
+	// 1016 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x3a, 0x6b, 0x27, 0x7e, 0x75, 0xec, 0x68, 0x94, 0x56, 0xdb, 0xb4, 0xd8, 0xd1, 0x8a,
+	0x43, 0x85, 0xc0, 0x6e, 0x42, 0x11, 0x14, 0x15, 0x21, 0x9c, 0x2a, 0x55, 0x0a, 0x28, 0xd5, 0xba,
+	0x02, 0xd4, 0xcb, 0x32, 0xde, 0x1d, 0x3b, 0x4b, 0xbd, 0x3b, 0xf6, 0xcc, 0xd8, 0x69, 0xfe, 0x01,
+	0x27, 0xc4, 0x8d, 0x2b, 0x3f, 0xa7, 0xe2, 0xd4, 0x23, 0x42, 0xa8, 0x42, 0xc9, 0x99, 0xff, 0x80,
+	0x66, 0x76, 0xc6, 0xde, 0x5d, 0xdb, 0x02, 0x6e, 0x3b, 0xf3, 0xbe, 0xf7, 0xcd, 0x37, 0xdf, 0xbc,
+	0x79, 0xb3, 0x70, 0x77, 0xd4, 0x8f, 0x3b, 0x83, 0x3e, 0x8b, 0xc2, 0x21, 0xe9, 0xcc, 0x0e, 0x3b,
+	0x43, 0x92, 0x10, 0x1e, 0xf1, 0xf6, 0x98, 0x51, 0x41, 0x51, 0x7d, 0xd4, 0x8f, 0xdb, 0x3a, 0xda,
+	0x9e, 0x1d, 0xee, 0xef, 0x0d, 0xe9, 0x90, 0xaa, 0x50, 0x47, 0x7e, 0xa5, 0xa8, 0xfd, 0x22, 0x87,
+	0x49, 0x50, 0x51, 0xf7, 0x37, 0x1b, 0x6a, 0x4f, 0x52, 0xd6, 0x9e, 0xc0, 0x82, 0xa0, 0x07, 0x50,
+	0x19, 0x63, 0x86, 0x63, 0xee, 0x58, 0x07, 0xd6, 0xbd, 0x1b, 0x47, 0xb7, 0xda, 0xf9, 0x55, 0xda,
+	0xcf, 0x54, 0xb4, 0x6b, 0xbf, 0x7e, 0xdb, 0xda, 0xf0, 0x34, 0x16, 0x3d, 0x81, 0x1d, 0x4e, 0x92,
+	0x30, 0x4a, 0x86, 0x3e, 0x97, 0x34, 0x4e, 0x49, 0x25, 0xdf, 0x2d, 0x26, 0xf7, 0x52, 0x90, 0x5a,
+	0x4a, 0x53, 0xd4, 0x78, 0x66, 0x0e, 0x7d, 0x0d, 0x0d, 0x46, 0x02, 0x12, 0xcd, 0x16, 0x54, 0x9b,
+	0x8a, 0xaa, 0x59, 0xa4, 0xf2, 0x0c, 0x2c, 0x4b, 0x56, 0x67, 0xb9, 0x59, 0x74, 0x08, 0x37, 0x13,
+	0xf2, 0x4a, 0xf8, 0x8c, 0x8e, 0x88, 0x3f, 0x66, 0x74, 0x4c, 0x39, 0x1e, 0xf9, 0x51, 0xe8, 0xd8,
+	0x07, 0xd6, 0x3d, 0xdb, 0x43, 0x32, 0xe8, 0xd1, 0x11, 0x79, 0xa6, 0x43, 0xa7, 0x21, 0x3a, 0x85,
+	0x7a, 0x0e, 0xcd, 0x9d, 0xf2, 0xc1, 0xe6, 0xaa, 0xbd, 0x64, 0xf3, 0xf4, 0xf2, 0x3b, 0x2c, 0x33,
+	0xc7, 0xd1, 0x7d, 0x28, 0xcf, 0xa8, 0x20, 0xdc, 0xa9, 0x28, 0x86, 0xbd, 0x22, 0xc3, 0x37, 0x74,
+	0x2e, 0x3c, 0x05, 0xa2, 0x07, 0x50, 0x96, 0x14, 0xdc, 0xd9, 0x52, 0x19, 0xce, 0xca, 0x35, 0x71,
+	0xc4, 0x4c, 0x96, 0x02, 0xa3, 0x2f, 0xa1, 0x91, 0x42, 0x7c, 0x7e, 0x11, 0x89, 0xe0, 0x9c, 0x70,
+	0x67, 0x7b, 0xb5, 0xe6, 0xae, 0xfa, 0xea, 0x29, 0x94, 0xb1, 0xac, 0x9f, 0x99, 0x23, 0x1c, 0x3d,
+	0x84, 0x4a, 0x48, 0x12, 0x1a, 0x73, 0xa7, 0xaa, 0x38, 0xee, 0xac, 0xe6, 0x78, 0x2c, 0x31, 0xa6,
+	0x0a, 0xd2, 0x04, 0xf7, 0xcf, 0x12, 0xd4, 0xb2, 0x27, 0x8c, 0x6e, 0xc3, 0xb6, 0xb2, 0x9f, 0x93,
+	0x89, 0x2a, 0x27, 0xdb, 0xdb, 0x92, 0xe3, 0x1e, 0x99, 0xa0, 0xa7, 0xd0, 0xe0, 0x64, 0xe2, 0x0b,
+	0xea, 0xf7, 0x47, 0x34, 0x78, 0x99, 0x4c, 0x63, 0xa7, 0xb4, 0x46, 0xb3, 0x8c, 0xf7, 0xc8, 0xe4,
+	0x34, 0x19, 0x50, 0xe3, 0x33, 0x27, 0x93, 0xe7, 0xb4, 0xab, 0x13, 0xd1, 0x19, 0xec, 0x0a, 0x86,
+	0x13, 0x3e, 0x20, 0xcc, 0xbf, 0x88, 0x92, 0x90, 0x5e, 0x70, 0x67, 0x53, 0x91, 0x2d, 0x55, 0xcd,
+	0x73, 0x8d, 0xfb, 0x56, 0xc1, 0x34, 0x5d, 0x43, 0xe4, 0x66, 0xb9, 0x24, 0xc4, 0x61, 0xc8, 0x08,
+	0xe7, 0x3e, 0x9d, 0x8a, 0xc1, 0x48, 0x12, 0xda, 0xab, 0x09, 0xbf, 0x48, 0x71, 0x67, 0x29, 0xcc,
+	0x10, 0xe2, 0xdc, 0x2c, 0x47, 0x9f, 0x01, 0x28, 0x8f, 0xa4, 0x13, 0xa6, 0xa0, 0x96, 0x0e, 0x57,
+	0x59, 0xda, 0x23, 0x13, 0x4d, 0x52, 0x0d, 0xf5, 0x98, 0x7f, 0x6a, 0xff, 0xf8, 0x6b, 0x6b, 0xc3,
+	0x3d, 0x82, 0x6d, 0x03, 0x41, 0x7b, 0x50, 0x56, 0x61, 0x65, 0x6b, 0xd5, 0x4b, 0x07, 0x68, 0x17,
+	0x36, 0xa5, 0xd5, 0x25, 0x65, 0xb5, 0xfc, 0x74, 0x7f, 0xb2, 0xa0, 0x9e, 0x97, 0x88, 0x1c, 0xd8,
+	0xd2, 0xf2, 0x74, 0xb2, 0x19, 0xa2, 0xaf, 0x60, 0x4b, 0x6f, 0x57, 0x51, 0x54, 0xbb, 0x47, 0x52,
+	0xc8, 0x1f, 0x6f, 0x5b, 0xef, 0x0d, 0x23, 0x71, 0x3e, 0xed, 0xb7, 0x03, 0x1a, 0x77, 0x4e, 0xa2,
+	0x84, 0x07, 0xe7, 0x11, 0xee, 0x0c, 0xf4, 0xc7, 0x07, 0x3c, 0x7c, 0xd9, 0x11, 0x97, 0x63, 0xc2,
+	0xdb, 0xa7, 0x89, 0xf0, 0x0c, 0xc5, 0x42, 0xe2, 0x66, 0x46, 0xa2, 0xfb, 0x08, 0x6a, 0xd9, 0x03,
+	0x35, 0x92, 0xad, 0xb9, 0x64, 0xb4, 0x0f, 0xdb, 0x99, 0x92, 0x90, 0xd3, 0xf3, 0xb1, 0xfb, 0xb7,
+	0x0d, 0xf5, 0xfc, 0xc5, 0x47, 0x14, 0xde, 0x1d, 0x32, 0x82, 0x05, 0xe1, 0xc2, 0x0f, 0x68, 0xc2,
+	0x49, 0x30, 0x15, 0xd1, 0x8c, 0x48, 0xa7, 0xfd, 0xfe, 0xa5, 0x4f, 0xc7, 0x84, 0x61, 0x41, 0x99,
+	0x63, 0x29, 0xd3, 0x5b, 0x45, 0xd3, 0xcf, 0x74, 0x5c, 0xeb, 0xf1, 0x0e, 0x0c, 0xd9, 0xf1, 0x82,
+	0x4b, 0x9e, 0xc9, 0xa5, 0x01, 0xa2, 0xef, 0xc0, 0x99, 0x2f, 0x58, 0x5c, 0xa4, 0xf4, 0xdf, 0x16,
+	0xb9, 0x69, 0x08, 0xf2, 0xcc, 0x9f, 0x64, 0x98, 0x0b, 0x5b, 0x51, 0x26, 0xda, 0xde, 0xad, 0xd5,
+	0xea, 0xd0, 0xfb, 0x80, 0xc6, 0xba, 0xff, 0x06, 0x23, 0x1c, 0xe9, 0x3a, 0x93, 0x25, 0x6b, 0x7b,
+	0xbb, 0x3a, 0x72, 0x2c, 0x03, 0xb2, 0x9c, 0xd0, 0x23, 0xb8, 0x11, 0xd0, 0x38, 0x8e, 0x44, 0x4c,
+	0x12, 0x61, 0xba, 0xd3, 0x7e, 0x51, 0xf4, 0xf1, 0x1c, 0xe2, 0x65, 0xe1, 0xe8, 0x21, 0xc0, 0x98,
+	0xd1, 0x59, 0xc4, 0x23, 0x9a, 0x98, 0x46, 0x75, 0x7b, 0xe9, 0x95, 0x30, 0x08, 0x2f, 0x03, 0x46,
+	0x18, 0xee, 0x04, 0x34, 0x19, 0x44, 0x2c, 0x26, 0xa1, 0xaf, 0xaf, 0xff, 0x82, 0x5a, 0x37, 0x2d,
+	0x77, 0x59, 0x88, 0x4e, 0x59, 0x90, 0x3a, 0x73, 0x9a, 0x9e, 0xec, 0x04, 0x0b, 0xa9, 0xe8, 0x04,
+	0x76, 0x18, 0x89, 0xe9, 0x0c, 0x8f, 0xfc, 0xb4, 0xf7, 0xae, 0xe9, 0x62, 0x5e, 0x0a, 0xca, 0xb4,
+	0xe0, 0x1a, 0x5b, 0x4c, 0x99, 0x2b, 0xf7, 0x39, 0x34, 0x0a, 0x67, 0x27, 0xcb, 0x33, 0x53, 0x53,
+	0xb2, 0xb2, 0xe7, 0xe3, 0x15, 0xf7, 0xef, 0x05, 0x40, 0x46, 0xdc, 0xff, 0xca, 0x45, 0x4d, 0x80,
+	0x8c, 0x39, 0xe9, 0x2d, 0xca, 0xcc, 0xb8, 0xbf, 0x58, 0x50, 0x9d, 0x5b, 0x52, 0x40, 0x5b, 0x45,
+	0x34, 0x3a, 0x04, 0x3b, 0xc4, 0x02, 0xeb, 0x97, 0xf9, 0x9d, 0xb5, 0x07, 0xf6, 0x18, 0x0b, 0xec,
+	0x29, 0x28, 0xfa, 0x18, 0x2a, 0xf2, 0x09, 0x9e, 0x72, 0xfd, 0x06, 0xb7, 0xd6, 0x26, 0xf5, 0x14,
+	0xcc, 0xd3, 0x70, 0xf7, 0x04, 0xd0, 0xf2, 0xa1, 0xad, 0xb8, 0xea, 0x79, 0xcd, 0xa5, 0xa5, 0x1d,
+	0x7e, 0x04, 0x37, 0x32, 0xe7, 0xb4, 0x82, 0x60, 0x0f, 0xca, 0x3f, 0x4c, 0xc3, 0x21, 0xd1, 0xb9,
+	0xe9, 0xc0, 0xfd, 0x1e, 0x6a, 0xd9, 0x87, 0x4e, 0xda, 0x3e, 0x9c, 0x62, 0x16, 0x46, 0x38, 0x31,
+	0xb6, 0x9b, 0xb1, 0xfc, 0xdf, 0xd1, 0x7b, 0x94, 0x14, 0xf5, 0xb5, 0x4f, 0x66, 0x6e, 0x83, 0xdd,
+	0xa7, 0xaf, 0xaf, 0x9a, 0xd6, 0x9b, 0xab, 0xa6, 0xf5, 0xd7, 0x55, 0xd3, 0xfa, 0xf9, 0xba, 0xb9,
+	0xf1, 0xe6, 0xba, 0xb9, 0xf1, 0xfb, 0x75, 0x73, 0xe3, 0xc5, 0xfd, 0x7f, 0x6d, 0x95, 0xaf, 0xe6,
+	0x7f, 0x63, 0xaa, 0x69, 0xf6, 0x2b, 0xea, 0x4f, 0xec, 0xc3, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff,
+	0x3c, 0x66, 0x5b, 0x9f, 0xed, 0x09, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -648,6 +846,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Denoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.BridgeSwitches) > 0 {
 		for iNdEx := len(m.BridgeSwitches) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -762,6 +974,48 @@ func (m *SendingState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DenomSeqs) > 0 {
+		for iNdEx := len(m.DenomSeqs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomSeqs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AddressOutflows) > 0 {
+		for iNdEx := len(m.AddressOutflows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddressOutflows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.TransferWindows) > 0 {
+		for iNdEx := len(m.TransferWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.SeqToBlocknum) > 0 {
 		for iNdEx := len(m.SeqToBlocknum) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -784,6 +1038,88 @@ func (m *SendingState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DenomSeq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomSeq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomSeq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddressOutflow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressOutflow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressOutflow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Outflow.Size()
+		i -= size
+		if _, err := m.Outflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *BlockSeqInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -837,6 +1173,20 @@ func (m *ReceivingState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RemovalVotes) > 0 {
+		for iNdEx := len(m.RemovalVotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemovalVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.ConfirmedSeqToCommitment) > 0 {
 		for iNdEx := len(m.ConfirmedSeqToCommitment) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1099,7 +1449,7 @@ func (m *ConfirmedProvision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *BridgeSwitch) Marshal() (dAtA []byte, err error) {
+func (m *RemovalVote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1109,41 +1459,76 @@ func (m *BridgeSwitch) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BridgeSwitch) MarshalTo(dAtA []byte) (int, error) {
+func (m *RemovalVote) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BridgeSwitch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RemovalVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Status != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.Status))
+	if len(m.Judge) > 0 {
+		i -= len(m.Judge)
+		copy(dAtA[i:], m.Judge)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Judge)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if len(m.Guardian) > 0 {
-		i -= len(m.Guardian)
-		copy(dAtA[i:], m.Guardian)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Guardian)))
+	if m.Seq != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Seq))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *BridgeSwitch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeSwitch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeSwitch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
 }
 func (m *GenesisState) Size() (n int) {
 	if m == nil {
@@ -1184,6 +1569,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Denoms) > 0 {
+		for _, e := range m.Denoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1202,6 +1593,59 @@ func (m *SendingState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.TransferWindows) > 0 {
+		for _, e := range m.TransferWindows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AddressOutflows) > 0 {
+		for _, e := range m.AddressOutflows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenomSeqs) > 0 {
+		for _, e := range m.DenomSeqs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomSeq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Seq != 0 {
+		n += 1 + sovGenesis(uint64(m.Seq))
+	}
+	return n
+}
+
+func (m *AddressOutflow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Outflow.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -1266,6 +1710,12 @@ func (m *ReceivingState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.RemovalVotes) > 0 {
+		for _, e := range m.RemovalVotes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1342,6 +1792,22 @@ func (m *ConfirmedProvision) Size() (n int) {
 	return n
 }
 
+func (m *RemovalVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		n += 1 + sovGenesis(uint64(m.Seq))
+	}
+	l = len(m.Judge)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
 func (m *BridgeSwitch) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1571,19 +2037,376 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, Vote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, RolePair{})
+			if err := m.Roles[len(m.Roles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeSwitches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeSwitches = append(m.BridgeSwitches, BridgeSwitch{})
+			if err := m.BridgeSwitches[len(m.BridgeSwitches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, BridgeDenom{})
+			if err := m.Denoms[len(m.Denoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SendingState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendingState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendingState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSeq", wireType)
+			}
+			m.NextSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeqToBlocknum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SeqToBlocknum = append(m.SeqToBlocknum, BlockSeqInfo{})
+			if err := m.SeqToBlocknum[len(m.SeqToBlocknum)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferWindows = append(m.TransferWindows, TransferWindow{})
+			if err := m.TransferWindows[len(m.TransferWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressOutflows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressOutflows = append(m.AddressOutflows, AddressOutflow{})
+			if err := m.AddressOutflows[len(m.AddressOutflows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomSeqs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomSeqs = append(m.DenomSeqs, DenomSeq{})
+			if err := m.DenomSeqs[len(m.DenomSeqs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomSeq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Votes = append(m.Votes, Vote{})
-			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 7:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomSeq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomSeq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1593,31 +2416,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Roles = append(m.Roles, RolePair{})
-			if err := m.Roles[len(m.Roles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeSwitches", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
 			}
-			var msglen int
+			m.Seq = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1627,26 +2448,11 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Seq |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BridgeSwitches = append(m.BridgeSwitches, BridgeSwitch{})
-			if err := m.BridgeSwitches[len(m.BridgeSwitches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1668,7 +2474,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SendingState) Unmarshal(dAtA []byte) error {
+func (m *AddressOutflow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1691,17 +2497,17 @@ func (m *SendingState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SendingState: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddressOutflow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SendingState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddressOutflow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextSeq", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.NextSeq = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1711,16 +2517,29 @@ func (m *SendingState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextSeq |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SeqToBlocknum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Outflow", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1730,26 +2549,58 @@ func (m *SendingState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SeqToBlocknum = append(m.SeqToBlocknum, BlockSeqInfo{})
-			if err := m.SeqToBlocknum[len(m.SeqToBlocknum)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Outflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2153,6 +3004,40 @@ func (m *ReceivingState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovalVotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemovalVotes = append(m.RemovalVotes, RemovalVote{})
+			if err := m.RemovalVotes[len(m.RemovalVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2663,6 +3548,107 @@ func (m *ConfirmedProvision) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RemovalVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemovalVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemovalVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Judge", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Judge = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *BridgeSwitch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -22,6 +22,22 @@ const (
 // - 0x01: params
 // - 0x02: next sequence number for bridge sending
 // - 0x03<sequence (8-byte)>: block number of sequence
+// - 0x04<denomLen (1-byte)><denom>: current transfer window of the denom
+// - 0x05<denomLen (1-byte)><denom><addrLen (1-byte)><senderAddr>: outflow of the sender during the current transfer window
+// - 0x06<denomLen (1-byte)><denom>: outflow of the denom in the current block
+// - 0x07<denomLen (1-byte)><denom><sequence (8-byte)>: sequence number of the bridge request by denom
+// - 0x08<denom>: bridgeable denom
+//
+// - 0x20<operatorAddrLen (1-byte)><operatorAddr>: greatest sequence number confirmed by the operator
+// - 0x21<operatorAddrLen (1-byte)><operatorAddr>: greatest consecutive sequence number confirmed by the operator
+// - 0x22: greatest consecutive sequence number confirmed by n-of-m operators
+// - 0x23<sequence (8-byte)><operatorAddrLen (1-byte)><operatorAddr>: commitment submitted by the operator
+// - 0x24<commitment>: provision data
+// - 0x25<commitment>: provision status
+// - 0x26<sequence (8-byte)>: commitment of the confirmed provision
+// - 0x27<sequence (8-byte)>: pending claim
+// - 0x28<sequence (8-byte)><judgeAddrLen (1-byte)><judgeAddr>: removal vote
+// - 0x29<denomLen (1-byte)><denom><sequence (8-byte)>: sequence number of the confirmed provision by denom
 //
 // - 0x10: next proposal ID
 // 	 0x11<proposalID (8-byte)>: proposal
@@ -34,9 +50,25 @@ const (
 // - 0xF2: bridge status
 
 var (
-	KeyParams              = []byte{0x01} // key for fbridge module params
-	KeyNextSeqSend         = []byte{0x02} // key for the next bridge send sequence
-	KeySeqToBlocknumPrefix = []byte{0x03} // key prefix for the sequence to block number mapping
+	KeyParams               = []byte{0x01} // key for fbridge module params
+	KeyNextSeqSend          = []byte{0x02} // key for the next bridge send sequence
+	KeySeqToBlocknumPrefix  = []byte{0x03} // key prefix for the sequence to block number mapping
+	KeyTransferWindowPrefix = []byte{0x04} // key prefix for the current transfer window of each denom
+	KeyAddressOutflowPrefix = []byte{0x05} // key prefix for the outflow of each address during the current transfer window
+	KeyBlockOutflowPrefix   = []byte{0x06} // key prefix for the outflow of each denom in the current block
+	KeyDenomSeqPrefix       = []byte{0x07} // key prefix for the sequence numbers of bridge requests by denom
+	KeyBridgeDenomPrefix    = []byte{0x08} // key prefix for the bridgeable denoms
+
+	KeyGreatestSeqByOperatorPrefix            = []byte{0x20} // key prefix for the greatest sequence number confirmed by each operator
+	KeyGreatestConsecutiveSeqByOperatorPrefix = []byte{0x21} // key prefix for the greatest consecutive sequence number confirmed by each operator
+	KeyGreatestConsecutiveConfirmedSeq        = []byte{0x22} // key for the greatest consecutive sequence number confirmed by n-of-m operators
+	KeyCommitmentPrefix                       = []byte{0x23} // key prefix for the commitment submitted by each operator
+	KeyProvisionPrefix                        = []byte{0x24} // key prefix for the provision data
+	KeyProvisionStatusPrefix                  = []byte{0x25} // key prefix for the provision status
+	KeyConfirmedSeqToCommitmentPrefix         = []byte{0x26} // key prefix for the commitment of the confirmed provision
+	KeyPendingClaimSeqPrefix                  = []byte{0x27} // key prefix for the sequence numbers to be claimed
+	KeyRemovalVotePrefix                      = []byte{0x28} // key prefix for the judge's vote to remove a provision
+	KeyConfirmedDenomSeqPrefix                = []byte{0x29} // key prefix for the sequence numbers of confirmed provisions by denom
 
 	KeyNextProposalID     = []byte{0x10} // key for the next role proposal ID
 	KeyProposalPrefix     = []byte{0x11} // key prefix for the role proposal
@@ -55,6 +87,151 @@ func SeqToBlocknumKey(seq uint64) []byte {
 	return append(KeySeqToBlocknumPrefix, bz...)
 }
 
+// TransferWindowKey key for the current transfer window of a specific denom
+func TransferWindowKey(denom string) []byte {
+	return append(KeyTransferWindowPrefix, address.MustLengthPrefix([]byte(denom))...)
+}
+
+// AddressOutflowsKey gets the first part of the keys of the address outflows of a specific denom
+func AddressOutflowsKey(denom string) []byte {
+	return append(KeyAddressOutflowPrefix, address.MustLengthPrefix([]byte(denom))...)
+}
+
+// AddressOutflowKey key for the outflow of a specific address during the current transfer window of a specific denom
+func AddressOutflowKey(denom string, addr sdk.AccAddress) []byte {
+	return append(AddressOutflowsKey(denom), address.MustLengthPrefix(addr)...)
+}
+
+// SplitAddressOutflowKey split the address outflow key and returns the denom and address
+func SplitAddressOutflowKey(key []byte) (string, sdk.AccAddress) {
+	kv.AssertKeyAtLeastLength(key, 2)
+	denomLen := int(key[1])
+	kv.AssertKeyAtLeastLength(key, 3+denomLen)
+	denom := string(key[2 : 2+denomLen])
+	addrLen := int(key[2+denomLen])
+	kv.AssertKeyLength(key, 3+denomLen+addrLen)
+	return denom, sdk.AccAddress(key[3+denomLen:])
+}
+
+// BlockOutflowKey key for the outflow of a specific denom in the current block
+func BlockOutflowKey(denom string) []byte {
+	return append(KeyBlockOutflowPrefix, address.MustLengthPrefix([]byte(denom))...)
+}
+
+// DenomSeqsKey gets the first part of the keys of the bridge requests of a specific denom
+func DenomSeqsKey(denom string) []byte {
+	return append(KeyDenomSeqPrefix, address.MustLengthPrefix([]byte(denom))...)
+}
+
+// DenomSeqKey key of the sequence number of a bridge request of a specific denom
+func DenomSeqKey(denom string, seq uint64) []byte {
+	return append(DenomSeqsKey(denom), GetSeqBytes(seq)...)
+}
+
+// ConfirmedDenomSeqsKey gets the first part of the keys of the confirmed provisions of a specific denom
+func ConfirmedDenomSeqsKey(denom string) []byte {
+	return append(KeyConfirmedDenomSeqPrefix, address.MustLengthPrefix([]byte(denom))...)
+}
+
+// ConfirmedDenomSeqKey key of the sequence number of a confirmed provision of a specific denom
+func ConfirmedDenomSeqKey(denom string, seq uint64) []byte {
+	return append(ConfirmedDenomSeqsKey(denom), GetSeqBytes(seq)...)
+}
+
+// SplitDenomSeqKey split the denom sequence key and returns the denom and sequence number
+func SplitDenomSeqKey(key []byte) (string, uint64) {
+	kv.AssertKeyAtLeastLength(key, 2)
+	denomLen := int(key[1])
+	kv.AssertKeyLength(key, 2+denomLen+8)
+	denom := string(key[2 : 2+denomLen])
+	seq := binary.BigEndian.Uint64(key[2+denomLen:])
+	return denom, seq
+}
+
+// BridgeDenomKey key of a bridgeable denom
+func BridgeDenomKey(denom string) []byte {
+	return append(KeyBridgeDenomPrefix, []byte(denom)...)
+}
+
+// GetSeqBytes returns the byte representation of the sequence number
+func GetSeqBytes(seq uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, seq)
+	return bz
+}
+
+// GreatestSeqByOperatorKey key of the greatest sequence number confirmed by the operator
+func GreatestSeqByOperatorKey(operator sdk.AccAddress) []byte {
+	return append(KeyGreatestSeqByOperatorPrefix, address.MustLengthPrefix(operator.Bytes())...)
+}
+
+// GreatestConsecutiveSeqByOperatorKey key of the greatest consecutive sequence number confirmed by the operator
+func GreatestConsecutiveSeqByOperatorKey(operator sdk.AccAddress) []byte {
+	return append(KeyGreatestConsecutiveSeqByOperatorPrefix, address.MustLengthPrefix(operator.Bytes())...)
+}
+
+// SplitOperatorSeqKey split the operator sequence key and returns the operator address
+func SplitOperatorSeqKey(key []byte) sdk.AccAddress {
+	kv.AssertKeyAtLeastLength(key, 3)
+	return key[2:]
+}
+
+// CommitmentsKey gets the first part of the commitments key based on the sequence number
+func CommitmentsKey(seq uint64) []byte {
+	return append(KeyCommitmentPrefix, GetSeqBytes(seq)...)
+}
+
+// CommitmentKey key of the commitment submitted by the operator for a specific sequence number
+func CommitmentKey(seq uint64, operator sdk.AccAddress) []byte {
+	return append(CommitmentsKey(seq), address.MustLengthPrefix(operator.Bytes())...)
+}
+
+// SplitCommitmentKey split the commitment key and returns the sequence number and operator address
+func SplitCommitmentKey(key []byte) (uint64, sdk.AccAddress) {
+	kv.AssertKeyAtLeastLength(key, 11)
+	seq := binary.BigEndian.Uint64(key[1:9])
+	operator := sdk.AccAddress(key[10:])
+	return seq, operator
+}
+
+// ProvisionKey key of the provision data associated with the commitment
+func ProvisionKey(commitment []byte) []byte {
+	return append(KeyProvisionPrefix, commitment...)
+}
+
+// ProvisionStatusKey key of the provision status associated with the commitment
+func ProvisionStatusKey(commitment []byte) []byte {
+	return append(KeyProvisionStatusPrefix, commitment...)
+}
+
+// ConfirmedSeqToCommitmentKey key of the commitment of the confirmed provision
+func ConfirmedSeqToCommitmentKey(seq uint64) []byte {
+	return append(KeyConfirmedSeqToCommitmentPrefix, GetSeqBytes(seq)...)
+}
+
+// PendingClaimSeqKey key of the sequence number to be claimed
+func PendingClaimSeqKey(seq uint64) []byte {
+	return append(KeyPendingClaimSeqPrefix, GetSeqBytes(seq)...)
+}
+
+// RemovalVotesKey gets the first part of the removal votes key based on the sequence number
+func RemovalVotesKey(seq uint64) []byte {
+	return append(KeyRemovalVotePrefix, GetSeqBytes(seq)...)
+}
+
+// RemovalVoteKey key of the judge's vote to remove the provision of a specific sequence number
+func RemovalVoteKey(seq uint64, judge sdk.AccAddress) []byte {
+	return append(RemovalVotesKey(seq), address.MustLengthPrefix(judge.Bytes())...)
+}
+
+// SplitRemovalVoteKey split the removal vote key and returns the sequence number and judge address
+func SplitRemovalVoteKey(key []byte) (uint64, sdk.AccAddress) {
+	kv.AssertKeyAtLeastLength(key, 11)
+	seq := binary.BigEndian.Uint64(key[1:9])
+	judge := sdk.AccAddress(key[10:])
+	return seq, judge
+}
+
 // GetProposalIDBytes returns the byte representation of the proposalID
 func GetProposalIDBytes(proposalID uint64) []byte {
 	bz := make([]byte, 8)
@@ -11,6 +11,7 @@ var (
 	_ sdk.Msg = &MsgRemoveProvision{}
 	_ sdk.Msg = &MsgClaimBatch{}
 	_ sdk.Msg = &MsgClaim{}
+	_ sdk.Msg = &MsgUpdateDenom{}
 	_ sdk.Msg = &MsgSuggestRole{}
 	_ sdk.Msg = &MsgAddVoteForRole{}
 	_ sdk.Msg = &MsgSetBridgeStatus{}
@@ -96,6 +97,16 @@ func (m MsgClaim) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
 }
 
+func (m MsgUpdateDenom) ValidateBasic() error { return nil }
+
+func (m MsgUpdateDenom) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Authority)}
+}
+
+func (m MsgUpdateDenom) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
 func (m MsgSuggestRole) ValidateBasic() error { return nil }
 
 func (m MsgSuggestRole) GetSigners() []sdk.AccAddress {
@@ -9,12 +9,17 @@ import (
 
 func DefaultParams() Params {
 	return Params{
-		GuardianTrustLevel: Fraction{Numerator: 2, Denominator: 3},
-		OperatorTrustLevel: Fraction{Numerator: 2, Denominator: 3},
-		JudgeTrustLevel:    Fraction{Numerator: 1, Denominator: 1},
-		ProposalPeriod:     uint64(time.Minute * 60),
-		TimelockPeriod:     uint64(time.Hour * 24),
-		TargetDenom:        sdktypes.DefaultBondDenom,
+		GuardianTrustLevel:    Fraction{Numerator: 2, Denominator: 3},
+		OperatorTrustLevel:    Fraction{Numerator: 2, Denominator: 3},
+		JudgeTrustLevel:       Fraction{Numerator: 1, Denominator: 1},
+		ProposalPeriod:        uint64(time.Minute * 60),
+		TimelockPeriod:        uint64(time.Hour * 24),
+		TargetDenom:           sdktypes.DefaultBondDenom,
+		TransferMaxPerTx:      sdktypes.ZeroInt(),
+		TransferWindowCap:     sdktypes.ZeroInt(),
+		TransferAddressCap:    sdktypes.ZeroInt(),
+		TransferWindowPeriod:  uint64(time.Hour * 24),
+		BlockOutflowThreshold: sdktypes.ZeroInt(),
 	}
 }
 
@@ -43,9 +48,54 @@ func (p Params) ValidateParams() error {
 		return err
 	}
 
+	if err := validateTransferLimit(p.TransferMaxPerTx); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("transfer max per tx: " + err.Error())
+	}
+
+	if err := validateTransferLimit(p.TransferWindowCap); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("transfer window cap: " + err.Error())
+	}
+
+	if err := validateTransferLimit(p.TransferAddressCap); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("transfer address cap: " + err.Error())
+	}
+
+	if err := validateTransferLimit(p.BlockOutflowThreshold); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("block outflow threshold: " + err.Error())
+	}
+
+	if p.TransferWindowPeriod == 0 && (IsTransferLimitSet(p.TransferWindowCap) || IsTransferLimitSet(p.TransferAddressCap)) {
+		return sdkerrors.ErrInvalidRequest.Wrap("transfer window period cannot be 0 if a window cap is set")
+	}
+
 	return nil
 }
 
+func validateTransferLimit(limit sdktypes.Int) error {
+	if !limit.IsNil() && limit.IsNegative() {
+		return sdkerrors.ErrInvalidRequest.Wrap("transfer limit cannot be negative")
+	}
+
+	return nil
+}
+
+// IsTransferLimitSet returns true if the limit is in effect. nil or zero means no limit.
+func IsTransferLimitSet(limit sdktypes.Int) bool {
+	return !limit.IsNil() && limit.IsPositive()
+}
+
+// MinTransferLimit returns the strictest of the limits. nil or zero means no limit.
+func MinTransferLimit(limits ...sdktypes.Int) sdktypes.Int {
+	min := sdktypes.ZeroInt()
+	for _, limit := range limits {
+		if IsTransferLimitSet(limit) && (!IsTransferLimitSet(min) || limit.LT(min)) {
+			min = limit
+		}
+	}
+
+	return min
+}
+
 func CheckTrustLevelThreshold(total, current uint64, trustLevel Fraction) bool {
 	if err := ValidateTrustLevel(trustLevel); err != nil {
 		panic(err)
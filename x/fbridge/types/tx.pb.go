@@ -129,6 +129,8 @@ type MsgTransfer struct {
 	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
 	// the amount of token to be transferred
 	Amount github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"amount"`
+	// the denom of token to be transferred. empty means the target denom.
+	Denom string `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *MsgTransfer) Reset()         { *m = MsgTransfer{} }
@@ -178,7 +180,16 @@ func (m *MsgTransfer) GetReceiver() string {
 	return ""
 }
 
+func (m *MsgTransfer) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 type MsgTransferResponse struct {
+	// the fee deducted from the amount
+	Fee github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,1,opt,name=fee,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"fee"`
 }
 
 func (m *MsgTransferResponse) Reset()         { *m = MsgTransferResponse{} }
@@ -226,6 +237,8 @@ type MsgProvision struct {
 	Receiver string `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
 	// the amount of token to be claimed
 	Amount github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"amount"`
+	// the denom of token to be claimed. empty means the target denom.
+	Denom string `protobuf:"bytes,6,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *MsgProvision) Reset()         { *m = MsgProvision{} }
@@ -289,6 +302,13 @@ func (m *MsgProvision) GetReceiver() string {
 	return ""
 }
 
+func (m *MsgProvision) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 type MsgProvisionResponse struct {
 }
 
@@ -780,6 +800,97 @@ func (m *MsgClaimResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgClaimResponse proto.InternalMessageInfo
 
+// MsgUpdateDenom is input values required for registering or updating a bridgeable denom
+type MsgUpdateDenom struct {
+	// the authority address
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// the denom to register or update
+	Denom BridgeDenom `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom"`
+}
+
+func (m *MsgUpdateDenom) Reset()         { *m = MsgUpdateDenom{} }
+func (m *MsgUpdateDenom) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateDenom) ProtoMessage()    {}
+func (*MsgUpdateDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_54a336bc5ea063bb, []int{16}
+}
+func (m *MsgUpdateDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateDenom.Merge(m, src)
+}
+func (m *MsgUpdateDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateDenom proto.InternalMessageInfo
+
+func (m *MsgUpdateDenom) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateDenom) GetDenom() BridgeDenom {
+	if m != nil {
+		return m.Denom
+	}
+	return BridgeDenom{}
+}
+
+type MsgUpdateDenomResponse struct {
+}
+
+func (m *MsgUpdateDenomResponse) Reset()         { *m = MsgUpdateDenomResponse{} }
+func (m *MsgUpdateDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateDenomResponse) ProtoMessage()    {}
+func (*MsgUpdateDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_54a336bc5ea063bb, []int{17}
+}
+func (m *MsgUpdateDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateDenomResponse.Merge(m, src)
+}
+func (m *MsgUpdateDenomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateDenomResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateDenomResponse proto.InternalMessageInfo
+
 // MsgUpdateRole is input values required for updating the role of an address
 type MsgSuggestRole struct {
 	// the guardian address
@@ -798,7 +909,7 @@ func (m *MsgSuggestRole) Reset()         { *m = MsgSuggestRole{} }
 func (m *MsgSuggestRole) String() string { return proto.CompactTextString(m) }
 func (*MsgSuggestRole) ProtoMessage()    {}
 func (*MsgSuggestRole) Descriptor() ([]byte, []int) {
-	return fileDescriptor_54a336bc5ea063bb, []int{16}
+	return fileDescriptor_54a336bc5ea063bb, []int{18}
 }
 func (m *MsgSuggestRole) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -855,7 +966,7 @@ func (m *MsgSuggestRoleResponse) Reset()         { *m = MsgSuggestRoleResponse{}
 func (m *MsgSuggestRoleResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSuggestRoleResponse) ProtoMessage()    {}
 func (*MsgSuggestRoleResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_54a336bc5ea063bb, []int{17}
+	return fileDescriptor_54a336bc5ea063bb, []int{19}
 }
 func (m *MsgSuggestRoleResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -897,7 +1008,7 @@ func (m *MsgAddVoteForRole) Reset()         { *m = MsgAddVoteForRole{} }
 func (m *MsgAddVoteForRole) String() string { return proto.CompactTextString(m) }
 func (*MsgAddVoteForRole) ProtoMessage()    {}
 func (*MsgAddVoteForRole) Descriptor() ([]byte, []int) {
-	return fileDescriptor_54a336bc5ea063bb, []int{18}
+	return fileDescriptor_54a336bc5ea063bb, []int{20}
 }
 func (m *MsgAddVoteForRole) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -954,7 +1065,7 @@ func (m *MsgAddVoteForRoleResponse) Reset()         { *m = MsgAddVoteForRoleResp
 func (m *MsgAddVoteForRoleResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAddVoteForRoleResponse) ProtoMessage()    {}
 func (*MsgAddVoteForRoleResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_54a336bc5ea063bb, []int{19}
+	return fileDescriptor_54a336bc5ea063bb, []int{21}
 }
 func (m *MsgAddVoteForRoleResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -994,7 +1105,7 @@ func (m *MsgSetBridgeStatus) Reset()         { *m = MsgSetBridgeStatus{} }
 func (m *MsgSetBridgeStatus) String() string { return proto.CompactTextString(m) }
 func (*MsgSetBridgeStatus) ProtoMessage()    {}
 func (*MsgSetBridgeStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_54a336bc5ea063bb, []int{20}
+	return fileDescriptor_54a336bc5ea063bb, []int{22}
 }
 func (m *MsgSetBridgeStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1044,7 +1155,7 @@ func (m *MsgSetBridgeStatusResponse) Reset()         { *m = MsgSetBridgeStatusRe
 func (m *MsgSetBridgeStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSetBridgeStatusResponse) ProtoMessage()    {}
 func (*MsgSetBridgeStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_54a336bc5ea063bb, []int{21}
+	return fileDescriptor_54a336bc5ea063bb, []int{23}
 }
 func (m *MsgSetBridgeStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1090,6 +1201,8 @@ func init() {
 	proto.RegisterType((*MsgClaimBatchResponse)(nil), "lbm.fbridge.v1.MsgClaimBatchResponse")
 	proto.RegisterType((*MsgClaim)(nil), "lbm.fbridge.v1.MsgClaim")
 	proto.RegisterType((*MsgClaimResponse)(nil), "lbm.fbridge.v1.MsgClaimResponse")
+	proto.RegisterType((*MsgUpdateDenom)(nil), "lbm.fbridge.v1.MsgUpdateDenom")
+	proto.RegisterType((*MsgUpdateDenomResponse)(nil), "lbm.fbridge.v1.MsgUpdateDenomResponse")
 	proto.RegisterType((*MsgSuggestRole)(nil), "lbm.fbridge.v1.MsgSuggestRole")
 	proto.RegisterType((*MsgSuggestRoleResponse)(nil), "lbm.fbridge.v1.MsgSuggestRoleResponse")
 	proto.RegisterType((*MsgAddVoteForRole)(nil), "lbm.fbridge.v1.MsgAddVoteForRole")
@@ -1101,59 +1214,64 @@ func init() {
 func init() { proto.RegisterFile("lbm/fbridge/v1/tx.proto", fileDescriptor_54a336bc5ea063bb) }
 
 var fileDescriptor_54a336bc5ea063bb = []byte{
-	// 823 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xdd, 0x8e, 0xdb, 0x44,
-	0x14, 0x8e, 0x49, 0x1a, 0x36, 0x27, 0x4b, 0x5a, 0x86, 0x6d, 0x36, 0x75, 0xd3, 0x64, 0x31, 0x7f,
-	0x4b, 0x25, 0x92, 0x36, 0x20, 0x21, 0x71, 0x47, 0x2a, 0x55, 0xb4, 0xc2, 0x6a, 0xe5, 0xa5, 0xa8,
-	0xe2, 0x82, 0x6a, 0x12, 0x4f, 0x26, 0x16, 0xb6, 0xc7, 0xcc, 0x4c, 0xa2, 0x54, 0xe2, 0x15, 0x90,
-	0x78, 0x18, 0x1e, 0xa2, 0x97, 0xe5, 0x0e, 0x71, 0x51, 0xa1, 0xdd, 0x17, 0x41, 0x9e, 0x9d, 0xcc,
-	0xda, 0x5e, 0x27, 0xe9, 0x8a, 0xbb, 0x19, 0x7f, 0xdf, 0xf9, 0xce, 0x77, 0x3c, 0x73, 0x8e, 0x0d,
-	0x87, 0xe1, 0x24, 0x1a, 0xce, 0x26, 0x3c, 0xf0, 0x29, 0x19, 0x2e, 0xef, 0x0f, 0xe5, 0x6a, 0x90,
-	0x70, 0x26, 0x19, 0x6a, 0x85, 0x93, 0x68, 0xa0, 0x81, 0xc1, 0xf2, 0xbe, 0x7d, 0x40, 0x19, 0x65,
-	0x0a, 0x1a, 0xa6, 0xab, 0x73, 0x96, 0xdd, 0x2d, 0x84, 0xaf, 0x03, 0x14, 0xea, 0x10, 0xb8, 0xee,
-	0x0a, 0xfa, 0x2c, 0xf1, 0xb1, 0x24, 0x4f, 0x31, 0xc7, 0x91, 0x40, 0x5d, 0x68, 0xe0, 0x85, 0x9c,
-	0x33, 0x1e, 0xc8, 0x97, 0x1d, 0xeb, 0xc8, 0x3a, 0x6e, 0x78, 0x17, 0x0f, 0xd0, 0x57, 0x50, 0x4f,
-	0x14, 0xaf, 0xf3, 0xce, 0x91, 0x75, 0xdc, 0x1c, 0xb5, 0x07, 0x79, 0x17, 0x83, 0x73, 0x95, 0x71,
-	0xed, 0xd5, 0x9b, 0x7e, 0xc5, 0xd3, 0x5c, 0xe7, 0x16, 0x1c, 0x16, 0xd2, 0x78, 0x44, 0x24, 0x2c,
-	0x16, 0xc4, 0xf9, 0xdd, 0x82, 0xa6, 0x2b, 0xe8, 0x0f, 0x1c, 0xc7, 0x62, 0x46, 0x38, 0x6a, 0x43,
-	0x5d, 0x90, 0xd8, 0x27, 0x5c, 0xe7, 0xd6, 0x3b, 0x64, 0xc3, 0x1e, 0x27, 0x53, 0x12, 0x2c, 0x09,
-	0x57, 0xa9, 0x1b, 0x9e, 0xd9, 0xa3, 0xc7, 0x50, 0xc7, 0x11, 0x5b, 0xc4, 0xb2, 0x53, 0x4d, 0x91,
-	0xf1, 0x28, 0x4d, 0xfe, 0xcf, 0x9b, 0xfe, 0x5d, 0x1a, 0xc8, 0xf9, 0x62, 0x32, 0x98, 0xb2, 0x68,
-	0xf8, 0x30, 0x88, 0xc5, 0x74, 0x1e, 0xe0, 0xe1, 0x4c, 0x2f, 0xbe, 0x10, 0xfe, 0x2f, 0x43, 0xf9,
-	0x32, 0x21, 0x62, 0xf0, 0x28, 0x96, 0x9e, 0x56, 0x70, 0x6e, 0xc2, 0x07, 0x19, 0x3b, 0xc6, 0xe6,
-	0x9f, 0x16, 0xec, 0xbb, 0x82, 0x3e, 0xe5, 0x6c, 0x19, 0x88, 0x80, 0xc5, 0x08, 0x41, 0x6d, 0xc6,
-	0x59, 0xa4, 0x5d, 0xaa, 0x35, 0xba, 0x01, 0x55, 0x41, 0x7e, 0x55, 0xf6, 0x6a, 0x5e, 0xba, 0xcc,
-	0x54, 0x53, 0xdd, 0x58, 0x4d, 0x6d, 0x63, 0x35, 0xd7, 0xfe, 0x77, 0x35, 0x6d, 0x38, 0xc8, 0xba,
-	0x36, 0xe5, 0x7c, 0xad, 0xce, 0xfd, 0x3b, 0x16, 0xfa, 0xe6, 0xc5, 0xbf, 0x55, 0x41, 0xfa, 0x24,
-	0xb3, 0x81, 0x46, 0xf3, 0x1b, 0x40, 0xae, 0xa0, 0x1e, 0x09, 0x09, 0x16, 0xe4, 0x8a, 0xb2, 0x5d,
-	0xb0, 0x2f, 0xc7, 0x5e, 0x52, 0x8e, 0xd8, 0x92, 0x5c, 0xf1, 0x04, 0x8c, 0x72, 0x2e, 0xd6, 0x28,
-	0x8f, 0xe1, 0x3d, 0x57, 0xd0, 0x07, 0x21, 0x0e, 0xa2, 0x31, 0x96, 0xd3, 0x79, 0xa9, 0xe8, 0x1d,
-	0x80, 0x08, 0xaf, 0x5e, 0x4c, 0x53, 0x96, 0xd0, 0xda, 0x8d, 0x08, 0xaf, 0x54, 0x98, 0x70, 0x0e,
-	0xe1, 0x66, 0x4e, 0xc3, 0x88, 0xdf, 0x83, 0xbd, 0x35, 0xf0, 0x96, 0x66, 0x11, 0xdc, 0x58, 0x47,
-	0x18, 0x95, 0x19, 0xb4, 0x5c, 0x41, 0x4f, 0x16, 0x94, 0x12, 0x21, 0x3d, 0x16, 0x92, 0x52, 0xad,
-	0x36, 0xd4, 0x25, 0xe6, 0x94, 0x48, 0xdd, 0x1c, 0x7a, 0x87, 0x8e, 0xa1, 0xc6, 0x59, 0x48, 0xd4,
-	0xf5, 0x6b, 0x8d, 0x0e, 0x8a, 0xdd, 0x9a, 0xea, 0x79, 0x8a, 0xe1, 0x74, 0xa0, 0x9d, 0xcf, 0x63,
-	0x1c, 0xfc, 0x06, 0xef, 0xbb, 0x82, 0x7e, 0xeb, 0xfb, 0x3f, 0x32, 0x49, 0x1e, 0x32, 0xbe, 0xd1,
-	0x44, 0x1f, 0x9a, 0x09, 0x67, 0x09, 0x13, 0x38, 0x7c, 0x11, 0xf8, 0xba, 0x30, 0x58, 0x3f, 0x7a,
-	0xe4, 0xa3, 0x11, 0xd4, 0x59, 0x22, 0x03, 0x16, 0x6b, 0x3f, 0x76, 0xd1, 0x4f, 0x9a, 0xe1, 0x89,
-	0x62, 0x78, 0x9a, 0xe9, 0xdc, 0x86, 0x5b, 0x97, 0xb2, 0x67, 0x5e, 0x4e, 0x7a, 0x33, 0x4e, 0x88,
-	0x1c, 0x2b, 0x89, 0x13, 0x89, 0xe5, 0x42, 0xa4, 0xdd, 0x45, 0x17, 0x98, 0xfb, 0x01, 0x8e, 0xb5,
-	0x3f, 0xb3, 0x4f, 0x07, 0x98, 0x50, 0x2c, 0x65, 0xaf, 0x35, 0xea, 0x16, 0x2d, 0x64, 0x95, 0x3c,
-	0xcd, 0xd5, 0xb7, 0xa8, 0x90, 0x67, 0xed, 0x62, 0xf4, 0xd7, 0xbb, 0x50, 0x75, 0x05, 0x45, 0xcf,
-	0x61, 0x3f, 0x37, 0x4a, 0xfb, 0x45, 0xed, 0xc2, 0x10, 0xb4, 0x3f, 0xdb, 0x41, 0x58, 0x67, 0x40,
-	0xdf, 0xc3, 0x9e, 0xe9, 0xa8, 0xdb, 0x25, 0x41, 0x6b, 0xd0, 0xfe, 0x68, 0x0b, 0x68, 0xd4, 0x9e,
-	0x40, 0xe3, 0xa2, 0x8d, 0xba, 0x25, 0x11, 0x06, 0xb5, 0x3f, 0xde, 0x86, 0x1a, 0xc1, 0xe7, 0xb0,
-	0x9f, 0x9b, 0x25, 0x65, 0x85, 0x67, 0x09, 0xa5, 0x85, 0x97, 0x0d, 0x15, 0x84, 0xe1, 0x7a, 0x71,
-	0xa2, 0x38, 0x25, 0xb1, 0x05, 0x8e, 0x7d, 0x77, 0x37, 0x27, 0x9f, 0x22, 0x3f, 0x5a, 0xca, 0x53,
-	0xe4, 0x38, 0x1b, 0x52, 0x94, 0x8e, 0x19, 0xe4, 0x01, 0x64, 0x66, 0xcc, 0x9d, 0x92, 0xc8, 0x0b,
-	0xd8, 0xfe, 0x64, 0x2b, 0x6c, 0x34, 0x1f, 0xc0, 0xb5, 0xf3, 0xd1, 0xd2, 0xd9, 0xc4, 0xb7, 0x8f,
-	0x36, 0x21, 0x46, 0xe4, 0x19, 0x34, 0xb3, 0x93, 0xa5, 0x57, 0x12, 0x90, 0xc1, 0xed, 0x4f, 0xb7,
-	0xe3, 0x46, 0xf6, 0x67, 0x68, 0x15, 0xc6, 0xc5, 0x87, 0x25, 0x91, 0x79, 0x8a, 0xfd, 0xf9, 0x4e,
-	0x4a, 0xf6, 0xc8, 0x8a, 0x3d, 0x5f, 0x76, 0x64, 0x05, 0x4e, 0xe9, 0x91, 0x6d, 0xe8, 0xe9, 0xf1,
-	0xe3, 0x57, 0xa7, 0x3d, 0xeb, 0xf5, 0x69, 0xcf, 0xfa, 0xf7, 0xb4, 0x67, 0xfd, 0x71, 0xd6, 0xab,
-	0xbc, 0x3e, 0xeb, 0x55, 0xfe, 0x3e, 0xeb, 0x55, 0x7e, 0xba, 0xb7, 0xf3, 0x3b, 0xbc, 0x32, 0x3f,
-	0x5c, 0xea, 0x8b, 0x3c, 0xa9, 0xab, 0x9f, 0xad, 0x2f, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x99,
-	0x66, 0xc5, 0x96, 0xcb, 0x09, 0x00, 0x00,
+	// 898 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0x8e, 0xb1, 0x63, 0xc5, 0x27, 0xc1, 0x2d, 0x43, 0x70, 0xdc, 0x8d, 0xeb, 0x04, 0xf3, 0x17,
+	0x2a, 0x61, 0xb7, 0x06, 0xa9, 0x12, 0x77, 0xb8, 0x55, 0x45, 0x2b, 0xac, 0x56, 0x1b, 0x8a, 0x2a,
+	0x90, 0xa8, 0xc6, 0xde, 0xf1, 0x64, 0x85, 0x77, 0x67, 0x99, 0x19, 0x5b, 0xa9, 0xc4, 0x43, 0xf0,
+	0x0e, 0xdc, 0xf0, 0x28, 0x15, 0x57, 0xbd, 0x44, 0x5c, 0x54, 0x28, 0x79, 0x11, 0xb4, 0xb3, 0xb3,
+	0x93, 0xdd, 0xcd, 0xac, 0xdd, 0xa0, 0xde, 0xcd, 0xf8, 0x7c, 0xe7, 0x3b, 0xdf, 0x9c, 0x39, 0xf3,
+	0x79, 0x61, 0x6f, 0x3e, 0x09, 0x06, 0xb3, 0x09, 0xf7, 0x3d, 0x4a, 0x06, 0xcb, 0x3b, 0x03, 0x79,
+	0xda, 0x8f, 0x38, 0x93, 0x0c, 0x35, 0xe7, 0x93, 0xa0, 0xaf, 0x03, 0xfd, 0xe5, 0x1d, 0x67, 0x97,
+	0x32, 0xca, 0x54, 0x68, 0x10, 0xaf, 0x12, 0x94, 0xd3, 0x29, 0xa4, 0xa7, 0x09, 0x2a, 0xda, 0x23,
+	0x70, 0x6d, 0x2c, 0xe8, 0xd3, 0xc8, 0xc3, 0x92, 0x3c, 0xc1, 0x1c, 0x07, 0x02, 0x75, 0xa0, 0x81,
+	0x17, 0xf2, 0x84, 0x71, 0x5f, 0xbe, 0x68, 0x57, 0x0e, 0x2b, 0x47, 0x0d, 0xf7, 0xe2, 0x07, 0xf4,
+	0x15, 0xd4, 0x23, 0x85, 0x6b, 0xbf, 0x73, 0x58, 0x39, 0xda, 0x1e, 0xb6, 0xfa, 0x79, 0x15, 0xfd,
+	0x84, 0x65, 0x54, 0x7b, 0xf9, 0xfa, 0x60, 0xc3, 0xd5, 0xd8, 0xde, 0x0d, 0xd8, 0x2b, 0x94, 0x71,
+	0x89, 0x88, 0x58, 0x28, 0x48, 0xef, 0x8f, 0x0a, 0x6c, 0x8f, 0x05, 0xfd, 0x9e, 0xe3, 0x50, 0xcc,
+	0x08, 0x47, 0x2d, 0xa8, 0x0b, 0x12, 0x7a, 0x84, 0xeb, 0xda, 0x7a, 0x87, 0x1c, 0xd8, 0xe2, 0x64,
+	0x4a, 0xfc, 0x25, 0xe1, 0xaa, 0x74, 0xc3, 0x35, 0x7b, 0xf4, 0x08, 0xea, 0x38, 0x60, 0x8b, 0x50,
+	0xb6, 0xab, 0x71, 0x64, 0x34, 0x8c, 0x8b, 0xff, 0xf3, 0xfa, 0xe0, 0x16, 0xf5, 0xe5, 0xc9, 0x62,
+	0xd2, 0x9f, 0xb2, 0x60, 0xf0, 0xc0, 0x0f, 0xc5, 0xf4, 0xc4, 0xc7, 0x83, 0x99, 0x5e, 0x7c, 0x21,
+	0xbc, 0x5f, 0x06, 0xf2, 0x45, 0x44, 0x44, 0xff, 0x61, 0x28, 0x5d, 0xcd, 0x80, 0x76, 0x61, 0xd3,
+	0x23, 0x21, 0x0b, 0xda, 0x35, 0x55, 0x24, 0xd9, 0xf4, 0x7e, 0x82, 0xf7, 0x33, 0x22, 0x53, 0xf1,
+	0xe8, 0x3e, 0x54, 0x67, 0x84, 0x24, 0x4a, 0xff, 0x57, 0xd5, 0x38, 0xbd, 0xf7, 0x57, 0x05, 0x76,
+	0xc6, 0x82, 0x3e, 0xe1, 0x6c, 0xe9, 0x0b, 0x9f, 0x85, 0x08, 0x41, 0x6d, 0xc6, 0x59, 0xa0, 0x3b,
+	0xa0, 0xd6, 0xe8, 0x3a, 0x54, 0x05, 0xf9, 0x55, 0x1d, 0xbd, 0xe6, 0xc6, 0xcb, 0x4c, 0xa7, 0xaa,
+	0xa5, 0x9d, 0xaa, 0x95, 0x76, 0x6a, 0xf3, 0xed, 0x75, 0xaa, 0x9e, 0xed, 0x54, 0x0b, 0x76, 0xb3,
+	0x67, 0x31, 0xf7, 0x7c, 0x57, 0x4d, 0xda, 0xb7, 0x6c, 0xee, 0x99, 0xab, 0x7e, 0xa3, 0x63, 0xea,
+	0xd9, 0xc9, 0x26, 0x1a, 0xce, 0xaf, 0x01, 0x8d, 0x05, 0x75, 0xc9, 0x9c, 0x60, 0x41, 0xae, 0x48,
+	0xdb, 0x01, 0xe7, 0x72, 0xee, 0x25, 0xe6, 0x80, 0x2d, 0xc9, 0x15, 0xef, 0xc5, 0x30, 0xe7, 0x72,
+	0x0d, 0xf3, 0x08, 0xde, 0x1d, 0x0b, 0x7a, 0x6f, 0x8e, 0xfd, 0x60, 0x84, 0xe5, 0xf4, 0xc4, 0x4a,
+	0x7a, 0x13, 0x20, 0xc0, 0xa7, 0xcf, 0xa7, 0x31, 0x4a, 0x68, 0xee, 0x46, 0x80, 0x4f, 0x55, 0x9a,
+	0xe8, 0xed, 0xc1, 0x07, 0x39, 0x0e, 0x43, 0x7e, 0x1b, 0xb6, 0xd2, 0xc0, 0x1b, 0x8a, 0x45, 0x70,
+	0x3d, 0xcd, 0x30, 0x2c, 0x14, 0x9a, 0xe6, 0xb5, 0xde, 0x8f, 0x2f, 0x75, 0x8d, 0x27, 0xdc, 0x4d,
+	0x07, 0x21, 0xb1, 0x84, 0xfd, 0xa2, 0x25, 0x8c, 0xd4, 0x4a, 0x31, 0x69, 0x5f, 0xd0, 0xb3, 0xd2,
+	0x86, 0x56, 0xbe, 0x90, 0x91, 0x30, 0x53, 0x12, 0x8e, 0x17, 0x94, 0x12, 0x21, 0x5d, 0x36, 0x27,
+	0xd6, 0xe3, 0xb4, 0xa0, 0x2e, 0x31, 0xa7, 0x44, 0x6a, 0x47, 0xd0, 0x3b, 0x74, 0x04, 0x35, 0xce,
+	0xe6, 0x44, 0xbd, 0x8b, 0xe6, 0x70, 0xb7, 0xa8, 0x27, 0xe6, 0x73, 0x15, 0x42, 0x2b, 0xc8, 0xd4,
+	0x31, 0x0a, 0x7e, 0x83, 0xf7, 0xc6, 0x82, 0x7e, 0xe3, 0x79, 0x3f, 0x30, 0x49, 0x1e, 0x30, 0x5e,
+	0x2a, 0xe2, 0x00, 0xb6, 0x23, 0xce, 0x22, 0x26, 0xf0, 0xfc, 0xb9, 0xef, 0xe9, 0xde, 0x42, 0xfa,
+	0xd3, 0x43, 0x0f, 0x0d, 0xa1, 0xce, 0x22, 0xe9, 0xb3, 0x50, 0xeb, 0x71, 0x8a, 0x7a, 0xe2, 0x0a,
+	0x8f, 0x15, 0xc2, 0xd5, 0xc8, 0xde, 0x3e, 0xdc, 0xb8, 0x54, 0x3d, 0xd3, 0x9c, 0x78, 0x38, 0x8f,
+	0x89, 0x4c, 0x1a, 0x7b, 0x2c, 0xb1, 0x5c, 0x88, 0xf8, 0xd9, 0xd3, 0x05, 0xe6, 0x9e, 0x8f, 0x43,
+	0xad, 0xcf, 0xec, 0x63, 0xd7, 0x16, 0x0a, 0xa5, 0xe4, 0x35, 0x87, 0x1d, 0xfb, 0x15, 0x25, 0x4c,
+	0xae, 0xc6, 0xea, 0x41, 0x2e, 0xd4, 0x49, 0x55, 0x0c, 0xff, 0xdc, 0x82, 0xea, 0x58, 0x50, 0xf4,
+	0x0c, 0x76, 0x72, 0xff, 0x1f, 0x07, 0x45, 0xee, 0x82, 0xf3, 0x3b, 0x9f, 0xad, 0x01, 0x18, 0x77,
+	0xfd, 0x0e, 0xb6, 0xcc, 0xa3, 0xde, 0xb7, 0x24, 0xa5, 0x41, 0xe7, 0xa3, 0x15, 0x41, 0xc3, 0xf6,
+	0x18, 0x1a, 0x17, 0x2f, 0xb9, 0x63, 0xc9, 0x30, 0x51, 0xe7, 0xe3, 0x55, 0x51, 0x43, 0xf8, 0x0c,
+	0x76, 0x72, 0x76, 0x66, 0x3b, 0x78, 0x16, 0x60, 0x3d, 0xb8, 0xcd, 0xd7, 0x10, 0x86, 0x6b, 0x45,
+	0x53, 0xeb, 0x59, 0x72, 0x0b, 0x18, 0xe7, 0xd6, 0x7a, 0x4c, 0xbe, 0x44, 0xde, 0xdd, 0xec, 0x25,
+	0x72, 0x98, 0x92, 0x12, 0x56, 0xa7, 0x43, 0x2e, 0x40, 0xc6, 0xe6, 0x6e, 0x5a, 0x32, 0x2f, 0xc2,
+	0xce, 0x27, 0x2b, 0xc3, 0x86, 0xf3, 0x1e, 0x6c, 0x26, 0xee, 0xd6, 0x2e, 0xc3, 0x3b, 0x87, 0x65,
+	0x11, 0x43, 0xf2, 0x14, 0xb6, 0xb3, 0xe6, 0xd6, 0x2d, 0x9d, 0x47, 0x15, 0x77, 0x3e, 0x5d, 0x1d,
+	0xcf, 0xd2, 0x66, 0x0d, 0xcb, 0x46, 0x9b, 0x89, 0x5b, 0x69, 0x2d, 0x46, 0x84, 0x7e, 0x86, 0x66,
+	0xc1, 0x85, 0x3e, 0xb4, 0x64, 0xe6, 0x21, 0xce, 0xe7, 0x6b, 0x21, 0xd9, 0x49, 0x28, 0x5a, 0x89,
+	0x6d, 0x12, 0x0a, 0x18, 0xeb, 0x24, 0x94, 0x58, 0xc5, 0xe8, 0xd1, 0xcb, 0xb3, 0x6e, 0xe5, 0xd5,
+	0x59, 0xb7, 0xf2, 0xef, 0x59, 0xb7, 0xf2, 0xfb, 0x79, 0x77, 0xe3, 0xd5, 0x79, 0x77, 0xe3, 0xef,
+	0xf3, 0xee, 0xc6, 0x8f, 0xb7, 0xd7, 0x7e, 0x77, 0x9c, 0x9a, 0x8f, 0x57, 0xf5, 0x05, 0x32, 0xa9,
+	0xab, 0x0f, 0xd7, 0x2f, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xe4, 0xbc, 0xbf, 0x2a, 0x17, 0x0b,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1184,6 +1302,8 @@ type MsgClient interface {
 	ClaimBatch(ctx context.Context, in *MsgClaimBatch, opts ...grpc.CallOption) (*MsgClaimBatchResponse, error)
 	// Claim processes the claiming of a provision with a specific sequence number
 	Claim(ctx context.Context, in *MsgClaim, opts ...grpc.CallOption) (*MsgClaimResponse, error)
+	// UpdateDenom registers or updates a bridgeable denom.
+	UpdateDenom(ctx context.Context, in *MsgUpdateDenom, opts ...grpc.CallOption) (*MsgUpdateDenomResponse, error)
 	// SuggestRole suggests updating the role of an address in the bridge module.
 	// The role can be one of the following: guardian, operator, judge.
 	// The proposal will be passed only with the consent of +2/3 Guardian members.
@@ -1275,6 +1395,15 @@ func (c *msgClient) Claim(ctx context.Context, in *MsgClaim, opts ...grpc.CallOp
 	return out, nil
 }
 
+func (c *msgClient) UpdateDenom(ctx context.Context, in *MsgUpdateDenom, opts ...grpc.CallOption) (*MsgUpdateDenomResponse, error) {
+	out := new(MsgUpdateDenomResponse)
+	err := c.cc.Invoke(ctx, "/lbm.fbridge.v1.Msg/UpdateDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SuggestRole(ctx context.Context, in *MsgSuggestRole, opts ...grpc.CallOption) (*MsgSuggestRoleResponse, error) {
 	out := new(MsgSuggestRoleResponse)
 	err := c.cc.Invoke(ctx, "/lbm.fbridge.v1.Msg/SuggestRole", in, out, opts...)
@@ -1320,6 +1449,8 @@ type MsgServer interface {
 	ClaimBatch(context.Context, *MsgClaimBatch) (*MsgClaimBatchResponse, error)
 	// Claim processes the claiming of a provision with a specific sequence number
 	Claim(context.Context, *MsgClaim) (*MsgClaimResponse, error)
+	// UpdateDenom registers or updates a bridgeable denom.
+	UpdateDenom(context.Context, *MsgUpdateDenom) (*MsgUpdateDenomResponse, error)
 	// SuggestRole suggests updating the role of an address in the bridge module.
 	// The role can be one of the following: guardian, operator, judge.
 	// The proposal will be passed only with the consent of +2/3 Guardian members.
@@ -1359,6 +1490,9 @@ func (*UnimplementedMsgServer) ClaimBatch(ctx context.Context, req *MsgClaimBatc
 func (*UnimplementedMsgServer) Claim(ctx context.Context, req *MsgClaim) (*MsgClaimResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Claim not implemented")
 }
+func (*UnimplementedMsgServer) UpdateDenom(ctx context.Context, req *MsgUpdateDenom) (*MsgUpdateDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDenom not implemented")
+}
 func (*UnimplementedMsgServer) SuggestRole(ctx context.Context, req *MsgSuggestRole) (*MsgSuggestRoleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SuggestRole not implemented")
 }
@@ -1517,6 +1651,24 @@ func _Msg_Claim_Handler(srv interface{}, ctx context.Context, dec func(interface
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDenom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.fbridge.v1.Msg/UpdateDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDenom(ctx, req.(*MsgUpdateDenom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SuggestRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSuggestRole)
 	if err := dec(in); err != nil {
@@ -1607,6 +1759,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Claim",
 			Handler:    _Msg_Claim_Handler,
 		},
+		{
+			MethodName: "UpdateDenom",
+			Handler:    _Msg_UpdateDenom_Handler,
+		},
 		{
 			MethodName: "SuggestRole",
 			Handler:    _Msg_SuggestRole_Handler,
@@ -1707,6 +1863,13 @@ func (m *MsgTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
 	{
 		size := m.Amount.Size()
 		i -= size
@@ -1754,6 +1917,16 @@ func (m *MsgTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.Fee.Size()
+		i -= size
+		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
@@ -1777,6 +1950,13 @@ func (m *MsgProvision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x32
+	}
 	{
 		size := m.Amount.Size()
 		i -= size
@@ -2129,6 +2309,69 @@ func (m *MsgClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Denom.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgSuggestRole) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2366,6 +2609,10 @@ func (m *MsgTransfer) Size() (n int) {
 	}
 	l = m.Amount.Size()
 	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2375,6 +2622,8 @@ func (m *MsgTransferResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = m.Fee.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
@@ -2401,6 +2650,10 @@ func (m *MsgProvision) Size() (n int) {
 	}
 	l = m.Amount.Size()
 	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2538,6 +2791,30 @@ func (m *MsgClaimResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Denom.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgSuggestRole) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2918,6 +3195,38 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2968,6 +3277,40 @@ func (m *MsgTransferResponse) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: MsgTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3167,6 +3510,38 @@ func (m *MsgProvision) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3993,6 +4368,171 @@ func (m *MsgClaimResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Denom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgSuggestRole) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
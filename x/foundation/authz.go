@@ -46,14 +46,35 @@ func (a ReceiveFromTreasuryAuthorization) MsgTypeURL() string {
 }
 
 func (a ReceiveFromTreasuryAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (AcceptResponse, error) {
-	_, ok := msg.(*MsgWithdrawFromTreasury)
+	mWithdraw, ok := msg.(*MsgWithdrawFromTreasury)
 	if !ok {
 		return AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
 	}
 
-	return AcceptResponse{Accept: true}, nil
+	// no limit
+	if a.ReceiveLimit.Empty() {
+		return AcceptResponse{Accept: true}, nil
+	}
+
+	limitLeft, isNegative := a.ReceiveLimit.SafeSub(mWithdraw.Amount)
+	if isNegative {
+		return AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf("requested amount is more than receive limit %s", a.ReceiveLimit)
+	}
+	if limitLeft.IsZero() {
+		return AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return AcceptResponse{Accept: true, Updated: &ReceiveFromTreasuryAuthorization{ReceiveLimit: limitLeft}}, nil
 }
 
 func (a ReceiveFromTreasuryAuthorization) ValidateBasic() error {
+	if a.ReceiveLimit.Empty() {
+		return nil
+	}
+
+	if err := a.ReceiveLimit.Validate(); err != nil {
+		return sdkerrors.ErrInvalidCoins.Wrap(err.Error())
+	}
+
 	return nil
 }
@@ -5,6 +5,8 @@ package foundation
 
 import (
 	fmt "fmt"
+	github_com_Finschia_finschia_sdk_types "github.com/Finschia/finschia-sdk/types"
+	types "github.com/Finschia/finschia-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/regen-network/cosmos-proto"
@@ -27,6 +29,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // ReceiveFromTreasuryAuthorization allows the grantee to receive coins
 // up to receive_limit from the treasury.
 type ReceiveFromTreasuryAuthorization struct {
+	// receive_limit is the remaining amount the grantee can receive.
+	// an empty receive_limit means no limit.
+	ReceiveLimit github_com_Finschia_finschia_sdk_types.Coins `protobuf:"bytes,1,rep,name=receive_limit,json=receiveLimit,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coins" json:"receive_limit"`
 }
 
 func (m *ReceiveFromTreasuryAuthorization) Reset()         { *m = ReceiveFromTreasuryAuthorization{} }
@@ -62,6 +67,13 @@ func (m *ReceiveFromTreasuryAuthorization) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ReceiveFromTreasuryAuthorization proto.InternalMessageInfo
 
+func (m *ReceiveFromTreasuryAuthorization) GetReceiveLimit() github_com_Finschia_finschia_sdk_types.Coins {
+	if m != nil {
+		return m.ReceiveLimit
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ReceiveFromTreasuryAuthorization)(nil), "lbm.foundation.v1.ReceiveFromTreasuryAuthorization")
 }
@@ -69,20 +81,26 @@ func init() {
 func init() { proto.RegisterFile("lbm/foundation/v1/authz.proto", fileDescriptor_8bdb89c90659aa0e) }
 
 var fileDescriptor_8bdb89c90659aa0e = []byte{
-	// 204 bytes of a gzipped FileDescriptorProto
+	// 291 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcd, 0x49, 0xca, 0xd5,
 	0x4f, 0xcb, 0x2f, 0xcd, 0x4b, 0x49, 0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x2f, 0x33, 0xd4, 0x4f, 0x2c,
 	0x2d, 0xc9, 0xa8, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0xcc, 0x49, 0xca, 0xd5, 0x43,
 	0x48, 0xeb, 0x95, 0x19, 0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x65, 0xf5, 0x41, 0x2c, 0x88,
-	0x42, 0x29, 0xc9, 0xe4, 0xfc, 0xe2, 0xdc, 0xfc, 0xe2, 0x78, 0x88, 0x04, 0x84, 0x03, 0x91, 0x52,
-	0x4a, 0xe6, 0x52, 0x08, 0x4a, 0x4d, 0x4e, 0xcd, 0x2c, 0x4b, 0x75, 0x2b, 0xca, 0xcf, 0x0d, 0x29,
-	0x4a, 0x4d, 0x2c, 0x2e, 0x2d, 0xaa, 0x74, 0x2c, 0x2d, 0xc9, 0xc8, 0x2f, 0xca, 0xac, 0x02, 0x9b,
-	0x6b, 0x65, 0x7f, 0x69, 0x8b, 0xae, 0x75, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e,
-	0xae, 0xbe, 0x5b, 0x66, 0x5e, 0x71, 0x72, 0x46, 0x66, 0xa2, 0x7e, 0x1a, 0x94, 0xa1, 0x5b, 0x9c,
-	0x92, 0xad, 0x5f, 0x81, 0xe4, 0x52, 0x3d, 0x14, 0x03, 0x9c, 0xdc, 0x4f, 0x3c, 0x92, 0x63, 0xbc,
-	0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63,
-	0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x97, 0x24, 0x63, 0x93, 0xd8, 0xc0, 0x8e, 0x36, 0x06, 0x04,
-	0x00, 0x00, 0xff, 0xff, 0x3d, 0x24, 0x13, 0x54, 0x19, 0x01, 0x00, 0x00,
+	0x42, 0x29, 0xc9, 0xe4, 0xfc, 0xe2, 0xdc, 0xfc, 0xe2, 0x78, 0x88, 0x04, 0x84, 0x03, 0x95, 0x92,
+	0x83, 0xf0, 0xf4, 0x93, 0x12, 0x8b, 0x53, 0xf5, 0xcb, 0x0c, 0x93, 0x52, 0x4b, 0x12, 0x0d, 0xf5,
+	0x93, 0xf3, 0x33, 0xf3, 0x20, 0xf2, 0x4a, 0x37, 0x19, 0xb9, 0x14, 0x82, 0x52, 0x93, 0x53, 0x33,
+	0xcb, 0x52, 0xdd, 0x8a, 0xf2, 0x73, 0x43, 0x8a, 0x52, 0x13, 0x8b, 0x4b, 0x8b, 0x2a, 0x1d, 0x4b,
+	0x4b, 0x32, 0xf2, 0x8b, 0x32, 0xab, 0xc0, 0x16, 0x0b, 0x95, 0x70, 0xf1, 0x16, 0x41, 0xd4, 0xc4,
+	0xe7, 0x64, 0xe6, 0x66, 0x96, 0x48, 0x30, 0x2a, 0x30, 0x6b, 0x70, 0x1b, 0x49, 0xea, 0x41, 0xad,
+	0x02, 0x19, 0xae, 0x07, 0x35, 0x5c, 0xcf, 0x39, 0x3f, 0x33, 0xcf, 0xc9, 0xe4, 0xc4, 0x3d, 0x79,
+	0x86, 0x55, 0xf7, 0xe5, 0x75, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5,
+	0xdd, 0x32, 0xf3, 0x8a, 0x93, 0x33, 0x32, 0x13, 0xf5, 0xd3, 0xa0, 0x0c, 0xdd, 0xe2, 0x94, 0x6c,
+	0xfd, 0x92, 0xca, 0x82, 0xd4, 0x62, 0xb0, 0xa6, 0xe2, 0x20, 0x1e, 0xa8, 0x2d, 0x3e, 0x20, 0x4b,
+	0xac, 0xec, 0x2f, 0x6d, 0xd1, 0xb5, 0x26, 0xa8, 0xbf, 0x02, 0x29, 0x00, 0xf5, 0x50, 0x9c, 0xed,
+	0xe4, 0x7e, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78,
+	0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xba, 0x24, 0x19, 0x9b,
+	0xc4, 0x06, 0x0e, 0x2b, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb1, 0x34, 0xce, 0x4d, 0xb0,
+	0x01, 0x00, 0x00,
 }
 
 func (m *ReceiveFromTreasuryAuthorization) Marshal() (dAtA []byte, err error) {
@@ -105,6 +123,20 @@ func (m *ReceiveFromTreasuryAuthorization) MarshalToSizedBuffer(dAtA []byte) (in
 	_ = i
 	var l int
 	_ = l
+	if len(m.ReceiveLimit) > 0 {
+		for iNdEx := len(m.ReceiveLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReceiveLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -125,6 +157,12 @@ func (m *ReceiveFromTreasuryAuthorization) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if len(m.ReceiveLimit) > 0 {
+		for _, e := range m.ReceiveLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -163,6 +201,40 @@ func (m *ReceiveFromTreasuryAuthorization) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: ReceiveFromTreasuryAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiveLimit = append(m.ReceiveLimit, types.Coin{})
+			if err := m.ReceiveLimit[len(m.ReceiveLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthz(dAtA[iNdEx:])
@@ -10,10 +10,17 @@ import (
 )
 
 func TestReceiveFromTreasuryAuthorization(t *testing.T) {
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	}
+
 	testCases := map[string]struct {
-		msg    sdk.Msg
-		valid  bool
-		accept bool
+		limit   sdk.Coins
+		msg     sdk.Msg
+		valid   bool
+		accept  bool
+		delete  bool
+		updated foundation.Authorization
 	}{
 		"valid": {
 			msg:    &foundation.MsgWithdrawFromTreasury{},
@@ -23,11 +30,39 @@ func TestReceiveFromTreasuryAuthorization(t *testing.T) {
 		"msg mismatch": {
 			msg: &foundation.MsgVote{},
 		},
+		"valid (within the limit)": {
+			limit: coins(10),
+			msg: &foundation.MsgWithdrawFromTreasury{
+				Amount: coins(3),
+			},
+			valid:  true,
+			accept: true,
+			updated: &foundation.ReceiveFromTreasuryAuthorization{
+				ReceiveLimit: coins(7),
+			},
+		},
+		"valid (exhaust the limit)": {
+			limit: coins(10),
+			msg: &foundation.MsgWithdrawFromTreasury{
+				Amount: coins(10),
+			},
+			valid:  true,
+			accept: true,
+			delete: true,
+		},
+		"exceeds the limit": {
+			limit: coins(10),
+			msg: &foundation.MsgWithdrawFromTreasury{
+				Amount: coins(11),
+			},
+		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			authorization := &foundation.ReceiveFromTreasuryAuthorization{}
+			authorization := &foundation.ReceiveFromTreasuryAuthorization{
+				ReceiveLimit: tc.limit,
+			}
 
 			resp, err := authorization.Accept(sdk.Context{}, tc.msg)
 			if !tc.valid {
@@ -37,6 +72,8 @@ func TestReceiveFromTreasuryAuthorization(t *testing.T) {
 			require.NoError(t, err)
 
 			require.Equal(t, tc.accept, resp.Accept)
+			require.Equal(t, tc.delete, resp.Delete)
+			require.Equal(t, tc.updated, resp.Updated)
 		})
 	}
 }
@@ -22,14 +22,20 @@ func NewQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		NewQueryCmdParams(),
 		NewQueryCmdTreasury(),
+		NewQueryCmdTreasuryStream(),
+		NewQueryCmdTreasuryStreams(),
+		NewQueryCmdTaxHistory(),
 		NewQueryCmdFoundationInfo(),
 		NewQueryCmdMember(),
+		NewQueryCmdMemberActivity(),
 		NewQueryCmdMembers(),
 		NewQueryCmdProposal(),
 		NewQueryCmdProposals(),
 		NewQueryCmdVote(),
 		NewQueryCmdVotes(),
 		NewQueryCmdTallyResult(),
+		NewQueryCmdDeposit(),
+		NewQueryCmdDeposits(),
 		NewQueryCmdCensorships(),
 		NewQueryCmdGrants(),
 	)
@@ -95,6 +101,110 @@ func NewQueryCmdTreasury() *cobra.Command {
 	return cmd
 }
 
+// NewQueryCmdTreasuryStream returns a treasury stream.
+func NewQueryCmdTreasuryStream() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "treasury-stream [stream-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a treasury stream",
+		Long: `Query a treasury stream including its remaining amount
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := foundation.NewQueryClient(clientCtx)
+
+			streamID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := foundation.QueryTreasuryStreamRequest{StreamId: streamID}
+			res, err := queryClient.TreasuryStream(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewQueryCmdTreasuryStreams returns all treasury streams.
+func NewQueryCmdTreasuryStreams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "treasury-streams",
+		Args:  cobra.NoArgs,
+		Short: "Query all treasury streams",
+		Long: `Query all treasury streams
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := foundation.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := foundation.QueryTreasuryStreamsRequest{Pagination: pageReq}
+			res, err := queryClient.TreasuryStreams(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "treasury-streams")
+	return cmd
+}
+
+// NewQueryCmdTaxHistory returns the distribution of the foundation tax per block.
+func NewQueryCmdTaxHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tax-history",
+		Args:  cobra.NoArgs,
+		Short: "Query the distribution of the foundation tax per block",
+		Long: `Query the distribution of the foundation tax per block
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := foundation.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := foundation.QueryTaxHistoryRequest{Pagination: pageReq}
+			res, err := queryClient.TaxHistory(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "tax history")
+	return cmd
+}
+
 // NewQueryCmdFoundationInfo returns the information of the foundation.
 func NewQueryCmdFoundationInfo() *cobra.Command {
 	cmd := &cobra.Command{
@@ -158,6 +268,40 @@ func NewQueryCmdMember() *cobra.Command {
 	return cmd
 }
 
+// NewQueryCmdMemberActivity returns the activity of a member of the foundation.
+func NewQueryCmdMemberActivity() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "member-activity [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the activity of a foundation member",
+		Long: `Query the participation of a foundation member in the proposals
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := foundation.NewQueryClient(clientCtx)
+
+			address := args[0]
+			if _, err := sdk.AccAddressFromBech32(address); err != nil {
+				return err
+			}
+
+			req := foundation.QueryMemberActivityRequest{Address: address}
+			res, err := queryClient.MemberActivity(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // NewQueryCmdMembers returns the members of the foundation.
 func NewQueryCmdMembers() *cobra.Command {
 	cmd := &cobra.Command{
@@ -357,6 +501,75 @@ func NewQueryCmdTallyResult() *cobra.Command {
 	return cmd
 }
 
+// NewQueryCmdDeposit returns the deposit of a proposal.
+func NewQueryCmdDeposit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the deposit of a proposal",
+		Long: `Query the deposit of a proposal
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := foundation.NewQueryClient(clientCtx)
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := foundation.QueryDepositRequest{ProposalId: proposalID}
+			res, err := queryClient.Deposit(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewQueryCmdDeposits returns the deposits of all proposals.
+func NewQueryCmdDeposits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposits",
+		Args:  cobra.NoArgs,
+		Short: "Query the deposits of all proposals",
+		Long: `Query the deposits of all proposals
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := foundation.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := foundation.QueryDepositsRequest{Pagination: pageReq}
+			res, err := queryClient.Deposits(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "deposits")
+	return cmd
+}
+
 // NewQueryCmdCensorships returns the query censorships command.
 func NewQueryCmdCensorships() *cobra.Command {
 	cmd := &cobra.Command{
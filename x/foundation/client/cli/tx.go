@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -20,8 +21,12 @@ import (
 
 // Proposal flags
 const (
-	FlagExec = "exec"
-	ExecTry  = "try"
+	FlagExec    = "exec"
+	ExecTry     = "try"
+	FlagDeposit = "deposit"
+
+	FlagExecuteAt  = "execute-at"
+	FlagExpiration = "expiration"
 )
 
 func validateGenerateOnly(cmd *cobra.Command) error {
@@ -159,6 +164,8 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		NewTxCmdFundTreasury(),
 		NewTxCmdWithdrawFromTreasury(),
+		NewTxCmdCreateTreasuryStream(),
+		NewTxCmdCancelTreasuryStream(),
 		NewTxCmdUpdateMembers(),
 		NewTxCmdUpdateDecisionPolicy(),
 		NewTxCmdSubmitProposal(),
@@ -249,6 +256,94 @@ func NewTxCmdWithdrawFromTreasury() *cobra.Command {
 	return cmd
 }
 
+func NewTxCmdCreateTreasuryStream() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-treasury-stream [authority] [recipient] [amount] [period] [total]",
+		Args:  cobra.ExactArgs(5),
+		Short: "Create a stream paying from the treasury",
+		Long: `Create a stream paying [amount] to [recipient] from the treasury every [period] until [total] has been paid.
+Zero [period] (e.g. 0s) means the payout happens on every block.
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateGenerateOnly(cmd); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			period, err := time.ParseDuration(args[3])
+			if err != nil {
+				return err
+			}
+
+			total, err := sdk.ParseCoinsNormalized(args[4])
+			if err != nil {
+				return err
+			}
+
+			msg := foundation.MsgCreateTreasuryStream{
+				Authority: args[0],
+				Recipient: args[1],
+				Amount:    amount,
+				Period:    period,
+				Total:     total,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdCancelTreasuryStream() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-treasury-stream [authority] [stream-id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Cancel a treasury stream",
+		Long: `Cancel a treasury stream
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateGenerateOnly(cmd); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			streamID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := foundation.MsgCancelTreasuryStream{
+				Authority: args[0],
+				StreamId:  streamID,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func NewTxCmdUpdateMembers() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "update-members [authority] [members-json]",
@@ -261,17 +356,17 @@ Example of the content of members-json:
 [
   {
     "address": "addr1",
-    "participating": true,
+    "weight": "2",
     "metadata": "some new metadata"
   },
   {
     "address": "addr2",
-    "participating": false,
-    "metadata": "some metadata"
+    "remove": true
   }
 ]
 
-Set a member's participating to false to delete it.
+Set a member's remove to true to delete it.
+The weight of a member must be positive, unless the member is being removed.
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := validateGenerateOnly(cmd); err != nil {
@@ -410,10 +505,32 @@ Example of the content of messages-json:
 			}
 			exec := execFromString(execStr)
 
+			depositStr, err := cmd.Flags().GetString(FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
 			msg := foundation.MsgSubmitProposal{
 				Proposers: proposers,
 				Metadata:  args[0],
 				Exec:      exec,
+				Deposit:   deposit,
+			}
+
+			executeAtStr, err := cmd.Flags().GetString(FlagExecuteAt)
+			if err != nil {
+				return err
+			}
+			if executeAtStr != "" {
+				executeAt, err := time.Parse(time.RFC3339, executeAtStr)
+				if err != nil {
+					return err
+				}
+				msg.ExecuteAt = &executeAt
 			}
 			if err := msg.SetMsgs(messages); err != nil {
 				return err
@@ -427,6 +544,8 @@ Example of the content of messages-json:
 
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.Flags().String(FlagExec, "", "Set to 'try' to try to execute proposal immediately after creation (proposers signatures are considered as Yes votes)")
+	cmd.Flags().String(FlagDeposit, "", "Deposit of the proposal, paid by the first proposer")
+	cmd.Flags().String(FlagExecuteAt, "", "Time in RFC3339 format to execute the proposal automatically once accepted")
 
 	return cmd
 }
@@ -664,8 +783,10 @@ Example of the content of authorization-json:
 {
   "@type": "/lbm.foundation.v1.ReceiveFromTreasuryAuthorization",
   "receive_limit": [
-    "denom": "stake",
-    "amount": "10000"
+    {
+      "denom": "stake",
+      "amount": "10000"
+    }
   ]
 }
 `,
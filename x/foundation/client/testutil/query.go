@@ -31,6 +31,8 @@ func (s *IntegrationTestSuite) TestNewQueryCmdParams() {
 			&foundation.QueryParamsResponse{
 				Params: foundation.Params{
 					FoundationTax: sdk.MustNewDecFromStr("0.2"),
+					MinDeposit:    sdk.Coins{},
+					TaxSplit:      foundation.DefaultTaxSplit(),
 				},
 			},
 		},
@@ -103,6 +105,47 @@ func (s *IntegrationTestSuite) TestNewQueryCmdTreasury() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestNewQueryCmdTaxHistory() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%d", flags.FlagHeight, s.setupHeight),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid query": {
+			[]string{},
+			true,
+		},
+		"wrong number of args": {
+			[]string{
+				"extra",
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewQueryCmdTaxHistory()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var actual foundation.QueryTaxHistoryResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &actual), out.String())
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestNewQueryCmdFoundationInfo() {
 	val := s.network.Validators[0]
 	commonArgs := []string{
@@ -163,6 +206,7 @@ func (s *IntegrationTestSuite) TestNewQueryCmdMember() {
 			true,
 			&foundation.Member{
 				Address:  s.permanentMember.String(),
+				Weight:   sdk.OneDec(),
 				Metadata: "permanent member",
 			},
 		},
@@ -202,6 +246,71 @@ func (s *IntegrationTestSuite) TestNewQueryCmdMember() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestNewQueryCmdMemberActivity() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%d", flags.FlagHeight, s.setupHeight),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	testCases := map[string]struct {
+		args     []string
+		valid    bool
+		expected *foundation.MemberActivity
+	}{
+		"valid query": {
+			[]string{
+				s.permanentMember.String(),
+			},
+			true,
+			&foundation.MemberActivity{
+				Address:             s.permanentMember.String(),
+				LastVotedProposalId: s.proposalID,
+			},
+		},
+		"wrong number of args": {
+			[]string{
+				s.permanentMember.String(),
+				"extra",
+			},
+			false,
+			nil,
+		},
+		"invalid member": {
+			[]string{
+				"",
+			},
+			false,
+			nil,
+		},
+		"not a member": {
+			[]string{
+				s.stranger.String(),
+			},
+			false,
+			nil,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewQueryCmdMemberActivity()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var actual foundation.QueryMemberActivityResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &actual), out.String())
+			s.Require().Equal(*tc.expected, actual.Activity)
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestNewQueryCmdMembers() {
 	val := s.network.Validators[0]
 	commonArgs := []string{
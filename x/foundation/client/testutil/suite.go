@@ -58,6 +58,7 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	// enable foundation tax
 	params := foundation.Params{
 		FoundationTax: sdk.MustNewDecFromStr("0.2"),
+		TaxSplit:      foundation.DefaultTaxSplit(),
 	}
 	foundationData.Params = params
 
@@ -71,10 +72,12 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	foundationData.Members = []foundation.Member{
 		{
 			Address:  s.leavingMember.String(),
+			Weight:   sdk.OneDec(),
 			Metadata: "leaving member",
 		},
 		{
 			Address:  s.permanentMember.String(),
+			Weight:   sdk.OneDec(),
 			Metadata: "permanent member",
 		},
 	}
@@ -115,7 +115,7 @@ func (s *IntegrationTestSuite) TestNewTxCmdUpdateMembers() {
 		fmt.Sprintf("--%s", flags.FlagGenerateOnly),
 	}
 
-	updates := `[{"address":"%s"}]`
+	updates := `[{"address":"%s","weight":"1"}]`
 	testCases := map[string]struct {
 		args  []string
 		valid bool
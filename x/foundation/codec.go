@@ -24,6 +24,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	// proposal from foundation operator
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "lbm-sdk/MsgUpdateParams")
 	legacy.RegisterAminoMsg(cdc, &MsgWithdrawFromTreasury{}, "lbm-sdk/MsgWithdrawFromTreasury")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateTreasuryStream{}, "lbm-sdk/MsgCreateTreasuryStream")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelTreasuryStream{}, "lbm-sdk/MsgCancelTreasuryStream")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateMembers{}, "lbm-sdk/MsgUpdateMembers")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateDecisionPolicy{}, "lbm-sdk/MsgUpdateDecisionPolicy")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateCensorship{}, "lbm-sdk/MsgUpdateCensorship")
@@ -44,6 +46,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgUpdateParams{},
 		&MsgFundTreasury{},
 		&MsgWithdrawFromTreasury{},
+		&MsgCreateTreasuryStream{},
+		&MsgCancelTreasuryStream{},
 		&MsgUpdateMembers{},
 		&MsgUpdateDecisionPolicy{},
 		&MsgSubmitProposal{},
@@ -10,11 +10,14 @@ type Config struct {
 	MaxExecutionPeriod time.Duration
 	// MaxMetadataLen defines the max length of the metadata bytes field for various entities within the foundation module. Defaults to 255 if not explicitly set.
 	MaxMetadataLen uint64
+	// TaxHistoryRetention defines the number of the recent blocks whose tax records are kept. Zero means the records are kept forever.
+	TaxHistoryRetention uint64
 }
 
 func DefaultConfig() Config {
 	return Config{
-		MaxExecutionPeriod: 2 * 7 * 24 * time.Hour, // two weeks
-		MaxMetadataLen:     255,
+		MaxExecutionPeriod:  2 * 7 * 24 * time.Hour, // two weeks
+		MaxMetadataLen:      255,
+		TaxHistoryRetention: 100000,
 	}
 }
@@ -10,16 +10,20 @@ import (
 	types "github.com/Finschia/finschia-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/regen-network/cosmos-proto"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -133,6 +137,241 @@ func (m *EventWithdrawFromTreasury) GetAmount() github_com_Finschia_finschia_sdk
 	return nil
 }
 
+// EventCollectFoundationTax is an event emitted when the foundation tax is collected.
+type EventCollectFoundationTax struct {
+	// treasury is the amount sent to the foundation treasury.
+	Treasury github_com_Finschia_finschia_sdk_types.Coins `protobuf:"bytes,1,rep,name=treasury,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coins" json:"treasury"`
+	// community_pool is the amount sent to the community pool.
+	CommunityPool github_com_Finschia_finschia_sdk_types.Coins `protobuf:"bytes,2,rep,name=community_pool,json=communityPool,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coins" json:"community_pool"`
+	// burned is the amount burned.
+	Burned github_com_Finschia_finschia_sdk_types.Coins `protobuf:"bytes,3,rep,name=burned,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coins" json:"burned"`
+}
+
+func (m *EventCollectFoundationTax) Reset()         { *m = EventCollectFoundationTax{} }
+func (m *EventCollectFoundationTax) String() string { return proto.CompactTextString(m) }
+func (*EventCollectFoundationTax) ProtoMessage()    {}
+func (*EventCollectFoundationTax) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b66c645bbb34fbc, []int{2}
+}
+func (m *EventCollectFoundationTax) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCollectFoundationTax) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCollectFoundationTax.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCollectFoundationTax) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCollectFoundationTax.Merge(m, src)
+}
+func (m *EventCollectFoundationTax) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCollectFoundationTax) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCollectFoundationTax.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCollectFoundationTax proto.InternalMessageInfo
+
+func (m *EventCollectFoundationTax) GetTreasury() github_com_Finschia_finschia_sdk_types.Coins {
+	if m != nil {
+		return m.Treasury
+	}
+	return nil
+}
+
+func (m *EventCollectFoundationTax) GetCommunityPool() github_com_Finschia_finschia_sdk_types.Coins {
+	if m != nil {
+		return m.CommunityPool
+	}
+	return nil
+}
+
+func (m *EventCollectFoundationTax) GetBurned() github_com_Finschia_finschia_sdk_types.Coins {
+	if m != nil {
+		return m.Burned
+	}
+	return nil
+}
+
+// EventCreateTreasuryStream is an event emitted when a treasury stream is created.
+type EventCreateTreasuryStream struct {
+	Stream TreasuryStream `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream"`
+}
+
+func (m *EventCreateTreasuryStream) Reset()         { *m = EventCreateTreasuryStream{} }
+func (m *EventCreateTreasuryStream) String() string { return proto.CompactTextString(m) }
+func (*EventCreateTreasuryStream) ProtoMessage()    {}
+func (*EventCreateTreasuryStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b66c645bbb34fbc, []int{3}
+}
+func (m *EventCreateTreasuryStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCreateTreasuryStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCreateTreasuryStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCreateTreasuryStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCreateTreasuryStream.Merge(m, src)
+}
+func (m *EventCreateTreasuryStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCreateTreasuryStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCreateTreasuryStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCreateTreasuryStream proto.InternalMessageInfo
+
+func (m *EventCreateTreasuryStream) GetStream() TreasuryStream {
+	if m != nil {
+		return m.Stream
+	}
+	return TreasuryStream{}
+}
+
+// EventCancelTreasuryStream is an event emitted when a treasury stream is cancelled.
+type EventCancelTreasuryStream struct {
+	// stream_id is the unique ID of the stream.
+	StreamId uint64 `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	// remaining is the amount which has not been paid.
+	Remaining github_com_Finschia_finschia_sdk_types.Coins `protobuf:"bytes,2,rep,name=remaining,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coins" json:"remaining"`
+}
+
+func (m *EventCancelTreasuryStream) Reset()         { *m = EventCancelTreasuryStream{} }
+func (m *EventCancelTreasuryStream) String() string { return proto.CompactTextString(m) }
+func (*EventCancelTreasuryStream) ProtoMessage()    {}
+func (*EventCancelTreasuryStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b66c645bbb34fbc, []int{4}
+}
+func (m *EventCancelTreasuryStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCancelTreasuryStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCancelTreasuryStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCancelTreasuryStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCancelTreasuryStream.Merge(m, src)
+}
+func (m *EventCancelTreasuryStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCancelTreasuryStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCancelTreasuryStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCancelTreasuryStream proto.InternalMessageInfo
+
+func (m *EventCancelTreasuryStream) GetStreamId() uint64 {
+	if m != nil {
+		return m.StreamId
+	}
+	return 0
+}
+
+func (m *EventCancelTreasuryStream) GetRemaining() github_com_Finschia_finschia_sdk_types.Coins {
+	if m != nil {
+		return m.Remaining
+	}
+	return nil
+}
+
+// EventTreasuryStreamPayout is an event emitted when a treasury stream pays its recipient.
+type EventTreasuryStreamPayout struct {
+	// stream_id is the unique ID of the stream.
+	StreamId  uint64                                       `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	Recipient string                                       `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount    github_com_Finschia_finschia_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coins" json:"amount"`
+	// completed is true if the stream has been paid in full.
+	Completed bool `protobuf:"varint,4,opt,name=completed,proto3" json:"completed,omitempty"`
+}
+
+func (m *EventTreasuryStreamPayout) Reset()         { *m = EventTreasuryStreamPayout{} }
+func (m *EventTreasuryStreamPayout) String() string { return proto.CompactTextString(m) }
+func (*EventTreasuryStreamPayout) ProtoMessage()    {}
+func (*EventTreasuryStreamPayout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b66c645bbb34fbc, []int{5}
+}
+func (m *EventTreasuryStreamPayout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTreasuryStreamPayout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTreasuryStreamPayout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventTreasuryStreamPayout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTreasuryStreamPayout.Merge(m, src)
+}
+func (m *EventTreasuryStreamPayout) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTreasuryStreamPayout) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTreasuryStreamPayout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTreasuryStreamPayout proto.InternalMessageInfo
+
+func (m *EventTreasuryStreamPayout) GetStreamId() uint64 {
+	if m != nil {
+		return m.StreamId
+	}
+	return 0
+}
+
+func (m *EventTreasuryStreamPayout) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *EventTreasuryStreamPayout) GetAmount() github_com_Finschia_finschia_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *EventTreasuryStreamPayout) GetCompleted() bool {
+	if m != nil {
+		return m.Completed
+	}
+	return false
+}
+
 // EventUpdateMembers is an event emitted when the members have been updated.
 type EventUpdateMembers struct {
 	MemberUpdates []MemberRequest `protobuf:"bytes,1,rep,name=member_updates,json=memberUpdates,proto3" json:"member_updates"`
@@ -142,7 +381,7 @@ func (m *EventUpdateMembers) Reset()         { *m = EventUpdateMembers{} }
 func (m *EventUpdateMembers) String() string { return proto.CompactTextString(m) }
 func (*EventUpdateMembers) ProtoMessage()    {}
 func (*EventUpdateMembers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b66c645bbb34fbc, []int{2}
+	return fileDescriptor_2b66c645bbb34fbc, []int{6}
 }
 func (m *EventUpdateMembers) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -187,7 +426,7 @@ func (m *EventUpdateDecisionPolicy) Reset()         { *m = EventUpdateDecisionPo
 func (m *EventUpdateDecisionPolicy) String() string { return proto.CompactTextString(m) }
 func (*EventUpdateDecisionPolicy) ProtoMessage()    {}
 func (*EventUpdateDecisionPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b66c645bbb34fbc, []int{3}
+	return fileDescriptor_2b66c645bbb34fbc, []int{7}
 }
 func (m *EventUpdateDecisionPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -226,7 +465,7 @@ func (m *EventSubmitProposal) Reset()         { *m = EventSubmitProposal{} }
 func (m *EventSubmitProposal) String() string { return proto.CompactTextString(m) }
 func (*EventSubmitProposal) ProtoMessage()    {}
 func (*EventSubmitProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b66c645bbb34fbc, []int{4}
+	return fileDescriptor_2b66c645bbb34fbc, []int{8}
 }
 func (m *EventSubmitProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -272,7 +511,7 @@ func (m *EventWithdrawProposal) Reset()         { *m = EventWithdrawProposal{} }
 func (m *EventWithdrawProposal) String() string { return proto.CompactTextString(m) }
 func (*EventWithdrawProposal) ProtoMessage()    {}
 func (*EventWithdrawProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b66c645bbb34fbc, []int{5}
+	return fileDescriptor_2b66c645bbb34fbc, []int{9}
 }
 func (m *EventWithdrawProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -317,7 +556,7 @@ func (m *EventVote) Reset()         { *m = EventVote{} }
 func (m *EventVote) String() string { return proto.CompactTextString(m) }
 func (*EventVote) ProtoMessage()    {}
 func (*EventVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b66c645bbb34fbc, []int{6}
+	return fileDescriptor_2b66c645bbb34fbc, []int{10}
 }
 func (m *EventVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -367,7 +606,7 @@ func (m *EventExec) Reset()         { *m = EventExec{} }
 func (m *EventExec) String() string { return proto.CompactTextString(m) }
 func (*EventExec) ProtoMessage()    {}
 func (*EventExec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b66c645bbb34fbc, []int{7}
+	return fileDescriptor_2b66c645bbb34fbc, []int{11}
 }
 func (m *EventExec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -427,7 +666,7 @@ func (m *EventLeaveFoundation) Reset()         { *m = EventLeaveFoundation{} }
 func (m *EventLeaveFoundation) String() string { return proto.CompactTextString(m) }
 func (*EventLeaveFoundation) ProtoMessage()    {}
 func (*EventLeaveFoundation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b66c645bbb34fbc, []int{8}
+	return fileDescriptor_2b66c645bbb34fbc, []int{12}
 }
 func (m *EventLeaveFoundation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -463,6 +702,120 @@ func (m *EventLeaveFoundation) GetAddress() string {
 	return ""
 }
 
+// EventRemoveInactiveMember is an event emitted when a foundation member is
+// removed for missing too many votes in a row.
+type EventRemoveInactiveMember struct {
+	// address is the account address of the removed member.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// consecutive_missed_votes is the number of the proposals the member has
+	// not voted on in a row.
+	ConsecutiveMissedVotes uint64 `protobuf:"varint,2,opt,name=consecutive_missed_votes,json=consecutiveMissedVotes,proto3" json:"consecutive_missed_votes,omitempty"`
+}
+
+func (m *EventRemoveInactiveMember) Reset()         { *m = EventRemoveInactiveMember{} }
+func (m *EventRemoveInactiveMember) String() string { return proto.CompactTextString(m) }
+func (*EventRemoveInactiveMember) ProtoMessage()    {}
+func (*EventRemoveInactiveMember) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b66c645bbb34fbc, []int{13}
+}
+func (m *EventRemoveInactiveMember) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRemoveInactiveMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRemoveInactiveMember.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRemoveInactiveMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRemoveInactiveMember.Merge(m, src)
+}
+func (m *EventRemoveInactiveMember) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRemoveInactiveMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRemoveInactiveMember.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRemoveInactiveMember proto.InternalMessageInfo
+
+func (m *EventRemoveInactiveMember) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EventRemoveInactiveMember) GetConsecutiveMissedVotes() uint64 {
+	if m != nil {
+		return m.ConsecutiveMissedVotes
+	}
+	return 0
+}
+
+// EventSuspendInactiveMember is an event emitted when a foundation member is
+// suspended for missing too many votes in a row.
+type EventSuspendInactiveMember struct {
+	// address is the account address of the suspended member.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// consecutive_missed_votes is the number of the proposals the member has
+	// not voted on in a row.
+	ConsecutiveMissedVotes uint64 `protobuf:"varint,2,opt,name=consecutive_missed_votes,json=consecutiveMissedVotes,proto3" json:"consecutive_missed_votes,omitempty"`
+}
+
+func (m *EventSuspendInactiveMember) Reset()         { *m = EventSuspendInactiveMember{} }
+func (m *EventSuspendInactiveMember) String() string { return proto.CompactTextString(m) }
+func (*EventSuspendInactiveMember) ProtoMessage()    {}
+func (*EventSuspendInactiveMember) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b66c645bbb34fbc, []int{14}
+}
+func (m *EventSuspendInactiveMember) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSuspendInactiveMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSuspendInactiveMember.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSuspendInactiveMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSuspendInactiveMember.Merge(m, src)
+}
+func (m *EventSuspendInactiveMember) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSuspendInactiveMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSuspendInactiveMember.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSuspendInactiveMember proto.InternalMessageInfo
+
+func (m *EventSuspendInactiveMember) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EventSuspendInactiveMember) GetConsecutiveMissedVotes() uint64 {
+	if m != nil {
+		return m.ConsecutiveMissedVotes
+	}
+	return 0
+}
+
 // EventUpdateCensorship is emitted when a censorship information updated.
 type EventUpdateCensorship struct {
 	Censorship Censorship `protobuf:"bytes,1,opt,name=censorship,proto3" json:"censorship"`
@@ -472,7 +825,7 @@ func (m *EventUpdateCensorship) Reset()         { *m = EventUpdateCensorship{} }
 func (m *EventUpdateCensorship) String() string { return proto.CompactTextString(m) }
 func (*EventUpdateCensorship) ProtoMessage()    {}
 func (*EventUpdateCensorship) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b66c645bbb34fbc, []int{9}
+	return fileDescriptor_2b66c645bbb34fbc, []int{15}
 }
 func (m *EventUpdateCensorship) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -514,13 +867,15 @@ type EventGrant struct {
 	Grantee string `protobuf:"bytes,1,opt,name=grantee,proto3" json:"grantee,omitempty"`
 	// authorization granted.
 	Authorization *types1.Any `protobuf:"bytes,2,opt,name=authorization,proto3" json:"authorization,omitempty"`
+	// expiration of the grant, if any.
+	Expiration *time.Time `protobuf:"bytes,3,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
 }
 
 func (m *EventGrant) Reset()         { *m = EventGrant{} }
 func (m *EventGrant) String() string { return proto.CompactTextString(m) }
 func (*EventGrant) ProtoMessage()    {}
 func (*EventGrant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b66c645bbb34fbc, []int{10}
+	return fileDescriptor_2b66c645bbb34fbc, []int{16}
 }
 func (m *EventGrant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -563,6 +918,13 @@ func (m *EventGrant) GetAuthorization() *types1.Any {
 	return nil
 }
 
+func (m *EventGrant) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
 // EventRevoke is emitted on Msg/Revoke
 type EventRevoke struct {
 	// address of the grantee.
@@ -575,7 +937,7 @@ func (m *EventRevoke) Reset()         { *m = EventRevoke{} }
 func (m *EventRevoke) String() string { return proto.CompactTextString(m) }
 func (*EventRevoke) ProtoMessage()    {}
 func (*EventRevoke) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b66c645bbb34fbc, []int{11}
+	return fileDescriptor_2b66c645bbb34fbc, []int{17}
 }
 func (m *EventRevoke) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -618,87 +980,217 @@ func (m *EventRevoke) GetMsgTypeUrl() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*EventFundTreasury)(nil), "lbm.foundation.v1.EventFundTreasury")
-	proto.RegisterType((*EventWithdrawFromTreasury)(nil), "lbm.foundation.v1.EventWithdrawFromTreasury")
-	proto.RegisterType((*EventUpdateMembers)(nil), "lbm.foundation.v1.EventUpdateMembers")
-	proto.RegisterType((*EventUpdateDecisionPolicy)(nil), "lbm.foundation.v1.EventUpdateDecisionPolicy")
-	proto.RegisterType((*EventSubmitProposal)(nil), "lbm.foundation.v1.EventSubmitProposal")
-	proto.RegisterType((*EventWithdrawProposal)(nil), "lbm.foundation.v1.EventWithdrawProposal")
-	proto.RegisterType((*EventVote)(nil), "lbm.foundation.v1.EventVote")
-	proto.RegisterType((*EventExec)(nil), "lbm.foundation.v1.EventExec")
-	proto.RegisterType((*EventLeaveFoundation)(nil), "lbm.foundation.v1.EventLeaveFoundation")
-	proto.RegisterType((*EventUpdateCensorship)(nil), "lbm.foundation.v1.EventUpdateCensorship")
-	proto.RegisterType((*EventGrant)(nil), "lbm.foundation.v1.EventGrant")
-	proto.RegisterType((*EventRevoke)(nil), "lbm.foundation.v1.EventRevoke")
-}
-
-func init() { proto.RegisterFile("lbm/foundation/v1/event.proto", fileDescriptor_2b66c645bbb34fbc) }
-
-var fileDescriptor_2b66c645bbb34fbc = []byte{
-	// 720 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0xc1, 0x4f, 0x13, 0x4f,
-	0x14, 0xc7, 0xbb, 0xd0, 0xf0, 0xfb, 0x31, 0x95, 0x1a, 0x56, 0x8c, 0x05, 0x43, 0xdb, 0xec, 0x09,
-	0x13, 0xbb, 0x6b, 0xd1, 0x18, 0x43, 0xa2, 0x09, 0x45, 0x4a, 0x48, 0x24, 0xc1, 0x15, 0x34, 0x31,
-	0x26, 0xcd, 0xec, 0xee, 0x74, 0x3b, 0x61, 0x77, 0xdf, 0x3a, 0x33, 0xbb, 0x52, 0xae, 0x5e, 0x3c,
-	0x72, 0xf0, 0x6c, 0x3c, 0x7b, 0xe6, 0x8f, 0x20, 0x9c, 0x38, 0x7a, 0x52, 0x03, 0xff, 0x88, 0xd9,
-	0xd9, 0x69, 0xa1, 0x01, 0xc1, 0x93, 0xb7, 0xf7, 0x66, 0xde, 0xf7, 0xfb, 0x3e, 0xf3, 0x66, 0x06,
-	0xcd, 0x07, 0x4e, 0x68, 0x75, 0x21, 0x89, 0x3c, 0x2c, 0x28, 0x44, 0x56, 0xda, 0xb4, 0x48, 0x4a,
-	0x22, 0x61, 0xc6, 0x0c, 0x04, 0xe8, 0xd3, 0x81, 0x13, 0x9a, 0x67, 0xdb, 0x66, 0xda, 0x9c, 0x9b,
-	0xf1, 0xc1, 0x07, 0xb9, 0x6b, 0x65, 0x51, 0x5e, 0x38, 0x37, 0xeb, 0x03, 0xf8, 0x01, 0xb1, 0x64,
-	0xe6, 0x24, 0x5d, 0x0b, 0x47, 0xfd, 0xc1, 0x96, 0x0b, 0x3c, 0x04, 0xde, 0xc9, 0x35, 0x79, 0xa2,
-	0xb6, 0xaa, 0x79, 0x66, 0x39, 0x98, 0x13, 0x2b, 0x6d, 0x3a, 0x44, 0xe0, 0xa6, 0xe5, 0x02, 0x8d,
-	0xd4, 0xbe, 0x71, 0x91, 0xee, 0x1c, 0x8c, 0xac, 0x31, 0xf6, 0x35, 0x34, 0xbd, 0x9a, 0x21, 0xb7,
-	0x93, 0xc8, 0xdb, 0x62, 0x04, 0xf3, 0x84, 0xf5, 0x75, 0x1d, 0x15, 0xbb, 0x0c, 0xc2, 0x8a, 0x56,
-	0xd7, 0x16, 0x26, 0x6d, 0x19, 0xeb, 0x3e, 0x9a, 0xc0, 0x21, 0x24, 0x91, 0xa8, 0x8c, 0xd5, 0xc7,
-	0x17, 0x4a, 0x8b, 0xb3, 0xa6, 0x82, 0xc9, 0xda, 0x9b, 0xaa, 0xbd, 0xb9, 0x02, 0x34, 0x6a, 0x3d,
-	0x3a, 0xfc, 0x51, 0x2b, 0x7c, 0xfb, 0x59, 0xbb, 0xef, 0x53, 0xd1, 0x4b, 0x1c, 0xd3, 0x85, 0xd0,
-	0x6a, 0xd3, 0x88, 0xbb, 0x3d, 0x8a, 0xad, 0xae, 0x0a, 0x1a, 0xdc, 0xdb, 0xb1, 0x44, 0x3f, 0x26,
-	0x5c, 0x8a, 0xb8, 0xad, 0xec, 0x8d, 0xcf, 0x1a, 0x9a, 0x95, 0x48, 0x6f, 0xa8, 0xe8, 0x79, 0x0c,
-	0x7f, 0x68, 0x33, 0x08, 0x87, 0x68, 0x65, 0x34, 0x26, 0x40, 0x81, 0x8d, 0x09, 0xf8, 0x77, 0x58,
-	0x2e, 0xd2, 0x25, 0xd5, 0x76, 0xec, 0x61, 0x41, 0x36, 0x48, 0xe8, 0x10, 0xc6, 0xf5, 0x0d, 0x54,
-	0x0e, 0x65, 0xd8, 0x49, 0xe4, 0x3a, 0xaf, 0x68, 0x12, 0xa3, 0x6e, 0x5e, 0xb8, 0x7b, 0x33, 0xd7,
-	0xd8, 0xe4, 0x7d, 0x42, 0xb8, 0x68, 0x15, 0x33, 0x1a, 0x7b, 0x2a, 0x57, 0xe7, 0xa6, 0xdc, 0x10,
-	0xea, 0xe8, 0x79, 0xfe, 0x9c, 0xb8, 0x94, 0x53, 0x88, 0x36, 0x21, 0xa0, 0x6e, 0x5f, 0x7f, 0x89,
-	0x6e, 0x7a, 0x6a, 0xa5, 0x13, 0xcb, 0x25, 0x39, 0x87, 0xd2, 0xe2, 0x8c, 0x99, 0xbf, 0x1f, 0x73,
-	0xf0, 0x7e, 0xcc, 0xe5, 0xa8, 0xdf, 0xd2, 0x8f, 0x0e, 0x1a, 0xe5, 0x51, 0x0b, 0xbb, 0xec, 0x8d,
-	0xe4, 0x4b, 0xc5, 0x4f, 0x5f, 0x6b, 0x05, 0x63, 0x0b, 0xdd, 0x92, 0x5d, 0x5f, 0x25, 0x4e, 0x48,
-	0xc5, 0x26, 0x83, 0x18, 0x38, 0x0e, 0xf4, 0xa7, 0xe8, 0xff, 0x58, 0xc5, 0xaa, 0xd1, 0xdd, 0x4b,
-	0x4e, 0x35, 0x28, 0x57, 0x07, 0x1a, 0x4a, 0x8c, 0x27, 0xe8, 0xf6, 0xc8, 0x35, 0x0e, 0x7d, 0x6b,
-	0xa8, 0x34, 0x28, 0xea, 0x50, 0x4f, 0x5a, 0x17, 0x6d, 0x34, 0x58, 0x5a, 0xf7, 0x8c, 0x67, 0x68,
-	0x52, 0x2a, 0x5f, 0x83, 0x20, 0x7a, 0x13, 0x15, 0x53, 0x10, 0x44, 0x11, 0xdc, 0xb9, 0x84, 0x20,
-	0x2b, 0x53, 0xdd, 0x65, 0xa9, 0xf1, 0x51, 0x53, 0x06, 0xab, 0xbb, 0xc4, 0xbd, 0xb6, 0x9d, 0xbe,
-	0x8c, 0x26, 0x18, 0xe1, 0x49, 0x90, 0x3d, 0x21, 0x6d, 0xa1, 0xbc, 0x78, 0xef, 0x8a, 0x53, 0x66,
-	0x8e, 0x89, 0x00, 0x66, 0x4b, 0x81, 0xad, 0x84, 0xd9, 0x87, 0x09, 0xc0, 0xe7, 0x95, 0xf1, 0xfc,
-	0xc3, 0x64, 0xb1, 0xf1, 0x00, 0xcd, 0x48, 0x88, 0x17, 0x04, 0xa7, 0xa4, 0x3d, 0x74, 0xd3, 0x2b,
-	0xe8, 0x3f, 0xec, 0x79, 0x8c, 0x70, 0xae, 0x9e, 0xf1, 0x20, 0x35, 0xde, 0xa9, 0x89, 0xe5, 0xb7,
-	0xbf, 0x42, 0x22, 0x0e, 0x8c, 0xf7, 0x68, 0xac, 0xaf, 0x20, 0xe4, 0x0e, 0x33, 0x35, 0x89, 0xf9,
-	0x4b, 0x28, 0xcf, 0x24, 0x6a, 0x1e, 0xe7, 0x64, 0xc6, 0x17, 0x0d, 0x21, 0x69, 0xbf, 0xc6, 0x70,
-	0x24, 0x32, 0x0c, 0x3f, 0x0b, 0x08, 0x19, 0x60, 0xa8, 0x54, 0x4f, 0xd1, 0x14, 0x4e, 0x44, 0x0f,
-	0x18, 0xdd, 0x93, 0xce, 0x72, 0x2c, 0x7f, 0x7a, 0x65, 0x4b, 0x47, 0x07, 0x8d, 0xc7, 0xd7, 0x7e,
-	0xa8, 0x5d, 0x2b, 0x73, 0xdc, 0x33, 0x97, 0xcf, 0xfb, 0xda, 0xa3, 0x6d, 0x8c, 0x75, 0x54, 0x92,
-	0x7c, 0x36, 0x49, 0x61, 0x87, 0x5c, 0x01, 0x58, 0x47, 0x37, 0x42, 0xee, 0x77, 0xb2, 0x5f, 0xda,
-	0x49, 0x58, 0x20, 0xf9, 0x26, 0x6d, 0x14, 0x72, 0x7f, 0xab, 0x1f, 0x93, 0x6d, 0x16, 0xb4, 0xd6,
-	0x0e, 0x4f, 0xaa, 0xda, 0xf1, 0x49, 0x55, 0xfb, 0x75, 0x52, 0xd5, 0xf6, 0x4f, 0xab, 0x85, 0xe3,
-	0xd3, 0x6a, 0xe1, 0xfb, 0x69, 0xb5, 0xf0, 0xb6, 0xf1, 0x17, 0xac, 0x67, 0x43, 0x75, 0x26, 0xe4,
-	0x61, 0x1f, 0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xdd, 0x6e, 0x65, 0xdb, 0xea, 0x05, 0x00, 0x00,
+// EventRefundDeposit is an event emitted when the deposit of a proposal is
+// refunded to the depositor.
+type EventRefundDeposit struct {
+	Deposit ProposalDeposit `protobuf:"bytes,1,opt,name=deposit,proto3" json:"deposit"`
 }
 
-func (m *EventFundTreasury) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *EventRefundDeposit) Reset()         { *m = EventRefundDeposit{} }
+func (m *EventRefundDeposit) String() string { return proto.CompactTextString(m) }
+func (*EventRefundDeposit) ProtoMessage()    {}
+func (*EventRefundDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b66c645bbb34fbc, []int{18}
 }
-
-func (m *EventFundTreasury) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventRefundDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
+func (m *EventRefundDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRefundDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRefundDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRefundDeposit.Merge(m, src)
+}
+func (m *EventRefundDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRefundDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRefundDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRefundDeposit proto.InternalMessageInfo
+
+func (m *EventRefundDeposit) GetDeposit() ProposalDeposit {
+	if m != nil {
+		return m.Deposit
+	}
+	return ProposalDeposit{}
+}
+
+// EventForfeitDeposit is an event emitted when the deposit of a withdrawn or
+// aborted proposal is forfeited.
+type EventForfeitDeposit struct {
+	Deposit ProposalDeposit `protobuf:"bytes,1,opt,name=deposit,proto3" json:"deposit"`
+	// burned is true if the deposit is burned, false if it is sent to the treasury.
+	Burned bool `protobuf:"varint,2,opt,name=burned,proto3" json:"burned,omitempty"`
+}
+
+func (m *EventForfeitDeposit) Reset()         { *m = EventForfeitDeposit{} }
+func (m *EventForfeitDeposit) String() string { return proto.CompactTextString(m) }
+func (*EventForfeitDeposit) ProtoMessage()    {}
+func (*EventForfeitDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b66c645bbb34fbc, []int{19}
+}
+func (m *EventForfeitDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventForfeitDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventForfeitDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventForfeitDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventForfeitDeposit.Merge(m, src)
+}
+func (m *EventForfeitDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventForfeitDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventForfeitDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventForfeitDeposit proto.InternalMessageInfo
+
+func (m *EventForfeitDeposit) GetDeposit() ProposalDeposit {
+	if m != nil {
+		return m.Deposit
+	}
+	return ProposalDeposit{}
+}
+
+func (m *EventForfeitDeposit) GetBurned() bool {
+	if m != nil {
+		return m.Burned
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*EventFundTreasury)(nil), "lbm.foundation.v1.EventFundTreasury")
+	proto.RegisterType((*EventWithdrawFromTreasury)(nil), "lbm.foundation.v1.EventWithdrawFromTreasury")
+	proto.RegisterType((*EventCollectFoundationTax)(nil), "lbm.foundation.v1.EventCollectFoundationTax")
+	proto.RegisterType((*EventCreateTreasuryStream)(nil), "lbm.foundation.v1.EventCreateTreasuryStream")
+	proto.RegisterType((*EventCancelTreasuryStream)(nil), "lbm.foundation.v1.EventCancelTreasuryStream")
+	proto.RegisterType((*EventTreasuryStreamPayout)(nil), "lbm.foundation.v1.EventTreasuryStreamPayout")
+	proto.RegisterType((*EventUpdateMembers)(nil), "lbm.foundation.v1.EventUpdateMembers")
+	proto.RegisterType((*EventUpdateDecisionPolicy)(nil), "lbm.foundation.v1.EventUpdateDecisionPolicy")
+	proto.RegisterType((*EventSubmitProposal)(nil), "lbm.foundation.v1.EventSubmitProposal")
+	proto.RegisterType((*EventWithdrawProposal)(nil), "lbm.foundation.v1.EventWithdrawProposal")
+	proto.RegisterType((*EventVote)(nil), "lbm.foundation.v1.EventVote")
+	proto.RegisterType((*EventExec)(nil), "lbm.foundation.v1.EventExec")
+	proto.RegisterType((*EventLeaveFoundation)(nil), "lbm.foundation.v1.EventLeaveFoundation")
+	proto.RegisterType((*EventRemoveInactiveMember)(nil), "lbm.foundation.v1.EventRemoveInactiveMember")
+	proto.RegisterType((*EventSuspendInactiveMember)(nil), "lbm.foundation.v1.EventSuspendInactiveMember")
+	proto.RegisterType((*EventUpdateCensorship)(nil), "lbm.foundation.v1.EventUpdateCensorship")
+	proto.RegisterType((*EventGrant)(nil), "lbm.foundation.v1.EventGrant")
+	proto.RegisterType((*EventRevoke)(nil), "lbm.foundation.v1.EventRevoke")
+	proto.RegisterType((*EventRefundDeposit)(nil), "lbm.foundation.v1.EventRefundDeposit")
+	proto.RegisterType((*EventForfeitDeposit)(nil), "lbm.foundation.v1.EventForfeitDeposit")
+}
+
+func init() { proto.RegisterFile("lbm/foundation/v1/event.proto", fileDescriptor_2b66c645bbb34fbc) }
+
+var fileDescriptor_2b66c645bbb34fbc = []byte{
+	// 1050 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4f, 0x6f, 0xdc, 0x44,
+	0x14, 0x8f, 0x93, 0x55, 0x9a, 0x4c, 0xc8, 0xa2, 0x0e, 0xa1, 0x6c, 0x52, 0xba, 0x1b, 0x7c, 0x0a,
+	0x12, 0xb1, 0x49, 0x40, 0xa8, 0xaa, 0xc4, 0x9f, 0x6c, 0xda, 0x54, 0x91, 0x88, 0x14, 0xdc, 0x14,
+	0x10, 0xaa, 0xb4, 0xf2, 0xda, 0x6f, 0x9d, 0x51, 0x3c, 0xf3, 0x5c, 0xcf, 0xd8, 0x64, 0x7b, 0xe5,
+	0xc2, 0x31, 0x07, 0x3e, 0x00, 0x37, 0x24, 0xce, 0xfd, 0x10, 0x55, 0x4f, 0x3d, 0x72, 0x81, 0x56,
+	0xc9, 0x89, 0x6f, 0x81, 0x3c, 0x1e, 0xef, 0x9f, 0x26, 0x4d, 0x39, 0x74, 0x7b, 0x7b, 0x6f, 0x66,
+	0x7e, 0xef, 0xfd, 0xde, 0x5f, 0x9b, 0xdc, 0x88, 0xbb, 0xdc, 0xed, 0x61, 0x26, 0x42, 0x5f, 0x31,
+	0x14, 0x6e, 0xbe, 0xe1, 0x42, 0x0e, 0x42, 0x39, 0x49, 0x8a, 0x0a, 0xe9, 0xd5, 0xb8, 0xcb, 0x9d,
+	0xe1, 0xb5, 0x93, 0x6f, 0xac, 0x2c, 0x45, 0x18, 0xa1, 0xbe, 0x75, 0x0b, 0xa9, 0x7c, 0xb8, 0xb2,
+	0x1c, 0x21, 0x46, 0x31, 0xb8, 0x5a, 0xeb, 0x66, 0x3d, 0xd7, 0x17, 0x7d, 0x73, 0xd5, 0x7a, 0xf9,
+	0x4a, 0x31, 0x0e, 0x52, 0xf9, 0x3c, 0xa9, 0xb0, 0x01, 0x4a, 0x8e, 0xb2, 0x53, 0x1a, 0x2d, 0x15,
+	0x73, 0xd5, 0x2c, 0x35, 0xb7, 0xeb, 0x4b, 0x70, 0xf3, 0x8d, 0x2e, 0x28, 0x7f, 0xc3, 0x0d, 0x90,
+	0x09, 0x73, 0x6f, 0x9f, 0xa7, 0x3f, 0xc2, 0x56, 0xbf, 0xb1, 0x4f, 0x2c, 0x72, 0xf5, 0x4e, 0x11,
+	0xd3, 0x4e, 0x26, 0xc2, 0x83, 0x14, 0x7c, 0x99, 0xa5, 0x7d, 0x4a, 0x49, 0xad, 0x97, 0x22, 0x6f,
+	0x58, 0xab, 0xd6, 0xda, 0xbc, 0xa7, 0x65, 0x1a, 0x91, 0x59, 0x9f, 0x63, 0x26, 0x54, 0x63, 0x7a,
+	0x75, 0x66, 0x6d, 0x61, 0x73, 0xd9, 0x31, 0x64, 0x0a, 0xf7, 0x8e, 0x71, 0xef, 0x6c, 0x23, 0x13,
+	0xed, 0xcf, 0x9f, 0xfc, 0xd3, 0x9a, 0xfa, 0xf3, 0x79, 0xeb, 0x93, 0x88, 0xa9, 0xc3, 0xac, 0xeb,
+	0x04, 0xc8, 0xdd, 0x1d, 0x26, 0x64, 0x70, 0xc8, 0x7c, 0xb7, 0x67, 0x84, 0x75, 0x19, 0x1e, 0xb9,
+	0xaa, 0x9f, 0x80, 0xd4, 0x20, 0xe9, 0x19, 0xf3, 0xf6, 0x6f, 0x16, 0x59, 0xd6, 0x94, 0x7e, 0x60,
+	0xea, 0x30, 0x4c, 0xfd, 0x9f, 0x77, 0x52, 0xe4, 0x03, 0x6a, 0x75, 0x32, 0xad, 0xd0, 0x10, 0x9b,
+	0x56, 0xf8, 0xf6, 0x68, 0xfd, 0x3b, 0x6d, 0x68, 0x6d, 0x63, 0x1c, 0x43, 0xa0, 0x76, 0x06, 0xa9,
+	0x3c, 0xf0, 0x8f, 0xe9, 0x11, 0x99, 0x53, 0x86, 0x62, 0xc3, 0x9a, 0x0c, 0x91, 0x81, 0x03, 0x9a,
+	0x93, 0x7a, 0x80, 0x9c, 0x67, 0x82, 0xa9, 0x7e, 0x27, 0x41, 0x8c, 0x27, 0x15, 0xfb, 0xe2, 0xc0,
+	0xcd, 0x3e, 0x62, 0x5c, 0xe4, 0xba, 0x9b, 0xa5, 0x02, 0xc2, 0xc6, 0xcc, 0x84, 0x72, 0x5d, 0x9a,
+	0xb7, 0x1f, 0x54, 0xa9, 0x4e, 0xc1, 0x57, 0x50, 0xd5, 0xfe, 0x5e, 0x91, 0x00, 0x4e, 0xbf, 0x26,
+	0xb3, 0x52, 0x4b, 0xba, 0x0b, 0x16, 0x36, 0x3f, 0x72, 0xce, 0xcd, 0xa1, 0x33, 0x0e, 0x69, 0xd7,
+	0x0a, 0x36, 0x9e, 0x81, 0xd9, 0x7f, 0x54, 0x0d, 0xb6, 0xed, 0x8b, 0x00, 0xe2, 0x97, 0xcc, 0x5f,
+	0x27, 0xf3, 0xe5, 0xbb, 0x0e, 0x0b, 0xb5, 0x87, 0x9a, 0x37, 0x57, 0x1e, 0xec, 0x86, 0x94, 0x93,
+	0xf9, 0x14, 0xb8, 0xcf, 0x04, 0x13, 0xd1, 0xa4, 0x92, 0x3e, 0xf4, 0x60, 0xff, 0x5d, 0x31, 0x1d,
+	0xe7, 0xb8, 0xef, 0xf7, 0x31, 0x53, 0x97, 0x33, 0xfd, 0xb0, 0x60, 0x1a, 0xb0, 0x84, 0x81, 0x1e,
+	0x8d, 0x62, 0x5c, 0x86, 0x07, 0x23, 0x53, 0x33, 0x33, 0xd1, 0xa9, 0x29, 0x68, 0x04, 0xc8, 0x93,
+	0x18, 0x14, 0x84, 0x8d, 0xda, 0xaa, 0xb5, 0x36, 0xe7, 0x0d, 0x0f, 0xec, 0x80, 0x50, 0x1d, 0xde,
+	0xfd, 0x24, 0xf4, 0x15, 0xec, 0x01, 0xef, 0x42, 0x2a, 0xe9, 0x1e, 0xa9, 0x73, 0x2d, 0x76, 0x32,
+	0x7d, 0x2e, 0xcd, 0x44, 0xad, 0x5e, 0x50, 0xe8, 0x12, 0xe3, 0xc1, 0xc3, 0x0c, 0xa4, 0x32, 0x75,
+	0x5e, 0x2c, 0xd1, 0xa5, 0x51, 0x69, 0x2b, 0x93, 0xc3, 0x52, 0xbf, 0x0d, 0x01, 0x93, 0x0c, 0xc5,
+	0x3e, 0xc6, 0x2c, 0xe8, 0xd3, 0xef, 0xc8, 0xbb, 0xa1, 0x39, 0xe9, 0x24, 0xfa, 0xc8, 0x74, 0xd5,
+	0x92, 0x53, 0x6e, 0x66, 0xa7, 0xda, 0xcc, 0xce, 0x96, 0xe8, 0xb7, 0xe9, 0xd3, 0xc7, 0xeb, 0xf5,
+	0x71, 0x13, 0x5e, 0x3d, 0x1c, 0xd3, 0x6f, 0xd5, 0x7e, 0xfd, 0xbd, 0x35, 0x65, 0x1f, 0x90, 0xf7,
+	0xb4, 0xd7, 0x7b, 0x59, 0x97, 0x33, 0xb5, 0x9f, 0x62, 0x82, 0xd2, 0x8f, 0xe9, 0x97, 0x64, 0x2e,
+	0x31, 0xb2, 0x71, 0x74, 0xfd, 0x82, 0xa8, 0xaa, 0xe7, 0x26, 0xa0, 0x01, 0xc4, 0xbe, 0x49, 0xde,
+	0x1f, 0x5b, 0x8d, 0x03, 0xbb, 0x2d, 0xb2, 0x50, 0x3d, 0x1a, 0x76, 0x03, 0xa9, 0x8e, 0x76, 0x43,
+	0xfb, 0x2b, 0x32, 0xaf, 0x91, 0xdf, 0xa3, 0x02, 0xba, 0x41, 0x6a, 0x39, 0x2a, 0x30, 0x0c, 0x3e,
+	0xb8, 0x80, 0x41, 0xf1, 0xcc, 0x78, 0xd7, 0x4f, 0xed, 0x5f, 0x2c, 0x63, 0xe0, 0xce, 0x31, 0x04,
+	0xaf, 0x75, 0x47, 0xb7, 0xc8, 0x6c, 0x0a, 0x32, 0x8b, 0xcb, 0xde, 0xab, 0x6f, 0x7e, 0x7c, 0x49,
+	0x94, 0x85, 0xc5, 0x4c, 0x61, 0xea, 0x69, 0x80, 0x67, 0x80, 0xc5, 0x47, 0x28, 0xc6, 0x48, 0x36,
+	0x66, 0xca, 0x8f, 0x50, 0x21, 0xdb, 0x9f, 0x92, 0x25, 0x4d, 0xe2, 0x5b, 0xf0, 0x73, 0x18, 0x6e,
+	0x60, 0xda, 0x20, 0x57, 0xfc, 0x30, 0x4c, 0x41, 0x4a, 0xf3, 0x69, 0xa8, 0x54, 0x1b, 0x4d, 0xf5,
+	0x3d, 0xe0, 0x98, 0xc3, 0xae, 0xf0, 0x03, 0xc5, 0x72, 0xd3, 0x6a, 0xaf, 0x86, 0xd1, 0x9b, 0xa4,
+	0x11, 0xa0, 0x90, 0x05, 0x31, 0x96, 0x43, 0x87, 0x33, 0x29, 0x21, 0xec, 0x14, 0x99, 0x90, 0x3a,
+	0xa2, 0x9a, 0x77, 0x6d, 0xe4, 0x7e, 0x4f, 0x5f, 0x17, 0x39, 0x93, 0x76, 0x42, 0x56, 0x4c, 0xe1,
+	0x65, 0x02, 0x22, 0x7c, 0x0b, 0x1e, 0x1f, 0x98, 0xa6, 0x28, 0x1b, 0x7c, 0x1b, 0x84, 0xc4, 0x54,
+	0x1e, 0xb2, 0x84, 0x6e, 0x13, 0x12, 0x0c, 0x34, 0x53, 0xec, 0x1b, 0x17, 0x14, 0x62, 0x08, 0x31,
+	0x25, 0x1f, 0x81, 0xd9, 0x2f, 0x2c, 0x42, 0xb4, 0xf9, 0xbb, 0xa9, 0x2f, 0x54, 0x11, 0x40, 0x54,
+	0x08, 0x00, 0x55, 0x00, 0x46, 0xa5, 0x39, 0x59, 0xf4, 0x33, 0x75, 0x88, 0x29, 0x7b, 0xa4, 0x2d,
+	0x6b, 0xd6, 0xaf, 0x1a, 0xa4, 0x5b, 0x4f, 0x1f, 0xaf, 0x7f, 0xf1, 0xda, 0x8d, 0x72, 0xec, 0x16,
+	0x16, 0x1f, 0x39, 0x5b, 0xa3, 0x76, 0xbd, 0x71, 0x37, 0xf4, 0x1b, 0x42, 0xe0, 0x38, 0x61, 0x69,
+	0xe9, 0x74, 0x46, 0x3b, 0x5d, 0x39, 0xe7, 0xf4, 0xa0, 0xfa, 0xaf, 0x6a, 0xd7, 0x4e, 0x9e, 0xb7,
+	0x2c, 0x6f, 0x04, 0x63, 0xef, 0x92, 0x05, 0xd3, 0x23, 0x39, 0x1e, 0xc1, 0x25, 0x21, 0xae, 0x92,
+	0x77, 0xb8, 0x8c, 0x3a, 0xc5, 0xa2, 0xeb, 0x64, 0x69, 0x6c, 0xf6, 0x2a, 0xe1, 0x32, 0x3a, 0xe8,
+	0x27, 0x70, 0x3f, 0x8d, 0xed, 0x1f, 0xcd, 0x46, 0xf3, 0xa0, 0x97, 0x89, 0xf0, 0x36, 0x24, 0x28,
+	0x99, 0xa2, 0x6d, 0x72, 0x25, 0x2c, 0x45, 0x53, 0x05, 0xfb, 0x92, 0x71, 0x30, 0x20, 0x53, 0x8a,
+	0x0a, 0x68, 0x3f, 0x34, 0x0b, 0x65, 0x07, 0xd3, 0x1e, 0x30, 0xf5, 0x06, 0x4d, 0xd3, 0x6b, 0x83,
+	0xef, 0xfa, 0xb4, 0xde, 0xd0, 0x46, 0x6b, 0xdf, 0x7d, 0x72, 0xda, 0xb4, 0x9e, 0x9d, 0x36, 0xad,
+	0x17, 0xa7, 0x4d, 0xeb, 0xe4, 0xac, 0x39, 0xf5, 0xec, 0xac, 0x39, 0xf5, 0xd7, 0x59, 0x73, 0xea,
+	0xa7, 0xf5, 0xff, 0x51, 0xba, 0x21, 0x85, 0xee, 0xac, 0x2e, 0xc3, 0x67, 0xff, 0x05, 0x00, 0x00,
+	0xff, 0xff, 0xf6, 0x04, 0x40, 0xde, 0x51, 0x0b, 0x00, 0x00,
+}
+
+func (m *EventFundTreasury) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFundTreasury) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
 func (m *EventFundTreasury) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
@@ -772,6 +1264,205 @@ func (m *EventWithdrawFromTreasury) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *EventCollectFoundationTax) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCollectFoundationTax) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCollectFoundationTax) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Burned) > 0 {
+		for iNdEx := len(m.Burned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.CommunityPool) > 0 {
+		for iNdEx := len(m.CommunityPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommunityPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Treasury) > 0 {
+		for iNdEx := len(m.Treasury) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Treasury[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCreateTreasuryStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCreateTreasuryStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCreateTreasuryStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Stream.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCancelTreasuryStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCancelTreasuryStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCancelTreasuryStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Remaining) > 0 {
+		for iNdEx := len(m.Remaining) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Remaining[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.StreamId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTreasuryStreamPayout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTreasuryStreamPayout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTreasuryStreamPayout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Completed {
+		i--
+		if m.Completed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StreamId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventUpdateMembers) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1008,7 +1699,7 @@ func (m *EventLeaveFoundation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventUpdateCensorship) Marshal() (dAtA []byte, err error) {
+func (m *EventRemoveInactiveMember) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1018,26 +1709,96 @@ func (m *EventUpdateCensorship) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventUpdateCensorship) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventRemoveInactiveMember) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventUpdateCensorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventRemoveInactiveMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Censorship.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+	if m.ConsecutiveMissedVotes != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ConsecutiveMissedVotes))
+		i--
+		dAtA[i] = 0x10
 	}
-	i--
-	dAtA[i] = 0xa
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSuspendInactiveMember) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSuspendInactiveMember) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSuspendInactiveMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConsecutiveMissedVotes != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ConsecutiveMissedVotes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUpdateCensorship) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateCensorship) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateCensorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Censorship.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
@@ -1061,6 +1822,16 @@ func (m *EventGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Expiration != nil {
+		n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintEvent(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Authorization != nil {
 		{
 			size, err := m.Authorization.MarshalToSizedBuffer(dAtA[:i])
@@ -1120,6 +1891,82 @@ func (m *EventRevoke) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventRefundDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRefundDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRefundDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventForfeitDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventForfeitDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventForfeitDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Burned {
+		i--
+		if m.Burned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvent(v)
 	base := offset
@@ -1169,6 +2016,87 @@ func (m *EventWithdrawFromTreasury) Size() (n int) {
 	return n
 }
 
+func (m *EventCollectFoundationTax) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Treasury) > 0 {
+		for _, e := range m.Treasury {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	if len(m.CommunityPool) > 0 {
+		for _, e := range m.CommunityPool {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	if len(m.Burned) > 0 {
+		for _, e := range m.Burned {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventCreateTreasuryStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stream.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventCancelTreasuryStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		n += 1 + sovEvent(uint64(m.StreamId))
+	}
+	if len(m.Remaining) > 0 {
+		for _, e := range m.Remaining {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventTreasuryStreamPayout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		n += 1 + sovEvent(uint64(m.StreamId))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	if m.Completed {
+		n += 2
+	}
+	return n
+}
+
 func (m *EventUpdateMembers) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1263,6 +2191,38 @@ func (m *EventLeaveFoundation) Size() (n int) {
 	return n
 }
 
+func (m *EventRemoveInactiveMember) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.ConsecutiveMissedVotes != 0 {
+		n += 1 + sovEvent(uint64(m.ConsecutiveMissedVotes))
+	}
+	return n
+}
+
+func (m *EventSuspendInactiveMember) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.ConsecutiveMissedVotes != 0 {
+		n += 1 + sovEvent(uint64(m.ConsecutiveMissedVotes))
+	}
+	return n
+}
+
 func (m *EventUpdateCensorship) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1288,6 +2248,10 @@ func (m *EventGrant) Size() (n int) {
 		l = m.Authorization.Size()
 		n += 1 + l + sovEvent(uint64(l))
 	}
+	if m.Expiration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovEvent(uint64(l))
+	}
 	return n
 }
 
@@ -1305,16 +2269,1019 @@ func (m *EventRevoke) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvent(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *EventRefundDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Deposit.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventForfeitDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Deposit.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.Burned {
+		n += 2
+	}
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventFundTreasury) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFundTreasury: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFundTreasury: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventWithdrawFromTreasury) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventWithdrawFromTreasury: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventWithdrawFromTreasury: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCollectFoundationTax) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCollectFoundationTax: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCollectFoundationTax: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Treasury = append(m.Treasury, types.Coin{})
+			if err := m.Treasury[len(m.Treasury)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityPool = append(m.CommunityPool, types.Coin{})
+			if err := m.CommunityPool[len(m.CommunityPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burned = append(m.Burned, types.Coin{})
+			if err := m.Burned[len(m.Burned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCreateTreasuryStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCreateTreasuryStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCreateTreasuryStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCancelTreasuryStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCancelTreasuryStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCancelTreasuryStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			m.StreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Remaining = append(m.Remaining, types.Coin{})
+			if err := m.Remaining[len(m.Remaining)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTreasuryStreamPayout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTreasuryStreamPayout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTreasuryStreamPayout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			m.StreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Completed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUpdateMembers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateMembers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateMembers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MemberUpdates = append(m.MemberUpdates, MemberRequest{})
+			if err := m.MemberUpdates[len(m.MemberUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUpdateDecisionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateDecisionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DecisionPolicy == nil {
+				m.DecisionPolicy = &types1.Any{}
+			}
+			if err := m.DecisionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSubmitProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSubmitProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSubmitProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovEvent(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvent(x uint64) (n int) {
-	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *EventFundTreasury) Unmarshal(dAtA []byte) error {
+func (m *EventWithdrawProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1337,17 +3304,17 @@ func (m *EventFundTreasury) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventFundTreasury: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventWithdrawProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventFundTreasury: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventWithdrawProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			var stringLen uint64
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1357,27 +3324,64 @@ func (m *EventFundTreasury) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvent
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1404,8 +3408,7 @@ func (m *EventFundTreasury) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Vote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1430,7 +3433,7 @@ func (m *EventFundTreasury) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventWithdrawFromTreasury) Unmarshal(dAtA []byte) error {
+func (m *EventExec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1453,17 +3456,17 @@ func (m *EventWithdrawFromTreasury) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventWithdrawFromTreasury: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventExec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventWithdrawFromTreasury: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventExec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			var stringLen uint64
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1473,29 +3476,35 @@ func (m *EventWithdrawFromTreasury) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= ProposalExecutorResult(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1505,25 +3514,23 @@ func (m *EventWithdrawFromTreasury) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Logs = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1546,7 +3553,7 @@ func (m *EventWithdrawFromTreasury) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventUpdateMembers) Unmarshal(dAtA []byte) error {
+func (m *EventLeaveFoundation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1569,17 +3576,17 @@ func (m *EventUpdateMembers) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventUpdateMembers: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventLeaveFoundation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUpdateMembers: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventLeaveFoundation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemberUpdates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1589,25 +3596,23 @@ func (m *EventUpdateMembers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MemberUpdates = append(m.MemberUpdates, MemberRequest{})
-			if err := m.MemberUpdates[len(m.MemberUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1630,7 +3635,7 @@ func (m *EventUpdateMembers) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventUpdateDecisionPolicy) Unmarshal(dAtA []byte) error {
+func (m *EventRemoveInactiveMember) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1653,17 +3658,17 @@ func (m *EventUpdateDecisionPolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventUpdateDecisionPolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRemoveInactiveMember: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUpdateDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRemoveInactiveMember: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DecisionPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1673,28 +3678,43 @@ func (m *EventUpdateDecisionPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DecisionPolicy == nil {
-				m.DecisionPolicy = &types1.Any{}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveMissedVotes", wireType)
 			}
-			if err := m.DecisionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ConsecutiveMissedVotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveMissedVotes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -1716,7 +3736,7 @@ func (m *EventUpdateDecisionPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSubmitProposal) Unmarshal(dAtA []byte) error {
+func (m *EventSuspendInactiveMember) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1739,17 +3759,17 @@ func (m *EventSubmitProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSubmitProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSuspendInactiveMember: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSubmitProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSuspendInactiveMember: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1759,80 +3779,29 @@ func (m *EventSubmitProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvent(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventWithdrawProposal) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvent
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventWithdrawProposal: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventWithdrawProposal: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveMissedVotes", wireType)
 			}
-			m.ProposalId = 0
+			m.ConsecutiveMissedVotes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1842,7 +3811,7 @@ func (m *EventWithdrawProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				m.ConsecutiveMissedVotes |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1868,7 +3837,7 @@ func (m *EventWithdrawProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventVote) Unmarshal(dAtA []byte) error {
+func (m *EventUpdateCensorship) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1891,15 +3860,15 @@ func (m *EventVote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventVote: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventUpdateCensorship: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventUpdateCensorship: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Censorship", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1926,7 +3895,7 @@ func (m *EventVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Vote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Censorship.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1951,7 +3920,7 @@ func (m *EventVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventExec) Unmarshal(dAtA []byte) error {
+func (m *EventGrant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1974,17 +3943,17 @@ func (m *EventExec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventExec: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventGrant: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventExec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventGrant: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
-			m.ProposalId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1994,16 +3963,29 @@ func (m *EventExec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorization", wireType)
 			}
-			m.Result = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2013,16 +3995,33 @@ func (m *EventExec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Result |= ProposalExecutorResult(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Authorization == nil {
+				m.Authorization = &types1.Any{}
+			}
+			if err := m.Authorization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2032,23 +4031,27 @@ func (m *EventExec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Logs = string(dAtA[iNdEx:postIndex])
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2071,7 +4074,7 @@ func (m *EventExec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventLeaveFoundation) Unmarshal(dAtA []byte) error {
+func (m *EventRevoke) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2094,15 +4097,15 @@ func (m *EventLeaveFoundation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventLeaveFoundation: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRevoke: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventLeaveFoundation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRevoke: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2130,63 +4133,13 @@ func (m *EventLeaveFoundation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Grantee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvent(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventUpdateCensorship) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvent
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventUpdateCensorship: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUpdateCensorship: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Censorship", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2196,24 +4149,23 @@ func (m *EventUpdateCensorship) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Censorship.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2236,7 +4188,7 @@ func (m *EventUpdateCensorship) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventGrant) Unmarshal(dAtA []byte) error {
+func (m *EventRefundDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2259,47 +4211,15 @@ func (m *EventGrant) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventGrant: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRefundDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRefundDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authorization", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2326,10 +4246,7 @@ func (m *EventGrant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Authorization == nil {
-				m.Authorization = &types1.Any{}
-			}
-			if err := m.Authorization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2354,7 +4271,7 @@ func (m *EventGrant) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRevoke) Unmarshal(dAtA []byte) error {
+func (m *EventForfeitDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2377,17 +4294,17 @@ func (m *EventRevoke) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRevoke: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventForfeitDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRevoke: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventForfeitDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2397,29 +4314,30 @@ func (m *EventRevoke) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2429,24 +4347,12 @@ func (m *EventRevoke) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Burned = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
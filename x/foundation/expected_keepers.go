@@ -10,6 +10,7 @@ type (
 	// foundation module.
 	AuthKeeper interface {
 		GetModuleAccount(ctx sdk.Context, name string) authtypes.ModuleAccountI
+		SetModuleAccount(ctx sdk.Context, macc authtypes.ModuleAccountI)
 	}
 
 	// BankKeeper defines the bank module interface contract needed by the
@@ -19,5 +20,13 @@ type (
 
 		SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 		SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+		SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
+		BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+	}
+
+	// DistributionKeeper defines the distribution module interface contract
+	// needed by the foundation module.
+	DistributionKeeper interface {
+		FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
 	}
 )
@@ -77,6 +77,47 @@ func (p Params) ValidateBasic() error {
 		return err
 	}
 
+	if err := validateMinDeposit(p.MinDeposit); err != nil {
+		return err
+	}
+
+	if err := p.TaxSplit.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s TaxSplit) ValidateBasic() error {
+	ratios := []struct {
+		name  string
+		ratio sdk.Dec
+	}{
+		{"treasury", s.Treasury},
+		{"community pool", s.CommunityPool},
+		{"burn", s.Burn},
+	}
+
+	sum := sdk.ZeroDec()
+	for _, r := range ratios {
+		if err := validateRatio(r.ratio, r.name); err != nil {
+			return sdkerrors.Wrap(err, ParamKeyTaxSplit)
+		}
+		sum = sum.Add(r.ratio)
+	}
+
+	if !sum.Equal(sdk.OneDec()) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("%s: sum of the ratios must be 1, got %s", ParamKeyTaxSplit, sum)
+	}
+
+	return nil
+}
+
+func validateMinDeposit(minDeposit sdk.Coins) error {
+	if err := minDeposit.Validate(); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("%s: %s", ParamKeyMinDeposit, err)
+	}
+
 	return nil
 }
 
@@ -91,6 +132,50 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 
 			return validateRatio(v, ParamKeyFoundationTax)
 		}),
+		paramtypes.NewParamSetPair([]byte(ParamKeyMinDeposit), &p.MinDeposit, func(i interface{}) error {
+			v, ok := i.(sdk.Coins)
+			if !ok {
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidType.Wrapf("%T", i), ParamKeyMinDeposit)
+			}
+
+			return validateMinDeposit(v)
+		}),
+		paramtypes.NewParamSetPair([]byte(ParamKeyBurnForfeitedDeposits), &p.BurnForfeitedDeposits, func(i interface{}) error {
+			if _, ok := i.(bool); !ok {
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidType.Wrapf("%T", i), ParamKeyBurnForfeitedDeposits)
+			}
+
+			return nil
+		}),
+		paramtypes.NewParamSetPair([]byte(ParamKeyMaxOpenProposalsPerMember), &p.MaxOpenProposalsPerMember, func(i interface{}) error {
+			if _, ok := i.(uint64); !ok {
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidType.Wrapf("%T", i), ParamKeyMaxOpenProposalsPerMember)
+			}
+
+			return nil
+		}),
+		paramtypes.NewParamSetPair([]byte(ParamKeyTaxSplit), &p.TaxSplit, func(i interface{}) error {
+			v, ok := i.(TaxSplit)
+			if !ok {
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidType.Wrapf("%T", i), ParamKeyTaxSplit)
+			}
+
+			return v.ValidateBasic()
+		}),
+		paramtypes.NewParamSetPair([]byte(ParamKeyMaxConsecutiveMissedVotes), &p.MaxConsecutiveMissedVotes, func(i interface{}) error {
+			if _, ok := i.(uint64); !ok {
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidType.Wrapf("%T", i), ParamKeyMaxConsecutiveMissedVotes)
+			}
+
+			return nil
+		}),
+		paramtypes.NewParamSetPair([]byte(ParamKeySuspendInactiveMembers), &p.SuspendInactiveMembers, func(i interface{}) error {
+			if _, ok := i.(bool); !ok {
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidType.Wrapf("%T", i), ParamKeySuspendInactiveMembers)
+			}
+
+			return nil
+		}),
 	}
 }
 
@@ -103,6 +188,10 @@ func (m Member) ValidateBasic() error {
 		return sdkerrors.ErrInvalidAddress.Wrapf("invalid member address: %s", m.Address)
 	}
 
+	if err := validateMemberWeight(m.Weight); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -112,6 +201,21 @@ func (m MemberRequest) ValidateBasic() error {
 		return sdkerrors.ErrInvalidAddress.Wrapf("invalid member address: %s", m.Address)
 	}
 
+	// weight is meaningless on removal
+	if !m.Remove {
+		if err := validateMemberWeight(m.Weight); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateMemberWeight(weight sdk.Dec) error {
+	if weight.IsNil() || !weight.IsPositive() {
+		return sdkerrors.ErrInvalidRequest.Wrap("member weight must be positive")
+	}
+
 	return nil
 }
 
@@ -149,9 +253,8 @@ func NewTallyResult(yes, abstain, no, veto sdk.Dec) TallyResult {
 	}
 }
 
-func (t *TallyResult) Add(option VoteOption) error {
-	weight := sdk.OneDec()
-
+// Add adds the weight of a vote to the count of its option.
+func (t *TallyResult) Add(option VoteOption, weight sdk.Dec) error {
 	switch option {
 	case VOTE_OPTION_YES:
 		t.YesCount = t.YesCount.Add(weight)
@@ -504,6 +607,60 @@ func (p Pool) ValidateBasic() error {
 	return nil
 }
 
+func (s TreasuryStream) ValidateBasic() error {
+	if s.Id == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty stream id")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(s.Recipient); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", s.Recipient)
+	}
+
+	if err := validateTreasuryStreamAmounts(s.Amount, s.Period, s.Remaining); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func validateTreasuryStreamAmounts(amount sdk.Coins, period time.Duration, total sdk.Coins) error {
+	if !amount.IsValid() || !amount.IsAllPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrap(amount.String())
+	}
+
+	if period < 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("negative period")
+	}
+
+	if !total.IsValid() || !total.IsAllPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrap(total.String())
+	}
+
+	// every denom of the total must be paid
+	if !total.DenomsSubsetOf(amount) || !amount.DenomsSubsetOf(total) {
+		return sdkerrors.ErrInvalidCoins.Wrapf("denoms of %s and %s do not match", amount, total)
+	}
+
+	return nil
+}
+
+// ValidateBasic performs stateless validation on a proposal deposit.
+func (d ProposalDeposit) ValidateBasic() error {
+	if d.ProposalId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty proposal id")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(d.Depositor); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid depositor address: %s", d.Depositor)
+	}
+
+	if !d.Amount.IsValid() || d.Amount.IsZero() {
+		return sdkerrors.ErrInvalidCoins.Wrap(d.Amount.String())
+	}
+
+	return nil
+}
+
 // Members defines a repeated slice of Member objects.
 type Members struct {
 	Members []Member
@@ -528,6 +685,16 @@ func (ms Members) ValidateBasic() error {
 	return nil
 }
 
+// TotalWeight returns the sum of the weights of the members.
+func (ms Members) TotalWeight() sdk.Dec {
+	totalWeight := sdk.ZeroDec()
+	for _, member := range ms.Members {
+		totalWeight = totalWeight.Add(member.Weight)
+	}
+
+	return totalWeight
+}
+
 // MemberRequests defines a repeated slice of MemberRequest objects.
 type MemberRequests struct {
 	Members []MemberRequest
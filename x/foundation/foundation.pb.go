@@ -5,9 +5,9 @@ package foundation
 
 import (
 	fmt "fmt"
-	types "github.com/Finschia/finschia-sdk/codec/types"
+	types1 "github.com/Finschia/finschia-sdk/codec/types"
 	github_com_Finschia_finschia_sdk_types "github.com/Finschia/finschia-sdk/types"
-	types1 "github.com/Finschia/finschia-sdk/types"
+	types "github.com/Finschia/finschia-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
@@ -190,6 +190,23 @@ func (ProposalExecutorResult) EnumDescriptor() ([]byte, []int) {
 // Params defines the parameters for the foundation module.
 type Params struct {
 	FoundationTax github_com_Finschia_finschia_sdk_types.Dec `protobuf:"bytes,1,opt,name=foundation_tax,json=foundationTax,proto3,customtype=github.com/Finschia/finschia-sdk/types.Dec" json:"foundation_tax"`
+	// min_deposit is the minimum deposit required to submit a proposal.
+	MinDeposit github_com_Finschia_finschia_sdk_types.Coins `protobuf:"bytes,3,rep,name=min_deposit,json=minDeposit,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coins" json:"min_deposit"`
+	// burn_forfeited_deposits defines whether the deposits of the withdrawn or
+	// aborted proposals are burned. If false, they are sent to the treasury.
+	BurnForfeitedDeposits bool `protobuf:"varint,4,opt,name=burn_forfeited_deposits,json=burnForfeitedDeposits,proto3" json:"burn_forfeited_deposits,omitempty"`
+	// max_open_proposals_per_member is the maximum number of the proposals in
+	// the voting period, which a member can propose. zero means no limit.
+	MaxOpenProposalsPerMember uint64 `protobuf:"varint,5,opt,name=max_open_proposals_per_member,json=maxOpenProposalsPerMember,proto3" json:"max_open_proposals_per_member,omitempty"`
+	// tax_split defines how the foundation tax is distributed.
+	TaxSplit TaxSplit `protobuf:"bytes,6,opt,name=tax_split,json=taxSplit,proto3" json:"tax_split"`
+	// max_consecutive_missed_votes is the number of the proposals in a row, which
+	// a member can miss to vote on before being removed from the foundation.
+	// zero means members are never removed for their inactivity.
+	MaxConsecutiveMissedVotes uint64 `protobuf:"varint,7,opt,name=max_consecutive_missed_votes,json=maxConsecutiveMissedVotes,proto3" json:"max_consecutive_missed_votes,omitempty"`
+	// suspend_inactive_members defines whether the inactive members are
+	// suspended instead of being removed from the foundation.
+	SuspendInactiveMembers bool `protobuf:"varint,8,opt,name=suspend_inactive_members,json=suspendInactiveMembers,proto3" json:"suspend_inactive_members,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -225,6 +242,92 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetMinDeposit() github_com_Finschia_finschia_sdk_types.Coins {
+	if m != nil {
+		return m.MinDeposit
+	}
+	return nil
+}
+
+func (m *Params) GetBurnForfeitedDeposits() bool {
+	if m != nil {
+		return m.BurnForfeitedDeposits
+	}
+	return false
+}
+
+func (m *Params) GetMaxOpenProposalsPerMember() uint64 {
+	if m != nil {
+		return m.MaxOpenProposalsPerMember
+	}
+	return 0
+}
+
+func (m *Params) GetTaxSplit() TaxSplit {
+	if m != nil {
+		return m.TaxSplit
+	}
+	return TaxSplit{}
+}
+
+func (m *Params) GetMaxConsecutiveMissedVotes() uint64 {
+	if m != nil {
+		return m.MaxConsecutiveMissedVotes
+	}
+	return 0
+}
+
+func (m *Params) GetSuspendInactiveMembers() bool {
+	if m != nil {
+		return m.SuspendInactiveMembers
+	}
+	return false
+}
+
+// TaxSplit defines the ratios of the foundation tax going to each destination.
+// The sum of the ratios must be 1.
+type TaxSplit struct {
+	// treasury is the ratio going to the foundation treasury.
+	Treasury github_com_Finschia_finschia_sdk_types.Dec `protobuf:"bytes,1,opt,name=treasury,proto3,customtype=github.com/Finschia/finschia-sdk/types.Dec" json:"treasury"`
+	// community_pool is the ratio going to the community pool of x/distribution.
+	CommunityPool github_com_Finschia_finschia_sdk_types.Dec `protobuf:"bytes,2,opt,name=community_pool,json=communityPool,proto3,customtype=github.com/Finschia/finschia-sdk/types.Dec" json:"community_pool"`
+	// burn is the ratio being burned.
+	Burn github_com_Finschia_finschia_sdk_types.Dec `protobuf:"bytes,3,opt,name=burn,proto3,customtype=github.com/Finschia/finschia-sdk/types.Dec" json:"burn"`
+}
+
+func (m *TaxSplit) Reset()         { *m = TaxSplit{} }
+func (m *TaxSplit) String() string { return proto.CompactTextString(m) }
+func (*TaxSplit) ProtoMessage()    {}
+func (*TaxSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1980496a233f02f4, []int{1}
+}
+func (m *TaxSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaxSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaxSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaxSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaxSplit.Merge(m, src)
+}
+func (m *TaxSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaxSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaxSplit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaxSplit proto.InternalMessageInfo
+
 type Censorship struct {
 	MsgTypeUrl string              `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
 	Authority  CensorshipAuthority `protobuf:"varint,2,opt,name=authority,proto3,enum=lbm.foundation.v1.CensorshipAuthority" json:"authority,omitempty"`
@@ -234,7 +337,7 @@ func (m *Censorship) Reset()         { *m = Censorship{} }
 func (m *Censorship) String() string { return proto.CompactTextString(m) }
 func (*Censorship) ProtoMessage()    {}
 func (*Censorship) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1980496a233f02f4, []int{1}
+	return fileDescriptor_1980496a233f02f4, []int{2}
 }
 func (m *Censorship) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -285,13 +388,15 @@ type Member struct {
 	Metadata string `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	// added_at is a timestamp specifying when a member was added.
 	AddedAt time.Time `protobuf:"bytes,4,opt,name=added_at,json=addedAt,proto3,stdtime" json:"added_at"`
+	// weight is the member's voting weight that should be greater than 0.
+	Weight github_com_Finschia_finschia_sdk_types.Dec `protobuf:"bytes,5,opt,name=weight,proto3,customtype=github.com/Finschia/finschia-sdk/types.Dec" json:"weight"`
 }
 
 func (m *Member) Reset()         { *m = Member{} }
 func (m *Member) String() string { return proto.CompactTextString(m) }
 func (*Member) ProtoMessage()    {}
 func (*Member) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1980496a233f02f4, []int{2}
+	return fileDescriptor_1980496a233f02f4, []int{3}
 }
 func (m *Member) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -341,6 +446,102 @@ func (m *Member) GetAddedAt() time.Time {
 	return time.Time{}
 }
 
+// MemberActivity represents the participation of a member in the proposals.
+type MemberActivity struct {
+	// address is the member's account address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// last_voted_proposal_id is the id of the last proposal the member has voted on.
+	LastVotedProposalId uint64 `protobuf:"varint,2,opt,name=last_voted_proposal_id,json=lastVotedProposalId,proto3" json:"last_voted_proposal_id,omitempty"`
+	// missed_votes is the number of the proposals the member has not voted on.
+	MissedVotes uint64 `protobuf:"varint,3,opt,name=missed_votes,json=missedVotes,proto3" json:"missed_votes,omitempty"`
+	// consecutive_missed_votes is the number of the proposals the member has not
+	// voted on since the last vote.
+	ConsecutiveMissedVotes uint64 `protobuf:"varint,4,opt,name=consecutive_missed_votes,json=consecutiveMissedVotes,proto3" json:"consecutive_missed_votes,omitempty"`
+	// suspended tells whether the member has been suspended for its inactivity.
+	// The weight of a suspended member does not count toward the total weight,
+	// and it cannot vote until the member gets updated by Msg/UpdateMembers.
+	Suspended bool `protobuf:"varint,5,opt,name=suspended,proto3" json:"suspended,omitempty"`
+	// blocked_version is the foundation version at which the member could not be
+	// removed or suspended for its inactivity, because no weight would be left in
+	// the foundation. It is not tried again until the foundation gets updated.
+	BlockedVersion uint64 `protobuf:"varint,6,opt,name=blocked_version,json=blockedVersion,proto3" json:"blocked_version,omitempty"`
+}
+
+func (m *MemberActivity) Reset()         { *m = MemberActivity{} }
+func (m *MemberActivity) String() string { return proto.CompactTextString(m) }
+func (*MemberActivity) ProtoMessage()    {}
+func (*MemberActivity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1980496a233f02f4, []int{4}
+}
+func (m *MemberActivity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MemberActivity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MemberActivity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MemberActivity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MemberActivity.Merge(m, src)
+}
+func (m *MemberActivity) XXX_Size() int {
+	return m.Size()
+}
+func (m *MemberActivity) XXX_DiscardUnknown() {
+	xxx_messageInfo_MemberActivity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MemberActivity proto.InternalMessageInfo
+
+func (m *MemberActivity) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MemberActivity) GetLastVotedProposalId() uint64 {
+	if m != nil {
+		return m.LastVotedProposalId
+	}
+	return 0
+}
+
+func (m *MemberActivity) GetMissedVotes() uint64 {
+	if m != nil {
+		return m.MissedVotes
+	}
+	return 0
+}
+
+func (m *MemberActivity) GetConsecutiveMissedVotes() uint64 {
+	if m != nil {
+		return m.ConsecutiveMissedVotes
+	}
+	return 0
+}
+
+func (m *MemberActivity) GetSuspended() bool {
+	if m != nil {
+		return m.Suspended
+	}
+	return false
+}
+
+func (m *MemberActivity) GetBlockedVersion() uint64 {
+	if m != nil {
+		return m.BlockedVersion
+	}
+	return 0
+}
+
 // MemberRequest represents a foundation member to be used in Msg server requests.
 // Contrary to `Member`, it doesn't have any `added_at` field
 // since this field cannot be set as part of requests.
@@ -351,13 +552,16 @@ type MemberRequest struct {
 	Remove bool `protobuf:"varint,2,opt,name=remove,proto3" json:"remove,omitempty"`
 	// metadata is any arbitrary metadata attached to the member.
 	Metadata string `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// weight is the member's voting weight that should be greater than 0.
+	// It is ignored if the member is being removed.
+	Weight github_com_Finschia_finschia_sdk_types.Dec `protobuf:"bytes,4,opt,name=weight,proto3,customtype=github.com/Finschia/finschia-sdk/types.Dec" json:"weight"`
 }
 
 func (m *MemberRequest) Reset()         { *m = MemberRequest{} }
 func (m *MemberRequest) String() string { return proto.CompactTextString(m) }
 func (*MemberRequest) ProtoMessage()    {}
 func (*MemberRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1980496a233f02f4, []int{3}
+	return fileDescriptor_1980496a233f02f4, []int{5}
 }
 func (m *MemberRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -424,7 +628,7 @@ func (m *ThresholdDecisionPolicy) Reset()         { *m = ThresholdDecisionPolicy
 func (m *ThresholdDecisionPolicy) String() string { return proto.CompactTextString(m) }
 func (*ThresholdDecisionPolicy) ProtoMessage()    {}
 func (*ThresholdDecisionPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1980496a233f02f4, []int{4}
+	return fileDescriptor_1980496a233f02f4, []int{6}
 }
 func (m *ThresholdDecisionPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -477,7 +681,7 @@ func (m *PercentageDecisionPolicy) Reset()         { *m = PercentageDecisionPoli
 func (m *PercentageDecisionPolicy) String() string { return proto.CompactTextString(m) }
 func (*PercentageDecisionPolicy) ProtoMessage()    {}
 func (*PercentageDecisionPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1980496a233f02f4, []int{5}
+	return fileDescriptor_1980496a233f02f4, []int{7}
 }
 func (m *PercentageDecisionPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -536,7 +740,7 @@ func (m *DecisionPolicyWindows) Reset()         { *m = DecisionPolicyWindows{} }
 func (m *DecisionPolicyWindows) String() string { return proto.CompactTextString(m) }
 func (*DecisionPolicyWindows) ProtoMessage()    {}
 func (*DecisionPolicyWindows) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1980496a233f02f4, []int{6}
+	return fileDescriptor_1980496a233f02f4, []int{8}
 }
 func (m *DecisionPolicyWindows) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -589,7 +793,7 @@ func (m *OutsourcingDecisionPolicy) Reset()         { *m = OutsourcingDecisionPo
 func (m *OutsourcingDecisionPolicy) String() string { return proto.CompactTextString(m) }
 func (*OutsourcingDecisionPolicy) ProtoMessage()    {}
 func (*OutsourcingDecisionPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1980496a233f02f4, []int{7}
+	return fileDescriptor_1980496a233f02f4, []int{9}
 }
 func (m *OutsourcingDecisionPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -635,14 +839,14 @@ type FoundationInfo struct {
 	// total_weight is the number of the foundation members.
 	TotalWeight github_com_Finschia_finschia_sdk_types.Dec `protobuf:"bytes,2,opt,name=total_weight,json=totalWeight,proto3,customtype=github.com/Finschia/finschia-sdk/types.Dec" json:"total_weight"`
 	// decision_policy specifies the foundation's decision policy.
-	DecisionPolicy *types.Any `protobuf:"bytes,3,opt,name=decision_policy,json=decisionPolicy,proto3" json:"decision_policy,omitempty"`
+	DecisionPolicy *types1.Any `protobuf:"bytes,3,opt,name=decision_policy,json=decisionPolicy,proto3" json:"decision_policy,omitempty"`
 }
 
 func (m *FoundationInfo) Reset()         { *m = FoundationInfo{} }
 func (m *FoundationInfo) String() string { return proto.CompactTextString(m) }
 func (*FoundationInfo) ProtoMessage()    {}
 func (*FoundationInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1980496a233f02f4, []int{8}
+	return fileDescriptor_1980496a233f02f4, []int{10}
 }
 func (m *FoundationInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -703,14 +907,18 @@ type Proposal struct {
 	// executor_result is the final result based on the votes and election rule. Initial value is NotRun.
 	ExecutorResult ProposalExecutorResult `protobuf:"varint,9,opt,name=executor_result,json=executorResult,proto3,enum=lbm.foundation.v1.ProposalExecutorResult" json:"executor_result,omitempty"`
 	// messages is a list of Msgs that will be executed if the proposal passes.
-	Messages []*types.Any `protobuf:"bytes,10,rep,name=messages,proto3" json:"messages,omitempty"`
+	Messages []*types1.Any `protobuf:"bytes,10,rep,name=messages,proto3" json:"messages,omitempty"`
+	// execute_at is the optional timestamp at which the proposal is executed
+	// automatically once accepted. The failed executions are retried on every
+	// block until the proposal is pruned.
+	ExecuteAt *time.Time `protobuf:"bytes,11,opt,name=execute_at,json=executeAt,proto3,stdtime" json:"execute_at,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
 func (m *Proposal) String() string { return proto.CompactTextString(m) }
 func (*Proposal) ProtoMessage()    {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1980496a233f02f4, []int{9}
+	return fileDescriptor_1980496a233f02f4, []int{11}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -755,7 +963,7 @@ func (m *TallyResult) Reset()         { *m = TallyResult{} }
 func (m *TallyResult) String() string { return proto.CompactTextString(m) }
 func (*TallyResult) ProtoMessage()    {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1980496a233f02f4, []int{10}
+	return fileDescriptor_1980496a233f02f4, []int{12}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -802,7 +1010,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1980496a233f02f4, []int{11}
+	return fileDescriptor_1980496a233f02f4, []int{13}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -875,7 +1083,7 @@ func (m *Pool) Reset()         { *m = Pool{} }
 func (m *Pool) String() string { return proto.CompactTextString(m) }
 func (*Pool) ProtoMessage()    {}
 func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1980496a233f02f4, []int{12}
+	return fileDescriptor_1980496a233f02f4, []int{14}
 }
 func (m *Pool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -911,27 +1119,30 @@ func (m *Pool) GetTreasury() github_com_Finschia_finschia_sdk_types.DecCoins {
 	return nil
 }
 
-// FoundationExecProposal is x/gov proposal to trigger the x/foundation messages on behalf of x/gov.
-type FoundationExecProposal struct {
-	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	// x/foundation messages to execute
-	// all the signers must be x/gov authority.
-	Messages []*types.Any `protobuf:"bytes,3,rep,name=messages,proto3" json:"messages,omitempty"`
+// TaxRecord records how the foundation tax of a block has been distributed.
+type TaxRecord struct {
+	// height is the block height at which the tax has been collected.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// treasury is the amount sent to the foundation treasury.
+	Treasury github_com_Finschia_finschia_sdk_types.Coins `protobuf:"bytes,2,rep,name=treasury,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coins" json:"treasury"`
+	// community_pool is the amount sent to the community pool.
+	CommunityPool github_com_Finschia_finschia_sdk_types.Coins `protobuf:"bytes,3,rep,name=community_pool,json=communityPool,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coins" json:"community_pool"`
+	// burned is the amount burned.
+	Burned github_com_Finschia_finschia_sdk_types.Coins `protobuf:"bytes,4,rep,name=burned,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coins" json:"burned"`
 }
 
-func (m *FoundationExecProposal) Reset()         { *m = FoundationExecProposal{} }
-func (m *FoundationExecProposal) String() string { return proto.CompactTextString(m) }
-func (*FoundationExecProposal) ProtoMessage()    {}
-func (*FoundationExecProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1980496a233f02f4, []int{13}
+func (m *TaxRecord) Reset()         { *m = TaxRecord{} }
+func (m *TaxRecord) String() string { return proto.CompactTextString(m) }
+func (*TaxRecord) ProtoMessage()    {}
+func (*TaxRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1980496a233f02f4, []int{15}
 }
-func (m *FoundationExecProposal) XXX_Unmarshal(b []byte) error {
+func (m *TaxRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *FoundationExecProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TaxRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_FoundationExecProposal.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TaxRecord.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -941,222 +1152,438 @@ func (m *FoundationExecProposal) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *FoundationExecProposal) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FoundationExecProposal.Merge(m, src)
+func (m *TaxRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaxRecord.Merge(m, src)
 }
-func (m *FoundationExecProposal) XXX_Size() int {
+func (m *TaxRecord) XXX_Size() int {
 	return m.Size()
 }
-func (m *FoundationExecProposal) XXX_DiscardUnknown() {
-	xxx_messageInfo_FoundationExecProposal.DiscardUnknown(m)
+func (m *TaxRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaxRecord.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FoundationExecProposal proto.InternalMessageInfo
+var xxx_messageInfo_TaxRecord proto.InternalMessageInfo
 
-func (m *FoundationExecProposal) GetTitle() string {
+func (m *TaxRecord) GetHeight() int64 {
 	if m != nil {
-		return m.Title
+		return m.Height
 	}
-	return ""
+	return 0
 }
 
-func (m *FoundationExecProposal) GetDescription() string {
+func (m *TaxRecord) GetTreasury() github_com_Finschia_finschia_sdk_types.Coins {
 	if m != nil {
-		return m.Description
+		return m.Treasury
 	}
-	return ""
+	return nil
 }
 
-func (m *FoundationExecProposal) GetMessages() []*types.Any {
+func (m *TaxRecord) GetCommunityPool() github_com_Finschia_finschia_sdk_types.Coins {
 	if m != nil {
-		return m.Messages
+		return m.CommunityPool
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("lbm.foundation.v1.CensorshipAuthority", CensorshipAuthority_name, CensorshipAuthority_value)
-	proto.RegisterEnum("lbm.foundation.v1.VoteOption", VoteOption_name, VoteOption_value)
-	proto.RegisterEnum("lbm.foundation.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
-	proto.RegisterEnum("lbm.foundation.v1.ProposalExecutorResult", ProposalExecutorResult_name, ProposalExecutorResult_value)
-	proto.RegisterType((*Params)(nil), "lbm.foundation.v1.Params")
-	proto.RegisterType((*Censorship)(nil), "lbm.foundation.v1.Censorship")
-	proto.RegisterType((*Member)(nil), "lbm.foundation.v1.Member")
-	proto.RegisterType((*MemberRequest)(nil), "lbm.foundation.v1.MemberRequest")
-	proto.RegisterType((*ThresholdDecisionPolicy)(nil), "lbm.foundation.v1.ThresholdDecisionPolicy")
-	proto.RegisterType((*PercentageDecisionPolicy)(nil), "lbm.foundation.v1.PercentageDecisionPolicy")
-	proto.RegisterType((*DecisionPolicyWindows)(nil), "lbm.foundation.v1.DecisionPolicyWindows")
-	proto.RegisterType((*OutsourcingDecisionPolicy)(nil), "lbm.foundation.v1.OutsourcingDecisionPolicy")
-	proto.RegisterType((*FoundationInfo)(nil), "lbm.foundation.v1.FoundationInfo")
-	proto.RegisterType((*Proposal)(nil), "lbm.foundation.v1.Proposal")
-	proto.RegisterType((*TallyResult)(nil), "lbm.foundation.v1.TallyResult")
-	proto.RegisterType((*Vote)(nil), "lbm.foundation.v1.Vote")
-	proto.RegisterType((*Pool)(nil), "lbm.foundation.v1.Pool")
-	proto.RegisterType((*FoundationExecProposal)(nil), "lbm.foundation.v1.FoundationExecProposal")
+func (m *TaxRecord) GetBurned() github_com_Finschia_finschia_sdk_types.Coins {
+	if m != nil {
+		return m.Burned
+	}
+	return nil
 }
 
-func init() {
-	proto.RegisterFile("lbm/foundation/v1/foundation.proto", fileDescriptor_1980496a233f02f4)
+// TreasuryStream defines a budget which pays the recipient from the treasury periodically.
+type TreasuryStream struct {
+	// id is the unique ID of the stream.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// recipient is the account address receiving the payouts.
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount is the amount paid on each payout.
+	Amount github_com_Finschia_finschia_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coins" json:"amount"`
+	// period is the interval between the payouts.
+	// zero means the payout happens on every block.
+	Period time.Duration `protobuf:"bytes,4,opt,name=period,proto3,stdduration" json:"period"`
+	// remaining is the amount left to be paid.
+	// the stream is removed once it has been paid in full.
+	Remaining github_com_Finschia_finschia_sdk_types.Coins `protobuf:"bytes,5,rep,name=remaining,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coins" json:"remaining"`
+	// next_payout_time is the time from which the next payout happens.
+	NextPayoutTime time.Time `protobuf:"bytes,6,opt,name=next_payout_time,json=nextPayoutTime,proto3,stdtime" json:"next_payout_time"`
+}
+
+func (m *TreasuryStream) Reset()         { *m = TreasuryStream{} }
+func (m *TreasuryStream) String() string { return proto.CompactTextString(m) }
+func (*TreasuryStream) ProtoMessage()    {}
+func (*TreasuryStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1980496a233f02f4, []int{16}
+}
+func (m *TreasuryStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-var fileDescriptor_1980496a233f02f4 = []byte{
-	// 1506 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4d, 0x6c, 0x1b, 0xc5,
-	0x17, 0xcf, 0xda, 0x4e, 0x62, 0x3f, 0x27, 0x8e, 0x3b, 0xcd, 0xbf, 0x75, 0xdc, 0xd6, 0x76, 0xad,
-	0xea, 0xaf, 0x50, 0x29, 0x36, 0x09, 0x42, 0x88, 0x5e, 0x90, 0x3f, 0x36, 0x8d, 0x4b, 0xeb, 0x75,
-	0xc7, 0xeb, 0x84, 0x22, 0xa1, 0xd5, 0xda, 0x3b, 0xb1, 0x57, 0xd8, 0x3b, 0xee, 0xce, 0xd8, 0x89,
-	0x4f, 0x48, 0x9c, 0xaa, 0x5e, 0xe8, 0x91, 0x4b, 0x25, 0x24, 0x2e, 0x88, 0x33, 0x12, 0x88, 0x2b,
-	0x12, 0xaa, 0x38, 0xa0, 0x8a, 0x0b, 0xa8, 0x87, 0x16, 0xa5, 0x67, 0x8e, 0xdc, 0xd1, 0x7e, 0xf9,
-	0x2b, 0x4e, 0x68, 0x53, 0x71, 0xf3, 0x9b, 0xf7, 0xde, 0x6f, 0x7e, 0xef, 0x73, 0xd6, 0x90, 0x6e,
-	0xd7, 0x3b, 0xd9, 0x7d, 0xda, 0x33, 0x34, 0x95, 0xeb, 0xd4, 0xc8, 0xf6, 0x37, 0xc7, 0xa4, 0x4c,
-	0xd7, 0xa4, 0x9c, 0xa2, 0x73, 0xed, 0x7a, 0x27, 0x33, 0x76, 0xda, 0xdf, 0x8c, 0xaf, 0x36, 0x69,
-	0x93, 0xda, 0xda, 0xac, 0xf5, 0xcb, 0x31, 0x8c, 0x27, 0x9a, 0x94, 0x36, 0xdb, 0x24, 0x6b, 0x4b,
-	0xf5, 0xde, 0x7e, 0x56, 0xeb, 0x99, 0x63, 0x40, 0xf1, 0xe4, 0xb4, 0x9e, 0xeb, 0x1d, 0xc2, 0xb8,
-	0xda, 0xe9, 0xba, 0x06, 0x6b, 0xd3, 0x06, 0xaa, 0x31, 0xf0, 0xb0, 0x1b, 0x94, 0x75, 0x28, 0xcb,
-	0xd6, 0x55, 0x46, 0xb2, 0xfd, 0xcd, 0x3a, 0xe1, 0xea, 0x66, 0xb6, 0x41, 0x75, 0x0f, 0x7b, 0xcd,
-	0xd1, 0x2b, 0x0e, 0x29, 0x47, 0x70, 0x54, 0x69, 0x1d, 0x16, 0x2a, 0xaa, 0xa9, 0x76, 0x18, 0xba,
-	0x07, 0x91, 0x51, 0x1c, 0x0a, 0x57, 0x0f, 0x63, 0x42, 0x4a, 0x58, 0x0f, 0xe5, 0xb7, 0x9e, 0x3c,
-	0x4f, 0xce, 0x3d, 0x7b, 0x9e, 0xbc, 0xde, 0xd4, 0x79, 0xab, 0x57, 0xcf, 0x34, 0x68, 0x27, 0xbb,
-	0xad, 0x1b, 0xac, 0xd1, 0xd2, 0xd5, 0xec, 0xbe, 0xfb, 0x63, 0x83, 0x69, 0x9f, 0x66, 0xf9, 0xa0,
-	0x4b, 0x58, 0xa6, 0x48, 0x1a, 0x78, 0x79, 0x84, 0x24, 0xab, 0x87, 0xb7, 0x02, 0x41, 0x5f, 0xd4,
-	0x9f, 0xe6, 0x00, 0x05, 0x62, 0x30, 0x6a, 0xb2, 0x96, 0xde, 0x45, 0x29, 0x58, 0xea, 0xb0, 0xa6,
-	0x62, 0xf9, 0x28, 0x3d, 0xb3, 0xed, 0x5c, 0x86, 0xa1, 0xc3, 0x9a, 0xf2, 0xa0, 0x4b, 0x6a, 0x66,
-	0x1b, 0x15, 0x21, 0xa4, 0xf6, 0x78, 0x8b, 0x9a, 0x3a, 0x1f, 0xc4, 0x7c, 0x29, 0x61, 0x3d, 0xb2,
-	0xf5, 0xff, 0xcc, 0xb1, 0x74, 0x67, 0x46, 0x98, 0x39, 0xcf, 0x1a, 0x8f, 0x1c, 0xd3, 0x9f, 0xc1,
-	0xc2, 0x1d, 0xd2, 0xa9, 0x13, 0x13, 0xc5, 0x60, 0x51, 0xd5, 0x34, 0x93, 0x30, 0xe6, 0x5e, 0xe6,
-	0x89, 0x28, 0x0e, 0xc1, 0x0e, 0xe1, 0xaa, 0xa6, 0x72, 0xd5, 0xbe, 0x28, 0x84, 0x87, 0x32, 0xfa,
-	0x00, 0x82, 0xaa, 0xa6, 0x11, 0x4d, 0x51, 0x79, 0x2c, 0x90, 0x12, 0xd6, 0xc3, 0x5b, 0xf1, 0x8c,
-	0x53, 0x89, 0x8c, 0x57, 0x89, 0x8c, 0xec, 0x95, 0x2a, 0x1f, 0xb4, 0x92, 0xf5, 0xe8, 0x45, 0x52,
-	0xb0, 0xc1, 0x89, 0x96, 0xe3, 0xe9, 0x4f, 0x60, 0xd9, 0x21, 0x80, 0xc9, 0xfd, 0x1e, 0x61, 0xfc,
-	0x14, 0x1e, 0x17, 0x60, 0xc1, 0x24, 0x1d, 0xda, 0x27, 0x36, 0x8b, 0x20, 0x76, 0xa5, 0x09, 0x7e,
-	0xfe, 0x49, 0x7e, 0xe9, 0x9f, 0x04, 0xb8, 0x28, 0xb7, 0x4c, 0xc2, 0x5a, 0xb4, 0xad, 0x15, 0x49,
-	0x43, 0x67, 0x3a, 0x35, 0x2a, 0xb4, 0xad, 0x37, 0x06, 0xa8, 0x02, 0x21, 0xee, 0xa9, 0xde, 0xa0,
-	0x9a, 0x23, 0x10, 0x94, 0x87, 0xc5, 0x03, 0xdd, 0xd0, 0xe8, 0x01, 0xb3, 0x29, 0x86, 0xb7, 0xd6,
-	0x67, 0x54, 0x64, 0x92, 0xc5, 0x9e, 0x63, 0x8f, 0x3d, 0xc7, 0x1b, 0xe8, 0xb7, 0xef, 0x36, 0x22,
-	0x93, 0x36, 0xe9, 0x9f, 0x05, 0x88, 0x55, 0x88, 0xd9, 0x20, 0x06, 0x57, 0x9b, 0x64, 0x2a, 0x0c,
-	0x0c, 0xd0, 0x1d, 0xea, 0xde, 0x20, 0x8e, 0x31, 0x94, 0xff, 0x2c, 0x90, 0x1f, 0x04, 0xf8, 0xdf,
-	0x4c, 0x37, 0xb4, 0x03, 0xcb, 0x7d, 0xca, 0x75, 0xa3, 0xa9, 0x74, 0x89, 0xa9, 0x53, 0xa7, 0x20,
-	0xe1, 0xad, 0xb5, 0x63, 0xdd, 0x54, 0x74, 0x17, 0x83, 0xd3, 0x4c, 0x5f, 0x5a, 0xcd, 0xb4, 0xe4,
-	0x78, 0x56, 0x6c, 0x47, 0x54, 0x83, 0xd5, 0x8e, 0x6e, 0x28, 0xe4, 0x90, 0x34, 0x7a, 0xf6, 0xb0,
-	0xba, 0x80, 0xbe, 0x57, 0x07, 0x44, 0x1d, 0xdd, 0x10, 0x3d, 0x7f, 0x07, 0x36, 0x7d, 0x17, 0xd6,
-	0xa4, 0x1e, 0x67, 0xb4, 0x67, 0x36, 0x74, 0xa3, 0x39, 0x55, 0x83, 0x14, 0x84, 0x35, 0xc2, 0x1a,
-	0xa6, 0xde, 0xb5, 0x3c, 0xdc, 0xc6, 0x1d, 0x3f, 0x9a, 0x99, 0x8d, 0x67, 0x02, 0x44, 0xb6, 0x87,
-	0x29, 0x2d, 0x19, 0xfb, 0xd4, 0xea, 0xfe, 0x3e, 0x31, 0x99, 0x07, 0x12, 0xc0, 0x9e, 0x88, 0x6a,
-	0xb0, 0xc4, 0x29, 0x57, 0xdb, 0xca, 0x01, 0xd1, 0x9b, 0x2d, 0xee, 0x4c, 0xe2, 0x99, 0x0a, 0x1d,
-	0xb6, 0x71, 0xf6, 0x6c, 0x18, 0x74, 0x17, 0x56, 0x34, 0x97, 0x95, 0xd2, 0xb5, 0x69, 0xd9, 0x33,
-	0x14, 0xde, 0x5a, 0x3d, 0x96, 0xa8, 0x9c, 0x31, 0xc8, 0xa3, 0x5f, 0x8e, 0x85, 0x81, 0x23, 0xda,
-	0x84, 0x7c, 0x23, 0xf0, 0xe0, 0xab, 0xe4, 0x5c, 0xfa, 0xfb, 0x00, 0x04, 0x2b, 0x26, 0xed, 0x52,
-	0xa6, 0xb6, 0x51, 0x04, 0x7c, 0xba, 0xe6, 0x46, 0xe4, 0xd3, 0xb5, 0x53, 0x57, 0xca, 0x65, 0x08,
-	0x75, 0x6d, 0x3f, 0x62, 0xb2, 0x98, 0x3f, 0xe5, 0x5f, 0x0f, 0xe1, 0xd1, 0x01, 0x12, 0x21, 0xcc,
-	0x7a, 0xf5, 0x8e, 0xce, 0x15, 0xeb, 0x05, 0x78, 0xad, 0x9d, 0x03, 0x8e, 0xa3, 0xa5, 0x42, 0x1b,
-	0x80, 0xc6, 0xd6, 0xb9, 0x97, 0xf2, 0x79, 0x9b, 0xe0, 0xb9, 0x91, 0x66, 0xd7, 0x4d, 0xfe, 0xfb,
-	0xb0, 0xc0, 0xb8, 0xca, 0x7b, 0x2c, 0xb6, 0x60, 0x6f, 0xda, 0xab, 0x33, 0xc6, 0xc1, 0x0b, 0xb6,
-	0x6a, 0x1b, 0x62, 0xd7, 0x01, 0x61, 0x40, 0xfb, 0xba, 0xa1, 0xb6, 0x15, 0xae, 0xb6, 0xdb, 0x03,
-	0xc5, 0x24, 0xac, 0xd7, 0xe6, 0xb1, 0x45, 0x9b, 0x77, 0x62, 0x06, 0x8c, 0x6c, 0x99, 0x61, 0xdb,
-	0x2a, 0x1f, 0xb0, 0xb8, 0xe3, 0xa8, 0xed, 0x3f, 0x76, 0x8e, 0x2a, 0x70, 0x6e, 0x62, 0x58, 0x14,
-	0x62, 0x68, 0xb1, 0xe0, 0x6b, 0xa4, 0x62, 0x65, 0x7c, 0x62, 0x44, 0x43, 0x43, 0x18, 0x56, 0x9c,
-	0x81, 0xa1, 0xa6, 0x47, 0x31, 0x64, 0x47, 0xfa, 0xd6, 0x29, 0x91, 0x8a, 0xae, 0x87, 0xc3, 0x0a,
-	0x47, 0xc8, 0x84, 0x8c, 0xde, 0xb6, 0x8a, 0xcc, 0x98, 0xda, 0x24, 0x2c, 0x06, 0x29, 0xff, 0x49,
-	0x3d, 0x85, 0x87, 0x56, 0x6e, 0xe7, 0xfc, 0xe5, 0x83, 0xf0, 0x78, 0xb4, 0x12, 0x84, 0x06, 0x84,
-	0x29, 0x0d, 0xda, 0x33, 0xf8, 0x1b, 0xec, 0xb7, 0xe0, 0x80, 0xb0, 0x82, 0x85, 0x81, 0xf6, 0x60,
-	0x59, 0xad, 0x33, 0xae, 0xea, 0x86, 0x0b, 0x7a, 0xf6, 0x59, 0x5a, 0x72, 0x81, 0x1c, 0xe0, 0x3b,
-	0x10, 0x34, 0xa8, 0x8b, 0xe9, 0x3f, 0x33, 0xe6, 0xa2, 0x41, 0x1d, 0x38, 0x05, 0x90, 0x41, 0x95,
-	0x03, 0x9d, 0xb7, 0x94, 0x3e, 0xe1, 0x1e, 0x70, 0xe0, 0xcc, 0xc0, 0x2b, 0x06, 0xdd, 0xd3, 0x79,
-	0x6b, 0x97, 0x70, 0xe7, 0x02, 0x37, 0xdf, 0xbf, 0x0b, 0x10, 0xd8, 0xa5, 0x9c, 0xa0, 0x24, 0x84,
-	0xbb, 0x6e, 0x69, 0x95, 0xe1, 0xb8, 0x82, 0x77, 0x54, 0xd2, 0xd0, 0x2a, 0xcc, 0xf7, 0x29, 0x27,
-	0xa6, 0x3b, 0xb3, 0x8e, 0x80, 0xde, 0x85, 0x05, 0xea, 0xec, 0x3d, 0xbf, 0xdd, 0x32, 0x57, 0x66,
-	0xb4, 0x8c, 0x85, 0x2f, 0xd9, 0x46, 0xd8, 0x35, 0x9e, 0xd8, 0x01, 0x81, 0xa9, 0x1d, 0x30, 0x35,
-	0xe5, 0xf3, 0x67, 0x9b, 0xf2, 0xf4, 0x00, 0x02, 0x15, 0x4a, 0xdb, 0xe8, 0x3e, 0x04, 0xb9, 0x49,
-	0x54, 0xd6, 0x33, 0x07, 0x31, 0xc1, 0xee, 0xc4, 0xcb, 0x19, 0xf7, 0x3b, 0xcf, 0xfa, 0x28, 0xcc,
-	0xb8, 0x1f, 0x85, 0x56, 0x92, 0x0a, 0x54, 0x37, 0xf2, 0xef, 0x59, 0x68, 0xdf, 0xbe, 0x48, 0x66,
-	0x5f, 0x3d, 0xb9, 0x96, 0x1f, 0xc3, 0xc3, 0x6b, 0xd2, 0x9f, 0x0b, 0x70, 0x61, 0xb4, 0xdb, 0xad,
-	0x49, 0x19, 0x2e, 0xc3, 0x55, 0x98, 0xe7, 0x3a, 0x6f, 0xbb, 0x6f, 0x35, 0x76, 0x84, 0xe9, 0x27,
-	0xc4, 0x77, 0xec, 0x09, 0x99, 0x98, 0x27, 0xff, 0xab, 0xcc, 0xd3, 0xf5, 0xbf, 0x05, 0x38, 0x3f,
-	0xe3, 0x03, 0x10, 0xed, 0x40, 0xaa, 0x20, 0x96, 0xab, 0x12, 0xae, 0xee, 0x94, 0x2a, 0x4a, 0xae,
-	0x26, 0xef, 0x48, 0xb8, 0x24, 0xdf, 0x53, 0x6a, 0xe5, 0x6a, 0x45, 0x2c, 0x94, 0xb6, 0x4b, 0x62,
-	0x31, 0x3a, 0x17, 0x4f, 0x3f, 0x7c, 0x9c, 0x4a, 0xcc, 0x70, 0xaf, 0x19, 0xac, 0x4b, 0x1a, 0xfa,
-	0xbe, 0x4e, 0x34, 0xb4, 0x0d, 0xc9, 0x99, 0x48, 0x37, 0xa5, 0x5d, 0x11, 0x97, 0x73, 0xe5, 0x82,
-	0x18, 0x15, 0xe2, 0x57, 0x1f, 0x3e, 0x4e, 0x5d, 0x99, 0x01, 0x74, 0x93, 0xf6, 0x89, 0x69, 0xa8,
-	0x46, 0x83, 0x9c, 0x88, 0xb3, 0x2d, 0xd5, 0xca, 0xc5, 0x9c, 0x5c, 0x92, 0xca, 0x51, 0xdf, 0x89,
-	0x38, 0xa3, 0x3c, 0xc7, 0x03, 0x0f, 0xbe, 0x4e, 0xcc, 0x5d, 0xff, 0x42, 0x00, 0x18, 0x75, 0x1c,
-	0xba, 0x04, 0x17, 0x77, 0x25, 0x59, 0x54, 0xa4, 0x8a, 0x05, 0x34, 0x19, 0x25, 0x3a, 0x0f, 0x2b,
-	0xe3, 0xca, 0x7b, 0x62, 0x35, 0x2a, 0xa0, 0x8b, 0x70, 0x7e, 0xfc, 0x30, 0x97, 0xaf, 0xca, 0xb9,
-	0x52, 0x39, 0xea, 0x43, 0x08, 0x22, 0xe3, 0x8a, 0xb2, 0x14, 0xf5, 0xa3, 0xcb, 0x10, 0x9b, 0x3c,
-	0x53, 0xf6, 0x4a, 0xf2, 0x8e, 0xb2, 0x2b, 0xca, 0x52, 0x34, 0xe0, 0x32, 0xfa, 0x55, 0x80, 0xc8,
-	0xe4, 0x03, 0x81, 0x92, 0x70, 0xa9, 0x82, 0xa5, 0x8a, 0x54, 0xcd, 0xdd, 0x56, 0xaa, 0x72, 0x4e,
-	0xae, 0x55, 0xa7, 0x98, 0x5d, 0x81, 0xb5, 0x69, 0x83, 0x6a, 0x2d, 0x7f, 0xa7, 0x24, 0xcb, 0x62,
-	0x31, 0x2a, 0x58, 0xd7, 0x4e, 0xab, 0x73, 0x85, 0x82, 0x58, 0xb1, 0xb4, 0xbe, 0x59, 0x5a, 0x2c,
-	0xde, 0x12, 0x0b, 0x96, 0xd6, 0x6f, 0x65, 0xe4, 0x98, 0x6f, 0x5e, 0xc2, 0x96, 0x32, 0x30, 0xeb,
-	0x5e, 0x2b, 0xa0, 0x22, 0xce, 0xed, 0x95, 0xa3, 0xf3, 0x6e, 0x40, 0x3f, 0x0a, 0x70, 0x61, 0xf6,
-	0x3b, 0x80, 0xd6, 0xe1, 0xda, 0xd0, 0x5f, 0xfc, 0x48, 0x2c, 0xd4, 0x64, 0x09, 0x2b, 0x58, 0xac,
-	0xd6, 0x6e, 0xcb, 0x53, 0x11, 0x5e, 0x83, 0xd4, 0x89, 0x96, 0x65, 0x49, 0x56, 0x70, 0xad, 0x1c,
-	0x15, 0x4e, 0xb5, 0xaa, 0xd6, 0x0a, 0x05, 0xb1, 0x5a, 0x8d, 0xfa, 0x4e, 0xb5, 0xda, 0xce, 0x95,
-	0x6e, 0xd7, 0xb0, 0x18, 0xf5, 0x3b, 0xe4, 0xf3, 0x1f, 0x7e, 0x73, 0x94, 0x10, 0x9e, 0x1c, 0x25,
-	0x84, 0xa7, 0x47, 0x09, 0xe1, 0xcf, 0xa3, 0x84, 0xf0, 0xe8, 0x65, 0x62, 0xee, 0xe9, 0xcb, 0xc4,
-	0xdc, 0x1f, 0x2f, 0x13, 0x73, 0x1f, 0x6f, 0xfc, 0xeb, 0xd4, 0x1f, 0x8e, 0xfd, 0xd3, 0xad, 0x2f,
-	0xd8, 0xc3, 0xf7, 0xce, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xcd, 0xef, 0x06, 0x11, 0x10, 0x0f,
-	0x00, 0x00,
+func (m *TreasuryStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TreasuryStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TreasuryStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TreasuryStream.Merge(m, src)
+}
+func (m *TreasuryStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *TreasuryStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_TreasuryStream.DiscardUnknown(m)
 }
 
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+var xxx_messageInfo_TreasuryStream proto.InternalMessageInfo
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.FoundationTax.Equal(that1.FoundationTax) {
-		return false
+func (m *TreasuryStream) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return true
+	return 0
 }
-func (this *Censorship) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*Censorship)
-	if !ok {
-		that2, ok := that.(Censorship)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *TreasuryStream) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *TreasuryStream) GetAmount() github_com_Finschia_finschia_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	if this.MsgTypeUrl != that1.MsgTypeUrl {
-		return false
+	return nil
+}
+
+func (m *TreasuryStream) GetPeriod() time.Duration {
+	if m != nil {
+		return m.Period
 	}
-	if this.Authority != that1.Authority {
-		return false
+	return 0
+}
+
+func (m *TreasuryStream) GetRemaining() github_com_Finschia_finschia_sdk_types.Coins {
+	if m != nil {
+		return m.Remaining
 	}
-	return true
+	return nil
 }
-func (this *Member) Equal(that interface{}) bool {
+
+func (m *TreasuryStream) GetNextPayoutTime() time.Time {
+	if m != nil {
+		return m.NextPayoutTime
+	}
+	return time.Time{}
+}
+
+// ProposalDeposit defines the deposit of a proposal, escrowed until the
+// proposal gets its final status.
+type ProposalDeposit struct {
+	// proposal_id is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// depositor is the account address which has paid the deposit.
+	Depositor string `protobuf:"bytes,2,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	// amount is the amount of the deposit.
+	Amount github_com_Finschia_finschia_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coins" json:"amount"`
+}
+
+func (m *ProposalDeposit) Reset()         { *m = ProposalDeposit{} }
+func (m *ProposalDeposit) String() string { return proto.CompactTextString(m) }
+func (*ProposalDeposit) ProtoMessage()    {}
+func (*ProposalDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1980496a233f02f4, []int{17}
+}
+func (m *ProposalDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposalDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalDeposit.Merge(m, src)
+}
+func (m *ProposalDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalDeposit proto.InternalMessageInfo
+
+func (m *ProposalDeposit) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *ProposalDeposit) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
+	}
+	return ""
+}
+
+func (m *ProposalDeposit) GetAmount() github_com_Finschia_finschia_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// FoundationExecProposal is x/gov proposal to trigger the x/foundation messages on behalf of x/gov.
+type FoundationExecProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// x/foundation messages to execute
+	// all the signers must be x/gov authority.
+	Messages []*types1.Any `protobuf:"bytes,3,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (m *FoundationExecProposal) Reset()         { *m = FoundationExecProposal{} }
+func (m *FoundationExecProposal) String() string { return proto.CompactTextString(m) }
+func (*FoundationExecProposal) ProtoMessage()    {}
+func (*FoundationExecProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1980496a233f02f4, []int{18}
+}
+func (m *FoundationExecProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FoundationExecProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FoundationExecProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FoundationExecProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FoundationExecProposal.Merge(m, src)
+}
+func (m *FoundationExecProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *FoundationExecProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_FoundationExecProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FoundationExecProposal proto.InternalMessageInfo
+
+func (m *FoundationExecProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *FoundationExecProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *FoundationExecProposal) GetMessages() []*types1.Any {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("lbm.foundation.v1.CensorshipAuthority", CensorshipAuthority_name, CensorshipAuthority_value)
+	proto.RegisterEnum("lbm.foundation.v1.VoteOption", VoteOption_name, VoteOption_value)
+	proto.RegisterEnum("lbm.foundation.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
+	proto.RegisterEnum("lbm.foundation.v1.ProposalExecutorResult", ProposalExecutorResult_name, ProposalExecutorResult_value)
+	proto.RegisterType((*Params)(nil), "lbm.foundation.v1.Params")
+	proto.RegisterType((*TaxSplit)(nil), "lbm.foundation.v1.TaxSplit")
+	proto.RegisterType((*Censorship)(nil), "lbm.foundation.v1.Censorship")
+	proto.RegisterType((*Member)(nil), "lbm.foundation.v1.Member")
+	proto.RegisterType((*MemberActivity)(nil), "lbm.foundation.v1.MemberActivity")
+	proto.RegisterType((*MemberRequest)(nil), "lbm.foundation.v1.MemberRequest")
+	proto.RegisterType((*ThresholdDecisionPolicy)(nil), "lbm.foundation.v1.ThresholdDecisionPolicy")
+	proto.RegisterType((*PercentageDecisionPolicy)(nil), "lbm.foundation.v1.PercentageDecisionPolicy")
+	proto.RegisterType((*DecisionPolicyWindows)(nil), "lbm.foundation.v1.DecisionPolicyWindows")
+	proto.RegisterType((*OutsourcingDecisionPolicy)(nil), "lbm.foundation.v1.OutsourcingDecisionPolicy")
+	proto.RegisterType((*FoundationInfo)(nil), "lbm.foundation.v1.FoundationInfo")
+	proto.RegisterType((*Proposal)(nil), "lbm.foundation.v1.Proposal")
+	proto.RegisterType((*TallyResult)(nil), "lbm.foundation.v1.TallyResult")
+	proto.RegisterType((*Vote)(nil), "lbm.foundation.v1.Vote")
+	proto.RegisterType((*Pool)(nil), "lbm.foundation.v1.Pool")
+	proto.RegisterType((*TaxRecord)(nil), "lbm.foundation.v1.TaxRecord")
+	proto.RegisterType((*TreasuryStream)(nil), "lbm.foundation.v1.TreasuryStream")
+	proto.RegisterType((*ProposalDeposit)(nil), "lbm.foundation.v1.ProposalDeposit")
+	proto.RegisterType((*FoundationExecProposal)(nil), "lbm.foundation.v1.FoundationExecProposal")
+}
+
+func init() {
+	proto.RegisterFile("lbm/foundation/v1/foundation.proto", fileDescriptor_1980496a233f02f4)
+}
+
+var fileDescriptor_1980496a233f02f4 = []byte{
+	// 2058 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4d, 0x6c, 0x1b, 0xc7,
+	0x15, 0xd6, 0x92, 0x14, 0x4d, 0x3e, 0xda, 0x14, 0x3d, 0x76, 0x64, 0x4a, 0x96, 0x29, 0x9a, 0x08,
+	0x5a, 0xd5, 0xa8, 0xc9, 0x5a, 0xe9, 0x6f, 0x0a, 0xd4, 0xa5, 0x28, 0x2a, 0xa6, 0x6b, 0x93, 0xcc,
+	0x92, 0x94, 0xea, 0x5e, 0x16, 0xcb, 0xdd, 0x11, 0x35, 0x30, 0x77, 0x67, 0xb3, 0x33, 0x94, 0xc5,
+	0x6b, 0x7b, 0x09, 0x72, 0xa9, 0x8f, 0xbd, 0x04, 0x28, 0x90, 0x4b, 0xdb, 0x73, 0x0f, 0x6d, 0xaf,
+	0x05, 0x8a, 0xa0, 0x05, 0x8a, 0xa0, 0x97, 0x16, 0x39, 0x24, 0x85, 0x7d, 0xee, 0xa1, 0x87, 0x02,
+	0xbd, 0x14, 0x28, 0x66, 0x76, 0x96, 0x7f, 0xa2, 0x65, 0x45, 0x82, 0x72, 0xe3, 0xcc, 0x7b, 0xef,
+	0x9b, 0xf7, 0xde, 0xbe, 0xef, 0xcd, 0x1b, 0x42, 0xa1, 0xdf, 0x75, 0x4a, 0xfb, 0x74, 0xe0, 0xda,
+	0x26, 0x27, 0xd4, 0x2d, 0x1d, 0xde, 0x9b, 0x58, 0x15, 0x3d, 0x9f, 0x72, 0x8a, 0xae, 0xf6, 0xbb,
+	0x4e, 0x71, 0x62, 0xf7, 0xf0, 0xde, 0xea, 0xf5, 0x1e, 0xed, 0x51, 0x29, 0x2d, 0x89, 0x5f, 0x81,
+	0xe2, 0x6a, 0xae, 0x47, 0x69, 0xaf, 0x8f, 0x4b, 0x72, 0xd5, 0x1d, 0xec, 0x97, 0xec, 0x81, 0x3f,
+	0x01, 0xb4, 0xba, 0x3e, 0x2b, 0xe7, 0xc4, 0xc1, 0x8c, 0x9b, 0x8e, 0xa7, 0x14, 0x56, 0x66, 0x15,
+	0x4c, 0x77, 0x18, 0x62, 0x5b, 0x94, 0x39, 0x94, 0x95, 0xba, 0x26, 0xc3, 0xa5, 0xc3, 0x7b, 0x5d,
+	0xcc, 0xcd, 0x7b, 0x25, 0x8b, 0x92, 0x10, 0x7b, 0x25, 0x90, 0x1b, 0x81, 0x53, 0xc1, 0x22, 0x10,
+	0x15, 0x9e, 0xc7, 0x20, 0xde, 0x34, 0x7d, 0xd3, 0x61, 0xe8, 0x09, 0xa4, 0xc7, 0x81, 0x18, 0xdc,
+	0x3c, 0xca, 0x6a, 0x79, 0x6d, 0x23, 0xb9, 0xb5, 0xf9, 0xf1, 0x67, 0xeb, 0x0b, 0x9f, 0x7e, 0xb6,
+	0x7e, 0xa7, 0x47, 0xf8, 0xc1, 0xa0, 0x5b, 0xb4, 0xa8, 0x53, 0xda, 0x21, 0x2e, 0xb3, 0x0e, 0x88,
+	0x59, 0xda, 0x57, 0x3f, 0xee, 0x32, 0xfb, 0x69, 0x89, 0x0f, 0x3d, 0xcc, 0x8a, 0xdb, 0xd8, 0xd2,
+	0xaf, 0x8c, 0x91, 0xda, 0xe6, 0x11, 0xf2, 0x20, 0xe5, 0x10, 0xd7, 0xb0, 0xb1, 0x47, 0x19, 0xe1,
+	0xd9, 0x68, 0x3e, 0xba, 0x91, 0xda, 0x5c, 0x29, 0x2a, 0x4f, 0x84, 0xdb, 0x45, 0xe5, 0x76, 0xb1,
+	0x42, 0x89, 0xbb, 0xf5, 0x4d, 0x71, 0xe4, 0x6f, 0x3e, 0x5f, 0xff, 0xfa, 0x29, 0x8f, 0x14, 0x46,
+	0x4c, 0x07, 0x87, 0xb8, 0xdb, 0xc1, 0x11, 0xe8, 0xdb, 0x70, 0xa3, 0x3b, 0xf0, 0x5d, 0x63, 0x9f,
+	0xfa, 0xfb, 0x98, 0x70, 0x6c, 0x87, 0x87, 0xb3, 0x6c, 0x2c, 0xaf, 0x6d, 0x24, 0xf4, 0x37, 0x84,
+	0x78, 0x27, 0x94, 0x2a, 0x33, 0x86, 0x7e, 0x08, 0xb7, 0x1c, 0xf3, 0xc8, 0xa0, 0x1e, 0x76, 0x45,
+	0xba, 0x3c, 0xca, 0xcc, 0x3e, 0x33, 0x3c, 0xec, 0x1b, 0x0e, 0x76, 0xba, 0xd8, 0xcf, 0x2e, 0xe6,
+	0xb5, 0x8d, 0x98, 0xbe, 0xe2, 0x98, 0x47, 0x0d, 0x0f, 0xbb, 0xcd, 0x50, 0xa5, 0x89, 0xfd, 0xc7,
+	0x52, 0x01, 0xfd, 0x00, 0x92, 0xdc, 0x3c, 0x32, 0x98, 0xd7, 0x27, 0x3c, 0x1b, 0xcf, 0x6b, 0x1b,
+	0xa9, 0xcd, 0x9b, 0xc5, 0x63, 0x55, 0x52, 0x6c, 0x9b, 0x47, 0x2d, 0xa1, 0xb2, 0x15, 0x13, 0xb1,
+	0xea, 0x09, 0xae, 0xd6, 0xe8, 0x3e, 0xac, 0x09, 0x0f, 0x2c, 0xea, 0x32, 0x6c, 0x0d, 0x38, 0x39,
+	0xc4, 0x86, 0x43, 0x18, 0xc3, 0xb6, 0x71, 0x48, 0x39, 0x66, 0xd9, 0x4b, 0x23, 0x07, 0x2a, 0x63,
+	0x95, 0xc7, 0x52, 0x63, 0x57, 0x28, 0xa0, 0xef, 0x42, 0x96, 0x0d, 0x98, 0x87, 0x5d, 0xdb, 0x20,
+	0xae, 0x69, 0x05, 0x08, 0xd2, 0x37, 0x96, 0x4d, 0xc8, 0xd8, 0x97, 0x95, 0xbc, 0xa6, 0xc4, 0x81,
+	0xe7, 0xec, 0x61, 0x2c, 0x11, 0xc9, 0x44, 0x0b, 0xff, 0xd3, 0x20, 0x11, 0x7a, 0x87, 0xea, 0x90,
+	0xe0, 0x3e, 0x36, 0xd9, 0xc0, 0x1f, 0x9e, 0xa3, 0x1c, 0x46, 0x18, 0xa2, 0xc8, 0x2c, 0xea, 0x38,
+	0x03, 0x97, 0xf0, 0xa1, 0xe1, 0x51, 0xda, 0xcf, 0x46, 0xce, 0x5e, 0x64, 0x23, 0xa4, 0x26, 0xa5,
+	0x7d, 0xb4, 0x03, 0x31, 0xf1, 0x4d, 0xb3, 0xd1, 0x33, 0x03, 0x4a, 0xfb, 0x02, 0x07, 0xa8, 0x60,
+	0x97, 0x51, 0x9f, 0x1d, 0x10, 0x0f, 0xe5, 0xe1, 0xb2, 0xc3, 0x7a, 0x86, 0x50, 0x32, 0x06, 0x7e,
+	0x3f, 0x48, 0x82, 0x0e, 0x0e, 0xeb, 0xb5, 0x87, 0x1e, 0xee, 0xf8, 0x7d, 0xb4, 0x0d, 0x49, 0x73,
+	0xc0, 0x0f, 0xa8, 0x4f, 0xf8, 0x50, 0x46, 0x93, 0xde, 0xfc, 0xca, 0x9c, 0x0f, 0x3e, 0xc6, 0x2c,
+	0x87, 0xda, 0xfa, 0xd8, 0xb0, 0xf0, 0x17, 0x0d, 0xe2, 0xaa, 0x82, 0xb2, 0x70, 0xc9, 0xb4, 0x6d,
+	0x1f, 0x33, 0xa6, 0x4e, 0x0b, 0x97, 0x68, 0x15, 0x12, 0x0e, 0xe6, 0xa6, 0x6d, 0x72, 0x33, 0xc8,
+	0x9b, 0x3e, 0x5a, 0xa3, 0xfb, 0x90, 0x30, 0x6d, 0x1b, 0xdb, 0x86, 0xc9, 0x65, 0x89, 0xa7, 0x36,
+	0x57, 0x8b, 0x41, 0xcb, 0x28, 0x86, 0x2d, 0xa3, 0xd8, 0x0e, 0x7b, 0xca, 0x56, 0x42, 0xa4, 0xe7,
+	0xf9, 0xe7, 0xeb, 0x9a, 0x04, 0xc7, 0x76, 0x99, 0xa3, 0x87, 0x10, 0x7f, 0x86, 0x49, 0xef, 0x80,
+	0xcb, 0x1a, 0x3f, 0x5b, 0x06, 0x15, 0x42, 0xe1, 0x67, 0x11, 0x48, 0x07, 0xd1, 0x94, 0x45, 0x85,
+	0x11, 0x3e, 0x3c, 0x21, 0xaa, 0xb7, 0x60, 0xb9, 0x6f, 0x32, 0x2e, 0xeb, 0xdb, 0x1e, 0xb1, 0xce,
+	0x20, 0xb6, 0x8c, 0x31, 0xa6, 0x5f, 0x13, 0x52, 0x51, 0xdb, 0x76, 0x48, 0xb7, 0x9a, 0x8d, 0x6e,
+	0xc3, 0xe5, 0x29, 0x5a, 0x44, 0xa5, 0x6a, 0xca, 0x99, 0x26, 0xc2, 0x2b, 0x59, 0x14, 0x93, 0xea,
+	0xcb, 0xd6, 0x7c, 0x0a, 0xad, 0x41, 0x52, 0x51, 0x04, 0xdb, 0x32, 0x1b, 0x09, 0x7d, 0xbc, 0x81,
+	0xbe, 0x0a, 0x4b, 0xdd, 0x3e, 0xb5, 0x9e, 0x0a, 0x30, 0xec, 0x33, 0x42, 0x5d, 0xc9, 0xf3, 0x98,
+	0x9e, 0x56, 0xdb, 0xbb, 0xc1, 0x6e, 0xe1, 0xd7, 0x1a, 0x5c, 0x09, 0xb2, 0xa0, 0xe3, 0xf7, 0x06,
+	0x98, 0xf1, 0x13, 0x92, 0xb0, 0x0c, 0x71, 0x1f, 0x3b, 0xf4, 0x10, 0xcb, 0xa0, 0x13, 0xba, 0x5a,
+	0x4d, 0x7d, 0xf2, 0xe8, 0xcc, 0x27, 0x1f, 0x7f, 0xb1, 0xd8, 0xb9, 0xbf, 0xd8, 0x1f, 0x35, 0xb8,
+	0xd1, 0x3e, 0xf0, 0x31, 0x3b, 0xa0, 0x7d, 0x7b, 0x1b, 0x5b, 0x44, 0x44, 0xd0, 0xa4, 0x7d, 0x62,
+	0x0d, 0x51, 0x13, 0x92, 0x3c, 0x14, 0x9d, 0xa3, 0x0b, 0x8c, 0x41, 0xd0, 0x16, 0x5c, 0x7a, 0x46,
+	0x5c, 0x9b, 0x3e, 0x63, 0x32, 0xdc, 0xd4, 0xe6, 0xc6, 0x1c, 0xc6, 0x4c, 0x7b, 0xb1, 0x17, 0xe8,
+	0xeb, 0xa1, 0xe1, 0xdb, 0xe8, 0x6f, 0xbf, 0xbd, 0x9b, 0x9e, 0xd6, 0x29, 0xfc, 0x49, 0x83, 0x6c,
+	0x13, 0xfb, 0x16, 0x76, 0xb9, 0xd9, 0xc3, 0x33, 0x61, 0xe8, 0x00, 0xde, 0x48, 0x76, 0x8e, 0x38,
+	0x26, 0x50, 0x2e, 0x2c, 0x90, 0xdf, 0x69, 0xf0, 0xc6, 0x5c, 0x33, 0xf4, 0x00, 0xae, 0x1c, 0x52,
+	0x4e, 0xdc, 0x9e, 0xb8, 0x95, 0x08, 0x0d, 0x3e, 0x88, 0xb8, 0x4d, 0x67, 0xc9, 0xbe, 0xad, 0x06,
+	0x8c, 0x80, 0xeb, 0xbf, 0x10, 0x5c, 0xbf, 0x1c, 0x58, 0x36, 0xa5, 0x21, 0xea, 0xc0, 0x75, 0x71,
+	0x2b, 0xe3, 0x23, 0xc9, 0x00, 0xea, 0x86, 0x80, 0x91, 0xd3, 0x03, 0x22, 0x87, 0xb8, 0xd5, 0xd0,
+	0x3e, 0x80, 0x2d, 0xbc, 0x0b, 0x2b, 0x8d, 0x01, 0x67, 0x74, 0xe0, 0x5b, 0xc4, 0xed, 0xcd, 0x7c,
+	0x83, 0x3c, 0xa4, 0x6c, 0xcc, 0x2c, 0x9f, 0x78, 0xc2, 0x42, 0x91, 0x60, 0x72, 0x6b, 0x6e, 0x36,
+	0x3e, 0xd5, 0x20, 0xbd, 0x33, 0x4a, 0x69, 0xcd, 0xdd, 0xa7, 0x82, 0x49, 0x21, 0xf9, 0x34, 0x49,
+	0xbe, 0x70, 0x89, 0x3a, 0x70, 0x99, 0x53, 0x6e, 0xf6, 0x0d, 0xc5, 0x8d, 0xb3, 0x5f, 0x30, 0x29,
+	0x89, 0xb3, 0x27, 0x61, 0xd0, 0xbb, 0xb0, 0x64, 0x2b, 0xaf, 0x0c, 0x4f, 0xba, 0x25, 0xf9, 0x98,
+	0xda, 0xbc, 0x7e, 0x2c, 0x51, 0x65, 0x77, 0xb8, 0x85, 0xfe, 0x7c, 0x2c, 0x0c, 0x3d, 0x6d, 0x4f,
+	0xad, 0xdf, 0x8e, 0xbd, 0xff, 0xcb, 0xf5, 0x85, 0xc2, 0x7f, 0x63, 0x90, 0x08, 0x1b, 0x1b, 0x4a,
+	0x43, 0x84, 0xd8, 0x2a, 0xa2, 0x08, 0xb1, 0x4f, 0xec, 0xf8, 0x6b, 0x90, 0x0c, 0x9a, 0xa5, 0xb8,
+	0xd9, 0xc5, 0x4c, 0x95, 0xd4, 0xc7, 0x1b, 0xa8, 0x0a, 0x29, 0x36, 0xe8, 0x3a, 0x84, 0x1b, 0x62,
+	0x92, 0xfc, 0x42, 0x57, 0x02, 0x04, 0x86, 0x42, 0x84, 0xee, 0x02, 0x9a, 0x98, 0x0a, 0xc3, 0x94,
+	0x07, 0x53, 0xd0, 0xd5, 0xb1, 0x44, 0xb5, 0x3c, 0xf4, 0x3d, 0x88, 0x33, 0x6e, 0xf2, 0x01, 0x93,
+	0x2d, 0x31, 0xbd, 0x79, 0x7b, 0x0e, 0x1d, 0xc2, 0x60, 0x5b, 0x52, 0x51, 0x57, 0x06, 0x48, 0x07,
+	0xb4, 0x4f, 0x5c, 0xb3, 0x6f, 0x70, 0xb3, 0xdf, 0x1f, 0x1a, 0x3e, 0x66, 0x83, 0x3e, 0x97, 0xe3,
+	0x4e, 0x6a, 0x33, 0x37, 0x77, 0x82, 0xea, 0xf7, 0x87, 0xba, 0xd4, 0x52, 0x43, 0x54, 0x46, 0xda,
+	0x4f, 0xec, 0xa3, 0x26, 0x5c, 0x9d, 0x22, 0x8b, 0x81, 0x5d, 0x5b, 0x0e, 0x41, 0xa7, 0x4d, 0xc5,
+	0xd2, 0x24, 0x63, 0xaa, 0xae, 0x8d, 0x74, 0x58, 0x0a, 0x08, 0x43, 0xfd, 0xd0, 0xc5, 0xa4, 0x8c,
+	0xf4, 0x6b, 0x27, 0x44, 0x5a, 0x55, 0x16, 0x81, 0x57, 0x7a, 0x1a, 0x4f, 0xad, 0xd1, 0x37, 0xc4,
+	0x47, 0x66, 0xcc, 0xec, 0x61, 0x96, 0x05, 0x39, 0x1b, 0xcf, 0xad, 0x29, 0x7d, 0xa4, 0x85, 0xee,
+	0x03, 0x04, 0x18, 0x58, 0x5c, 0xf7, 0xa9, 0xd7, 0x06, 0x14, 0x93, 0xc1, 0x24, 0x95, 0x4d, 0x99,
+	0xab, 0xd2, 0xfb, 0x57, 0x04, 0x52, 0x93, 0xe9, 0x6a, 0x40, 0x72, 0x88, 0x99, 0x61, 0xd1, 0x81,
+	0xcb, 0xcf, 0x33, 0xee, 0x0d, 0x31, 0xab, 0x08, 0x0c, 0xb4, 0x07, 0x57, 0xcc, 0x2e, 0xe3, 0x26,
+	0x71, 0x15, 0xe8, 0xd9, 0xc9, 0x78, 0x59, 0x01, 0x05, 0xc0, 0x8f, 0x21, 0xe1, 0x52, 0x85, 0x79,
+	0xf6, 0x81, 0xef, 0x92, 0x4b, 0x03, 0x38, 0x03, 0x90, 0x4b, 0x8d, 0x67, 0x84, 0x1f, 0x18, 0x87,
+	0x98, 0x87, 0xc0, 0x67, 0xbf, 0x55, 0x97, 0x5c, 0xba, 0x47, 0xf8, 0xc1, 0x2e, 0xe6, 0xc1, 0x01,
+	0x2a, 0xdf, 0x7f, 0xd7, 0x20, 0x26, 0x26, 0x0c, 0xb4, 0x0e, 0xa9, 0xc9, 0x39, 0x27, 0xe0, 0x3b,
+	0x78, 0xe3, 0xf1, 0xe6, 0x3a, 0x2c, 0x8a, 0x41, 0xc5, 0x57, 0xa4, 0x0f, 0x16, 0xe8, 0x5b, 0x10,
+	0xa7, 0x41, 0xe3, 0x8c, 0xca, 0x9a, 0xbb, 0x35, 0xa7, 0xe6, 0x04, 0x7e, 0x43, 0x2a, 0xe9, 0x4a,
+	0x79, 0xaa, 0x89, 0xc4, 0x66, 0x9a, 0xc8, 0x4c, 0x9b, 0x58, 0x3c, 0x5b, 0x9b, 0x28, 0x0c, 0x21,
+	0x26, 0x87, 0xf0, 0xf7, 0xa6, 0xde, 0x0b, 0xa2, 0x94, 0xd7, 0xe6, 0x3e, 0xf3, 0xb6, 0xb1, 0x25,
+	0x5f, 0x7a, 0xdf, 0x51, 0x2f, 0xbd, 0xd2, 0xe9, 0x93, 0x1b, 0x3c, 0xf6, 0x46, 0xc7, 0x14, 0xfe,
+	0x1d, 0x81, 0x64, 0xdb, 0x3c, 0xd2, 0xb1, 0x45, 0x7d, 0x5b, 0xcc, 0x51, 0x07, 0x41, 0xdf, 0x17,
+	0x49, 0x8d, 0xea, 0x6a, 0x85, 0x9e, 0x4e, 0x38, 0x16, 0xb9, 0x98, 0xf7, 0xe7, 0xf8, 0x95, 0x73,
+	0x78, 0xec, 0x95, 0x73, 0x41, 0x4f, 0xde, 0x99, 0x27, 0x50, 0x0f, 0xe2, 0xe2, 0x09, 0x83, 0xed,
+	0x6c, 0xec, 0x62, 0xce, 0x53, 0xf0, 0x85, 0x8f, 0xa2, 0x90, 0x6e, 0xab, 0x68, 0x5b, 0x22, 0x6c,
+	0xe7, 0xd8, 0xcd, 0xb5, 0x06, 0x49, 0x1f, 0x5b, 0xc4, 0x23, 0x38, 0xa4, 0xbd, 0x3e, 0xde, 0x10,
+	0x9e, 0x9a, 0x8e, 0x62, 0xef, 0xc5, 0x78, 0x1a, 0xc0, 0xa3, 0xef, 0x43, 0x5c, 0x8d, 0x35, 0xb1,
+	0xd3, 0x8f, 0x35, 0xca, 0x04, 0x39, 0x22, 0x06, 0xc7, 0x24, 0x2e, 0x71, 0x7b, 0xd9, 0xc5, 0x8b,
+	0x71, 0x74, 0x7c, 0x02, 0xaa, 0x43, 0xc6, 0xc5, 0x47, 0xdc, 0xf0, 0xcc, 0x21, 0x1d, 0x28, 0x42,
+	0xc6, 0xbf, 0x00, 0x21, 0xd3, 0xc2, 0xba, 0x29, 0x8d, 0x25, 0x29, 0x7f, 0xaf, 0xc1, 0x52, 0x78,
+	0x05, 0x85, 0x7f, 0x8c, 0xbc, 0xb6, 0xf3, 0xac, 0x41, 0x52, 0xfd, 0x55, 0x42, 0xc3, 0xee, 0x33,
+	0xde, 0xf8, 0xd2, 0xbe, 0x5b, 0xe1, 0xa7, 0x1a, 0x2c, 0x8f, 0x47, 0x3e, 0x71, 0x81, 0x8e, 0x66,
+	0xa4, 0xeb, 0xb0, 0xc8, 0x09, 0xef, 0xab, 0x11, 0x5e, 0x0f, 0x16, 0xb3, 0x93, 0x65, 0xe4, 0xd8,
+	0x64, 0x39, 0x75, 0xcd, 0x46, 0x4f, 0x73, 0xcd, 0xde, 0xf9, 0x8f, 0x06, 0xd7, 0xe6, 0xbc, 0xdb,
+	0xd1, 0x03, 0xc8, 0x57, 0xaa, 0xf5, 0x56, 0x43, 0x6f, 0x3d, 0xa8, 0x35, 0x8d, 0x72, 0xa7, 0xfd,
+	0xa0, 0xa1, 0xd7, 0xda, 0x4f, 0x8c, 0x4e, 0xbd, 0xd5, 0xac, 0x56, 0x6a, 0x3b, 0xb5, 0xea, 0x76,
+	0x66, 0x61, 0xb5, 0xf0, 0xc1, 0x87, 0xf9, 0xdc, 0x1c, 0xf3, 0x8e, 0xcb, 0x3c, 0x6c, 0x91, 0x7d,
+	0x82, 0x6d, 0xb4, 0x03, 0xeb, 0x73, 0x91, 0xde, 0x69, 0xec, 0x56, 0xf5, 0x7a, 0xb9, 0x5e, 0xa9,
+	0x66, 0xb4, 0xd5, 0xdb, 0x1f, 0x7c, 0x98, 0xbf, 0x35, 0x07, 0xe8, 0x1d, 0x7a, 0x88, 0x7d, 0xd7,
+	0x74, 0x2d, 0xfc, 0x4a, 0x9c, 0x9d, 0x46, 0xa7, 0xbe, 0x5d, 0x6e, 0xd7, 0x1a, 0xf5, 0x4c, 0xe4,
+	0x95, 0x38, 0xe3, 0x3c, 0xaf, 0xc6, 0xde, 0xff, 0x28, 0xb7, 0x70, 0xe7, 0xe7, 0x1a, 0xc0, 0xf8,
+	0x1e, 0x41, 0x37, 0xe1, 0xc6, 0x6e, 0xa3, 0x5d, 0x35, 0x1a, 0x4d, 0x01, 0x34, 0x1d, 0x25, 0xba,
+	0x06, 0x4b, 0x93, 0xc2, 0x27, 0xd5, 0x56, 0x46, 0x43, 0x37, 0xe0, 0xda, 0xe4, 0x66, 0x79, 0xab,
+	0xd5, 0x2e, 0xd7, 0xea, 0x99, 0x08, 0x42, 0x90, 0x9e, 0x14, 0xd4, 0x1b, 0x99, 0x28, 0x5a, 0x83,
+	0xec, 0xf4, 0x9e, 0xb1, 0x57, 0x6b, 0x3f, 0x30, 0x76, 0xab, 0xed, 0x46, 0x26, 0xa6, 0x3c, 0xfa,
+	0xab, 0x06, 0xe9, 0xe9, 0xb9, 0x11, 0xad, 0xc3, 0xcd, 0xa6, 0xde, 0x68, 0x36, 0x5a, 0xe5, 0x47,
+	0x46, 0xab, 0x5d, 0x6e, 0x77, 0x5a, 0x33, 0x9e, 0xdd, 0x82, 0x95, 0x59, 0x85, 0x56, 0x67, 0xeb,
+	0x71, 0xad, 0xdd, 0xae, 0x6e, 0x67, 0x34, 0x71, 0xec, 0xac, 0xb8, 0x5c, 0xa9, 0x54, 0x9b, 0x42,
+	0x1a, 0x99, 0x27, 0xd5, 0xab, 0x0f, 0xab, 0x15, 0x21, 0x8d, 0x8a, 0x8c, 0x1c, 0xb3, 0xdd, 0x6a,
+	0xe8, 0x42, 0x18, 0x9b, 0x77, 0xae, 0x08, 0x68, 0x5b, 0x2f, 0xef, 0xd5, 0x33, 0x8b, 0x2a, 0xa0,
+	0x3f, 0x68, 0xb0, 0x3c, 0x7f, 0x3c, 0x44, 0x1b, 0xf0, 0xe6, 0xc8, 0xbe, 0xfa, 0xe3, 0x6a, 0xa5,
+	0xd3, 0x6e, 0xe8, 0x86, 0x5e, 0x6d, 0x75, 0x1e, 0xb5, 0x67, 0x22, 0x7c, 0x13, 0xf2, 0xaf, 0xd4,
+	0xac, 0x37, 0xda, 0x86, 0xde, 0xa9, 0x67, 0xb4, 0x13, 0xb5, 0x5a, 0x9d, 0x4a, 0xa5, 0xda, 0x6a,
+	0x65, 0x22, 0x27, 0x6a, 0xed, 0x94, 0x6b, 0x8f, 0x3a, 0x7a, 0x35, 0x13, 0x0d, 0x9c, 0xdf, 0xfa,
+	0xd1, 0xaf, 0x5e, 0xe4, 0xb4, 0x8f, 0x5f, 0xe4, 0xb4, 0x4f, 0x5e, 0xe4, 0xb4, 0x7f, 0xbe, 0xc8,
+	0x69, 0xcf, 0x5f, 0xe6, 0x16, 0x3e, 0x79, 0x99, 0x5b, 0xf8, 0xc7, 0xcb, 0xdc, 0xc2, 0x4f, 0xee,
+	0xbe, 0x96, 0xf0, 0x47, 0x13, 0x7f, 0xa4, 0x77, 0xe3, 0x92, 0x7c, 0x6f, 0xfd, 0x3f, 0x00, 0x00,
+	0xff, 0xff, 0xe9, 0xd7, 0x54, 0xff, 0x6f, 0x17, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Member)
+	that1, ok := that.(*Params)
 	if !ok {
-		that2, ok := that.(Member)
+		that2, ok := that.(Params)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1168,25 +1595,42 @@ func (this *Member) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Address != that1.Address {
+	if !this.FoundationTax.Equal(that1.FoundationTax) {
 		return false
 	}
-	if this.Metadata != that1.Metadata {
+	if len(this.MinDeposit) != len(that1.MinDeposit) {
 		return false
 	}
-	if !this.AddedAt.Equal(that1.AddedAt) {
+	for i := range this.MinDeposit {
+		if !this.MinDeposit[i].Equal(&that1.MinDeposit[i]) {
+			return false
+		}
+	}
+	if this.BurnForfeitedDeposits != that1.BurnForfeitedDeposits {
+		return false
+	}
+	if this.MaxOpenProposalsPerMember != that1.MaxOpenProposalsPerMember {
+		return false
+	}
+	if !this.TaxSplit.Equal(&that1.TaxSplit) {
+		return false
+	}
+	if this.MaxConsecutiveMissedVotes != that1.MaxConsecutiveMissedVotes {
+		return false
+	}
+	if this.SuspendInactiveMembers != that1.SuspendInactiveMembers {
 		return false
 	}
 	return true
 }
-func (this *MemberRequest) Equal(that interface{}) bool {
+func (this *TaxSplit) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MemberRequest)
+	that1, ok := that.(*TaxSplit)
 	if !ok {
-		that2, ok := that.(MemberRequest)
+		that2, ok := that.(TaxSplit)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1198,25 +1642,25 @@ func (this *MemberRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Address != that1.Address {
+	if !this.Treasury.Equal(that1.Treasury) {
 		return false
 	}
-	if this.Remove != that1.Remove {
+	if !this.CommunityPool.Equal(that1.CommunityPool) {
 		return false
 	}
-	if this.Metadata != that1.Metadata {
+	if !this.Burn.Equal(that1.Burn) {
 		return false
 	}
 	return true
 }
-func (this *ThresholdDecisionPolicy) Equal(that interface{}) bool {
+func (this *Censorship) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ThresholdDecisionPolicy)
+	that1, ok := that.(*Censorship)
 	if !ok {
-		that2, ok := that.(ThresholdDecisionPolicy)
+		that2, ok := that.(Censorship)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1228,22 +1672,22 @@ func (this *ThresholdDecisionPolicy) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Threshold.Equal(that1.Threshold) {
+	if this.MsgTypeUrl != that1.MsgTypeUrl {
 		return false
 	}
-	if !this.Windows.Equal(that1.Windows) {
+	if this.Authority != that1.Authority {
 		return false
 	}
 	return true
 }
-func (this *PercentageDecisionPolicy) Equal(that interface{}) bool {
+func (this *Member) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*PercentageDecisionPolicy)
+	that1, ok := that.(*Member)
 	if !ok {
-		that2, ok := that.(PercentageDecisionPolicy)
+		that2, ok := that.(Member)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1255,22 +1699,28 @@ func (this *PercentageDecisionPolicy) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Percentage.Equal(that1.Percentage) {
+	if this.Address != that1.Address {
 		return false
 	}
-	if !this.Windows.Equal(that1.Windows) {
+	if this.Metadata != that1.Metadata {
+		return false
+	}
+	if !this.AddedAt.Equal(that1.AddedAt) {
+		return false
+	}
+	if !this.Weight.Equal(that1.Weight) {
 		return false
 	}
 	return true
 }
-func (this *DecisionPolicyWindows) Equal(that interface{}) bool {
+func (this *MemberActivity) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DecisionPolicyWindows)
+	that1, ok := that.(*MemberActivity)
 	if !ok {
-		that2, ok := that.(DecisionPolicyWindows)
+		that2, ok := that.(MemberActivity)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1282,22 +1732,34 @@ func (this *DecisionPolicyWindows) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.VotingPeriod != that1.VotingPeriod {
+	if this.Address != that1.Address {
 		return false
 	}
-	if this.MinExecutionPeriod != that1.MinExecutionPeriod {
+	if this.LastVotedProposalId != that1.LastVotedProposalId {
+		return false
+	}
+	if this.MissedVotes != that1.MissedVotes {
+		return false
+	}
+	if this.ConsecutiveMissedVotes != that1.ConsecutiveMissedVotes {
+		return false
+	}
+	if this.Suspended != that1.Suspended {
+		return false
+	}
+	if this.BlockedVersion != that1.BlockedVersion {
 		return false
 	}
 	return true
 }
-func (this *OutsourcingDecisionPolicy) Equal(that interface{}) bool {
+func (this *MemberRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*OutsourcingDecisionPolicy)
+	that1, ok := that.(*MemberRequest)
 	if !ok {
-		that2, ok := that.(OutsourcingDecisionPolicy)
+		that2, ok := that.(MemberRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1309,19 +1771,28 @@ func (this *OutsourcingDecisionPolicy) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Description != that1.Description {
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Remove != that1.Remove {
+		return false
+	}
+	if this.Metadata != that1.Metadata {
+		return false
+	}
+	if !this.Weight.Equal(that1.Weight) {
 		return false
 	}
 	return true
 }
-func (this *FoundationInfo) Equal(that interface{}) bool {
+func (this *ThresholdDecisionPolicy) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*FoundationInfo)
+	that1, ok := that.(*ThresholdDecisionPolicy)
 	if !ok {
-		that2, ok := that.(FoundationInfo)
+		that2, ok := that.(ThresholdDecisionPolicy)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1333,25 +1804,22 @@ func (this *FoundationInfo) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Version != that1.Version {
-		return false
-	}
-	if !this.TotalWeight.Equal(that1.TotalWeight) {
+	if !this.Threshold.Equal(that1.Threshold) {
 		return false
 	}
-	if !this.DecisionPolicy.Equal(that1.DecisionPolicy) {
+	if !this.Windows.Equal(that1.Windows) {
 		return false
 	}
 	return true
 }
-func (this *Proposal) Equal(that interface{}) bool {
+func (this *PercentageDecisionPolicy) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Proposal)
+	that1, ok := that.(*PercentageDecisionPolicy)
 	if !ok {
-		that2, ok := that.(Proposal)
+		that2, ok := that.(PercentageDecisionPolicy)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1363,23 +1831,131 @@ func (this *Proposal) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Id != that1.Id {
+	if !this.Percentage.Equal(that1.Percentage) {
 		return false
 	}
-	if this.Metadata != that1.Metadata {
+	if !this.Windows.Equal(that1.Windows) {
 		return false
 	}
-	if len(this.Proposers) != len(that1.Proposers) {
-		return false
+	return true
+}
+func (this *DecisionPolicyWindows) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	for i := range this.Proposers {
-		if this.Proposers[i] != that1.Proposers[i] {
+
+	that1, ok := that.(*DecisionPolicyWindows)
+	if !ok {
+		that2, ok := that.(DecisionPolicyWindows)
+		if ok {
+			that1 = &that2
+		} else {
 			return false
 		}
 	}
-	if !this.SubmitTime.Equal(that1.SubmitTime) {
-		return false
-	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.VotingPeriod != that1.VotingPeriod {
+		return false
+	}
+	if this.MinExecutionPeriod != that1.MinExecutionPeriod {
+		return false
+	}
+	return true
+}
+func (this *OutsourcingDecisionPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OutsourcingDecisionPolicy)
+	if !ok {
+		that2, ok := that.(OutsourcingDecisionPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	return true
+}
+func (this *FoundationInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FoundationInfo)
+	if !ok {
+		that2, ok := that.(FoundationInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if !this.TotalWeight.Equal(that1.TotalWeight) {
+		return false
+	}
+	if !this.DecisionPolicy.Equal(that1.DecisionPolicy) {
+		return false
+	}
+	return true
+}
+func (this *Proposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Proposal)
+	if !ok {
+		that2, ok := that.(Proposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Metadata != that1.Metadata {
+		return false
+	}
+	if len(this.Proposers) != len(that1.Proposers) {
+		return false
+	}
+	for i := range this.Proposers {
+		if this.Proposers[i] != that1.Proposers[i] {
+			return false
+		}
+	}
+	if !this.SubmitTime.Equal(that1.SubmitTime) {
+		return false
+	}
 	if this.FoundationVersion != that1.FoundationVersion {
 		return false
 	}
@@ -1403,6 +1979,13 @@ func (this *Proposal) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if that1.ExecuteAt == nil {
+		if this.ExecuteAt != nil {
+			return false
+		}
+	} else if !this.ExecuteAt.Equal(*that1.ExecuteAt) {
+		return false
+	}
 	return true
 }
 func (this *TallyResult) Equal(that interface{}) bool {
@@ -1503,6 +2086,138 @@ func (this *Pool) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *TaxRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TaxRecord)
+	if !ok {
+		that2, ok := that.(TaxRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if len(this.Treasury) != len(that1.Treasury) {
+		return false
+	}
+	for i := range this.Treasury {
+		if !this.Treasury[i].Equal(&that1.Treasury[i]) {
+			return false
+		}
+	}
+	if len(this.CommunityPool) != len(that1.CommunityPool) {
+		return false
+	}
+	for i := range this.CommunityPool {
+		if !this.CommunityPool[i].Equal(&that1.CommunityPool[i]) {
+			return false
+		}
+	}
+	if len(this.Burned) != len(that1.Burned) {
+		return false
+	}
+	for i := range this.Burned {
+		if !this.Burned[i].Equal(&that1.Burned[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *TreasuryStream) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TreasuryStream)
+	if !ok {
+		that2, ok := that.(TreasuryStream)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Recipient != that1.Recipient {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	if this.Period != that1.Period {
+		return false
+	}
+	if len(this.Remaining) != len(that1.Remaining) {
+		return false
+	}
+	for i := range this.Remaining {
+		if !this.Remaining[i].Equal(&that1.Remaining[i]) {
+			return false
+		}
+	}
+	if !this.NextPayoutTime.Equal(that1.NextPayoutTime) {
+		return false
+	}
+	return true
+}
+func (this *ProposalDeposit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ProposalDeposit)
+	if !ok {
+		that2, ok := that.(ProposalDeposit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ProposalId != that1.ProposalId {
+		return false
+	}
+	if this.Depositor != that1.Depositor {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *FoundationExecProposal) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1558,6 +2273,60 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SuspendInactiveMembers {
+		i--
+		if m.SuspendInactiveMembers {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxConsecutiveMissedVotes != 0 {
+		i = encodeVarintFoundation(dAtA, i, uint64(m.MaxConsecutiveMissedVotes))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.TaxSplit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFoundation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.MaxOpenProposalsPerMember != 0 {
+		i = encodeVarintFoundation(dAtA, i, uint64(m.MaxOpenProposalsPerMember))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BurnForfeitedDeposits {
+		i--
+		if m.BurnForfeitedDeposits {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MinDeposit) > 0 {
+		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFoundation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size := m.FoundationTax.Size()
 		i -= size
@@ -1571,7 +2340,7 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Censorship) Marshal() (dAtA []byte, err error) {
+func (m *TaxSplit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1581,21 +2350,74 @@ func (m *Censorship) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Censorship) MarshalTo(dAtA []byte) (int, error) {
+func (m *TaxSplit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Censorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TaxSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Authority != 0 {
-		i = encodeVarintFoundation(dAtA, i, uint64(m.Authority))
-		i--
-		dAtA[i] = 0x10
-	}
+	{
+		size := m.Burn.Size()
+		i -= size
+		if _, err := m.Burn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFoundation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CommunityPool.Size()
+		i -= size
+		if _, err := m.CommunityPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFoundation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Treasury.Size()
+		i -= size
+		if _, err := m.Treasury.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFoundation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Censorship) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Censorship) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Censorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Authority != 0 {
+		i = encodeVarintFoundation(dAtA, i, uint64(m.Authority))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.MsgTypeUrl) > 0 {
 		i -= len(m.MsgTypeUrl)
 		copy(dAtA[i:], m.MsgTypeUrl)
@@ -1626,12 +2448,22 @@ func (m *Member) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.AddedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.AddedAt):])
-	if err1 != nil {
-		return 0, err1
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFoundation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.AddedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.AddedAt):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n1
-	i = encodeVarintFoundation(dAtA, i, uint64(n1))
+	i -= n2
+	i = encodeVarintFoundation(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x22
 	if len(m.Metadata) > 0 {
@@ -1651,6 +2483,66 @@ func (m *Member) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MemberActivity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MemberActivity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MemberActivity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockedVersion != 0 {
+		i = encodeVarintFoundation(dAtA, i, uint64(m.BlockedVersion))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Suspended {
+		i--
+		if m.Suspended {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ConsecutiveMissedVotes != 0 {
+		i = encodeVarintFoundation(dAtA, i, uint64(m.ConsecutiveMissedVotes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MissedVotes != 0 {
+		i = encodeVarintFoundation(dAtA, i, uint64(m.MissedVotes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LastVotedProposalId != 0 {
+		i = encodeVarintFoundation(dAtA, i, uint64(m.LastVotedProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintFoundation(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MemberRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1671,6 +2563,16 @@ func (m *MemberRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFoundation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if len(m.Metadata) > 0 {
 		i -= len(m.Metadata)
 		copy(dAtA[i:], m.Metadata)
@@ -1808,21 +2710,21 @@ func (m *DecisionPolicyWindows) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MinExecutionPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinExecutionPeriod):])
-	if err4 != nil {
-		return 0, err4
-	}
-	i -= n4
-	i = encodeVarintFoundation(dAtA, i, uint64(n4))
-	i--
-	dAtA[i] = 0x12
-	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod):])
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MinExecutionPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinExecutionPeriod):])
 	if err5 != nil {
 		return 0, err5
 	}
 	i -= n5
 	i = encodeVarintFoundation(dAtA, i, uint64(n5))
 	i--
+	dAtA[i] = 0x12
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintFoundation(dAtA, i, uint64(n6))
+	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
@@ -1927,6 +2829,16 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExecuteAt != nil {
+		n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExecuteAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExecuteAt):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintFoundation(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x5a
+	}
 	if len(m.Messages) > 0 {
 		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1946,12 +2858,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x48
 	}
-	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingPeriodEnd, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingPeriodEnd):])
-	if err7 != nil {
-		return 0, err7
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingPeriodEnd, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingPeriodEnd):])
+	if err9 != nil {
+		return 0, err9
 	}
-	i -= n7
-	i = encodeVarintFoundation(dAtA, i, uint64(n7))
+	i -= n9
+	i = encodeVarintFoundation(dAtA, i, uint64(n9))
 	i--
 	dAtA[i] = 0x42
 	{
@@ -1974,12 +2886,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x28
 	}
-	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
-	if err9 != nil {
-		return 0, err9
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
+	if err11 != nil {
+		return 0, err11
 	}
-	i -= n9
-	i = encodeVarintFoundation(dAtA, i, uint64(n9))
+	i -= n11
+	i = encodeVarintFoundation(dAtA, i, uint64(n11))
 	i--
 	dAtA[i] = 0x22
 	if len(m.Proposers) > 0 {
@@ -2089,12 +3001,12 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
-	if err10 != nil {
-		return 0, err10
+	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
+	if err12 != nil {
+		return 0, err12
 	}
-	i -= n10
-	i = encodeVarintFoundation(dAtA, i, uint64(n10))
+	i -= n12
+	i = encodeVarintFoundation(dAtA, i, uint64(n12))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Metadata) > 0 {
@@ -2161,7 +3073,7 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *FoundationExecProposal) Marshal() (dAtA []byte, err error) {
+func (m *TaxRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2171,20 +3083,34 @@ func (m *FoundationExecProposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FoundationExecProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *TaxRecord) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *FoundationExecProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TaxRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Messages) > 0 {
-		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Burned) > 0 {
+		for iNdEx := len(m.Burned) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Burned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFoundation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.CommunityPool) > 0 {
+		for iNdEx := len(m.CommunityPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommunityPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2195,78 +3121,327 @@ func (m *FoundationExecProposal) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintFoundation(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+	if len(m.Treasury) > 0 {
+		for iNdEx := len(m.Treasury) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Treasury[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFoundation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintFoundation(dAtA, i, uint64(len(m.Title)))
+	if m.Height != 0 {
+		i = encodeVarintFoundation(dAtA, i, uint64(m.Height))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintFoundation(dAtA []byte, offset int, v uint64) int {
-	offset -= sovFoundation(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TreasuryStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.FoundationTax.Size()
-	n += 1 + l + sovFoundation(uint64(l))
-	return n
+
+func (m *TreasuryStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Censorship) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TreasuryStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MsgTypeUrl)
-	if l > 0 {
-		n += 1 + l + sovFoundation(uint64(l))
+	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.NextPayoutTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.NextPayoutTime):])
+	if err13 != nil {
+		return 0, err13
 	}
-	if m.Authority != 0 {
-		n += 1 + sovFoundation(uint64(m.Authority))
+	i -= n13
+	i = encodeVarintFoundation(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Remaining) > 0 {
+		for iNdEx := len(m.Remaining) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Remaining[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFoundation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	return n
-}
-
-func (m *Member) Size() (n int) {
-	if m == nil {
-		return 0
+	n14, err14 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period):])
+	if err14 != nil {
+		return 0, err14
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovFoundation(uint64(l))
+	i -= n14
+	i = encodeVarintFoundation(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFoundation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovFoundation(uint64(l))
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintFoundation(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.AddedAt)
-	n += 1 + l + sovFoundation(uint64(l))
-	return n
+	if m.Id != 0 {
+		i = encodeVarintFoundation(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposalDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFoundation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintFoundation(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintFoundation(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FoundationExecProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FoundationExecProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FoundationExecProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFoundation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintFoundation(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintFoundation(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFoundation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFoundation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FoundationTax.Size()
+	n += 1 + l + sovFoundation(uint64(l))
+	if len(m.MinDeposit) > 0 {
+		for _, e := range m.MinDeposit {
+			l = e.Size()
+			n += 1 + l + sovFoundation(uint64(l))
+		}
+	}
+	if m.BurnForfeitedDeposits {
+		n += 2
+	}
+	if m.MaxOpenProposalsPerMember != 0 {
+		n += 1 + sovFoundation(uint64(m.MaxOpenProposalsPerMember))
+	}
+	l = m.TaxSplit.Size()
+	n += 1 + l + sovFoundation(uint64(l))
+	if m.MaxConsecutiveMissedVotes != 0 {
+		n += 1 + sovFoundation(uint64(m.MaxConsecutiveMissedVotes))
+	}
+	if m.SuspendInactiveMembers {
+		n += 2
+	}
+	return n
+}
+
+func (m *TaxSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Treasury.Size()
+	n += 1 + l + sovFoundation(uint64(l))
+	l = m.CommunityPool.Size()
+	n += 1 + l + sovFoundation(uint64(l))
+	l = m.Burn.Size()
+	n += 1 + l + sovFoundation(uint64(l))
+	return n
+}
+
+func (m *Censorship) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovFoundation(uint64(l))
+	}
+	if m.Authority != 0 {
+		n += 1 + sovFoundation(uint64(m.Authority))
+	}
+	return n
+}
+
+func (m *Member) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovFoundation(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovFoundation(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.AddedAt)
+	n += 1 + l + sovFoundation(uint64(l))
+	l = m.Weight.Size()
+	n += 1 + l + sovFoundation(uint64(l))
+	return n
+}
+
+func (m *MemberActivity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovFoundation(uint64(l))
+	}
+	if m.LastVotedProposalId != 0 {
+		n += 1 + sovFoundation(uint64(m.LastVotedProposalId))
+	}
+	if m.MissedVotes != 0 {
+		n += 1 + sovFoundation(uint64(m.MissedVotes))
+	}
+	if m.ConsecutiveMissedVotes != 0 {
+		n += 1 + sovFoundation(uint64(m.ConsecutiveMissedVotes))
+	}
+	if m.Suspended {
+		n += 2
+	}
+	if m.BlockedVersion != 0 {
+		n += 1 + sovFoundation(uint64(m.BlockedVersion))
+	}
+	return n
 }
 
 func (m *MemberRequest) Size() (n int) {
@@ -2286,6 +3461,8 @@ func (m *MemberRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovFoundation(uint64(l))
 	}
+	l = m.Weight.Size()
+	n += 1 + l + sovFoundation(uint64(l))
 	return n
 }
 
@@ -2403,6 +3580,10 @@ func (m *Proposal) Size() (n int) {
 			n += 1 + l + sovFoundation(uint64(l))
 		}
 	}
+	if m.ExecuteAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExecuteAt)
+		n += 1 + l + sovFoundation(uint64(l))
+	}
 	return n
 }
 
@@ -2463,6 +3644,90 @@ func (m *Pool) Size() (n int) {
 	return n
 }
 
+func (m *TaxRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovFoundation(uint64(m.Height))
+	}
+	if len(m.Treasury) > 0 {
+		for _, e := range m.Treasury {
+			l = e.Size()
+			n += 1 + l + sovFoundation(uint64(l))
+		}
+	}
+	if len(m.CommunityPool) > 0 {
+		for _, e := range m.CommunityPool {
+			l = e.Size()
+			n += 1 + l + sovFoundation(uint64(l))
+		}
+	}
+	if len(m.Burned) > 0 {
+		for _, e := range m.Burned {
+			l = e.Size()
+			n += 1 + l + sovFoundation(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TreasuryStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovFoundation(uint64(m.Id))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovFoundation(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovFoundation(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovFoundation(uint64(l))
+	if len(m.Remaining) > 0 {
+		for _, e := range m.Remaining {
+			l = e.Size()
+			n += 1 + l + sovFoundation(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.NextPayoutTime)
+	n += 1 + l + sovFoundation(uint64(l))
+	return n
+}
+
+func (m *ProposalDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovFoundation(uint64(m.ProposalId))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovFoundation(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovFoundation(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *FoundationExecProposal) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2502,28 +3767,953 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowFoundation
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FoundationTax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FoundationTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinDeposit = append(m.MinDeposit, types.Coin{})
+			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnForfeitedDeposits", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnForfeitedDeposits = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOpenProposalsPerMember", wireType)
+			}
+			m.MaxOpenProposalsPerMember = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOpenProposalsPerMember |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxSplit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TaxSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConsecutiveMissedVotes", wireType)
+			}
+			m.MaxConsecutiveMissedVotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxConsecutiveMissedVotes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuspendInactiveMembers", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SuspendInactiveMembers = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFoundation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaxSplit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFoundation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaxSplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaxSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Treasury.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Burn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFoundation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Censorship) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFoundation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Censorship: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Censorship: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			m.Authority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Authority |= CensorshipAuthority(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFoundation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Member) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFoundation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Member: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Member: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.AddedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFoundation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MemberActivity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFoundation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemberActivity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemberActivity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastVotedProposalId", wireType)
+			}
+			m.LastVotedProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastVotedProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedVotes", wireType)
+			}
+			m.MissedVotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedVotes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveMissedVotes", wireType)
+			}
+			m.ConsecutiveMissedVotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveMissedVotes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suspended", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Suspended = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedVersion", wireType)
+			}
+			m.BlockedVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockedVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFoundation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MemberRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFoundation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemberRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemberRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Remove = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FoundationTax", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2551,7 +4741,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FoundationTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2576,7 +4766,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Censorship) Unmarshal(dAtA []byte) error {
+func (m *ThresholdDecisionPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2599,15 +4789,15 @@ func (m *Censorship) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Censorship: wiretype end group for non-group")
+			return fmt.Errorf("proto: ThresholdDecisionPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Censorship: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ThresholdDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2635,13 +4825,15 @@ func (m *Censorship) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
 			}
-			m.Authority = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFoundation
@@ -2651,11 +4843,28 @@ func (m *Censorship) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Authority |= CensorshipAuthority(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Windows == nil {
+				m.Windows = &DecisionPolicyWindows{}
+			}
+			if err := m.Windows.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFoundation(dAtA[iNdEx:])
@@ -2677,7 +4886,7 @@ func (m *Censorship) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Member) Unmarshal(dAtA []byte) error {
+func (m *PercentageDecisionPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2700,15 +4909,15 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Member: wiretype end group for non-group")
+			return fmt.Errorf("proto: PercentageDecisionPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Member: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PercentageDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2736,43 +4945,13 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFoundation
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthFoundation
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthFoundation
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Percentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2799,7 +4978,10 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.AddedAt, dAtA[iNdEx:postIndex]); err != nil {
+			if m.Windows == nil {
+				m.Windows = &DecisionPolicyWindows{}
+			}
+			if err := m.Windows.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2824,7 +5006,7 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MemberRequest) Unmarshal(dAtA []byte) error {
+func (m *DecisionPolicyWindows) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2847,17 +5029,17 @@ func (m *MemberRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MemberRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DecisionPolicyWindows: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MemberRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DecisionPolicyWindows: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFoundation
@@ -2867,49 +5049,30 @@ func (m *MemberRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFoundation
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFoundation
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFoundation
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Remove = bool(v != 0)
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinExecutionPeriod", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFoundation
@@ -2919,23 +5082,24 @@ func (m *MemberRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFoundation
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFoundation
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MinExecutionPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2958,7 +5122,7 @@ func (m *MemberRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ThresholdDecisionPolicy) Unmarshal(dAtA []byte) error {
+func (m *OutsourcingDecisionPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2981,15 +5145,15 @@ func (m *ThresholdDecisionPolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ThresholdDecisionPolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: OutsourcingDecisionPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ThresholdDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OutsourcingDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3001,61 +5165,23 @@ func (m *ThresholdDecisionPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthFoundation
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthFoundation
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFoundation
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFoundation
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFoundation
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Windows == nil {
-				m.Windows = &DecisionPolicyWindows{}
-			}
-			if err := m.Windows.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3078,7 +5204,7 @@ func (m *ThresholdDecisionPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PercentageDecisionPolicy) Unmarshal(dAtA []byte) error {
+func (m *FoundationInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3101,15 +5227,34 @@ func (m *PercentageDecisionPolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PercentageDecisionPolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: FoundationInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PercentageDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FoundationInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalWeight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3137,13 +5282,13 @@ func (m *PercentageDecisionPolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Percentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionPolicy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3170,10 +5315,10 @@ func (m *PercentageDecisionPolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Windows == nil {
-				m.Windows = &DecisionPolicyWindows{}
+			if m.DecisionPolicy == nil {
+				m.DecisionPolicy = &types1.Any{}
 			}
-			if err := m.Windows.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DecisionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3198,7 +5343,7 @@ func (m *PercentageDecisionPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DecisionPolicyWindows) Unmarshal(dAtA []byte) error {
+func (m *Proposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3221,17 +5366,36 @@ func (m *DecisionPolicyWindows) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DecisionPolicyWindows: wiretype end group for non-group")
+			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DecisionPolicyWindows: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFoundation
@@ -3241,28 +5405,59 @@ func (m *DecisionPolicyWindows) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFoundation
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFoundation
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Proposers = append(m.Proposers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinExecutionPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3289,65 +5484,53 @@ func (m *DecisionPolicyWindows) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MinExecutionPeriod, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFoundation(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthFoundation
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FoundationVersion", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OutsourcingDecisionPolicy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFoundation
+			m.FoundationVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FoundationVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ProposalStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OutsourcingDecisionPolicy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OutsourcingDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalTallyResult", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFoundation
@@ -3357,79 +5540,63 @@ func (m *OutsourcingDecisionPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFoundation
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFoundation
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFoundation(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.FinalTallyResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthFoundation
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriodEnd", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FoundationInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFoundation
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.VotingPeriodEnd, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FoundationInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FoundationInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorResult", wireType)
 			}
-			m.Version = 0
+			m.ExecutorResult = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFoundation
@@ -3439,16 +5606,16 @@ func (m *FoundationInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint64(b&0x7F) << shift
+				m.ExecutorResult |= ProposalExecutorResult(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalWeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFoundation
@@ -3458,29 +5625,29 @@ func (m *FoundationInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFoundation
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFoundation
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Messages = append(m.Messages, &types1.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DecisionPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3507,10 +5674,10 @@ func (m *FoundationInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DecisionPolicy == nil {
-				m.DecisionPolicy = &types.Any{}
+			if m.ExecuteAt == nil {
+				m.ExecuteAt = new(time.Time)
 			}
-			if err := m.DecisionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExecuteAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3535,7 +5702,7 @@ func (m *FoundationInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Proposal) Unmarshal(dAtA []byte) error {
+func (m *TallyResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3558,17 +5725,17 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YesCount", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFoundation
@@ -3578,14 +5745,29 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.YesCount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstainCount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3613,11 +5795,13 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			if err := m.AbstainCount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NoCount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3645,13 +5829,15 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposers = append(m.Proposers, string(dAtA[iNdEx:postIndex]))
+			if err := m.NoCount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NoWithVetoCount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFoundation
@@ -3661,49 +5847,81 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFoundation
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFoundation
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NoWithVetoCount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FoundationVersion", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFoundation(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.FoundationVersion = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFoundation
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FoundationVersion |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFoundation
 			}
-		case 6:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Vote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFoundation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			m.Status = 0
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFoundation
@@ -3713,16 +5931,16 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= ProposalStatus(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalTallyResult", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFoundation
@@ -3732,30 +5950,29 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFoundation
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFoundation
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FinalTallyResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriodEnd", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
 			}
-			var msglen int
+			m.Option = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFoundation
@@ -3765,30 +5982,16 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Option |= VoteOption(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthFoundation
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthFoundation
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.VotingPeriodEnd, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorResult", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			m.ExecutorResult = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFoundation
@@ -3798,14 +6001,27 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExecutorResult |= ProposalExecutorResult(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3832,8 +6048,7 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Messages = append(m.Messages, &types.Any{})
-			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3858,7 +6073,7 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TallyResult) Unmarshal(dAtA []byte) error {
+func (m *Pool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3881,17 +6096,17 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field YesCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFoundation
@@ -3901,31 +6116,100 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFoundation
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFoundation
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.YesCount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Treasury = append(m.Treasury, types.DecCoin{})
+			if err := m.Treasury[len(m.Treasury)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFoundation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaxRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFoundation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaxRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaxRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbstainCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFoundation
@@ -3935,31 +6219,31 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFoundation
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFoundation
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AbstainCount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Treasury = append(m.Treasury, types.Coin{})
+			if err := m.Treasury[len(m.Treasury)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFoundation
@@ -3969,31 +6253,31 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFoundation
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFoundation
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NoCount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CommunityPool = append(m.CommunityPool, types.Coin{})
+			if err := m.CommunityPool[len(m.CommunityPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoWithVetoCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFoundation
@@ -4003,23 +6287,23 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFoundation
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFoundation
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NoWithVetoCount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Burned = append(m.Burned, types.Coin{})
+			if err := m.Burned[len(m.Burned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4044,7 +6328,7 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Vote) Unmarshal(dAtA []byte) error {
+func (m *TreasuryStream) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4067,17 +6351,17 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
+			return fmt.Errorf("proto: TreasuryStream: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TreasuryStream: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.ProposalId = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFoundation
@@ -4087,14 +6371,14 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4122,13 +6406,13 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.Option = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFoundation
@@ -4138,16 +6422,31 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Option |= VoteOption(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFoundation
@@ -4157,27 +6456,28 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFoundation
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFoundation
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4204,7 +6504,41 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.Remaining = append(m.Remaining, types.Coin{})
+			if err := m.Remaining[len(m.Remaining)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPayoutTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.NextPayoutTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4229,7 +6563,7 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Pool) Unmarshal(dAtA []byte) error {
+func (m *ProposalDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4252,15 +6586,66 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProposalDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProposalDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFoundation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFoundation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4287,8 +6672,8 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Treasury = append(m.Treasury, types1.DecCoin{})
-			if err := m.Treasury[len(m.Treasury)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4435,7 +6820,7 @@ func (m *FoundationExecProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Messages = append(m.Messages, &types.Any{})
+			m.Messages = append(m.Messages, &types1.Any{})
 			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -15,26 +15,31 @@ import (
 func TestTallyResult(t *testing.T) {
 	result := foundation.DefaultTallyResult()
 
-	err := result.Add(foundation.VOTE_OPTION_UNSPECIFIED)
+	err := result.Add(foundation.VOTE_OPTION_UNSPECIFIED, sdk.OneDec())
 	require.Error(t, err)
 
-	err = result.Add(foundation.VOTE_OPTION_YES)
+	err = result.Add(foundation.VOTE_OPTION_YES, sdk.OneDec())
 	require.NoError(t, err)
 	require.Equal(t, sdk.OneDec(), result.YesCount)
 
-	err = result.Add(foundation.VOTE_OPTION_ABSTAIN)
+	err = result.Add(foundation.VOTE_OPTION_ABSTAIN, sdk.OneDec())
 	require.NoError(t, err)
 	require.Equal(t, sdk.OneDec(), result.AbstainCount)
 
-	err = result.Add(foundation.VOTE_OPTION_NO)
+	err = result.Add(foundation.VOTE_OPTION_NO, sdk.OneDec())
 	require.NoError(t, err)
 	require.Equal(t, sdk.OneDec(), result.NoCount)
 
-	err = result.Add(foundation.VOTE_OPTION_NO_WITH_VETO)
+	err = result.Add(foundation.VOTE_OPTION_NO_WITH_VETO, sdk.OneDec())
 	require.NoError(t, err)
 	require.Equal(t, sdk.OneDec(), result.NoWithVetoCount)
 
 	require.Equal(t, sdk.NewDec(4), result.TotalCounts())
+
+	err = result.Add(foundation.VOTE_OPTION_YES, sdk.NewDec(3))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(4), result.YesCount)
+	require.Equal(t, sdk.NewDec(7), result.TotalCounts())
 }
 
 func TestThresholdDecisionPolicy(t *testing.T) {
@@ -358,9 +363,11 @@ func TestMembers(t *testing.T) {
 			members: []foundation.Member{
 				{
 					Address: addrs[0].String(),
+					Weight:  sdk.OneDec(),
 				},
 				{
 					Address: addrs[1].String(),
+					Weight:  sdk.OneDec(),
 				},
 			},
 			valid: true,
@@ -368,13 +375,23 @@ func TestMembers(t *testing.T) {
 		"invalid member": {
 			members: []foundation.Member{{}},
 		},
+		"non-positive weight": {
+			members: []foundation.Member{
+				{
+					Address: addrs[0].String(),
+					Weight:  sdk.ZeroDec(),
+				},
+			},
+		},
 		"duplicate members": {
 			members: []foundation.Member{
 				{
 					Address: addrs[0].String(),
+					Weight:  sdk.OneDec(),
 				},
 				{
 					Address: addrs[0].String(),
+					Weight:  sdk.OneDec(),
 				},
 			},
 		},
@@ -407,6 +424,7 @@ func TestMemberRequests(t *testing.T) {
 			members: []foundation.MemberRequest{
 				{
 					Address: addrs[0].String(),
+					Weight:  sdk.OneDec(),
 				},
 				{
 					Address: addrs[1].String(),
@@ -418,10 +436,19 @@ func TestMemberRequests(t *testing.T) {
 		"invalid member": {
 			members: []foundation.MemberRequest{{}},
 		},
+		"non-positive weight": {
+			members: []foundation.MemberRequest{
+				{
+					Address: addrs[0].String(),
+					Weight:  sdk.ZeroDec(),
+				},
+			},
+		},
 		"duplicate requests": {
 			members: []foundation.MemberRequest{
 				{
 					Address: addrs[0].String(),
+					Weight:  sdk.OneDec(),
 				},
 				{
 					Address: addrs[0].String(),
@@ -555,6 +582,105 @@ func TestProposal(t *testing.T) {
 	}
 }
 
+func TestProposalDeposit(t *testing.T) {
+	depositor := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	testCases := map[string]struct {
+		id        uint64
+		depositor string
+		amount    sdk.Coins
+		valid     bool
+	}{
+		"valid deposit": {
+			id:        1,
+			depositor: depositor.String(),
+			amount:    sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+			valid:     true,
+		},
+		"invalid id": {
+			depositor: depositor.String(),
+			amount:    sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+		},
+		"invalid depositor": {
+			id:     1,
+			amount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+		},
+		"empty amount": {
+			id:        1,
+			depositor: depositor.String(),
+		},
+		"invalid amount": {
+			id:        1,
+			depositor: depositor.String(),
+			amount:    sdk.Coins{sdk.Coin{Denom: "", Amount: sdk.OneInt()}},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			deposit := foundation.ProposalDeposit{
+				ProposalId: tc.id,
+				Depositor:  tc.depositor,
+				Amount:     tc.amount,
+			}
+
+			err := deposit.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestTaxSplit(t *testing.T) {
+	testCases := map[string]struct {
+		treasury      sdk.Dec
+		communityPool sdk.Dec
+		burn          sdk.Dec
+		valid         bool
+	}{
+		"valid split": {
+			treasury:      sdk.MustNewDecFromStr("0.5"),
+			communityPool: sdk.MustNewDecFromStr("0.3"),
+			burn:          sdk.MustNewDecFromStr("0.2"),
+			valid:         true,
+		},
+		"nil ratio": {
+			treasury:      sdk.OneDec(),
+			communityPool: sdk.ZeroDec(),
+		},
+		"negative ratio": {
+			treasury:      sdk.MustNewDecFromStr("1.1"),
+			communityPool: sdk.MustNewDecFromStr("-0.1"),
+			burn:          sdk.ZeroDec(),
+		},
+		"sum less than 1": {
+			treasury:      sdk.MustNewDecFromStr("0.5"),
+			communityPool: sdk.ZeroDec(),
+			burn:          sdk.ZeroDec(),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			split := foundation.TaxSplit{
+				Treasury:      tc.treasury,
+				CommunityPool: tc.communityPool,
+				Burn:          tc.burn,
+			}
+
+			err := split.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestOutsourcingDecisionPolicy(t *testing.T) {
 	config := foundation.DefaultConfig()
 
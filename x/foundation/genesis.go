@@ -37,6 +37,16 @@ func DefaultAuthority() sdk.AccAddress {
 func DefaultParams() Params {
 	return Params{
 		FoundationTax: sdk.ZeroDec(),
+		TaxSplit:      DefaultTaxSplit(),
+	}
+}
+
+// DefaultTaxSplit sends the whole foundation tax to the treasury.
+func DefaultTaxSplit() TaxSplit {
+	return TaxSplit{
+		Treasury:      sdk.OneDec(),
+		CommunityPool: sdk.ZeroDec(),
+		Burn:          sdk.ZeroDec(),
 	}
 }
 
@@ -104,13 +114,25 @@ func ValidateGenesis(data GenesisState) error {
 	// Is x/foundation outsourcing the proposal feature
 	isOutsourcing := info.TotalWeight.IsZero()
 
-	if realWeight := sdk.NewDec(int64(len(data.Members))); !info.TotalWeight.Equal(realWeight) {
-		return sdkerrors.ErrInvalidRequest.Wrapf("total weight not match, %s != %s", info.TotalWeight, realWeight)
-	}
 	members := Members{Members: data.Members}
 	if err := members.ValidateBasic(); err != nil {
 		return err
 	}
+	// the weights of the suspended members do not count
+	realWeight := members.TotalWeight()
+	for _, activity := range data.MemberActivities {
+		if !activity.Suspended {
+			continue
+		}
+		for _, member := range data.Members {
+			if member.Address == activity.Address {
+				realWeight = realWeight.Sub(member.Weight)
+			}
+		}
+	}
+	if !info.TotalWeight.Equal(realWeight) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("total weight not match, %s != %s", info.TotalWeight, realWeight)
+	}
 
 	if isOutsourcing && len(data.Proposals) != 0 {
 		return sdkerrors.ErrInvalidRequest.Wrap("outsourcing policy not allows proposals")
@@ -149,6 +171,45 @@ func ValidateGenesis(data GenesisState) error {
 		}
 	}
 
+	depositIDs := map[uint64]bool{}
+	for _, deposit := range data.Deposits {
+		id := deposit.ProposalId
+		if !proposalIDs[id] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("deposit for a proposal which does not exist: id %d", id)
+		}
+		if depositIDs[id] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicated deposit for proposal %d", id)
+		}
+		depositIDs[id] = true
+
+		if err := deposit.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	memberAddrs := map[string]bool{}
+	for _, member := range data.Members {
+		memberAddrs[member.Address] = true
+	}
+	activityAddrs := map[string]bool{}
+	for _, activity := range data.MemberActivities {
+		addr := activity.Address
+		if !memberAddrs[addr] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("activity of a non-member: %s", addr)
+		}
+		if activityAddrs[addr] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicated activity of %s", addr)
+		}
+		activityAddrs[addr] = true
+
+		if activity.LastVotedProposalId > data.PreviousProposalId {
+			return sdkerrors.ErrInvalidRequest.Wrapf("vote for a proposal which has not yet been submitted: id %d", activity.LastVotedProposalId)
+		}
+		if activity.ConsecutiveMissedVotes > activity.MissedVotes {
+			return sdkerrors.ErrInvalidRequest.Wrapf("consecutive missed votes of %s exceeds the missed votes", addr)
+		}
+	}
+
 	seenURLs := map[string]bool{}
 	for _, censorship := range data.Censorships {
 		if err := censorship.ValidateBasic(); err != nil {
@@ -185,6 +246,22 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
+	streamIDs := map[uint64]bool{}
+	for _, stream := range data.TreasuryStreams {
+		id := stream.Id
+		if id > data.PreviousTreasuryStreamId {
+			return sdkerrors.ErrInvalidRequest.Wrapf("treasury stream %d has not yet been created", id)
+		}
+		if streamIDs[id] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicated treasury stream id of %d", id)
+		}
+		streamIDs[id] = true
+
+		if err := stream.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
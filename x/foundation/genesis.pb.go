@@ -8,16 +8,20 @@ import (
 	types "github.com/Finschia/finschia-sdk/codec/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/regen-network/cosmos-proto"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -44,6 +48,14 @@ type GenesisState struct {
 	// pool
 	Pool        Pool         `protobuf:"bytes,8,opt,name=pool,proto3" json:"pool"`
 	Censorships []Censorship `protobuf:"bytes,10,rep,name=censorships,proto3" json:"censorships"`
+	// it is used to get the next treasury stream ID.
+	PreviousTreasuryStreamId uint64 `protobuf:"varint,11,opt,name=previous_treasury_stream_id,json=previousTreasuryStreamId,proto3" json:"previous_treasury_stream_id,omitempty"`
+	// treasury_streams is the list of the treasury streams.
+	TreasuryStreams []TreasuryStream `protobuf:"bytes,12,rep,name=treasury_streams,json=treasuryStreams,proto3" json:"treasury_streams"`
+	// deposits is the list of the escrowed proposal deposits.
+	Deposits []ProposalDeposit `protobuf:"bytes,13,rep,name=deposits,proto3" json:"deposits"`
+	// member_activities is the list of the participation records of the members.
+	MemberActivities []MemberActivity `protobuf:"bytes,14,rep,name=member_activities,json=memberActivities,proto3" json:"member_activities"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -83,6 +95,8 @@ var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 type GrantAuthorization struct {
 	Grantee       string     `protobuf:"bytes,1,opt,name=grantee,proto3" json:"grantee,omitempty"`
 	Authorization *types.Any `protobuf:"bytes,2,opt,name=authorization,proto3" json:"authorization,omitempty"`
+	// expiration is the time at which the grant expires, if any.
+	Expiration *time.Time `protobuf:"bytes,3,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
 }
 
 func (m *GrantAuthorization) Reset()         { *m = GrantAuthorization{} }
@@ -126,40 +140,49 @@ func init() {
 func init() { proto.RegisterFile("lbm/foundation/v1/genesis.proto", fileDescriptor_c5e13dd78b24d473) }
 
 var fileDescriptor_c5e13dd78b24d473 = []byte{
-	// 517 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0x87, 0x6d, 0xea, 0xe6, 0xcf, 0x15, 0x10, 0x9c, 0x22, 0x71, 0x2d, 0xc2, 0x09, 0x91, 0x90,
-	0xba, 0xc4, 0x26, 0x74, 0x40, 0x94, 0xa1, 0x6a, 0x10, 0x8d, 0x0a, 0x42, 0x8a, 0x52, 0x89, 0x81,
-	0xa5, 0xb2, 0x93, 0x8b, 0x73, 0x22, 0xbe, 0xd7, 0xf2, 0x9d, 0x23, 0x0a, 0x5f, 0x80, 0x91, 0x8f,
-	0xd0, 0x91, 0x15, 0x89, 0x0f, 0x51, 0x31, 0x55, 0x4c, 0x4c, 0x08, 0x25, 0x0b, 0x1f, 0x03, 0xe5,
-	0x7c, 0x6e, 0x1c, 0x62, 0x06, 0x36, 0x5f, 0xde, 0xe7, 0x79, 0xdf, 0xdf, 0x9d, 0xde, 0xa0, 0xfa,
-	0xc4, 0x0f, 0xdd, 0x11, 0x24, 0x7c, 0xe8, 0x49, 0x06, 0xdc, 0x9d, 0xb6, 0xdd, 0x80, 0x72, 0x2a,
-	0x98, 0x70, 0xa2, 0x18, 0x24, 0xe0, 0xdb, 0x13, 0x3f, 0x74, 0x96, 0x80, 0x33, 0x6d, 0xef, 0xd4,
-	0x02, 0x08, 0x40, 0x55, 0xdd, 0xc5, 0x57, 0x0a, 0xee, 0x34, 0xd7, 0x3b, 0xe5, 0xb4, 0x94, 0xd9,
-	0x1e, 0x80, 0x08, 0x41, 0x9c, 0xa6, 0x72, 0x7a, 0xc8, 0x4a, 0x01, 0x40, 0x30, 0xa1, 0xae, 0x3a,
-	0xf9, 0xc9, 0xc8, 0xf5, 0xf8, 0x59, 0x5a, 0x6a, 0x7e, 0xb7, 0xd0, 0xf5, 0x6e, 0x1a, 0xea, 0x44,
-	0x7a, 0x92, 0xe2, 0xc7, 0xa8, 0x14, 0x79, 0xb1, 0x17, 0x0a, 0x62, 0x36, 0xcc, 0xdd, 0xad, 0x47,
-	0xdb, 0xce, 0x5a, 0x48, 0xa7, 0xa7, 0x80, 0x8e, 0x75, 0xf1, 0xb3, 0x6e, 0xf4, 0x35, 0x8e, 0xbb,
-	0x08, 0x2d, 0x29, 0x72, 0x4d, 0xc9, 0xf7, 0x0b, 0xe4, 0xa3, 0xab, 0xd3, 0x31, 0x1f, 0x81, 0x6e,
-	0x92, 0x53, 0xf1, 0x13, 0x54, 0x0e, 0x69, 0xe8, 0xd3, 0x58, 0x90, 0x8d, 0xc6, 0xc6, 0x3f, 0x22,
-	0xbc, 0x52, 0x84, 0xb6, 0x33, 0x1e, 0x3f, 0x44, 0xb5, 0x28, 0xa6, 0x53, 0x06, 0x89, 0x7a, 0x87,
-	0x08, 0x84, 0x37, 0x39, 0x65, 0x43, 0x62, 0x35, 0xcc, 0x5d, 0xab, 0x8f, 0xb3, 0x5a, 0x4f, 0x97,
-	0x8e, 0x87, 0xf8, 0x00, 0x55, 0x33, 0x50, 0x90, 0x4d, 0x35, 0xee, 0x6e, 0xd1, 0x8d, 0x35, 0xa3,
-	0x07, 0x2e, 0x1d, 0xbc, 0x87, 0x36, 0xa7, 0x20, 0xa9, 0x20, 0x25, 0x25, 0xdf, 0x29, 0x90, 0x5f,
-	0x83, 0xa4, 0x5a, 0x4c, 0x59, 0x7c, 0x82, 0x6e, 0x7a, 0x89, 0x1c, 0x43, 0xcc, 0xde, 0x2b, 0x4a,
-	0x90, 0xb2, 0xb2, 0x1f, 0x14, 0xd8, 0xdd, 0xd8, 0xe3, 0xf2, 0x30, 0x4f, 0xeb, 0x5e, 0x7f, 0xb5,
-	0xc0, 0x6d, 0x64, 0x45, 0x00, 0x13, 0x52, 0x51, 0x4f, 0x5f, 0x14, 0xa4, 0x07, 0x90, 0xdd, 0x40,
-	0xa1, 0xf8, 0x39, 0xda, 0x1a, 0x50, 0x2e, 0x20, 0x16, 0x63, 0x16, 0x09, 0x82, 0x54, 0x88, 0x7b,
-	0x05, 0xe6, 0xb3, 0x2b, 0x4a, 0xfb, 0x79, 0x6f, 0xbf, 0xf2, 0xf1, 0xbc, 0x6e, 0xfc, 0x3e, 0xaf,
-	0x1b, 0x2f, 0xac, 0x4a, 0xf5, 0x16, 0x6a, 0x7e, 0x31, 0x11, 0x5e, 0x8f, 0x8d, 0x09, 0x2a, 0x07,
-	0x8b, 0x5f, 0x29, 0x55, 0xbb, 0x55, 0xed, 0x67, 0x47, 0xfc, 0x01, 0xdd, 0x58, 0xb9, 0x8c, 0x5e,
-	0x9f, 0x9a, 0x93, 0x2e, 0xae, 0x93, 0x2d, 0xae, 0x73, 0xc8, 0xcf, 0x3a, 0x07, 0xdf, 0xbe, 0xb6,
-	0x9e, 0x06, 0x4c, 0x8e, 0x13, 0xdf, 0x19, 0x40, 0xe8, 0x1e, 0x31, 0x2e, 0x06, 0x63, 0xe6, 0xb9,
-	0x23, 0xfd, 0xd1, 0x12, 0xc3, 0xb7, 0xee, 0xbb, 0xfc, 0x3f, 0x64, 0x25, 0x47, 0x7f, 0x75, 0xd6,
-	0xbe, 0xb5, 0x48, 0xdf, 0x79, 0xf9, 0x79, 0x66, 0x9b, 0x17, 0x33, 0xdb, 0xbc, 0x9c, 0xd9, 0xe6,
-	0xaf, 0x99, 0x6d, 0x7e, 0x9a, 0xdb, 0xc6, 0xe5, 0xdc, 0x36, 0x7e, 0xcc, 0x6d, 0xe3, 0x4d, 0xeb,
-	0xbf, 0xe6, 0xf9, 0x25, 0x15, 0x78, 0xef, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x52, 0x37, 0xe3,
-	0x2e, 0x02, 0x04, 0x00, 0x00,
+	// 661 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x4d, 0x4f, 0x13, 0x4f,
+	0x18, 0xc0, 0xbb, 0x7f, 0x16, 0x5a, 0x86, 0x97, 0x3f, 0x4c, 0x48, 0x1c, 0x20, 0x6e, 0xb1, 0x89,
+	0x09, 0x17, 0x76, 0x45, 0x0e, 0x46, 0x8c, 0x41, 0x2a, 0x42, 0xd0, 0x98, 0x90, 0x42, 0x3c, 0x78,
+	0x69, 0xa6, 0xed, 0x74, 0x3b, 0xb1, 0xbb, 0xb3, 0xd9, 0x67, 0xda, 0x50, 0x3d, 0x79, 0xf3, 0xc8,
+	0x47, 0xe0, 0xe8, 0x07, 0xf0, 0x43, 0x10, 0x4f, 0x1c, 0x3d, 0xa9, 0x29, 0x17, 0xef, 0x7e, 0x01,
+	0xd3, 0x99, 0xd9, 0xb2, 0xa5, 0x8b, 0x89, 0xb7, 0x9d, 0x79, 0x7e, 0xbf, 0xe7, 0x79, 0xe6, 0x6d,
+	0x51, 0xb1, 0x5d, 0x0b, 0xbc, 0xa6, 0xe8, 0x84, 0x0d, 0x2a, 0xb9, 0x08, 0xbd, 0xee, 0xa6, 0xe7,
+	0xb3, 0x90, 0x01, 0x07, 0x37, 0x8a, 0x85, 0x14, 0x78, 0xb1, 0x5d, 0x0b, 0xdc, 0x6b, 0xc0, 0xed,
+	0x6e, 0xae, 0x2c, 0xf9, 0xc2, 0x17, 0x2a, 0xea, 0x0d, 0xbe, 0x34, 0xb8, 0x52, 0x1a, 0xcf, 0x94,
+	0xd2, 0x34, 0xb3, 0x5c, 0x17, 0x10, 0x08, 0xa8, 0x6a, 0x59, 0x0f, 0x92, 0x90, 0x2f, 0x84, 0xdf,
+	0x66, 0x9e, 0x1a, 0xd5, 0x3a, 0x4d, 0x8f, 0x86, 0x3d, 0x13, 0x2a, 0xde, 0x0c, 0x49, 0x1e, 0x30,
+	0x90, 0x34, 0x88, 0x34, 0x50, 0xfa, 0x98, 0x47, 0xb3, 0x07, 0xba, 0xeb, 0x63, 0x49, 0x25, 0xc3,
+	0x8f, 0xd0, 0x54, 0x44, 0x63, 0x1a, 0x00, 0xb1, 0xd6, 0xac, 0xf5, 0x99, 0x87, 0xcb, 0xee, 0xd8,
+	0x2a, 0xdc, 0x23, 0x05, 0x94, 0xed, 0x8b, 0xef, 0xc5, 0x5c, 0xc5, 0xe0, 0xf8, 0x00, 0xa1, 0x6b,
+	0x8a, 0xfc, 0xa7, 0xe4, 0x7b, 0x19, 0xf2, 0xfe, 0x70, 0x74, 0x18, 0x36, 0x85, 0x49, 0x92, 0x52,
+	0xf1, 0x63, 0x94, 0x0f, 0x58, 0x50, 0x63, 0x31, 0x90, 0x89, 0xb5, 0x89, 0x5b, 0x5a, 0x78, 0xad,
+	0x08, 0x63, 0x27, 0x3c, 0x7e, 0x80, 0x96, 0xa2, 0x98, 0x75, 0xb9, 0xe8, 0xa8, 0x8d, 0x8a, 0x04,
+	0xd0, 0x76, 0x95, 0x37, 0x88, 0xbd, 0x66, 0xad, 0xdb, 0x15, 0x9c, 0xc4, 0x8e, 0x4c, 0xe8, 0xb0,
+	0x81, 0x77, 0xd0, 0x74, 0x02, 0x02, 0x99, 0x54, 0xe5, 0x56, 0xb3, 0x56, 0x6c, 0x18, 0x53, 0xf0,
+	0xda, 0xc1, 0x5b, 0x68, 0xb2, 0x2b, 0x24, 0x03, 0x32, 0xa5, 0xe4, 0x3b, 0x19, 0xf2, 0x1b, 0x21,
+	0x99, 0x11, 0x35, 0x8b, 0x8f, 0xd1, 0x3c, 0xed, 0xc8, 0x96, 0x88, 0xf9, 0x7b, 0x45, 0x01, 0xc9,
+	0x2b, 0xfb, 0x7e, 0x86, 0x7d, 0x10, 0xd3, 0x50, 0xee, 0xa6, 0x69, 0x93, 0xeb, 0x46, 0x0a, 0xbc,
+	0x89, 0xec, 0x48, 0x88, 0x36, 0x29, 0xa8, 0xad, 0xcf, 0x6a, 0xe4, 0x48, 0x88, 0x64, 0x05, 0x0a,
+	0xc5, 0x2f, 0xd0, 0x4c, 0x9d, 0x85, 0x20, 0x62, 0x68, 0xf1, 0x08, 0x08, 0x52, 0x4d, 0xdc, 0xcd,
+	0x30, 0x9f, 0x0f, 0x29, 0xe3, 0xa7, 0x3d, 0xfc, 0x14, 0xad, 0x0e, 0xb7, 0x5d, 0xc6, 0x8c, 0x42,
+	0x27, 0xee, 0x55, 0x61, 0xf0, 0x15, 0x0c, 0x76, 0x7f, 0x46, 0xed, 0x3e, 0x49, 0x90, 0x13, 0x43,
+	0x1c, 0x2b, 0xe0, 0xb0, 0x81, 0x2b, 0x68, 0xe1, 0x86, 0x05, 0x64, 0x56, 0xb5, 0x92, 0x75, 0x7f,
+	0x46, 0x75, 0xd3, 0xce, 0xff, 0x72, 0x64, 0x16, 0xf0, 0x1e, 0x2a, 0x34, 0x58, 0x24, 0x80, 0x4b,
+	0x20, 0x73, 0x2a, 0x57, 0xe9, 0x2f, 0xc7, 0xba, 0xa7, 0x51, 0x93, 0x6c, 0x68, 0xe2, 0x13, 0xb4,
+	0xa8, 0xaf, 0x56, 0x95, 0xd6, 0x25, 0xef, 0x72, 0xc9, 0x19, 0x90, 0xf9, 0x5b, 0x5b, 0xd3, 0x97,
+	0x72, 0x57, 0xa3, 0x3d, 0x93, 0x6d, 0x21, 0x48, 0xcf, 0x72, 0x06, 0xdb, 0x85, 0x4f, 0xe7, 0xc5,
+	0xdc, 0xaf, 0xf3, 0x62, 0xee, 0xa5, 0x5d, 0x98, 0x5e, 0x40, 0xa5, 0xdf, 0x16, 0xc2, 0xe3, 0xa7,
+	0x8c, 0x09, 0xca, 0xfb, 0x83, 0x59, 0xc6, 0xd4, 0x53, 0x9c, 0xae, 0x24, 0x43, 0xfc, 0x01, 0xcd,
+	0x8d, 0x9c, 0xbd, 0x79, 0x6d, 0x4b, 0xae, 0x7e, 0xed, 0x6e, 0xf2, 0xda, 0xdd, 0xdd, 0xb0, 0x57,
+	0xde, 0xf9, 0xfa, 0x65, 0xe3, 0x89, 0xcf, 0x65, 0xab, 0x53, 0x73, 0xeb, 0x22, 0xf0, 0xf6, 0x79,
+	0x08, 0xf5, 0x16, 0xa7, 0x5e, 0xd3, 0x7c, 0x6c, 0x40, 0xe3, 0x9d, 0x77, 0x9a, 0xfe, 0xe3, 0x8c,
+	0xf4, 0x51, 0x19, 0xad, 0x85, 0x9f, 0x21, 0xc4, 0x4e, 0x23, 0x1e, 0xeb, 0xca, 0x13, 0xaa, 0xf2,
+	0xca, 0x58, 0xe5, 0x93, 0xe4, 0x3f, 0x53, 0xb6, 0xcf, 0x7e, 0x14, 0xad, 0x4a, 0xca, 0xd9, 0xb6,
+	0x07, 0xeb, 0x2f, 0xbf, 0xfa, 0xdc, 0x77, 0xac, 0x8b, 0xbe, 0x63, 0x5d, 0xf6, 0x1d, 0xeb, 0x67,
+	0xdf, 0xb1, 0xce, 0xae, 0x9c, 0xdc, 0xe5, 0x95, 0x93, 0xfb, 0x76, 0xe5, 0xe4, 0xde, 0x6e, 0xfc,
+	0x53, 0xc7, 0xb5, 0x29, 0x55, 0x78, 0xeb, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd3, 0x09, 0xa0,
+	0x1d, 0x94, 0x05, 0x00, 0x00,
 }
 
 func (this *GrantAuthorization) Equal(that interface{}) bool {
@@ -187,6 +210,13 @@ func (this *GrantAuthorization) Equal(that interface{}) bool {
 	if !this.Authorization.Equal(that1.Authorization) {
 		return false
 	}
+	if that1.Expiration == nil {
+		if this.Expiration != nil {
+			return false
+		}
+	} else if !this.Expiration.Equal(*that1.Expiration) {
+		return false
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -209,6 +239,53 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MemberActivities) > 0 {
+		for iNdEx := len(m.MemberActivities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MemberActivities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.Deposits) > 0 {
+		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.TreasuryStreams) > 0 {
+		for iNdEx := len(m.TreasuryStreams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TreasuryStreams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.PreviousTreasuryStreamId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PreviousTreasuryStreamId))
+		i--
+		dAtA[i] = 0x58
+	}
 	if len(m.Censorships) > 0 {
 		for iNdEx := len(m.Censorships) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -337,6 +414,16 @@ func (m *GrantAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Expiration != nil {
+		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintGenesis(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Authorization != nil {
 		{
 			size, err := m.Authorization.MarshalToSizedBuffer(dAtA[:i])
@@ -415,6 +502,27 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.PreviousTreasuryStreamId != 0 {
+		n += 1 + sovGenesis(uint64(m.PreviousTreasuryStreamId))
+	}
+	if len(m.TreasuryStreams) > 0 {
+		for _, e := range m.TreasuryStreams {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Deposits) > 0 {
+		for _, e := range m.Deposits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MemberActivities) > 0 {
+		for _, e := range m.MemberActivities {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -432,6 +540,10 @@ func (m *GrantAuthorization) Size() (n int) {
 		l = m.Authorization.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if m.Expiration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -758,6 +870,127 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousTreasuryStreamId", wireType)
+			}
+			m.PreviousTreasuryStreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousTreasuryStreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryStreams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TreasuryStreams = append(m.TreasuryStreams, TreasuryStream{})
+			if err := m.TreasuryStreams[len(m.TreasuryStreams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposits = append(m.Deposits, ProposalDeposit{})
+			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberActivities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MemberActivities = append(m.MemberActivities, MemberActivity{})
+			if err := m.MemberActivities[len(m.MemberActivities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -876,6 +1109,42 @@ func (m *GrantAuthorization) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
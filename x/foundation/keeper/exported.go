@@ -13,6 +13,7 @@ import (
 type Keeper interface {
 	GetAuthority() string
 	Accept(ctx sdk.Context, grantee sdk.AccAddress, msg sdk.Msg) error
+	FundTreasury(ctx sdk.Context, from sdk.AccAddress, amt sdk.Coins) error
 
 	InitGenesis(ctx sdk.Context, gs *foundation.GenesisState) error
 	ExportGenesis(ctx sdk.Context) *foundation.GenesisState
@@ -28,6 +29,7 @@ func NewKeeper(
 	router *baseapp.MsgServiceRouter,
 	authKeeper foundation.AuthKeeper,
 	bankKeeper foundation.BankKeeper,
+	distrKeeper foundation.DistributionKeeper,
 	feeCollectorName string,
 	config foundation.Config,
 	authority string,
@@ -40,6 +42,7 @@ func NewKeeper(
 			router,
 			authKeeper,
 			bankKeeper,
+			distrKeeper,
 			feeCollectorName,
 			config,
 			authority,
@@ -57,6 +60,11 @@ func (k keeper) Accept(ctx sdk.Context, grantee sdk.AccAddress, msg sdk.Msg) err
 	return k.impl.Accept(ctx, grantee, msg)
 }
 
+// FundTreasury sends the coins from the account to the treasury.
+func (k keeper) FundTreasury(ctx sdk.Context, from sdk.AccAddress, amt sdk.Coins) error {
+	return k.impl.FundTreasury(ctx, from, amt)
+}
+
 func (k keeper) InitGenesis(ctx sdk.Context, gs *foundation.GenesisState) error {
 	return k.impl.InitGenesis(ctx, gs)
 }
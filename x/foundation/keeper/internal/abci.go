@@ -9,16 +9,21 @@ import (
 )
 
 // BeginBlocker withdraws rewards from fee-collector before the distribution
-// module's withdraw.
+// module's withdraw, and prunes the expired authorizations.
 func BeginBlocker(ctx sdk.Context, k Keeper) {
 	defer telemetry.ModuleMeasureSince(foundation.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
 	if err := k.CollectFoundationTax(ctx); err != nil {
 		panic(err)
 	}
+
+	k.PruneExpiredAuthorizations(ctx)
 }
 
 func EndBlocker(ctx sdk.Context, k Keeper) {
 	k.UpdateTallyOfVPEndProposals(ctx)
+	k.ExecScheduledProposals(ctx)
 	k.PruneExpiredProposals(ctx)
+	k.PayTreasuryStreams(ctx)
+	k.RemoveInactiveMembers(ctx)
 }
@@ -0,0 +1,174 @@
+package internal
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/foundation"
+)
+
+// recordVote updates the activity of the voter, breaking the run of the
+// missed votes.
+func (k Keeper) recordVote(ctx sdk.Context, voter sdk.AccAddress, proposalID uint64) {
+	activity := k.GetMemberActivity(ctx, voter)
+	activity.LastVotedProposalId = proposalID
+	activity.ConsecutiveMissedVotes = 0
+	k.setMemberActivity(ctx, activity)
+}
+
+// recordMissedVotes updates the activities of the members who have not voted
+// on the proposal, except the suspended ones. It must be called before pruning
+// the votes.
+func (k Keeper) recordMissedVotes(ctx sdk.Context, proposalID uint64) {
+	k.iterateMembers(ctx, func(member foundation.Member) (stop bool) {
+		addr := sdk.MustAccAddressFromBech32(member.Address)
+		if k.hasVote(ctx, proposalID, addr) {
+			return false
+		}
+
+		activity := k.GetMemberActivity(ctx, addr)
+		if activity.Suspended {
+			return false
+		}
+		activity.MissedVotes++
+		activity.ConsecutiveMissedVotes++
+		k.setMemberActivity(ctx, activity)
+
+		return false
+	})
+}
+
+// RemoveInactiveMembers removes (or suspends, if configured by the params) the
+// members who have missed the votes in a row more than allowed by the params.
+// Unlike Msg/UpdateMembers, it does not increment the foundation version, so
+// the active proposals are kept and the votes of the removed members are just
+// excluded from the tally. A member would not be removed if the removal leaves
+// no weight in the foundation, which is recorded into its activity not to be
+// tried again until the foundation gets updated.
+func (k Keeper) RemoveInactiveMembers(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	limit := params.MaxConsecutiveMissedVotes
+	if limit == 0 {
+		return
+	}
+
+	info := k.GetFoundationInfo(ctx)
+
+	var inactives []foundation.MemberActivity
+	k.iterateMemberActivities(ctx, func(activity foundation.MemberActivity) (stop bool) {
+		if activity.Suspended || activity.BlockedVersion == info.Version {
+			return false
+		}
+		if activity.ConsecutiveMissedVotes >= limit {
+			inactives = append(inactives, activity)
+		}
+
+		return false
+	})
+	if len(inactives) == 0 {
+		return
+	}
+
+	for _, activity := range inactives {
+		addr := sdk.MustAccAddressFromBech32(activity.Address)
+		member, err := k.GetMember(ctx, addr)
+		if err != nil {
+			panic(err)
+		}
+
+		info.TotalWeight = info.TotalWeight.Sub(member.Weight)
+		if err := info.GetDecisionPolicy().Validate(info, k.config); err != nil {
+			info.TotalWeight = info.TotalWeight.Add(member.Weight)
+
+			activity.BlockedVersion = info.Version
+			k.setMemberActivity(ctx, activity)
+			k.Logger(ctx).Error("failed to remove the inactive member", "address", activity.Address, "cause", err)
+			continue
+		}
+
+		if params.SuspendInactiveMembers {
+			activity.Suspended = true
+			k.setMemberActivity(ctx, activity)
+
+			if err := ctx.EventManager().EmitTypedEvent(&foundation.EventSuspendInactiveMember{
+				Address:                activity.Address,
+				ConsecutiveMissedVotes: activity.ConsecutiveMissedVotes,
+			}); err != nil {
+				panic(err)
+			}
+			continue
+		}
+
+		k.deleteMember(ctx, addr)
+
+		if err := ctx.EventManager().EmitTypedEvent(&foundation.EventRemoveInactiveMember{
+			Address:                activity.Address,
+			ConsecutiveMissedVotes: activity.ConsecutiveMissedVotes,
+		}); err != nil {
+			panic(err)
+		}
+	}
+
+	k.SetFoundationInfo(ctx, info)
+}
+
+// isSuspended returns whether the member has been suspended for its inactivity.
+func (k Keeper) isSuspended(ctx sdk.Context, address sdk.AccAddress) bool {
+	return k.GetMemberActivity(ctx, address).Suspended
+}
+
+// GetMemberActivity returns the activity of the member, which would be empty
+// if the member has not been involved in any proposal.
+func (k Keeper) GetMemberActivity(ctx sdk.Context, address sdk.AccAddress) foundation.MemberActivity {
+	store := ctx.KVStore(k.storeKey)
+	key := memberActivityKey(address)
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return foundation.MemberActivity{
+			Address: address.String(),
+		}
+	}
+
+	var activity foundation.MemberActivity
+	k.cdc.MustUnmarshal(bz, &activity)
+
+	return activity
+}
+
+func (k Keeper) setMemberActivity(ctx sdk.Context, activity foundation.MemberActivity) {
+	store := ctx.KVStore(k.storeKey)
+	addr := sdk.MustAccAddressFromBech32(activity.Address)
+	key := memberActivityKey(addr)
+
+	bz := k.cdc.MustMarshal(&activity)
+	store.Set(key, bz)
+}
+
+func (k Keeper) deleteMemberActivity(ctx sdk.Context, address sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	key := memberActivityKey(address)
+	store.Delete(key)
+}
+
+func (k Keeper) iterateMemberActivities(ctx sdk.Context, fn func(activity foundation.MemberActivity) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := memberActivityKeyPrefix
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var activity foundation.MemberActivity
+		k.cdc.MustUnmarshal(iterator.Value(), &activity)
+		if stop := fn(activity); stop {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetMemberActivities(ctx sdk.Context) []foundation.MemberActivity {
+	var activities []foundation.MemberActivity
+	k.iterateMemberActivities(ctx, func(activity foundation.MemberActivity) (stop bool) {
+		activities = append(activities, activity)
+		return false
+	})
+
+	return activities
+}
@@ -0,0 +1,184 @@
+package internal_test
+
+import (
+	"time"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/foundation"
+	"github.com/Finschia/finschia-sdk/x/foundation/keeper/internal"
+)
+
+func (s *KeeperTestSuite) TestMemberActivity() {
+	ctx, _ := s.ctx.CacheContext()
+
+	// all the members have voted on the last proposal
+	for _, member := range s.members {
+		activity := s.impl.GetMemberActivity(ctx, member)
+		s.Require().Equal(member.String(), activity.Address)
+		s.Require().Equal(s.noHandlerProposal, activity.LastVotedProposalId)
+		s.Require().Zero(activity.MissedVotes)
+		s.Require().Zero(activity.ConsecutiveMissedVotes)
+	}
+
+	// the first member has not voted on the active proposal
+	votingPeriod := s.impl.GetFoundationInfo(ctx).GetDecisionPolicy().GetVotingPeriod()
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(votingPeriod).Add(time.Nanosecond))
+	s.impl.UpdateTallyOfVPEndProposals(ctx)
+
+	for i, member := range s.members {
+		activity := s.impl.GetMemberActivity(ctx, member)
+		missed := uint64(0)
+		if i == 0 {
+			missed = 1
+		}
+		s.Require().Equal(missed, activity.MissedVotes)
+		s.Require().Equal(missed, activity.ConsecutiveMissedVotes)
+	}
+
+	// voting breaks the run of the missed votes
+	id, err := s.impl.SubmitProposal(ctx, []string{s.members[0].String()}, "", []sdk.Msg{newMsgCreateDog("shiba")}, nil)
+	s.Require().NoError(err)
+	err = s.impl.Vote(ctx, foundation.Vote{
+		ProposalId: *id,
+		Voter:      s.members[0].String(),
+		Option:     foundation.VOTE_OPTION_YES,
+	})
+	s.Require().NoError(err)
+
+	res, err := s.queryServer.MemberActivity(sdk.WrapSDKContext(ctx), &foundation.QueryMemberActivityRequest{
+		Address: s.members[0].String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(*id, res.Activity.LastVotedProposalId)
+	s.Require().Equal(uint64(1), res.Activity.MissedVotes)
+	s.Require().Zero(res.Activity.ConsecutiveMissedVotes)
+
+	// no activity for the non-member
+	_, err = s.queryServer.MemberActivity(sdk.WrapSDKContext(ctx), &foundation.QueryMemberActivityRequest{
+		Address: s.stranger.String(),
+	})
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestRemoveInactiveMembers() {
+	testCases := map[string]struct {
+		limit       uint64
+		suspend     bool
+		allInactive bool
+		removed     bool
+	}{
+		"valid request": {
+			limit:   1,
+			removed: true,
+		},
+		"suspend": {
+			limit:   1,
+			suspend: true,
+			removed: true,
+		},
+		"policy disabled": {
+			limit: 0,
+		},
+		"not enough missed votes": {
+			limit: 2,
+		},
+		"no member left": {
+			limit:       1,
+			allInactive: true,
+			removed:     true,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			params := s.impl.GetParams(ctx)
+			params.MaxConsecutiveMissedVotes = tc.limit
+			params.SuspendInactiveMembers = tc.suspend
+			s.impl.SetParams(ctx, params)
+
+			if tc.allInactive {
+				_, err := s.impl.SubmitProposal(ctx, []string{s.members[0].String()}, "", []sdk.Msg{newMsgCreateDog("shiba")}, nil)
+				s.Require().NoError(err)
+			}
+
+			// the first member misses the active proposal
+			votingPeriod := s.impl.GetFoundationInfo(ctx).GetDecisionPolicy().GetVotingPeriod()
+			ctx = ctx.WithBlockTime(ctx.BlockTime().Add(votingPeriod).Add(time.Nanosecond))
+			s.impl.UpdateTallyOfVPEndProposals(ctx)
+
+			// the removal must not abort the open proposals
+			openProposal, err := s.impl.SubmitProposal(ctx, []string{s.members[1].String()}, "", []sdk.Msg{newMsgCreateDog("akita")}, nil)
+			s.Require().NoError(err)
+
+			before := s.impl.GetFoundationInfo(ctx)
+			s.impl.RemoveInactiveMembers(ctx)
+			after := s.impl.GetFoundationInfo(ctx)
+			s.Require().Equal(before.Version, after.Version)
+
+			proposal, err := s.impl.GetProposal(ctx, *openProposal)
+			s.Require().NoError(err)
+			s.Require().Equal(foundation.PROPOSAL_STATUS_SUBMITTED, proposal.Status)
+
+			_, err = s.impl.GetMember(ctx, s.members[0])
+			if !tc.removed {
+				s.Require().NoError(err)
+				s.Require().Equal(before, after)
+				return
+			}
+
+			if tc.allInactive {
+				// the last one is left, which would not be tried again
+				members := s.impl.GetMembers(ctx)
+				s.Require().Len(members, 1)
+				s.Require().Equal(members[0].Weight, after.TotalWeight)
+
+				addr := sdk.MustAccAddressFromBech32(members[0].Address)
+				s.Require().Equal(after.Version, s.impl.GetMemberActivity(ctx, addr).BlockedVersion)
+
+				ctx = ctx.WithEventManager(sdk.NewEventManager())
+				s.impl.RemoveInactiveMembers(ctx)
+				s.Require().Empty(ctx.EventManager().Events())
+				s.Require().Equal(after, s.impl.GetFoundationInfo(ctx))
+				return
+			}
+			s.Require().Equal(before.TotalWeight.Sub(sdk.OneDec()), after.TotalWeight)
+
+			if tc.suspend {
+				s.Require().NoError(err)
+				s.Require().True(s.impl.GetMemberActivity(ctx, s.members[0]).Suspended)
+				_, broken := internal.TotalWeightInvariant(s.impl)(ctx)
+				s.Require().False(broken)
+
+				// a suspended member cannot vote
+				err = s.impl.Vote(ctx, foundation.Vote{
+					ProposalId: *openProposal,
+					Voter:      s.members[0].String(),
+					Option:     foundation.VOTE_OPTION_YES,
+				})
+				s.Require().Error(err)
+
+				// the foundation restores the member
+				err = s.impl.UpdateMembers(ctx, []foundation.MemberRequest{{
+					Address: s.members[0].String(),
+					Weight:  sdk.OneDec(),
+				}})
+				s.Require().NoError(err)
+				s.Require().Equal(before.TotalWeight, s.impl.GetFoundationInfo(ctx).TotalWeight)
+				s.Require().False(s.impl.GetMemberActivity(ctx, s.members[0]).Suspended)
+				return
+			}
+			s.Require().Error(err)
+
+			// the activity has been removed with the member
+			activity := s.impl.GetMemberActivity(ctx, s.members[0])
+			s.Require().Zero(activity.MissedVotes)
+
+			for _, member := range s.members[1:] {
+				_, err := s.impl.GetMember(ctx, member)
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
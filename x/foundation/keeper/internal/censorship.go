@@ -1,6 +1,8 @@
 package internal
 
 import (
+	"time"
+
 	sdk "github.com/Finschia/finschia-sdk/types"
 	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 	"github.com/Finschia/finschia-sdk/x/foundation"
@@ -72,7 +74,7 @@ func (k Keeper) iterateCensorships(ctx sdk.Context, fn func(censorship foundatio
 	}
 }
 
-func (k Keeper) Grant(ctx sdk.Context, grantee sdk.AccAddress, authorization foundation.Authorization) error {
+func (k Keeper) Grant(ctx sdk.Context, grantee sdk.AccAddress, authorization foundation.Authorization, expiration *time.Time) error {
 	msgTypeURL := authorization.MsgTypeURL()
 	if !k.IsCensoredMessage(ctx, msgTypeURL) {
 		return sdkerrors.ErrInvalidRequest.Wrapf("%s is not being censored", msgTypeURL)
@@ -82,7 +84,14 @@ func (k Keeper) Grant(ctx sdk.Context, grantee sdk.AccAddress, authorization fou
 		return sdkerrors.ErrInvalidRequest.Wrapf("authorization for %s already exists", msgTypeURL)
 	}
 
+	if expiration != nil && !expiration.After(ctx.BlockTime()) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("expiration must be after the current block time: %s", ctx.BlockTime())
+	}
+
 	k.setAuthorization(ctx, grantee, authorization)
+	if expiration != nil {
+		k.setGrantExpiration(ctx, grantee, msgTypeURL, *expiration)
+	}
 
 	any, err := foundation.SetAuthorization(authorization)
 	if err != nil {
@@ -92,6 +101,7 @@ func (k Keeper) Grant(ctx sdk.Context, grantee sdk.AccAddress, authorization fou
 	if err := ctx.EventManager().EmitTypedEvent(&foundation.EventGrant{
 		Grantee:       grantee.String(),
 		Authorization: any,
+		Expiration:    expiration,
 	}); err != nil {
 		panic(err)
 	}
@@ -164,6 +174,71 @@ func (k Keeper) deleteAuthorization(ctx sdk.Context, grantee sdk.AccAddress, msg
 	store := ctx.KVStore(k.storeKey)
 	key := grantKey(grantee, msgTypeURL)
 	store.Delete(key)
+
+	if expiration := k.GetGrantExpiration(ctx, grantee, msgTypeURL); expiration != nil {
+		store.Delete(grantExpirationKey(grantee, msgTypeURL))
+		store.Delete(grantByExpirationKey(*expiration, grantee, msgTypeURL))
+	}
+}
+
+// GetGrantExpiration returns the expiration of the grant, or nil if the grant
+// never expires.
+func (k Keeper) GetGrantExpiration(ctx sdk.Context, grantee sdk.AccAddress, msgTypeURL string) *time.Time {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(grantExpirationKey(grantee, msgTypeURL))
+	if bz == nil {
+		return nil
+	}
+
+	expiration, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		panic(err)
+	}
+
+	return &expiration
+}
+
+func (k Keeper) setGrantExpiration(ctx sdk.Context, grantee sdk.AccAddress, msgTypeURL string, expiration time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(grantExpirationKey(grantee, msgTypeURL), sdk.FormatTimeBytes(expiration))
+	store.Set(grantByExpirationKey(expiration, grantee, msgTypeURL), []byte{})
+}
+
+// PruneExpiredAuthorizations prunes the grants which have expired at the
+// current block time.
+func (k Keeper) PruneExpiredAuthorizations(ctx sdk.Context) {
+	type grant struct {
+		grantee    sdk.AccAddress
+		msgTypeURL string
+	}
+
+	var pruning []grant
+	k.iterateGrantsByExpiration(ctx, ctx.BlockTime(), func(grantee sdk.AccAddress, msgTypeURL string) (stop bool) {
+		pruning = append(pruning, grant{
+			grantee:    grantee,
+			msgTypeURL: msgTypeURL,
+		})
+		return false
+	})
+
+	for _, grant := range pruning {
+		k.deleteAuthorization(ctx, grant.grantee, grant.msgTypeURL)
+	}
+}
+
+// iterateGrantsByExpiration iterates over the grants whose expiration is not
+// after the provided time.
+func (k Keeper) iterateGrantsByExpiration(ctx sdk.Context, expiration time.Time, fn func(grantee sdk.AccAddress, msgTypeURL string) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(grantByExpirationKeyPrefix, sdk.PrefixEndBytes(append(grantByExpirationKeyPrefix, sdk.FormatTimeBytes(expiration)...)))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		_, grantee, msgTypeURL := splitGrantByExpirationKey(iter.Key())
+		if fn(grantee, msgTypeURL) {
+			break
+		}
+	}
 }
 
 func (k Keeper) Accept(ctx sdk.Context, grantee sdk.AccAddress, msg sdk.Msg) error {
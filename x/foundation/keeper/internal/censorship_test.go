@@ -1,6 +1,8 @@
 package internal_test
 
 import (
+	"time"
+
 	sdk "github.com/Finschia/finschia-sdk/types"
 	"github.com/Finschia/finschia-sdk/x/foundation"
 )
@@ -55,17 +57,32 @@ func (s *KeeperTestSuite) TestUpdateCensorship() {
 }
 
 func (s *KeeperTestSuite) TestGrant() {
+	expiration := s.ctx.BlockTime().Add(time.Hour)
+	expired := s.ctx.BlockTime()
+
 	testCases := map[string]struct {
-		malleate func(ctx sdk.Context)
-		grantee  sdk.AccAddress
-		auth     foundation.Authorization
-		valid    bool
+		malleate   func(ctx sdk.Context)
+		grantee    sdk.AccAddress
+		auth       foundation.Authorization
+		expiration *time.Time
+		valid      bool
 	}{
 		"valid authz": {
 			grantee: s.members[0],
 			auth:    &foundation.ReceiveFromTreasuryAuthorization{},
 			valid:   true,
 		},
+		"valid authz with expiration": {
+			grantee:    s.members[0],
+			auth:       &foundation.ReceiveFromTreasuryAuthorization{},
+			expiration: &expiration,
+			valid:      true,
+		},
+		"already expired": {
+			grantee:    s.members[0],
+			auth:       &foundation.ReceiveFromTreasuryAuthorization{},
+			expiration: &expired,
+		},
 		"not being censored": {
 			malleate: func(ctx sdk.Context) {
 				err := s.impl.UpdateCensorship(ctx, foundation.Censorship{
@@ -90,16 +107,44 @@ func (s *KeeperTestSuite) TestGrant() {
 				tc.malleate(ctx)
 			}
 
-			err := s.impl.Grant(ctx, tc.grantee, tc.auth)
-			if tc.valid {
-				s.Require().NoError(err)
-			} else {
+			err := s.impl.Grant(ctx, tc.grantee, tc.auth, tc.expiration)
+			if !tc.valid {
 				s.Require().Error(err)
+				return
 			}
+			s.Require().NoError(err)
+
+			s.Require().Equal(tc.expiration, s.impl.GetGrantExpiration(ctx, tc.grantee, tc.auth.MsgTypeURL()))
 		})
 	}
 }
 
+func (s *KeeperTestSuite) TestPruneExpiredAuthorizations() {
+	ctx, _ := s.ctx.CacheContext()
+
+	msgTypeURL := foundation.ReceiveFromTreasuryAuthorization{}.MsgTypeURL()
+	expiration := ctx.BlockTime().Add(time.Hour)
+	err := s.impl.Grant(ctx, s.members[0], &foundation.ReceiveFromTreasuryAuthorization{}, &expiration)
+	s.Require().NoError(err)
+
+	// not expired yet
+	ctx = ctx.WithBlockTime(expiration.Add(-time.Nanosecond))
+	s.impl.PruneExpiredAuthorizations(ctx)
+	_, err = s.impl.GetAuthorization(ctx, s.members[0], msgTypeURL)
+	s.Require().NoError(err)
+
+	// expired
+	ctx = ctx.WithBlockTime(expiration)
+	s.impl.PruneExpiredAuthorizations(ctx)
+	_, err = s.impl.GetAuthorization(ctx, s.members[0], msgTypeURL)
+	s.Require().Error(err)
+	s.Require().Nil(s.impl.GetGrantExpiration(ctx, s.members[0], msgTypeURL))
+
+	// the grant without expiration remains
+	_, err = s.impl.GetAuthorization(ctx, s.stranger, msgTypeURL)
+	s.Require().NoError(err)
+}
+
 func (s *KeeperTestSuite) TestRevoke() {
 	testCases := map[string]struct {
 		grantee sdk.AccAddress
@@ -163,6 +208,35 @@ func (s *KeeperTestSuite) TestAccept() {
 			},
 			valid: true,
 		},
+		"within the receive limit": {
+			malleate: func(ctx sdk.Context) {
+				err := s.impl.Grant(ctx, s.members[0], &foundation.ReceiveFromTreasuryAuthorization{
+					ReceiveLimit: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())),
+				}, nil)
+				s.Require().NoError(err)
+			},
+			grantee: s.members[0],
+			msg: &foundation.MsgWithdrawFromTreasury{
+				Authority: s.authority.String(),
+				To:        s.members[0].String(),
+				Amount:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())),
+			},
+			valid: true,
+		},
+		"exceeds the receive limit": {
+			malleate: func(ctx sdk.Context) {
+				err := s.impl.Grant(ctx, s.members[0], &foundation.ReceiveFromTreasuryAuthorization{
+					ReceiveLimit: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())),
+				}, nil)
+				s.Require().NoError(err)
+			},
+			grantee: s.members[0],
+			msg: &foundation.MsgWithdrawFromTreasury{
+				Authority: s.authority.String(),
+				To:        s.members[0].String(),
+				Amount:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(2))),
+			},
+		},
 		"no authorization": {
 			grantee: s.members[0],
 			msg: &foundation.MsgWithdrawFromTreasury{
@@ -0,0 +1,172 @@
+package internal
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/foundation"
+)
+
+// validateOpenProposals checks that none of the proposers exceeds the maximum
+// number of the open proposals.
+func (k Keeper) validateOpenProposals(ctx sdk.Context, proposers []string) error {
+	limit := k.GetParams(ctx).MaxOpenProposalsPerMember
+	if limit == 0 {
+		return nil
+	}
+
+	counts := map[string]uint64{}
+	k.iterateProposals(ctx, func(proposal foundation.Proposal) (stop bool) {
+		if proposal.Status != foundation.PROPOSAL_STATUS_SUBMITTED {
+			return false
+		}
+
+		for _, proposer := range proposal.Proposers {
+			counts[proposer]++
+		}
+
+		return false
+	})
+
+	for _, proposer := range proposers {
+		if counts[proposer] >= limit {
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s has reached the limit of open proposals: %d", proposer, limit)
+		}
+	}
+
+	return nil
+}
+
+// depositForProposal escrows the deposit of a proposal, which must not be less
+// than the minimum deposit.
+func (k Keeper) depositForProposal(ctx sdk.Context, proposalID uint64, depositor sdk.AccAddress, amount sdk.Coins) error {
+	if minDeposit := k.GetParams(ctx).MinDeposit; !amount.IsAllGTE(minDeposit) {
+		return sdkerrors.ErrInsufficientFunds.Wrapf("deposit %s is less than the min deposit %s", amount, minDeposit)
+	}
+
+	if amount.IsZero() {
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositor, foundation.DepositName, amount); err != nil {
+		return err
+	}
+
+	k.setDeposit(ctx, foundation.ProposalDeposit{
+		ProposalId: proposalID,
+		Depositor:  depositor.String(),
+		Amount:     amount,
+	})
+
+	return nil
+}
+
+// refundDeposit returns the deposit of a proposal to its depositor.
+func (k Keeper) refundDeposit(ctx sdk.Context, proposalID uint64) error {
+	deposit, err := k.GetDeposit(ctx, proposalID)
+	if err != nil {
+		// the proposal has been submitted without any deposit
+		return nil
+	}
+
+	depositor := sdk.MustAccAddressFromBech32(deposit.Depositor)
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, foundation.DepositName, depositor, deposit.Amount); err != nil {
+		return err
+	}
+	k.deleteDeposit(ctx, proposalID)
+
+	if err := ctx.EventManager().EmitTypedEvent(&foundation.EventRefundDeposit{
+		Deposit: *deposit,
+	}); err != nil {
+		panic(err)
+	}
+
+	return nil
+}
+
+// forfeitDeposit burns the deposit of a proposal, or sends it to the treasury,
+// according to the params.
+func (k Keeper) forfeitDeposit(ctx sdk.Context, proposalID uint64) error {
+	deposit, err := k.GetDeposit(ctx, proposalID)
+	if err != nil {
+		// the proposal has been submitted without any deposit
+		return nil
+	}
+
+	burn := k.GetParams(ctx).BurnForfeitedDeposits
+	if burn {
+		if err := k.bankKeeper.BurnCoins(ctx, foundation.DepositName, deposit.Amount); err != nil {
+			return err
+		}
+	} else {
+		pool := k.GetPool(ctx)
+		pool.Treasury = pool.Treasury.Add(sdk.NewDecCoinsFromCoins(deposit.Amount...)...)
+		k.SetPool(ctx, pool)
+
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, foundation.DepositName, foundation.TreasuryName, deposit.Amount); err != nil {
+			return err
+		}
+	}
+	k.deleteDeposit(ctx, proposalID)
+
+	if err := ctx.EventManager().EmitTypedEvent(&foundation.EventForfeitDeposit{
+		Deposit: *deposit,
+		Burned:  burn,
+	}); err != nil {
+		panic(err)
+	}
+
+	return nil
+}
+
+func (k Keeper) GetDeposit(ctx sdk.Context, proposalID uint64) (*foundation.ProposalDeposit, error) {
+	store := ctx.KVStore(k.storeKey)
+	key := depositKey(proposalID)
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return nil, sdkerrors.ErrNotFound.Wrapf("No deposit for proposal: %d", proposalID)
+	}
+
+	var deposit foundation.ProposalDeposit
+	k.cdc.MustUnmarshal(bz, &deposit)
+
+	return &deposit, nil
+}
+
+func (k Keeper) setDeposit(ctx sdk.Context, deposit foundation.ProposalDeposit) {
+	store := ctx.KVStore(k.storeKey)
+	key := depositKey(deposit.ProposalId)
+
+	bz := k.cdc.MustMarshal(&deposit)
+	store.Set(key, bz)
+}
+
+func (k Keeper) deleteDeposit(ctx sdk.Context, proposalID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	key := depositKey(proposalID)
+	store.Delete(key)
+}
+
+func (k Keeper) iterateDeposits(ctx sdk.Context, fn func(deposit foundation.ProposalDeposit) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := depositKeyPrefix
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var deposit foundation.ProposalDeposit
+		k.cdc.MustUnmarshal(iterator.Value(), &deposit)
+		if stop := fn(deposit); stop {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetDeposits(ctx sdk.Context) []foundation.ProposalDeposit {
+	var deposits []foundation.ProposalDeposit
+	k.iterateDeposits(ctx, func(deposit foundation.ProposalDeposit) (stop bool) {
+		deposits = append(deposits, deposit)
+		return false
+	})
+
+	return deposits
+}
@@ -43,7 +43,10 @@ func (k Keeper) Exec(ctx sdk.Context, proposalID uint64) error {
 
 	// Execute proposal payload.
 	var logs string
-	if proposal.Status == foundation.PROPOSAL_STATUS_ACCEPTED &&
+	if executeAt := proposal.ExecuteAt; executeAt != nil && ctx.BlockTime().Before(*executeAt) {
+		// The scheduled proposal would be executed in the EndBlocker.
+		logs = fmt.Sprintf("proposal %d is scheduled to be executed at %s", proposalID, executeAt)
+	} else if proposal.Status == foundation.PROPOSAL_STATUS_ACCEPTED &&
 		proposal.ExecutorResult != foundation.PROPOSAL_EXECUTOR_RESULT_SUCCESS {
 		logger := ctx.Logger().With("module", fmt.Sprintf("x/%s", foundation.ModuleName))
 		// Caching context so that we don't update the store in case of failure.
@@ -82,6 +85,39 @@ func (k Keeper) Exec(ctx sdk.Context, proposalID uint64) error {
 	return nil
 }
 
+// ExecScheduledProposals executes the scheduled proposals whose execute_at has
+// passed. The proposals not accepted yet, or failed on the execution, would be
+// tried again on the next block, until they are pruned.
+func (k Keeper) ExecScheduledProposals(ctx sdk.Context) {
+	var proposals []foundation.Proposal
+	k.iterateProposalsByExecuteAt(ctx, ctx.BlockTime(), func(proposal foundation.Proposal) (stop bool) {
+		proposals = append(proposals, proposal)
+		return false
+	})
+
+	for _, proposal := range proposals {
+		if proposal.Status != foundation.PROPOSAL_STATUS_SUBMITTED &&
+			proposal.Status != foundation.PROPOSAL_STATUS_ACCEPTED {
+			continue
+		}
+
+		cacheCtx, flush := ctx.CacheContext()
+		if err := k.Exec(cacheCtx, proposal.Id); err != nil {
+			k.Logger(ctx).Info("scheduled proposal execution failed", "cause", err, "proposalID", proposal.Id)
+			continue
+		}
+
+		// do not flush if the proposal is still in its voting period
+		if updated, err := k.GetProposal(cacheCtx, proposal.Id); err == nil &&
+			updated.Status == foundation.PROPOSAL_STATUS_SUBMITTED {
+			continue
+		}
+
+		flush()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+}
+
 // doExecuteMsgs routes the messages to the registered handlers.
 func (k Keeper) doExecuteMsgs(ctx sdk.Context, proposal foundation.Proposal) ([]sdk.Result, error) {
 	msgs := proposal.GetMsgs()
@@ -1,5 +1,13 @@
 package internal_test
 
+import (
+	"time"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/foundation"
+	"github.com/Finschia/finschia-sdk/x/foundation/keeper/internal"
+)
+
 func (s *KeeperTestSuite) TestExec() {
 	testCases := map[string]struct {
 		proposalID uint64
@@ -42,3 +50,60 @@ func (s *KeeperTestSuite) TestExec() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestExecScheduledProposals() {
+	testCases := map[string]struct {
+		proposalID uint64
+		success    bool
+	}{
+		"valid proposal": {
+			proposalID: s.activeProposal,
+			success:    true,
+		},
+		"invalid msg in proposal": {
+			proposalID: s.invalidProposal,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			executeAt := ctx.BlockTime().Add(time.Hour)
+			err := s.impl.ScheduleProposal(ctx, tc.proposalID, executeAt)
+			s.Require().NoError(err)
+
+			// not executed before the execute_at, even if requested
+			err = s.impl.Exec(ctx, tc.proposalID)
+			s.Require().NoError(err)
+			internal.EndBlocker(ctx, s.impl)
+
+			proposal, err := s.impl.GetProposal(ctx, tc.proposalID)
+			s.Require().NoError(err)
+			s.Require().Equal(foundation.PROPOSAL_EXECUTOR_RESULT_NOT_RUN, proposal.ExecutorResult)
+
+			// executed at the execute_at
+			ctx = ctx.WithBlockTime(executeAt)
+			internal.EndBlocker(ctx, s.impl)
+
+			proposal, err = s.impl.GetProposal(ctx, tc.proposalID)
+			if tc.success {
+				// pruned on success
+				s.Require().Error(err)
+
+				expected := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, s.balance.Add(s.balance)))
+				s.Require().Equal(expected, s.bankKeeper.GetAllBalances(ctx, s.stranger))
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(foundation.PROPOSAL_EXECUTOR_RESULT_FAILURE, proposal.ExecutorResult)
+
+			// retried until the proposal expires
+			ctx = ctx.WithBlockTime(proposal.VotingPeriodEnd.Add(foundation.DefaultConfig().MaxExecutionPeriod))
+			internal.EndBlocker(ctx, s.impl)
+
+			_, err = s.impl.GetProposal(ctx, tc.proposalID)
+			s.Require().Error(err)
+		})
+	}
+}
@@ -22,6 +22,10 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *foundation.GenesisState) erro
 		k.SetMember(ctx, member)
 	}
 
+	for _, activity := range data.MemberActivities {
+		k.setMemberActivity(ctx, activity)
+	}
+
 	for _, proposal := range data.Proposals {
 		if err := validateMetadata(proposal.Metadata, k.config); err != nil {
 			return err
@@ -29,6 +33,9 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *foundation.GenesisState) erro
 
 		k.setProposal(ctx, proposal)
 		k.addProposalToVPEndQueue(ctx, proposal)
+		if proposal.ExecuteAt != nil {
+			k.addProposalToExecuteAtQueue(ctx, proposal)
+		}
 	}
 
 	for _, vote := range data.Votes {
@@ -39,17 +46,30 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *foundation.GenesisState) erro
 		k.setVote(ctx, vote)
 	}
 
+	for _, deposit := range data.Deposits {
+		k.setDeposit(ctx, deposit)
+	}
+
 	for _, censorship := range data.Censorships {
 		k.SetCensorship(ctx, censorship)
 	}
 
 	for _, ga := range data.Authorizations {
 		grantee := sdk.MustAccAddressFromBech32(ga.Grantee)
-		k.setAuthorization(ctx, grantee, ga.GetAuthorization())
+		authorization := ga.GetAuthorization()
+		k.setAuthorization(ctx, grantee, authorization)
+		if ga.Expiration != nil {
+			k.setGrantExpiration(ctx, grantee, authorization.MsgTypeURL(), *ga.Expiration)
+		}
 	}
 
 	k.SetPool(ctx, data.Pool)
 
+	k.setPreviousTreasuryStreamID(ctx, data.PreviousTreasuryStreamId)
+	for _, stream := range data.TreasuryStreams {
+		k.setTreasuryStream(ctx, stream)
+	}
+
 	// init module accounts just in case
 	if acc := k.authKeeper.GetModuleAccount(ctx, foundation.ModuleName); acc == nil {
 		panic(fmt.Sprintf("failed to create module account=%s", foundation.ModuleName))
@@ -57,6 +77,9 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *foundation.GenesisState) erro
 	if acc := k.authKeeper.GetModuleAccount(ctx, foundation.TreasuryName); acc == nil {
 		panic(fmt.Sprintf("failed to create module account=%s", foundation.TreasuryName))
 	}
+	if acc := k.authKeeper.GetModuleAccount(ctx, foundation.DepositName); acc == nil {
+		panic(fmt.Sprintf("failed to create module account=%s", foundation.DepositName))
+	}
 	return nil
 }
 
@@ -70,15 +93,19 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *foundation.GenesisState {
 	}
 
 	return &foundation.GenesisState{
-		Params:             k.GetParams(ctx),
-		Foundation:         info,
-		Members:            k.GetMembers(ctx),
-		PreviousProposalId: k.getPreviousProposalID(ctx),
-		Proposals:          proposals,
-		Votes:              votes,
-		Censorships:        k.GetCensorships(ctx),
-		Authorizations:     k.GetGrants(ctx),
-		Pool:               k.GetPool(ctx),
+		Params:                   k.GetParams(ctx),
+		Foundation:               info,
+		Members:                  k.GetMembers(ctx),
+		PreviousProposalId:       k.getPreviousProposalID(ctx),
+		Proposals:                proposals,
+		Votes:                    votes,
+		Deposits:                 k.GetDeposits(ctx),
+		Censorships:              k.GetCensorships(ctx),
+		Authorizations:           k.GetGrants(ctx),
+		Pool:                     k.GetPool(ctx),
+		PreviousTreasuryStreamId: k.getPreviousTreasuryStreamID(ctx),
+		TreasuryStreams:          k.GetTreasuryStreams(ctx),
+		MemberActivities:         k.GetMemberActivities(ctx),
 	}
 }
 
@@ -96,7 +123,8 @@ func (k Keeper) GetGrants(ctx sdk.Context) []foundation.GrantAuthorization {
 	var grantAuthorizations []foundation.GrantAuthorization
 	k.iterateAuthorizations(ctx, func(grantee sdk.AccAddress, authorization foundation.Authorization) (stop bool) {
 		grantAuthorization := foundation.GrantAuthorization{
-			Grantee: grantee.String(),
+			Grantee:    grantee.String(),
+			Expiration: k.GetGrantExpiration(ctx, grantee, authorization.MsgTypeURL()),
 		}
 		if err := grantAuthorization.SetAuthorization(authorization); err != nil {
 			panic(err)
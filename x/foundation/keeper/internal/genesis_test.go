@@ -45,6 +45,8 @@ func TestImportExportGenesis(t *testing.T) {
 	member := createAddress()
 	stranger := createAddress()
 
+	expiration := ctx.BlockTime().Add(time.Hour).UTC()
+
 	testCases := map[string]struct {
 		init   *foundation.GenesisState
 		valid  bool
@@ -71,6 +73,7 @@ func TestImportExportGenesis(t *testing.T) {
 				Members: []foundation.Member{
 					{
 						Address: member.String(),
+						Weight:  sdk.OneDec(),
 					},
 				},
 			},
@@ -84,6 +87,7 @@ func TestImportExportGenesis(t *testing.T) {
 				Members: []foundation.Member{
 					{
 						Address: member.String(),
+						Weight:  sdk.OneDec(),
 					},
 				},
 			},
@@ -98,6 +102,7 @@ func TestImportExportGenesis(t *testing.T) {
 				Members: []foundation.Member{
 					{
 						Address: member.String(),
+						Weight:  sdk.OneDec(),
 					},
 				},
 				PreviousProposalId: 1,
@@ -126,6 +131,7 @@ func TestImportExportGenesis(t *testing.T) {
 				Members: []foundation.Member{
 					{
 						Address: member.String(),
+						Weight:  sdk.OneDec(),
 					},
 				},
 				PreviousProposalId: 1,
@@ -151,6 +157,53 @@ func TestImportExportGenesis(t *testing.T) {
 				},
 			},
 		},
+		"member activities": {
+			init: &foundation.GenesisState{
+				Params: foundation.DefaultParams(),
+				Foundation: *foundation.FoundationInfo{
+					Version:     1,
+					TotalWeight: sdk.OneDec(),
+				}.WithDecisionPolicy(workingPolicy()),
+				Members: []foundation.Member{
+					{
+						Address: member.String(),
+						Weight:  sdk.OneDec(),
+					},
+				},
+				PreviousProposalId: 3,
+				MemberActivities: []foundation.MemberActivity{
+					{
+						Address:                member.String(),
+						LastVotedProposalId:    1,
+						MissedVotes:            2,
+						ConsecutiveMissedVotes: 1,
+					},
+				},
+			},
+			valid: true,
+			export: &foundation.GenesisState{
+				Params: foundation.DefaultParams(),
+				Foundation: *foundation.FoundationInfo{
+					Version:     1,
+					TotalWeight: sdk.OneDec(),
+				}.WithDecisionPolicy(workingPolicy()),
+				Members: []foundation.Member{
+					{
+						Address: member.String(),
+						Weight:  sdk.OneDec(),
+					},
+				},
+				PreviousProposalId: 3,
+				MemberActivities: []foundation.MemberActivity{
+					{
+						Address:                member.String(),
+						LastVotedProposalId:    1,
+						MissedVotes:            2,
+						ConsecutiveMissedVotes: 1,
+					},
+				},
+			},
+		},
 		"authorizations": {
 			init: &foundation.GenesisState{
 				Params:     foundation.DefaultParams(),
@@ -184,6 +237,45 @@ func TestImportExportGenesis(t *testing.T) {
 				},
 			},
 		},
+		"authorizations with limits": {
+			init: &foundation.GenesisState{
+				Params:     foundation.DefaultParams(),
+				Foundation: foundation.DefaultFoundation(),
+				Censorships: []foundation.Censorship{
+					{
+						MsgTypeUrl: sdk.MsgTypeURL((*foundation.MsgWithdrawFromTreasury)(nil)),
+						Authority:  foundation.CensorshipAuthorityFoundation,
+					},
+				},
+				Authorizations: []foundation.GrantAuthorization{
+					*foundation.GrantAuthorization{
+						Grantee:    stranger.String(),
+						Expiration: &expiration,
+					}.WithAuthorization(&foundation.ReceiveFromTreasuryAuthorization{
+						ReceiveLimit: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())),
+					}),
+				},
+			},
+			valid: true,
+			export: &foundation.GenesisState{
+				Params:     foundation.DefaultParams(),
+				Foundation: foundation.DefaultFoundation(),
+				Censorships: []foundation.Censorship{
+					{
+						MsgTypeUrl: sdk.MsgTypeURL((*foundation.MsgWithdrawFromTreasury)(nil)),
+						Authority:  foundation.CensorshipAuthorityFoundation,
+					},
+				},
+				Authorizations: []foundation.GrantAuthorization{
+					*foundation.GrantAuthorization{
+						Grantee:    stranger.String(),
+						Expiration: &expiration,
+					}.WithAuthorization(&foundation.ReceiveFromTreasuryAuthorization{
+						ReceiveLimit: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())),
+					}),
+				},
+			},
+		},
 		"pool": {
 			init: &foundation.GenesisState{
 				Params:     foundation.DefaultParams(),
@@ -211,6 +303,7 @@ func TestImportExportGenesis(t *testing.T) {
 				Members: []foundation.Member{
 					{
 						Address:  member.String(),
+						Weight:   sdk.OneDec(),
 						Metadata: string(make([]rune, 256)),
 					},
 				},
@@ -226,6 +319,7 @@ func TestImportExportGenesis(t *testing.T) {
 				Members: []foundation.Member{
 					{
 						Address: member.String(),
+						Weight:  sdk.OneDec(),
 					},
 				},
 				PreviousProposalId: 1,
@@ -249,6 +343,7 @@ func TestImportExportGenesis(t *testing.T) {
 				Members: []foundation.Member{
 					{
 						Address: member.String(),
+						Weight:  sdk.OneDec(),
 					},
 				},
 				PreviousProposalId: 1,
@@ -320,6 +415,7 @@ func TestShouldPanicWhenFailToGenerateFoundationModuleAccountInInitGenesis(t *te
 					app.MsgServiceRouter(),
 					tc.mockAccKeeper,
 					app.BankKeeper,
+					app.DistrKeeper,
 					authtypes.FeeCollectorName,
 					foundation.DefaultConfig(),
 					foundation.DefaultAuthority().String(),
@@ -343,3 +439,5 @@ func (s *stubAccKeeper) GetModuleAccount(_ sdk.Context, name string) authtypes.M
 	}
 	return authtypes.NewEmptyModuleAccount("dontcare")
 }
+
+func (s *stubAccKeeper) SetModuleAccount(_ sdk.Context, _ authtypes.ModuleAccountI) {}
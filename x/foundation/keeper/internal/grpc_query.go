@@ -48,6 +48,64 @@ func (s queryServer) Treasury(c context.Context, req *foundation.QueryTreasuryRe
 	return &foundation.QueryTreasuryResponse{Amount: amount}, nil
 }
 
+func (s queryServer) TreasuryStream(c context.Context, req *foundation.QueryTreasuryStreamRequest) (*foundation.QueryTreasuryStreamResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	stream, err := s.keeper.GetTreasuryStream(ctx, req.StreamId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &foundation.QueryTreasuryStreamResponse{Stream: *stream}, nil
+}
+
+func (s queryServer) TreasuryStreams(c context.Context, req *foundation.QueryTreasuryStreamsRequest) (*foundation.QueryTreasuryStreamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var streams []foundation.TreasuryStream
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(s.keeper.storeKey)
+	streamStore := prefix.NewStore(store, treasuryStreamKeyPrefix)
+	pageRes, err := query.Paginate(streamStore, req.Pagination, func(key, value []byte) error {
+		var stream foundation.TreasuryStream
+		s.keeper.cdc.MustUnmarshal(value, &stream)
+		streams = append(streams, stream)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &foundation.QueryTreasuryStreamsResponse{Streams: streams, Pagination: pageRes}, nil
+}
+
+func (s queryServer) TaxHistory(c context.Context, req *foundation.QueryTaxHistoryRequest) (*foundation.QueryTaxHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var records []foundation.TaxRecord
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(s.keeper.storeKey)
+	recordStore := prefix.NewStore(store, taxRecordKeyPrefix)
+	pageRes, err := query.Paginate(recordStore, req.Pagination, func(key, value []byte) error {
+		var record foundation.TaxRecord
+		s.keeper.cdc.MustUnmarshal(value, &record)
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &foundation.QueryTaxHistoryResponse{Records: records, Pagination: pageRes}, nil
+}
+
 func (s queryServer) FoundationInfo(c context.Context, req *foundation.QueryFoundationInfoRequest) (*foundation.QueryFoundationInfoResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -77,6 +135,23 @@ func (s queryServer) Member(c context.Context, req *foundation.QueryMemberReques
 	return &foundation.QueryMemberResponse{Member: member}, nil
 }
 
+func (s queryServer) MemberActivity(c context.Context, req *foundation.QueryMemberActivityRequest) (*foundation.QueryMemberActivityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.keeper.GetMember(ctx, addr); err != nil {
+		return nil, err
+	}
+
+	return &foundation.QueryMemberActivityResponse{Activity: s.keeper.GetMemberActivity(ctx, addr)}, nil
+}
+
 func (s queryServer) Members(c context.Context, req *foundation.QueryMembersRequest) (*foundation.QueryMembersResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -216,6 +291,42 @@ func (s queryServer) Censorships(c context.Context, req *foundation.QueryCensors
 	return &foundation.QueryCensorshipsResponse{Censorships: censorships, Pagination: pageRes}, nil
 }
 
+func (s queryServer) Deposit(c context.Context, req *foundation.QueryDepositRequest) (*foundation.QueryDepositResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	deposit, err := s.keeper.GetDeposit(ctx, req.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &foundation.QueryDepositResponse{Deposit: *deposit}, nil
+}
+
+func (s queryServer) Deposits(c context.Context, req *foundation.QueryDepositsRequest) (*foundation.QueryDepositsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var deposits []foundation.ProposalDeposit
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(s.keeper.storeKey)
+	depositStore := prefix.NewStore(store, depositKeyPrefix)
+	pageRes, err := query.Paginate(depositStore, req.Pagination, func(key, value []byte) error {
+		var deposit foundation.ProposalDeposit
+		s.keeper.cdc.MustUnmarshal(value, &deposit)
+		deposits = append(deposits, deposit)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &foundation.QueryDepositsResponse{Deposits: deposits, Pagination: pageRes}, nil
+}
+
 func (s queryServer) Grants(c context.Context, req *foundation.QueryGrantsRequest) (*foundation.QueryGrantsResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
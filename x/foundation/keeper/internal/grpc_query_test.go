@@ -41,6 +41,7 @@ func (s *FoundationTestSuite) SetupTest() {
 		s.app.MsgServiceRouter(),
 		s.app.AccountKeeper,
 		s.app.BankKeeper,
+		s.app.DistrKeeper,
 		authtypes.FeeCollectorName,
 		foundation.DefaultConfig(),
 		foundation.DefaultAuthority().String(),
@@ -64,6 +65,7 @@ func (s *FoundationTestSuite) TestQueryParams() {
 			func() {
 				params := foundation.Params{
 					FoundationTax: sdk.OneDec(),
+					TaxSplit:      foundation.DefaultTaxSplit(),
 				}
 				s.impl.SetParams(s.ctx, params)
 
@@ -11,6 +11,7 @@ const (
 	moduleAccountInvariant = "module-accounts"
 	totalWeightInvariant   = "total-weight"
 	proposalInvariant      = "proposals"
+	depositInvariant       = "deposits"
 )
 
 func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
@@ -18,6 +19,7 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 		moduleAccountInvariant: ModuleAccountInvariant,
 		totalWeightInvariant:   TotalWeightInvariant,
 		proposalInvariant:      ProposalInvariant,
+		depositInvariant:       DepositInvariant,
 	} {
 		ir.RegisterRoute(foundation.ModuleName, name, invariant(k))
 	}
@@ -39,7 +41,14 @@ func ModuleAccountInvariant(k Keeper) sdk.Invariant {
 func TotalWeightInvariant(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
 		expected := k.GetFoundationInfo(ctx).TotalWeight
-		actual := sdk.NewDec(int64(len(k.GetMembers(ctx))))
+		actual := sdk.ZeroDec()
+		k.iterateMembers(ctx, func(member foundation.Member) (stop bool) {
+			// the weights of the suspended members do not count
+			if !k.isSuspended(ctx, sdk.MustAccAddressFromBech32(member.Address)) {
+				actual = actual.Add(member.Weight)
+			}
+			return false
+		})
 
 		msg := fmt.Sprintf("total weight of foundation; expected %s, got %s\n", expected, actual)
 		broken := !actual.Equal(expected)
@@ -70,3 +79,21 @@ func ProposalInvariant(k Keeper) sdk.Invariant {
 		return sdk.FormatInvariant(foundation.ModuleName, proposalInvariant, msg), broken
 	}
 }
+
+func DepositInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		depositAcc := k.authKeeper.GetModuleAccount(ctx, foundation.DepositName)
+		balance := k.bankKeeper.GetAllBalances(ctx, depositAcc.GetAddress())
+
+		deposits := sdk.NewCoins()
+		k.iterateDeposits(ctx, func(deposit foundation.ProposalDeposit) (stop bool) {
+			deposits = deposits.Add(deposit.Amount...)
+			return false
+		})
+
+		msg := fmt.Sprintf("coins in the deposits; expected %s, got %s\n", deposits, balance)
+		broken := !deposits.Equal(balance)
+
+		return sdk.FormatInvariant(foundation.ModuleName, depositInvariant, msg), broken
+	}
+}
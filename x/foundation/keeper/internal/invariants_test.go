@@ -100,3 +100,34 @@ func (s *KeeperTestSuite) TestProposalInvariant() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestDepositInvariant() {
+	testCases := map[string]struct {
+		malleate func(ctx sdk.Context)
+		valid    bool
+	}{
+		"invariant not broken": {
+			valid: true,
+		},
+		"deposits differ from the balance": {
+			malleate: func(ctx sdk.Context) {
+				amount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt()))
+				err := s.bankKeeper.SendCoinsFromAccountToModule(ctx, s.stranger, foundation.DepositName, amount)
+				s.Require().NoError(err)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			if tc.malleate != nil {
+				tc.malleate(ctx)
+			}
+
+			invariant := internal.DepositInvariant(s.impl)
+			_, broken := invariant(ctx)
+			s.Require().Equal(!tc.valid, broken)
+		})
+	}
+}
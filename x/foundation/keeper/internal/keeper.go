@@ -21,8 +21,9 @@ type Keeper struct {
 	router *baseapp.MsgServiceRouter
 
 	// keepers
-	authKeeper foundation.AuthKeeper
-	bankKeeper foundation.BankKeeper
+	authKeeper  foundation.AuthKeeper
+	bankKeeper  foundation.BankKeeper
+	distrKeeper foundation.DistributionKeeper
 
 	feeCollectorName string
 
@@ -33,6 +34,8 @@ type Keeper struct {
 	// - MsgUpdateDecisionPolicy
 	// - MsgUpdateMembers
 	// - MsgWithdrawFromTreasury
+	// - MsgCreateTreasuryStream
+	// - MsgCancelTreasuryStream
 	//
 	// Typically, this should be the x/foundation module account.
 	authority string
@@ -46,6 +49,7 @@ func NewKeeper(
 	router *baseapp.MsgServiceRouter,
 	authKeeper foundation.AuthKeeper,
 	bankKeeper foundation.BankKeeper,
+	distrKeeper foundation.DistributionKeeper,
 	feeCollectorName string,
 	config foundation.Config,
 	authority string,
@@ -71,6 +75,7 @@ func NewKeeper(
 		router:           router,
 		authKeeper:       authKeeper,
 		bankKeeper:       bankKeeper,
+		distrKeeper:      distrKeeper,
 		feeCollectorName: feeCollectorName,
 		config:           config,
 		authority:        authority,
@@ -27,6 +27,7 @@ type KeeperTestSuite struct {
 
 	ctx sdk.Context
 
+	authKeeper foundation.AuthKeeper
 	bankKeeper foundation.BankKeeper
 	keeper     keeper.Keeper
 	impl       internal.Keeper
@@ -90,6 +91,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	testdata.RegisterMsgServer(app.MsgServiceRouter(), testdata.MsgServerImpl{})
 
 	s.ctx = app.BaseApp.NewContext(checkTx, tmproto.Header{})
+	s.authKeeper = app.AccountKeeper
 	s.bankKeeper = app.BankKeeper
 	s.keeper = app.FoundationKeeper
 	s.impl = internal.NewKeeper(
@@ -98,6 +100,7 @@ func (s *KeeperTestSuite) SetupTest() {
 		app.MsgServiceRouter(),
 		app.AccountKeeper,
 		app.BankKeeper,
+		app.DistrKeeper,
 		authtypes.FeeCollectorName,
 		foundation.DefaultConfig(),
 		foundation.DefaultAuthority().String(),
@@ -111,6 +114,7 @@ func (s *KeeperTestSuite) SetupTest() {
 
 	s.impl.SetParams(s.ctx, foundation.Params{
 		FoundationTax: sdk.OneDec(),
+		TaxSplit:      foundation.DefaultTaxSplit(),
 	})
 
 	s.impl.SetCensorship(s.ctx, foundation.Censorship{
@@ -126,6 +130,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	for i := range s.members {
 		member := foundation.Member{
 			Address: s.members[i].String(),
+			Weight:  sdk.OneDec(),
 		}
 		s.impl.SetMember(s.ctx, member)
 	}
@@ -169,7 +174,7 @@ func (s *KeeperTestSuite) SetupTest() {
 			To:        s.stranger.String(),
 			Amount:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, s.balance)),
 		},
-	})
+	}, nil)
 	s.Require().NoError(err)
 	s.activeProposal = *activeProposal
 
@@ -183,7 +188,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	}
 
 	// create a proposal voted no by all members
-	votedProposal, err := s.impl.SubmitProposal(s.ctx, []string{s.members[0].String()}, "", []sdk.Msg{newMsgCreateDog("shiba1")})
+	votedProposal, err := s.impl.SubmitProposal(s.ctx, []string{s.members[0].String()}, "", []sdk.Msg{newMsgCreateDog("shiba1")}, nil)
 	s.Require().NoError(err)
 	s.votedProposal = *votedProposal
 
@@ -197,7 +202,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	}
 
 	// create an withdrawn proposal
-	withdrawnProposal, err := s.impl.SubmitProposal(s.ctx, []string{s.members[0].String()}, "", []sdk.Msg{newMsgCreateDog("shiba2")})
+	withdrawnProposal, err := s.impl.SubmitProposal(s.ctx, []string{s.members[0].String()}, "", []sdk.Msg{newMsgCreateDog("shiba2")}, nil)
 	s.Require().NoError(err)
 	s.withdrawnProposal = *withdrawnProposal
 
@@ -211,7 +216,7 @@ func (s *KeeperTestSuite) SetupTest() {
 			To:        s.stranger.String(),
 			Amount:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, s.balance.Add(sdk.OneInt()))),
 		},
-	})
+	}, nil)
 	s.Require().NoError(err)
 	s.invalidProposal = *invalidProposal
 
@@ -225,7 +230,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	}
 
 	// create an invalid proposal which contains invalid message
-	noHandlerProposal, err := s.impl.SubmitProposal(s.ctx, []string{s.members[0].String()}, "", []sdk.Msg{testdata.NewTestMsg(s.authority)})
+	noHandlerProposal, err := s.impl.SubmitProposal(s.ctx, []string{s.members[0].String()}, "", []sdk.Msg{testdata.NewTestMsg(s.authority)}, nil)
 	s.Require().NoError(err)
 	s.noHandlerProposal = *noHandlerProposal
 
@@ -242,7 +247,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	s.nextProposal = s.noHandlerProposal + 1
 
 	// grant stranger to receive foundation treasury
-	err = s.impl.Grant(s.ctx, s.stranger, &foundation.ReceiveFromTreasuryAuthorization{})
+	err = s.impl.Grant(s.ctx, s.stranger, &foundation.ReceiveFromTreasuryAuthorization{}, nil)
 	s.Require().NoError(err)
 }
 
@@ -282,7 +287,7 @@ func TestNewKeeper(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			newKeeper := func() keeper.Keeper {
 				app := simapp.Setup(false)
-				return keeper.NewKeeper(app.AppCodec(), sdk.NewKVStoreKey(foundation.StoreKey), app.MsgServiceRouter(), app.AccountKeeper, app.BankKeeper, authtypes.FeeCollectorName, foundation.DefaultConfig(), tc.authority.String(), app.GetSubspace(foundation.ModuleName))
+				return keeper.NewKeeper(app.AppCodec(), sdk.NewKVStoreKey(foundation.StoreKey), app.MsgServiceRouter(), app.AccountKeeper, app.BankKeeper, app.DistrKeeper, authtypes.FeeCollectorName, foundation.DefaultConfig(), tc.authority.String(), app.GetSubspace(foundation.ModuleName))
 			}
 
 			if tc.panics {
@@ -19,12 +19,23 @@ var (
 	proposalKeyPrefix        = []byte{0x12}
 	proposalByVPEndKeyPrefix = []byte{0x13}
 	voteKeyPrefix            = []byte{0x14}
+	depositKeyPrefix         = []byte{0x15}
+
+	proposalByExecuteAtKeyPrefix = []byte{0x16}
+	memberActivityKeyPrefix      = []byte{0x17}
 
 	censorshipKeyPrefix = []byte{0x20}
 	grantKeyPrefix      = []byte{0x21}
 
+	grantExpirationKeyPrefix   = []byte{0x22}
+	grantByExpirationKeyPrefix = []byte{0x23}
+
 	poolKey = []byte{0x30}
 
+	previousTreasuryStreamIDKey = []byte{0x31}
+	treasuryStreamKeyPrefix     = []byte{0x32}
+	taxRecordKeyPrefix          = []byte{0x33}
+
 	// deprecatedGovMintKey Deprecated. Don't use it again.
 	deprecatedGovMintKey = []byte{0x40}
 	_                    = deprecatedGovMintKey
@@ -56,6 +67,17 @@ func memberKey(address sdk.AccAddress) []byte {
 	return key
 }
 
+// memberActivityKey key for the activity of a specific member from the store
+func memberActivityKey(address sdk.AccAddress) []byte {
+	prefix := memberActivityKeyPrefix
+	key := make([]byte, len(prefix)+len(address))
+
+	copy(key, prefix)
+	copy(key[len(prefix):], address)
+
+	return key
+}
+
 // proposalKey key for a specific proposal from the store
 func proposalKey(id uint64) []byte {
 	prefix := proposalKeyPrefix
@@ -68,6 +90,42 @@ func proposalKey(id uint64) []byte {
 	return key
 }
 
+// treasuryStreamKey key for a specific treasury stream from the store
+func treasuryStreamKey(id uint64) []byte {
+	prefix := treasuryStreamKeyPrefix
+	idBz := Uint64ToBytes(id)
+	key := make([]byte, len(prefix)+len(idBz))
+
+	copy(key, prefix)
+	copy(key[len(prefix):], idBz)
+
+	return key
+}
+
+// taxRecordKey key for the tax record of a specific block from the store
+func taxRecordKey(height int64) []byte {
+	prefix := taxRecordKeyPrefix
+	heightBz := Uint64ToBytes(uint64(height))
+	key := make([]byte, len(prefix)+len(heightBz))
+
+	copy(key, prefix)
+	copy(key[len(prefix):], heightBz)
+
+	return key
+}
+
+// depositKey key for the deposit of a specific proposal from the store
+func depositKey(proposalID uint64) []byte {
+	prefix := depositKeyPrefix
+	idBz := Uint64ToBytes(proposalID)
+	key := make([]byte, len(prefix)+len(idBz))
+
+	copy(key, prefix)
+	copy(key[len(prefix):], idBz)
+
+	return key
+}
+
 func voteKey(proposalID uint64, voter sdk.AccAddress) []byte {
 	prefix := voteKeyPrefix
 	idBz := Uint64ToBytes(proposalID)
@@ -118,6 +176,39 @@ func splitProposalByVPEndKey(key []byte) (vpEnd time.Time, id uint64) {
 	return
 }
 
+func proposalByExecuteAtKey(executeAt time.Time, id uint64) []byte {
+	prefix := proposalByExecuteAtKeyPrefix
+	executeAtBz := sdk.FormatTimeBytes(executeAt)
+	idBz := Uint64ToBytes(id)
+	key := make([]byte, len(prefix)+lenTime+len(idBz))
+
+	begin := 0
+	copy(key[begin:], prefix)
+
+	begin += len(prefix)
+	copy(key[begin:], executeAtBz)
+
+	begin += len(executeAtBz)
+	copy(key[begin:], idBz)
+
+	return key
+}
+
+func splitProposalByExecuteAtKey(key []byte) (executeAt time.Time, id uint64) {
+	prefix := proposalByExecuteAtKeyPrefix
+	begin := len(prefix)
+	end := begin + lenTime
+	executeAt, err := sdk.ParseTimeBytes(key[begin:end])
+	if err != nil {
+		panic(err)
+	}
+
+	begin = end
+	id = Uint64FromBytes(key[begin:])
+
+	return
+}
+
 // memberKey key for a specific member from the store
 func censorshipKey(url string) []byte {
 	prefix := censorshipKeyPrefix
@@ -167,3 +258,64 @@ func splitGrantKey(key []byte) (grantee sdk.AccAddress, url string) {
 
 	return
 }
+
+func grantExpirationKey(grantee sdk.AccAddress, url string) []byte {
+	prefix := grantExpirationKeyPrefix
+	key := make([]byte, len(prefix)+1+len(grantee)+len(url))
+
+	begin := 0
+	copy(key[begin:], prefix)
+
+	begin += len(prefix)
+	key[begin] = byte(len(grantee))
+
+	begin++
+	copy(key[begin:], grantee)
+
+	begin += len(grantee)
+	copy(key[begin:], url)
+
+	return key
+}
+
+func grantByExpirationKey(expiration time.Time, grantee sdk.AccAddress, url string) []byte {
+	prefix := grantByExpirationKeyPrefix
+	expirationBz := sdk.FormatTimeBytes(expiration)
+	key := make([]byte, len(prefix)+lenTime+1+len(grantee)+len(url))
+
+	begin := 0
+	copy(key[begin:], prefix)
+
+	begin += len(prefix)
+	copy(key[begin:], expirationBz)
+
+	begin += len(expirationBz)
+	key[begin] = byte(len(grantee))
+
+	begin++
+	copy(key[begin:], grantee)
+
+	begin += len(grantee)
+	copy(key[begin:], url)
+
+	return key
+}
+
+func splitGrantByExpirationKey(key []byte) (expiration time.Time, grantee sdk.AccAddress, url string) {
+	prefix := grantByExpirationKeyPrefix
+	begin := len(prefix)
+	end := begin + lenTime
+	expiration, err := sdk.ParseTimeBytes(key[begin:end])
+	if err != nil {
+		panic(err)
+	}
+
+	begin = end + 1
+	end = begin + int(key[begin-1])
+	grantee = key[begin:end]
+
+	begin = end
+	url = string(key[begin:])
+
+	return
+}
@@ -29,9 +29,7 @@ func (k Keeper) UpdateDecisionPolicy(ctx sdk.Context, policy foundation.Decision
 	}
 
 	// invalidate active proposals
-	k.abortOldProposals(ctx)
-
-	return nil
+	return k.abortOldProposals(ctx)
 }
 
 func (k Keeper) GetFoundationInfo(ctx sdk.Context) foundation.FoundationInfo {
@@ -59,8 +57,9 @@ func (k Keeper) UpdateMembers(ctx sdk.Context, members []foundation.MemberReques
 			Address:  request.Address,
 			Metadata: request.Metadata,
 			AddedAt:  ctx.BlockTime(),
+			Weight:   request.Weight,
 		}
-		if err := new.ValidateBasic(); err != nil {
+		if err := request.ValidateBasic(); err != nil {
 			panic(err)
 		}
 		if err := validateMetadata(new.Metadata, k.config); err != nil {
@@ -73,14 +72,21 @@ func (k Keeper) UpdateMembers(ctx sdk.Context, members []foundation.MemberReques
 			return err
 		}
 		if err == nil { // overwrite
-			weightUpdate = weightUpdate.Sub(sdk.OneDec())
+			// the weight of a suspended member has been excluded already
+			if activity := k.GetMemberActivity(ctx, addr); activity.Suspended {
+				activity.Suspended = false
+				activity.ConsecutiveMissedVotes = 0
+				k.setMemberActivity(ctx, activity)
+			} else {
+				weightUpdate = weightUpdate.Sub(old.Weight)
+			}
 			new.AddedAt = old.AddedAt
 		}
 
 		if request.Remove {
 			k.deleteMember(ctx, addr)
 		} else {
-			weightUpdate = weightUpdate.Add(sdk.OneDec())
+			weightUpdate = weightUpdate.Add(new.Weight)
 			k.SetMember(ctx, new)
 		}
 	}
@@ -95,9 +101,7 @@ func (k Keeper) UpdateMembers(ctx sdk.Context, members []foundation.MemberReques
 	}
 
 	// invalidate active proposals
-	k.abortOldProposals(ctx)
-
-	return nil
+	return k.abortOldProposals(ctx)
 }
 
 func (k Keeper) GetMember(ctx sdk.Context, address sdk.AccAddress) (*foundation.Member, error) {
@@ -127,6 +131,8 @@ func (k Keeper) deleteMember(ctx sdk.Context, address sdk.AccAddress) {
 	store := ctx.KVStore(k.storeKey)
 	key := memberKey(address)
 	store.Delete(key)
+
+	k.deleteMemberActivity(ctx, address)
 }
 
 func (k Keeper) iterateMembers(ctx sdk.Context, fn func(member foundation.Member) (stop bool)) {
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/Finschia/finschia-sdk/types"
 	"github.com/Finschia/finschia-sdk/x/foundation"
+	"github.com/Finschia/finschia-sdk/x/foundation/keeper/internal"
 )
 
 func (s *KeeperTestSuite) TestUpdateDecisionPolicy() {
@@ -49,16 +50,29 @@ func (s *KeeperTestSuite) TestUpdateDecisionPolicy() {
 
 func (s *KeeperTestSuite) TestUpdateMembers() {
 	testCases := map[string]struct {
-		updates []foundation.MemberRequest
-		valid   bool
+		updates     []foundation.MemberRequest
+		valid       bool
+		totalWeight sdk.Dec
 	}{
 		"add a new member": {
 			updates: []foundation.MemberRequest{
 				{
 					Address: s.stranger.String(),
+					Weight:  sdk.NewDec(2),
 				},
 			},
-			valid: true,
+			valid:       true,
+			totalWeight: sdk.NewDec(int64(len(s.members) + 2)),
+		},
+		"update the weight of a member": {
+			updates: []foundation.MemberRequest{
+				{
+					Address: s.members[0].String(),
+					Weight:  sdk.NewDec(3),
+				},
+			},
+			valid:       true,
+			totalWeight: sdk.NewDec(int64(len(s.members) + 2)),
 		},
 		"remove a member": {
 			updates: []foundation.MemberRequest{
@@ -67,7 +81,8 @@ func (s *KeeperTestSuite) TestUpdateMembers() {
 					Remove:  true,
 				},
 			},
-			valid: true,
+			valid:       true,
+			totalWeight: sdk.NewDec(int64(len(s.members) - 1)),
 		},
 		"remove a non-member": {
 			updates: []foundation.MemberRequest{
@@ -81,6 +96,7 @@ func (s *KeeperTestSuite) TestUpdateMembers() {
 			updates: []foundation.MemberRequest{
 				{
 					Address:  s.stranger.String(),
+					Weight:   sdk.OneDec(),
 					Metadata: string(make([]rune, 256)),
 				},
 			},
@@ -92,11 +108,15 @@ func (s *KeeperTestSuite) TestUpdateMembers() {
 			ctx, _ := s.ctx.CacheContext()
 
 			err := s.impl.UpdateMembers(ctx, tc.updates)
-			if tc.valid {
-				s.Require().NoError(err)
-			} else {
+			if !tc.valid {
 				s.Require().Error(err)
+				return
 			}
+			s.Require().NoError(err)
+
+			s.Require().Equal(tc.totalWeight, s.impl.GetFoundationInfo(ctx).TotalWeight)
+			_, broken := internal.TotalWeightInvariant(s.impl)(ctx)
+			s.Require().False(broken)
 		})
 	}
 }
@@ -5,6 +5,10 @@ import (
 	"github.com/Finschia/finschia-sdk/types/module"
 	"github.com/Finschia/finschia-sdk/x/foundation"
 	v2 "github.com/Finschia/finschia-sdk/x/foundation/keeper/internal/migrations/v2"
+	v3 "github.com/Finschia/finschia-sdk/x/foundation/keeper/internal/migrations/v3"
+	v4 "github.com/Finschia/finschia-sdk/x/foundation/keeper/internal/migrations/v4"
+	v5 "github.com/Finschia/finschia-sdk/x/foundation/keeper/internal/migrations/v5"
+	v6 "github.com/Finschia/finschia-sdk/x/foundation/keeper/internal/migrations/v6"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -22,6 +26,18 @@ func (m Migrator) Register(register func(moduleName string, fromVersion uint64,
 		1: func(ctx sdk.Context) error {
 			return v2.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc, m.keeper.paramSpace)
 		},
+		2: func(ctx sdk.Context) error {
+			return v3.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+		},
+		3: func(ctx sdk.Context) error {
+			return v4.MigrateStore(ctx, m.keeper.paramSpace)
+		},
+		4: func(ctx sdk.Context) error {
+			return v5.MigrateStore(ctx, m.keeper.paramSpace, m.keeper.authKeeper)
+		},
+		5: func(ctx sdk.Context) error {
+			return v6.MigrateStore(ctx, m.keeper.paramSpace)
+		},
 	} {
 		if err := register(foundation.ModuleName, fromVersion, handler); err != nil {
 			return err
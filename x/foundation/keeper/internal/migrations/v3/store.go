@@ -0,0 +1,53 @@
+package v3
+
+import (
+	"github.com/Finschia/finschia-sdk/codec"
+	"github.com/Finschia/finschia-sdk/store/prefix"
+	storetypes "github.com/Finschia/finschia-sdk/store/types"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/foundation"
+)
+
+// MigrateStore performs in-place store migrations from v2 to v3.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	// migrate members
+	if err := migrateMembers(store, cdc); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// migrateMembers assigns the weight of 1 to the existing members, which keeps
+// the total weight of the foundation intact.
+func migrateMembers(store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	memberStore := prefix.NewStore(store, MemberKeyPrefix)
+
+	var keys [][]byte
+	var members []foundation.Member
+	iterator := memberStore.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var member foundation.Member
+		if err := cdc.Unmarshal(iterator.Value(), &member); err != nil {
+			return err
+		}
+
+		keys = append(keys, iterator.Key())
+		members = append(members, member)
+	}
+
+	for i := range members {
+		members[i].Weight = sdk.OneDec()
+
+		bz, err := cdc.Marshal(&members[i])
+		if err != nil {
+			return err
+		}
+		memberStore.Set(keys[i], bz)
+	}
+
+	return nil
+}
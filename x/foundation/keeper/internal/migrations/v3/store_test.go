@@ -0,0 +1,84 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	simappparams "github.com/Finschia/finschia-sdk/simapp/params"
+	"github.com/Finschia/finschia-sdk/testutil"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/foundation"
+	"github.com/Finschia/finschia-sdk/x/foundation/keeper/internal/migrations/v3"
+)
+
+func TestMigrateStore(t *testing.T) {
+	foundationKey := sdk.NewKVStoreKey(foundation.StoreKey)
+	newKey := sdk.NewTransientStoreKey("transient_test")
+	encCfg := simappparams.MakeTestEncodingConfig()
+	ctx := testutil.DefaultContext(foundationKey, newKey)
+
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress("member1"),
+		sdk.AccAddress("member2"),
+	}
+	memberKey := func(addr sdk.AccAddress) []byte {
+		return append(append([]byte{}, v3.MemberKeyPrefix...), addr...)
+	}
+
+	for name, tc := range map[string]struct {
+		malleate func(ctx sdk.Context)
+		valid    bool
+	}{
+		"valid": {
+			malleate: func(ctx sdk.Context) {
+				// set old members
+				store := ctx.KVStore(foundationKey)
+				for _, addr := range addrs {
+					bz := encCfg.Marshaler.MustMarshal(&foundation.Member{
+						Address:  addr.String(),
+						Metadata: "old member",
+					})
+					store.Set(memberKey(addr), bz)
+				}
+			},
+			valid: true,
+		},
+		"no members": {
+			valid: true,
+		},
+		"unmarshal fails": {
+			malleate: func(ctx sdk.Context) {
+				// invalid contents
+				store := ctx.KVStore(foundationKey)
+				store.Set(memberKey(addrs[0]), []byte("invalid"))
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			if tc.malleate != nil {
+				tc.malleate(ctx)
+			}
+
+			// migrate
+			err := v3.MigrateStore(ctx, foundationKey, encCfg.Marshaler)
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			store := ctx.KVStore(foundationKey)
+			iterator := sdk.KVStorePrefixIterator(store, v3.MemberKeyPrefix)
+			defer iterator.Close()
+			for ; iterator.Valid(); iterator.Next() {
+				var member foundation.Member
+				encCfg.Marshaler.MustUnmarshal(iterator.Value(), &member)
+				require.NoError(t, member.ValidateBasic())
+				require.Equal(t, sdk.OneDec(), member.Weight)
+				require.Equal(t, "old member", member.Metadata)
+			}
+		})
+	}
+}
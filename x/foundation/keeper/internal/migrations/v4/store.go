@@ -0,0 +1,24 @@
+package v4
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/foundation"
+)
+
+// MigrateStore performs in-place store migrations from v3 to v4.
+func MigrateStore(ctx sdk.Context, subspace Subspace) error {
+	// migrate params
+	migrateParams(ctx, subspace)
+
+	return nil
+}
+
+// migrateParams sets the params introduced by the proposal deposits to their
+// defaults, which keeps the behavior of the existing chains intact.
+func migrateParams(ctx sdk.Context, subspace Subspace) {
+	defaults := foundation.DefaultParams()
+
+	subspace.Set(ctx, []byte(foundation.ParamKeyMinDeposit), defaults.MinDeposit)
+	subspace.Set(ctx, []byte(foundation.ParamKeyBurnForfeitedDeposits), defaults.BurnForfeitedDeposits)
+	subspace.Set(ctx, []byte(foundation.ParamKeyMaxOpenProposalsPerMember), defaults.MaxOpenProposalsPerMember)
+}
@@ -0,0 +1,35 @@
+package v4_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/finschia-sdk/testutil"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/foundation"
+	"github.com/Finschia/finschia-sdk/x/foundation/keeper/internal/migrations/v4"
+)
+
+type mockSubspace struct {
+	params map[string]interface{}
+}
+
+func (ms *mockSubspace) Set(ctx sdk.Context, key []byte, value interface{}) {
+	ms.params[string(key)] = value
+}
+
+func TestMigrateStore(t *testing.T) {
+	foundationKey := sdk.NewKVStoreKey(foundation.StoreKey)
+	newKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(foundationKey, newKey)
+
+	subspace := &mockSubspace{params: map[string]interface{}{}}
+	err := v4.MigrateStore(ctx, subspace)
+	require.NoError(t, err)
+
+	defaults := foundation.DefaultParams()
+	require.Equal(t, defaults.MinDeposit, subspace.params[foundation.ParamKeyMinDeposit])
+	require.Equal(t, defaults.BurnForfeitedDeposits, subspace.params[foundation.ParamKeyBurnForfeitedDeposits])
+	require.Equal(t, defaults.MaxOpenProposalsPerMember, subspace.params[foundation.ParamKeyMaxOpenProposalsPerMember])
+}
@@ -0,0 +1,17 @@
+package v5
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	authtypes "github.com/Finschia/finschia-sdk/x/auth/types"
+)
+
+type (
+	Subspace interface {
+		Set(ctx sdk.Context, key []byte, value interface{})
+	}
+
+	AuthKeeper interface {
+		GetModuleAccount(ctx sdk.Context, name string) authtypes.ModuleAccountI
+		SetModuleAccount(ctx sdk.Context, macc authtypes.ModuleAccountI)
+	}
+)
@@ -0,0 +1,46 @@
+package v5
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	authtypes "github.com/Finschia/finschia-sdk/x/auth/types"
+	"github.com/Finschia/finschia-sdk/x/foundation"
+)
+
+// MigrateStore performs in-place store migrations from v4 to v5.
+func MigrateStore(ctx sdk.Context, subspace Subspace, authKeeper AuthKeeper) error {
+	// migrate params
+	migrateParams(ctx, subspace)
+
+	// migrate the treasury module account
+	if err := migrateTreasury(ctx, authKeeper); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// migrateParams sets the tax split to its default, which sends the whole tax
+// to the treasury as before.
+func migrateParams(ctx sdk.Context, subspace Subspace) {
+	subspace.Set(ctx, []byte(foundation.ParamKeyTaxSplit), foundation.DefaultTaxSplit())
+}
+
+// migrateTreasury grants the burner permission to the treasury module account,
+// which burns the share of the tax to burn. The permissions of a module account
+// are stored with it, so the existing account must be rewritten.
+func migrateTreasury(ctx sdk.Context, authKeeper AuthKeeper) error {
+	acc := authKeeper.GetModuleAccount(ctx, foundation.TreasuryName)
+	if acc.HasPermission(authtypes.Burner) {
+		return nil
+	}
+
+	macc, ok := acc.(*authtypes.ModuleAccount)
+	if !ok {
+		return sdkerrors.ErrInvalidType.Wrapf("unexpected module account type %T", acc)
+	}
+	macc.Permissions = append(macc.Permissions, authtypes.Burner)
+	authKeeper.SetModuleAccount(ctx, macc)
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package v5_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/finschia-sdk/testutil"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	authtypes "github.com/Finschia/finschia-sdk/x/auth/types"
+	"github.com/Finschia/finschia-sdk/x/foundation"
+	"github.com/Finschia/finschia-sdk/x/foundation/keeper/internal/migrations/v5"
+)
+
+type mockSubspace struct {
+	params map[string]interface{}
+}
+
+func (ms *mockSubspace) Set(ctx sdk.Context, key []byte, value interface{}) {
+	ms.params[string(key)] = value
+}
+
+type mockAuthKeeper struct {
+	accounts map[string]authtypes.ModuleAccountI
+}
+
+func (mk *mockAuthKeeper) GetModuleAccount(ctx sdk.Context, name string) authtypes.ModuleAccountI {
+	return mk.accounts[name]
+}
+
+func (mk *mockAuthKeeper) SetModuleAccount(ctx sdk.Context, macc authtypes.ModuleAccountI) {
+	mk.accounts[macc.GetName()] = macc
+}
+
+func TestMigrateStore(t *testing.T) {
+	foundationKey := sdk.NewKVStoreKey(foundation.StoreKey)
+	newKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(foundationKey, newKey)
+
+	subspace := &mockSubspace{params: map[string]interface{}{}}
+	authKeeper := &mockAuthKeeper{accounts: map[string]authtypes.ModuleAccountI{
+		foundation.TreasuryName: authtypes.NewEmptyModuleAccount(foundation.TreasuryName),
+	}}
+	err := v5.MigrateStore(ctx, subspace, authKeeper)
+	require.NoError(t, err)
+
+	require.Equal(t, foundation.DefaultTaxSplit(), subspace.params[foundation.ParamKeyTaxSplit])
+	treasury := authKeeper.GetModuleAccount(ctx, foundation.TreasuryName)
+	require.True(t, treasury.HasPermission(authtypes.Burner))
+	require.Equal(t, []string{authtypes.Burner}, treasury.GetPermissions())
+
+	// the migration is idempotent
+	err = v5.MigrateStore(ctx, subspace, authKeeper)
+	require.NoError(t, err)
+	require.Equal(t, []string{authtypes.Burner}, authKeeper.GetModuleAccount(ctx, foundation.TreasuryName).GetPermissions())
+}
@@ -0,0 +1,11 @@
+package v6
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+)
+
+type (
+	Subspace interface {
+		Set(ctx sdk.Context, key []byte, value interface{})
+	}
+)
@@ -0,0 +1,21 @@
+package v6
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/foundation"
+)
+
+// MigrateStore performs in-place store migrations from v5 to v6.
+func MigrateStore(ctx sdk.Context, subspace Subspace) error {
+	// migrate params
+	migrateParams(ctx, subspace)
+
+	return nil
+}
+
+// migrateParams disables the removal of the inactive members by default.
+func migrateParams(ctx sdk.Context, subspace Subspace) {
+	defaults := foundation.DefaultParams()
+	subspace.Set(ctx, []byte(foundation.ParamKeyMaxConsecutiveMissedVotes), defaults.MaxConsecutiveMissedVotes)
+	subspace.Set(ctx, []byte(foundation.ParamKeySuspendInactiveMembers), defaults.SuspendInactiveMembers)
+}
@@ -69,6 +69,47 @@ func (s msgServer) WithdrawFromTreasury(c context.Context, req *foundation.MsgWi
 	return &foundation.MsgWithdrawFromTreasuryResponse{}, nil
 }
 
+// CreateTreasuryStream defines a method to create a stream paying from the treasury.
+func (s msgServer) CreateTreasuryStream(c context.Context, req *foundation.MsgCreateTreasuryStream) (*foundation.MsgCreateTreasuryStreamResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := s.keeper.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	// the stream is subject to the same censorship as the withdrawal
+	recipient := sdk.MustAccAddressFromBech32(req.Recipient)
+	if err := s.keeper.Accept(ctx, recipient, &foundation.MsgWithdrawFromTreasury{
+		Authority: req.Authority,
+		To:        req.Recipient,
+		Amount:    req.Total,
+	}); err != nil {
+		return nil, err
+	}
+
+	id, err := s.keeper.CreateTreasuryStream(ctx, recipient, req.Amount, req.Period, req.Total)
+	if err != nil {
+		return nil, err
+	}
+
+	return &foundation.MsgCreateTreasuryStreamResponse{StreamId: *id}, nil
+}
+
+// CancelTreasuryStream defines a method to cancel a treasury stream.
+func (s msgServer) CancelTreasuryStream(c context.Context, req *foundation.MsgCancelTreasuryStream) (*foundation.MsgCancelTreasuryStreamResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := s.keeper.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := s.keeper.CancelTreasuryStream(ctx, req.StreamId); err != nil {
+		return nil, err
+	}
+
+	return &foundation.MsgCancelTreasuryStreamResponse{}, nil
+}
+
 func (s msgServer) UpdateMembers(c context.Context, req *foundation.MsgUpdateMembers) (*foundation.MsgUpdateMembersResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
@@ -119,11 +160,17 @@ func (s msgServer) SubmitProposal(c context.Context, req *foundation.MsgSubmitPr
 		return nil, err
 	}
 
-	id, err := s.keeper.SubmitProposal(ctx, req.Proposers, req.Metadata, req.GetMsgs())
+	id, err := s.keeper.SubmitProposal(ctx, req.Proposers, req.Metadata, req.GetMsgs(), req.Deposit)
 	if err != nil {
 		return nil, err
 	}
 
+	if req.ExecuteAt != nil {
+		if err := s.keeper.ScheduleProposal(ctx, *id, *req.ExecuteAt); err != nil {
+			return nil, err
+		}
+	}
+
 	proposal, err := s.keeper.GetProposal(ctx, *id)
 	if err != nil {
 		panic(err)
@@ -287,7 +334,7 @@ func (s msgServer) Grant(c context.Context, req *foundation.MsgGrant) (*foundati
 	}
 
 	grantee := sdk.MustAccAddressFromBech32(req.Grantee)
-	if err := s.keeper.Grant(ctx, grantee, authorization); err != nil {
+	if err := s.keeper.Grant(ctx, grantee, authorization, req.Expiration); err != nil {
 		return nil, err
 	}
 
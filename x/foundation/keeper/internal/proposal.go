@@ -29,11 +29,16 @@ func (k Keeper) setPreviousProposalID(ctx sdk.Context, id uint64) {
 	store.Set(previousProposalIDKey, Uint64ToBytes(id))
 }
 
-func (k Keeper) SubmitProposal(ctx sdk.Context, proposers []string, metadata string, msgs []sdk.Msg) (*uint64, error) {
+func (k Keeper) SubmitProposal(ctx sdk.Context, proposers []string, metadata string, msgs []sdk.Msg, deposit sdk.Coins) (*uint64, error) {
 	if err := validateMetadata(metadata, k.config); err != nil {
 		return nil, err
 	}
 
+	// Prevent a member from flooding proposals.
+	if err := k.validateOpenProposals(ctx, proposers); err != nil {
+		return nil, err
+	}
+
 	foundationInfo := k.GetFoundationInfo(ctx)
 	authority := sdk.MustAccAddressFromBech32(k.GetAuthority())
 	if err := ensureMsgAuthz(msgs, authority); err != nil {
@@ -65,6 +70,12 @@ func (k Keeper) SubmitProposal(ctx sdk.Context, proposers []string, metadata str
 	k.setProposal(ctx, proposal)
 	k.addProposalToVPEndQueue(ctx, proposal)
 
+	// The first proposer pays the deposit.
+	depositor := sdk.MustAccAddressFromBech32(proposers[0])
+	if err := k.depositForProposal(ctx, id, depositor, deposit); err != nil {
+		return nil, err
+	}
+
 	return &id, nil
 }
 
@@ -82,6 +93,36 @@ func (k Keeper) WithdrawProposal(ctx sdk.Context, proposalID uint64) error {
 	proposal.Status = foundation.PROPOSAL_STATUS_WITHDRAWN
 	k.setProposal(ctx, *proposal)
 
+	return k.forfeitDeposit(ctx, proposalID)
+}
+
+// ScheduleProposal schedules the execution of a proposal at the given time.
+// The proposal would be executed in the EndBlocker once it has been accepted.
+func (k Keeper) ScheduleProposal(ctx sdk.Context, proposalID uint64, executeAt time.Time) error {
+	proposal, err := k.GetProposal(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+
+	if proposal.Status != foundation.PROPOSAL_STATUS_SUBMITTED {
+		return sdkerrors.ErrInvalidRequest.Wrapf("cannot schedule a proposal with the status of %s", proposal.Status)
+	}
+	if proposal.ExecuteAt != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("proposal %d has been already scheduled", proposalID)
+	}
+
+	if executeAt.Before(ctx.BlockTime()) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("execute_at %s is in the past", executeAt)
+	}
+	// the proposal would be pruned before the execution.
+	if expiry := proposal.VotingPeriodEnd.Add(k.config.MaxExecutionPeriod); !executeAt.Before(expiry) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("execute_at %s must be before the expiry of the proposal %s", executeAt, expiry)
+	}
+
+	proposal.ExecuteAt = &executeAt
+	k.setProposal(ctx, *proposal)
+	k.addProposalToExecuteAtQueue(ctx, *proposal)
+
 	return nil
 }
 
@@ -89,6 +130,9 @@ func (k Keeper) WithdrawProposal(ctx sdk.Context, proposalID uint64) error {
 func (k Keeper) pruneProposal(ctx sdk.Context, proposal foundation.Proposal) {
 	k.pruneVotes(ctx, proposal.Id)
 	k.removeProposalFromVPEndQueue(ctx, proposal)
+	if proposal.ExecuteAt != nil {
+		k.removeProposalFromExecuteAtQueue(ctx, proposal)
+	}
 	k.deleteProposal(ctx, proposal.Id)
 }
 
@@ -109,9 +153,10 @@ func (k Keeper) PruneExpiredProposals(ctx sdk.Context) {
 }
 
 // abortOldProposals aborts all proposals which have lower version than the current foundation's
-func (k Keeper) abortOldProposals(ctx sdk.Context) {
+func (k Keeper) abortOldProposals(ctx sdk.Context) error {
 	latestVersion := k.GetFoundationInfo(ctx).Version
 
+	var aborted []uint64
 	k.iterateProposals(ctx, func(proposal foundation.Proposal) (stop bool) {
 		if proposal.FoundationVersion == latestVersion {
 			return true
@@ -122,10 +167,20 @@ func (k Keeper) abortOldProposals(ctx sdk.Context) {
 
 			proposal.Status = foundation.PROPOSAL_STATUS_ABORTED
 			k.setProposal(ctx, proposal)
+
+			aborted = append(aborted, proposal.Id)
 		}
 
 		return false
 	})
+
+	for _, id := range aborted {
+		if err := k.forfeitDeposit(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func (k Keeper) GetProposals(ctx sdk.Context) []foundation.Proposal {
@@ -234,6 +289,37 @@ func (k Keeper) removeProposalFromVPEndQueue(ctx sdk.Context, proposal foundatio
 	store.Delete(key)
 }
 
+func (k Keeper) iterateProposalsByExecuteAt(ctx sdk.Context, executeAt time.Time, fn func(proposal foundation.Proposal) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(proposalByExecuteAtKeyPrefix, sdk.PrefixEndBytes(append(proposalByExecuteAtKeyPrefix, sdk.FormatTimeBytes(executeAt)...)))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		_, id := splitProposalByExecuteAtKey(iter.Key())
+
+		proposal, err := k.GetProposal(ctx, id)
+		if err != nil {
+			panic(err)
+		}
+
+		if fn(*proposal) {
+			break
+		}
+	}
+}
+
+func (k Keeper) addProposalToExecuteAtQueue(ctx sdk.Context, proposal foundation.Proposal) {
+	store := ctx.KVStore(k.storeKey)
+	key := proposalByExecuteAtKey(*proposal.ExecuteAt, proposal.Id)
+	store.Set(key, []byte{})
+}
+
+func (k Keeper) removeProposalFromExecuteAtQueue(ctx sdk.Context, proposal foundation.Proposal) {
+	store := ctx.KVStore(k.storeKey)
+	key := proposalByExecuteAtKey(*proposal.ExecuteAt, proposal.Id)
+	store.Delete(key)
+}
+
 func validateActorForProposal(address string, proposal foundation.Proposal) error {
 	for _, proposer := range proposal.Proposers {
 		if address == proposer {
@@ -2,6 +2,7 @@ package internal_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -42,7 +43,7 @@ func (s *KeeperTestSuite) TestSubmitProposal() {
 		s.Run(name, func() {
 			ctx, _ := s.ctx.CacheContext()
 
-			_, err := s.impl.SubmitProposal(ctx, tc.proposers, tc.metadata, []sdk.Msg{tc.msg})
+			_, err := s.impl.SubmitProposal(ctx, tc.proposers, tc.metadata, []sdk.Msg{tc.msg}, nil)
 			if tc.valid {
 				s.Require().NoError(err)
 			} else {
@@ -83,6 +84,208 @@ func (s *KeeperTestSuite) TestWithdrawProposal() {
 	}
 }
 
+func (s *KeeperTestSuite) TestScheduleProposal() {
+	testCases := map[string]struct {
+		id        uint64
+		executeAt time.Time
+		valid     bool
+	}{
+		"valid schedule": {
+			id:        s.activeProposal,
+			executeAt: s.ctx.BlockTime().Add(time.Hour),
+			valid:     true,
+		},
+		"no such a proposal": {
+			id:        s.nextProposal,
+			executeAt: s.ctx.BlockTime().Add(time.Hour),
+		},
+		"not active": {
+			id:        s.withdrawnProposal,
+			executeAt: s.ctx.BlockTime().Add(time.Hour),
+		},
+		"in the past": {
+			id:        s.activeProposal,
+			executeAt: s.ctx.BlockTime().Add(-time.Nanosecond),
+		},
+		"after the expiry": {
+			id:        s.activeProposal,
+			executeAt: s.ctx.BlockTime().Add(workingPolicy().GetVotingPeriod() + foundation.DefaultConfig().MaxExecutionPeriod),
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			err := s.impl.ScheduleProposal(ctx, tc.id, tc.executeAt)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			proposal, err := s.impl.GetProposal(ctx, tc.id)
+			s.Require().NoError(err)
+			s.Require().NotNil(proposal.ExecuteAt)
+			s.Require().Equal(tc.executeAt, *proposal.ExecuteAt)
+
+			// cannot schedule twice
+			err = s.impl.ScheduleProposal(ctx, tc.id, tc.executeAt)
+			s.Require().Error(err)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSubmitProposalWithDeposit() {
+	minDeposit := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+
+	testCases := map[string]struct {
+		proposer  sdk.AccAddress
+		deposit   sdk.Int
+		openLimit uint64
+		valid     bool
+	}{
+		"valid deposit": {
+			proposer: s.stranger,
+			deposit:  minDeposit.AmountOf(sdk.DefaultBondDenom),
+			valid:    true,
+		},
+		"insufficient deposit": {
+			proposer: s.stranger,
+			deposit:  minDeposit.AmountOf(sdk.DefaultBondDenom).Sub(sdk.OneInt()),
+		},
+		"insufficient funds": {
+			proposer: s.stranger,
+			deposit:  s.balance.Add(sdk.OneInt()),
+		},
+		"open proposals under the limit": {
+			proposer:  s.stranger,
+			deposit:   minDeposit.AmountOf(sdk.DefaultBondDenom),
+			openLimit: 1,
+			valid:     true,
+		},
+		"too many open proposals": {
+			proposer:  s.members[0],
+			deposit:   minDeposit.AmountOf(sdk.DefaultBondDenom),
+			openLimit: 1,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			params := s.impl.GetParams(ctx)
+			params.MinDeposit = minDeposit
+			params.MaxOpenProposalsPerMember = tc.openLimit
+			s.impl.SetParams(ctx, params)
+
+			before := s.bankKeeper.GetAllBalances(ctx, tc.proposer)
+
+			deposit := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, tc.deposit))
+			id, err := s.impl.SubmitProposal(ctx, []string{tc.proposer.String()}, "", []sdk.Msg{testdata.NewTestMsg(s.authority)}, deposit)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			stored, err := s.impl.GetDeposit(ctx, *id)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.proposer.String(), stored.Depositor)
+			s.Require().Equal(deposit, stored.Amount)
+
+			after := s.bankKeeper.GetAllBalances(ctx, tc.proposer)
+			s.Require().Equal(before.Sub(deposit), after)
+
+			_, broken := internal.DepositInvariant(s.impl)(ctx)
+			s.Require().False(broken)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSettleDeposit() {
+	deposit := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+
+	testCases := map[string]struct {
+		burn     bool
+		settle   func(ctx sdk.Context, id uint64)
+		refunded bool
+		forfeit  bool
+	}{
+		"refund on the final tally": {
+			settle: func(ctx sdk.Context, id uint64) {
+				// no votes, so the proposal is finalized after the voting period
+				votingPeriod := s.impl.GetFoundationInfo(ctx).GetDecisionPolicy().GetVotingPeriod()
+				internal.EndBlocker(ctx.WithBlockTime(ctx.BlockTime().Add(votingPeriod).Add(time.Nanosecond)), s.impl)
+			},
+			refunded: true,
+		},
+		"forfeit to the treasury on withdrawal": {
+			settle: func(ctx sdk.Context, id uint64) {
+				err := s.impl.WithdrawProposal(ctx, id)
+				s.Require().NoError(err)
+			},
+			forfeit: true,
+		},
+		"burn on withdrawal": {
+			burn: true,
+			settle: func(ctx sdk.Context, id uint64) {
+				err := s.impl.WithdrawProposal(ctx, id)
+				s.Require().NoError(err)
+			},
+		},
+		"forfeit to the treasury on abort": {
+			settle: func(ctx sdk.Context, id uint64) {
+				err := s.impl.UpdateDecisionPolicy(ctx, workingPolicy())
+				s.Require().NoError(err)
+			},
+			forfeit: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			params := s.impl.GetParams(ctx)
+			params.BurnForfeitedDeposits = tc.burn
+			s.impl.SetParams(ctx, params)
+
+			before := s.bankKeeper.GetAllBalances(ctx, s.stranger)
+			treasuryBefore := s.impl.GetTreasury(ctx)
+
+			id, err := s.impl.SubmitProposal(ctx, []string{s.stranger.String()}, "", []sdk.Msg{testdata.NewTestMsg(s.authority)}, deposit)
+			s.Require().NoError(err)
+
+			tc.settle(ctx, *id)
+
+			_, err = s.impl.GetDeposit(ctx, *id)
+			s.Require().Error(err)
+
+			expected := before.Sub(deposit)
+			if tc.refunded {
+				expected = before
+			}
+			s.Require().Equal(expected, s.bankKeeper.GetAllBalances(ctx, s.stranger))
+
+			expectedTreasury := treasuryBefore
+			if tc.forfeit {
+				expectedTreasury = treasuryBefore.Add(sdk.NewDecCoinsFromCoins(deposit...)...)
+			}
+			s.Require().Equal(expectedTreasury, s.impl.GetTreasury(ctx))
+
+			for _, invariant := range []func(k internal.Keeper) sdk.Invariant{
+				internal.ModuleAccountInvariant,
+				internal.DepositInvariant,
+			} {
+				_, broken := invariant(s.impl)(ctx)
+				s.Require().False(broken)
+			}
+		})
+	}
+}
+
 func TestAbortProposal(t *testing.T) {
 	checkTx := false
 	app := simapp.Setup(checkTx)
@@ -95,6 +298,7 @@ func TestAbortProposal(t *testing.T) {
 		app.MsgServiceRouter(),
 		app.AccountKeeper,
 		app.BankKeeper,
+		app.DistrKeeper,
 		authtypes.FeeCollectorName,
 		foundation.DefaultConfig(),
 		foundation.DefaultAuthority().String(),
@@ -113,6 +317,7 @@ func TestAbortProposal(t *testing.T) {
 	}
 	impl.SetMember(ctx, foundation.Member{
 		Address: members[0].String(),
+		Weight:  sdk.OneDec(),
 	})
 
 	info := foundation.DefaultFoundation()
@@ -123,12 +328,13 @@ func TestAbortProposal(t *testing.T) {
 
 	// create proposals of different versions and abort them
 	for _, newMember := range members[1:] {
-		_, err := impl.SubmitProposal(ctx, []string{members[0].String()}, "", []sdk.Msg{testdata.NewTestMsg(authority)})
+		_, err := impl.SubmitProposal(ctx, []string{members[0].String()}, "", []sdk.Msg{testdata.NewTestMsg(authority)}, nil)
 		require.NoError(t, err)
 
 		err = impl.UpdateMembers(ctx, []foundation.MemberRequest{
 			{
 				Address: newMember.String(),
+				Weight:  sdk.OneDec(),
 			},
 		})
 		require.NoError(t, err)
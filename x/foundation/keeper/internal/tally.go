@@ -8,6 +8,7 @@ import (
 
 // doTallyAndUpdate performs a tally, and, if the tally result is final, then:
 // - updates the proposal's `Status` and `FinalTallyResult` fields,
+// - records the members who have not voted until the end of the voting period,
 // - prune all the votes.
 func (k Keeper) doTallyAndUpdate(ctx sdk.Context, p *foundation.Proposal) error {
 	tallyResult, err := k.tally(ctx, *p)
@@ -25,8 +26,17 @@ func (k Keeper) doTallyAndUpdate(ctx sdk.Context, p *foundation.Proposal) error
 
 	// If the result was final (i.e. enough votes to pass) or if the voting
 	// period ended, then we consider the proposal as final.
-	if isFinal := result.Final || ctx.BlockTime().After(p.VotingPeriodEnd); isFinal {
+	vpEnded := ctx.BlockTime().After(p.VotingPeriodEnd)
+	if isFinal := result.Final || vpEnded; isFinal {
+		// the members could have voted until the end of the voting period,
+		// so they are not considered to miss the proposal finalized early.
+		if vpEnded {
+			k.recordMissedVotes(ctx, p.Id)
+		}
 		k.pruneVotes(ctx, p.Id)
+		if err := k.refundDeposit(ctx, p.Id); err != nil {
+			return err
+		}
 		p.FinalTallyResult = tallyResult
 		if result.Allow {
 			p.Status = foundation.PROPOSAL_STATUS_ACCEPTED
@@ -55,7 +65,7 @@ func (k Keeper) tally(ctx sdk.Context, p foundation.Proposal) (foundation.TallyR
 	k.iterateVotes(ctx, p.Id, func(vote foundation.Vote) (stop bool) {
 		voter := sdk.MustAccAddressFromBech32(vote.Voter)
 
-		_, err := k.GetMember(ctx, voter)
+		member, err := k.GetMember(ctx, voter)
 		switch {
 		case sdkerrors.ErrNotFound.Is(err):
 			// If the member left the foundation after voting, then we simply skip the
@@ -67,7 +77,12 @@ func (k Keeper) tally(ctx sdk.Context, p foundation.Proposal) (foundation.TallyR
 			return true
 		}
 
-		if err := tallyResult.Add(vote.Option); err != nil {
+		// The weight of a suspended member does not count.
+		if k.isSuspended(ctx, voter) {
+			return false
+		}
+
+		if err := tallyResult.Add(vote.Option, member.Weight); err != nil {
 			panic(err)
 		}
 
@@ -1,8 +1,11 @@
 package internal
 
 import (
+	"time"
+
 	sdk "github.com/Finschia/finschia-sdk/types"
 	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	authtypes "github.com/Finschia/finschia-sdk/x/auth/types"
 	"github.com/Finschia/finschia-sdk/x/foundation"
 )
 
@@ -21,14 +24,107 @@ func (k Keeper) CollectFoundationTax(ctx sdk.Context) error {
 		return nil
 	}
 
+	// split the tax
+	split := k.GetParams(ctx).TaxSplit
+	taxDec := sdk.NewDecCoinsFromCoins(tax...)
+	communityPool, _ := taxDec.MulDecTruncate(split.CommunityPool).TruncateDecimal()
+	burned, _ := taxDec.MulDecTruncate(split.Burn).TruncateDecimal()
+	if !burned.Empty() && !k.canBurnTax(ctx) {
+		// the treasury keeps the share to burn, rather than halting the chain
+		k.Logger(ctx).Error("treasury cannot burn the tax; sending the share to the treasury", "amount", burned)
+		burned = sdk.NewCoins()
+	}
+	treasury := tax.Sub(communityPool).Sub(burned)
+
 	// collect the tax
-	if err := k.FundTreasury(ctx, feeCollector, tax); err != nil {
-		return err
+	if !treasury.Empty() {
+		if err := k.FundTreasury(ctx, feeCollector, treasury); err != nil {
+			return err
+		}
+	}
+
+	if !communityPool.Empty() {
+		if err := k.distrKeeper.FundCommunityPool(ctx, communityPool, feeCollector); err != nil {
+			return err
+		}
+	}
+
+	if !burned.Empty() {
+		if err := k.burnTax(ctx, burned); err != nil {
+			return err
+		}
+	}
+
+	record := foundation.TaxRecord{
+		Height:        ctx.BlockHeight(),
+		Treasury:      treasury,
+		CommunityPool: communityPool,
+		Burned:        burned,
+	}
+	k.setTaxRecord(ctx, record)
+	k.pruneTaxRecords(ctx)
+
+	if err := ctx.EventManager().EmitTypedEvent(&foundation.EventCollectFoundationTax{
+		Treasury:      record.Treasury,
+		CommunityPool: record.CommunityPool,
+		Burned:        record.Burned,
+	}); err != nil {
+		panic(err)
 	}
 
 	return nil
 }
 
+// canBurnTax returns true if the treasury module account has the burner
+// permission, which burnTax requires.
+func (k Keeper) canBurnTax(ctx sdk.Context) bool {
+	return k.authKeeper.GetModuleAccount(ctx, foundation.TreasuryName).HasPermission(authtypes.Burner)
+}
+
+// burnTax burns the coins from the fee collector, via the treasury module
+// account which must have the burner permission.
+func (k Keeper) burnTax(ctx sdk.Context, amt sdk.Coins) error {
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, foundation.TreasuryName, amt); err != nil {
+		return err
+	}
+
+	return k.bankKeeper.BurnCoins(ctx, foundation.TreasuryName, amt)
+}
+
+func (k Keeper) setTaxRecord(ctx sdk.Context, record foundation.TaxRecord) {
+	store := ctx.KVStore(k.storeKey)
+	key := taxRecordKey(record.Height)
+
+	bz := k.cdc.MustMarshal(&record)
+	store.Set(key, bz)
+}
+
+// pruneTaxRecords deletes the tax records older than the retention.
+func (k Keeper) pruneTaxRecords(ctx sdk.Context) {
+	retention := int64(k.config.TaxHistoryRetention)
+	if retention == 0 {
+		return
+	}
+
+	end := ctx.BlockHeight() - retention + 1
+	if end <= 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(taxRecordKeyPrefix, taxRecordKey(end))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
 func (k Keeper) GetTreasury(ctx sdk.Context) sdk.DecCoins {
 	return k.GetPool(ctx).Treasury
 }
@@ -53,6 +149,161 @@ func (k Keeper) WithdrawFromTreasury(ctx sdk.Context, to sdk.AccAddress, amt sdk
 	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, foundation.TreasuryName, to, amt)
 }
 
+func (k Keeper) CreateTreasuryStream(ctx sdk.Context, recipient sdk.AccAddress, amount sdk.Coins, period time.Duration, total sdk.Coins) (*uint64, error) {
+	id := k.getPreviousTreasuryStreamID(ctx) + 1
+	k.setPreviousTreasuryStreamID(ctx, id)
+
+	stream := foundation.TreasuryStream{
+		Id:             id,
+		Recipient:      recipient.String(),
+		Amount:         amount,
+		Period:         period,
+		Remaining:      total,
+		NextPayoutTime: ctx.BlockTime(),
+	}
+	k.setTreasuryStream(ctx, stream)
+
+	if err := ctx.EventManager().EmitTypedEvent(&foundation.EventCreateTreasuryStream{
+		Stream: stream,
+	}); err != nil {
+		panic(err)
+	}
+
+	return &id, nil
+}
+
+func (k Keeper) CancelTreasuryStream(ctx sdk.Context, id uint64) error {
+	stream, err := k.GetTreasuryStream(ctx, id)
+	if err != nil {
+		return err
+	}
+	k.deleteTreasuryStream(ctx, id)
+
+	if err := ctx.EventManager().EmitTypedEvent(&foundation.EventCancelTreasuryStream{
+		StreamId:  id,
+		Remaining: stream.Remaining,
+	}); err != nil {
+		panic(err)
+	}
+
+	return nil
+}
+
+// PayTreasuryStreams pays the recipients of the streams due. A stream pays at
+// most once per block, and a payout which the treasury cannot afford is
+// retried on the next block.
+func (k Keeper) PayTreasuryStreams(ctx sdk.Context) {
+	var streams []foundation.TreasuryStream
+	k.iterateTreasuryStreams(ctx, func(stream foundation.TreasuryStream) (stop bool) {
+		if !ctx.BlockTime().Before(stream.NextPayoutTime) {
+			streams = append(streams, stream)
+		}
+		return false
+	})
+
+	for _, stream := range streams {
+		k.payTreasuryStream(ctx, stream)
+	}
+}
+
+func (k Keeper) payTreasuryStream(ctx sdk.Context, stream foundation.TreasuryStream) {
+	payout := stream.Amount.Min(stream.Remaining)
+	recipient := sdk.MustAccAddressFromBech32(stream.Recipient)
+
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.WithdrawFromTreasury(cacheCtx, recipient, payout); err != nil {
+		k.Logger(ctx).Info("treasury stream payout failed", "stream", stream.Id, "error", err)
+		return
+	}
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	stream.Remaining = stream.Remaining.Sub(payout)
+	stream.NextPayoutTime = stream.NextPayoutTime.Add(stream.Period)
+	completed := stream.Remaining.IsZero()
+	if completed {
+		k.deleteTreasuryStream(ctx, stream.Id)
+	} else {
+		k.setTreasuryStream(ctx, stream)
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&foundation.EventTreasuryStreamPayout{
+		StreamId:  stream.Id,
+		Recipient: stream.Recipient,
+		Amount:    payout,
+		Completed: completed,
+	}); err != nil {
+		panic(err)
+	}
+}
+
+func (k Keeper) getPreviousTreasuryStreamID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(previousTreasuryStreamIDKey)
+	if len(bz) == 0 {
+		return 0
+	}
+	return Uint64FromBytes(bz)
+}
+
+func (k Keeper) setPreviousTreasuryStreamID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(previousTreasuryStreamIDKey, Uint64ToBytes(id))
+}
+
+func (k Keeper) GetTreasuryStream(ctx sdk.Context, id uint64) (*foundation.TreasuryStream, error) {
+	store := ctx.KVStore(k.storeKey)
+	key := treasuryStreamKey(id)
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return nil, sdkerrors.ErrNotFound.Wrapf("No treasury stream for id: %d", id)
+	}
+
+	var stream foundation.TreasuryStream
+	k.cdc.MustUnmarshal(bz, &stream)
+
+	return &stream, nil
+}
+
+func (k Keeper) setTreasuryStream(ctx sdk.Context, stream foundation.TreasuryStream) {
+	store := ctx.KVStore(k.storeKey)
+	key := treasuryStreamKey(stream.Id)
+
+	bz := k.cdc.MustMarshal(&stream)
+	store.Set(key, bz)
+}
+
+func (k Keeper) deleteTreasuryStream(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	key := treasuryStreamKey(id)
+	store.Delete(key)
+}
+
+func (k Keeper) iterateTreasuryStreams(ctx sdk.Context, fn func(stream foundation.TreasuryStream) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, treasuryStreamKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var stream foundation.TreasuryStream
+		k.cdc.MustUnmarshal(iterator.Value(), &stream)
+
+		if stop := fn(stream); stop {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetTreasuryStreams(ctx sdk.Context) []foundation.TreasuryStream {
+	var streams []foundation.TreasuryStream
+	k.iterateTreasuryStreams(ctx, func(stream foundation.TreasuryStream) (stop bool) {
+		streams = append(streams, stream)
+		return false
+	})
+
+	return streams
+}
+
 func (k Keeper) GetPool(ctx sdk.Context) foundation.Pool {
 	store := ctx.KVStore(k.storeKey)
 	key := poolKey
@@ -1,9 +1,14 @@
 package internal_test
 
 import (
+	"sort"
+	"time"
+
 	sdk "github.com/Finschia/finschia-sdk/types"
 	authtypes "github.com/Finschia/finschia-sdk/x/auth/types"
+	distributiontypes "github.com/Finschia/finschia-sdk/x/distribution/types"
 	"github.com/Finschia/finschia-sdk/x/foundation"
+	"github.com/Finschia/finschia-sdk/x/foundation/keeper/internal"
 )
 
 func (s *KeeperTestSuite) TestCollectFoundationTax() {
@@ -52,6 +57,7 @@ func (s *KeeperTestSuite) TestCollectFoundationTax() {
 			// set tax ratio
 			s.impl.SetParams(ctx, foundation.Params{
 				FoundationTax: tc.taxRatio,
+				TaxSplit:      foundation.DefaultTaxSplit(),
 			})
 
 			before := s.impl.GetTreasury(ctx)
@@ -77,6 +83,102 @@ func (s *KeeperTestSuite) TestCollectFoundationTax() {
 	}
 }
 
+func (s *KeeperTestSuite) TestCollectFoundationTaxSplit() {
+	ctx, _ := s.ctx.CacheContext()
+	ctx = ctx.WithBlockHeight(42)
+
+	collector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	fees := s.bankKeeper.GetAllBalances(ctx, collector)
+	s.Require().Len(fees, 1)
+	denom := fees[0].Denom
+	fee := fees[0].Amount
+
+	distribution := authtypes.NewModuleAddress(distributiontypes.ModuleName)
+	communityPoolBefore := s.bankKeeper.GetAllBalances(ctx, distribution).AmountOf(denom)
+
+	params := s.impl.GetParams(ctx)
+	params.FoundationTax = sdk.OneDec()
+	params.TaxSplit = foundation.TaxSplit{
+		Treasury:      sdk.MustNewDecFromStr("0.5"),
+		CommunityPool: sdk.MustNewDecFromStr("0.3"),
+		Burn:          sdk.MustNewDecFromStr("0.2"),
+	}
+	s.impl.SetParams(ctx, params)
+
+	err := s.impl.CollectFoundationTax(ctx)
+	s.Require().NoError(err)
+
+	communityPool := sdk.NewDecFromInt(fee).MulTruncate(params.TaxSplit.CommunityPool).TruncateInt()
+	burned := sdk.NewDecFromInt(fee).MulTruncate(params.TaxSplit.Burn).TruncateInt()
+	treasury := fee.Sub(communityPool).Sub(burned)
+
+	// check the balances
+	s.Require().True(s.bankKeeper.GetAllBalances(ctx, collector).IsZero())
+	s.Require().Equal(communityPoolBefore.Add(communityPool), s.bankKeeper.GetAllBalances(ctx, distribution).AmountOf(denom))
+	s.Require().Equal(sdk.NewDecFromInt(s.balance.Add(treasury)), s.impl.GetTreasury(ctx).AmountOf(denom))
+
+	// check the history
+	res, err := s.queryServer.TaxHistory(sdk.WrapSDKContext(ctx), &foundation.QueryTaxHistoryRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]foundation.TaxRecord{{
+		Height:        ctx.BlockHeight(),
+		Treasury:      sdk.NewCoins(sdk.NewCoin(denom, treasury)),
+		CommunityPool: sdk.NewCoins(sdk.NewCoin(denom, communityPool)),
+		Burned:        sdk.NewCoins(sdk.NewCoin(denom, burned)),
+	}}, res.Records)
+
+	// old records are pruned
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(foundation.DefaultConfig().TaxHistoryRetention))
+	err = s.bankKeeper.SendCoinsFromAccountToModule(ctx, s.stranger, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewCoin(denom, sdk.OneInt())))
+	s.Require().NoError(err)
+
+	err = s.impl.CollectFoundationTax(ctx)
+	s.Require().NoError(err)
+
+	res, err = s.queryServer.TaxHistory(sdk.WrapSDKContext(ctx), &foundation.QueryTaxHistoryRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(res.Records, 1)
+	s.Require().Equal(ctx.BlockHeight(), res.Records[0].Height)
+}
+
+func (s *KeeperTestSuite) TestCollectFoundationTaxWithoutBurner() {
+	ctx, _ := s.ctx.CacheContext()
+
+	// the treasury of a chain which has not been migrated
+	treasuryAcc, ok := s.authKeeper.GetModuleAccount(ctx, foundation.TreasuryName).(*authtypes.ModuleAccount)
+	s.Require().True(ok)
+	treasuryAcc.Permissions = nil
+	s.authKeeper.SetModuleAccount(ctx, treasuryAcc)
+
+	collector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	fees := s.bankKeeper.GetAllBalances(ctx, collector)
+	s.Require().Len(fees, 1)
+	denom := fees[0].Denom
+	fee := fees[0].Amount
+
+	params := s.impl.GetParams(ctx)
+	params.FoundationTax = sdk.OneDec()
+	params.TaxSplit = foundation.TaxSplit{
+		Treasury:      sdk.MustNewDecFromStr("0.8"),
+		CommunityPool: sdk.ZeroDec(),
+		Burn:          sdk.MustNewDecFromStr("0.2"),
+	}
+	s.impl.SetParams(ctx, params)
+
+	err := s.impl.CollectFoundationTax(ctx)
+	s.Require().NoError(err)
+
+	// the share to burn goes to the treasury
+	s.Require().True(s.bankKeeper.GetAllBalances(ctx, collector).IsZero())
+	s.Require().Equal(sdk.NewDecFromInt(s.balance.Add(fee)), s.impl.GetTreasury(ctx).AmountOf(denom))
+
+	res, err := s.queryServer.TaxHistory(sdk.WrapSDKContext(ctx), &foundation.QueryTaxHistoryRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(res.Records, 1)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin(denom, fee)), res.Records[0].Treasury)
+	s.Require().True(res.Records[0].Burned.Empty())
+}
+
 func (s *KeeperTestSuite) TestFundTreasury() {
 	testCases := map[string]struct {
 		amount sdk.Int
@@ -150,3 +252,88 @@ func (s *KeeperTestSuite) TestWithdrawFromTreasury() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestPayTreasuryStreams() {
+	start := time.Unix(1_700_000_000, 0).UTC()
+
+	testCases := map[string]struct {
+		amount  sdk.Int
+		period  time.Duration
+		total   sdk.Int
+		payouts map[time.Duration]sdk.Int
+	}{
+		"periodic": {
+			amount: sdk.NewInt(100),
+			period: time.Hour,
+			total:  sdk.NewInt(250),
+			payouts: map[time.Duration]sdk.Int{
+				0:                             sdk.NewInt(100),
+				30 * time.Minute:              sdk.ZeroInt(),
+				time.Hour:                     sdk.NewInt(100),
+				2*time.Hour - time.Nanosecond: sdk.ZeroInt(),
+				2 * time.Hour:                 sdk.NewInt(50),
+				3 * time.Hour:                 sdk.ZeroInt(),
+			},
+		},
+		"every block": {
+			amount: sdk.NewInt(100),
+			total:  sdk.NewInt(250),
+			payouts: map[time.Duration]sdk.Int{
+				0:               sdk.NewInt(100),
+				time.Second:     sdk.NewInt(100),
+				2 * time.Second: sdk.NewInt(50),
+				3 * time.Second: sdk.ZeroInt(),
+			},
+		},
+		"insufficient treasury": {
+			amount: s.balance.Add(sdk.OneInt()),
+			period: time.Hour,
+			total:  s.balance.Add(sdk.OneInt()),
+			payouts: map[time.Duration]sdk.Int{
+				0:         sdk.ZeroInt(),
+				time.Hour: sdk.ZeroInt(),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			ctx = ctx.WithBlockTime(start)
+
+			amount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, tc.amount))
+			total := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, tc.total))
+			id, err := s.impl.CreateTreasuryStream(ctx, s.stranger, amount, tc.period, total)
+			s.Require().NoError(err)
+
+			offsets := make([]time.Duration, 0, len(tc.payouts))
+			for offset := range tc.payouts {
+				offsets = append(offsets, offset)
+			}
+			sort.Slice(offsets, func(i, j int) bool { return offsets[i] < offsets[j] })
+
+			paid := sdk.ZeroInt()
+			for _, offset := range offsets {
+				ctx := ctx.WithBlockTime(start.Add(offset))
+				before := s.bankKeeper.GetAllBalances(ctx, s.stranger).AmountOf(sdk.DefaultBondDenom)
+				treasuryBefore := s.impl.GetTreasury(ctx)
+
+				internal.EndBlocker(ctx, s.impl)
+
+				payout := s.bankKeeper.GetAllBalances(ctx, s.stranger).AmountOf(sdk.DefaultBondDenom).Sub(before)
+				s.Require().True(tc.payouts[offset].Equal(payout), "%s: expected %s, got %s", offset, tc.payouts[offset], payout)
+				treasuryAfter := s.impl.GetTreasury(ctx)
+				s.Require().Equal(treasuryBefore.AmountOf(sdk.DefaultBondDenom).Sub(payout.ToDec()), treasuryAfter.AmountOf(sdk.DefaultBondDenom))
+				paid = paid.Add(payout)
+			}
+
+			stream, err := s.impl.GetTreasuryStream(ctx, *id)
+			if paid.Equal(tc.total) {
+				s.Require().Error(err, "completed stream must be removed")
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.total.Sub(paid), stream.Remaining.AmountOf(sdk.DefaultBondDenom))
+		})
+	}
+}
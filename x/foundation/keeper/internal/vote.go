@@ -11,8 +11,12 @@ func (k Keeper) Vote(ctx sdk.Context, vote foundation.Vote) error {
 		return err
 	}
 
-	// Make sure that a voter hasn't already voted.
 	voter := sdk.MustAccAddressFromBech32(vote.Voter)
+	if k.isSuspended(ctx, voter) {
+		return sdkerrors.ErrUnauthorized.Wrapf("suspended member: %s", vote.Voter)
+	}
+
+	// Make sure that a voter hasn't already voted.
 	if k.hasVote(ctx, vote.ProposalId, voter) {
 		return sdkerrors.ErrInvalidRequest.Wrapf("Already voted: %s", vote.Voter)
 	}
@@ -32,6 +36,7 @@ func (k Keeper) Vote(ctx sdk.Context, vote foundation.Vote) error {
 
 	vote.SubmitTime = ctx.BlockTime()
 	k.setVote(ctx, vote)
+	k.recordVote(ctx, voter, vote.ProposalId)
 
 	if err := ctx.EventManager().EmitTypedEvent(&foundation.EventVote{
 		Vote: vote,
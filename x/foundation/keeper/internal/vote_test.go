@@ -84,3 +84,28 @@ func (s *KeeperTestSuite) TestVote() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestTallyByWeight() {
+	ctx, _ := s.ctx.CacheContext()
+
+	// the first member has not voted yet
+	weights := map[string]sdk.Dec{
+		s.members[0].String(): sdk.NewDec(4),
+		s.members[1].String(): sdk.NewDec(3),
+	}
+	for addr, weight := range weights {
+		member, err := s.impl.GetMember(ctx, sdk.MustAccAddressFromBech32(addr))
+		s.Require().NoError(err)
+		member.Weight = weight
+		s.impl.SetMember(ctx, *member)
+	}
+
+	res, err := s.queryServer.TallyResult(sdk.WrapSDKContext(ctx), &foundation.QueryTallyResultRequest{
+		ProposalId: s.activeProposal,
+	})
+	s.Require().NoError(err)
+
+	expected := sdk.NewDec(int64(len(s.members) - 2)).Add(weights[s.members[1].String()])
+	s.Require().Equal(expected, res.Tally.YesCount)
+	s.Require().True(res.Tally.NoCount.IsZero())
+}
@@ -11,9 +11,18 @@ const (
 	RouterKey = ModuleName
 
 	TreasuryName = "treasury"
+
+	// DepositName is the name of the module account escrowing the proposal deposits
+	DepositName = "foundation_deposit"
 )
 
 // params
 const (
-	ParamKeyFoundationTax = "FoundationTax"
+	ParamKeyFoundationTax             = "FoundationTax"
+	ParamKeyMinDeposit                = "MinDeposit"
+	ParamKeyBurnForfeitedDeposits     = "BurnForfeitedDeposits"
+	ParamKeyMaxOpenProposalsPerMember = "MaxOpenProposalsPerMember"
+	ParamKeyTaxSplit                  = "TaxSplit"
+	ParamKeyMaxConsecutiveMissedVotes = "MaxConsecutiveMissedVotes"
+	ParamKeySuspendInactiveMembers    = "SuspendInactiveMembers"
 )
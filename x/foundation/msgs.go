@@ -113,6 +113,82 @@ func (m MsgWithdrawFromTreasury) GetSignBytes() []byte {
 	return sdk.MustSortJSON(codec.ModuleCdc.MustMarshalJSON(&m))
 }
 
+var _ sdk.Msg = (*MsgCreateTreasuryStream)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgCreateTreasuryStream) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", m.Authority)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", m.Recipient)
+	}
+
+	if err := validateTreasuryStreamAmounts(m.Amount, m.Period, m.Total); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg.
+func (m MsgCreateTreasuryStream) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgCreateTreasuryStream) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgCreateTreasuryStream) Route() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgCreateTreasuryStream) GetSignBytes() []byte {
+	return sdk.MustSortJSON(codec.ModuleCdc.MustMarshalJSON(&m))
+}
+
+var _ sdk.Msg = (*MsgCancelTreasuryStream)(nil)
+
+// ValidateBasic implements Msg.
+func (m MsgCancelTreasuryStream) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", m.Authority)
+	}
+
+	if m.StreamId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("empty stream id")
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg.
+func (m MsgCancelTreasuryStream) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{signer}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgCancelTreasuryStream) Type() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgCancelTreasuryStream) Route() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgCancelTreasuryStream) GetSignBytes() []byte {
+	return sdk.MustSortJSON(codec.ModuleCdc.MustMarshalJSON(&m))
+}
+
 var _ sdk.Msg = (*MsgUpdateMembers)(nil)
 
 // ValidateBasic implements Msg.
@@ -240,6 +316,14 @@ func (m MsgSubmitProposal) ValidateBasic() error {
 		return sdkerrors.ErrInvalidRequest.Wrap("invalid exec option")
 	}
 
+	if err := m.Deposit.Validate(); err != nil {
+		return sdkerrors.ErrInvalidCoins.Wrap(err.Error())
+	}
+
+	if m.ExecuteAt != nil && m.Exec == Exec_EXEC_TRY {
+		return sdkerrors.ErrInvalidRequest.Wrap("scheduled proposal cannot be executed on submission")
+	}
+
 	return nil
 }
 
@@ -162,6 +162,135 @@ func TestMsgWithdrawFromTreasury(t *testing.T) {
 	}
 }
 
+func TestMsgCreateTreasuryStream(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 2)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := map[string]struct {
+		authority sdk.AccAddress
+		recipient sdk.AccAddress
+		amount    sdk.Coins
+		period    time.Duration
+		total     sdk.Coins
+		valid     bool
+	}{
+		"valid msg": {
+			authority: addrs[0],
+			recipient: addrs[1],
+			amount:    sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)),
+			period:    time.Hour,
+			total:     sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+			valid:     true,
+		},
+		"payout on every block": {
+			authority: addrs[0],
+			recipient: addrs[1],
+			amount:    sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)),
+			total:     sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+			valid:     true,
+		},
+		"empty authority": {
+			recipient: addrs[1],
+			amount:    sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)),
+			total:     sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+		},
+		"empty recipient": {
+			authority: addrs[0],
+			amount:    sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)),
+			total:     sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+		},
+		"empty amount": {
+			authority: addrs[0],
+			recipient: addrs[1],
+			total:     sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+		},
+		"negative period": {
+			authority: addrs[0],
+			recipient: addrs[1],
+			amount:    sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)),
+			period:    -time.Hour,
+			total:     sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+		},
+		"empty total": {
+			authority: addrs[0],
+			recipient: addrs[1],
+			amount:    sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)),
+		},
+		"denoms mismatch": {
+			authority: addrs[0],
+			recipient: addrs[1],
+			amount:    sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)),
+			total:     sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), sdk.NewInt64Coin("foo", 100)),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := foundation.MsgCreateTreasuryStream{
+				Authority: tc.authority.String(),
+				Recipient: tc.recipient.String(),
+				Amount:    tc.amount,
+				Period:    tc.period,
+				Total:     tc.total,
+			}
+
+			err := msg.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, []sdk.AccAddress{tc.authority}, msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgCancelTreasuryStream(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 1)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := map[string]struct {
+		authority sdk.AccAddress
+		id        uint64
+		valid     bool
+	}{
+		"valid msg": {
+			authority: addrs[0],
+			id:        1,
+			valid:     true,
+		},
+		"empty authority": {
+			id: 1,
+		},
+		"empty stream id": {
+			authority: addrs[0],
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := foundation.MsgCancelTreasuryStream{
+				Authority: tc.authority.String(),
+				StreamId:  tc.id,
+			}
+
+			err := msg.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, []sdk.AccAddress{tc.authority}, msg.GetSigners())
+		})
+	}
+}
+
 func TestMsgUpdateMembers(t *testing.T) {
 	addrs := make([]sdk.AccAddress, 2)
 	for i := range addrs {
@@ -177,12 +306,14 @@ func TestMsgUpdateMembers(t *testing.T) {
 			authority: addrs[0],
 			members: []foundation.MemberRequest{{
 				Address: addrs[1].String(),
+				Weight:  sdk.OneDec(),
 			}},
 			valid: true,
 		},
 		"empty authority": {
 			members: []foundation.MemberRequest{{
 				Address: addrs[1].String(),
+				Weight:  sdk.OneDec(),
 			}},
 		},
 		"empty requests": {
@@ -310,10 +441,14 @@ func TestMsgSubmitProposal(t *testing.T) {
 		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
 	}
 
+	executeAt := time.Unix(1_700_000_000, 0).UTC()
+
 	testCases := map[string]struct {
 		proposers []sdk.AccAddress
 		msgs      []sdk.Msg
 		exec      foundation.Exec
+		deposit   sdk.Coins
+		executeAt *time.Time
 		valid     bool
 	}{
 		"valid msg": {
@@ -321,6 +456,12 @@ func TestMsgSubmitProposal(t *testing.T) {
 			msgs:      []sdk.Msg{testdata.NewTestMsg()},
 			valid:     true,
 		},
+		"valid msg with deposit": {
+			proposers: []sdk.AccAddress{addrs[0]},
+			msgs:      []sdk.Msg{testdata.NewTestMsg()},
+			deposit:   sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+			valid:     true,
+		},
 		"empty proposers": {
 			msgs: []sdk.Msg{testdata.NewTestMsg()},
 		},
@@ -344,6 +485,23 @@ func TestMsgSubmitProposal(t *testing.T) {
 			msgs:      []sdk.Msg{testdata.NewTestMsg()},
 			exec:      -1,
 		},
+		"invalid deposit": {
+			proposers: []sdk.AccAddress{addrs[0]},
+			msgs:      []sdk.Msg{testdata.NewTestMsg()},
+			deposit:   sdk.Coins{sdk.Coin{Denom: "", Amount: sdk.OneInt()}},
+		},
+		"scheduled msg": {
+			proposers: []sdk.AccAddress{addrs[0]},
+			msgs:      []sdk.Msg{testdata.NewTestMsg()},
+			executeAt: &executeAt,
+			valid:     true,
+		},
+		"scheduled msg with exec try": {
+			proposers: []sdk.AccAddress{addrs[0]},
+			msgs:      []sdk.Msg{testdata.NewTestMsg()},
+			exec:      foundation.Exec_EXEC_TRY,
+			executeAt: &executeAt,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -356,6 +514,8 @@ func TestMsgSubmitProposal(t *testing.T) {
 			msg := foundation.MsgSubmitProposal{
 				Proposers: proposers,
 				Exec:      tc.exec,
+				Deposit:   tc.deposit,
+				ExecuteAt: tc.executeAt,
 			}
 			err := msg.SetMsgs(tc.msgs)
 			require.NoError(t, err)
@@ -588,6 +748,13 @@ func TestMsgGrant(t *testing.T) {
 			authority: addrs[0],
 			grantee:   addrs[1],
 		},
+		"invalid receive limit": {
+			authority: addrs[0],
+			grantee:   addrs[1],
+			authorization: &foundation.ReceiveFromTreasuryAuthorization{
+				ReceiveLimit: sdk.Coins{sdk.Coin{Denom: "", Amount: sdk.OneInt()}},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -738,7 +905,7 @@ func TestMsgSubmitProposalAminoJSON(t *testing.T) {
 				Authority: addrs[0].String(),
 				Params:    foundation.Params{FoundationTax: sdk.ZeroDec()},
 			},
-			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgSubmitProposal\",\"value\":{\"exec\":1,\"messages\":[{\"type\":\"lbm-sdk/MsgUpdateParams\",\"value\":{\"authority\":\"%s\",\"params\":{\"foundation_tax\":\"0.000000000000000000\"}}}],\"metadata\":\"MsgUpdateParams\",\"proposers\":[\"%s\"]}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String(), proposer.String()),
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgSubmitProposal\",\"value\":{\"deposit\":[],\"exec\":1,\"messages\":[{\"type\":\"lbm-sdk/MsgUpdateParams\",\"value\":{\"authority\":\"%s\",\"params\":{\"foundation_tax\":\"0.000000000000000000\",\"min_deposit\":[],\"tax_split\":{\"burn\":\"0.000000000000000000\",\"community_pool\":\"0.000000000000000000\",\"treasury\":\"0.000000000000000000\"}}}}],\"metadata\":\"MsgUpdateParams\",\"proposers\":[\"%s\"]}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String(), proposer.String()),
 		},
 		"MsgWithdrawFromTreasury": {
 			&foundation.MsgWithdrawFromTreasury{
@@ -746,16 +913,34 @@ func TestMsgSubmitProposalAminoJSON(t *testing.T) {
 				To:        addrs[1].String(),
 				Amount:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000000))),
 			},
-			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgSubmitProposal\",\"value\":{\"exec\":1,\"messages\":[{\"type\":\"lbm-sdk/MsgWithdrawFromTreasury\",\"value\":{\"amount\":[{\"amount\":\"1000000\",\"denom\":\"stake\"}],\"authority\":\"%s\",\"to\":\"%s\"}}],\"metadata\":\"MsgWithdrawFromTreasury\",\"proposers\":[\"%s\"]}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String(), addrs[1].String(), proposer.String()),
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgSubmitProposal\",\"value\":{\"deposit\":[],\"exec\":1,\"messages\":[{\"type\":\"lbm-sdk/MsgWithdrawFromTreasury\",\"value\":{\"amount\":[{\"amount\":\"1000000\",\"denom\":\"stake\"}],\"authority\":\"%s\",\"to\":\"%s\"}}],\"metadata\":\"MsgWithdrawFromTreasury\",\"proposers\":[\"%s\"]}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String(), addrs[1].String(), proposer.String()),
+		},
+		"MsgCreateTreasuryStream": {
+			&foundation.MsgCreateTreasuryStream{
+				Authority: addrs[0].String(),
+				Recipient: addrs[1].String(),
+				Amount:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000))),
+				Period:    time.Hour,
+				Total:     sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000000))),
+			},
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgSubmitProposal\",\"value\":{\"deposit\":[],\"exec\":1,\"messages\":[{\"type\":\"lbm-sdk/MsgCreateTreasuryStream\",\"value\":{\"amount\":[{\"amount\":\"1000\",\"denom\":\"stake\"}],\"authority\":\"%s\",\"period\":\"3600000000000\",\"recipient\":\"%s\",\"total\":[{\"amount\":\"1000000\",\"denom\":\"stake\"}]}}],\"metadata\":\"MsgCreateTreasuryStream\",\"proposers\":[\"%s\"]}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String(), addrs[1].String(), proposer.String()),
+		},
+		"MsgCancelTreasuryStream": {
+			&foundation.MsgCancelTreasuryStream{
+				Authority: addrs[0].String(),
+				StreamId:  1,
+			},
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgSubmitProposal\",\"value\":{\"deposit\":[],\"exec\":1,\"messages\":[{\"type\":\"lbm-sdk/MsgCancelTreasuryStream\",\"value\":{\"authority\":\"%s\",\"stream_id\":\"1\"}}],\"metadata\":\"MsgCancelTreasuryStream\",\"proposers\":[\"%s\"]}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String(), proposer.String()),
 		},
 		"MsgUpdateMembers": {
 			&foundation.MsgUpdateMembers{
 				Authority: addrs[0].String(),
 				MemberUpdates: []foundation.MemberRequest{{
 					Address: addrs[1].String(),
+					Weight:  sdk.OneDec(),
 				}},
 			},
-			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgSubmitProposal\",\"value\":{\"exec\":1,\"messages\":[{\"type\":\"lbm-sdk/MsgUpdateMembers\",\"value\":{\"authority\":\"%s\",\"member_updates\":[{\"address\":\"%s\"}]}}],\"metadata\":\"MsgUpdateMembers\",\"proposers\":[\"%s\"]}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String(), addrs[1].String(), proposer.String()),
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgSubmitProposal\",\"value\":{\"deposit\":[],\"exec\":1,\"messages\":[{\"type\":\"lbm-sdk/MsgUpdateMembers\",\"value\":{\"authority\":\"%s\",\"member_updates\":[{\"address\":\"%s\",\"weight\":\"1.000000000000000000\"}]}}],\"metadata\":\"MsgUpdateMembers\",\"proposers\":[\"%s\"]}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String(), addrs[1].String(), proposer.String()),
 		},
 		"MsgUpdateCensorship": {
 			&foundation.MsgUpdateCensorship{
@@ -765,7 +950,7 @@ func TestMsgSubmitProposalAminoJSON(t *testing.T) {
 					Authority:  foundation.CensorshipAuthorityGovernance,
 				},
 			},
-			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgSubmitProposal\",\"value\":{\"exec\":1,\"messages\":[{\"type\":\"lbm-sdk/MsgUpdateCensorship\",\"value\":{\"authority\":\"%s\",\"censorship\":{\"authority\":1,\"msg_type_url\":\"/lbm.foundation.v1.MsgWithdrawFromTreasury\"}}}],\"metadata\":\"MsgUpdateCensorship\",\"proposers\":[\"%s\"]}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String(), proposer.String()),
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgSubmitProposal\",\"value\":{\"deposit\":[],\"exec\":1,\"messages\":[{\"type\":\"lbm-sdk/MsgUpdateCensorship\",\"value\":{\"authority\":\"%s\",\"censorship\":{\"authority\":1,\"msg_type_url\":\"/lbm.foundation.v1.MsgWithdrawFromTreasury\"}}}],\"metadata\":\"MsgUpdateCensorship\",\"proposers\":[\"%s\"]}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String(), proposer.String()),
 		},
 		"MsgRevoke": {
 			&foundation.MsgRevoke{
@@ -773,7 +958,7 @@ func TestMsgSubmitProposalAminoJSON(t *testing.T) {
 				Grantee:    addrs[1].String(),
 				MsgTypeUrl: foundation.ReceiveFromTreasuryAuthorization{}.MsgTypeURL(),
 			},
-			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgSubmitProposal\",\"value\":{\"exec\":1,\"messages\":[{\"type\":\"lbm-sdk/MsgRevoke\",\"value\":{\"authority\":\"%s\",\"grantee\":\"%s\",\"msg_type_url\":\"/lbm.foundation.v1.MsgWithdrawFromTreasury\"}}],\"metadata\":\"MsgRevoke\",\"proposers\":[\"%s\"]}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String(), addrs[1].String(), proposer.String()),
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgSubmitProposal\",\"value\":{\"deposit\":[],\"exec\":1,\"messages\":[{\"type\":\"lbm-sdk/MsgRevoke\",\"value\":{\"authority\":\"%s\",\"grantee\":\"%s\",\"msg_type_url\":\"/lbm.foundation.v1.MsgWithdrawFromTreasury\"}}],\"metadata\":\"MsgRevoke\",\"proposers\":[\"%s\"]}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String(), addrs[1].String(), proposer.String()),
 		},
 	}
 
@@ -810,7 +995,7 @@ func TestMsgUpdateDecisionPolicyAminoJson(t *testing.T) {
 					VotingPeriod: time.Hour,
 				},
 			},
-			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgSubmitProposal\",\"value\":{\"exec\":1,\"messages\":[{\"type\":\"lbm-sdk/MsgUpdateDecisionPolicy\",\"value\":{\"authority\":\"%s\",\"decision_policy\":{\"type\":\"lbm-sdk/ThresholdDecisionPolicy\",\"value\":{\"threshold\":\"1.000000000000000000\",\"windows\":{\"min_execution_period\":\"0\",\"voting_period\":\"3600000000000\"}}}}}],\"metadata\":\"ThresholdDecisionPolicy\",\"proposers\":[\"%s\"]}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", authority, proposer),
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgSubmitProposal\",\"value\":{\"deposit\":[],\"exec\":1,\"messages\":[{\"type\":\"lbm-sdk/MsgUpdateDecisionPolicy\",\"value\":{\"authority\":\"%s\",\"decision_policy\":{\"type\":\"lbm-sdk/ThresholdDecisionPolicy\",\"value\":{\"threshold\":\"1.000000000000000000\",\"windows\":{\"min_execution_period\":\"0\",\"voting_period\":\"3600000000000\"}}}}}],\"metadata\":\"ThresholdDecisionPolicy\",\"proposers\":[\"%s\"]}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", authority, proposer),
 		},
 		"PercentageDecisionPolicy": {
 			&foundation.PercentageDecisionPolicy{
@@ -819,7 +1004,7 @@ func TestMsgUpdateDecisionPolicyAminoJson(t *testing.T) {
 					VotingPeriod: time.Hour,
 				},
 			},
-			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgSubmitProposal\",\"value\":{\"exec\":1,\"messages\":[{\"type\":\"lbm-sdk/MsgUpdateDecisionPolicy\",\"value\":{\"authority\":\"%s\",\"decision_policy\":{\"type\":\"lbm-sdk/PercentageDecisionPolicy\",\"value\":{\"percentage\":\"1.000000000000000000\",\"windows\":{\"min_execution_period\":\"0\",\"voting_period\":\"3600000000000\"}}}}}],\"metadata\":\"PercentageDecisionPolicy\",\"proposers\":[\"%s\"]}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", authority, proposer),
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgSubmitProposal\",\"value\":{\"deposit\":[],\"exec\":1,\"messages\":[{\"type\":\"lbm-sdk/MsgUpdateDecisionPolicy\",\"value\":{\"authority\":\"%s\",\"decision_policy\":{\"type\":\"lbm-sdk/PercentageDecisionPolicy\",\"value\":{\"percentage\":\"1.000000000000000000\",\"windows\":{\"min_execution_period\":\"0\",\"voting_period\":\"3600000000000\"}}}}}],\"metadata\":\"PercentageDecisionPolicy\",\"proposers\":[\"%s\"]}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", authority, proposer),
 		},
 	}
 
@@ -862,7 +1047,7 @@ func TestMsgGrantAminoJson(t *testing.T) {
 	}{
 		"ReceiveFromTreasuryAuthorization": {
 			&foundation.ReceiveFromTreasuryAuthorization{},
-			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgSubmitProposal\",\"value\":{\"exec\":1,\"messages\":[{\"type\":\"lbm-sdk/MsgGrant\",\"value\":{\"authority\":\"%s\",\"authorization\":{\"type\":\"lbm-sdk/ReceiveFromTreasuryAuthorization\",\"value\":{}},\"grantee\":\"%s\"}}],\"metadata\":\"ReceiveFromTreasuryAuthorization\",\"proposers\":[\"%s\"]}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", operator.String(), grantee.String(), proposer.String()),
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgSubmitProposal\",\"value\":{\"deposit\":[],\"exec\":1,\"messages\":[{\"type\":\"lbm-sdk/MsgGrant\",\"value\":{\"authority\":\"%s\",\"authorization\":{\"type\":\"lbm-sdk/ReceiveFromTreasuryAuthorization\",\"value\":{\"receive_limit\":[]}},\"grantee\":\"%s\"}}],\"metadata\":\"ReceiveFromTreasuryAuthorization\",\"proposers\":[\"%s\"]}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", operator.String(), grantee.String(), proposer.String()),
 		},
 	}
 
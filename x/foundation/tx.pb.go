@@ -12,19 +12,24 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/regen-network/cosmos-proto"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -300,6 +305,171 @@ func (m *MsgWithdrawFromTreasuryResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgWithdrawFromTreasuryResponse proto.InternalMessageInfo
 
+// MsgCreateTreasuryStream is the Msg/CreateTreasuryStream request type.
+type MsgCreateTreasuryStream struct {
+	// authority is the address of the privileged account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// recipient is the account address receiving the payouts.
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount is the amount paid on each payout.
+	Amount github_com_Finschia_finschia_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coins" json:"amount"`
+	// period is the interval between the payouts.
+	// zero means the payout happens on every block.
+	Period time.Duration `protobuf:"bytes,4,opt,name=period,proto3,stdduration" json:"period"`
+	// total is the total amount to be paid by the stream.
+	Total github_com_Finschia_finschia_sdk_types.Coins `protobuf:"bytes,5,rep,name=total,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coins" json:"total"`
+}
+
+func (m *MsgCreateTreasuryStream) Reset()         { *m = MsgCreateTreasuryStream{} }
+func (m *MsgCreateTreasuryStream) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateTreasuryStream) ProtoMessage()    {}
+func (*MsgCreateTreasuryStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec2105611cae3ff, []int{6}
+}
+func (m *MsgCreateTreasuryStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateTreasuryStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateTreasuryStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateTreasuryStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateTreasuryStream.Merge(m, src)
+}
+func (m *MsgCreateTreasuryStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateTreasuryStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateTreasuryStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateTreasuryStream proto.InternalMessageInfo
+
+// MsgCreateTreasuryStreamResponse is the Msg/CreateTreasuryStream response type.
+type MsgCreateTreasuryStreamResponse struct {
+	// stream_id is the unique ID of the stream.
+	StreamId uint64 `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+}
+
+func (m *MsgCreateTreasuryStreamResponse) Reset()         { *m = MsgCreateTreasuryStreamResponse{} }
+func (m *MsgCreateTreasuryStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateTreasuryStreamResponse) ProtoMessage()    {}
+func (*MsgCreateTreasuryStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec2105611cae3ff, []int{7}
+}
+func (m *MsgCreateTreasuryStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateTreasuryStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateTreasuryStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateTreasuryStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateTreasuryStreamResponse.Merge(m, src)
+}
+func (m *MsgCreateTreasuryStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateTreasuryStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateTreasuryStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateTreasuryStreamResponse proto.InternalMessageInfo
+
+// MsgCancelTreasuryStream is the Msg/CancelTreasuryStream request type.
+type MsgCancelTreasuryStream struct {
+	// authority is the address of the privileged account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// stream_id is the unique ID of the stream.
+	StreamId uint64 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+}
+
+func (m *MsgCancelTreasuryStream) Reset()         { *m = MsgCancelTreasuryStream{} }
+func (m *MsgCancelTreasuryStream) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelTreasuryStream) ProtoMessage()    {}
+func (*MsgCancelTreasuryStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec2105611cae3ff, []int{8}
+}
+func (m *MsgCancelTreasuryStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelTreasuryStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelTreasuryStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelTreasuryStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelTreasuryStream.Merge(m, src)
+}
+func (m *MsgCancelTreasuryStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelTreasuryStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelTreasuryStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelTreasuryStream proto.InternalMessageInfo
+
+// MsgCancelTreasuryStreamResponse is the Msg/CancelTreasuryStream response type.
+type MsgCancelTreasuryStreamResponse struct {
+}
+
+func (m *MsgCancelTreasuryStreamResponse) Reset()         { *m = MsgCancelTreasuryStreamResponse{} }
+func (m *MsgCancelTreasuryStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelTreasuryStreamResponse) ProtoMessage()    {}
+func (*MsgCancelTreasuryStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ec2105611cae3ff, []int{9}
+}
+func (m *MsgCancelTreasuryStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelTreasuryStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelTreasuryStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelTreasuryStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelTreasuryStreamResponse.Merge(m, src)
+}
+func (m *MsgCancelTreasuryStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelTreasuryStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelTreasuryStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelTreasuryStreamResponse proto.InternalMessageInfo
+
 // MsgUpdateMembers is the Msg/UpdateMembers request type.
 type MsgUpdateMembers struct {
 	// authority is the address of the privileged account.
@@ -313,7 +483,7 @@ func (m *MsgUpdateMembers) Reset()         { *m = MsgUpdateMembers{} }
 func (m *MsgUpdateMembers) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateMembers) ProtoMessage()    {}
 func (*MsgUpdateMembers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{6}
+	return fileDescriptor_5ec2105611cae3ff, []int{10}
 }
 func (m *MsgUpdateMembers) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -350,7 +520,7 @@ func (m *MsgUpdateMembersResponse) Reset()         { *m = MsgUpdateMembersRespon
 func (m *MsgUpdateMembersResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateMembersResponse) ProtoMessage()    {}
 func (*MsgUpdateMembersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{7}
+	return fileDescriptor_5ec2105611cae3ff, []int{11}
 }
 func (m *MsgUpdateMembersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -391,7 +561,7 @@ func (m *MsgUpdateDecisionPolicy) Reset()         { *m = MsgUpdateDecisionPolicy
 func (m *MsgUpdateDecisionPolicy) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateDecisionPolicy) ProtoMessage()    {}
 func (*MsgUpdateDecisionPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{8}
+	return fileDescriptor_5ec2105611cae3ff, []int{12}
 }
 func (m *MsgUpdateDecisionPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -428,7 +598,7 @@ func (m *MsgUpdateDecisionPolicyResponse) Reset()         { *m = MsgUpdateDecisi
 func (m *MsgUpdateDecisionPolicyResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateDecisionPolicyResponse) ProtoMessage()    {}
 func (*MsgUpdateDecisionPolicyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{9}
+	return fileDescriptor_5ec2105611cae3ff, []int{13}
 }
 func (m *MsgUpdateDecisionPolicyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -470,13 +640,20 @@ type MsgSubmitProposal struct {
 	// whether it should be executed immediately on creation or not.
 	// If so, proposers signatures are considered as Yes votes.
 	Exec Exec `protobuf:"varint,4,opt,name=exec,proto3,enum=lbm.foundation.v1.Exec" json:"exec,omitempty"`
+	// deposit is the amount of coins deposited by the first proposer.
+	// It must not be less than the min_deposit of the params.
+	Deposit github_com_Finschia_finschia_sdk_types.Coins `protobuf:"bytes,5,rep,name=deposit,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coins" json:"deposit"`
+	// execute_at is the optional timestamp at which the proposal is executed
+	// automatically once accepted.
+	// It cannot be used with the exec of EXEC_TRY.
+	ExecuteAt *time.Time `protobuf:"bytes,6,opt,name=execute_at,json=executeAt,proto3,stdtime" json:"execute_at,omitempty"`
 }
 
 func (m *MsgSubmitProposal) Reset()         { *m = MsgSubmitProposal{} }
 func (m *MsgSubmitProposal) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitProposal) ProtoMessage()    {}
 func (*MsgSubmitProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{10}
+	return fileDescriptor_5ec2105611cae3ff, []int{14}
 }
 func (m *MsgSubmitProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -515,7 +692,7 @@ func (m *MsgSubmitProposalResponse) Reset()         { *m = MsgSubmitProposalResp
 func (m *MsgSubmitProposalResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitProposalResponse) ProtoMessage()    {}
 func (*MsgSubmitProposalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{11}
+	return fileDescriptor_5ec2105611cae3ff, []int{15}
 }
 func (m *MsgSubmitProposalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -556,7 +733,7 @@ func (m *MsgWithdrawProposal) Reset()         { *m = MsgWithdrawProposal{} }
 func (m *MsgWithdrawProposal) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawProposal) ProtoMessage()    {}
 func (*MsgWithdrawProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{12}
+	return fileDescriptor_5ec2105611cae3ff, []int{16}
 }
 func (m *MsgWithdrawProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -593,7 +770,7 @@ func (m *MsgWithdrawProposalResponse) Reset()         { *m = MsgWithdrawProposal
 func (m *MsgWithdrawProposalResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawProposalResponse) ProtoMessage()    {}
 func (*MsgWithdrawProposalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{13}
+	return fileDescriptor_5ec2105611cae3ff, []int{17}
 }
 func (m *MsgWithdrawProposalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -641,7 +818,7 @@ func (m *MsgVote) Reset()         { *m = MsgVote{} }
 func (m *MsgVote) String() string { return proto.CompactTextString(m) }
 func (*MsgVote) ProtoMessage()    {}
 func (*MsgVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{14}
+	return fileDescriptor_5ec2105611cae3ff, []int{18}
 }
 func (m *MsgVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -678,7 +855,7 @@ func (m *MsgVoteResponse) Reset()         { *m = MsgVoteResponse{} }
 func (m *MsgVoteResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgVoteResponse) ProtoMessage()    {}
 func (*MsgVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{15}
+	return fileDescriptor_5ec2105611cae3ff, []int{19}
 }
 func (m *MsgVoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -719,7 +896,7 @@ func (m *MsgExec) Reset()         { *m = MsgExec{} }
 func (m *MsgExec) String() string { return proto.CompactTextString(m) }
 func (*MsgExec) ProtoMessage()    {}
 func (*MsgExec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{16}
+	return fileDescriptor_5ec2105611cae3ff, []int{20}
 }
 func (m *MsgExec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -756,7 +933,7 @@ func (m *MsgExecResponse) Reset()         { *m = MsgExecResponse{} }
 func (m *MsgExecResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgExecResponse) ProtoMessage()    {}
 func (*MsgExecResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{17}
+	return fileDescriptor_5ec2105611cae3ff, []int{21}
 }
 func (m *MsgExecResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -795,7 +972,7 @@ func (m *MsgLeaveFoundation) Reset()         { *m = MsgLeaveFoundation{} }
 func (m *MsgLeaveFoundation) String() string { return proto.CompactTextString(m) }
 func (*MsgLeaveFoundation) ProtoMessage()    {}
 func (*MsgLeaveFoundation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{18}
+	return fileDescriptor_5ec2105611cae3ff, []int{22}
 }
 func (m *MsgLeaveFoundation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -832,7 +1009,7 @@ func (m *MsgLeaveFoundationResponse) Reset()         { *m = MsgLeaveFoundationRe
 func (m *MsgLeaveFoundationResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgLeaveFoundationResponse) ProtoMessage()    {}
 func (*MsgLeaveFoundationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{19}
+	return fileDescriptor_5ec2105611cae3ff, []int{23}
 }
 func (m *MsgLeaveFoundationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -873,7 +1050,7 @@ func (m *MsgUpdateCensorship) Reset()         { *m = MsgUpdateCensorship{} }
 func (m *MsgUpdateCensorship) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateCensorship) ProtoMessage()    {}
 func (*MsgUpdateCensorship) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{20}
+	return fileDescriptor_5ec2105611cae3ff, []int{24}
 }
 func (m *MsgUpdateCensorship) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -910,7 +1087,7 @@ func (m *MsgUpdateCensorshipResponse) Reset()         { *m = MsgUpdateCensorship
 func (m *MsgUpdateCensorshipResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateCensorshipResponse) ProtoMessage()    {}
 func (*MsgUpdateCensorshipResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{21}
+	return fileDescriptor_5ec2105611cae3ff, []int{25}
 }
 func (m *MsgUpdateCensorshipResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -946,13 +1123,16 @@ type MsgGrant struct {
 	Authority     string      `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 	Grantee       string      `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
 	Authorization *types1.Any `protobuf:"bytes,3,opt,name=authorization,proto3" json:"authorization,omitempty"`
+	// expiration is the time at which the grant expires.
+	// the grant never expires if it's not set.
+	Expiration *time.Time `protobuf:"bytes,4,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
 }
 
 func (m *MsgGrant) Reset()         { *m = MsgGrant{} }
 func (m *MsgGrant) String() string { return proto.CompactTextString(m) }
 func (*MsgGrant) ProtoMessage()    {}
 func (*MsgGrant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{22}
+	return fileDescriptor_5ec2105611cae3ff, []int{26}
 }
 func (m *MsgGrant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -989,7 +1169,7 @@ func (m *MsgGrantResponse) Reset()         { *m = MsgGrantResponse{} }
 func (m *MsgGrantResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgGrantResponse) ProtoMessage()    {}
 func (*MsgGrantResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{23}
+	return fileDescriptor_5ec2105611cae3ff, []int{27}
 }
 func (m *MsgGrantResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1030,7 +1210,7 @@ func (m *MsgRevoke) Reset()         { *m = MsgRevoke{} }
 func (m *MsgRevoke) String() string { return proto.CompactTextString(m) }
 func (*MsgRevoke) ProtoMessage()    {}
 func (*MsgRevoke) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{24}
+	return fileDescriptor_5ec2105611cae3ff, []int{28}
 }
 func (m *MsgRevoke) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1067,7 +1247,7 @@ func (m *MsgRevokeResponse) Reset()         { *m = MsgRevokeResponse{} }
 func (m *MsgRevokeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRevokeResponse) ProtoMessage()    {}
 func (*MsgRevokeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5ec2105611cae3ff, []int{25}
+	return fileDescriptor_5ec2105611cae3ff, []int{29}
 }
 func (m *MsgRevokeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1104,6 +1284,10 @@ func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "lbm.foundation.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "lbm.foundation.v1.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgWithdrawFromTreasuryResponse)(nil), "lbm.foundation.v1.MsgWithdrawFromTreasuryResponse")
+	proto.RegisterType((*MsgCreateTreasuryStream)(nil), "lbm.foundation.v1.MsgCreateTreasuryStream")
+	proto.RegisterType((*MsgCreateTreasuryStreamResponse)(nil), "lbm.foundation.v1.MsgCreateTreasuryStreamResponse")
+	proto.RegisterType((*MsgCancelTreasuryStream)(nil), "lbm.foundation.v1.MsgCancelTreasuryStream")
+	proto.RegisterType((*MsgCancelTreasuryStreamResponse)(nil), "lbm.foundation.v1.MsgCancelTreasuryStreamResponse")
 	proto.RegisterType((*MsgUpdateMembers)(nil), "lbm.foundation.v1.MsgUpdateMembers")
 	proto.RegisterType((*MsgUpdateMembersResponse)(nil), "lbm.foundation.v1.MsgUpdateMembersResponse")
 	proto.RegisterType((*MsgUpdateDecisionPolicy)(nil), "lbm.foundation.v1.MsgUpdateDecisionPolicy")
@@ -1129,79 +1313,93 @@ func init() {
 func init() { proto.RegisterFile("lbm/foundation/v1/tx.proto", fileDescriptor_5ec2105611cae3ff) }
 
 var fileDescriptor_5ec2105611cae3ff = []byte{
-	// 1148 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4d, 0x6f, 0xdb, 0x46,
-	0x13, 0x16, 0x6d, 0x45, 0xb1, 0x27, 0xb6, 0x6c, 0x33, 0xc6, 0x1b, 0x99, 0x49, 0x64, 0xbd, 0x4c,
-	0x1a, 0x18, 0x4e, 0x4c, 0xd6, 0x6e, 0x8b, 0x1e, 0x5a, 0xa0, 0x88, 0x1d, 0xab, 0x30, 0x10, 0x35,
-	0x2e, 0x63, 0xf7, 0xeb, 0x50, 0x61, 0x25, 0xae, 0x29, 0x36, 0x22, 0x97, 0xe5, 0x92, 0xaa, 0xd5,
-	0x1e, 0x7a, 0x2d, 0x7a, 0x28, 0xfa, 0x33, 0x8a, 0xa2, 0x87, 0x1e, 0x72, 0x6f, 0x8f, 0x46, 0x4e,
-	0x39, 0xf6, 0xd4, 0x0f, 0xfb, 0x8f, 0x14, 0x5c, 0x2e, 0xd7, 0xa4, 0x44, 0x7d, 0xb4, 0x40, 0x6f,
-	0xda, 0x9d, 0x67, 0x9e, 0x79, 0x66, 0x76, 0x38, 0x23, 0x50, 0xba, 0x2d, 0x47, 0x3f, 0x21, 0xa1,
-	0x6b, 0xa2, 0xc0, 0x26, 0xae, 0xde, 0xdb, 0xd6, 0x83, 0x53, 0xcd, 0xf3, 0x49, 0x40, 0xe4, 0x95,
-	0x6e, 0xcb, 0xd1, 0x2e, 0x6d, 0x5a, 0x6f, 0x5b, 0x59, 0xb5, 0x88, 0x45, 0x98, 0x55, 0x8f, 0x7e,
-	0xc5, 0x40, 0x45, 0x1d, 0x26, 0x49, 0xb9, 0xc5, 0x98, 0x6a, 0x9b, 0x50, 0x87, 0x50, 0xbd, 0x85,
-	0x28, 0xd6, 0x7b, 0xdb, 0x2d, 0x1c, 0xa0, 0x6d, 0xbd, 0x4d, 0xec, 0xc4, 0xbe, 0x66, 0x11, 0x62,
-	0x75, 0xb1, 0xce, 0x4e, 0xad, 0xf0, 0x44, 0x47, 0x6e, 0x3f, 0x31, 0xc5, 0xae, 0xcd, 0x38, 0x6e,
-	0x7c, 0x88, 0x4d, 0xea, 0x77, 0x12, 0x2c, 0x35, 0xa8, 0x55, 0x0f, 0x5d, 0xf3, 0xc8, 0xc7, 0x88,
-	0x86, 0x7e, 0x5f, 0x96, 0xa1, 0x78, 0xe2, 0x13, 0xa7, 0x22, 0xd5, 0xa4, 0x8d, 0x79, 0x83, 0xfd,
-	0x96, 0x2d, 0x28, 0x21, 0x87, 0x84, 0x6e, 0x50, 0x99, 0xa9, 0xcd, 0x6e, 0x5c, 0xdb, 0x59, 0xd3,
-	0x38, 0x4d, 0x24, 0x47, 0xe3, 0x72, 0xb4, 0x3d, 0x62, 0xbb, 0xbb, 0xaf, 0x9f, 0xfd, 0xbe, 0x5e,
-	0xf8, 0xf1, 0x8f, 0xf5, 0x07, 0x96, 0x1d, 0x74, 0xc2, 0x96, 0xd6, 0x26, 0x8e, 0x5e, 0xb7, 0x5d,
-	0xda, 0xee, 0xd8, 0x48, 0x3f, 0xe1, 0x3f, 0xb6, 0xa8, 0xf9, 0x4c, 0x0f, 0xfa, 0x1e, 0xa6, 0xcc,
-	0x89, 0x1a, 0x9c, 0x5e, 0x5d, 0x83, 0x1b, 0x03, 0x7a, 0x0c, 0x4c, 0x3d, 0xe2, 0x52, 0xac, 0xfe,
-	0x2c, 0x31, 0xdb, 0x87, 0x76, 0xd0, 0x31, 0x7d, 0xf4, 0x45, 0xdd, 0x27, 0x8e, 0xd0, 0x7c, 0x0b,
-	0xe6, 0x51, 0x18, 0x74, 0x88, 0x6f, 0x07, 0x7d, 0x2e, 0xfc, 0xf2, 0x42, 0x2e, 0xc3, 0x4c, 0x40,
-	0x2a, 0x33, 0xec, 0x7a, 0x26, 0x20, 0xa9, 0x6c, 0x66, 0xff, 0xdb, 0x6c, 0x3a, 0xac, 0xba, 0xc7,
-	0x9e, 0x89, 0x02, 0x7c, 0x88, 0x7c, 0xe4, 0xd0, 0x09, 0x4a, 0xdf, 0x84, 0x92, 0xc7, 0x70, 0x4c,
-	0x6d, 0xa4, 0x6c, 0xa8, 0x87, 0xb4, 0x98, 0x68, 0xb7, 0x18, 0x29, 0x33, 0x38, 0x9c, 0xd7, 0x2d,
-	0x1d, 0x49, 0xd4, 0xed, 0xff, 0xb0, 0x3e, 0xa2, 0x6c, 0x02, 0xf2, 0x35, 0x2c, 0x0b, 0xef, 0x06,
-	0x76, 0x5a, 0xd8, 0x9f, 0x24, 0xb4, 0x01, 0x65, 0x87, 0x01, 0x9b, 0x21, 0xf3, 0xa2, 0xbc, 0x31,
-	0x6a, 0x39, 0x82, 0x63, 0x46, 0x03, 0x7f, 0x1e, 0x62, 0x1a, 0x70, 0xdd, 0x8b, 0xb1, 0x77, 0x1c,
-	0x92, 0xaa, 0x0a, 0x54, 0x06, 0x05, 0x08, 0x71, 0xdf, 0x4a, 0xa9, 0xdc, 0x1e, 0xe1, 0xb6, 0x4d,
-	0x6d, 0xe2, 0x1e, 0x92, 0xae, 0xdd, 0x9e, 0xf4, 0xee, 0xef, 0xc3, 0x92, 0xc9, 0xf1, 0x4d, 0x8f,
-	0x39, 0xf0, 0xb2, 0xae, 0x6a, 0xf1, 0xd7, 0xa2, 0x25, 0x5f, 0x8b, 0xf6, 0xd0, 0xed, 0xef, 0xca,
-	0x2f, 0x9e, 0x6f, 0x95, 0xb3, 0x01, 0x8c, 0xb2, 0x99, 0x39, 0xf3, 0x62, 0xe6, 0x69, 0x11, 0x7a,
-	0x7f, 0x92, 0x60, 0xa5, 0x41, 0xad, 0xa7, 0x61, 0xcb, 0xb1, 0x83, 0x43, 0x9f, 0x78, 0x84, 0xa2,
-	0x6e, 0xa4, 0xd4, 0x63, 0xbf, 0xb1, 0x4f, 0x2b, 0x52, 0x6d, 0x36, 0x52, 0x2a, 0x2e, 0x64, 0x05,
-	0xe6, 0x1c, 0x1c, 0x20, 0x13, 0x05, 0x88, 0xf7, 0xa9, 0x38, 0xcb, 0xaf, 0x46, 0x36, 0x4a, 0x91,
-	0x85, 0x29, 0xef, 0xd7, 0x5c, 0xf9, 0x86, 0x40, 0xc9, 0xf7, 0xa1, 0x88, 0x4f, 0x71, 0xbb, 0x52,
-	0xac, 0x49, 0x1b, 0xe5, 0x9d, 0x1b, 0x39, 0x4f, 0xb2, 0x7f, 0x8a, 0xdb, 0x06, 0x03, 0xa9, 0x6f,
-	0xc3, 0xda, 0x90, 0xda, 0x24, 0x17, 0x79, 0x1d, 0xae, 0x79, 0xfc, 0xae, 0x69, 0x9b, 0xac, 0xc2,
-	0x45, 0x03, 0x92, 0xab, 0x03, 0x53, 0x3d, 0x84, 0xeb, 0xa9, 0xe6, 0x12, 0xd9, 0x4e, 0xf2, 0x93,
-	0x2b, 0x70, 0x15, 0x99, 0xa6, 0x8f, 0x29, 0xe5, 0xf9, 0x26, 0x47, 0xf5, 0x36, 0xdc, 0xcc, 0x61,
-	0x14, 0xd5, 0xfd, 0x55, 0x82, 0xab, 0x0d, 0x6a, 0x7d, 0x40, 0x82, 0xc9, 0xea, 0xe4, 0x55, 0xb8,
-	0xd2, 0x23, 0x01, 0xf6, 0x79, 0x8c, 0xf8, 0x20, 0xbf, 0x01, 0x25, 0xe2, 0x45, 0x95, 0xa8, 0xcc,
-	0xb2, 0x02, 0xdd, 0xce, 0x29, 0x50, 0xc4, 0xff, 0x84, 0x81, 0x0c, 0x0e, 0xce, 0xbc, 0x51, 0x71,
-	0xe0, 0x8d, 0x92, 0x8a, 0x5f, 0x99, 0xa6, 0xe2, 0x2b, 0x6c, 0x2a, 0x44, 0x11, 0x44, 0x56, 0xbb,
-	0x2c, 0xa9, 0x08, 0x33, 0x39, 0xa9, 0xff, 0x41, 0x89, 0xda, 0x96, 0x2b, 0xb2, 0xe2, 0x27, 0x4e,
-	0xcb, 0xe2, 0x24, 0xb4, 0x1a, 0xc8, 0x0d, 0x6a, 0x3d, 0xc6, 0xa8, 0x87, 0xeb, 0x42, 0x4d, 0xba,
-	0xf6, 0x52, 0xb6, 0xf6, 0xb7, 0x40, 0x19, 0xc6, 0x0b, 0xb6, 0x53, 0xf6, 0xd6, 0x71, 0xef, 0xef,
-	0x61, 0x97, 0x12, 0x9f, 0x76, 0x6c, 0x6f, 0xc2, 0x37, 0xb8, 0x07, 0xd0, 0x16, 0x58, 0xfe, 0xf9,
-	0xe5, 0x15, 0xfc, 0x92, 0x90, 0x4f, 0x88, 0x94, 0x1b, 0xef, 0x89, 0xc1, 0xc8, 0x42, 0xd8, 0x2f,
-	0x12, 0xcc, 0x35, 0xa8, 0xf5, 0xae, 0x8f, 0xdc, 0x60, 0x82, 0x9c, 0x0a, 0x5c, 0xb5, 0x22, 0x18,
-	0xc6, 0x49, 0xdf, 0xf1, 0xa3, 0xfc, 0x15, 0x2c, 0x72, 0xd8, 0x97, 0x48, 0x34, 0xc7, 0xa8, 0x51,
-	0xf1, 0xce, 0x8b, 0xe7, 0x5b, 0x6f, 0x4d, 0x5c, 0x09, 0xa7, 0xe9, 0x35, 0xfe, 0x30, 0x4d, 0x6e,
-	0x64, 0x63, 0xa9, 0x32, 0x1b, 0xc0, 0x2c, 0x01, 0x91, 0x15, 0x86, 0xf9, 0x06, 0xb5, 0x0c, 0xdc,
-	0x23, 0xcf, 0xf0, 0xbf, 0xce, 0xaa, 0x06, 0x0b, 0x0e, 0xb5, 0x9a, 0xd1, 0x72, 0x6a, 0x86, 0x7e,
-	0x97, 0x25, 0x35, 0x6f, 0x80, 0x43, 0xad, 0xa3, 0xbe, 0x87, 0x8f, 0xfd, 0xae, 0x7a, 0x9d, 0x4d,
-	0xab, 0x38, 0x4c, 0x12, 0x7b, 0x73, 0x13, 0x8a, 0xac, 0x19, 0x57, 0x61, 0x79, 0xff, 0xa3, 0xfd,
-	0xbd, 0xe6, 0xf1, 0x7b, 0x4f, 0x0f, 0xf7, 0xf7, 0x0e, 0xea, 0x07, 0xfb, 0x8f, 0x96, 0x0b, 0xf2,
-	0x02, 0xcc, 0xb1, 0xdb, 0x23, 0xe3, 0xe3, 0x65, 0x69, 0xe7, 0x9b, 0x79, 0x98, 0x6d, 0x50, 0x4b,
-	0xfe, 0x14, 0x16, 0x32, 0xff, 0x23, 0xd4, 0xbc, 0x55, 0x90, 0xdd, 0xed, 0xca, 0xe6, 0x64, 0x8c,
-	0x98, 0x45, 0x3d, 0x58, 0xcd, 0xdd, 0xfd, 0x23, 0x38, 0xf2, 0xb0, 0xca, 0xce, 0xf4, 0x58, 0x11,
-	0x17, 0xc1, 0x62, 0x76, 0x33, 0xde, 0xc9, 0x27, 0xc9, 0x80, 0x94, 0xfb, 0x53, 0x80, 0xd2, 0xa9,
-	0xe5, 0xae, 0xb7, 0xcd, 0x71, 0x24, 0x59, 0xec, 0xa8, 0xd4, 0xc6, 0xad, 0x2a, 0xd9, 0x84, 0xf2,
-	0xc0, 0x9a, 0xba, 0x9b, 0xcf, 0x92, 0x45, 0x29, 0x0f, 0xa6, 0x41, 0x89, 0x28, 0x9f, 0xc1, 0xf2,
-	0xd0, 0x82, 0xb8, 0x37, 0xfe, 0x21, 0x44, 0x24, 0x6d, 0x3a, 0x9c, 0x88, 0x55, 0x87, 0x22, 0x5b,
-	0x0d, 0x4a, 0xbe, 0x5f, 0x64, 0x53, 0xd4, 0xd1, 0xb6, 0x34, 0x0f, 0xfb, 0x00, 0x46, 0xf0, 0x44,
-	0xb6, 0x51, 0x3c, 0xe9, 0x09, 0x2c, 0x5b, 0xb0, 0x34, 0x38, 0x7e, 0x5f, 0xc9, 0x77, 0x1b, 0x80,
-	0x29, 0x5b, 0x53, 0xc1, 0xd2, 0x45, 0x1e, 0x9a, 0xcc, 0xf7, 0xc6, 0xb5, 0xc4, 0x25, 0x6e, 0x54,
-	0x91, 0x47, 0xcd, 0x5b, 0xf9, 0x00, 0xae, 0xc4, 0xb3, 0xf6, 0x66, 0xbe, 0x23, 0x33, 0x2a, 0x77,
-	0xc6, 0x18, 0x05, 0xd5, 0x63, 0x28, 0x25, 0x13, 0x2e, 0x1f, 0x1e, 0x5b, 0x95, 0xbb, 0xe3, 0xac,
-	0x09, 0xdb, 0xee, 0x93, 0xb3, 0xbf, 0xaa, 0x85, 0x1f, 0xce, 0xab, 0x85, 0xb3, 0xf3, 0xaa, 0xf4,
-	0xf2, 0xbc, 0x2a, 0xfd, 0x79, 0x5e, 0x95, 0xbe, 0xbf, 0xa8, 0x16, 0x5e, 0x5e, 0x54, 0x0b, 0xbf,
-	0x5d, 0x54, 0x0b, 0x9f, 0x6c, 0xfd, 0xa3, 0xa1, 0xdd, 0x2a, 0xb1, 0xa9, 0xff, 0xda, 0xdf, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x0a, 0x94, 0xad, 0x73, 0xe7, 0x0d, 0x00, 0x00,
+	// 1373 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4f, 0x6f, 0xdb, 0xc6,
+	0x12, 0x17, 0x65, 0x59, 0xb6, 0x26, 0x89, 0xe3, 0x6c, 0x8c, 0x17, 0x99, 0x49, 0x64, 0x3f, 0x26,
+	0x2f, 0x08, 0x9c, 0x58, 0x7a, 0xf6, 0x7b, 0x45, 0x0f, 0x29, 0x9a, 0x46, 0x8e, 0x5d, 0x18, 0x88,
+	0x1a, 0x97, 0x71, 0xfa, 0xef, 0x50, 0x61, 0x25, 0xae, 0x69, 0x36, 0x22, 0x97, 0xe5, 0x2e, 0x55,
+	0xbb, 0x3d, 0x14, 0xbd, 0xf6, 0x50, 0xe4, 0xd8, 0x8f, 0x50, 0xf4, 0xd4, 0x43, 0x3e, 0x40, 0x8f,
+	0x41, 0x4e, 0x39, 0xe6, 0xd4, 0xb4, 0xce, 0x97, 0xe8, 0xb1, 0xe0, 0x72, 0xb9, 0xa6, 0x24, 0x52,
+	0x72, 0x0a, 0xa4, 0x37, 0x72, 0xe7, 0x37, 0xbf, 0x99, 0x9d, 0x3f, 0x3b, 0x03, 0x7a, 0xaf, 0xe3,
+	0x36, 0xf6, 0x68, 0xe8, 0x59, 0x98, 0x3b, 0xd4, 0x6b, 0xf4, 0xd7, 0x1a, 0xfc, 0xa0, 0xee, 0x07,
+	0x94, 0x53, 0x74, 0xae, 0xd7, 0x71, 0xeb, 0xc7, 0xb2, 0x7a, 0x7f, 0x4d, 0x5f, 0xb0, 0xa9, 0x4d,
+	0x85, 0xb4, 0x11, 0x7d, 0xc5, 0x40, 0xdd, 0x18, 0x25, 0x49, 0xa9, 0xc5, 0x98, 0x5a, 0x97, 0x32,
+	0x97, 0xb2, 0x46, 0x07, 0x33, 0xd2, 0xe8, 0xaf, 0x75, 0x08, 0xc7, 0x6b, 0x8d, 0x2e, 0x75, 0x12,
+	0xf9, 0xa2, 0x4d, 0xa9, 0xdd, 0x23, 0x0d, 0xf1, 0xd7, 0x09, 0xf7, 0x1a, 0xd8, 0x3b, 0x4c, 0x54,
+	0x87, 0x45, 0x56, 0x18, 0xa4, 0xa9, 0x97, 0x86, 0xe5, 0xdc, 0x71, 0x09, 0xe3, 0xd8, 0xf5, 0x13,
+	0xee, 0xd8, 0x76, 0x3b, 0x76, 0x3c, 0xfe, 0x89, 0x45, 0xc6, 0x0f, 0x1a, 0x9c, 0x6d, 0x31, 0x7b,
+	0x2b, 0xf4, 0xac, 0xdd, 0x80, 0x60, 0x16, 0x06, 0x87, 0x08, 0x41, 0x69, 0x2f, 0xa0, 0x6e, 0x55,
+	0x5b, 0xd6, 0xae, 0x57, 0x4c, 0xf1, 0x8d, 0x6c, 0x28, 0x63, 0x97, 0x86, 0x1e, 0xaf, 0x16, 0x97,
+	0xa7, 0xae, 0x9f, 0x5a, 0x5f, 0xac, 0x4b, 0x9a, 0xe8, 0x3e, 0x75, 0x79, 0x9f, 0xfa, 0x06, 0x75,
+	0xbc, 0xe6, 0xff, 0x9f, 0xfe, 0xb6, 0x54, 0xf8, 0xf9, 0xe5, 0xd2, 0x4d, 0xdb, 0xe1, 0xfb, 0x61,
+	0xa7, 0xde, 0xa5, 0x6e, 0x63, 0xcb, 0xf1, 0x58, 0x77, 0xdf, 0xc1, 0x8d, 0x3d, 0xf9, 0xb1, 0xca,
+	0xac, 0x47, 0x0d, 0x7e, 0xe8, 0x13, 0x26, 0x94, 0x98, 0x29, 0xe9, 0x8d, 0x45, 0xb8, 0x30, 0xe4,
+	0x8f, 0x49, 0x98, 0x4f, 0x3d, 0x46, 0x8c, 0x5f, 0x34, 0x21, 0xfb, 0xd8, 0xe1, 0xfb, 0x56, 0x80,
+	0xbf, 0xda, 0x0a, 0xa8, 0xab, 0x7c, 0xbe, 0x04, 0x15, 0x1c, 0xf2, 0x7d, 0x1a, 0x38, 0xfc, 0x50,
+	0x3a, 0x7e, 0x7c, 0x80, 0xe6, 0xa0, 0xc8, 0x69, 0xb5, 0x28, 0x8e, 0x8b, 0x9c, 0xa6, 0x6e, 0x33,
+	0xf5, 0x66, 0x6f, 0xb3, 0x2f, 0xa2, 0xfb, 0xd0, 0xb7, 0x30, 0x27, 0x3b, 0x38, 0xc0, 0x2e, 0x9b,
+	0xe0, 0xe9, 0xdb, 0x50, 0xf6, 0x05, 0x4e, 0x78, 0x1b, 0x79, 0x36, 0x52, 0x84, 0xf5, 0x98, 0xa8,
+	0x59, 0x8a, 0x3c, 0x33, 0x25, 0x5c, 0xc6, 0x2d, 0x6d, 0x49, 0xc5, 0xed, 0xdf, 0xb0, 0x94, 0x13,
+	0x36, 0x05, 0x39, 0x2a, 0x0a, 0xf5, 0x8d, 0x80, 0x60, 0x4e, 0x12, 0xe9, 0x03, 0x1e, 0x10, 0xec,
+	0x4e, 0x70, 0xf8, 0x12, 0x54, 0x02, 0xd2, 0x75, 0x7c, 0x87, 0x88, 0xda, 0x10, 0x52, 0x75, 0xf0,
+	0x8f, 0x05, 0x1a, 0xdd, 0x82, 0xb2, 0x4f, 0x02, 0x87, 0x5a, 0xd5, 0x92, 0x8c, 0x5b, 0xdc, 0x14,
+	0xf5, 0xa4, 0x29, 0xea, 0x77, 0x65, 0xd3, 0x34, 0x67, 0x23, 0x43, 0x3f, 0xbe, 0x5c, 0xd2, 0x4c,
+	0xa9, 0x82, 0x08, 0x4c, 0x73, 0xca, 0x71, 0xaf, 0x3a, 0xfd, 0x66, 0x9c, 0x8c, 0xd9, 0x8d, 0x77,
+	0x45, 0x1e, 0xb2, 0x62, 0x9c, 0xe4, 0x01, 0x5d, 0x84, 0x0a, 0x13, 0x27, 0x6d, 0xc7, 0x12, 0xb1,
+	0x2e, 0x99, 0xb3, 0xf1, 0xc1, 0xb6, 0x65, 0xec, 0xc6, 0x39, 0xc2, 0x5e, 0x97, 0xf4, 0x5e, 0x2b,
+	0x47, 0x03, 0xac, 0xc5, 0x21, 0xd6, 0xb8, 0x3a, 0xb2, 0x58, 0x55, 0x75, 0x7c, 0x0b, 0xf3, 0xaa,
+	0xb6, 0x5a, 0xc4, 0xed, 0x90, 0x60, 0x52, 0x19, 0xb7, 0x60, 0xce, 0x15, 0xc0, 0x76, 0x28, 0xb4,
+	0x98, 0x7c, 0x36, 0x96, 0x33, 0xca, 0x39, 0x66, 0x34, 0xc9, 0x97, 0x21, 0x61, 0x5c, 0x56, 0xf5,
+	0x99, 0x58, 0x3b, 0x36, 0xc9, 0x0c, 0x1d, 0xaa, 0xc3, 0x0e, 0x28, 0xe7, 0xbe, 0xd7, 0x52, 0x95,
+	0x7f, 0x97, 0x74, 0x1d, 0xe6, 0x50, 0x6f, 0x87, 0xf6, 0x9c, 0xee, 0xa4, 0x57, 0xe1, 0x43, 0x38,
+	0x6b, 0x49, 0x7c, 0xdb, 0x17, 0x0a, 0xb2, 0xe9, 0x16, 0x46, 0x8a, 0xe7, 0x8e, 0x77, 0xd8, 0x44,
+	0xcf, 0x9e, 0xac, 0xce, 0x0d, 0x1a, 0x30, 0xe7, 0xac, 0x81, 0x7f, 0x19, 0xcc, 0x2c, 0x5f, 0x94,
+	0xbf, 0x2f, 0x8a, 0x70, 0xae, 0xc5, 0xec, 0x07, 0x61, 0xc7, 0x75, 0xf8, 0x4e, 0x40, 0x7d, 0xca,
+	0x70, 0x2f, 0xf2, 0xd4, 0x17, 0xdf, 0x24, 0x60, 0x55, 0x6d, 0x79, 0x2a, 0xf2, 0x54, 0x1d, 0x20,
+	0x1d, 0x66, 0x5d, 0xc2, 0xb1, 0x85, 0x39, 0x96, 0x3d, 0xa6, 0xfe, 0xd1, 0x7f, 0x23, 0x19, 0x63,
+	0xd8, 0x26, 0x4c, 0x36, 0x59, 0xa6, 0xfb, 0xa6, 0x42, 0xa1, 0x1b, 0x50, 0x22, 0x07, 0xa4, 0x2b,
+	0x3a, 0x65, 0x6e, 0xfd, 0x42, 0x46, 0x4a, 0x36, 0x0f, 0x48, 0xd7, 0x14, 0x20, 0xe4, 0xc0, 0x8c,
+	0x45, 0x7c, 0xca, 0x1c, 0xfe, 0xa6, 0xba, 0x23, 0xe1, 0x47, 0xb7, 0x01, 0x22, 0x93, 0x21, 0x27,
+	0x6d, 0xcc, 0xab, 0x65, 0x91, 0x0a, 0x7d, 0xe4, 0x2e, 0xbb, 0xc9, 0x70, 0x6b, 0x96, 0x1e, 0x47,
+	0x4d, 0x5c, 0x91, 0x3a, 0x77, 0xb8, 0xf1, 0x0e, 0x2c, 0x8e, 0x44, 0x56, 0xb5, 0xd6, 0x12, 0x9c,
+	0xf2, 0xe5, 0xd9, 0x71, 0x73, 0x41, 0x72, 0xb4, 0x6d, 0x19, 0x3b, 0x70, 0x3e, 0xf5, 0x4c, 0xaa,
+	0xcc, 0x4c, 0xd2, 0x43, 0x55, 0x98, 0xc1, 0x96, 0x15, 0x10, 0xc6, 0x64, 0x6e, 0x92, 0x5f, 0xe3,
+	0x32, 0x5c, 0xcc, 0x60, 0x54, 0x95, 0xf0, 0xab, 0x06, 0x33, 0x2d, 0x66, 0x7f, 0x44, 0xf9, 0x64,
+	0xef, 0xd0, 0x02, 0x4c, 0xf7, 0x29, 0x27, 0x81, 0xb4, 0x11, 0xff, 0xa0, 0xb7, 0xa0, 0x4c, 0xfd,
+	0x28, 0x6b, 0xd5, 0x29, 0x91, 0xcc, 0xcb, 0x19, 0xc9, 0x8c, 0xf8, 0xef, 0x0b, 0x90, 0x29, 0xc1,
+	0x03, 0xf5, 0x54, 0x1a, 0xaa, 0xa7, 0xa4, 0x3a, 0xa6, 0x4f, 0x50, 0x1d, 0xc6, 0x39, 0x31, 0xdf,
+	0x22, 0x0b, 0xea, 0x56, 0x4d, 0x71, 0xa9, 0x08, 0x33, 0xf9, 0x52, 0xff, 0x82, 0x32, 0x73, 0x6c,
+	0x4f, 0xdd, 0x4a, 0xfe, 0x49, 0x5a, 0x61, 0x27, 0xa1, 0xad, 0x03, 0x6a, 0x31, 0xfb, 0x1e, 0xc1,
+	0x7d, 0xb2, 0xa5, 0xbc, 0x49, 0xc7, 0x5e, 0x1b, 0x8c, 0xfd, 0x25, 0xd0, 0x47, 0xf1, 0x8a, 0xed,
+	0x40, 0xe4, 0x3a, 0xee, 0xd3, 0x0d, 0xe2, 0x31, 0x1a, 0xb0, 0x7d, 0xc7, 0x9f, 0xf0, 0x5e, 0x6c,
+	0x00, 0x74, 0x15, 0x56, 0x3e, 0x15, 0x59, 0x01, 0x3f, 0x26, 0x94, 0xaf, 0x59, 0x4a, 0x4d, 0xd6,
+	0xc4, 0xb0, 0x65, 0xe5, 0xd8, 0x77, 0x45, 0x98, 0x6d, 0x31, 0xfb, 0xfd, 0x00, 0x7b, 0x7c, 0x82,
+	0x3b, 0x55, 0x98, 0xb1, 0x23, 0x18, 0x21, 0x49, 0xdd, 0xc9, 0x5f, 0xf4, 0x0d, 0x9c, 0x91, 0xb0,
+	0xaf, 0xb1, 0x2a, 0x8e, 0xbc, 0x67, 0xed, 0xf6, 0xb3, 0x27, 0xab, 0xb7, 0x26, 0x36, 0xec, 0x41,
+	0x7a, 0xa3, 0xbd, 0x93, 0x26, 0x37, 0x07, 0x6d, 0xa1, 0xf7, 0xa2, 0x2e, 0xf6, 0x9d, 0x78, 0xd8,
+	0xca, 0x69, 0x3c, 0xb9, 0x8b, 0x53, 0x3a, 0x06, 0x12, 0xe3, 0x46, 0x84, 0x40, 0xc5, 0x85, 0x40,
+	0xa5, 0xc5, 0x6c, 0x93, 0xf4, 0xe9, 0x23, 0xf2, 0xb7, 0xe3, 0xb2, 0x0c, 0xa7, 0x5d, 0x66, 0xb7,
+	0xa3, 0xc7, 0xa7, 0x1d, 0x06, 0x3d, 0x11, 0x96, 0x8a, 0x09, 0x2e, 0xb3, 0x77, 0x0f, 0x7d, 0xf2,
+	0x30, 0xe8, 0x19, 0xe7, 0xc5, 0xdb, 0x1c, 0x9b, 0x49, 0x6c, 0xaf, 0xac, 0x40, 0x49, 0x94, 0xf3,
+	0x02, 0xcc, 0x6f, 0x7e, 0xb2, 0xb9, 0xd1, 0x7e, 0xf8, 0xc1, 0x83, 0x9d, 0xcd, 0x8d, 0xed, 0xad,
+	0xed, 0xcd, 0xbb, 0xf3, 0x05, 0x74, 0x1a, 0x66, 0xc5, 0xe9, 0xae, 0xf9, 0xe9, 0xbc, 0xb6, 0xfe,
+	0x27, 0xc0, 0x54, 0x8b, 0xd9, 0xe8, 0x73, 0x38, 0x3d, 0xb0, 0x53, 0x1b, 0x59, 0x83, 0x6f, 0x70,
+	0xcf, 0xd5, 0x57, 0x26, 0x63, 0xd4, 0x6b, 0xd6, 0x87, 0x85, 0xcc, 0x3d, 0x38, 0x87, 0x23, 0x0b,
+	0xab, 0xaf, 0x9f, 0x1c, 0x9b, 0xb6, 0x9b, 0xb9, 0x24, 0xe6, 0xd8, 0xcd, 0xc2, 0xe6, 0xd9, 0x1d,
+	0xbb, 0x18, 0x45, 0x76, 0xb3, 0x16, 0x9f, 0x3c, 0xbb, 0x19, 0xd8, 0x5c, 0xbb, 0x63, 0x56, 0x1f,
+	0x84, 0xe1, 0xcc, 0xe0, 0xde, 0x73, 0x25, 0x9b, 0x64, 0x00, 0xa4, 0xdf, 0x38, 0x01, 0x28, 0x7d,
+	0xb5, 0xcc, 0xe5, 0x65, 0x65, 0x1c, 0xc9, 0x20, 0x36, 0xef, 0x6a, 0xe3, 0x16, 0x11, 0x64, 0xc1,
+	0xdc, 0xd0, 0x12, 0x72, 0x35, 0x9b, 0x65, 0x10, 0xa5, 0xdf, 0x3c, 0x09, 0x4a, 0x59, 0xf9, 0x02,
+	0xe6, 0x47, 0x46, 0xea, 0xb5, 0xf1, 0x85, 0xa7, 0x2c, 0xd5, 0x4f, 0x86, 0x53, 0xb6, 0xb6, 0xa0,
+	0x24, 0x86, 0xa9, 0x9e, 0xad, 0x17, 0xc9, 0x74, 0x23, 0x5f, 0x96, 0xe6, 0x11, 0x0d, 0x9f, 0xc3,
+	0x13, 0xc9, 0xf2, 0x78, 0xd2, 0x33, 0x0b, 0xd9, 0x70, 0x76, 0x78, 0x60, 0xfd, 0x27, 0x5b, 0x6d,
+	0x08, 0xa6, 0xaf, 0x9e, 0x08, 0x96, 0x0e, 0xf2, 0xc8, 0x2c, 0xbb, 0x36, 0xae, 0x24, 0x8e, 0x71,
+	0x79, 0x41, 0xce, 0x9b, 0x50, 0x68, 0x1b, 0xa6, 0xe3, 0xe9, 0x74, 0x31, 0x5b, 0x51, 0x08, 0xf5,
+	0x2b, 0x63, 0x84, 0x8a, 0xea, 0x1e, 0x94, 0x93, 0x17, 0x3d, 0x1b, 0x1e, 0x4b, 0xf5, 0xab, 0xe3,
+	0xa4, 0x09, 0x5b, 0xf3, 0xfe, 0xd3, 0x3f, 0x6a, 0x85, 0x9f, 0x8e, 0x6a, 0x85, 0xa7, 0x47, 0x35,
+	0xed, 0xf9, 0x51, 0x4d, 0xfb, 0xfd, 0xa8, 0xa6, 0x3d, 0x7e, 0x55, 0x2b, 0x3c, 0x7f, 0x55, 0x2b,
+	0xbc, 0x78, 0x55, 0x2b, 0x7c, 0xb6, 0xfa, 0x5a, 0x63, 0xae, 0x53, 0x16, 0xd3, 0xea, 0x7f, 0x7f,
+	0x05, 0x00, 0x00, 0xff, 0xff, 0x53, 0x63, 0xc8, 0xe0, 0x24, 0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1220,6 +1418,10 @@ type MsgClient interface {
 	FundTreasury(ctx context.Context, in *MsgFundTreasury, opts ...grpc.CallOption) (*MsgFundTreasuryResponse, error)
 	// WithdrawFromTreasury defines a method to withdraw coins from the treasury.
 	WithdrawFromTreasury(ctx context.Context, in *MsgWithdrawFromTreasury, opts ...grpc.CallOption) (*MsgWithdrawFromTreasuryResponse, error)
+	// CreateTreasuryStream defines a method to create a stream paying from the treasury.
+	CreateTreasuryStream(ctx context.Context, in *MsgCreateTreasuryStream, opts ...grpc.CallOption) (*MsgCreateTreasuryStreamResponse, error)
+	// CancelTreasuryStream defines a method to cancel a treasury stream.
+	CancelTreasuryStream(ctx context.Context, in *MsgCancelTreasuryStream, opts ...grpc.CallOption) (*MsgCancelTreasuryStreamResponse, error)
 	// UpdateMembers updates the foundation members.
 	UpdateMembers(ctx context.Context, in *MsgUpdateMembers, opts ...grpc.CallOption) (*MsgUpdateMembersResponse, error)
 	// UpdateDecisionPolicy allows a group policy's decision policy to be updated.
@@ -1271,6 +1473,24 @@ func (c *msgClient) WithdrawFromTreasury(ctx context.Context, in *MsgWithdrawFro
 	return out, nil
 }
 
+func (c *msgClient) CreateTreasuryStream(ctx context.Context, in *MsgCreateTreasuryStream, opts ...grpc.CallOption) (*MsgCreateTreasuryStreamResponse, error) {
+	out := new(MsgCreateTreasuryStreamResponse)
+	err := c.cc.Invoke(ctx, "/lbm.foundation.v1.Msg/CreateTreasuryStream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelTreasuryStream(ctx context.Context, in *MsgCancelTreasuryStream, opts ...grpc.CallOption) (*MsgCancelTreasuryStreamResponse, error) {
+	out := new(MsgCancelTreasuryStreamResponse)
+	err := c.cc.Invoke(ctx, "/lbm.foundation.v1.Msg/CancelTreasuryStream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateMembers(ctx context.Context, in *MsgUpdateMembers, opts ...grpc.CallOption) (*MsgUpdateMembersResponse, error) {
 	out := new(MsgUpdateMembersResponse)
 	err := c.cc.Invoke(ctx, "/lbm.foundation.v1.Msg/UpdateMembers", in, out, opts...)
@@ -1367,6 +1587,10 @@ type MsgServer interface {
 	FundTreasury(context.Context, *MsgFundTreasury) (*MsgFundTreasuryResponse, error)
 	// WithdrawFromTreasury defines a method to withdraw coins from the treasury.
 	WithdrawFromTreasury(context.Context, *MsgWithdrawFromTreasury) (*MsgWithdrawFromTreasuryResponse, error)
+	// CreateTreasuryStream defines a method to create a stream paying from the treasury.
+	CreateTreasuryStream(context.Context, *MsgCreateTreasuryStream) (*MsgCreateTreasuryStreamResponse, error)
+	// CancelTreasuryStream defines a method to cancel a treasury stream.
+	CancelTreasuryStream(context.Context, *MsgCancelTreasuryStream) (*MsgCancelTreasuryStreamResponse, error)
 	// UpdateMembers updates the foundation members.
 	UpdateMembers(context.Context, *MsgUpdateMembers) (*MsgUpdateMembersResponse, error)
 	// UpdateDecisionPolicy allows a group policy's decision policy to be updated.
@@ -1402,6 +1626,12 @@ func (*UnimplementedMsgServer) FundTreasury(ctx context.Context, req *MsgFundTre
 func (*UnimplementedMsgServer) WithdrawFromTreasury(ctx context.Context, req *MsgWithdrawFromTreasury) (*MsgWithdrawFromTreasuryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFromTreasury not implemented")
 }
+func (*UnimplementedMsgServer) CreateTreasuryStream(ctx context.Context, req *MsgCreateTreasuryStream) (*MsgCreateTreasuryStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTreasuryStream not implemented")
+}
+func (*UnimplementedMsgServer) CancelTreasuryStream(ctx context.Context, req *MsgCancelTreasuryStream) (*MsgCancelTreasuryStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTreasuryStream not implemented")
+}
 func (*UnimplementedMsgServer) UpdateMembers(ctx context.Context, req *MsgUpdateMembers) (*MsgUpdateMembersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateMembers not implemented")
 }
@@ -1473,6 +1703,42 @@ func _Msg_WithdrawFromTreasury_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateTreasuryStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateTreasuryStream)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateTreasuryStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.foundation.v1.Msg/CreateTreasuryStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateTreasuryStream(ctx, req.(*MsgCreateTreasuryStream))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelTreasuryStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelTreasuryStream)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelTreasuryStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.foundation.v1.Msg/CancelTreasuryStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelTreasuryStream(ctx, req.(*MsgCancelTreasuryStream))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateMembers)
 	if err := dec(in); err != nil {
@@ -1665,6 +1931,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "WithdrawFromTreasury",
 			Handler:    _Msg_WithdrawFromTreasury_Handler,
 		},
+		{
+			MethodName: "CreateTreasuryStream",
+			Handler:    _Msg_CreateTreasuryStream_Handler,
+		},
+		{
+			MethodName: "CancelTreasuryStream",
+			Handler:    _Msg_CancelTreasuryStream_Handler,
+		},
 		{
 			MethodName: "UpdateMembers",
 			Handler:    _Msg_UpdateMembers_Handler,
@@ -1914,7 +2188,7 @@ func (m *MsgWithdrawFromTreasuryResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateMembers) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateTreasuryStream) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1924,20 +2198,20 @@ func (m *MsgUpdateMembers) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateMembers) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateTreasuryStream) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateMembers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateTreasuryStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.MemberUpdates) > 0 {
-		for iNdEx := len(m.MemberUpdates) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.MemberUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1945,9 +2219,38 @@ func (m *MsgUpdateMembers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintTx(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x2a
+		}
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Authority) > 0 {
 		i -= len(m.Authority)
 		copy(dAtA[i:], m.Authority)
@@ -1958,7 +2261,7 @@ func (m *MsgUpdateMembers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateMembersResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateTreasuryStreamResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1968,20 +2271,25 @@ func (m *MsgUpdateMembersResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateMembersResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateTreasuryStreamResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateMembersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateTreasuryStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.StreamId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateDecisionPolicy) Marshal() (dAtA []byte, err error) {
+func (m *MsgCancelTreasuryStream) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1991,27 +2299,20 @@ func (m *MsgUpdateDecisionPolicy) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateDecisionPolicy) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCancelTreasuryStream) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCancelTreasuryStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DecisionPolicy != nil {
-		{
-			size, err := m.DecisionPolicy.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
+	if m.StreamId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StreamId))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
 	if len(m.Authority) > 0 {
 		i -= len(m.Authority)
@@ -2023,7 +2324,7 @@ func (m *MsgUpdateDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateDecisionPolicyResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCancelTreasuryStreamResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2033,12 +2334,12 @@ func (m *MsgUpdateDecisionPolicyResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateDecisionPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCancelTreasuryStreamResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateDecisionPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCancelTreasuryStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2046,7 +2347,7 @@ func (m *MsgUpdateDecisionPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitProposal) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateMembers) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2056,25 +2357,20 @@ func (m *MsgSubmitProposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateMembers) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateMembers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Exec != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Exec))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Messages) > 0 {
-		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.MemberUpdates) > 0 {
+		for iNdEx := len(m.MemberUpdates) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.MemberUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2082,24 +2378,185 @@ func (m *MsgSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintTx(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Proposers) > 0 {
-		for iNdEx := len(m.Proposers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Proposers[iNdEx])
-			copy(dAtA[i:], m.Proposers[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Proposers[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMembersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMembersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMembersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDecisionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDecisionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DecisionPolicy != nil {
+		{
+			size, err := m.DecisionPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDecisionPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDecisionPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDecisionPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExecuteAt != nil {
+		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExecuteAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExecuteAt):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintTx(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Exec != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Exec))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proposers) > 0 {
+		for iNdEx := len(m.Proposers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proposers[iNdEx])
+			copy(dAtA[i:], m.Proposers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Proposers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
@@ -2459,6 +2916,16 @@ func (m *MsgGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Expiration != nil {
+		n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintTx(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Authorization != nil {
 		{
 			size, err := m.Authorization.MarshalToSizedBuffer(dAtA[:i])
@@ -2673,6 +3140,74 @@ func (m *MsgWithdrawFromTreasuryResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCreateTreasuryStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateTreasuryStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		n += 1 + sovTx(uint64(m.StreamId))
+	}
+	return n
+}
+
+func (m *MsgCancelTreasuryStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StreamId != 0 {
+		n += 1 + sovTx(uint64(m.StreamId))
+	}
+	return n
+}
+
+func (m *MsgCancelTreasuryStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgUpdateMembers) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2752,6 +3287,16 @@ func (m *MsgSubmitProposal) Size() (n int) {
 	if m.Exec != 0 {
 		n += 1 + sovTx(uint64(m.Exec))
 	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.ExecuteAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExecuteAt)
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2916,6 +3461,10 @@ func (m *MsgGrant) Size() (n int) {
 		l = m.Authorization.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.Expiration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -3149,21 +3698,448 @@ func (m *MsgWithdrawFromTreasury) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawFromTreasury: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawFromTreasury: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawFromTreasury: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawFromTreasury: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawFromTreasuryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawFromTreasuryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawFromTreasuryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateTreasuryStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateTreasuryStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateTreasuryStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3173,29 +4149,31 @@ func (m *MsgWithdrawFromTreasury) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3205,27 +4183,28 @@ func (m *MsgWithdrawFromTreasury) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3252,8 +4231,8 @@ func (m *MsgWithdrawFromTreasury) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Total = append(m.Total, types.Coin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3278,7 +4257,7 @@ func (m *MsgWithdrawFromTreasury) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateTreasuryStreamResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3301,49 +4280,17 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateTreasuryStreamResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateTreasuryStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
 			}
-			var msglen int
+			m.StreamId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3353,25 +4300,11 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.StreamId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3393,7 +4326,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelTreasuryStream) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3416,12 +4349,63 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelTreasuryStream: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelTreasuryStream: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			m.StreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3443,7 +4427,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawFromTreasuryResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelTreasuryStreamResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3466,10 +4450,10 @@ func (m *MsgWithdrawFromTreasuryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawFromTreasuryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelTreasuryStreamResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawFromTreasuryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelTreasuryStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3973,6 +4957,76 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecuteAt == nil {
+				m.ExecuteAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExecuteAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5012,6 +6066,42 @@ func (m *MsgGrant) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
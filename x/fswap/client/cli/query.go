@@ -25,6 +25,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		CmdQuerySwapped(),
 		CmdQueryTotalSwappableAmount(),
 		CmdQuerySwaps(),
+		CmdQuerySwapAudits(),
 	)
 	return cmd
 }
@@ -110,3 +111,33 @@ func CmdQuerySwaps() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdQuerySwapAudits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-audits",
+		Short: "shows the consistency checks of all the swaps",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SwapAudits(cmd.Context(), &types.QuerySwapAuditsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "swap-audits")
+	return cmd
+}
@@ -3,6 +3,8 @@ package cli
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -20,6 +22,10 @@ const (
 	FlagToDenom             = "to-denom"
 	FlagAmountCapForToDenom = "to-coin-amount-cap"
 	FlagSwapRate            = "swap-rate"
+	FlagRateSchedule        = "rate-schedule"
+	FlagStartTime           = "start-time"
+	FlagEndTime             = "end-time"
+	FlagReverseSwapEndTime  = "reverse-swap-end-time"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -35,6 +41,8 @@ func GetTxCmd() *cobra.Command {
 		CmdTxMsgSwap(),
 		CmdTxMsgSwapAll(),
 		CmdMsgSetSwap(),
+		CmdMsgCloseSwap(),
+		CmdTxMsgReverseSwap(),
 	)
 
 	return cmd
@@ -195,6 +203,21 @@ Example of the content of metadata-json:
 				SwapRate:            swapRateDec,
 			}
 
+			rateSchedule, err := cmd.Flags().GetString(FlagRateSchedule)
+			if err != nil {
+				return err
+			}
+			if swap.RateSchedule, err = parseRateSchedule(rateSchedule); err != nil {
+				return err
+			}
+
+			if swap.StartTime, err = parseTimeFlag(cmd, FlagStartTime); err != nil {
+				return err
+			}
+			if swap.EndTime, err = parseTimeFlag(cmd, FlagEndTime); err != nil {
+				return err
+			}
+
 			authority := args[0]
 			toDenomMetadata, err := parseToDenomMetadata(args[1])
 			if err != nil {
@@ -215,6 +238,97 @@ Example of the content of metadata-json:
 	cmd.Flags().String(FlagToDenom, "", "set toDenom string, ex) peb")
 	cmd.Flags().String(FlagAmountCapForToDenom, "0", "set integer value for limit cap for the amount to swap to to-denom, ex 1000000000")
 	cmd.Flags().String(FlagSwapRate, "0", "set swap rate for swap from fromDenom to toDenom, ex(rate for cony to peb)  148079656000000")
+	cmd.Flags().String(FlagRateSchedule, "", "set comma-separated phases of start-time=swap-rate replacing the swap rate over time, ex) 2024-01-01T00:00:00Z=150,2024-02-01T00:00:00Z=149")
+	cmd.Flags().String(FlagStartTime, "", "set the time from which the swap is available in RFC3339 format, ex) 2024-01-01T00:00:00Z")
+	cmd.Flags().String(FlagEndTime, "", "set the time from which the swap is rejected in RFC3339 format, ex) 2024-12-31T00:00:00Z")
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdMsgCloseSwap implements a command handler for submitting a swap close proposal transaction.
+func CmdMsgCloseSwap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "close-swap [authority]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Close a swap",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateGenerateOnly(cmd); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromDenom, err := cmd.Flags().GetString(FlagFromDenom)
+			if err != nil {
+				return err
+			}
+
+			toDenom, err := cmd.Flags().GetString(FlagToDenom)
+			if err != nil {
+				return err
+			}
+
+			reverseSwapEndTime, err := parseTimeFlag(cmd, FlagReverseSwapEndTime)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgCloseSwap{
+				Authority:          args[0],
+				FromDenom:          fromDenom,
+				ToDenom:            toDenom,
+				ReverseSwapEndTime: reverseSwapEndTime,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagFromDenom, "", "set fromDenom string, ex) cony")
+	cmd.Flags().String(FlagToDenom, "", "set toDenom string, ex) peb")
+	cmd.Flags().String(FlagReverseSwapEndTime, "", "set the time until which the reverse swap is allowed in RFC3339 format, ex) 2024-12-31T00:00:00Z")
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdTxMsgReverseSwap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reverse-swap [from] [to_coin_amount] [from_denom]",
+		Short: "swap amount of to-coin of a closed swap back to from-coin",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			from := args[0]
+			if err := cmd.Flags().Set(flags.FlagFrom, from); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgReverseSwap{
+				FromAddress:  clientCtx.GetFromAddress().String(),
+				ToCoinAmount: amount,
+				FromDenom:    args[2],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
 
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
@@ -231,6 +345,50 @@ func validateGenerateOnly(cmd *cobra.Command) error {
 	return nil
 }
 
+func parseRateSchedule(rateSchedule string) ([]types.SwapRatePhase, error) {
+	phases := []types.SwapRatePhase{}
+	if len(rateSchedule) == 0 {
+		return phases, nil
+	}
+
+	for _, phaseStr := range strings.Split(rateSchedule, ",") {
+		kv := strings.Split(phaseStr, "=")
+		if len(kv) != 2 {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid phase %s", phaseStr)
+		}
+
+		startTime, err := time.Parse(time.RFC3339, kv[0])
+		if err != nil {
+			return nil, err
+		}
+
+		swapRate, err := sdk.NewDecFromStr(kv[1])
+		if err != nil {
+			return nil, err
+		}
+
+		phases = append(phases, types.SwapRatePhase{StartTime: startTime, SwapRate: swapRate})
+	}
+
+	return phases, nil
+}
+
+func parseTimeFlag(cmd *cobra.Command, flag string) (*time.Time, error) {
+	timeStr, err := cmd.Flags().GetString(flag)
+	if err != nil {
+		return nil, err
+	}
+	if len(timeStr) == 0 {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, timeStr)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
 func parseToDenomMetadata(jsonDenomMetadata string) (bank.Metadata, error) {
 	type toDenomMeta struct {
 		Metadata bank.Metadata `json:"metadata"`
@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/Finschia/finschia-sdk/store/prefix"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/fswap/types"
+)
+
+// CloseSwap retires the swap so that no further swaps happen. The reverse swap at the inverse of the highest swap rate
+// the swap has ever had is allowed until reverseSwapEndTime if it is given.
+func (k Keeper) CloseSwap(ctx sdk.Context, fromDenom, toDenom string, reverseSwapEndTime *time.Time) error {
+	if _, err := k.getSwap(ctx, fromDenom, toDenom); err != nil {
+		return err
+	}
+
+	ledger, err := k.getSwapLedger(ctx, fromDenom, toDenom)
+	if err != nil {
+		return err
+	}
+
+	if _, closed := k.getSwapClosure(ctx, fromDenom, toDenom); closed {
+		return types.ErrSwapClosed.Wrapf("swap from %s to %s", fromDenom, toDenom)
+	}
+
+	if reverseSwapEndTime != nil {
+		if k.config.MaxReverseSwapPeriod == 0 {
+			return types.ErrReverseSwapNotAllowed
+		}
+		if !reverseSwapEndTime.After(ctx.BlockTime()) {
+			return sdkerrors.ErrInvalidRequest.Wrap("reverse swap end time must be in the future")
+		}
+		if reverseSwapEndTime.After(ctx.BlockTime().Add(k.config.MaxReverseSwapPeriod)) {
+			return sdkerrors.ErrInvalidRequest.Wrapf("reverse swap cannot be allowed for more than %s", k.config.MaxReverseSwapPeriod)
+		}
+	}
+
+	closure := types.SwapClosure{
+		FromDenom:          fromDenom,
+		ToDenom:            toDenom,
+		ClosedAt:           ctx.BlockTime(),
+		SwapRate:           ledger.MaxSwapRate,
+		ReverseSwapEndTime: reverseSwapEndTime,
+	}
+	if err := k.setSwapClosure(ctx, closure); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventCloseSwap{
+		Closure: closure,
+	})
+}
+
+// ReverseSwap swaps the to-coin of a closed swap back to the from-coin at the inverse rate.
+func (k Keeper) ReverseSwap(ctx sdk.Context, addr sdk.AccAddress, toCoinAmount sdk.Coin, fromDenom string) error {
+	closure, closed := k.getSwapClosure(ctx, fromDenom, toCoinAmount.Denom)
+	if !closed {
+		return types.ErrReverseSwapNotAllowed.Wrapf("swap from %s to %s has not been closed", fromDenom, toCoinAmount.Denom)
+	}
+
+	if !closure.IsReverseSwapAllowed(ctx.BlockTime()) {
+		return types.ErrReverseSwapNotAllowed.Wrapf("reverse swap from %s to %s is not allowed", toCoinAmount.Denom, fromDenom)
+	}
+
+	fromCoinAmount := sdk.NewCoin(fromDenom, toCoinAmount.Amount.ToDec().QuoTruncate(closure.SwapRate).TruncateInt())
+	if !fromCoinAmount.IsPositive() {
+		return sdkerrors.ErrInvalidRequest.Wrapf("%s is too small to be swapped back", toCoinAmount)
+	}
+
+	swapped, err := k.getSwapped(ctx, fromDenom, toCoinAmount.Denom)
+	if err != nil {
+		return err
+	}
+
+	if swapped.FromCoinAmount.IsLT(fromCoinAmount) || swapped.ToCoinAmount.IsLT(toCoinAmount) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("cannot swap back more than the swapped amount %s", swapped.ToCoinAmount)
+	}
+
+	if err := k.setSwapped(ctx, types.Swapped{
+		FromCoinAmount: swapped.FromCoinAmount.Sub(fromCoinAmount),
+		ToCoinAmount:   swapped.ToCoinAmount.Sub(toCoinAmount),
+	}); err != nil {
+		return err
+	}
+
+	if err := k.SendCoinsFromAccountToModule(ctx, addr, types.ModuleName, sdk.NewCoins(toCoinAmount)); err != nil {
+		return err
+	}
+
+	if err := k.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(toCoinAmount)); err != nil {
+		return err
+	}
+
+	if err := k.MintCoins(ctx, types.ModuleName, sdk.NewCoins(fromCoinAmount)); err != nil {
+		return err
+	}
+
+	if err := k.recordReverseSwap(ctx, toCoinAmount, fromCoinAmount); err != nil {
+		return err
+	}
+
+	if err := k.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, sdk.NewCoins(fromCoinAmount)); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventReverseSwapCoins{
+		Address:        addr.String(),
+		ToCoinAmount:   toCoinAmount,
+		FromCoinAmount: fromCoinAmount,
+	})
+}
+
+func (k Keeper) setSwapClosure(ctx sdk.Context, closure types.SwapClosure) error {
+	key := swapClosureKey(closure.FromDenom, closure.ToDenom)
+	bz, err := k.cdc.Marshal(&closure)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(key, bz)
+	return nil
+}
+
+func (k Keeper) getSwapClosure(ctx sdk.Context, fromDenom, toDenom string) (types.SwapClosure, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(swapClosureKey(fromDenom, toDenom))
+	if bz == nil {
+		return types.SwapClosure{}, false
+	}
+
+	closure := types.SwapClosure{}
+	k.cdc.MustUnmarshal(bz, &closure)
+	return closure, true
+}
+
+func (k Keeper) getAllSwapClosures(ctx sdk.Context) []types.SwapClosure {
+	closures := []types.SwapClosure{}
+	store := ctx.KVStore(k.storeKey)
+	closureStore := prefix.NewStore(store, swapClosurePrefix)
+
+	iterator := closureStore.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		closure := types.SwapClosure{}
+		k.cdc.MustUnmarshal(iterator.Value(), &closure)
+		closures = append(closures, closure)
+	}
+	return closures
+}
+
+// activePhase returns the phase of the swap at the current block time, taking its closure into account.
+func (k Keeper) activePhase(ctx sdk.Context, swap types.Swap) types.ActiveSwapPhase {
+	phase := swap.ActivePhaseAt(ctx.BlockTime())
+	if closure, closed := k.getSwapClosure(ctx, swap.FromDenom, swap.ToDenom); closed {
+		phase.Status = types.SwapStatusClosed
+		phase.SwapRate = closure.SwapRate
+	}
+	return phase
+}
@@ -0,0 +1,220 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/fswap/keeper"
+	"github.com/Finschia/finschia-sdk/x/fswap/types"
+)
+
+func (s *KeeperTestSuite) TestCloseSwap() {
+	now := time.Unix(1_700_000_000, 0).UTC()
+	swap := s.swap
+	swap.SwapRate = sdk.NewDec(2)
+	swap.AmountCapForToDenom = sdk.NewInt(1000000)
+
+	testCases := map[string]struct {
+		authority          string
+		fromDenom          string
+		reverseSwapEndTime *time.Time
+		expectedError      error
+	}{
+		"close without reverse swap": {
+			types.DefaultAuthority().String(),
+			swap.FromDenom,
+			nil,
+			nil,
+		},
+		"close with reverse swap": {
+			types.DefaultAuthority().String(),
+			swap.FromDenom,
+			timePtr(now.Add(time.Hour)),
+			nil,
+		},
+		"invalid authority": {
+			s.accWithFromCoin.String(),
+			swap.FromDenom,
+			nil,
+			sdkerrors.ErrUnauthorized,
+		},
+		"swap not found": {
+			types.DefaultAuthority().String(),
+			"unknown",
+			nil,
+			sdkerrors.ErrNotFound,
+		},
+		"reverse swap end time in the past": {
+			types.DefaultAuthority().String(),
+			swap.FromDenom,
+			timePtr(now),
+			sdkerrors.ErrInvalidRequest,
+		},
+		"reverse swap period too long": {
+			types.DefaultAuthority().String(),
+			swap.FromDenom,
+			timePtr(now.Add(types.DefaultConfig().MaxReverseSwapPeriod + time.Second)),
+			sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			ctx = ctx.WithBlockTime(now)
+			s.Require().NoError(s.keeper.SetSwap(ctx, swap, s.toDenomMetadata))
+
+			_, err := s.msgServer.CloseSwap(sdk.WrapSDKContext(ctx), &types.MsgCloseSwap{
+				Authority:          tc.authority,
+				FromDenom:          tc.fromDenom,
+				ToDenom:            swap.ToDenom,
+				ReverseSwapEndTime: tc.reverseSwapEndTime,
+			})
+			if tc.expectedError != nil {
+				s.Require().ErrorIs(err, tc.expectedError)
+				return
+			}
+			s.Require().NoError(err)
+
+			err = s.keeper.Swap(ctx, s.accWithFromCoin, sdk.NewCoin(swap.FromDenom, sdk.NewInt(100)), swap.ToDenom)
+			s.Require().ErrorIs(err, types.ErrSwapClosed)
+			err = s.keeper.SetSwap(ctx, swap, s.toDenomMetadata)
+			s.Require().ErrorIs(err, types.ErrSwapClosed)
+
+			res, err := s.queryServer.Swapped(sdk.WrapSDKContext(ctx), &types.QuerySwappedRequest{FromDenom: swap.FromDenom, ToDenom: swap.ToDenom})
+			s.Require().NoError(err)
+			s.Require().Equal(types.SwapStatusClosed, res.ActivePhase.Status)
+			s.Require().Equal(&types.SwapClosure{
+				FromDenom:          swap.FromDenom,
+				ToDenom:            swap.ToDenom,
+				ClosedAt:           now,
+				SwapRate:           swap.SwapRate,
+				ReverseSwapEndTime: tc.reverseSwapEndTime,
+			}, res.Closure)
+
+			// the closed swap still counts towards the max swaps
+			newSwap := swap
+			newSwap.FromDenom = "newdenom"
+			cacheCtx, _ := ctx.CacheContext()
+			err = s.keeper.SetSwap(cacheCtx, newSwap, s.toDenomMetadata)
+			s.Require().ErrorIs(err, types.ErrCanNotHaveMoreSwap)
+
+			for _, invariant := range []sdk.Invariant{keeper.SwapCountInvariant(s.keeper), keeper.SwappedSupplyInvariant(s.keeper), keeper.SwapsInvariant(s.keeper)} {
+				msg, broken := invariant(ctx)
+				s.Require().False(broken, msg)
+			}
+
+			genState := s.keeper.ExportGenesis(ctx)
+			s.Require().NoError(genState.Validate())
+			s.Require().Len(genState.Closures, 1)
+			s.Require().NoError(s.keeper.InitGenesis(ctx, genState))
+			s.Require().Equal(genState, s.keeper.ExportGenesis(ctx))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestCloseSwapRate() {
+	now := time.Unix(1_700_000_000, 0).UTC()
+	swap := s.swap
+	swap.SwapRate = sdk.NewDec(2)
+	swap.AmountCapForToDenom = sdk.NewInt(1000000)
+	swap.RateSchedule = []types.SwapRatePhase{
+		{StartTime: now.Add(-time.Hour), SwapRate: sdk.OneDec()},
+	}
+
+	ctx, _ := s.ctx.CacheContext()
+	ctx = ctx.WithBlockTime(now)
+	s.Require().NoError(s.keeper.SetSwap(ctx, swap, s.toDenomMetadata))
+	s.Require().NoError(s.keeper.CloseSwap(ctx, swap.FromDenom, swap.ToDenom, nil))
+
+	// the reverse swap uses the highest rate, not the current one
+	res, err := s.queryServer.Swapped(sdk.WrapSDKContext(ctx), &types.QuerySwappedRequest{FromDenom: swap.FromDenom, ToDenom: swap.ToDenom})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDec(2), res.Closure.SwapRate)
+}
+
+func (s *KeeperTestSuite) TestReverseSwap() {
+	now := time.Unix(1_700_000_000, 0).UTC()
+	swap := s.swap
+	swap.SwapRate = sdk.NewDec(2)
+	swap.AmountCapForToDenom = sdk.NewInt(1000000)
+
+	testCases := map[string]struct {
+		reverseSwapEndTime *time.Time
+		blockTime          time.Time
+		toCoinAmount       sdk.Coin
+		expectedFromAmount sdk.Int
+		expectedError      error
+	}{
+		"reverse swap": {
+			timePtr(now.Add(time.Hour)),
+			now.Add(time.Minute),
+			sdk.NewCoin(swap.ToDenom, sdk.NewInt(51)),
+			sdk.NewInt(25),
+			nil,
+		},
+		"reverse swap not allowed": {
+			nil,
+			now.Add(time.Minute),
+			sdk.NewCoin(swap.ToDenom, sdk.NewInt(50)),
+			sdk.ZeroInt(),
+			types.ErrReverseSwapNotAllowed,
+		},
+		"reverse swap period ended": {
+			timePtr(now.Add(time.Hour)),
+			now.Add(time.Hour),
+			sdk.NewCoin(swap.ToDenom, sdk.NewInt(50)),
+			sdk.ZeroInt(),
+			types.ErrReverseSwapNotAllowed,
+		},
+		"more than swapped": {
+			timePtr(now.Add(time.Hour)),
+			now.Add(time.Minute),
+			sdk.NewCoin(swap.ToDenom, sdk.NewInt(202)),
+			sdk.ZeroInt(),
+			sdkerrors.ErrInvalidRequest,
+		},
+		"too small": {
+			timePtr(now.Add(time.Hour)),
+			now.Add(time.Minute),
+			sdk.NewCoin(swap.ToDenom, sdk.NewInt(1)),
+			sdk.ZeroInt(),
+			sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			ctx = ctx.WithBlockTime(now)
+			s.Require().NoError(s.keeper.SetSwap(ctx, swap, s.toDenomMetadata))
+			s.Require().NoError(s.keeper.Swap(ctx, s.accWithFromCoin, sdk.NewCoin(swap.FromDenom, sdk.NewInt(100)), swap.ToDenom))
+
+			// reverse swap is not allowed before closing
+			_, err := s.msgServer.ReverseSwap(sdk.WrapSDKContext(ctx), &types.MsgReverseSwap{FromAddress: s.accWithFromCoin.String(), ToCoinAmount: tc.toCoinAmount, FromDenom: swap.FromDenom})
+			s.Require().ErrorIs(err, types.ErrReverseSwapNotAllowed)
+
+			s.Require().NoError(s.keeper.CloseSwap(ctx, swap.FromDenom, swap.ToDenom, tc.reverseSwapEndTime))
+			ctx = ctx.WithBlockTime(tc.blockTime)
+
+			fromBalance := s.keeper.GetBalance(ctx, s.accWithFromCoin, swap.FromDenom)
+			_, err = s.msgServer.ReverseSwap(sdk.WrapSDKContext(ctx), &types.MsgReverseSwap{FromAddress: s.accWithFromCoin.String(), ToCoinAmount: tc.toCoinAmount, FromDenom: swap.FromDenom})
+			if tc.expectedError != nil {
+				s.Require().ErrorIs(err, tc.expectedError)
+				return
+			}
+			s.Require().NoError(err)
+
+			s.Require().Equal(fromBalance.Amount.Add(tc.expectedFromAmount), s.keeper.GetBalance(ctx, s.accWithFromCoin, swap.FromDenom).Amount)
+			s.Require().Equal(sdk.NewInt(200).Sub(tc.toCoinAmount.Amount), s.keeper.GetBalance(ctx, s.accWithFromCoin, swap.ToDenom).Amount)
+			res, err := s.queryServer.Swapped(sdk.WrapSDKContext(ctx), &types.QuerySwappedRequest{FromDenom: swap.FromDenom, ToDenom: swap.ToDenom})
+			s.Require().NoError(err)
+			s.Require().Equal(sdk.NewInt(100).Sub(tc.expectedFromAmount), res.FromCoinAmount.Amount)
+			s.Require().Equal(sdk.NewInt(200).Sub(tc.toCoinAmount.Amount), res.ToCoinAmount.Amount)
+
+			for _, invariant := range []sdk.Invariant{keeper.SwappedSupplyInvariant(s.keeper), keeper.SwapsInvariant(s.keeper)} {
+				msg, broken := invariant(ctx)
+				s.Require().False(broken, msg)
+			}
+		})
+	}
+}
@@ -15,5 +15,6 @@ type (
 		SetDenomMetaData(ctx sdk.Context, denomMetaData banktypes.Metadata)
 		MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 		BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+		GetSupply(ctx sdk.Context, denom string) sdk.Coin
 	}
 )
@@ -36,6 +36,29 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) error
 		}
 	}
 
+	for _, closure := range genState.GetClosures() {
+		if err := k.setSwapClosure(ctx, closure); err != nil {
+			return err
+		}
+	}
+
+	ledgers := genState.GetLedgers()
+	if len(ledgers) == 0 {
+		// the genesis predates the ledgers
+		for _, swap := range genState.GetSwaps() {
+			swapped, err := k.getSwapped(ctx, swap.FromDenom, swap.ToDenom)
+			if err != nil {
+				return err
+			}
+			ledgers = append(ledgers, newSwapLedger(swap, swapped))
+		}
+	}
+	for _, ledger := range ledgers {
+		if err := k.setSwapLedger(ctx, ledger); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -53,5 +76,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		Swaps:     k.getAllSwaps(ctx),
 		SwapStats: stats,
 		Swappeds:  k.getAllSwapped(ctx),
+		Closures:  k.getAllSwapClosures(ctx),
+		Ledgers:   k.getAllSwapLedgers(ctx),
 	}
 }
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"fmt"
 
+	sdk "github.com/Finschia/finschia-sdk/types"
 	"github.com/Finschia/finschia-sdk/x/fswap/types"
 )
 
@@ -19,3 +20,27 @@ func (s *KeeperTestSuite) TestInitAndExportGenesis() {
 	s.Require().Equal(defaultGenesis.GetSwapStats(), exportGenesis.GetSwapStats())
 	s.Require().Equal(defaultGenesis.GetSwappeds(), exportGenesis.GetSwappeds())
 }
+
+func (s *KeeperTestSuite) TestInitGenesisWithoutLedgers() {
+	ctx, _ := s.ctx.CacheContext()
+	swapped := types.Swapped{
+		FromCoinAmount: sdk.NewCoin(s.swap.FromDenom, sdk.NewInt(100)),
+		ToCoinAmount:   sdk.NewCoin(s.swap.ToDenom, s.swap.SwapRate.MulInt64(100).TruncateInt()),
+	}
+	genState := &types.GenesisState{
+		Swaps:     []types.Swap{s.swap},
+		SwapStats: types.SwapStats{SwapCount: 1},
+		Swappeds:  []types.Swapped{swapped},
+	}
+	s.Require().NoError(s.keeper.InitGenesis(ctx, genState))
+
+	// the ledgers are derived from the swappeds
+	expected := []types.SwapLedger{{
+		FromDenom:            s.swap.FromDenom,
+		ToDenom:              s.swap.ToDenom,
+		MaxSwapRate:          s.swap.MaxSwapRate(),
+		BurnedFromCoinAmount: swapped.FromCoinAmount,
+		MintedToCoinAmount:   swapped.ToCoinAmount,
+	}}
+	s.Require().Equal(expected, s.keeper.ExportGenesis(ctx).Ledgers)
+}
@@ -36,9 +36,22 @@ func (s QueryServer) Swapped(ctx context.Context, req *types.QuerySwappedRequest
 		return nil, err
 	}
 
+	swap, err := s.Keeper.getSwap(c, req.GetFromDenom(), req.GetToDenom())
+	if err != nil {
+		return nil, err
+	}
+
+	var closure *types.SwapClosure
+	if found, closed := s.Keeper.getSwapClosure(c, req.GetFromDenom(), req.GetToDenom()); closed {
+		closure = &found
+	}
+
 	return &types.QuerySwappedResponse{
 		FromCoinAmount: swapped.GetFromCoinAmount(),
 		ToCoinAmount:   swapped.GetToCoinAmount(),
+		Swap:           swap,
+		ActivePhase:    s.Keeper.activePhase(c, swap),
+		Closure:        closure,
 	}, nil
 }
 
@@ -63,6 +76,7 @@ func (s QueryServer) Swaps(ctx context.Context, req *types.QuerySwapsRequest) (*
 	c := sdk.UnwrapSDKContext(ctx)
 
 	swaps := []types.Swap{}
+	activePhases := []types.ActiveSwapPhase{}
 	store := c.KVStore(s.storeKey)
 	swapStore := prefix.NewStore(store, swapPrefix)
 	pageResponse, err := query.Paginate(swapStore, req.Pagination, func(key, value []byte) error {
@@ -71,13 +85,42 @@ func (s QueryServer) Swaps(ctx context.Context, req *types.QuerySwapsRequest) (*
 			return err
 		}
 		swaps = append(swaps, swap)
+		activePhases = append(activePhases, s.Keeper.activePhase(c, swap))
 		return nil
 	})
 	if err != nil {
 		return nil, err
 	}
 	return &types.QuerySwapsResponse{
-		Swaps:      swaps,
+		Swaps:        swaps,
+		Pagination:   pageResponse,
+		ActivePhases: activePhases,
+	}, nil
+}
+
+func (s QueryServer) SwapAudits(ctx context.Context, req *types.QuerySwapAuditsRequest) (*types.QuerySwapAuditsResponse, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+
+	audits := []types.SwapAudit{}
+	store := c.KVStore(s.storeKey)
+	swapStore := prefix.NewStore(store, swapPrefix)
+	pageResponse, err := query.Paginate(swapStore, req.Pagination, func(key, value []byte) error {
+		swap := types.Swap{}
+		if err := s.Keeper.cdc.Unmarshal(value, &swap); err != nil {
+			return err
+		}
+		audit, err := s.Keeper.auditSwap(c, swap)
+		if err != nil {
+			return err
+		}
+		audits = append(audits, audit)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QuerySwapAuditsResponse{
+		Audits:     audits,
 		Pagination: pageResponse,
 	}, nil
 }
@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	authtypes "github.com/Finschia/finschia-sdk/x/auth/types"
+	"github.com/Finschia/finschia-sdk/x/fswap/types"
+)
+
+const (
+	swapCountInvariant     = "swap-count"
+	swappedSupplyInvariant = "swapped-supply"
+	swapsInvariant         = "swaps"
+)
+
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	for name, invariant := range map[string]func(k Keeper) sdk.Invariant{
+		swapCountInvariant:     SwapCountInvariant,
+		swappedSupplyInvariant: SwappedSupplyInvariant,
+		swapsInvariant:         SwapsInvariant,
+	} {
+		ir.RegisterRoute(types.ModuleName, name, invariant(k))
+	}
+}
+
+// SwapCountInvariant checks that the swap count equals the number of the swaps, including the closed ones.
+func SwapCountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		stats, err := k.getSwapStats(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, swapCountInvariant, err.Error()), true
+		}
+
+		expected := len(k.getAllSwaps(ctx))
+		msg := fmt.Sprintf("number of swaps; expected %d, got %d\n", expected, stats.SwapCount)
+		broken := int(stats.SwapCount) != expected
+
+		return sdk.FormatInvariant(types.ModuleName, swapCountInvariant, msg), broken
+	}
+}
+
+// SwappedSupplyInvariant checks that the total swapped to-coin does not exceed the supply of the to-coin.
+func SwappedSupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg := ""
+		broken := false
+
+		totalSwapped := sdk.NewCoins()
+		k.iterateAllSwapped(ctx, func(swapped types.Swapped) bool {
+			totalSwapped = totalSwapped.Add(swapped.ToCoinAmount)
+			return false
+		})
+
+		for _, swapped := range totalSwapped {
+			supply := k.GetSupply(ctx, swapped.Denom)
+			if supply.IsLT(swapped) {
+				msg += fmt.Sprintf("swapped %s exceeds the supply %s\n", swapped, supply)
+				broken = true
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, swappedSupplyInvariant, msg), broken
+	}
+}
+
+// SwapsInvariant checks for every swap that the minted to-coin does not exceed the amount the burned from-coin can
+// be swapped to, the cap nor the supply of the to-coin, that the coins actually burned and minted equal the swapped
+// amounts, and that every coin received by the module has been burned.
+func SwapsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg := ""
+		broken := false
+
+		k.iterateAllSwaps(ctx, func(swap types.Swap) bool {
+			audit, err := k.auditSwap(ctx, swap)
+			if err != nil {
+				msg += fmt.Sprintf("swap from %s to %s: %s\n", swap.FromDenom, swap.ToDenom, err)
+				broken = true
+				return false
+			}
+
+			if audit.OverMinted {
+				msg += fmt.Sprintf("swap from %s to %s: minted %s exceeds %s%s mintable for burned %s\n",
+					swap.FromDenom, swap.ToDenom, audit.MintedToCoinAmount, audit.MaxMintableToAmount, swap.ToDenom, audit.BurnedFromCoinAmount)
+				broken = true
+			}
+			if audit.CapExceeded {
+				msg += fmt.Sprintf("swap from %s to %s: minted %s exceeds the cap %s\n",
+					swap.FromDenom, swap.ToDenom, audit.MintedToCoinAmount, audit.AmountCapForToDenom)
+				broken = true
+			}
+			if audit.LedgerMismatched {
+				msg += fmt.Sprintf("swap from %s to %s: burned %s and minted %s differ from swapped %s and %s\n",
+					swap.FromDenom, swap.ToDenom, audit.LedgerBurnedFromCoinAmount, audit.LedgerMintedToCoinAmount, audit.BurnedFromCoinAmount, audit.MintedToCoinAmount)
+				broken = true
+			}
+			if audit.SupplyExceeded {
+				msg += fmt.Sprintf("swap from %s to %s: swapped %s or minted %s exceeds the supply %s\n",
+					swap.FromDenom, swap.ToDenom, audit.MintedToCoinAmount, audit.LedgerMintedToCoinAmount, audit.ToCoinSupply)
+				broken = true
+			}
+			if !audit.Unburned.IsZero() {
+				msg += fmt.Sprintf("swap from %s to %s: %s has not been burned\n", swap.FromDenom, swap.ToDenom, audit.Unburned)
+				broken = true
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, swapsInvariant, msg), broken
+	}
+}
+
+// auditSwap returns the breakdown of the consistency checks of the swap.
+func (k Keeper) auditSwap(ctx sdk.Context, swap types.Swap) (types.SwapAudit, error) {
+	swapped, err := k.getSwapped(ctx, swap.FromDenom, swap.ToDenom)
+	if err != nil {
+		return types.SwapAudit{}, err
+	}
+
+	ledger, err := k.getSwapLedger(ctx, swap.FromDenom, swap.ToDenom)
+	if err != nil {
+		return types.SwapAudit{}, err
+	}
+
+	// the swap may have been updated to a lower rate after swaps at a higher one
+	maxSwapRate := ledger.MaxSwapRate
+	maxMintable := CalcSwap(maxSwapRate, swapped.FromCoinAmount.Amount)
+
+	supply := k.GetSupply(ctx, swap.ToDenom)
+
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	unburned := sdk.NewCoins(
+		k.GetBalance(ctx, moduleAddr, swap.FromDenom),
+		k.GetBalance(ctx, moduleAddr, swap.ToDenom),
+	)
+
+	return types.SwapAudit{
+		FromDenom:                  swap.FromDenom,
+		ToDenom:                    swap.ToDenom,
+		BurnedFromCoinAmount:       swapped.FromCoinAmount,
+		MintedToCoinAmount:         swapped.ToCoinAmount,
+		MaxSwapRate:                maxSwapRate,
+		MaxMintableToAmount:        maxMintable,
+		AmountCapForToDenom:        swap.AmountCapForToDenom,
+		ToCoinSupply:               supply,
+		Unburned:                   unburned,
+		OverMinted:                 swapped.ToCoinAmount.Amount.GT(maxMintable),
+		CapExceeded:                swapped.ToCoinAmount.Amount.GT(swap.AmountCapForToDenom),
+		LedgerBurnedFromCoinAmount: ledger.BurnedFromCoinAmount,
+		LedgerMintedToCoinAmount:   ledger.MintedToCoinAmount,
+		LedgerMismatched: !ledger.BurnedFromCoinAmount.IsEqual(swapped.FromCoinAmount) ||
+			!ledger.MintedToCoinAmount.IsEqual(swapped.ToCoinAmount),
+		SupplyExceeded: supply.IsLT(swapped.ToCoinAmount) || supply.IsLT(ledger.MintedToCoinAmount),
+	}, nil
+}
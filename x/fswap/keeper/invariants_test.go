@@ -0,0 +1,168 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/fswap/keeper"
+	"github.com/Finschia/finschia-sdk/x/fswap/types"
+)
+
+func (s *KeeperTestSuite) TestSwapAudits() {
+	start := time.Unix(1_700_000_000, 0).UTC()
+	swap := s.swap
+	swap.SwapRate = sdk.NewDec(2)
+	swap.AmountCapForToDenom = sdk.NewInt(1000)
+	swap.RateSchedule = []types.SwapRatePhase{
+		{StartTime: start.Add(time.Hour), SwapRate: sdk.NewDec(3)},
+	}
+
+	testCases := map[string]struct {
+		malleate    func(ctx sdk.Context)
+		overMinted  bool
+		capExceeded bool
+		mismatched  bool
+		exceeded    bool
+		unburned    sdk.Coins
+	}{
+		"consistent": {
+			func(ctx sdk.Context) {},
+			false,
+			false,
+			false,
+			false,
+			sdk.NewCoins(),
+		},
+		"over minted": {
+			func(ctx sdk.Context) {
+				s.setSwapped(ctx, swap, sdk.NewInt(200), sdk.NewInt(601))
+			},
+			true,
+			false,
+			true,
+			false,
+			sdk.NewCoins(),
+		},
+		"cap exceeded": {
+			func(ctx sdk.Context) {
+				s.setSwapped(ctx, swap, sdk.NewInt(400), sdk.NewInt(1001))
+			},
+			false,
+			true,
+			true,
+			false,
+			sdk.NewCoins(),
+		},
+		"burned less than swapped": {
+			func(ctx sdk.Context) {
+				s.setSwapped(ctx, swap, sdk.NewInt(201), sdk.NewInt(500))
+			},
+			false,
+			false,
+			true,
+			false,
+			sdk.NewCoins(),
+		},
+		"unburned": {
+			func(ctx sdk.Context) {
+				coins := sdk.NewCoins(sdk.NewInt64Coin(swap.FromDenom, 10))
+				s.Require().NoError(s.keeper.SendCoinsFromAccountToModule(ctx, s.accWithFromCoin, types.ModuleName, coins))
+			},
+			false,
+			false,
+			false,
+			false,
+			sdk.NewCoins(sdk.NewInt64Coin(swap.FromDenom, 10)),
+		},
+		"supply less than minted": {
+			func(ctx sdk.Context) {
+				// burn the to-coin not minted by the swaps, and then one of those minted
+				burned := sdk.NewCoins(sdk.NewCoin(swap.ToDenom, s.initBalance))
+				s.Require().NoError(s.app.BankKeeper.SendCoinsFromAccountToModule(ctx, s.accWithToCoin, types.ModuleName, burned))
+				s.Require().NoError(s.app.BankKeeper.SendCoinsFromAccountToModule(ctx, s.accWithFromCoin, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(swap.ToDenom, 1))))
+				s.Require().NoError(s.app.BankKeeper.BurnCoins(ctx, types.ModuleName, burned.Add(sdk.NewInt64Coin(swap.ToDenom, 1))))
+			},
+			false,
+			false,
+			false,
+			true,
+			sdk.NewCoins(),
+		},
+	}
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			ctx = ctx.WithBlockTime(start)
+			s.Require().NoError(s.keeper.SetSwap(ctx, swap, s.toDenomMetadata))
+			s.Require().NoError(s.keeper.Swap(ctx, s.accWithFromCoin, sdk.NewCoin(swap.FromDenom, sdk.NewInt(100)), swap.ToDenom))
+			ctx = ctx.WithBlockTime(start.Add(time.Hour))
+			s.Require().NoError(s.keeper.Swap(ctx, s.accWithFromCoin, sdk.NewCoin(swap.FromDenom, sdk.NewInt(100)), swap.ToDenom))
+			tc.malleate(ctx)
+
+			res, err := s.queryServer.SwapAudits(sdk.WrapSDKContext(ctx), &types.QuerySwapAuditsRequest{})
+			s.Require().NoError(err)
+			s.Require().Len(res.Audits, 1)
+			audit := res.Audits[0]
+			s.Require().Equal(sdk.NewDec(3), audit.MaxSwapRate)
+			s.Require().Equal(keeper.CalcSwap(audit.MaxSwapRate, audit.BurnedFromCoinAmount.Amount), audit.MaxMintableToAmount)
+			s.Require().Equal(swap.AmountCapForToDenom, audit.AmountCapForToDenom)
+			s.Require().Equal(s.keeper.GetSupply(ctx, swap.ToDenom), audit.ToCoinSupply)
+			s.Require().Equal(tc.overMinted, audit.OverMinted)
+			s.Require().Equal(tc.capExceeded, audit.CapExceeded)
+			s.Require().Equal(sdk.NewCoin(swap.FromDenom, sdk.NewInt(200)), audit.LedgerBurnedFromCoinAmount)
+			s.Require().Equal(sdk.NewCoin(swap.ToDenom, sdk.NewInt(500)), audit.LedgerMintedToCoinAmount)
+			s.Require().Equal(tc.mismatched, audit.LedgerMismatched)
+			s.Require().Equal(tc.exceeded, audit.SupplyExceeded)
+			s.Require().Equal(tc.unburned, audit.Unburned)
+
+			msg, broken := keeper.SwapsInvariant(s.keeper)(ctx)
+			s.Require().Equal(tc.overMinted || tc.capExceeded || tc.mismatched || tc.exceeded || !tc.unburned.IsZero(), broken, msg)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSwapAuditsAfterRateUpdate() {
+	ctx, _ := s.ctx.CacheContext()
+	config := types.DefaultConfig()
+	config.UpdateAllowed = true
+	k := keeper.NewKeeper(s.app.AppCodec(), s.app.GetKey(types.StoreKey), config, types.DefaultAuthority().String(), s.app.BankKeeper)
+
+	swap := s.swap
+	swap.SwapRate = sdk.NewDec(3)
+	swap.AmountCapForToDenom = sdk.NewInt(1000)
+	s.Require().NoError(k.SetSwap(ctx, swap, s.toDenomMetadata))
+	s.Require().NoError(k.Swap(ctx, s.accWithFromCoin, sdk.NewCoin(swap.FromDenom, sdk.NewInt(100)), swap.ToDenom))
+
+	// the rate is lowered after the swap at the higher rate
+	swap.SwapRate = sdk.NewDec(2)
+	s.Require().NoError(k.SetSwap(ctx, swap, s.toDenomMetadata))
+	s.Require().NoError(k.Swap(ctx, s.accWithFromCoin, sdk.NewCoin(swap.FromDenom, sdk.NewInt(100)), swap.ToDenom))
+
+	audits, err := keeper.NewQueryServer(k).SwapAudits(sdk.WrapSDKContext(ctx), &types.QuerySwapAuditsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(audits.Audits, 1)
+	audit := audits.Audits[0]
+	s.Require().Equal(sdk.NewDec(3), audit.MaxSwapRate)
+	s.Require().Equal(sdk.NewInt(600), audit.MaxMintableToAmount)
+	s.Require().Equal(sdk.NewCoin(swap.ToDenom, sdk.NewInt(500)), audit.MintedToCoinAmount)
+	s.Require().False(audit.OverMinted)
+	s.Require().False(audit.LedgerMismatched)
+
+	msg, broken := keeper.SwapsInvariant(k)(ctx)
+	s.Require().False(broken, msg)
+
+	// the reverse swap of the closed swap uses the highest rate
+	s.Require().NoError(k.CloseSwap(ctx, swap.FromDenom, swap.ToDenom, nil))
+	res, err := keeper.NewQueryServer(k).Swapped(sdk.WrapSDKContext(ctx), &types.QuerySwappedRequest{FromDenom: swap.FromDenom, ToDenom: swap.ToDenom})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDec(3), res.Closure.SwapRate)
+}
+
+func (s *KeeperTestSuite) setSwapped(ctx sdk.Context, swap types.Swap, fromAmount, toAmount sdk.Int) {
+	genState := s.keeper.ExportGenesis(ctx)
+	genState.Swappeds = []types.Swapped{{
+		FromCoinAmount: sdk.NewCoin(swap.FromDenom, fromAmount),
+		ToCoinAmount:   sdk.NewCoin(swap.ToDenom, toAmount),
+	}}
+	s.Require().NoError(s.keeper.InitGenesis(ctx, genState))
+}
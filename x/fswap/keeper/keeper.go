@@ -58,7 +58,16 @@ func (k Keeper) Swap(ctx sdk.Context, addr sdk.AccAddress, fromCoinAmount sdk.Co
 		return err
 	}
 
-	newCoinAmountInt := CalcSwap(swap.SwapRate, fromCoinAmount.Amount)
+	if _, closed := k.getSwapClosure(ctx, swap.FromDenom, swap.ToDenom); closed {
+		return types.ErrSwapClosed.Wrapf("swap from %s to %s", swap.FromDenom, swap.ToDenom)
+	}
+
+	if status := swap.StatusAt(ctx.BlockTime()); status != types.SwapStatusActive {
+		return types.ErrSwapNotActive.Wrapf("swap from %s to %s is %s", swap.FromDenom, swap.ToDenom, status)
+	}
+
+	_, swapRate := swap.SwapRateAt(ctx.BlockTime())
+	newCoinAmountInt := CalcSwap(swapRate, fromCoinAmount.Amount)
 	newCoinAmount := sdk.NewCoin(toDenom, newCoinAmountInt)
 	swapped, err := k.getSwapped(ctx, swap.GetFromDenom(), swap.GetToDenom())
 	if err != nil {
@@ -86,6 +95,10 @@ func (k Keeper) Swap(ctx sdk.Context, addr sdk.AccAddress, fromCoinAmount sdk.Co
 		return err
 	}
 
+	if err := k.recordSwap(ctx, fromCoinAmount, newCoinAmount); err != nil {
+		return err
+	}
+
 	if err := k.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, sdk.NewCoins(newCoinAmount)); err != nil {
 		return err
 	}
@@ -106,6 +119,10 @@ func (k Keeper) SetSwap(ctx sdk.Context, swap types.Swap, toDenomMetadata bank.M
 		isNewSwap = false
 	}
 
+	if _, closed := k.getSwapClosure(ctx, swap.FromDenom, swap.ToDenom); closed {
+		return types.ErrSwapClosed.Wrap("closed swap cannot be updated")
+	}
+
 	if !isNewSwap && !k.config.UpdateAllowed {
 		return sdkerrors.ErrInvalidRequest.Wrap("update existing swap not allowed")
 	}
@@ -141,6 +158,10 @@ func (k Keeper) SetSwap(ctx sdk.Context, swap types.Swap, toDenomMetadata bank.M
 		}
 	}
 
+	if err := k.recordSwapRate(ctx, swap, isNewSwap); err != nil {
+		return err
+	}
+
 	if err := k.setSwap(ctx, swap); err != nil {
 		return err
 	}
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -21,6 +22,7 @@ import (
 type KeeperTestSuite struct {
 	suite.Suite
 
+	app         *simapp.SimApp
 	ctx         sdk.Context
 	goCtx       context.Context
 	keeper      keeper.Keeper
@@ -58,6 +60,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	app := simapp.Setup(checkTx)
 	testdata.RegisterInterfaces(app.InterfaceRegistry())
 	testdata.RegisterMsgServer(app.MsgServiceRouter(), testdata.MsgServerImpl{})
+	s.app = app
 	s.ctx = app.BaseApp.NewContext(checkTx, tmproto.Header{})
 	s.goCtx = sdk.WrapSDKContext(s.ctx)
 	s.keeper = app.FswapKeeper
@@ -295,6 +298,45 @@ func (s *KeeperTestSuite) TestSwapValidateBasic() {
 			true,
 			sdkerrors.ErrInvalidRequest,
 		},
+		"invalid unsorted rate schedule": {
+			types.Swap{
+				FromDenom:           "fromD",
+				ToDenom:             "toD",
+				AmountCapForToDenom: sdk.OneInt(),
+				SwapRate:            sdk.OneDec(),
+				RateSchedule: []types.SwapRatePhase{
+					{StartTime: time.Unix(200, 0).UTC(), SwapRate: sdk.OneDec()},
+					{StartTime: time.Unix(100, 0).UTC(), SwapRate: sdk.OneDec()},
+				},
+			},
+			true,
+			sdkerrors.ErrInvalidRequest,
+		},
+		"invalid zero swap-rate of a phase": {
+			types.Swap{
+				FromDenom:           "fromD",
+				ToDenom:             "toD",
+				AmountCapForToDenom: sdk.OneInt(),
+				SwapRate:            sdk.OneDec(),
+				RateSchedule: []types.SwapRatePhase{
+					{StartTime: time.Unix(100, 0).UTC(), SwapRate: sdk.ZeroDec()},
+				},
+			},
+			true,
+			sdkerrors.ErrInvalidRequest,
+		},
+		"invalid end time before start time": {
+			types.Swap{
+				FromDenom:           "fromD",
+				ToDenom:             "toD",
+				AmountCapForToDenom: sdk.OneInt(),
+				SwapRate:            sdk.OneDec(),
+				StartTime:           timePtr(time.Unix(200, 0).UTC()),
+				EndTime:             timePtr(time.Unix(100, 0).UTC()),
+			},
+			true,
+			sdkerrors.ErrInvalidRequest,
+		},
 		"invalid the same from-denom and to-denom": {
 			types.Swap{
 				FromDenom:           "same",
@@ -317,3 +359,82 @@ func (s *KeeperTestSuite) TestSwapValidateBasic() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestScheduledSwap() {
+	start := time.Unix(1_700_000_000, 0).UTC()
+	swap := s.swap
+	swap.SwapRate = sdk.NewDec(3)
+	swap.RateSchedule = []types.SwapRatePhase{
+		{StartTime: start.Add(time.Hour), SwapRate: sdk.NewDec(2)},
+		{StartTime: start.Add(2 * time.Hour), SwapRate: sdk.NewDec(1)},
+	}
+	swap.StartTime = timePtr(start)
+	swap.EndTime = timePtr(start.Add(3 * time.Hour))
+
+	testCases := map[string]struct {
+		blockTime      time.Time
+		expectedPhase  types.ActiveSwapPhase
+		expectedAmount sdk.Int
+		expectedError  error
+	}{
+		"before the start time": {
+			start.Add(-time.Second),
+			types.ActiveSwapPhase{Status: types.SwapStatusNotStarted, Phase: 0, SwapRate: sdk.NewDec(3)},
+			sdk.ZeroInt(),
+			types.ErrSwapNotActive,
+		},
+		"base swap rate": {
+			start,
+			types.ActiveSwapPhase{Status: types.SwapStatusActive, Phase: 0, SwapRate: sdk.NewDec(3)},
+			sdk.NewInt(300),
+			nil,
+		},
+		"first phase": {
+			start.Add(time.Hour),
+			types.ActiveSwapPhase{Status: types.SwapStatusActive, Phase: 1, SwapRate: sdk.NewDec(2)},
+			sdk.NewInt(200),
+			nil,
+		},
+		"last phase": {
+			start.Add(3*time.Hour - time.Second),
+			types.ActiveSwapPhase{Status: types.SwapStatusActive, Phase: 2, SwapRate: sdk.NewDec(1)},
+			sdk.NewInt(100),
+			nil,
+		},
+		"at the end time": {
+			start.Add(3 * time.Hour),
+			types.ActiveSwapPhase{Status: types.SwapStatusEnded, Phase: 2, SwapRate: sdk.NewDec(1)},
+			sdk.ZeroInt(),
+			types.ErrSwapNotActive,
+		},
+	}
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			ctx = ctx.WithBlockTime(tc.blockTime)
+			s.Require().NoError(s.keeper.SetSwap(ctx, swap, s.toDenomMetadata))
+
+			tc.expectedPhase.FromDenom = swap.FromDenom
+			tc.expectedPhase.ToDenom = swap.ToDenom
+			swappedRes, err := s.queryServer.Swapped(sdk.WrapSDKContext(ctx), &types.QuerySwappedRequest{FromDenom: swap.FromDenom, ToDenom: swap.ToDenom})
+			s.Require().NoError(err)
+			s.Require().Equal(swap.RateSchedule, swappedRes.Swap.RateSchedule)
+			s.Require().Equal(tc.expectedPhase, swappedRes.ActivePhase)
+			swapsRes, err := s.queryServer.Swaps(sdk.WrapSDKContext(ctx), &types.QuerySwapsRequest{})
+			s.Require().NoError(err)
+			s.Require().Equal([]types.ActiveSwapPhase{tc.expectedPhase}, swapsRes.ActivePhases)
+
+			err = s.keeper.Swap(ctx, s.accWithFromCoin, sdk.NewCoin(swap.FromDenom, sdk.NewInt(100)), swap.ToDenom)
+			if tc.expectedError != nil {
+				s.Require().ErrorIs(err, tc.expectedError)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedAmount, s.keeper.GetBalance(ctx, s.accWithFromCoin, swap.ToDenom).Amount)
+		})
+	}
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
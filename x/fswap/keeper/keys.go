@@ -1,9 +1,11 @@
 package keeper
 
 var (
-	swapPrefix       = []byte{0x01}
-	swapStatsKey     = []byte{0x02}
-	swappedKeyPrefix = []byte{0x03}
+	swapPrefix        = []byte{0x01}
+	swapStatsKey      = []byte{0x02}
+	swappedKeyPrefix  = []byte{0x03}
+	swapClosurePrefix = []byte{0x04}
+	swapLedgerPrefix  = []byte{0x05}
 )
 
 // swapKey key(prefix + fromDenom + toDenom)
@@ -18,6 +20,18 @@ func swappedKey(fromDenom, toDenom string) []byte {
 	return append(swappedKeyPrefix, denoms...)
 }
 
+// swapClosureKey key(prefix + (lengthPrefixed+)fromDenom + (lengthPrefixed+)toDenom)
+func swapClosureKey(fromDenom, toDenom string) []byte {
+	denoms := combineDenoms(fromDenom, toDenom)
+	return append(swapClosurePrefix, denoms...)
+}
+
+// swapLedgerKey key(prefix + (lengthPrefixed+)fromDenom + (lengthPrefixed+)toDenom)
+func swapLedgerKey(fromDenom, toDenom string) []byte {
+	denoms := combineDenoms(fromDenom, toDenom)
+	return append(swapLedgerPrefix, denoms...)
+}
+
 func combineDenoms(fromDenom, toDenom string) []byte {
 	lengthPrefixedFromDenom := lengthPrefix([]byte(fromDenom))
 	lengthPrefixedToDenom := lengthPrefix([]byte(toDenom))
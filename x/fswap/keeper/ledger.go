@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"github.com/Finschia/finschia-sdk/store/prefix"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	"github.com/Finschia/finschia-sdk/x/fswap/types"
+)
+
+// newSwapLedger returns the ledger of the swap derived from its swapped, for the swaps which predate the ledgers.
+func newSwapLedger(swap types.Swap, swapped types.Swapped) types.SwapLedger {
+	return types.SwapLedger{
+		FromDenom:            swap.FromDenom,
+		ToDenom:              swap.ToDenom,
+		MaxSwapRate:          swap.MaxSwapRate(),
+		BurnedFromCoinAmount: swapped.FromCoinAmount,
+		MintedToCoinAmount:   swapped.ToCoinAmount,
+	}
+}
+
+// recordSwapRate raises the max swap rate of the ledger to the highest rate of the swap, so that the rates replaced
+// by an update of the swap are still taken into account.
+func (k Keeper) recordSwapRate(ctx sdk.Context, swap types.Swap, isNewSwap bool) error {
+	if isNewSwap {
+		return k.setSwapLedger(ctx, types.SwapLedger{
+			FromDenom:            swap.FromDenom,
+			ToDenom:              swap.ToDenom,
+			MaxSwapRate:          swap.MaxSwapRate(),
+			BurnedFromCoinAmount: sdk.NewCoin(swap.FromDenom, sdk.ZeroInt()),
+			MintedToCoinAmount:   sdk.NewCoin(swap.ToDenom, sdk.ZeroInt()),
+		})
+	}
+
+	ledger, err := k.getSwapLedger(ctx, swap.FromDenom, swap.ToDenom)
+	if err != nil {
+		return err
+	}
+	if rate := swap.MaxSwapRate(); rate.GT(ledger.MaxSwapRate) {
+		ledger.MaxSwapRate = rate
+	}
+	return k.setSwapLedger(ctx, ledger)
+}
+
+// recordSwap records the from-coin burned and the to-coin minted by a swap.
+func (k Keeper) recordSwap(ctx sdk.Context, burned, minted sdk.Coin) error {
+	ledger, err := k.getSwapLedger(ctx, burned.Denom, minted.Denom)
+	if err != nil {
+		return err
+	}
+	ledger.BurnedFromCoinAmount = ledger.BurnedFromCoinAmount.Add(burned)
+	ledger.MintedToCoinAmount = ledger.MintedToCoinAmount.Add(minted)
+	return k.setSwapLedger(ctx, ledger)
+}
+
+// recordReverseSwap records the to-coin burned and the from-coin minted by a reverse swap.
+func (k Keeper) recordReverseSwap(ctx sdk.Context, burned, minted sdk.Coin) error {
+	ledger, err := k.getSwapLedger(ctx, minted.Denom, burned.Denom)
+	if err != nil {
+		return err
+	}
+	if ledger.BurnedFromCoinAmount.IsLT(minted) || ledger.MintedToCoinAmount.IsLT(burned) {
+		return types.ErrInvalidState.Wrapf("reverse swap of %s exceeds the ledger", burned)
+	}
+	ledger.BurnedFromCoinAmount = ledger.BurnedFromCoinAmount.Sub(minted)
+	ledger.MintedToCoinAmount = ledger.MintedToCoinAmount.Sub(burned)
+	return k.setSwapLedger(ctx, ledger)
+}
+
+func (k Keeper) setSwapLedger(ctx sdk.Context, ledger types.SwapLedger) error {
+	key := swapLedgerKey(ledger.FromDenom, ledger.ToDenom)
+	bz, err := k.cdc.Marshal(&ledger)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(key, bz)
+	return nil
+}
+
+func (k Keeper) getSwapLedger(ctx sdk.Context, fromDenom, toDenom string) (types.SwapLedger, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(swapLedgerKey(fromDenom, toDenom))
+	if bz == nil {
+		return types.SwapLedger{}, sdkerrors.ErrNotFound.Wrapf("ledger of swap from %s to %s not found", fromDenom, toDenom)
+	}
+
+	ledger := types.SwapLedger{}
+	if err := k.cdc.Unmarshal(bz, &ledger); err != nil {
+		return types.SwapLedger{}, err
+	}
+	return ledger, nil
+}
+
+func (k Keeper) getAllSwapLedgers(ctx sdk.Context) []types.SwapLedger {
+	ledgers := []types.SwapLedger{}
+	store := ctx.KVStore(k.storeKey)
+	ledgerStore := prefix.NewStore(store, swapLedgerPrefix)
+
+	iterator := ledgerStore.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		ledger := types.SwapLedger{}
+		k.cdc.MustUnmarshal(iterator.Value(), &ledger)
+		ledgers = append(ledgers, ledger)
+	}
+	return ledgers
+}
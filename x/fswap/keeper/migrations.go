@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 derives the ledgers of the existing swaps from their swapped amounts.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	k := m.keeper
+	for _, swap := range k.getAllSwaps(ctx) {
+		swapped, err := k.getSwapped(ctx, swap.FromDenom, swap.ToDenom)
+		if err != nil {
+			return err
+		}
+		if err := k.setSwapLedger(ctx, newSwapLedger(swap, swapped)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
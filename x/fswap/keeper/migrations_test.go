@@ -0,0 +1,47 @@
+package keeper_test
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/fswap/keeper"
+	"github.com/Finschia/finschia-sdk/x/fswap/types"
+)
+
+func (s *KeeperTestSuite) TestMigrate1to2() {
+	ctx, _ := s.ctx.CacheContext()
+	swapped := types.Swapped{
+		FromCoinAmount: sdk.NewCoin(s.swap.FromDenom, sdk.NewInt(100)),
+		ToCoinAmount:   sdk.NewCoin(s.swap.ToDenom, s.swap.SwapRate.MulInt64(100).TruncateInt()),
+	}
+	genState := &types.GenesisState{
+		Swaps:     []types.Swap{s.swap},
+		SwapStats: types.SwapStats{SwapCount: 1},
+		Swappeds:  []types.Swapped{swapped},
+		Ledgers: []types.SwapLedger{{
+			FromDenom:            s.swap.FromDenom,
+			ToDenom:              s.swap.ToDenom,
+			MaxSwapRate:          sdk.OneDec(),
+			BurnedFromCoinAmount: sdk.NewCoin(s.swap.FromDenom, sdk.ZeroInt()),
+			MintedToCoinAmount:   sdk.NewCoin(s.swap.ToDenom, sdk.ZeroInt()),
+		}},
+	}
+	s.Require().NoError(s.keeper.InitGenesis(ctx, genState))
+	// the to-coin swapped before the migration has been minted
+	minted := sdk.NewCoins(swapped.ToCoinAmount)
+	s.Require().NoError(s.app.BankKeeper.MintCoins(ctx, types.ModuleName, minted))
+	s.Require().NoError(s.app.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, s.accWithFromCoin, minted))
+	msg, broken := keeper.SwapsInvariant(s.keeper)(ctx)
+	s.Require().True(broken, msg)
+
+	s.Require().NoError(keeper.NewMigrator(s.keeper).Migrate1to2(ctx))
+
+	expected := []types.SwapLedger{{
+		FromDenom:            s.swap.FromDenom,
+		ToDenom:              s.swap.ToDenom,
+		MaxSwapRate:          s.swap.MaxSwapRate(),
+		BurnedFromCoinAmount: swapped.FromCoinAmount,
+		MintedToCoinAmount:   swapped.ToCoinAmount,
+	}}
+	s.Require().Equal(expected, s.keeper.ExportGenesis(ctx).Ledgers)
+	msg, broken = keeper.SwapsInvariant(s.keeper)(ctx)
+	s.Require().False(broken, msg)
+}
@@ -74,3 +74,36 @@ func (s MsgServer) SetSwap(ctx context.Context, req *types.MsgSetSwap) (*types.M
 
 	return &types.MsgSetSwapResponse{}, nil
 }
+
+func (s MsgServer) CloseSwap(ctx context.Context, req *types.MsgCloseSwap) (*types.MsgCloseSwapResponse, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+
+	if err := s.keeper.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := s.keeper.CloseSwap(c, req.GetFromDenom(), req.GetToDenom(), req.GetReverseSwapEndTime()); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCloseSwapResponse{}, nil
+}
+
+func (s MsgServer) ReverseSwap(ctx context.Context, req *types.MsgReverseSwap) (*types.MsgReverseSwapResponse, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+
+	from, err := sdk.AccAddressFromBech32(req.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.keeper.IsSendEnabledCoins(c, req.GetToCoinAmount()); err != nil {
+		return nil, err
+	}
+
+	if err := s.keeper.ReverseSwap(c, from, req.GetToCoinAmount(), req.GetFromDenom()); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReverseSwapResponse{}, nil
+}
@@ -29,6 +29,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgSwap{}, "lbm-sdk/MsgSwap")
 	legacy.RegisterAminoMsg(cdc, &MsgSwapAll{}, "lbm-sdk/MsgSwapAll")
 	legacy.RegisterAminoMsg(cdc, &MsgSetSwap{}, "lbm-sdk/MsgSetSwap")
+	legacy.RegisterAminoMsg(cdc, &MsgCloseSwap{}, "lbm-sdk/MsgCloseSwap")
+	legacy.RegisterAminoMsg(cdc, &MsgReverseSwap{}, "lbm-sdk/MsgReverseSwap")
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -36,6 +38,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgSwap{},
 		&MsgSwapAll{},
 		&MsgSetSwap{},
+		&MsgCloseSwap{},
+		&MsgReverseSwap{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
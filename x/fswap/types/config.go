@@ -1,13 +1,19 @@
 package types
 
+import "time"
+
 type Config struct {
 	MaxSwaps      int
 	UpdateAllowed bool
+	// MaxReverseSwapPeriod is the maximum period the reverse swap of a closed swap can be allowed for.
+	// zero disables the reverse swap.
+	MaxReverseSwapPeriod time.Duration
 }
 
 func DefaultConfig() Config {
 	return Config{
-		MaxSwaps:      1,
-		UpdateAllowed: false,
+		MaxSwaps:             1,
+		UpdateAllowed:        false,
+		MaxReverseSwapPeriod: 30 * 24 * time.Hour,
 	}
 }
@@ -12,4 +12,7 @@ var (
 	ErrCanNotHaveMoreSwap          = sdkerrors.Register(ModuleName, 3, "no more swap allowed")
 	ErrSwappedNotFound             = sdkerrors.Register(ModuleName, 4, "swapped does not exist")
 	ErrExceedSwappableToCoinAmount = sdkerrors.Register(ModuleName, 5, "exceed swappable to-coin amount")
+	ErrSwapNotActive               = sdkerrors.Register(ModuleName, 6, "swap is not active")
+	ErrSwapClosed                  = sdkerrors.Register(ModuleName, 7, "swap has been closed")
+	ErrReverseSwapNotAllowed       = sdkerrors.Register(ModuleName, 8, "reverse swap not allowed")
 )
@@ -176,42 +176,154 @@ func (m *EventAddDenomMetadata) GetMetadata() types1.Metadata {
 	return types1.Metadata{}
 }
 
+type EventCloseSwap struct {
+	Closure SwapClosure `protobuf:"bytes,1,opt,name=closure,proto3" json:"closure"`
+}
+
+func (m *EventCloseSwap) Reset()         { *m = EventCloseSwap{} }
+func (m *EventCloseSwap) String() string { return proto.CompactTextString(m) }
+func (*EventCloseSwap) ProtoMessage()    {}
+func (*EventCloseSwap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92d5edbd64a725af, []int{3}
+}
+func (m *EventCloseSwap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCloseSwap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCloseSwap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCloseSwap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCloseSwap.Merge(m, src)
+}
+func (m *EventCloseSwap) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCloseSwap) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCloseSwap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCloseSwap proto.InternalMessageInfo
+
+func (m *EventCloseSwap) GetClosure() SwapClosure {
+	if m != nil {
+		return m.Closure
+	}
+	return SwapClosure{}
+}
+
+type EventReverseSwapCoins struct {
+	// holder's address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// to-coin amount swapped back
+	ToCoinAmount types.Coin `protobuf:"bytes,2,opt,name=to_coin_amount,json=toCoinAmount,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coin" json:"to_coin_amount"`
+	// from-coin amount returned to the holder
+	FromCoinAmount types.Coin `protobuf:"bytes,3,opt,name=from_coin_amount,json=fromCoinAmount,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coin" json:"from_coin_amount"`
+}
+
+func (m *EventReverseSwapCoins) Reset()         { *m = EventReverseSwapCoins{} }
+func (m *EventReverseSwapCoins) String() string { return proto.CompactTextString(m) }
+func (*EventReverseSwapCoins) ProtoMessage()    {}
+func (*EventReverseSwapCoins) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92d5edbd64a725af, []int{4}
+}
+func (m *EventReverseSwapCoins) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventReverseSwapCoins) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventReverseSwapCoins.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventReverseSwapCoins) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventReverseSwapCoins.Merge(m, src)
+}
+func (m *EventReverseSwapCoins) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventReverseSwapCoins) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventReverseSwapCoins.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventReverseSwapCoins proto.InternalMessageInfo
+
+func (m *EventReverseSwapCoins) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EventReverseSwapCoins) GetToCoinAmount() types.Coin {
+	if m != nil {
+		return m.ToCoinAmount
+	}
+	return types.Coin{}
+}
+
+func (m *EventReverseSwapCoins) GetFromCoinAmount() types.Coin {
+	if m != nil {
+		return m.FromCoinAmount
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*EventSwapCoins)(nil), "lbm.fswap.v1.EventSwapCoins")
 	proto.RegisterType((*EventMakeSwap)(nil), "lbm.fswap.v1.EventMakeSwap")
 	proto.RegisterType((*EventAddDenomMetadata)(nil), "lbm.fswap.v1.EventAddDenomMetadata")
+	proto.RegisterType((*EventCloseSwap)(nil), "lbm.fswap.v1.EventCloseSwap")
+	proto.RegisterType((*EventReverseSwapCoins)(nil), "lbm.fswap.v1.EventReverseSwapCoins")
 }
 
 func init() { proto.RegisterFile("lbm/fswap/v1/event.proto", fileDescriptor_92d5edbd64a725af) }
 
 var fileDescriptor_92d5edbd64a725af = []byte{
-	// 403 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x92, 0xcd, 0x8e, 0xda, 0x30,
-	0x14, 0x85, 0x13, 0x8a, 0xfa, 0x63, 0x28, 0xaa, 0xa2, 0x22, 0xa5, 0x48, 0x04, 0x94, 0x15, 0x52,
-	0x5b, 0x5b, 0x81, 0x5d, 0xa5, 0x2e, 0x48, 0x7f, 0xd4, 0x0d, 0x9b, 0x74, 0x53, 0x75, 0x83, 0x9c,
-	0xc4, 0x40, 0x14, 0x6c, 0xa7, 0xd8, 0x04, 0x78, 0x8b, 0xae, 0xfb, 0x08, 0x7d, 0x12, 0x96, 0x2c,
-	0x67, 0xc5, 0x8c, 0xe0, 0x0d, 0xe6, 0x09, 0x46, 0x36, 0x01, 0x06, 0xcd, 0x62, 0x56, 0xb3, 0xbb,
-	0xd6, 0xb9, 0xf7, 0x7c, 0xd7, 0xc7, 0x06, 0xf6, 0x34, 0xa4, 0x68, 0x24, 0x16, 0x38, 0x43, 0xb9,
-	0x87, 0x48, 0x4e, 0x98, 0x84, 0xd9, 0x8c, 0x4b, 0x6e, 0x55, 0xa7, 0x21, 0x85, 0x5a, 0x81, 0xb9,
-	0xd7, 0x78, 0x3b, 0xe6, 0x63, 0xae, 0x05, 0xa4, 0xaa, 0x43, 0x4f, 0xc3, 0x89, 0xb8, 0xa0, 0x5c,
-	0xa0, 0x10, 0x0b, 0x82, 0x72, 0x2f, 0x24, 0x12, 0x7b, 0x28, 0xe2, 0x09, 0x7b, 0xa0, 0xb3, 0xf4,
-	0xa4, 0xab, 0x43, 0xa1, 0x5f, 0xd2, 0x0f, 0x30, 0xad, 0xb8, 0xff, 0x4a, 0xa0, 0xf6, 0x4d, 0x6d,
-	0xf3, 0x73, 0x81, 0xb3, 0x2f, 0x3c, 0x61, 0xc2, 0xb2, 0xc1, 0x0b, 0x1c, 0xc7, 0x33, 0x22, 0x84,
-	0x6d, 0xb6, 0xcd, 0xce, 0xab, 0xe0, 0x78, 0xb4, 0x96, 0xe0, 0xcd, 0x68, 0xc6, 0xe9, 0x50, 0x91,
-	0x87, 0x98, 0xf2, 0x39, 0x93, 0x76, 0xa9, 0x6d, 0x76, 0x2a, 0xdd, 0x77, 0xf0, 0xb0, 0x01, 0x54,
-	0x1b, 0xc2, 0x62, 0x03, 0xa8, 0xfc, 0xfc, 0xde, 0x7a, 0xdb, 0x32, 0xfe, 0x5f, 0xb7, 0xde, 0x8f,
-	0x13, 0x39, 0x99, 0x87, 0x30, 0xe2, 0x14, 0x7d, 0x4f, 0x98, 0x88, 0x26, 0x09, 0x46, 0xa3, 0xa2,
-	0xf8, 0x28, 0xe2, 0x14, 0xc9, 0x55, 0x46, 0x84, 0x1e, 0x0a, 0x6a, 0x8a, 0xa3, 0xaa, 0xbe, 0xa6,
-	0x58, 0x12, 0xd4, 0x24, 0xbf, 0xe0, 0x3e, 0x7b, 0x12, 0x6e, 0x55, 0xf2, 0x33, 0xd5, 0xfd, 0x0c,
-	0x5e, 0xeb, 0x6c, 0x06, 0x38, 0x25, 0x2a, 0x1f, 0xeb, 0x03, 0x28, 0xab, 0xec, 0x74, 0x2e, 0x95,
-	0xae, 0x05, 0xef, 0x3f, 0x1d, 0x54, 0x1d, 0x7e, 0x59, 0x51, 0x03, 0xdd, 0xe5, 0xfe, 0x01, 0x75,
-	0x3d, 0xde, 0x8f, 0xe3, 0xaf, 0x84, 0x71, 0x3a, 0x20, 0x12, 0xc7, 0x58, 0x62, 0xeb, 0x17, 0x78,
-	0x49, 0x8b, 0xba, 0xb0, 0x6a, 0x9e, 0xef, 0xc1, 0xd2, 0xd3, 0x3d, 0x8e, 0x03, 0x7e, 0x53, 0xb9,
-	0xde, 0x6e, 0x5b, 0xf5, 0x15, 0xa6, 0xd3, 0x4f, 0x6e, 0xac, 0xdc, 0x86, 0x47, 0x0b, 0x37, 0x38,
-	0xb9, 0xf9, 0x3f, 0xd6, 0x3b, 0xc7, 0xdc, 0xec, 0x1c, 0xf3, 0x66, 0xe7, 0x98, 0x7f, 0xf7, 0x8e,
-	0xb1, 0xd9, 0x3b, 0xc6, 0xd5, 0xde, 0x31, 0x7e, 0xc3, 0x47, 0x63, 0x58, 0x16, 0x3f, 0x44, 0xc7,
-	0x11, 0x3e, 0xd7, 0xff, 0xa3, 0x77, 0x17, 0x00, 0x00, 0xff, 0xff, 0x9f, 0x45, 0x65, 0x5a, 0xb9,
-	0x02, 0x00, 0x00,
+	// 461 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
+	0x14, 0x8f, 0xd3, 0x8a, 0xc2, 0xb5, 0x44, 0xc8, 0xa2, 0x52, 0x5a, 0xa9, 0x4e, 0xe5, 0xa9, 0x12,
+	0x70, 0x27, 0xb7, 0x13, 0x48, 0x0c, 0x75, 0x00, 0x21, 0xa1, 0x2e, 0x66, 0x41, 0x2c, 0xd1, 0xd9,
+	0xbe, 0xa4, 0x96, 0x7d, 0xf7, 0x8c, 0xef, 0xe2, 0xb6, 0xdf, 0x82, 0x99, 0x8d, 0x95, 0x4f, 0xd2,
+	0xb1, 0x23, 0x53, 0x41, 0xc9, 0x37, 0xe0, 0x13, 0xa0, 0x3b, 0x9f, 0x53, 0xaa, 0x20, 0x75, 0x4a,
+	0xb7, 0x77, 0xfa, 0xbd, 0xf7, 0xfb, 0xfd, 0xde, 0x9f, 0x43, 0xfd, 0x22, 0xe6, 0x64, 0x2c, 0xcf,
+	0x68, 0x49, 0xea, 0x80, 0xb0, 0x9a, 0x09, 0x85, 0xcb, 0x0a, 0x14, 0xb8, 0x5b, 0x45, 0xcc, 0xb1,
+	0x41, 0x70, 0x1d, 0xec, 0x3e, 0x9d, 0xc0, 0x04, 0x0c, 0x40, 0x74, 0xd4, 0xe4, 0xec, 0x7a, 0x09,
+	0x48, 0x0e, 0x92, 0xc4, 0x54, 0x32, 0x52, 0x07, 0x31, 0x53, 0x34, 0x20, 0x09, 0x64, 0x62, 0x09,
+	0x17, 0xf9, 0x02, 0xd7, 0x0f, 0x8b, 0xdf, 0x56, 0x6f, 0xc4, 0x0c, 0xe2, 0x7f, 0xeb, 0xa2, 0xde,
+	0x5b, 0xed, 0xe6, 0xe3, 0x19, 0x2d, 0x87, 0x90, 0x09, 0xe9, 0xf6, 0xd1, 0x06, 0x4d, 0xd3, 0x8a,
+	0x49, 0xd9, 0x77, 0xf6, 0x9d, 0x83, 0x47, 0x51, 0xfb, 0x74, 0xcf, 0xd1, 0x93, 0x71, 0x05, 0x7c,
+	0xa4, 0x95, 0x47, 0x94, 0xc3, 0x54, 0xa8, 0x7e, 0x77, 0xdf, 0x39, 0xd8, 0x3c, 0xdc, 0xc1, 0x8d,
+	0x03, 0xac, 0x1d, 0x62, 0xeb, 0x00, 0x6b, 0xbe, 0xf0, 0xe8, 0xf2, 0x7a, 0xd0, 0xf9, 0xf1, 0x6b,
+	0xf0, 0x6c, 0x92, 0xa9, 0xd3, 0x69, 0x8c, 0x13, 0xe0, 0xe4, 0x5d, 0x26, 0x64, 0x72, 0x9a, 0x51,
+	0x32, 0xb6, 0xc1, 0x0b, 0x99, 0xe6, 0x44, 0x5d, 0x94, 0x4c, 0x9a, 0xa2, 0xa8, 0xa7, 0x75, 0x74,
+	0x74, 0x6c, 0x54, 0x5c, 0x85, 0x7a, 0x0a, 0x6e, 0xe9, 0xae, 0xad, 0x44, 0x77, 0x4b, 0xc1, 0x8d,
+	0xaa, 0xff, 0x1a, 0x3d, 0x36, 0xb3, 0x39, 0xa1, 0x39, 0xd3, 0xf3, 0x71, 0x9f, 0xa3, 0x75, 0x3d,
+	0x3b, 0x33, 0x97, 0xcd, 0x43, 0x17, 0xff, 0xbb, 0x3a, 0xac, 0x33, 0xc2, 0x75, 0xad, 0x1a, 0x99,
+	0x2c, 0xff, 0x0b, 0xda, 0x36, 0xe5, 0xc7, 0x69, 0xfa, 0x86, 0x09, 0xe0, 0x27, 0x4c, 0xd1, 0x94,
+	0x2a, 0xea, 0x7e, 0x42, 0x0f, 0xb9, 0x8d, 0x2d, 0xd5, 0xde, 0x4d, 0x1f, 0x22, 0x5f, 0xf4, 0xd1,
+	0x16, 0x84, 0x7b, 0x9a, 0xf5, 0xcf, 0xf5, 0x60, 0xfb, 0x82, 0xf2, 0xe2, 0x95, 0x9f, 0x6a, 0xb6,
+	0x51, 0x4b, 0xe1, 0x47, 0x0b, 0x36, 0xff, 0x83, 0xdd, 0xe6, 0xb0, 0x00, 0xd9, 0x58, 0x7e, 0x89,
+	0x36, 0x92, 0x02, 0xe4, 0xb4, 0x62, 0x56, 0x6a, 0x67, 0xd9, 0xf5, 0xb0, 0x49, 0xb0, 0xe6, 0xdb,
+	0x7c, 0xff, 0x7b, 0xd7, 0x36, 0x10, 0xb1, 0x9a, 0x55, 0x0d, 0xdf, 0x5d, 0x27, 0xb2, 0xbc, 0xa8,
+	0xee, 0xea, 0x17, 0xf5, 0xdf, 0xc3, 0x5c, 0xbb, 0x8f, 0xc3, 0x0c, 0xdf, 0x5f, 0xce, 0x3c, 0xe7,
+	0x6a, 0xe6, 0x39, 0xbf, 0x67, 0x9e, 0xf3, 0x75, 0xee, 0x75, 0xae, 0xe6, 0x5e, 0xe7, 0xe7, 0xdc,
+	0xeb, 0x7c, 0xc6, 0x77, 0xd2, 0x9e, 0xdb, 0x2f, 0x69, 0xe8, 0xe3, 0x07, 0xe6, 0x43, 0x1e, 0xfd,
+	0x0d, 0x00, 0x00, 0xff, 0xff, 0x69, 0x2c, 0x5b, 0x13, 0x2a, 0x04, 0x00, 0x00,
 }
 
 func (m *EventSwapCoins) Marshal() (dAtA []byte, err error) {
@@ -330,6 +442,89 @@ func (m *EventAddDenomMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventCloseSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCloseSwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCloseSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Closure.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventReverseSwapCoins) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventReverseSwapCoins) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventReverseSwapCoins) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FromCoinAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.ToCoinAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvent(v)
 	base := offset
@@ -380,6 +575,34 @@ func (m *EventAddDenomMetadata) Size() (n int) {
 	return n
 }
 
+func (m *EventCloseSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Closure.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventReverseSwapCoins) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.ToCoinAmount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.FromCoinAmount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
 func sovEvent(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -700,6 +923,237 @@ func (m *EventAddDenomMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventCloseSwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCloseSwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCloseSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Closure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Closure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventReverseSwapCoins) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventReverseSwapCoins: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventReverseSwapCoins: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToCoinAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ToCoinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromCoinAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FromCoinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvent(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/Finschia/finschia-sdk/types"
 	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
 )
@@ -27,9 +29,95 @@ func (s *Swap) ValidateBasic() error {
 		return sdkerrors.ErrInvalidRequest.Wrap("swap rate cannot be zero")
 	}
 
+	for i, phase := range s.RateSchedule {
+		if phase.SwapRate.IsNil() || !phase.SwapRate.IsPositive() {
+			return sdkerrors.ErrInvalidRequest.Wrapf("swap rate of phase %d must be positive", i+1)
+		}
+		if i > 0 && !phase.StartTime.After(s.RateSchedule[i-1].StartTime) {
+			return sdkerrors.ErrInvalidRequest.Wrap("rate schedule must be sorted by start time without duplicates")
+		}
+	}
+
+	if s.StartTime != nil && s.EndTime != nil && !s.EndTime.After(*s.StartTime) {
+		return sdkerrors.ErrInvalidRequest.Wrap("end time must be after start time")
+	}
+
+	return nil
+}
+
+// StatusAt returns the status of the swap at the given time.
+func (s *Swap) StatusAt(t time.Time) SwapStatus {
+	if s.StartTime != nil && t.Before(*s.StartTime) {
+		return SwapStatusNotStarted
+	}
+	if s.EndTime != nil && !t.Before(*s.EndTime) {
+		return SwapStatusEnded
+	}
+	return SwapStatusActive
+}
+
+// SwapRateAt returns the phase of the rate schedule which applies at the given time and its swap rate.
+// The phase zero means the base swap rate.
+func (s *Swap) SwapRateAt(t time.Time) (uint32, sdk.Dec) {
+	phase, rate := uint32(0), s.SwapRate
+	for i, p := range s.RateSchedule {
+		if t.Before(p.StartTime) {
+			break
+		}
+		phase, rate = uint32(i+1), p.SwapRate
+	}
+	return phase, rate
+}
+
+// MaxSwapRate returns the highest swap rate of the swap including its rate schedule.
+func (s *Swap) MaxSwapRate() sdk.Dec {
+	rate := s.SwapRate
+	for _, p := range s.RateSchedule {
+		if p.SwapRate.GT(rate) {
+			rate = p.SwapRate
+		}
+	}
+	return rate
+}
+
+// ActivePhaseAt returns the phase of the swap at the given time.
+func (s *Swap) ActivePhaseAt(t time.Time) ActiveSwapPhase {
+	phase, rate := s.SwapRateAt(t)
+	return ActiveSwapPhase{
+		FromDenom: s.FromDenom,
+		ToDenom:   s.ToDenom,
+		Status:    s.StatusAt(t),
+		Phase:     phase,
+		SwapRate:  rate,
+	}
+}
+
+// ValidateBasic validates the set of SwapClosure
+func (s *SwapClosure) ValidateBasic() error {
+	if err := sdk.ValidateDenom(s.FromDenom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if err := sdk.ValidateDenom(s.ToDenom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if s.SwapRate.IsNil() || !s.SwapRate.IsPositive() {
+		return sdkerrors.ErrInvalidRequest.Wrap("swap rate must be positive")
+	}
+
+	if s.ReverseSwapEndTime != nil && s.ReverseSwapEndTime.Before(s.ClosedAt) {
+		return sdkerrors.ErrInvalidRequest.Wrap("reverse swap end time cannot be before the closing time")
+	}
+
 	return nil
 }
 
+// IsReverseSwapAllowed returns true if the reverse swap of the closed swap is allowed at the given time.
+func (s *SwapClosure) IsReverseSwapAllowed(t time.Time) bool {
+	return s.ReverseSwapEndTime != nil && t.Before(*s.ReverseSwapEndTime)
+}
+
 // ValidateBasic validates the set of SwapStats
 func (s *SwapStats) ValidateBasic() error {
 	if s.SwapCount < 0 {
@@ -49,6 +137,23 @@ func (s *Swapped) ValidateBasic() error {
 	return nil
 }
 
+// ValidateBasic validates the SwapLedger
+func (s *SwapLedger) ValidateBasic() error {
+	if s.MaxSwapRate.IsNil() || !s.MaxSwapRate.IsPositive() {
+		return sdkerrors.ErrInvalidRequest.Wrap("max swap rate must be positive")
+	}
+	if err := validateCoinAmount(s.BurnedFromCoinAmount); err != nil {
+		return err
+	}
+	if err := validateCoinAmount(s.MintedToCoinAmount); err != nil {
+		return err
+	}
+	if s.BurnedFromCoinAmount.Denom != s.FromDenom || s.MintedToCoinAmount.Denom != s.ToDenom {
+		return sdkerrors.ErrInvalidCoins.Wrap("ledger denominations do not match the swap")
+	}
+	return nil
+}
+
 func validateCoinAmount(i interface{}) error {
 	v, ok := i.(sdk.Coin)
 	if !ok {
@@ -9,15 +9,19 @@ import (
 	types "github.com/Finschia/finschia-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -25,11 +29,58 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// SwapStatus defines whether a swap is available at a given time.
+type SwapStatus int32
+
+const (
+	// SWAP_STATUS_UNSPECIFIED defines an invalid status.
+	SwapStatusUnspecified SwapStatus = 0
+	// SWAP_STATUS_NOT_STARTED defines a swap whose start time has not been reached yet.
+	SwapStatusNotStarted SwapStatus = 1
+	// SWAP_STATUS_ACTIVE defines a swap which is available.
+	SwapStatusActive SwapStatus = 2
+	// SWAP_STATUS_ENDED defines a swap whose end time has passed.
+	SwapStatusEnded SwapStatus = 3
+	// SWAP_STATUS_CLOSED defines a swap which has been closed by the authority.
+	SwapStatusClosed SwapStatus = 4
+)
+
+var SwapStatus_name = map[int32]string{
+	0: "SWAP_STATUS_UNSPECIFIED",
+	1: "SWAP_STATUS_NOT_STARTED",
+	2: "SWAP_STATUS_ACTIVE",
+	3: "SWAP_STATUS_ENDED",
+	4: "SWAP_STATUS_CLOSED",
+}
+
+var SwapStatus_value = map[string]int32{
+	"SWAP_STATUS_UNSPECIFIED": 0,
+	"SWAP_STATUS_NOT_STARTED": 1,
+	"SWAP_STATUS_ACTIVE":      2,
+	"SWAP_STATUS_ENDED":       3,
+	"SWAP_STATUS_CLOSED":      4,
+}
+
+func (x SwapStatus) String() string {
+	return proto.EnumName(SwapStatus_name, int32(x))
+}
+
+func (SwapStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_42ca60eaf37a2b67, []int{0}
+}
+
 type Swap struct {
 	FromDenom           string                                     `protobuf:"bytes,1,opt,name=from_denom,json=fromDenom,proto3" json:"from_denom,omitempty"`
 	ToDenom             string                                     `protobuf:"bytes,2,opt,name=to_denom,json=toDenom,proto3" json:"to_denom,omitempty"`
 	AmountCapForToDenom github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,3,opt,name=amount_cap_for_to_denom,json=amountCapForToDenom,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"amount_cap_for_to_denom"`
-	SwapRate            github_com_Finschia_finschia_sdk_types.Dec `protobuf:"bytes,4,opt,name=swap_rate,json=swapRate,proto3,customtype=github.com/Finschia/finschia-sdk/types.Dec" json:"swap_rate"`
+	// the swap rate which applies until the first phase of the rate schedule starts.
+	SwapRate github_com_Finschia_finschia_sdk_types.Dec `protobuf:"bytes,4,opt,name=swap_rate,json=swapRate,proto3,customtype=github.com/Finschia/finschia-sdk/types.Dec" json:"swap_rate"`
+	// the phases replacing the swap rate over time, sorted by the start time.
+	RateSchedule []SwapRatePhase `protobuf:"bytes,5,rep,name=rate_schedule,json=rateSchedule,proto3" json:"rate_schedule"`
+	// the time from which the swap is available. unset means the swap is available immediately.
+	StartTime *time.Time `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty"`
+	// the time from which the swap is rejected. unset means the swap never ends.
+	EndTime *time.Time `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty"`
 }
 
 func (m *Swap) Reset()         { *m = Swap{} }
@@ -79,6 +130,219 @@ func (m *Swap) GetToDenom() string {
 	return ""
 }
 
+func (m *Swap) GetRateSchedule() []SwapRatePhase {
+	if m != nil {
+		return m.RateSchedule
+	}
+	return nil
+}
+
+func (m *Swap) GetStartTime() *time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *Swap) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+// SwapRatePhase defines the swap rate which applies from its start time until the next phase starts.
+type SwapRatePhase struct {
+	StartTime time.Time                                  `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	SwapRate  github_com_Finschia_finschia_sdk_types.Dec `protobuf:"bytes,2,opt,name=swap_rate,json=swapRate,proto3,customtype=github.com/Finschia/finschia-sdk/types.Dec" json:"swap_rate"`
+}
+
+func (m *SwapRatePhase) Reset()         { *m = SwapRatePhase{} }
+func (m *SwapRatePhase) String() string { return proto.CompactTextString(m) }
+func (*SwapRatePhase) ProtoMessage()    {}
+func (*SwapRatePhase) Descriptor() ([]byte, []int) {
+	return fileDescriptor_42ca60eaf37a2b67, []int{1}
+}
+func (m *SwapRatePhase) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapRatePhase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapRatePhase.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapRatePhase) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapRatePhase.Merge(m, src)
+}
+func (m *SwapRatePhase) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapRatePhase) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapRatePhase.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapRatePhase proto.InternalMessageInfo
+
+func (m *SwapRatePhase) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+// SwapClosure defines the retirement of a swap.
+type SwapClosure struct {
+	FromDenom string `protobuf:"bytes,1,opt,name=from_denom,json=fromDenom,proto3" json:"from_denom,omitempty"`
+	ToDenom   string `protobuf:"bytes,2,opt,name=to_denom,json=toDenom,proto3" json:"to_denom,omitempty"`
+	// the time the swap has been closed
+	ClosedAt time.Time `protobuf:"bytes,3,opt,name=closed_at,json=closedAt,proto3,stdtime" json:"closed_at"`
+	// the highest swap rate of the swap. The reverse swap uses its inverse so that it never returns more from-coin
+	// than burned.
+	SwapRate github_com_Finschia_finschia_sdk_types.Dec `protobuf:"bytes,4,opt,name=swap_rate,json=swapRate,proto3,customtype=github.com/Finschia/finschia-sdk/types.Dec" json:"swap_rate"`
+	// the time until which the reverse swap is allowed. unset means the reverse swap is not allowed.
+	ReverseSwapEndTime *time.Time `protobuf:"bytes,5,opt,name=reverse_swap_end_time,json=reverseSwapEndTime,proto3,stdtime" json:"reverse_swap_end_time,omitempty"`
+}
+
+func (m *SwapClosure) Reset()         { *m = SwapClosure{} }
+func (m *SwapClosure) String() string { return proto.CompactTextString(m) }
+func (*SwapClosure) ProtoMessage()    {}
+func (*SwapClosure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_42ca60eaf37a2b67, []int{2}
+}
+func (m *SwapClosure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapClosure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapClosure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapClosure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapClosure.Merge(m, src)
+}
+func (m *SwapClosure) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapClosure) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapClosure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapClosure proto.InternalMessageInfo
+
+func (m *SwapClosure) GetFromDenom() string {
+	if m != nil {
+		return m.FromDenom
+	}
+	return ""
+}
+
+func (m *SwapClosure) GetToDenom() string {
+	if m != nil {
+		return m.ToDenom
+	}
+	return ""
+}
+
+func (m *SwapClosure) GetClosedAt() time.Time {
+	if m != nil {
+		return m.ClosedAt
+	}
+	return time.Time{}
+}
+
+func (m *SwapClosure) GetReverseSwapEndTime() *time.Time {
+	if m != nil {
+		return m.ReverseSwapEndTime
+	}
+	return nil
+}
+
+// ActiveSwapPhase describes the phase of a swap at the current block time.
+type ActiveSwapPhase struct {
+	FromDenom string     `protobuf:"bytes,1,opt,name=from_denom,json=fromDenom,proto3" json:"from_denom,omitempty"`
+	ToDenom   string     `protobuf:"bytes,2,opt,name=to_denom,json=toDenom,proto3" json:"to_denom,omitempty"`
+	Status    SwapStatus `protobuf:"varint,3,opt,name=status,proto3,enum=lbm.fswap.v1.SwapStatus" json:"status,omitempty"`
+	// zero means the base swap rate of the swap, and n means the n-th phase of the rate schedule.
+	Phase uint32 `protobuf:"varint,4,opt,name=phase,proto3" json:"phase,omitempty"`
+	// the swap rate of the phase
+	SwapRate github_com_Finschia_finschia_sdk_types.Dec `protobuf:"bytes,5,opt,name=swap_rate,json=swapRate,proto3,customtype=github.com/Finschia/finschia-sdk/types.Dec" json:"swap_rate"`
+}
+
+func (m *ActiveSwapPhase) Reset()         { *m = ActiveSwapPhase{} }
+func (m *ActiveSwapPhase) String() string { return proto.CompactTextString(m) }
+func (*ActiveSwapPhase) ProtoMessage()    {}
+func (*ActiveSwapPhase) Descriptor() ([]byte, []int) {
+	return fileDescriptor_42ca60eaf37a2b67, []int{3}
+}
+func (m *ActiveSwapPhase) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActiveSwapPhase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActiveSwapPhase.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActiveSwapPhase) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActiveSwapPhase.Merge(m, src)
+}
+func (m *ActiveSwapPhase) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActiveSwapPhase) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActiveSwapPhase.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActiveSwapPhase proto.InternalMessageInfo
+
+func (m *ActiveSwapPhase) GetFromDenom() string {
+	if m != nil {
+		return m.FromDenom
+	}
+	return ""
+}
+
+func (m *ActiveSwapPhase) GetToDenom() string {
+	if m != nil {
+		return m.ToDenom
+	}
+	return ""
+}
+
+func (m *ActiveSwapPhase) GetStatus() SwapStatus {
+	if m != nil {
+		return m.Status
+	}
+	return SwapStatusUnspecified
+}
+
+func (m *ActiveSwapPhase) GetPhase() uint32 {
+	if m != nil {
+		return m.Phase
+	}
+	return 0
+}
+
 type SwapStats struct {
 	SwapCount int32 `protobuf:"varint,1,opt,name=swap_count,json=swapCount,proto3" json:"swap_count,omitempty"`
 }
@@ -87,7 +351,7 @@ func (m *SwapStats) Reset()         { *m = SwapStats{} }
 func (m *SwapStats) String() string { return proto.CompactTextString(m) }
 func (*SwapStats) ProtoMessage()    {}
 func (*SwapStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_42ca60eaf37a2b67, []int{1}
+	return fileDescriptor_42ca60eaf37a2b67, []int{4}
 }
 func (m *SwapStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -132,7 +396,7 @@ func (m *Swapped) Reset()         { *m = Swapped{} }
 func (m *Swapped) String() string { return proto.CompactTextString(m) }
 func (*Swapped) ProtoMessage()    {}
 func (*Swapped) Descriptor() ([]byte, []int) {
-	return fileDescriptor_42ca60eaf37a2b67, []int{2}
+	return fileDescriptor_42ca60eaf37a2b67, []int{5}
 }
 func (m *Swapped) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -175,235 +439,2300 @@ func (m *Swapped) GetToCoinAmount() types.Coin {
 	return types.Coin{}
 }
 
-func init() {
-	proto.RegisterType((*Swap)(nil), "lbm.fswap.v1.Swap")
-	proto.RegisterType((*SwapStats)(nil), "lbm.fswap.v1.SwapStats")
-	proto.RegisterType((*Swapped)(nil), "lbm.fswap.v1.Swapped")
-}
-
-func init() { proto.RegisterFile("lbm/fswap/v1/fswap.proto", fileDescriptor_42ca60eaf37a2b67) }
-
-var fileDescriptor_42ca60eaf37a2b67 = []byte{
-	// 397 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x92, 0xcf, 0xae, 0xd2, 0x40,
-	0x14, 0xc6, 0x5b, 0x44, 0xa1, 0x23, 0x21, 0xa6, 0x9a, 0x08, 0x24, 0x16, 0xc3, 0xca, 0x60, 0x9c,
-	0x49, 0xe1, 0x09, 0x04, 0x42, 0x74, 0x65, 0x52, 0x5c, 0xb9, 0x69, 0xa6, 0x65, 0x0a, 0x8d, 0xb4,
-	0xa7, 0xe9, 0x0c, 0x7f, 0x7c, 0x0b, 0x9f, 0xc3, 0x27, 0x61, 0xc9, 0xd2, 0xb8, 0x40, 0x03, 0x4f,
-	0x61, 0xdc, 0x98, 0x33, 0xd3, 0x68, 0x5c, 0x79, 0x73, 0x93, 0xbb, 0x3b, 0x39, 0xdf, 0x99, 0xef,
-	0x77, 0xe6, 0xcb, 0x21, 0x9d, 0x4d, 0x94, 0xb1, 0x44, 0xee, 0x79, 0xc1, 0x76, 0xbe, 0x29, 0x68,
-	0x51, 0x82, 0x02, 0xb7, 0xb5, 0x89, 0x32, 0x6a, 0x1a, 0x3b, 0xbf, 0xf7, 0x64, 0x05, 0x2b, 0xd0,
-	0x02, 0xc3, 0xca, 0xcc, 0xf4, 0xbc, 0x18, 0x64, 0x06, 0x92, 0x45, 0x5c, 0x0a, 0xb6, 0xf3, 0x23,
-	0xa1, 0xb8, 0xcf, 0x62, 0x48, 0x73, 0xa3, 0x0f, 0x7e, 0xd9, 0xa4, 0xbe, 0xd8, 0xf3, 0xc2, 0x7d,
-	0x46, 0x48, 0x52, 0x42, 0x16, 0x2e, 0x45, 0x0e, 0x59, 0xc7, 0x7e, 0x6e, 0xbf, 0x70, 0x02, 0x07,
-	0x3b, 0x33, 0x6c, 0xb8, 0x5d, 0xd2, 0x54, 0x50, 0x89, 0x35, 0x2d, 0x36, 0x14, 0x18, 0x69, 0x4d,
-	0x9e, 0xf2, 0x0c, 0xb6, 0xb9, 0x0a, 0x63, 0x5e, 0x84, 0x09, 0x94, 0xe1, 0x9f, 0xc9, 0x7b, 0x38,
-	0x39, 0x19, 0x1d, 0xcf, 0x7d, 0xeb, 0xdb, 0xb9, 0x3f, 0x5c, 0xa5, 0x6a, 0xbd, 0x8d, 0x68, 0x0c,
-	0x19, 0x9b, 0xa7, 0xb9, 0x8c, 0xd7, 0x29, 0x67, 0x49, 0x55, 0xbc, 0x92, 0xcb, 0x8f, 0x4c, 0x7d,
-	0x2a, 0x84, 0xa4, 0x6f, 0x73, 0x15, 0x3c, 0x36, 0x96, 0x53, 0x5e, 0xcc, 0xa1, 0x7c, 0x5f, 0x91,
-	0xde, 0x11, 0x07, 0x7f, 0x1b, 0x96, 0x5c, 0x89, 0x4e, 0xfd, 0x56, 0xde, 0x33, 0x11, 0x07, 0x4d,
-	0x34, 0x09, 0xb8, 0x12, 0x83, 0x21, 0x71, 0xf0, 0xf3, 0x0b, 0xc5, 0x95, 0xc4, 0x04, 0xb4, 0x7b,
-	0x8c, 0x60, 0x9d, 0xc0, 0xfd, 0x40, 0xf3, 0xa6, 0xd8, 0x18, 0xfc, 0xb4, 0x49, 0x03, 0x87, 0x0b,
-	0xb1, 0x74, 0x0f, 0xe4, 0x91, 0x0e, 0x0b, 0x83, 0x0c, 0xcd, 0xa6, 0xfa, 0xc1, 0xc3, 0x51, 0x97,
-	0x9a, 0xc0, 0x29, 0x06, 0x4e, 0xab, 0xc0, 0xe9, 0x14, 0xd2, 0x7c, 0x32, 0xc6, 0x55, 0xbf, 0x7c,
-	0xef, 0xbf, 0xbc, 0xe1, 0xaa, 0xf8, 0x28, 0x68, 0x23, 0x07, 0xab, 0xd7, 0x9a, 0xe2, 0x2a, 0xd2,
-	0x56, 0xf0, 0x0f, 0xb7, 0x76, 0x27, 0xdc, 0x96, 0x82, 0xbf, 0xd4, 0xc9, 0x9b, 0xe3, 0xc5, 0xb3,
-	0x4f, 0x17, 0xcf, 0xfe, 0x71, 0xf1, 0xec, 0xcf, 0x57, 0xcf, 0x3a, 0x5d, 0x3d, 0xeb, 0xeb, 0xd5,
-	0xb3, 0x3e, 0xd0, 0xff, 0x9a, 0x1e, 0xaa, 0xe3, 0xd5, 0xe6, 0xd1, 0x03, 0x7d, 0x76, 0xe3, 0xdf,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x53, 0x35, 0xf1, 0x71, 0xd6, 0x02, 0x00, 0x00,
+// SwapLedger records the coins actually burned and minted by the module for a swap, against which its Swapped is
+// audited.
+type SwapLedger struct {
+	FromDenom string `protobuf:"bytes,1,opt,name=from_denom,json=fromDenom,proto3" json:"from_denom,omitempty"`
+	ToDenom   string `protobuf:"bytes,2,opt,name=to_denom,json=toDenom,proto3" json:"to_denom,omitempty"`
+	// the highest swap rate the swap has ever had, including the rates replaced by the updates of the swap
+	MaxSwapRate github_com_Finschia_finschia_sdk_types.Dec `protobuf:"bytes,3,opt,name=max_swap_rate,json=maxSwapRate,proto3,customtype=github.com/Finschia/finschia-sdk/types.Dec" json:"max_swap_rate"`
+	// the from-coin burned by the swaps, net of the from-coin minted by the reverse swaps
+	BurnedFromCoinAmount types.Coin `protobuf:"bytes,4,opt,name=burned_from_coin_amount,json=burnedFromCoinAmount,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coin" json:"burned_from_coin_amount"`
+	// the to-coin minted by the swaps, net of the to-coin burned by the reverse swaps
+	MintedToCoinAmount types.Coin `protobuf:"bytes,5,opt,name=minted_to_coin_amount,json=mintedToCoinAmount,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coin" json:"minted_to_coin_amount"`
 }
 
-func (m *Swap) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *SwapLedger) Reset()         { *m = SwapLedger{} }
+func (m *SwapLedger) String() string { return proto.CompactTextString(m) }
+func (*SwapLedger) ProtoMessage()    {}
+func (*SwapLedger) Descriptor() ([]byte, []int) {
+	return fileDescriptor_42ca60eaf37a2b67, []int{6}
 }
-
-func (m *Swap) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SwapLedger) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *Swap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.SwapRate.Size()
-		i -= size
-		if _, err := m.SwapRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintFswap(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.AmountCapForToDenom.Size()
-		i -= size
-		if _, err := m.AmountCapForToDenom.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *SwapLedger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapLedger.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintFswap(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.ToDenom) > 0 {
-		i -= len(m.ToDenom)
-		copy(dAtA[i:], m.ToDenom)
-		i = encodeVarintFswap(dAtA, i, uint64(len(m.ToDenom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.FromDenom) > 0 {
-		i -= len(m.FromDenom)
-		copy(dAtA[i:], m.FromDenom)
-		i = encodeVarintFswap(dAtA, i, uint64(len(m.FromDenom)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SwapLedger) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapLedger.Merge(m, src)
+}
+func (m *SwapLedger) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapLedger) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapLedger.DiscardUnknown(m)
 }
 
-func (m *SwapStats) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SwapLedger proto.InternalMessageInfo
+
+func (m *SwapLedger) GetFromDenom() string {
+	if m != nil {
+		return m.FromDenom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *SwapStats) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SwapLedger) GetToDenom() string {
+	if m != nil {
+		return m.ToDenom
+	}
+	return ""
 }
 
-func (m *SwapStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SwapCount != 0 {
-		i = encodeVarintFswap(dAtA, i, uint64(m.SwapCount))
-		i--
-		dAtA[i] = 0x8
+func (m *SwapLedger) GetBurnedFromCoinAmount() types.Coin {
+	if m != nil {
+		return m.BurnedFromCoinAmount
 	}
-	return len(dAtA) - i, nil
+	return types.Coin{}
 }
 
-func (m *Swapped) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SwapLedger) GetMintedToCoinAmount() types.Coin {
+	if m != nil {
+		return m.MintedToCoinAmount
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *Swapped) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// SwapAudit is the breakdown of the consistency checks of a swap.
+type SwapAudit struct {
+	FromDenom string `protobuf:"bytes,1,opt,name=from_denom,json=fromDenom,proto3" json:"from_denom,omitempty"`
+	ToDenom   string `protobuf:"bytes,2,opt,name=to_denom,json=toDenom,proto3" json:"to_denom,omitempty"`
+	// the from-coin swapped, net of the reverse swaps
+	BurnedFromCoinAmount types.Coin `protobuf:"bytes,3,opt,name=burned_from_coin_amount,json=burnedFromCoinAmount,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coin" json:"burned_from_coin_amount"`
+	// the to-coin swapped to, net of the reverse swaps
+	MintedToCoinAmount types.Coin `protobuf:"bytes,4,opt,name=minted_to_coin_amount,json=mintedToCoinAmount,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coin" json:"minted_to_coin_amount"`
+	// the highest swap rate the swap has ever had, including its rate schedule and the rates of its past updates
+	MaxSwapRate github_com_Finschia_finschia_sdk_types.Dec `protobuf:"bytes,5,opt,name=max_swap_rate,json=maxSwapRate,proto3,customtype=github.com/Finschia/finschia-sdk/types.Dec" json:"max_swap_rate"`
+	// the maximum amount of to-coin which the burned from-coin can be swapped to
+	MaxMintableToAmount github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,6,opt,name=max_mintable_to_amount,json=maxMintableToAmount,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"max_mintable_to_amount"`
+	AmountCapForToDenom github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,7,opt,name=amount_cap_for_to_denom,json=amountCapForToDenom,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"amount_cap_for_to_denom"`
+	// the supply of the to-coin
+	ToCoinSupply types.Coin `protobuf:"bytes,8,opt,name=to_coin_supply,json=toCoinSupply,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coin" json:"to_coin_supply"`
+	// the from-coin and to-coin held by the module account, which must be empty as every received coin is burned
+	Unburned github_com_Finschia_finschia_sdk_types.Coins `protobuf:"bytes,9,rep,name=unburned,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coins" json:"unburned"`
+	// whether the minted to-coin exceeds the max mintable amount
+	OverMinted bool `protobuf:"varint,10,opt,name=over_minted,json=overMinted,proto3" json:"over_minted,omitempty"`
+	// whether the minted to-coin exceeds the amount cap
+	CapExceeded bool `protobuf:"varint,11,opt,name=cap_exceeded,json=capExceeded,proto3" json:"cap_exceeded,omitempty"`
+	// the from-coin actually burned by the module, which must equal burned_from_coin_amount
+	LedgerBurnedFromCoinAmount types.Coin `protobuf:"bytes,12,opt,name=ledger_burned_from_coin_amount,json=ledgerBurnedFromCoinAmount,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coin" json:"ledger_burned_from_coin_amount"`
+	// the to-coin actually minted by the module, which must equal minted_to_coin_amount
+	LedgerMintedToCoinAmount types.Coin `protobuf:"bytes,13,opt,name=ledger_minted_to_coin_amount,json=ledgerMintedToCoinAmount,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coin" json:"ledger_minted_to_coin_amount"`
+	// whether the coins actually burned or minted differ from the swapped amounts
+	LedgerMismatched bool `protobuf:"varint,14,opt,name=ledger_mismatched,json=ledgerMismatched,proto3" json:"ledger_mismatched,omitempty"`
+	// whether the minted to-coin, either swapped or actually minted, exceeds the supply of the to-coin
+	SupplyExceeded bool `protobuf:"varint,15,opt,name=supply_exceeded,json=supplyExceeded,proto3" json:"supply_exceeded,omitempty"`
 }
 
-func (m *Swapped) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
+func (m *SwapAudit) Reset()         { *m = SwapAudit{} }
+func (m *SwapAudit) String() string { return proto.CompactTextString(m) }
+func (*SwapAudit) ProtoMessage()    {}
+func (*SwapAudit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_42ca60eaf37a2b67, []int{7}
+}
+func (m *SwapAudit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapAudit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapAudit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapAudit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapAudit.Merge(m, src)
+}
+func (m *SwapAudit) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapAudit) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapAudit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapAudit proto.InternalMessageInfo
+
+func (m *SwapAudit) GetFromDenom() string {
+	if m != nil {
+		return m.FromDenom
+	}
+	return ""
+}
+
+func (m *SwapAudit) GetToDenom() string {
+	if m != nil {
+		return m.ToDenom
+	}
+	return ""
+}
+
+func (m *SwapAudit) GetBurnedFromCoinAmount() types.Coin {
+	if m != nil {
+		return m.BurnedFromCoinAmount
+	}
+	return types.Coin{}
+}
+
+func (m *SwapAudit) GetMintedToCoinAmount() types.Coin {
+	if m != nil {
+		return m.MintedToCoinAmount
+	}
+	return types.Coin{}
+}
+
+func (m *SwapAudit) GetToCoinSupply() types.Coin {
+	if m != nil {
+		return m.ToCoinSupply
+	}
+	return types.Coin{}
+}
+
+func (m *SwapAudit) GetUnburned() github_com_Finschia_finschia_sdk_types.Coins {
+	if m != nil {
+		return m.Unburned
+	}
+	return nil
+}
+
+func (m *SwapAudit) GetOverMinted() bool {
+	if m != nil {
+		return m.OverMinted
+	}
+	return false
+}
+
+func (m *SwapAudit) GetCapExceeded() bool {
+	if m != nil {
+		return m.CapExceeded
+	}
+	return false
+}
+
+func (m *SwapAudit) GetLedgerBurnedFromCoinAmount() types.Coin {
+	if m != nil {
+		return m.LedgerBurnedFromCoinAmount
+	}
+	return types.Coin{}
+}
+
+func (m *SwapAudit) GetLedgerMintedToCoinAmount() types.Coin {
+	if m != nil {
+		return m.LedgerMintedToCoinAmount
+	}
+	return types.Coin{}
+}
+
+func (m *SwapAudit) GetLedgerMismatched() bool {
+	if m != nil {
+		return m.LedgerMismatched
+	}
+	return false
+}
+
+func (m *SwapAudit) GetSupplyExceeded() bool {
+	if m != nil {
+		return m.SupplyExceeded
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterEnum("lbm.fswap.v1.SwapStatus", SwapStatus_name, SwapStatus_value)
+	proto.RegisterType((*Swap)(nil), "lbm.fswap.v1.Swap")
+	proto.RegisterType((*SwapRatePhase)(nil), "lbm.fswap.v1.SwapRatePhase")
+	proto.RegisterType((*SwapClosure)(nil), "lbm.fswap.v1.SwapClosure")
+	proto.RegisterType((*ActiveSwapPhase)(nil), "lbm.fswap.v1.ActiveSwapPhase")
+	proto.RegisterType((*SwapStats)(nil), "lbm.fswap.v1.SwapStats")
+	proto.RegisterType((*Swapped)(nil), "lbm.fswap.v1.Swapped")
+	proto.RegisterType((*SwapLedger)(nil), "lbm.fswap.v1.SwapLedger")
+	proto.RegisterType((*SwapAudit)(nil), "lbm.fswap.v1.SwapAudit")
+}
+
+func init() { proto.RegisterFile("lbm/fswap/v1/fswap.proto", fileDescriptor_42ca60eaf37a2b67) }
+
+var fileDescriptor_42ca60eaf37a2b67 = []byte{
+	// 1102 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcb, 0x6f, 0x23, 0xc5,
+	0x13, 0xf6, 0xf8, 0x91, 0xd8, 0xed, 0x3c, 0xbc, 0xbd, 0xc9, 0x2f, 0x13, 0xff, 0xc0, 0x36, 0xb9,
+	0x10, 0x65, 0x97, 0x19, 0x92, 0x05, 0x2e, 0x1c, 0x90, 0x5f, 0x11, 0x91, 0x36, 0x0f, 0xcd, 0x38,
+	0x8b, 0xc4, 0x65, 0xd4, 0x9e, 0x69, 0x3b, 0xa3, 0xf5, 0x4c, 0x8f, 0xa6, 0x7b, 0xbc, 0xde, 0x23,
+	0x07, 0x24, 0xc8, 0x01, 0xad, 0xb8, 0x47, 0x1c, 0x10, 0x97, 0x3d, 0xf1, 0x2f, 0x70, 0xdb, 0xe3,
+	0x1e, 0x11, 0x87, 0x5d, 0x94, 0xf0, 0x4f, 0x70, 0x43, 0xdd, 0x3d, 0x8e, 0xe3, 0x64, 0xc9, 0x86,
+	0x24, 0x46, 0xdc, 0x3a, 0xd5, 0x55, 0xf5, 0x55, 0x7f, 0x5f, 0x4d, 0xa5, 0x0c, 0xd4, 0x5e, 0xdb,
+	0xd3, 0x3b, 0xf4, 0x09, 0x0a, 0xf4, 0xfe, 0xba, 0x3c, 0x68, 0x41, 0x48, 0x18, 0x81, 0x33, 0xbd,
+	0xb6, 0xa7, 0x49, 0x43, 0x7f, 0xbd, 0x58, 0xee, 0x12, 0xd2, 0xed, 0x61, 0x5d, 0xdc, 0xb5, 0xa3,
+	0x8e, 0xce, 0x5c, 0x0f, 0x53, 0x86, 0xbc, 0xd8, 0xbd, 0xb8, 0xd0, 0x25, 0x5d, 0x22, 0x8e, 0x3a,
+	0x3f, 0xc5, 0xd6, 0x92, 0x4d, 0xa8, 0x47, 0xa8, 0xde, 0x46, 0x14, 0xeb, 0xfd, 0xf5, 0x36, 0x66,
+	0x68, 0x5d, 0xb7, 0x89, 0xeb, 0xcb, 0xfb, 0x95, 0x5f, 0x52, 0x20, 0x6d, 0x3e, 0x41, 0x01, 0x7c,
+	0x17, 0x80, 0x4e, 0x48, 0x3c, 0xcb, 0xc1, 0x3e, 0xf1, 0x54, 0xa5, 0xa2, 0xac, 0xe6, 0x8c, 0x1c,
+	0xb7, 0x34, 0xb8, 0x01, 0x2e, 0x83, 0x2c, 0x23, 0xf1, 0x65, 0x52, 0x5c, 0x4e, 0x33, 0x22, 0xaf,
+	0x0e, 0xc0, 0x12, 0xf2, 0x48, 0xe4, 0x33, 0xcb, 0x46, 0x81, 0xd5, 0x21, 0xa1, 0x75, 0xea, 0x99,
+	0xe2, 0x9e, 0xb5, 0x8d, 0x17, 0xaf, 0xca, 0x89, 0xdf, 0x5e, 0x95, 0xd7, 0xba, 0x2e, 0x3b, 0x88,
+	0xda, 0x9a, 0x4d, 0x3c, 0x7d, 0xd3, 0xf5, 0xa9, 0x7d, 0xe0, 0x22, 0xbd, 0x13, 0x1f, 0x3e, 0xa0,
+	0xce, 0x63, 0x9d, 0x3d, 0x0d, 0x30, 0xd5, 0xb6, 0x7c, 0x66, 0xdc, 0x95, 0x29, 0xeb, 0x28, 0xd8,
+	0x24, 0x61, 0x2b, 0x46, 0xda, 0x05, 0x39, 0x4e, 0x87, 0x15, 0x22, 0x86, 0xd5, 0xf4, 0xb5, 0x72,
+	0x37, 0xb0, 0x6d, 0x64, 0x79, 0x12, 0x03, 0x31, 0x0c, 0x37, 0xc1, 0x2c, 0xcf, 0x65, 0x51, 0xfb,
+	0x00, 0x3b, 0x51, 0x0f, 0xab, 0x99, 0x4a, 0x6a, 0x35, 0xbf, 0xf1, 0x7f, 0xed, 0x2c, 0xf5, 0x9a,
+	0x19, 0xbb, 0xef, 0x1d, 0x20, 0x8a, 0x6b, 0x69, 0x8e, 0x68, 0xcc, 0xf0, 0x38, 0x33, 0x0e, 0x83,
+	0x9f, 0x01, 0x40, 0x19, 0x0a, 0x99, 0xc5, 0x45, 0x51, 0xa7, 0x2a, 0xca, 0x6a, 0x7e, 0xa3, 0xa8,
+	0x49, 0xc5, 0xb4, 0xa1, 0x62, 0x5a, 0x6b, 0xa8, 0x58, 0x2d, 0xfd, 0xec, 0x75, 0x59, 0x31, 0x72,
+	0x22, 0x86, 0x5b, 0xe1, 0xa7, 0x20, 0x8b, 0x7d, 0x47, 0x86, 0x4f, 0x5f, 0x31, 0x7c, 0x1a, 0xfb,
+	0x0e, 0xb7, 0xad, 0xfc, 0xa4, 0x80, 0xd9, 0xb1, 0x1a, 0x61, 0x7d, 0xac, 0x1e, 0xe5, 0xad, 0x09,
+	0xb3, 0xfc, 0x4d, 0xe7, 0x6b, 0x1a, 0x63, 0x3b, 0x79, 0x73, 0xb6, 0x57, 0x7e, 0x4e, 0x82, 0x3c,
+	0xaf, 0xb3, 0xde, 0x23, 0x34, 0x0a, 0xf1, 0x0d, 0x5a, 0xae, 0x0a, 0x72, 0x76, 0x8f, 0x50, 0xec,
+	0x58, 0x88, 0x89, 0x26, 0xbb, 0xea, 0xf3, 0xb2, 0x32, 0xac, 0xca, 0x6e, 0xbf, 0x97, 0x4c, 0xb0,
+	0x18, 0xe2, 0x3e, 0x0e, 0x29, 0xb6, 0x44, 0xe2, 0x53, 0x3d, 0x33, 0x57, 0xd4, 0x13, 0xc6, 0xe1,
+	0x9c, 0xa2, 0x66, 0x2c, 0xed, 0x1f, 0x0a, 0x98, 0xaf, 0xda, 0xcc, 0xed, 0x0b, 0xab, 0x14, 0xf7,
+	0xfa, 0xb4, 0x7d, 0x08, 0xa6, 0x28, 0x43, 0x2c, 0xa2, 0x82, 0xb3, 0xb9, 0x0d, 0xf5, 0x62, 0x9f,
+	0x9b, 0xe2, 0xde, 0x88, 0xfd, 0xe0, 0x02, 0xc8, 0x04, 0x1c, 0x54, 0x30, 0x34, 0x6b, 0xc8, 0x3f,
+	0xc6, 0xb9, 0xcb, 0xdc, 0x42, 0x67, 0xac, 0x81, 0xdc, 0x10, 0x9c, 0xf2, 0xf7, 0x89, 0xec, 0x36,
+	0x1f, 0x00, 0xe2, 0x7d, 0x19, 0x43, 0xe0, 0xd5, 0xb9, 0x61, 0xe5, 0x4f, 0x05, 0x4c, 0x73, 0xe7,
+	0x00, 0x3b, 0x70, 0x00, 0x0a, 0x82, 0x0a, 0x3e, 0xd0, 0x2c, 0x39, 0x31, 0xe2, 0x6e, 0x5f, 0xd6,
+	0xe4, 0xe0, 0xd3, 0xf8, 0xe0, 0xd3, 0xe2, 0xc1, 0xa7, 0xd5, 0x89, 0xeb, 0xd7, 0x1e, 0xf0, 0x52,
+	0x9f, 0xbf, 0x2e, 0xdf, 0xbb, 0x62, 0xa9, 0x3c, 0xc8, 0x98, 0xe3, 0x38, 0xfc, 0x54, 0x15, 0x28,
+	0x90, 0x81, 0x39, 0x46, 0xc6, 0x70, 0x93, 0x13, 0xc1, 0x9d, 0x61, 0x64, 0x84, 0xba, 0xf2, 0x3c,
+	0x05, 0x00, 0x7f, 0xfb, 0x43, 0xec, 0x74, 0x71, 0x78, 0x83, 0x4e, 0x78, 0x04, 0x66, 0x3d, 0x34,
+	0xb0, 0x46, 0x2a, 0xa6, 0xae, 0xad, 0x62, 0xde, 0x43, 0x83, 0xe1, 0xf4, 0x81, 0x5f, 0x2b, 0x60,
+	0xa9, 0x1d, 0x85, 0x3e, 0x76, 0xac, 0x0b, 0xc2, 0xa4, 0x27, 0x42, 0xd0, 0x82, 0x84, 0xdb, 0x1c,
+	0x97, 0xe7, 0x2b, 0x05, 0x2c, 0x7a, 0xae, 0xcf, 0xb0, 0x63, 0x9d, 0x93, 0x29, 0x33, 0x91, 0x2a,
+	0xa0, 0x04, 0x6b, 0x9d, 0x15, 0xeb, 0x07, 0x20, 0xbb, 0xba, 0x1a, 0x39, 0x2e, 0xbb, 0x81, 0x56,
+	0x97, 0x71, 0x9a, 0xfa, 0x4f, 0x70, 0x9a, 0xfe, 0xb7, 0x38, 0xbd, 0xd8, 0xb7, 0x99, 0xdb, 0xe9,
+	0xdb, 0x2e, 0xf8, 0x1f, 0xcf, 0xcb, 0x11, 0x51, 0xbb, 0x87, 0xf9, 0x03, 0xe3, 0xb7, 0x4d, 0x5d,
+	0x7f, 0x85, 0xf1, 0xd0, 0x60, 0x3b, 0x4e, 0xd8, 0x22, 0xf1, 0x03, 0x2e, 0x59, 0x96, 0xa6, 0x6f,
+	0x77, 0x59, 0x3a, 0x33, 0xa1, 0x68, 0x14, 0x04, 0xbd, 0xa7, 0x6a, 0x76, 0x92, 0x13, 0xca, 0x14,
+	0x18, 0xf0, 0x31, 0xc8, 0x46, 0xbe, 0x6c, 0x1f, 0x35, 0x27, 0x96, 0xa9, 0x4b, 0xf0, 0x3e, 0x8a,
+	0xf1, 0xee, 0xff, 0x03, 0x3c, 0x6a, 0x9c, 0x02, 0xc0, 0x32, 0xc8, 0x93, 0x3e, 0x0e, 0x2d, 0xd9,
+	0x28, 0x2a, 0xa8, 0x28, 0xab, 0x59, 0x03, 0x70, 0xd3, 0xb6, 0xb0, 0xc0, 0xf7, 0xc0, 0x0c, 0xa7,
+	0x19, 0x0f, 0x6c, 0x8c, 0x1d, 0xec, 0xa8, 0x79, 0xe1, 0x91, 0xb7, 0x51, 0xd0, 0x8c, 0x4d, 0xf0,
+	0x7b, 0x05, 0x94, 0x7a, 0x62, 0x9c, 0x5a, 0x7f, 0xf7, 0x91, 0xcd, 0x4c, 0x84, 0xb7, 0xa2, 0x44,
+	0xad, 0xbd, 0xe9, 0x53, 0xfb, 0x4e, 0x01, 0xef, 0xc4, 0x45, 0xbd, 0xf9, 0x8b, 0x9b, 0x9d, 0x48,
+	0x49, 0xaa, 0xc4, 0xdc, 0xbe, 0xf8, 0xdd, 0xdd, 0x03, 0x77, 0x4e, 0xeb, 0xa1, 0x1e, 0x62, 0x7c,
+	0xf1, 0x55, 0xe7, 0x04, 0x9b, 0x85, 0x61, 0xd0, 0xd0, 0x0e, 0xdf, 0x07, 0xf3, 0xb2, 0xe3, 0x46,
+	0xc4, 0xcf, 0x0b, 0xd7, 0x39, 0x69, 0x1e, 0x72, 0xbf, 0xf6, 0x6d, 0x52, 0xfe, 0x3b, 0x93, 0x4b,
+	0x07, 0xfc, 0x04, 0x2c, 0x99, 0x5f, 0x54, 0xf7, 0x2c, 0xb3, 0x55, 0x6d, 0xed, 0x9b, 0xd6, 0xfe,
+	0x8e, 0xb9, 0xd7, 0xac, 0x6f, 0x6d, 0x6e, 0x35, 0x1b, 0x85, 0x44, 0x71, 0xf9, 0xf0, 0xa8, 0xb2,
+	0x38, 0x72, 0xde, 0xf7, 0x69, 0x80, 0x6d, 0xb7, 0xe3, 0x62, 0x07, 0x7e, 0x3c, 0x1e, 0xb7, 0xb3,
+	0xdb, 0xe2, 0x47, 0xa3, 0xd5, 0x6c, 0x14, 0x94, 0xa2, 0x7a, 0x78, 0x54, 0x59, 0x18, 0xc5, 0xed,
+	0x10, 0x66, 0xf2, 0x0d, 0x17, 0x3b, 0xf0, 0x3e, 0x80, 0x67, 0xc3, 0xaa, 0xf5, 0xd6, 0xd6, 0xa3,
+	0x66, 0x21, 0x59, 0x5c, 0x38, 0x3c, 0xaa, 0x14, 0x46, 0x11, 0x72, 0xfd, 0x82, 0x6b, 0xe0, 0xce,
+	0x59, 0xef, 0xe6, 0x4e, 0xa3, 0xd9, 0x28, 0xa4, 0x8a, 0x77, 0x0f, 0x8f, 0x2a, 0xf3, 0x23, 0xe7,
+	0xa6, 0xef, 0x5c, 0xcc, 0x5c, 0x7f, 0xb8, 0x6b, 0x36, 0x1b, 0x85, 0xf4, 0xf9, 0xcc, 0x75, 0xb1,
+	0x8b, 0x16, 0xd3, 0xdf, 0xfc, 0x58, 0x4a, 0xd4, 0x3e, 0x7f, 0x71, 0x5c, 0x52, 0x5e, 0x1e, 0x97,
+	0x94, 0xdf, 0x8f, 0x4b, 0xca, 0xb3, 0x93, 0x52, 0xe2, 0xe5, 0x49, 0x29, 0xf1, 0xeb, 0x49, 0x29,
+	0xf1, 0xa5, 0xf6, 0x56, 0x09, 0x07, 0xf1, 0x0f, 0x48, 0x21, 0x65, 0x7b, 0x4a, 0x6c, 0x98, 0x0f,
+	0xfe, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x30, 0x17, 0xc5, 0xdb, 0x5a, 0x0e, 0x00, 0x00,
+}
+
+func (m *Swap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Swap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Swap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.EndTime != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintFswap(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.StartTime != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintFswap(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.RateSchedule) > 0 {
+		for iNdEx := len(m.RateSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFswap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	{
-		size, err := m.ToCoinAmount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.SwapRate.Size()
+		i -= size
+		if _, err := m.SwapRate.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintFswap(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
+	dAtA[i] = 0x22
 	{
-		size, err := m.FromCoinAmount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.AmountCapForToDenom.Size()
+		i -= size
+		if _, err := m.AmountCapForToDenom.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintFswap(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x1a
+	if len(m.ToDenom) > 0 {
+		i -= len(m.ToDenom)
+		copy(dAtA[i:], m.ToDenom)
+		i = encodeVarintFswap(dAtA, i, uint64(len(m.ToDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromDenom) > 0 {
+		i -= len(m.FromDenom)
+		copy(dAtA[i:], m.FromDenom)
+		i = encodeVarintFswap(dAtA, i, uint64(len(m.FromDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintFswap(dAtA []byte, offset int, v uint64) int {
-	offset -= sovFswap(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SwapRatePhase) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Swap) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *SwapRatePhase) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapRatePhase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FromDenom)
-	if l > 0 {
-		n += 1 + l + sovFswap(uint64(l))
+	{
+		size := m.SwapRate.Size()
+		i -= size
+		if _, err := m.SwapRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFswap(dAtA, i, uint64(size))
 	}
-	l = len(m.ToDenom)
-	if l > 0 {
-		n += 1 + l + sovFswap(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err3 != nil {
+		return 0, err3
 	}
-	l = m.AmountCapForToDenom.Size()
-	n += 1 + l + sovFswap(uint64(l))
-	l = m.SwapRate.Size()
-	n += 1 + l + sovFswap(uint64(l))
-	return n
+	i -= n3
+	i = encodeVarintFswap(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *SwapStats) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SwapCount != 0 {
-		n += 1 + sovFswap(uint64(m.SwapCount))
+func (m *SwapClosure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Swapped) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SwapClosure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapClosure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.FromCoinAmount.Size()
-	n += 1 + l + sovFswap(uint64(l))
-	l = m.ToCoinAmount.Size()
-	n += 1 + l + sovFswap(uint64(l))
-	return n
+	if m.ReverseSwapEndTime != nil {
+		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ReverseSwapEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ReverseSwapEndTime):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintFswap(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.SwapRate.Size()
+		i -= size
+		if _, err := m.SwapRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ClosedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ClosedAt):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintFswap(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ToDenom) > 0 {
+		i -= len(m.ToDenom)
+		copy(dAtA[i:], m.ToDenom)
+		i = encodeVarintFswap(dAtA, i, uint64(len(m.ToDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromDenom) > 0 {
+		i -= len(m.FromDenom)
+		copy(dAtA[i:], m.FromDenom)
+		i = encodeVarintFswap(dAtA, i, uint64(len(m.FromDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovFswap(x uint64) (n int) {
+func (m *ActiveSwapPhase) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActiveSwapPhase) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActiveSwapPhase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SwapRate.Size()
+		i -= size
+		if _, err := m.SwapRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Phase != 0 {
+		i = encodeVarintFswap(dAtA, i, uint64(m.Phase))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Status != 0 {
+		i = encodeVarintFswap(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ToDenom) > 0 {
+		i -= len(m.ToDenom)
+		copy(dAtA[i:], m.ToDenom)
+		i = encodeVarintFswap(dAtA, i, uint64(len(m.ToDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromDenom) > 0 {
+		i -= len(m.FromDenom)
+		copy(dAtA[i:], m.FromDenom)
+		i = encodeVarintFswap(dAtA, i, uint64(len(m.FromDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SwapCount != 0 {
+		i = encodeVarintFswap(dAtA, i, uint64(m.SwapCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Swapped) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Swapped) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Swapped) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ToCoinAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.FromCoinAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapLedger) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapLedger) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapLedger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MintedToCoinAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.BurnedFromCoinAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxSwapRate.Size()
+		i -= size
+		if _, err := m.MaxSwapRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ToDenom) > 0 {
+		i -= len(m.ToDenom)
+		copy(dAtA[i:], m.ToDenom)
+		i = encodeVarintFswap(dAtA, i, uint64(len(m.ToDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromDenom) > 0 {
+		i -= len(m.FromDenom)
+		copy(dAtA[i:], m.FromDenom)
+		i = encodeVarintFswap(dAtA, i, uint64(len(m.FromDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapAudit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapAudit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapAudit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SupplyExceeded {
+		i--
+		if m.SupplyExceeded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.LedgerMismatched {
+		i--
+		if m.LedgerMismatched {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	{
+		size, err := m.LedgerMintedToCoinAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size, err := m.LedgerBurnedFromCoinAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.CapExceeded {
+		i--
+		if m.CapExceeded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.OverMinted {
+		i--
+		if m.OverMinted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Unburned) > 0 {
+		for iNdEx := len(m.Unburned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unburned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFswap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	{
+		size, err := m.ToCoinSupply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.AmountCapForToDenom.Size()
+		i -= size
+		if _, err := m.AmountCapForToDenom.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MaxMintableToAmount.Size()
+		i -= size
+		if _, err := m.MaxMintableToAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MaxSwapRate.Size()
+		i -= size
+		if _, err := m.MaxSwapRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.MintedToCoinAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.BurnedFromCoinAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ToDenom) > 0 {
+		i -= len(m.ToDenom)
+		copy(dAtA[i:], m.ToDenom)
+		i = encodeVarintFswap(dAtA, i, uint64(len(m.ToDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromDenom) > 0 {
+		i -= len(m.FromDenom)
+		copy(dAtA[i:], m.FromDenom)
+		i = encodeVarintFswap(dAtA, i, uint64(len(m.FromDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFswap(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFswap(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Swap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromDenom)
+	if l > 0 {
+		n += 1 + l + sovFswap(uint64(l))
+	}
+	l = len(m.ToDenom)
+	if l > 0 {
+		n += 1 + l + sovFswap(uint64(l))
+	}
+	l = m.AmountCapForToDenom.Size()
+	n += 1 + l + sovFswap(uint64(l))
+	l = m.SwapRate.Size()
+	n += 1 + l + sovFswap(uint64(l))
+	if len(m.RateSchedule) > 0 {
+		for _, e := range m.RateSchedule {
+			l = e.Size()
+			n += 1 + l + sovFswap(uint64(l))
+		}
+	}
+	if m.StartTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovFswap(uint64(l))
+	}
+	if m.EndTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovFswap(uint64(l))
+	}
+	return n
+}
+
+func (m *SwapRatePhase) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovFswap(uint64(l))
+	l = m.SwapRate.Size()
+	n += 1 + l + sovFswap(uint64(l))
+	return n
+}
+
+func (m *SwapClosure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromDenom)
+	if l > 0 {
+		n += 1 + l + sovFswap(uint64(l))
+	}
+	l = len(m.ToDenom)
+	if l > 0 {
+		n += 1 + l + sovFswap(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ClosedAt)
+	n += 1 + l + sovFswap(uint64(l))
+	l = m.SwapRate.Size()
+	n += 1 + l + sovFswap(uint64(l))
+	if m.ReverseSwapEndTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ReverseSwapEndTime)
+		n += 1 + l + sovFswap(uint64(l))
+	}
+	return n
+}
+
+func (m *ActiveSwapPhase) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromDenom)
+	if l > 0 {
+		n += 1 + l + sovFswap(uint64(l))
+	}
+	l = len(m.ToDenom)
+	if l > 0 {
+		n += 1 + l + sovFswap(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovFswap(uint64(m.Status))
+	}
+	if m.Phase != 0 {
+		n += 1 + sovFswap(uint64(m.Phase))
+	}
+	l = m.SwapRate.Size()
+	n += 1 + l + sovFswap(uint64(l))
+	return n
+}
+
+func (m *SwapStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SwapCount != 0 {
+		n += 1 + sovFswap(uint64(m.SwapCount))
+	}
+	return n
+}
+
+func (m *Swapped) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FromCoinAmount.Size()
+	n += 1 + l + sovFswap(uint64(l))
+	l = m.ToCoinAmount.Size()
+	n += 1 + l + sovFswap(uint64(l))
+	return n
+}
+
+func (m *SwapLedger) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromDenom)
+	if l > 0 {
+		n += 1 + l + sovFswap(uint64(l))
+	}
+	l = len(m.ToDenom)
+	if l > 0 {
+		n += 1 + l + sovFswap(uint64(l))
+	}
+	l = m.MaxSwapRate.Size()
+	n += 1 + l + sovFswap(uint64(l))
+	l = m.BurnedFromCoinAmount.Size()
+	n += 1 + l + sovFswap(uint64(l))
+	l = m.MintedToCoinAmount.Size()
+	n += 1 + l + sovFswap(uint64(l))
+	return n
+}
+
+func (m *SwapAudit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromDenom)
+	if l > 0 {
+		n += 1 + l + sovFswap(uint64(l))
+	}
+	l = len(m.ToDenom)
+	if l > 0 {
+		n += 1 + l + sovFswap(uint64(l))
+	}
+	l = m.BurnedFromCoinAmount.Size()
+	n += 1 + l + sovFswap(uint64(l))
+	l = m.MintedToCoinAmount.Size()
+	n += 1 + l + sovFswap(uint64(l))
+	l = m.MaxSwapRate.Size()
+	n += 1 + l + sovFswap(uint64(l))
+	l = m.MaxMintableToAmount.Size()
+	n += 1 + l + sovFswap(uint64(l))
+	l = m.AmountCapForToDenom.Size()
+	n += 1 + l + sovFswap(uint64(l))
+	l = m.ToCoinSupply.Size()
+	n += 1 + l + sovFswap(uint64(l))
+	if len(m.Unburned) > 0 {
+		for _, e := range m.Unburned {
+			l = e.Size()
+			n += 1 + l + sovFswap(uint64(l))
+		}
+	}
+	if m.OverMinted {
+		n += 2
+	}
+	if m.CapExceeded {
+		n += 2
+	}
+	l = m.LedgerBurnedFromCoinAmount.Size()
+	n += 1 + l + sovFswap(uint64(l))
+	l = m.LedgerMintedToCoinAmount.Size()
+	n += 1 + l + sovFswap(uint64(l))
+	if m.LedgerMismatched {
+		n += 2
+	}
+	if m.SupplyExceeded {
+		n += 2
+	}
+	return n
+}
+
+func sovFswap(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
-func sozFswap(x uint64) (n int) {
-	return sovFswap(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func sozFswap(x uint64) (n int) {
+	return sovFswap(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Swap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFswap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Swap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Swap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountCapForToDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountCapForToDenom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFswap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateSchedule = append(m.RateSchedule, SwapRatePhase{})
+			if err := m.RateSchedule[len(m.RateSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFswap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFswap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFswap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFswap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapRatePhase) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFswap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapRatePhase: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapRatePhase: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFswap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFswap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFswap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapClosure) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFswap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapClosure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapClosure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFswap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ClosedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReverseSwapEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFswap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReverseSwapEndTime == nil {
+				m.ReverseSwapEndTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ReverseSwapEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFswap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFswap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActiveSwapPhase) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFswap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActiveSwapPhase: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActiveSwapPhase: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SwapStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			m.Phase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Phase |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFswap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFswap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFswap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapCount", wireType)
+			}
+			m.SwapCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SwapCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFswap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFswap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Swapped) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFswap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Swapped: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Swapped: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromCoinAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFswap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FromCoinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToCoinAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFswap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ToCoinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFswap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFswap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapLedger) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFswap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapLedger: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapLedger: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSwapRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSwapRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedFromCoinAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFswap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnedFromCoinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintedToCoinAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFswap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintedToCoinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFswap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFswap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Swap) Unmarshal(dAtA []byte) error {
+func (m *SwapAudit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -413,30 +2742,127 @@ func (m *Swap) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowFswap
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapAudit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapAudit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedFromCoinAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFswap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFswap
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.BurnedFromCoinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Swap: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Swap: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MintedToCoinAmount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFswap
@@ -446,27 +2872,28 @@ func (m *Swap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFswap
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFswap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.MintedToCoinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSwapRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -494,11 +2921,13 @@ func (m *Swap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.MaxSwapRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountCapForToDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMintableToAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -526,13 +2955,13 @@ func (m *Swap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AmountCapForToDenom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxMintableToAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountCapForToDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -560,65 +2989,48 @@ func (m *Swap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SwapRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AmountCapForToDenom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFswap(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToCoinSupply", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthFswap
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthFswap
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SwapStats) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFswap
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFswap
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.ToCoinSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SwapStats: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SwapStats: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapCount", wireType)
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unburned", wireType)
 			}
-			m.SwapCount = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFswap
@@ -628,64 +3040,69 @@ func (m *SwapStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SwapCount |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFswap(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthFswap
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthFswap
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Swapped) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFswap
+			m.Unburned = append(m.Unburned, types.Coin{})
+			if err := m.Unburned[len(m.Unburned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OverMinted", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Swapped: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Swapped: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.OverMinted = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CapExceeded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CapExceeded = bool(v != 0)
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromCoinAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LedgerBurnedFromCoinAmount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -712,13 +3129,13 @@ func (m *Swapped) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FromCoinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LedgerBurnedFromCoinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToCoinAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LedgerMintedToCoinAmount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -745,10 +3162,50 @@ func (m *Swapped) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ToCoinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LedgerMintedToCoinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LedgerMismatched", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LedgerMismatched = bool(v != 0)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyExceeded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SupplyExceeded = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFswap(dAtA[iNdEx:])
@@ -13,6 +13,8 @@ func DefaultGenesis() *GenesisState {
 		Swaps:     []Swap{},
 		SwapStats: SwapStats{},
 		Swappeds:  []Swapped{},
+		Closures:  []SwapClosure{},
+		Ledgers:   []SwapLedger{},
 	}
 }
 
@@ -49,6 +51,46 @@ func (gs *GenesisState) Validate() error {
 		return ErrInvalidState.Wrap("number of swaps does not match number of Swappeds")
 	}
 
+	swaps := make(map[[2]string]struct{}, len(gs.GetSwaps()))
+	for _, swap := range gs.GetSwaps() {
+		swaps[[2]string{swap.FromDenom, swap.ToDenom}] = struct{}{}
+	}
+
+	closed := make(map[[2]string]struct{}, len(gs.GetClosures()))
+	for _, closure := range gs.GetClosures() {
+		if err := closure.ValidateBasic(); err != nil {
+			return err
+		}
+
+		key := [2]string{closure.FromDenom, closure.ToDenom}
+		if _, ok := swaps[key]; !ok {
+			return ErrInvalidState.Wrapf("closure of unknown swap from %s to %s", closure.FromDenom, closure.ToDenom)
+		}
+		if _, ok := closed[key]; ok {
+			return ErrInvalidState.Wrapf("duplicate closure of swap from %s to %s", closure.FromDenom, closure.ToDenom)
+		}
+		closed[key] = struct{}{}
+	}
+
+	ledgers := make(map[[2]string]struct{}, len(gs.GetLedgers()))
+	for _, ledger := range gs.GetLedgers() {
+		if err := ledger.ValidateBasic(); err != nil {
+			return err
+		}
+
+		key := [2]string{ledger.FromDenom, ledger.ToDenom}
+		if _, ok := swaps[key]; !ok {
+			return ErrInvalidState.Wrapf("ledger of unknown swap from %s to %s", ledger.FromDenom, ledger.ToDenom)
+		}
+		if _, ok := ledgers[key]; ok {
+			return ErrInvalidState.Wrapf("duplicate ledger of swap from %s to %s", ledger.FromDenom, ledger.ToDenom)
+		}
+		ledgers[key] = struct{}{}
+	}
+	if len(ledgers) != 0 && len(ledgers) != len(swaps) {
+		return ErrInvalidState.Wrap("number of ledgers does not match number of swaps")
+	}
+
 	if len(gs.GetSwaps()) != int(gs.GetSwapStats().SwapCount) {
 		return ErrInvalidState.Wrap("number of swaps does not match swap count in SwapStats")
 	}
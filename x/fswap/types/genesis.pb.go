@@ -28,6 +28,10 @@ type GenesisState struct {
 	Swaps     []Swap    `protobuf:"bytes,1,rep,name=swaps,proto3" json:"swaps"`
 	SwapStats SwapStats `protobuf:"bytes,2,opt,name=swap_stats,json=swapStats,proto3" json:"swap_stats"`
 	Swappeds  []Swapped `protobuf:"bytes,3,rep,name=swappeds,proto3" json:"swappeds"`
+	// closures of the retired swaps. Their swaps and swappeds remain in the state.
+	Closures []SwapClosure `protobuf:"bytes,4,rep,name=closures,proto3" json:"closures"`
+	// ledgers of the swaps. If empty, they are derived from the swaps and swappeds.
+	Ledgers []SwapLedger `protobuf:"bytes,5,rep,name=ledgers,proto3" json:"ledgers"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -84,6 +88,20 @@ func (m *GenesisState) GetSwappeds() []Swapped {
 	return nil
 }
 
+func (m *GenesisState) GetClosures() []SwapClosure {
+	if m != nil {
+		return m.Closures
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLedgers() []SwapLedger {
+	if m != nil {
+		return m.Ledgers
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "lbm.fswap.v1.GenesisState")
 }
@@ -91,23 +109,26 @@ func init() {
 func init() { proto.RegisterFile("lbm/fswap/v1/genesis.proto", fileDescriptor_94e309cb1db27661) }
 
 var fileDescriptor_94e309cb1db27661 = []byte{
-	// 255 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xca, 0x49, 0xca, 0xd5,
-	0x4f, 0x2b, 0x2e, 0x4f, 0x2c, 0xd0, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c,
-	0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0xc9, 0x49, 0xca, 0xd5, 0x03, 0xcb, 0xe9, 0x95,
-	0x19, 0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x25, 0xf4, 0x41, 0x2c, 0x88, 0x1a, 0x29, 0x09,
-	0x14, 0xfd, 0x10, 0xc5, 0x60, 0x19, 0xa5, 0xad, 0x8c, 0x5c, 0x3c, 0xee, 0x10, 0xf3, 0x82, 0x4b,
-	0x12, 0x4b, 0x52, 0x85, 0xf4, 0xb8, 0x58, 0x41, 0xd2, 0xc5, 0x12, 0x8c, 0x0a, 0xcc, 0x1a, 0xdc,
-	0x46, 0x42, 0x7a, 0xc8, 0xc6, 0xeb, 0x05, 0x97, 0x27, 0x16, 0x38, 0xb1, 0x9c, 0xb8, 0x27, 0xcf,
-	0x10, 0x04, 0x51, 0x26, 0x64, 0xc3, 0xc5, 0x05, 0x62, 0xc4, 0x17, 0x97, 0x24, 0x96, 0x14, 0x4b,
-	0x30, 0x29, 0x30, 0x6a, 0x70, 0x1b, 0x89, 0x63, 0x6a, 0x02, 0x19, 0x5e, 0x0c, 0xd5, 0xc9, 0x59,
-	0x0c, 0x13, 0x10, 0x32, 0xe7, 0xe2, 0x00, 0x71, 0x0a, 0x52, 0x53, 0x8a, 0x25, 0x98, 0xc1, 0x16,
-	0x8a, 0x62, 0xea, 0x2d, 0x48, 0x4d, 0x81, 0xea, 0x84, 0x2b, 0x76, 0xf2, 0x38, 0xf1, 0x48, 0x8e,
-	0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58,
-	0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xbd, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4,
-	0xfc, 0x5c, 0x7d, 0xb7, 0xcc, 0xbc, 0xe2, 0xe4, 0x8c, 0xcc, 0x44, 0xfd, 0x34, 0x28, 0x43, 0xb7,
-	0x38, 0x25, 0x5b, 0xbf, 0x02, 0x1a, 0x14, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0x80,
-	0x30, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x4f, 0x1a, 0xa2, 0xb3, 0x64, 0x01, 0x00, 0x00,
+	// 304 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xbd, 0x4e, 0xf3, 0x30,
+	0x18, 0x85, 0x93, 0xfe, 0x7c, 0x5f, 0x71, 0x3b, 0x59, 0x20, 0x4c, 0x06, 0x53, 0x31, 0x75, 0xc1,
+	0x56, 0xcb, 0x00, 0x12, 0x4c, 0x45, 0x02, 0x06, 0x26, 0xba, 0xb1, 0xa0, 0xfc, 0xb8, 0x69, 0x44,
+	0x52, 0x5b, 0x79, 0xdd, 0x16, 0xee, 0x82, 0x0b, 0xe1, 0x42, 0x3a, 0x76, 0x64, 0x42, 0x28, 0xb9,
+	0x11, 0x14, 0x27, 0x41, 0xa0, 0x6c, 0x27, 0x39, 0xcf, 0x73, 0x2c, 0xd9, 0xc8, 0x89, 0xbd, 0x84,
+	0xcf, 0x61, 0xe3, 0x2a, 0xbe, 0x1e, 0xf3, 0x50, 0x2c, 0x05, 0x44, 0xc0, 0x54, 0x2a, 0xb5, 0xc4,
+	0x83, 0xd8, 0x4b, 0x98, 0xe9, 0xd8, 0x7a, 0xec, 0xec, 0x87, 0x32, 0x94, 0xa6, 0xe0, 0x45, 0x2a,
+	0x19, 0x87, 0xfc, 0xf1, 0x4b, 0xd8, 0x34, 0x27, 0xef, 0x2d, 0x34, 0xb8, 0x2d, 0xf7, 0x66, 0xda,
+	0xd5, 0x02, 0x33, 0xd4, 0x2d, 0x6a, 0x20, 0xf6, 0xb0, 0x3d, 0xea, 0x4f, 0x30, 0xfb, 0x3d, 0xcf,
+	0x66, 0x1b, 0x57, 0x4d, 0x3b, 0xdb, 0xcf, 0x63, 0xeb, 0xa1, 0xc4, 0xf0, 0x15, 0x42, 0x45, 0x78,
+	0x02, 0xed, 0x6a, 0x20, 0xad, 0xa1, 0x3d, 0xea, 0x4f, 0x0e, 0x9b, 0x52, 0x31, 0x0e, 0x95, 0xb9,
+	0x07, 0xf5, 0x0f, 0x7c, 0x8e, 0x7a, 0xc5, 0x87, 0x12, 0x01, 0x90, 0xb6, 0x39, 0xf0, 0xa0, 0xe9,
+	0x2a, 0x11, 0x54, 0xe6, 0x0f, 0x8c, 0x2f, 0x51, 0xcf, 0x8f, 0x25, 0xac, 0x52, 0x01, 0xa4, 0x63,
+	0xc4, 0xa3, 0xa6, 0x78, 0x5d, 0x12, 0xb5, 0x5c, 0x0b, 0xf8, 0x02, 0xfd, 0x8f, 0x45, 0x10, 0x8a,
+	0x14, 0x48, 0xd7, 0xb8, 0xa4, 0xe9, 0xde, 0x1b, 0xa0, 0x52, 0x6b, 0x7c, 0x7a, 0xb7, 0xcd, 0xa8,
+	0xbd, 0xcb, 0xa8, 0xfd, 0x95, 0x51, 0xfb, 0x2d, 0xa7, 0xd6, 0x2e, 0xa7, 0xd6, 0x47, 0x4e, 0xad,
+	0x47, 0x16, 0x46, 0x7a, 0xb1, 0xf2, 0x98, 0x2f, 0x13, 0x7e, 0x13, 0x2d, 0xc1, 0x5f, 0x44, 0x2e,
+	0x9f, 0x57, 0xe1, 0x14, 0x82, 0x67, 0xfe, 0x52, 0xbd, 0x80, 0x7e, 0x55, 0x02, 0xbc, 0x7f, 0xe6,
+	0xfe, 0xcf, 0xbe, 0x03, 0x00, 0x00, 0xff, 0xff, 0xb2, 0x0a, 0xfd, 0xb5, 0xdb, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -130,6 +151,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Ledgers) > 0 {
+		for iNdEx := len(m.Ledgers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ledgers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Closures) > 0 {
+		for iNdEx := len(m.Closures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Closures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Swappeds) > 0 {
 		for iNdEx := len(m.Swappeds) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -202,6 +251,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Closures) > 0 {
+		for _, e := range m.Closures {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Ledgers) > 0 {
+		for _, e := range m.Ledgers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -341,6 +402,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Closures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Closures = append(m.Closures, SwapClosure{})
+			if err := m.Closures[len(m.Closures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ledgers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ledgers = append(m.Ledgers, SwapLedger{})
+			if err := m.Ledgers[len(m.Ledgers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2,14 +2,20 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/Finschia/finschia-sdk/types"
 	"github.com/Finschia/finschia-sdk/x/fswap/types"
 )
 
 // todo: add tests
 func TestGenesisStateValidate(t *testing.T) {
+	swap := types.Swap{FromDenom: "cony", ToDenom: "kei", AmountCapForToDenom: sdk.NewInt(100), SwapRate: sdk.NewDec(2)}
+	swapped := types.Swapped{FromCoinAmount: sdk.NewInt64Coin("cony", 0), ToCoinAmount: sdk.NewInt64Coin("kei", 0)}
+	closure := types.SwapClosure{FromDenom: "cony", ToDenom: "kei", ClosedAt: time.Unix(100, 0).UTC(), SwapRate: sdk.NewDec(2)}
+
 	for _, tc := range []struct {
 		desc     string
 		genState *types.GenesisState
@@ -20,6 +26,36 @@ func TestGenesisStateValidate(t *testing.T) {
 			genState: types.DefaultGenesis(),
 			valid:    true,
 		},
+		{
+			desc: "closed swap is counted",
+			genState: &types.GenesisState{
+				Swaps:     []types.Swap{swap},
+				SwapStats: types.SwapStats{SwapCount: 1},
+				Swappeds:  []types.Swapped{swapped},
+				Closures:  []types.SwapClosure{closure},
+			},
+			valid: true,
+		},
+		{
+			desc: "closed swap is not counted",
+			genState: &types.GenesisState{
+				Swaps:     []types.Swap{swap},
+				SwapStats: types.SwapStats{SwapCount: 0},
+				Swappeds:  []types.Swapped{swapped},
+				Closures:  []types.SwapClosure{closure},
+			},
+			valid: false,
+		},
+		{
+			desc: "closure of unknown swap",
+			genState: &types.GenesisState{
+				Swaps:     []types.Swap{},
+				SwapStats: types.SwapStats{SwapCount: 0},
+				Swappeds:  []types.Swapped{},
+				Closures:  []types.SwapClosure{closure},
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
@@ -9,6 +9,8 @@ var (
 	_ sdk.Msg = &MsgSwap{}
 	_ sdk.Msg = &MsgSwapAll{}
 	_ sdk.Msg = &MsgSetSwap{}
+	_ sdk.Msg = &MsgCloseSwap{}
+	_ sdk.Msg = &MsgReverseSwap{}
 )
 
 // ValidateBasic Implements Msg.
@@ -106,3 +108,65 @@ func (m *MsgSetSwap) GetSigners() []sdk.AccAddress {
 func (m *MsgSetSwap) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
 }
+
+func (m *MsgCloseSwap) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", m.Authority)
+	}
+
+	if err := sdk.ValidateDenom(m.FromDenom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if err := sdk.ValidateDenom(m.ToDenom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return nil
+}
+
+func (m *MsgCloseSwap) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{signer}
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m *MsgCloseSwap) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic Implements Msg.
+func (m *MsgReverseSwap) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.FromAddress)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("Invalid address (%s)", err)
+	}
+
+	if !m.ToCoinAmount.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrap(m.ToCoinAmount.String())
+	}
+
+	if !m.ToCoinAmount.IsPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrap(m.ToCoinAmount.String())
+	}
+
+	if err := sdk.ValidateDenom(m.GetFromDenom()); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return nil
+}
+
+// GetSigners Implements Msg.
+func (m *MsgReverseSwap) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(m.FromAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m *MsgReverseSwap) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
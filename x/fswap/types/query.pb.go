@@ -86,6 +86,12 @@ func (m *QuerySwappedRequest) GetToDenom() string {
 type QuerySwappedResponse struct {
 	FromCoinAmount types.Coin `protobuf:"bytes,1,opt,name=from_coin_amount,json=fromCoinAmount,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coin" json:"from_coin_amount"`
 	ToCoinAmount   types.Coin `protobuf:"bytes,2,opt,name=to_coin_amount,json=toCoinAmount,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coin" json:"to_coin_amount"`
+	// the swap including its rate schedule
+	Swap Swap `protobuf:"bytes,3,opt,name=swap,proto3" json:"swap"`
+	// the phase of the swap at the current block time
+	ActivePhase ActiveSwapPhase `protobuf:"bytes,4,opt,name=active_phase,json=activePhase,proto3" json:"active_phase"`
+	// the closure of the swap if it has been closed
+	Closure *SwapClosure `protobuf:"bytes,5,opt,name=closure,proto3" json:"closure,omitempty"`
 }
 
 func (m *QuerySwappedResponse) Reset()         { *m = QuerySwappedResponse{} }
@@ -135,6 +141,27 @@ func (m *QuerySwappedResponse) GetToCoinAmount() types.Coin {
 	return types.Coin{}
 }
 
+func (m *QuerySwappedResponse) GetSwap() Swap {
+	if m != nil {
+		return m.Swap
+	}
+	return Swap{}
+}
+
+func (m *QuerySwappedResponse) GetActivePhase() ActiveSwapPhase {
+	if m != nil {
+		return m.ActivePhase
+	}
+	return ActiveSwapPhase{}
+}
+
+func (m *QuerySwappedResponse) GetClosure() *SwapClosure {
+	if m != nil {
+		return m.Closure
+	}
+	return nil
+}
+
 type QueryTotalSwappableToCoinAmountRequest struct {
 	FromDenom string `protobuf:"bytes,1,opt,name=fromDenom,proto3" json:"fromDenom,omitempty"`
 	ToDenom   string `protobuf:"bytes,2,opt,name=toDenom,proto3" json:"toDenom,omitempty"`
@@ -282,6 +309,8 @@ func (m *QuerySwapsRequest) GetPagination() *query.PageRequest {
 type QuerySwapsResponse struct {
 	Swaps      []Swap              `protobuf:"bytes,1,rep,name=swaps,proto3" json:"swaps"`
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// the phases of the swaps at the current block time, in the same order as swaps
+	ActivePhases []ActiveSwapPhase `protobuf:"bytes,3,rep,name=active_phases,json=activePhases,proto3" json:"active_phases"`
 }
 
 func (m *QuerySwapsResponse) Reset()         { *m = QuerySwapsResponse{} }
@@ -331,6 +360,109 @@ func (m *QuerySwapsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+func (m *QuerySwapsResponse) GetActivePhases() []ActiveSwapPhase {
+	if m != nil {
+		return m.ActivePhases
+	}
+	return nil
+}
+
+type QuerySwapAuditsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySwapAuditsRequest) Reset()         { *m = QuerySwapAuditsRequest{} }
+func (m *QuerySwapAuditsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapAuditsRequest) ProtoMessage()    {}
+func (*QuerySwapAuditsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_01deae9da7816d6a, []int{6}
+}
+func (m *QuerySwapAuditsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySwapAuditsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapAuditsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySwapAuditsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapAuditsRequest.Merge(m, src)
+}
+func (m *QuerySwapAuditsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySwapAuditsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapAuditsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySwapAuditsRequest proto.InternalMessageInfo
+
+func (m *QuerySwapAuditsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QuerySwapAuditsResponse struct {
+	Audits     []SwapAudit         `protobuf:"bytes,1,rep,name=audits,proto3" json:"audits"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySwapAuditsResponse) Reset()         { *m = QuerySwapAuditsResponse{} }
+func (m *QuerySwapAuditsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySwapAuditsResponse) ProtoMessage()    {}
+func (*QuerySwapAuditsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_01deae9da7816d6a, []int{7}
+}
+func (m *QuerySwapAuditsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySwapAuditsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySwapAuditsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySwapAuditsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySwapAuditsResponse.Merge(m, src)
+}
+func (m *QuerySwapAuditsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySwapAuditsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySwapAuditsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySwapAuditsResponse proto.InternalMessageInfo
+
+func (m *QuerySwapAuditsResponse) GetAudits() []SwapAudit {
+	if m != nil {
+		return m.Audits
+	}
+	return nil
+}
+
+func (m *QuerySwapAuditsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QuerySwappedRequest)(nil), "lbm.fswap.v1.QuerySwappedRequest")
 	proto.RegisterType((*QuerySwappedResponse)(nil), "lbm.fswap.v1.QuerySwappedResponse")
@@ -338,49 +470,61 @@ func init() {
 	proto.RegisterType((*QueryTotalSwappableToCoinAmountResponse)(nil), "lbm.fswap.v1.QueryTotalSwappableToCoinAmountResponse")
 	proto.RegisterType((*QuerySwapsRequest)(nil), "lbm.fswap.v1.QuerySwapsRequest")
 	proto.RegisterType((*QuerySwapsResponse)(nil), "lbm.fswap.v1.QuerySwapsResponse")
+	proto.RegisterType((*QuerySwapAuditsRequest)(nil), "lbm.fswap.v1.QuerySwapAuditsRequest")
+	proto.RegisterType((*QuerySwapAuditsResponse)(nil), "lbm.fswap.v1.QuerySwapAuditsResponse")
 }
 
 func init() { proto.RegisterFile("lbm/fswap/v1/query.proto", fileDescriptor_01deae9da7816d6a) }
 
 var fileDescriptor_01deae9da7816d6a = []byte{
-	// 590 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xcf, 0x6e, 0xd3, 0x4e,
-	0x10, 0xc7, 0xe3, 0xf4, 0xd7, 0x5f, 0xd5, 0x6d, 0x55, 0xca, 0xb6, 0x15, 0xc1, 0x14, 0x27, 0xf8,
-	0xd0, 0x56, 0xfc, 0xd9, 0x55, 0xd2, 0xf2, 0x00, 0x04, 0x54, 0xb8, 0x20, 0x41, 0xe8, 0x09, 0x0e,
-	0x61, 0x9d, 0x6e, 0x5c, 0x8b, 0xd8, 0xe3, 0x66, 0x37, 0x69, 0x73, 0xe5, 0xc0, 0x0d, 0x09, 0x89,
-	0x47, 0x80, 0x13, 0x2f, 0xc1, 0xb5, 0xc7, 0x4a, 0x5c, 0x38, 0x01, 0x4a, 0x78, 0x10, 0xb4, 0xeb,
-	0x75, 0x63, 0x8b, 0x86, 0x08, 0xa1, 0xde, 0x36, 0x3b, 0xdf, 0x99, 0xef, 0x67, 0x27, 0x33, 0x46,
-	0xa5, 0x8e, 0x17, 0xd2, 0xb6, 0x38, 0x62, 0x31, 0xed, 0x57, 0xe9, 0x61, 0x8f, 0x77, 0x07, 0x24,
-	0xee, 0x82, 0x04, 0xbc, 0xd8, 0xf1, 0x42, 0xa2, 0x23, 0xa4, 0x5f, 0xb5, 0xd7, 0x7d, 0x00, 0xbf,
-	0xc3, 0x29, 0x8b, 0x03, 0xca, 0xa2, 0x08, 0x24, 0x93, 0x01, 0x44, 0x22, 0xd1, 0xda, 0xab, 0x3e,
-	0xf8, 0xa0, 0x8f, 0x54, 0x9d, 0xcc, 0xed, 0xcd, 0x16, 0x88, 0x10, 0x04, 0xf5, 0x98, 0xe0, 0x49,
-	0x69, 0xda, 0xaf, 0x7a, 0x5c, 0xb2, 0x2a, 0x8d, 0x99, 0x1f, 0x44, 0xba, 0x84, 0xd1, 0x3a, 0x59,
-	0x6d, 0xaa, 0x6a, 0x41, 0x90, 0xc6, 0xf3, 0x9c, 0x09, 0x96, 0x8e, 0xb8, 0x8f, 0xd1, 0xca, 0x53,
-	0x55, 0xfb, 0xd9, 0x11, 0x8b, 0x63, 0xbe, 0xdf, 0xe0, 0x87, 0x3d, 0x2e, 0x24, 0x5e, 0x47, 0xf3,
-	0xed, 0x2e, 0x84, 0x0f, 0x78, 0x04, 0x61, 0xc9, 0xaa, 0x58, 0x5b, 0xf3, 0x8d, 0xf1, 0x05, 0x2e,
-	0xa1, 0x39, 0x09, 0x49, 0xac, 0xa8, 0x63, 0xe9, 0x4f, 0xf7, 0x4d, 0x11, 0xad, 0xe6, 0xeb, 0x89,
-	0x18, 0x22, 0xc1, 0xf1, 0x31, 0x5a, 0x56, 0xf9, 0x4d, 0x05, 0xd5, 0x64, 0x21, 0xf4, 0x22, 0xa9,
-	0xeb, 0x2e, 0xd4, 0xae, 0x92, 0x04, 0x9e, 0x28, 0x78, 0x62, 0xe0, 0xc9, 0x7d, 0x08, 0xa2, 0xfa,
-	0xf6, 0xc9, 0xb7, 0x72, 0xe1, 0xd3, 0xf7, 0xf2, 0x2d, 0x3f, 0x90, 0x07, 0x3d, 0x8f, 0xb4, 0x20,
-	0xa4, 0xbb, 0x41, 0x24, 0x5a, 0x07, 0x01, 0xa3, 0x6d, 0x73, 0xb8, 0x23, 0xf6, 0x5f, 0x51, 0x39,
-	0x88, 0xb9, 0xd0, 0x49, 0x8d, 0x25, 0xe5, 0xa3, 0x4e, 0xf7, 0xb4, 0x0b, 0x96, 0x68, 0x49, 0x42,
-	0xce, 0xb7, 0x78, 0x21, 0xbe, 0x8b, 0x12, 0xc6, 0xae, 0xee, 0x4b, 0xb4, 0xa1, 0xfb, 0xb0, 0x07,
-	0x92, 0x75, 0x74, 0x33, 0x98, 0xd7, 0xe1, 0x7b, 0x19, 0xc9, 0xbf, 0xb6, 0xfa, 0xa3, 0x85, 0x36,
-	0xa7, 0x5a, 0x98, 0xee, 0x0f, 0xd0, 0xb2, 0x48, 0x05, 0x17, 0xdb, 0xfd, 0x4b, 0x67, 0x3e, 0xa6,
-	0x11, 0x2f, 0xd0, 0xe5, 0xb3, 0x81, 0x10, 0xe9, 0x9b, 0x77, 0x11, 0x1a, 0xcf, 0xb0, 0x21, 0xd9,
-	0xc8, 0x91, 0x24, 0xbb, 0x94, 0xf2, 0x3c, 0x61, 0x3e, 0x37, 0xb9, 0x8d, 0x4c, 0xa6, 0xfb, 0xd6,
-	0x42, 0x38, 0x5b, 0xdd, 0x3c, 0x97, 0xa0, 0x59, 0x85, 0x21, 0x4a, 0x56, 0x65, 0x66, 0x6b, 0xa1,
-	0x86, 0x49, 0x76, 0x19, 0x89, 0xd2, 0xd6, 0xff, 0x53, 0x8f, 0x6b, 0x24, 0x32, 0xfc, 0x30, 0x87,
-	0x93, 0x8c, 0xc7, 0xe6, 0x54, 0x9c, 0xc4, 0x2c, 0xcb, 0x53, 0xfb, 0x30, 0x83, 0x66, 0x35, 0x0f,
-	0x06, 0x34, 0x67, 0x56, 0x00, 0xdf, 0xc8, 0xdb, 0x9f, 0xb3, 0x6e, 0xb6, 0xfb, 0x27, 0x49, 0xe2,
-	0xe3, 0x5e, 0x7f, 0xfd, 0xe5, 0xe7, 0xfb, 0xe2, 0x15, 0xbc, 0x46, 0x73, 0xcb, 0x2c, 0x8c, 0xcb,
-	0x67, 0x0b, 0xd9, 0x93, 0x27, 0x01, 0xef, 0x9c, 0xe3, 0x30, 0x75, 0x36, 0xed, 0xbb, 0x7f, 0x99,
-	0x65, 0x50, 0x77, 0x34, 0x2a, 0xc1, 0xb7, 0xf3, 0xa8, 0x52, 0x65, 0x36, 0xc7, 0x83, 0x98, 0x5f,
-	0x4b, 0xec, 0xa3, 0x59, 0xfd, 0x37, 0xe2, 0xf2, 0x84, 0x6e, 0xa4, 0xe3, 0x63, 0x57, 0x26, 0x0b,
-	0x0c, 0xc1, 0x35, 0x4d, 0xb0, 0x86, 0x57, 0x7e, 0x6f, 0x96, 0xa8, 0x3f, 0x3a, 0x19, 0x3a, 0xd6,
-	0xe9, 0xd0, 0xb1, 0x7e, 0x0c, 0x1d, 0xeb, 0xdd, 0xc8, 0x29, 0x9c, 0x8e, 0x9c, 0xc2, 0xd7, 0x91,
-	0x53, 0x78, 0x4e, 0xa6, 0x8e, 0xfa, 0xb1, 0x29, 0xa6, 0x47, 0xde, 0xfb, 0x5f, 0x7f, 0x44, 0xb7,
-	0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0x4e, 0x87, 0x53, 0x1d, 0x08, 0x06, 0x00, 0x00,
+	// 739 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcd, 0x52, 0x13, 0x4b,
+	0x18, 0xcd, 0x90, 0x04, 0x8a, 0x8f, 0x5c, 0x2e, 0xb7, 0x81, 0xcb, 0x38, 0x42, 0xc0, 0x29, 0x05,
+	0x4b, 0x71, 0xa6, 0xc2, 0xcf, 0x03, 0x00, 0x16, 0xb2, 0xb1, 0x0a, 0x23, 0x2b, 0x5d, 0x84, 0x4e,
+	0x68, 0x86, 0x29, 0x33, 0xd3, 0x43, 0xba, 0x13, 0x60, 0xe1, 0xc6, 0x27, 0xb0, 0xca, 0x95, 0x5b,
+	0xcb, 0x95, 0x3b, 0x9f, 0xc0, 0x2d, 0x4b, 0xaa, 0x5c, 0xe8, 0x4a, 0x2d, 0xf0, 0x41, 0xac, 0xfe,
+	0x19, 0x32, 0x53, 0x21, 0x44, 0x4b, 0xd8, 0x75, 0xba, 0xcf, 0xf9, 0xce, 0xe9, 0x6f, 0x4e, 0x7f,
+	0x01, 0xb3, 0x5e, 0x0d, 0xdc, 0x5d, 0x76, 0x80, 0x23, 0xb7, 0x55, 0x72, 0xf7, 0x9b, 0xa4, 0x71,
+	0xe4, 0x44, 0x0d, 0xca, 0x29, 0x2a, 0xd4, 0xab, 0x81, 0x23, 0x4f, 0x9c, 0x56, 0xc9, 0x9a, 0xf4,
+	0x28, 0xf5, 0xea, 0xc4, 0xc5, 0x91, 0xef, 0xe2, 0x30, 0xa4, 0x1c, 0x73, 0x9f, 0x86, 0x4c, 0x61,
+	0xad, 0x31, 0x8f, 0x7a, 0x54, 0x2e, 0x5d, 0xb1, 0xd2, 0xbb, 0xf7, 0x6a, 0x94, 0x05, 0x94, 0xb9,
+	0x55, 0xcc, 0x88, 0x2a, 0xed, 0xb6, 0x4a, 0x55, 0xc2, 0x71, 0xc9, 0x8d, 0xb0, 0xe7, 0x87, 0xb2,
+	0x84, 0xc6, 0x16, 0x93, 0xd8, 0x18, 0x55, 0xa3, 0x7e, 0x7c, 0x9e, 0xf6, 0xa9, 0x6c, 0xc9, 0x13,
+	0xfb, 0x31, 0x8c, 0x3e, 0x11, 0xb5, 0x9f, 0x1e, 0xe0, 0x28, 0x22, 0x3b, 0x65, 0xb2, 0xdf, 0x24,
+	0x8c, 0xa3, 0x49, 0x18, 0xdc, 0x6d, 0xd0, 0xe0, 0x21, 0x09, 0x69, 0x60, 0x1a, 0x33, 0xc6, 0xdd,
+	0xc1, 0x72, 0x7b, 0x03, 0x99, 0x30, 0xc0, 0xa9, 0x3a, 0xeb, 0x93, 0x67, 0xf1, 0x4f, 0xfb, 0x63,
+	0x16, 0xc6, 0xd2, 0xf5, 0x58, 0x44, 0x43, 0x46, 0xd0, 0x21, 0x8c, 0x08, 0x7e, 0x45, 0x98, 0xaa,
+	0xe0, 0x80, 0x36, 0x43, 0x2e, 0xeb, 0x0e, 0x2d, 0xdc, 0x70, 0x94, 0x79, 0x47, 0x98, 0x77, 0xb4,
+	0x79, 0x67, 0x8d, 0xfa, 0xe1, 0xea, 0xe2, 0xf1, 0xb7, 0xe9, 0xcc, 0x87, 0xef, 0xd3, 0xf7, 0x3d,
+	0x9f, 0xef, 0x35, 0xab, 0x4e, 0x8d, 0x06, 0xee, 0xba, 0x1f, 0xb2, 0xda, 0x9e, 0x8f, 0xdd, 0x5d,
+	0xbd, 0x78, 0xc0, 0x76, 0x5e, 0xb8, 0xfc, 0x28, 0x22, 0x4c, 0x92, 0xca, 0xc3, 0x42, 0x47, 0xac,
+	0x56, 0xa4, 0x0a, 0xe2, 0x30, 0xcc, 0x69, 0x4a, 0xb7, 0xef, 0x5a, 0x74, 0x0b, 0x9c, 0x26, 0x54,
+	0xe7, 0x21, 0x27, 0xba, 0x6c, 0x66, 0xa5, 0x16, 0x72, 0x92, 0x71, 0x70, 0x44, 0x73, 0x56, 0x73,
+	0x42, 0xa4, 0x2c, 0x51, 0x68, 0x1d, 0x0a, 0xb8, 0xc6, 0xfd, 0x16, 0xa9, 0x44, 0x7b, 0x98, 0x11,
+	0x33, 0x27, 0x59, 0x53, 0x69, 0xd6, 0x8a, 0x44, 0x08, 0xee, 0xa6, 0x00, 0xe9, 0x02, 0x43, 0x8a,
+	0x28, 0xb7, 0xd0, 0x22, 0x0c, 0xd4, 0xea, 0x94, 0x35, 0x1b, 0xc4, 0xcc, 0xeb, 0x4b, 0x76, 0x08,
+	0xaf, 0x29, 0x40, 0x39, 0x46, 0xda, 0xdb, 0x30, 0x2b, 0x3f, 0xd9, 0x16, 0xe5, 0xb8, 0x2e, 0xbf,
+	0x1b, 0xae, 0xd6, 0xc9, 0x56, 0xe2, 0x36, 0x7f, 0x9b, 0x8a, 0xf7, 0x06, 0xcc, 0xf5, 0x94, 0xd0,
+	0x41, 0x39, 0x82, 0x11, 0x16, 0x03, 0xae, 0x37, 0x28, 0xff, 0x9e, 0xeb, 0x28, 0x0b, 0xf6, 0x73,
+	0xf8, 0xef, 0x3c, 0xbb, 0x2c, 0xbe, 0xf3, 0x3a, 0x40, 0xfb, 0xb9, 0x69, 0x27, 0xb3, 0x29, 0x27,
+	0xea, 0xd9, 0xc7, 0x7e, 0x36, 0xb1, 0x47, 0x34, 0xb7, 0x9c, 0x60, 0xda, 0x5f, 0x0c, 0x40, 0xc9,
+	0xea, 0xfa, 0xba, 0x0e, 0xe4, 0x85, 0x0d, 0x66, 0x1a, 0x33, 0xd9, 0x4b, 0x83, 0xa2, 0x60, 0xe8,
+	0x51, 0xca, 0x8e, 0x4a, 0xf2, 0x5c, 0x4f, 0x3b, 0x4a, 0x2c, 0xe9, 0x07, 0x6d, 0xc0, 0x3f, 0xc9,
+	0xc8, 0x31, 0x33, 0x2b, 0x0d, 0xfc, 0x56, 0xe6, 0x0a, 0x89, 0xcc, 0x31, 0x7b, 0x1b, 0xfe, 0x3f,
+	0xbf, 0xd8, 0x4a, 0x73, 0xc7, 0xe7, 0x57, 0xde, 0xbb, 0xb7, 0x06, 0x4c, 0x74, 0x48, 0xe8, 0x06,
+	0x2e, 0x43, 0x3f, 0x96, 0x3b, 0xba, 0x83, 0x13, 0x9d, 0x1d, 0x94, 0x0c, 0x6d, 0x5d, 0x83, 0xaf,
+	0xac, 0x8f, 0x0b, 0xef, 0x72, 0x90, 0x97, 0xde, 0x10, 0x85, 0x01, 0x3d, 0xf5, 0xd0, 0xad, 0xb4,
+	0x89, 0x0b, 0x26, 0xac, 0x65, 0x5f, 0x06, 0x51, 0x3a, 0xf6, 0xd4, 0xab, 0xcf, 0x3f, 0xdf, 0xf4,
+	0x4d, 0xa0, 0x71, 0x37, 0x35, 0xbf, 0x99, 0x56, 0xf9, 0x64, 0x80, 0xd5, 0xfd, 0x45, 0xa1, 0xa5,
+	0x0b, 0x14, 0x7a, 0xbe, 0x71, 0x6b, 0xf9, 0x0f, 0x59, 0xda, 0xea, 0x92, 0xb4, 0xea, 0xa0, 0xf9,
+	0xb4, 0x55, 0x2e, 0x98, 0x95, 0xf6, 0x83, 0x4e, 0x4f, 0x62, 0xe4, 0x41, 0x5e, 0x3e, 0x07, 0x34,
+	0xdd, 0xa5, 0x1b, 0x71, 0x94, 0xac, 0x99, 0xee, 0x00, 0xed, 0xe0, 0xa6, 0x74, 0x30, 0x8e, 0x46,
+	0x3b, 0x9b, 0xc5, 0xd0, 0x4b, 0x80, 0x76, 0x76, 0xd0, 0xed, 0x2e, 0xc5, 0x52, 0xe9, 0xb5, 0xee,
+	0xf4, 0x40, 0x69, 0x5d, 0x5b, 0xea, 0x4e, 0x22, 0xeb, 0x02, 0x5d, 0x57, 0xa5, 0x6d, 0x75, 0xe3,
+	0xf8, 0xb4, 0x68, 0x9c, 0x9c, 0x16, 0x8d, 0x1f, 0xa7, 0x45, 0xe3, 0xf5, 0x59, 0x31, 0x73, 0x72,
+	0x56, 0xcc, 0x7c, 0x3d, 0x2b, 0x66, 0x9e, 0x39, 0x3d, 0x27, 0xd6, 0xa1, 0xae, 0x29, 0x27, 0x57,
+	0xb5, 0x5f, 0xfe, 0x6d, 0x2f, 0xfe, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x03, 0x1d, 0x3b, 0x2e, 0x7a,
+	0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -402,6 +546,8 @@ type QueryClient interface {
 	TotalSwappableToCoinAmount(ctx context.Context, in *QueryTotalSwappableToCoinAmountRequest, opts ...grpc.CallOption) (*QueryTotalSwappableToCoinAmountResponse, error)
 	// Swaps queries all the swap that registered
 	Swaps(ctx context.Context, in *QuerySwapsRequest, opts ...grpc.CallOption) (*QuerySwapsResponse, error)
+	// SwapAudits queries the consistency checks of all the swaps.
+	SwapAudits(ctx context.Context, in *QuerySwapAuditsRequest, opts ...grpc.CallOption) (*QuerySwapAuditsResponse, error)
 }
 
 type queryClient struct {
@@ -439,6 +585,15 @@ func (c *queryClient) Swaps(ctx context.Context, in *QuerySwapsRequest, opts ...
 	return out, nil
 }
 
+func (c *queryClient) SwapAudits(ctx context.Context, in *QuerySwapAuditsRequest, opts ...grpc.CallOption) (*QuerySwapAuditsResponse, error) {
+	out := new(QuerySwapAuditsResponse)
+	err := c.cc.Invoke(ctx, "/lbm.fswap.v1.Query/SwapAudits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Swapped queries the current swapped status that includes a burnt amount of from-coin and a minted amount of
@@ -448,6 +603,8 @@ type QueryServer interface {
 	TotalSwappableToCoinAmount(context.Context, *QueryTotalSwappableToCoinAmountRequest) (*QueryTotalSwappableToCoinAmountResponse, error)
 	// Swaps queries all the swap that registered
 	Swaps(context.Context, *QuerySwapsRequest) (*QuerySwapsResponse, error)
+	// SwapAudits queries the consistency checks of all the swaps.
+	SwapAudits(context.Context, *QuerySwapAuditsRequest) (*QuerySwapAuditsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -463,6 +620,9 @@ func (*UnimplementedQueryServer) TotalSwappableToCoinAmount(ctx context.Context,
 func (*UnimplementedQueryServer) Swaps(ctx context.Context, req *QuerySwapsRequest) (*QuerySwapsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Swaps not implemented")
 }
+func (*UnimplementedQueryServer) SwapAudits(ctx context.Context, req *QuerySwapAuditsRequest) (*QuerySwapAuditsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapAudits not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -522,6 +682,24 @@ func _Query_Swaps_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SwapAudits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySwapAuditsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SwapAudits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.fswap.v1.Query/SwapAudits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SwapAudits(ctx, req.(*QuerySwapAuditsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lbm.fswap.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -538,6 +716,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Swaps",
 			Handler:    _Query_Swaps_Handler,
 		},
+		{
+			MethodName: "SwapAudits",
+			Handler:    _Query_SwapAudits_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lbm/fswap/v1/query.proto",
@@ -600,6 +782,38 @@ func (m *QuerySwappedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Closure != nil {
+		{
+			size, err := m.Closure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.ActivePhase.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Swap.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.ToCoinAmount.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -748,6 +962,20 @@ func (m *QuerySwapsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ActivePhases) > 0 {
+		for iNdEx := len(m.ActivePhases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ActivePhases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -777,6 +1005,90 @@ func (m *QuerySwapsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QuerySwapAuditsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapAuditsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapAuditsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySwapAuditsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySwapAuditsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySwapAuditsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Audits) > 0 {
+		for iNdEx := len(m.Audits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Audits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -815,6 +1127,14 @@ func (m *QuerySwappedResponse) Size() (n int) {
 	n += 1 + l + sovQuery(uint64(l))
 	l = m.ToCoinAmount.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	l = m.Swap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ActivePhase.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Closure != nil {
+		l = m.Closure.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -875,6 +1195,44 @@ func (m *QuerySwapsResponse) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if len(m.ActivePhases) > 0 {
+		for _, e := range m.ActivePhases {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySwapAuditsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySwapAuditsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Audits) > 0 {
+		for _, e := range m.Audits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -1093,14 +1451,116 @@ func (m *QuerySwappedResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Swap", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Swap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivePhase", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ActivePhase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Closure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Closure == nil {
+				m.Closure = &SwapClosure{}
+			}
+			if err := m.Closure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
 			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
@@ -1496,6 +1956,246 @@ func (m *QuerySwapsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivePhases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActivePhases = append(m.ActivePhases, ActiveSwapPhase{})
+			if err := m.ActivePhases[len(m.ActivePhases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySwapAuditsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySwapAuditsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySwapAuditsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySwapAuditsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySwapAuditsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySwapAuditsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Audits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Audits = append(m.Audits, SwapAudit{})
+			if err := m.Audits[len(m.Audits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
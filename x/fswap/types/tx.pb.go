@@ -11,18 +11,22 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -322,6 +326,210 @@ func (m *MsgSetSwapResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetSwapResponse proto.InternalMessageInfo
 
+type MsgCloseSwap struct {
+	// authority is the address of the privileged account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	FromDenom string `protobuf:"bytes,2,opt,name=from_denom,json=fromDenom,proto3" json:"from_denom,omitempty"`
+	ToDenom   string `protobuf:"bytes,3,opt,name=to_denom,json=toDenom,proto3" json:"to_denom,omitempty"`
+	// the time until which the reverse swap is allowed. unset means the reverse swap is not allowed.
+	ReverseSwapEndTime *time.Time `protobuf:"bytes,4,opt,name=reverse_swap_end_time,json=reverseSwapEndTime,proto3,stdtime" json:"reverse_swap_end_time,omitempty"`
+}
+
+func (m *MsgCloseSwap) Reset()         { *m = MsgCloseSwap{} }
+func (m *MsgCloseSwap) String() string { return proto.CompactTextString(m) }
+func (*MsgCloseSwap) ProtoMessage()    {}
+func (*MsgCloseSwap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65c77cf1d9b67323, []int{6}
+}
+func (m *MsgCloseSwap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCloseSwap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCloseSwap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCloseSwap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCloseSwap.Merge(m, src)
+}
+func (m *MsgCloseSwap) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCloseSwap) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCloseSwap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCloseSwap proto.InternalMessageInfo
+
+func (m *MsgCloseSwap) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgCloseSwap) GetFromDenom() string {
+	if m != nil {
+		return m.FromDenom
+	}
+	return ""
+}
+
+func (m *MsgCloseSwap) GetToDenom() string {
+	if m != nil {
+		return m.ToDenom
+	}
+	return ""
+}
+
+func (m *MsgCloseSwap) GetReverseSwapEndTime() *time.Time {
+	if m != nil {
+		return m.ReverseSwapEndTime
+	}
+	return nil
+}
+
+type MsgCloseSwapResponse struct {
+}
+
+func (m *MsgCloseSwapResponse) Reset()         { *m = MsgCloseSwapResponse{} }
+func (m *MsgCloseSwapResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCloseSwapResponse) ProtoMessage()    {}
+func (*MsgCloseSwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65c77cf1d9b67323, []int{7}
+}
+func (m *MsgCloseSwapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCloseSwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCloseSwapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCloseSwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCloseSwapResponse.Merge(m, src)
+}
+func (m *MsgCloseSwapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCloseSwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCloseSwapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCloseSwapResponse proto.InternalMessageInfo
+
+type MsgReverseSwap struct {
+	// holder's address
+	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	// to-coin amount to be swapped back
+	ToCoinAmount types.Coin `protobuf:"bytes,2,opt,name=to_coin_amount,json=toCoinAmount,proto3,castrepeated=github.com/Finschia/finschia-sdk/types.Coin" json:"to_coin_amount"`
+	FromDenom    string     `protobuf:"bytes,3,opt,name=from_denom,json=fromDenom,proto3" json:"from_denom,omitempty"`
+}
+
+func (m *MsgReverseSwap) Reset()         { *m = MsgReverseSwap{} }
+func (m *MsgReverseSwap) String() string { return proto.CompactTextString(m) }
+func (*MsgReverseSwap) ProtoMessage()    {}
+func (*MsgReverseSwap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65c77cf1d9b67323, []int{8}
+}
+func (m *MsgReverseSwap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReverseSwap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReverseSwap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReverseSwap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReverseSwap.Merge(m, src)
+}
+func (m *MsgReverseSwap) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReverseSwap) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReverseSwap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReverseSwap proto.InternalMessageInfo
+
+func (m *MsgReverseSwap) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+func (m *MsgReverseSwap) GetToCoinAmount() types.Coin {
+	if m != nil {
+		return m.ToCoinAmount
+	}
+	return types.Coin{}
+}
+
+func (m *MsgReverseSwap) GetFromDenom() string {
+	if m != nil {
+		return m.FromDenom
+	}
+	return ""
+}
+
+type MsgReverseSwapResponse struct {
+}
+
+func (m *MsgReverseSwapResponse) Reset()         { *m = MsgReverseSwapResponse{} }
+func (m *MsgReverseSwapResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReverseSwapResponse) ProtoMessage()    {}
+func (*MsgReverseSwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65c77cf1d9b67323, []int{9}
+}
+func (m *MsgReverseSwapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReverseSwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReverseSwapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReverseSwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReverseSwapResponse.Merge(m, src)
+}
+func (m *MsgReverseSwapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReverseSwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReverseSwapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReverseSwapResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSwap)(nil), "lbm.fswap.v1.MsgSwap")
 	proto.RegisterType((*MsgSwapResponse)(nil), "lbm.fswap.v1.MsgSwapResponse")
@@ -329,44 +537,58 @@ func init() {
 	proto.RegisterType((*MsgSwapAllResponse)(nil), "lbm.fswap.v1.MsgSwapAllResponse")
 	proto.RegisterType((*MsgSetSwap)(nil), "lbm.fswap.v1.MsgSetSwap")
 	proto.RegisterType((*MsgSetSwapResponse)(nil), "lbm.fswap.v1.MsgSetSwapResponse")
+	proto.RegisterType((*MsgCloseSwap)(nil), "lbm.fswap.v1.MsgCloseSwap")
+	proto.RegisterType((*MsgCloseSwapResponse)(nil), "lbm.fswap.v1.MsgCloseSwapResponse")
+	proto.RegisterType((*MsgReverseSwap)(nil), "lbm.fswap.v1.MsgReverseSwap")
+	proto.RegisterType((*MsgReverseSwapResponse)(nil), "lbm.fswap.v1.MsgReverseSwapResponse")
 }
 
 func init() { proto.RegisterFile("lbm/fswap/v1/tx.proto", fileDescriptor_65c77cf1d9b67323) }
 
 var fileDescriptor_65c77cf1d9b67323 = []byte{
-	// 506 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcb, 0x8e, 0xda, 0x30,
-	0x14, 0xc5, 0x33, 0xa8, 0x14, 0x33, 0xea, 0x74, 0xac, 0x41, 0x62, 0x50, 0x09, 0x34, 0xab, 0x91,
-	0xda, 0xda, 0x82, 0xd9, 0x55, 0xdd, 0x40, 0x1f, 0xea, 0x86, 0x4d, 0xba, 0xeb, 0x06, 0x39, 0xc4,
-	0x84, 0x88, 0x38, 0x8e, 0xb0, 0x61, 0xe0, 0x2f, 0xfa, 0x1d, 0xfd, 0x8b, 0x76, 0x35, 0xcb, 0xe9,
-	0xae, 0xab, 0x69, 0x05, 0x7f, 0xd0, 0x2f, 0xa8, 0xec, 0x38, 0xd0, 0xa8, 0xf4, 0xb1, 0x73, 0xee,
-	0x39, 0xb9, 0xe7, 0x9c, 0x7b, 0x6d, 0x58, 0x8f, 0x7d, 0x4e, 0x26, 0xf2, 0x9a, 0xa6, 0x64, 0xd9,
-	0x25, 0x6a, 0x85, 0xd3, 0xb9, 0x50, 0x02, 0x9d, 0xc4, 0x3e, 0xc7, 0xa6, 0x8c, 0x97, 0xdd, 0xe6,
-	0x79, 0x28, 0x42, 0x61, 0x00, 0xa2, 0x4f, 0x19, 0xa7, 0xe9, 0x8c, 0x85, 0xe4, 0x42, 0x12, 0x9f,
-	0x4a, 0x46, 0x96, 0x5d, 0x9f, 0x29, 0xda, 0x25, 0x63, 0x11, 0x25, 0xbf, 0xe1, 0xc9, 0x6c, 0x87,
-	0xeb, 0x0f, 0x8b, 0x37, 0x0a, 0xd2, 0x99, 0x98, 0x41, 0xdc, 0x4f, 0x00, 0x56, 0x86, 0x32, 0x7c,
-	0x77, 0x4d, 0x53, 0xf4, 0x18, 0x9e, 0x4c, 0xe6, 0x82, 0x8f, 0x68, 0x10, 0xcc, 0x99, 0x94, 0x0d,
-	0xd0, 0x01, 0x97, 0x55, 0xaf, 0xa6, 0x6b, 0xfd, 0xac, 0x84, 0x56, 0xf0, 0xa1, 0xa1, 0x68, 0xed,
-	0x11, 0xe5, 0x62, 0x91, 0xa8, 0xc6, 0x51, 0x07, 0x5c, 0xd6, 0x7a, 0x17, 0x38, 0xf3, 0x80, 0xb5,
-	0x47, 0x6c, 0x3d, 0xe0, 0x97, 0x22, 0x4a, 0x06, 0x57, 0x37, 0x77, 0xed, 0xd2, 0xc7, 0x6f, 0xed,
-	0x27, 0x61, 0xa4, 0xa6, 0x0b, 0x1f, 0x8f, 0x05, 0x27, 0x6f, 0xa2, 0x44, 0x8e, 0xa7, 0x11, 0x25,
-	0x13, 0x7b, 0x78, 0x26, 0x83, 0x19, 0x51, 0xeb, 0x94, 0x49, 0xf3, 0x93, 0xf7, 0x40, 0xeb, 0xe8,
-	0x53, 0xdf, 0xa8, 0xa0, 0x0b, 0x78, 0x5f, 0x89, 0x51, 0xc0, 0x12, 0xc1, 0x1b, 0xc7, 0xc6, 0x58,
-	0x45, 0x89, 0x57, 0xfa, 0xd3, 0x3d, 0x83, 0xa7, 0x36, 0x82, 0xc7, 0x64, 0x2a, 0x12, 0xc9, 0xdc,
-	0x08, 0x42, 0x5b, 0xea, 0xc7, 0xf1, 0xff, 0x04, 0x6b, 0x41, 0x68, 0x28, 0x99, 0xc0, 0x91, 0x21,
-	0x54, 0x75, 0xc5, 0x48, 0xfc, 0x4d, 0xfd, 0x1c, 0xa2, 0xbd, 0xd4, 0xce, 0xc0, 0x67, 0x90, 0x39,
-	0x60, 0xca, 0x8c, 0xf6, 0x11, 0xac, 0xd2, 0x85, 0x9a, 0x8a, 0x79, 0xa4, 0xd6, 0x56, 0x7e, 0x5f,
-	0x40, 0x4f, 0x61, 0x59, 0xaf, 0xc4, 0x4e, 0x12, 0xe1, 0x5f, 0x6f, 0x04, 0xd6, 0xff, 0x0f, 0xca,
-	0x7a, 0x84, 0x9e, 0x61, 0xa1, 0x29, 0x3c, 0xcb, 0xbd, 0x8c, 0x38, 0x53, 0x34, 0xa0, 0x8a, 0x1a,
-	0x53, 0xb5, 0x5e, 0x6b, 0xbf, 0x84, 0x64, 0xb6, 0x5b, 0xc2, 0xd0, 0x92, 0x06, 0x2d, 0xdd, 0xe5,
-	0xc7, 0x5d, 0xbb, 0xbe, 0xa6, 0x3c, 0x7e, 0xee, 0x16, 0x5b, 0xb8, 0xde, 0xa9, 0x8d, 0x94, 0xf3,
-	0xf3, 0x68, 0x59, 0x86, 0x3c, 0x5a, 0xef, 0x0b, 0x80, 0xc7, 0x43, 0x19, 0xa2, 0x17, 0xb0, 0x6c,
-	0xb2, 0xd5, 0x8b, 0x7e, 0xed, 0x30, 0x9a, 0xad, 0x83, 0xe5, 0xbc, 0x0b, 0x7a, 0x0d, 0x2b, 0xf9,
-	0x7a, 0x1a, 0x07, 0x99, 0xfd, 0x38, 0x6e, 0x76, 0xfe, 0x84, 0x14, 0xda, 0xd8, 0x19, 0x1f, 0x68,
-	0x93, 0x21, 0x87, 0xda, 0x14, 0x33, 0x0d, 0xde, 0xde, 0x6c, 0x1c, 0x70, 0xbb, 0x71, 0xc0, 0xf7,
-	0x8d, 0x03, 0x3e, 0x6c, 0x9d, 0xd2, 0xed, 0xd6, 0x29, 0x7d, 0xdd, 0x3a, 0xa5, 0xf7, 0xf8, 0x9f,
-	0x97, 0x76, 0x65, 0x5f, 0x96, 0xb9, 0xbc, 0xfe, 0x3d, 0xf3, 0xae, 0xae, 0x7e, 0x06, 0x00, 0x00,
-	0xff, 0xff, 0xc9, 0xd9, 0x78, 0xc1, 0xee, 0x03, 0x00, 0x00,
+	// 668 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xc1, 0x4e, 0xdb, 0x40,
+	0x10, 0x8d, 0x21, 0x2a, 0xcd, 0x26, 0x82, 0xb2, 0x22, 0xc8, 0x58, 0xc4, 0xa1, 0x56, 0x0f, 0x48,
+	0x6d, 0xd7, 0x0a, 0xdc, 0xaa, 0x5e, 0x12, 0x4a, 0x55, 0xa9, 0xca, 0xa1, 0xa6, 0xa7, 0x5e, 0xac,
+	0x75, 0xbc, 0x71, 0x2c, 0x6c, 0x6f, 0x94, 0xdd, 0x04, 0xf8, 0x0b, 0xbe, 0xa3, 0xbf, 0xd0, 0x53,
+	0x7b, 0xe2, 0xc8, 0xb1, 0x27, 0xa8, 0xe0, 0x0b, 0xda, 0x2f, 0xa8, 0x76, 0xbd, 0x76, 0xe2, 0x12,
+	0x68, 0xd4, 0x43, 0x6f, 0xeb, 0x79, 0xb3, 0x33, 0xef, 0xcd, 0xbe, 0x31, 0xa8, 0x47, 0x5e, 0x6c,
+	0xf7, 0xd9, 0x09, 0x1e, 0xda, 0x93, 0x96, 0xcd, 0x4f, 0xd1, 0x70, 0x44, 0x39, 0x85, 0xb5, 0xc8,
+	0x8b, 0x91, 0x0c, 0xa3, 0x49, 0xcb, 0x68, 0x06, 0x94, 0x06, 0x11, 0xb1, 0x25, 0xe6, 0x8d, 0xfb,
+	0x36, 0x0f, 0x63, 0xc2, 0x38, 0x8e, 0x87, 0x69, 0xba, 0xb1, 0x11, 0xd0, 0x80, 0xca, 0xa3, 0x2d,
+	0x4e, 0x2a, 0x6a, 0xf6, 0x28, 0x8b, 0x29, 0xb3, 0x3d, 0xcc, 0x88, 0x3d, 0x69, 0x79, 0x84, 0xe3,
+	0x96, 0xdd, 0xa3, 0x61, 0x72, 0x07, 0x4f, 0x8e, 0x73, 0x5c, 0x7c, 0x28, 0x5c, 0x2f, 0x70, 0x4b,
+	0xd9, 0x48, 0xc4, 0xfa, 0xaa, 0x81, 0x95, 0x2e, 0x0b, 0x8e, 0x4e, 0xf0, 0x10, 0x3e, 0x05, 0xb5,
+	0xfe, 0x88, 0xc6, 0x2e, 0xf6, 0xfd, 0x11, 0x61, 0x4c, 0xd7, 0x76, 0xb4, 0xdd, 0x8a, 0x53, 0x15,
+	0xb1, 0x76, 0x1a, 0x82, 0xa7, 0xe0, 0x89, 0x4c, 0x11, 0xbd, 0x5d, 0x1c, 0xd3, 0x71, 0xc2, 0xf5,
+	0xa5, 0x1d, 0x6d, 0xb7, 0xba, 0xb7, 0x85, 0x52, 0x0e, 0x48, 0x70, 0x44, 0x8a, 0x03, 0x3a, 0xa0,
+	0x61, 0xd2, 0xd9, 0xbf, 0xb8, 0x6a, 0x96, 0x3e, 0x5f, 0x37, 0x9f, 0x07, 0x21, 0x1f, 0x8c, 0x3d,
+	0xd4, 0xa3, 0xb1, 0xfd, 0x36, 0x4c, 0x58, 0x6f, 0x10, 0x62, 0xbb, 0xaf, 0x0e, 0x2f, 0x99, 0x7f,
+	0x6c, 0xf3, 0xb3, 0x21, 0x61, 0xf2, 0x92, 0xb3, 0x2a, 0xfa, 0x88, 0x53, 0x5b, 0x76, 0x81, 0x5b,
+	0xe0, 0x31, 0xa7, 0xae, 0x4f, 0x12, 0x1a, 0xeb, 0xcb, 0x92, 0xd8, 0x0a, 0xa7, 0x6f, 0xc4, 0xa7,
+	0xb5, 0x0e, 0xd6, 0x94, 0x04, 0x87, 0xb0, 0x21, 0x4d, 0x18, 0xb1, 0x42, 0x00, 0x54, 0xa8, 0x1d,
+	0x45, 0x8b, 0x08, 0x6b, 0x00, 0x20, 0x53, 0xd2, 0x06, 0x4b, 0x32, 0xa1, 0x22, 0x22, 0xb2, 0xc5,
+	0x43, 0xdd, 0x37, 0x00, 0x9c, 0xb6, 0xca, 0x09, 0x7c, 0xd3, 0x52, 0x06, 0x84, 0xcb, 0xd1, 0x6e,
+	0x83, 0x0a, 0x1e, 0xf3, 0x01, 0x1d, 0x85, 0xfc, 0x4c, 0xb5, 0x9f, 0x06, 0xe0, 0x0b, 0x50, 0x16,
+	0x4f, 0xa2, 0x26, 0x09, 0xd1, 0xac, 0x65, 0x90, 0xb8, 0xdf, 0x29, 0x8b, 0x11, 0x3a, 0x32, 0x0b,
+	0x0e, 0xc0, 0x7a, 0xc6, 0xc5, 0x8d, 0x09, 0xc7, 0x3e, 0xe6, 0x58, 0x92, 0xaa, 0xee, 0x35, 0xa6,
+	0x8f, 0x90, 0x1c, 0xe7, 0x8f, 0xd0, 0x55, 0x49, 0x9d, 0x86, 0xa8, 0xf2, 0xeb, 0xaa, 0x59, 0x3f,
+	0xc3, 0x71, 0xf4, 0xca, 0x2a, 0x96, 0xb0, 0x9c, 0x35, 0x25, 0x29, 0xcb, 0xcf, 0xa4, 0xa5, 0x1a,
+	0x72, 0x69, 0x5f, 0x34, 0x50, 0xeb, 0xb2, 0xe0, 0x20, 0xa2, 0x8c, 0x2c, 0x20, 0xee, 0x9f, 0x27,
+	0x0b, 0x8f, 0x40, 0x7d, 0x44, 0x26, 0x64, 0xc4, 0x88, 0x2b, 0x84, 0xbb, 0x24, 0xf1, 0x5d, 0xb1,
+	0x2f, 0x7a, 0x59, 0x8a, 0x35, 0x50, 0xba, 0x4c, 0x28, 0x5b, 0x26, 0xf4, 0x31, 0x5b, 0xa6, 0x4e,
+	0xf9, 0xfc, 0xba, 0xa9, 0x39, 0x50, 0x5d, 0x17, 0x2c, 0x0f, 0x13, 0x5f, 0xc0, 0xd6, 0x26, 0xd8,
+	0x98, 0x25, 0x9f, 0xab, 0xba, 0xd0, 0xc0, 0x6a, 0x97, 0x05, 0xce, 0xf4, 0xc6, 0x22, 0xb6, 0xe1,
+	0x60, 0x95, 0xd3, 0xff, 0xb0, 0x0d, 0x35, 0x4e, 0x67, 0x76, 0xa1, 0x38, 0xd2, 0xe5, 0x3f, 0x46,
+	0x6a, 0xe9, 0x60, 0xb3, 0xa8, 0x24, 0x13, 0xb9, 0xf7, 0x73, 0x09, 0x2c, 0x77, 0x59, 0x00, 0x5f,
+	0x83, 0xb2, 0x54, 0x58, 0x2f, 0x5a, 0x4d, 0xf9, 0xd8, 0x68, 0xcc, 0x0d, 0x67, 0x55, 0xe0, 0x21,
+	0x58, 0xc9, 0x36, 0x4b, 0x9f, 0x9b, 0xd9, 0x8e, 0x22, 0x63, 0xe7, 0x3e, 0xa4, 0x50, 0x46, 0xad,
+	0xc7, 0x9c, 0x32, 0x29, 0x32, 0xaf, 0x4c, 0xd1, 0x8e, 0xf0, 0x3d, 0xa8, 0x4c, 0xad, 0x68, 0xdc,
+	0x49, 0xcf, 0x31, 0xc3, 0xba, 0x1f, 0xcb, 0x8b, 0x7d, 0x00, 0xd5, 0x59, 0x07, 0x6c, 0xdf, 0xb9,
+	0x32, 0x83, 0x1a, 0xcf, 0x1e, 0x42, 0xb3, 0x92, 0x9d, 0x77, 0x17, 0x37, 0xa6, 0x76, 0x79, 0x63,
+	0x6a, 0x3f, 0x6e, 0x4c, 0xed, 0xfc, 0xd6, 0x2c, 0x5d, 0xde, 0x9a, 0xa5, 0xef, 0xb7, 0x66, 0xe9,
+	0x13, 0xfa, 0xab, 0x03, 0x4e, 0xd5, 0x4f, 0x5b, 0x3a, 0xc1, 0x7b, 0x24, 0x8d, 0xbe, 0xff, 0x3b,
+	0x00, 0x00, 0xff, 0xff, 0x63, 0x33, 0x82, 0xce, 0x6a, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -384,6 +606,8 @@ type MsgClient interface {
 	Swap(ctx context.Context, in *MsgSwap, opts ...grpc.CallOption) (*MsgSwapResponse, error)
 	SwapAll(ctx context.Context, in *MsgSwapAll, opts ...grpc.CallOption) (*MsgSwapAllResponse, error)
 	SetSwap(ctx context.Context, in *MsgSetSwap, opts ...grpc.CallOption) (*MsgSetSwapResponse, error)
+	CloseSwap(ctx context.Context, in *MsgCloseSwap, opts ...grpc.CallOption) (*MsgCloseSwapResponse, error)
+	ReverseSwap(ctx context.Context, in *MsgReverseSwap, opts ...grpc.CallOption) (*MsgReverseSwapResponse, error)
 }
 
 type msgClient struct {
@@ -421,11 +645,31 @@ func (c *msgClient) SetSwap(ctx context.Context, in *MsgSetSwap, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *msgClient) CloseSwap(ctx context.Context, in *MsgCloseSwap, opts ...grpc.CallOption) (*MsgCloseSwapResponse, error) {
+	out := new(MsgCloseSwapResponse)
+	err := c.cc.Invoke(ctx, "/lbm.fswap.v1.Msg/CloseSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReverseSwap(ctx context.Context, in *MsgReverseSwap, opts ...grpc.CallOption) (*MsgReverseSwapResponse, error) {
+	out := new(MsgReverseSwapResponse)
+	err := c.cc.Invoke(ctx, "/lbm.fswap.v1.Msg/ReverseSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	Swap(context.Context, *MsgSwap) (*MsgSwapResponse, error)
 	SwapAll(context.Context, *MsgSwapAll) (*MsgSwapAllResponse, error)
 	SetSwap(context.Context, *MsgSetSwap) (*MsgSetSwapResponse, error)
+	CloseSwap(context.Context, *MsgCloseSwap) (*MsgCloseSwapResponse, error)
+	ReverseSwap(context.Context, *MsgReverseSwap) (*MsgReverseSwapResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -441,6 +685,12 @@ func (*UnimplementedMsgServer) SwapAll(ctx context.Context, req *MsgSwapAll) (*M
 func (*UnimplementedMsgServer) SetSwap(ctx context.Context, req *MsgSetSwap) (*MsgSetSwapResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetSwap not implemented")
 }
+func (*UnimplementedMsgServer) CloseSwap(ctx context.Context, req *MsgCloseSwap) (*MsgCloseSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseSwap not implemented")
+}
+func (*UnimplementedMsgServer) ReverseSwap(ctx context.Context, req *MsgReverseSwap) (*MsgReverseSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReverseSwap not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -500,6 +750,42 @@ func _Msg_SetSwap_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CloseSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCloseSwap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CloseSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.fswap.v1.Msg/CloseSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CloseSwap(ctx, req.(*MsgCloseSwap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReverseSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReverseSwap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReverseSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.fswap.v1.Msg/ReverseSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReverseSwap(ctx, req.(*MsgReverseSwap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lbm.fswap.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -516,6 +802,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetSwap",
 			Handler:    _Msg_SetSwap_Handler,
 		},
+		{
+			MethodName: "CloseSwap",
+			Handler:    _Msg_CloseSwap_Handler,
+		},
+		{
+			MethodName: "ReverseSwap",
+			Handler:    _Msg_ReverseSwap_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lbm/fswap/v1/tx.proto",
@@ -731,48 +1025,195 @@ func (m *MsgSetSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgCloseSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgSwap) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgCloseSwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCloseSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.ReverseSwapEndTime != nil {
+		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ReverseSwapEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ReverseSwapEndTime):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintTx(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = m.FromCoinAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.ToDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ToDenom) > 0 {
+		i -= len(m.ToDenom)
+		copy(dAtA[i:], m.ToDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToDenom)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.FromDenom) > 0 {
+		i -= len(m.FromDenom)
+		copy(dAtA[i:], m.FromDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSwapResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCloseSwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCloseSwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCloseSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSwapAll) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgReverseSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReverseSwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReverseSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FromDenom) > 0 {
+		i -= len(m.FromDenom)
+		copy(dAtA[i:], m.FromDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.ToCoinAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReverseSwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReverseSwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReverseSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.FromCoinAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ToDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSwapAll) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -788,51 +1229,506 @@ func (m *MsgSwapAll) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgSwapAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Swap.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ToDenomMetadata.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetSwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCloseSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FromDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ReverseSwapEndTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ReverseSwapEndTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCloseSwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReverseSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ToCoinAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.FromDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReverseSwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromCoinAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FromCoinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapAll) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapAll: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapAll: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgSwapAllResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgSetSwap) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSwapAllResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapAllResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Swap.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.ToDenomMetadata.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgSetSwapResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgSwap) Unmarshal(dAtA []byte) error {
+func (m *MsgSetSwap) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -855,15 +1751,15 @@ func (m *MsgSwap) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwap: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetSwap: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetSwap: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -891,11 +1787,11 @@ func (m *MsgSwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromCoinAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Swap", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -922,15 +1818,15 @@ func (m *MsgSwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FromCoinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Swap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToDenomMetadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -940,23 +1836,24 @@ func (m *MsgSwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.ToDenomMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -979,7 +1876,7 @@ func (m *MsgSwap) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetSwapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1002,10 +1899,10 @@ func (m *MsgSwapResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetSwapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1029,7 +1926,7 @@ func (m *MsgSwapResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapAll) Unmarshal(dAtA []byte) error {
+func (m *MsgCloseSwap) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1052,15 +1949,15 @@ func (m *MsgSwapAll) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapAll: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCloseSwap: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapAll: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCloseSwap: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1088,7 +1985,7 @@ func (m *MsgSwapAll) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1154,6 +2051,42 @@ func (m *MsgSwapAll) Unmarshal(dAtA []byte) error {
 			}
 			m.ToDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReverseSwapEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReverseSwapEndTime == nil {
+				m.ReverseSwapEndTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ReverseSwapEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1175,7 +2108,7 @@ func (m *MsgSwapAll) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapAllResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCloseSwapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1198,10 +2131,10 @@ func (m *MsgSwapAllResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapAllResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCloseSwapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCloseSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1225,7 +2158,7 @@ func (m *MsgSwapAllResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetSwap) Unmarshal(dAtA []byte) error {
+func (m *MsgReverseSwap) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1248,15 +2181,15 @@ func (m *MsgSetSwap) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetSwap: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReverseSwap: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReverseSwap: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1284,11 +2217,11 @@ func (m *MsgSetSwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Swap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToCoinAmount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1315,15 +2248,15 @@ func (m *MsgSetSwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Swap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ToCoinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToDenomMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1333,24 +2266,23 @@ func (m *MsgSetSwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ToDenomMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FromDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1373,7 +2305,7 @@ func (m *MsgSetSwap) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetSwapResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReverseSwapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1396,10 +2328,10 @@ func (m *MsgSetSwapResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetSwapResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReverseSwapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReverseSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
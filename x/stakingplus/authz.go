@@ -23,7 +23,17 @@ func (a CreateValidatorAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (foun
 		return foundation.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrap("validator address differs from the authorization's")
 	}
 
-	return foundation.AcceptResponse{Accept: true}, nil
+	switch a.RemainingUses {
+	case 0: // no limit
+		return foundation.AcceptResponse{Accept: true}, nil
+	case 1:
+		return foundation.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return foundation.AcceptResponse{Accept: true, Updated: &CreateValidatorAuthorization{
+		ValidatorAddress: a.ValidatorAddress,
+		RemainingUses:    a.RemainingUses - 1,
+	}}, nil
 }
 
 func (a CreateValidatorAuthorization) ValidateBasic() error {
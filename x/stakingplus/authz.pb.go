@@ -28,6 +28,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type CreateValidatorAuthorization struct {
 	// redundant, but good for the query.
 	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// remaining_uses is the number of the validator creations left to the
+	// grantee. The authorization is deleted once it runs out.
+	// zero means no limit.
+	RemainingUses uint64 `protobuf:"varint,2,opt,name=remaining_uses,json=remainingUses,proto3" json:"remaining_uses,omitempty"`
 }
 
 func (m *CreateValidatorAuthorization) Reset()         { *m = CreateValidatorAuthorization{} }
@@ -70,6 +74,13 @@ func (m *CreateValidatorAuthorization) GetValidatorAddress() string {
 	return ""
 }
 
+func (m *CreateValidatorAuthorization) GetRemainingUses() uint64 {
+	if m != nil {
+		return m.RemainingUses
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*CreateValidatorAuthorization)(nil), "lbm.stakingplus.v1.CreateValidatorAuthorization")
 }
@@ -77,22 +88,24 @@ func init() {
 func init() { proto.RegisterFile("lbm/stakingplus/v1/authz.proto", fileDescriptor_85cae299ee13354e) }
 
 var fileDescriptor_85cae299ee13354e = []byte{
-	// 241 bytes of a gzipped FileDescriptorProto
+	// 273 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcb, 0x49, 0xca, 0xd5,
 	0x2f, 0x2e, 0x49, 0xcc, 0xce, 0xcc, 0x4b, 0x2f, 0xc8, 0x29, 0x2d, 0xd6, 0x2f, 0x33, 0xd4, 0x4f,
 	0x2c, 0x2d, 0xc9, 0xa8, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0xca, 0x49, 0xca, 0xd5,
 	0x43, 0x92, 0xd7, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x4b, 0xeb, 0x83, 0x58,
 	0x10, 0x95, 0x52, 0x92, 0xc9, 0xf9, 0xc5, 0xb9, 0xf9, 0xc5, 0xf1, 0x10, 0x09, 0x08, 0x07, 0x22,
-	0xa5, 0xd4, 0xc3, 0xc8, 0x25, 0xe3, 0x5c, 0x94, 0x9a, 0x58, 0x92, 0x1a, 0x96, 0x98, 0x93, 0x99,
-	0x92, 0x58, 0x92, 0x5f, 0xe4, 0x58, 0x5a, 0x92, 0x91, 0x5f, 0x94, 0x59, 0x95, 0x58, 0x92, 0x99,
-	0x9f, 0x27, 0xa4, 0xcd, 0x25, 0x58, 0x06, 0x93, 0x89, 0x4f, 0x4c, 0x49, 0x29, 0x4a, 0x2d, 0x2e,
-	0x96, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x0c, 0x12, 0x80, 0x4b, 0x38, 0x42, 0xc4, 0xad, 0xec, 0x2f,
+	0xa5, 0xb4, 0x99, 0x91, 0x4b, 0xc6, 0xb9, 0x28, 0x35, 0xb1, 0x24, 0x35, 0x2c, 0x31, 0x27, 0x33,
+	0x25, 0xb1, 0x24, 0xbf, 0xc8, 0xb1, 0xb4, 0x24, 0x23, 0xbf, 0x28, 0xb3, 0x2a, 0xb1, 0x24, 0x33,
+	0x3f, 0x4f, 0x48, 0x9b, 0x4b, 0xb0, 0x0c, 0x26, 0x13, 0x9f, 0x98, 0x92, 0x52, 0x94, 0x5a, 0x5c,
+	0x2c, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x19, 0x24, 0x00, 0x97, 0x70, 0x84, 0x88, 0x0b, 0xa9, 0x72,
+	0xf1, 0x15, 0xa5, 0xe6, 0x26, 0x66, 0xe6, 0x65, 0xe6, 0xa5, 0xc7, 0x97, 0x16, 0xa7, 0x16, 0x4b,
+	0x30, 0x29, 0x30, 0x6a, 0xb0, 0x04, 0xf1, 0xc2, 0x45, 0x43, 0x8b, 0x53, 0x8b, 0xad, 0xec, 0x2f,
 	0x6d, 0xd1, 0xb5, 0x4e, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x77, 0xcb,
 	0xcc, 0x2b, 0x4e, 0xce, 0xc8, 0x4c, 0xd4, 0x4f, 0x83, 0x32, 0x74, 0x8b, 0x53, 0xb2, 0xf5, 0x2b,
-	0xf4, 0xd3, 0xf2, 0x4b, 0xf3, 0x52, 0xc0, 0xe6, 0xeb, 0xa1, 0xd8, 0xe6, 0xe4, 0x71, 0xe2, 0x91,
-	0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1,
-	0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x7a, 0x44, 0x18, 0x8b, 0x14, 0x18, 0x49, 0x6c,
-	0x60, 0xff, 0x19, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x10, 0x9b, 0x46, 0xf1, 0x46, 0x01, 0x00,
+	0xf4, 0xd3, 0xf2, 0x4b, 0xf3, 0x52, 0xc0, 0xce, 0xd0, 0x43, 0x71, 0x94, 0x93, 0xc7, 0x89, 0x47,
+	0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85,
+	0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xe9, 0x11, 0x61, 0x2c, 0x52, 0x98, 0x25, 0xb1,
+	0x81, 0x83, 0xc1, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xc4, 0x98, 0x1a, 0x73, 0x6d, 0x01, 0x00,
 	0x00,
 }
 
@@ -116,6 +129,11 @@ func (m *CreateValidatorAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if m.RemainingUses != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.RemainingUses))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.ValidatorAddress) > 0 {
 		i -= len(m.ValidatorAddress)
 		copy(dAtA[i:], m.ValidatorAddress)
@@ -147,6 +165,9 @@ func (m *CreateValidatorAuthorization) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAuthz(uint64(l))
 	}
+	if m.RemainingUses != 0 {
+		n += 1 + sovAuthz(uint64(m.RemainingUses))
+	}
 	return n
 }
 
@@ -217,6 +238,25 @@ func (m *CreateValidatorAuthorization) Unmarshal(dAtA []byte) error {
 			}
 			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingUses", wireType)
+			}
+			m.RemainingUses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingUses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthz(dAtA[iNdEx:])
@@ -9,6 +9,7 @@ import (
 	"github.com/Finschia/finschia-sdk/crypto/keys/secp256k1"
 	sdk "github.com/Finschia/finschia-sdk/types"
 	"github.com/Finschia/finschia-sdk/x/foundation"
+	stakingtypes "github.com/Finschia/finschia-sdk/x/staking/types"
 )
 
 func TestAminoJson(t *testing.T) {
@@ -31,3 +32,67 @@ func TestAminoJson(t *testing.T) {
 
 	require.Equal(t, expected, string(grantMsg.GetSignBytes()))
 }
+
+func TestCreateValidatorAuthorization(t *testing.T) {
+	valAddr := sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	testCases := map[string]struct {
+		uses    uint64
+		msg     sdk.Msg
+		valid   bool
+		accept  bool
+		delete  bool
+		updated foundation.Authorization
+	}{
+		"valid": {
+			msg:    &stakingtypes.MsgCreateValidator{ValidatorAddress: valAddr.String()},
+			valid:  true,
+			accept: true,
+		},
+		"msg mismatch": {
+			msg: &foundation.MsgVote{},
+		},
+		"validator mismatch": {
+			msg: &stakingtypes.MsgCreateValidator{
+				ValidatorAddress: sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+			},
+		},
+		"valid (within the uses)": {
+			uses:   3,
+			msg:    &stakingtypes.MsgCreateValidator{ValidatorAddress: valAddr.String()},
+			valid:  true,
+			accept: true,
+			updated: &CreateValidatorAuthorization{
+				ValidatorAddress: valAddr.String(),
+				RemainingUses:    2,
+			},
+		},
+		"valid (exhaust the uses)": {
+			uses:   1,
+			msg:    &stakingtypes.MsgCreateValidator{ValidatorAddress: valAddr.String()},
+			valid:  true,
+			accept: true,
+			delete: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			authorization := &CreateValidatorAuthorization{
+				ValidatorAddress: valAddr.String(),
+				RemainingUses:    tc.uses,
+			}
+
+			resp, err := authorization.Accept(sdk.Context{}, tc.msg)
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, tc.accept, resp.Accept)
+			require.Equal(t, tc.delete, resp.Delete)
+			require.Equal(t, tc.updated, resp.Updated)
+		})
+	}
+}
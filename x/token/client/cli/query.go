@@ -31,6 +31,9 @@ func NewQueryCmd() *cobra.Command {
 		NewQueryCmdGranteeGrants(),
 		NewQueryCmdIsOperatorFor(),
 		NewQueryCmdHoldersByOperator(),
+		NewQueryCmdFrozenHolders(),
+		NewQueryCmdAllowance(),
+		NewQueryCmdDenom(),
 	)
 
 	return queryCmd
@@ -254,3 +257,89 @@ func NewQueryCmdHoldersByOperator() *cobra.Command {
 	flags.AddPaginationFlagsToCmd(cmd, "authorizations")
 	return cmd
 }
+
+func NewQueryCmdFrozenHolders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "frozen-holders [contract-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "query all frozen holders of a token",
+		Example: fmt.Sprintf(`$ %s query %s frozen-holders <contract-id>`, version.AppName, token.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := token.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.FrozenHolders(cmd.Context(), &token.QueryFrozenHoldersRequest{
+				ContractId: args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "frozen holders")
+	return cmd
+}
+
+func NewQueryCmdAllowance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "allowance [contract-id] [holder] [spender]",
+		Args:    cobra.ExactArgs(3),
+		Short:   "query the allowance of a spender on the tokens of a holder",
+		Example: fmt.Sprintf(`$ %s query %s allowance <contract-id> <holder> <spender>`, version.AppName, token.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := token.NewQueryClient(clientCtx)
+			res, err := queryClient.Allowance(cmd.Context(), &token.QueryAllowanceRequest{
+				ContractId: args[0],
+				Holder:     args[1],
+				Spender:    args[2],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewQueryCmdDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "denom [contract-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "query the bank denom registered for a token",
+		Example: fmt.Sprintf(`$ %s query %s denom <contract-id>`, version.AppName, token.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := token.NewQueryClient(clientCtx)
+			res, err := queryClient.Denom(cmd.Context(), &token.QueryDenomRequest{
+				ContractId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
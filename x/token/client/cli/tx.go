@@ -16,11 +16,13 @@ import (
 )
 
 const (
-	FlagSupply   = "supply"
-	FlagDecimals = "decimals"
-	FlagMintable = "mintable"
-	FlagMeta     = "meta"
-	FlagImageURI = "image-uri"
+	FlagSupply    = "supply"
+	FlagDecimals  = "decimals"
+	FlagMintable  = "mintable"
+	FlagMeta      = "meta"
+	FlagImageURI  = "image-uri"
+	FlagMaxSupply = "max-supply"
+	FlagIncoming  = "incoming"
 
 	DefaultDecimals = 8
 	DefaultSupply   = "1"
@@ -48,6 +50,17 @@ func NewTxCmd() *cobra.Command {
 		NewTxCmdBurn(),
 		NewTxCmdOperatorBurn(),
 		NewTxCmdModify(),
+		NewTxCmdPause(),
+		NewTxCmdUnpause(),
+		NewTxCmdFreeze(),
+		NewTxCmdUnfreeze(),
+		NewTxCmdApprove(),
+		NewTxCmdIncreaseAllowance(),
+		NewTxCmdDecreaseAllowance(),
+		NewTxCmdRegisterDenom(),
+		NewTxCmdLock(),
+		NewTxCmdUnlock(),
+		NewTxCmdBatchSend(),
 	)
 
 	return txCmd
@@ -229,16 +242,30 @@ func NewTxCmdIssue() *cobra.Command {
 				return err
 			}
 
+			var maxSupply *sdk.Int
+			maxSupplyStr, err := cmd.Flags().GetString(FlagMaxSupply)
+			if err != nil {
+				return err
+			}
+			if len(maxSupplyStr) != 0 {
+				amount, ok := sdk.NewIntFromString(maxSupplyStr)
+				if !ok {
+					return sdkerrors.ErrInvalidType.Wrapf("failed to set max supply: %s", maxSupplyStr)
+				}
+				maxSupply = &amount
+			}
+
 			msg := token.MsgIssue{
-				Owner:    args[0],
-				To:       args[1],
-				Name:     args[2],
-				Symbol:   args[3],
-				Uri:      imageURI,
-				Meta:     meta,
-				Amount:   supply,
-				Mintable: mintable,
-				Decimals: decimals,
+				Owner:     args[0],
+				To:        args[1],
+				Name:      args[2],
+				Symbol:    args[3],
+				Uri:       imageURI,
+				Meta:      meta,
+				Amount:    supply,
+				Mintable:  mintable,
+				Decimals:  decimals,
+				MaxSupply: maxSupply,
 			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -253,6 +280,7 @@ func NewTxCmdIssue() *cobra.Command {
 	cmd.Flags().String(FlagSupply, DefaultSupply, "initial supply")
 	cmd.Flags().Bool(FlagMintable, false, "set mintable")
 	cmd.Flags().Int32(FlagDecimals, DefaultDecimals, "set decimals")
+	cmd.Flags().String(FlagMaxSupply, "", "set max supply (no limit if not set)")
 
 	return cmd
 }
@@ -458,3 +486,381 @@ func NewTxCmdModify() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func NewTxCmdPause() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause [contract-id] [operator]",
+		Args:  cobra.ExactArgs(2),
+		Short: "pause the transfers and burns of a token",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s pause <contract-id> <operator>`, version.AppName, token.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := token.MsgPause{
+				ContractId: args[0],
+				Operator:   args[1],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdUnpause() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unpause [contract-id] [operator]",
+		Args:  cobra.ExactArgs(2),
+		Short: "unpause the transfers and burns of a token",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s unpause <contract-id> <operator>`, version.AppName, token.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := token.MsgUnpause{
+				ContractId: args[0],
+				Operator:   args[1],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdFreeze() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze [contract-id] [operator] [holder]",
+		Args:  cobra.ExactArgs(3),
+		Short: "freeze the transfers of a holder",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s freeze <contract-id> <operator> <holder>`, version.AppName, token.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			incoming, err := cmd.Flags().GetBool(FlagIncoming)
+			if err != nil {
+				return err
+			}
+
+			msg := token.MsgFreeze{
+				ContractId: args[0],
+				Operator:   args[1],
+				Holder:     args[2],
+				Incoming:   incoming,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Bool(FlagIncoming, false, "block the incoming transfers as well")
+	return cmd
+}
+
+func NewTxCmdUnfreeze() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unfreeze [contract-id] [operator] [holder]",
+		Args:  cobra.ExactArgs(3),
+		Short: "unfreeze the transfers of a holder",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s unfreeze <contract-id> <operator> <holder>`, version.AppName, token.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := token.MsgUnfreeze{
+				ContractId: args[0],
+				Operator:   args[1],
+				Holder:     args[2],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdApprove() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve [contract-id] [holder] [spender] [amount]",
+		Args:  cobra.ExactArgs(4),
+		Short: "set the allowance of a spender",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s approve <contract-id> <holder> <spender> <amount>`, version.AppName, token.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amountStr := args[3]
+			amount, ok := sdk.NewIntFromString(amountStr)
+			if !ok {
+				return sdkerrors.ErrInvalidType.Wrapf("failed to set amount: %s", amountStr)
+			}
+			msg := token.MsgApprove{
+				ContractId: args[0],
+				Holder:     args[1],
+				Spender:    args[2],
+				Amount:     amount,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdIncreaseAllowance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "increase-allowance [contract-id] [holder] [spender] [amount]",
+		Args:  cobra.ExactArgs(4),
+		Short: "increase the allowance of a spender",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s increase-allowance <contract-id> <holder> <spender> <amount>`, version.AppName, token.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amountStr := args[3]
+			amount, ok := sdk.NewIntFromString(amountStr)
+			if !ok {
+				return sdkerrors.ErrInvalidType.Wrapf("failed to set amount: %s", amountStr)
+			}
+			msg := token.MsgIncreaseAllowance{
+				ContractId: args[0],
+				Holder:     args[1],
+				Spender:    args[2],
+				Amount:     amount,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdDecreaseAllowance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decrease-allowance [contract-id] [holder] [spender] [amount]",
+		Args:  cobra.ExactArgs(4),
+		Short: "decrease the allowance of a spender",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s decrease-allowance <contract-id> <holder> <spender> <amount>`, version.AppName, token.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amountStr := args[3]
+			amount, ok := sdk.NewIntFromString(amountStr)
+			if !ok {
+				return sdkerrors.ErrInvalidType.Wrapf("failed to set amount: %s", amountStr)
+			}
+			msg := token.MsgDecreaseAllowance{
+				ContractId: args[0],
+				Holder:     args[1],
+				Spender:    args[2],
+				Amount:     amount,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdRegisterDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-denom [contract-id] [owner]",
+		Args:  cobra.ExactArgs(2),
+		Short: "register the bank denom of a token",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s register-denom <contract-id> <owner>`, version.AppName, token.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := token.MsgRegisterDenom{
+				ContractId: args[0],
+				Owner:      args[1],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdLock() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lock [contract-id] [holder] [amount]",
+		Args:  cobra.ExactArgs(3),
+		Short: "lock tokens to mint the bank denom",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s lock <contract-id> <holder> <amount>`, version.AppName, token.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amountStr := args[2]
+			amount, ok := sdk.NewIntFromString(amountStr)
+			if !ok {
+				return sdkerrors.ErrInvalidType.Wrapf("failed to set amount: %s", amountStr)
+			}
+			msg := token.MsgLock{
+				ContractId: args[0],
+				Holder:     args[1],
+				Amount:     amount,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdUnlock() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unlock [contract-id] [holder] [amount]",
+		Args:  cobra.ExactArgs(3),
+		Short: "burn the bank denom to unlock tokens",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s unlock <contract-id> <holder> <amount>`, version.AppName, token.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amountStr := args[2]
+			amount, ok := sdk.NewIntFromString(amountStr)
+			if !ok {
+				return sdkerrors.ErrInvalidType.Wrapf("failed to set amount: %s", amountStr)
+			}
+			msg := token.MsgUnlock{
+				ContractId: args[0],
+				Holder:     args[1],
+				Amount:     amount,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewTxCmdBatchSend() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-send [contract-id] [from] [to]=[amount] [to]=[amount]...",
+		Args:  cobra.MinimumNArgs(3),
+		Short: "send tokens to many accounts at once",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s batch-send <contract-id> <from> <to>=<amount> <to>=<amount>...`, version.AppName, token.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			entries := make([]token.BatchSendEntry, len(args[2:]))
+			for i, entryStr := range args[2:] {
+				to, amountStr, found := strings.Cut(entryStr, "=")
+				if !found {
+					return sdkerrors.ErrInvalidRequest.Wrapf("missing amount: %s", entryStr)
+				}
+				amount, ok := sdk.NewIntFromString(amountStr)
+				if !ok {
+					return sdkerrors.ErrInvalidType.Wrapf("failed to set amount: %s", amountStr)
+				}
+				entries[i] = token.BatchSendEntry{
+					To:     to,
+					Amount: amount,
+				}
+			}
+
+			msg := token.MsgBatchSend{
+				ContractId: args[0],
+				From:       args[1],
+				Entries:    entries,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
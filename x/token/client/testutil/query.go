@@ -8,6 +8,7 @@ import (
 
 	"github.com/Finschia/finschia-sdk/client/flags"
 	clitestutil "github.com/Finschia/finschia-sdk/testutil/cli"
+	sdk "github.com/Finschia/finschia-sdk/types"
 	"github.com/Finschia/finschia-sdk/types/query"
 	"github.com/Finschia/finschia-sdk/x/token"
 	"github.com/Finschia/finschia-sdk/x/token/client/cli"
@@ -167,9 +168,17 @@ func (s *IntegrationTestSuite) TestNewQueryCmdGranteeGrants() {
 						Grantee:    s.vendor.String(),
 						Permission: token.PermissionBurn,
 					},
+					{
+						Grantee:    s.vendor.String(),
+						Permission: token.PermissionPause,
+					},
+					{
+						Grantee:    s.vendor.String(),
+						Permission: token.PermissionFreeze,
+					},
 				},
 				Pagination: &query.PageResponse{
-					Total: 3,
+					Total: 5,
 				},
 			},
 		},
@@ -331,3 +340,171 @@ func (s *IntegrationTestSuite) TestNewQueryCmdHoldersByOperator() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestNewQueryCmdFrozenHolders() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%d", flags.FlagHeight, s.setupHeight),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	testCases := map[string]struct {
+		args     []string
+		valid    bool
+		expected proto.Message
+	}{
+		"valid query": {
+			[]string{
+				s.classes[0].Id,
+			},
+			true,
+			&token.QueryFrozenHoldersResponse{
+				Holders:    []token.FrozenHolder{},
+				Pagination: &query.PageResponse{},
+			},
+		},
+		"extra args": {
+			[]string{
+				s.classes[0].Id,
+				"extra",
+			},
+			false,
+			nil,
+		},
+		"not enough args": {
+			[]string{},
+			false,
+			nil,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewQueryCmdFrozenHolders()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var actual token.QueryFrozenHoldersResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &actual), out.String())
+			s.Require().Equal(tc.expected, &actual)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewQueryCmdAllowance() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%d", flags.FlagHeight, s.setupHeight),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	testCases := map[string]struct {
+		args     []string
+		valid    bool
+		expected proto.Message
+	}{
+		"valid query": {
+			[]string{
+				s.classes[0].Id,
+				s.customer.String(),
+				val.Address.String(),
+			},
+			true,
+			&token.QueryAllowanceResponse{
+				Amount: sdk.ZeroInt(),
+			},
+		},
+		"extra args": {
+			[]string{
+				s.classes[0].Id,
+				s.customer.String(),
+				val.Address.String(),
+				"extra",
+			},
+			false,
+			nil,
+		},
+		"not enough args": {
+			[]string{
+				s.classes[0].Id,
+				s.customer.String(),
+			},
+			false,
+			nil,
+		},
+		"invalid spender": {
+			[]string{
+				s.classes[0].Id,
+				s.customer.String(),
+				"invalid",
+			},
+			false,
+			nil,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewQueryCmdAllowance()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var actual token.QueryAllowanceResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &actual), out.String())
+			s.Require().Equal(tc.expected, &actual)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewQueryCmdDenom() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%d", flags.FlagHeight, s.setupHeight),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"denom not registered": {
+			[]string{
+				s.classes[0].Id,
+			},
+			false,
+		},
+		"extra args": {
+			[]string{
+				s.classes[0].Id,
+				"extra",
+			},
+			false,
+		},
+		"not enough args": {
+			[]string{},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewQueryCmdDenom()
+			_, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			s.Require().Equal(tc.valid, err == nil)
+		})
+	}
+}
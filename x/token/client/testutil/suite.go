@@ -56,16 +56,18 @@ func (s *IntegrationTestSuite) SetupSuite() {
 
 	s.classes = []token.Contract{
 		{
-			Name:     "test",
-			Symbol:   "ZERO",
-			Decimals: 8,
-			Mintable: true,
+			Name:      "test",
+			Symbol:    "ZERO",
+			Decimals:  8,
+			Mintable:  true,
+			MaxSupply: sdk.ZeroInt(),
 		},
 		{
-			Name:     "test",
-			Symbol:   "ONE",
-			Decimals: 8,
-			Mintable: true,
+			Name:      "test",
+			Symbol:    "ONE",
+			Decimals:  8,
+			Mintable:  true,
+			MaxSupply: sdk.ZeroInt(),
 		},
 	}
 
@@ -118,7 +120,7 @@ func (s *IntegrationTestSuite) createAccount(uid string) sdk.AccAddress {
 	s.Require().NoError(err)
 	addr := keyInfo.GetAddress()
 
-	fee := sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(1000)))
+	fee := sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10000)))
 	args := append([]string{
 		val.Address.String(),
 		addr.String(),
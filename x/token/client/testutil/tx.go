@@ -695,3 +695,744 @@ func (s *IntegrationTestSuite) TestNewTxCmdModify() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestNewTxCmdPause() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, s.vendor),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	// use a new contract not to affect the other tests
+	contractID := s.createClass(s.vendor, s.vendor, "paused", "PSD", s.balance, false)
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid transaction": {
+			[]string{
+				contractID,
+				s.vendor.String(),
+			},
+			true,
+		},
+		"extra args": {
+			[]string{
+				contractID,
+				s.vendor.String(),
+				"extra",
+			},
+			false,
+		},
+		"not enough args": {
+			[]string{
+				contractID,
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewTxCmdPause()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res sdk.TxResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+			s.Require().EqualValues(0, res.Code, out.String())
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewTxCmdUnpause() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, s.vendor),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	// use a new contract not to affect the other tests
+	contractID := s.createClass(s.vendor, s.vendor, "unpaused", "UPSD", s.balance, false)
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.NewTxCmdPause(), append([]string{contractID, s.vendor.String()}, commonArgs...))
+	s.Require().NoError(err)
+	var res sdk.TxResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+	s.Require().EqualValues(0, res.Code, out.String())
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid transaction": {
+			[]string{
+				contractID,
+				s.vendor.String(),
+			},
+			true,
+		},
+		"extra args": {
+			[]string{
+				contractID,
+				s.vendor.String(),
+				"extra",
+			},
+			false,
+		},
+		"not enough args": {
+			[]string{
+				contractID,
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewTxCmdUnpause()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res sdk.TxResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+			s.Require().EqualValues(0, res.Code, out.String())
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewTxCmdFreeze() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, s.vendor),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	// use a new contract not to affect the other tests
+	contractID := s.createClass(s.vendor, s.vendor, "frozen", "FRZ", s.balance, false)
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid transaction": {
+			[]string{
+				contractID,
+				s.vendor.String(),
+				s.customer.String(),
+				fmt.Sprintf("--%s", cli.FlagIncoming),
+			},
+			true,
+		},
+		"extra args": {
+			[]string{
+				contractID,
+				s.vendor.String(),
+				s.customer.String(),
+				"extra",
+			},
+			false,
+		},
+		"not enough args": {
+			[]string{
+				contractID,
+				s.vendor.String(),
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewTxCmdFreeze()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res sdk.TxResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+			s.Require().EqualValues(0, res.Code, out.String())
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewTxCmdUnfreeze() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, s.vendor),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	// use a new contract not to affect the other tests
+	contractID := s.createClass(s.vendor, s.vendor, "unfrozen", "UFRZ", s.balance, false)
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.NewTxCmdFreeze(), append([]string{contractID, s.vendor.String(), s.customer.String()}, commonArgs...))
+	s.Require().NoError(err)
+	var res sdk.TxResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+	s.Require().EqualValues(0, res.Code, out.String())
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid transaction": {
+			[]string{
+				contractID,
+				s.vendor.String(),
+				s.customer.String(),
+			},
+			true,
+		},
+		"extra args": {
+			[]string{
+				contractID,
+				s.vendor.String(),
+				s.customer.String(),
+				"extra",
+			},
+			false,
+		},
+		"not enough args": {
+			[]string{
+				contractID,
+				s.vendor.String(),
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewTxCmdUnfreeze()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res sdk.TxResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+			s.Require().EqualValues(0, res.Code, out.String())
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewTxCmdApprove() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, s.customer),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid transaction": {
+			[]string{
+				s.classes[0].Id,
+				s.customer.String(),
+				val.Address.String(),
+				"1",
+			},
+			true,
+		},
+		"extra args": {
+			[]string{
+				s.classes[0].Id,
+				s.customer.String(),
+				val.Address.String(),
+				"1",
+				"extra",
+			},
+			false,
+		},
+		"not enough args": {
+			[]string{
+				s.classes[0].Id,
+				s.customer.String(),
+				val.Address.String(),
+			},
+			false,
+		},
+		"invalid amount": {
+			[]string{
+				s.classes[0].Id,
+				s.customer.String(),
+				val.Address.String(),
+				"invalid",
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewTxCmdApprove()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res sdk.TxResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+			s.Require().EqualValues(0, res.Code, out.String())
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewTxCmdIncreaseAllowance() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, s.customer),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid transaction": {
+			[]string{
+				s.classes[0].Id,
+				s.customer.String(),
+				val.Address.String(),
+				"1",
+			},
+			true,
+		},
+		"extra args": {
+			[]string{
+				s.classes[0].Id,
+				s.customer.String(),
+				val.Address.String(),
+				"1",
+				"extra",
+			},
+			false,
+		},
+		"not enough args": {
+			[]string{
+				s.classes[0].Id,
+				s.customer.String(),
+				val.Address.String(),
+			},
+			false,
+		},
+		"invalid amount": {
+			[]string{
+				s.classes[0].Id,
+				s.customer.String(),
+				val.Address.String(),
+				"invalid",
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewTxCmdIncreaseAllowance()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res sdk.TxResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+			s.Require().EqualValues(0, res.Code, out.String())
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewTxCmdDecreaseAllowance() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, s.customer),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.NewTxCmdApprove(), append([]string{s.classes[0].Id, s.customer.String(), val.Address.String(), "10"}, commonArgs...))
+	s.Require().NoError(err)
+	var res sdk.TxResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+	s.Require().EqualValues(0, res.Code, out.String())
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid transaction": {
+			[]string{
+				s.classes[0].Id,
+				s.customer.String(),
+				val.Address.String(),
+				"1",
+			},
+			true,
+		},
+		"extra args": {
+			[]string{
+				s.classes[0].Id,
+				s.customer.String(),
+				val.Address.String(),
+				"1",
+				"extra",
+			},
+			false,
+		},
+		"not enough args": {
+			[]string{
+				s.classes[0].Id,
+				s.customer.String(),
+				val.Address.String(),
+			},
+			false,
+		},
+		"invalid amount": {
+			[]string{
+				s.classes[0].Id,
+				s.customer.String(),
+				val.Address.String(),
+				"invalid",
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewTxCmdDecreaseAllowance()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res sdk.TxResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+			s.Require().EqualValues(0, res.Code, out.String())
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewTxCmdRegisterDenom() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, s.vendor),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	// use a new contract not to affect the other tests
+	contractID := s.createClass(s.vendor, s.vendor, "registered", "REG", s.balance, false)
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid transaction": {
+			[]string{
+				contractID,
+				s.vendor.String(),
+			},
+			true,
+		},
+		"extra args": {
+			[]string{
+				contractID,
+				s.vendor.String(),
+				"extra",
+			},
+			false,
+		},
+		"not enough args": {
+			[]string{
+				contractID,
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewTxCmdRegisterDenom()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res sdk.TxResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+			s.Require().EqualValues(0, res.Code, out.String())
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewTxCmdLock() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, s.vendor),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	// use a new contract not to affect the other tests
+	contractID := s.createClass(s.vendor, s.vendor, "locked", "LCK", s.balance, false)
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.NewTxCmdRegisterDenom(), append([]string{contractID, s.vendor.String()}, commonArgs...))
+	s.Require().NoError(err)
+	var res sdk.TxResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+	s.Require().EqualValues(0, res.Code, out.String())
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid transaction": {
+			[]string{
+				contractID,
+				s.vendor.String(),
+				"1",
+			},
+			true,
+		},
+		"extra args": {
+			[]string{
+				contractID,
+				s.vendor.String(),
+				"1",
+				"extra",
+			},
+			false,
+		},
+		"not enough args": {
+			[]string{
+				contractID,
+				s.vendor.String(),
+			},
+			false,
+		},
+		"invalid amount": {
+			[]string{
+				contractID,
+				s.vendor.String(),
+				"0",
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewTxCmdLock()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res sdk.TxResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+			s.Require().EqualValues(0, res.Code, out.String())
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewTxCmdUnlock() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, s.vendor),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	// use a new contract not to affect the other tests
+	contractID := s.createClass(s.vendor, s.vendor, "unlocked", "ULCK", s.balance, false)
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.NewTxCmdRegisterDenom(), append([]string{contractID, s.vendor.String()}, commonArgs...))
+	s.Require().NoError(err)
+	var res sdk.TxResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+	s.Require().EqualValues(0, res.Code, out.String())
+	out, err = clitestutil.ExecTestCLICmd(val.ClientCtx, cli.NewTxCmdLock(), append([]string{contractID, s.vendor.String(), s.balance.String()}, commonArgs...))
+	s.Require().NoError(err)
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+	s.Require().EqualValues(0, res.Code, out.String())
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid transaction": {
+			[]string{
+				contractID,
+				s.vendor.String(),
+				"1",
+			},
+			true,
+		},
+		"extra args": {
+			[]string{
+				contractID,
+				s.vendor.String(),
+				"1",
+				"extra",
+			},
+			false,
+		},
+		"not enough args": {
+			[]string{
+				contractID,
+				s.vendor.String(),
+			},
+			false,
+		},
+		"invalid amount": {
+			[]string{
+				contractID,
+				s.vendor.String(),
+				"0",
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewTxCmdUnlock()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res sdk.TxResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+			s.Require().EqualValues(0, res.Code, out.String())
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewTxCmdBatchSend() {
+	val := s.network.Validators[0]
+	commonArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, s.customer),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	testCases := map[string]struct {
+		args  []string
+		valid bool
+	}{
+		"valid transaction": {
+			[]string{
+				s.classes[0].Id,
+				s.customer.String(),
+				fmt.Sprintf("%s=1", s.vendor),
+				fmt.Sprintf("%s=1", val.Address),
+			},
+			true,
+		},
+		"not enough args": {
+			[]string{
+				s.classes[0].Id,
+				s.customer.String(),
+			},
+			false,
+		},
+		"missing amount": {
+			[]string{
+				s.classes[0].Id,
+				s.customer.String(),
+				s.vendor.String(),
+			},
+			false,
+		},
+		"amount out of range": {
+			[]string{
+				s.classes[0].Id,
+				s.customer.String(),
+				fmt.Sprintf("%s=10000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000", s.vendor),
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			cmd := cli.NewTxCmdBatchSend()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, commonArgs...))
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res sdk.TxResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+			s.Require().EqualValues(0, res.Code, out.String())
+		})
+	}
+}
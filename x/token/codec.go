@@ -25,6 +25,17 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgBurn{}, "lbm-sdk/MsgBurn")
 	legacy.RegisterAminoMsg(cdc, &MsgOperatorBurn{}, "lbm-sdk/MsgOperatorBurn")
 	legacy.RegisterAminoMsg(cdc, &MsgModify{}, "lbm-sdk/token/MsgModify") // Changed msgName due to conflict with `x/collection`
+	legacy.RegisterAminoMsg(cdc, &MsgPause{}, "lbm-sdk/token/MsgPause")
+	legacy.RegisterAminoMsg(cdc, &MsgUnpause{}, "lbm-sdk/token/MsgUnpause")
+	legacy.RegisterAminoMsg(cdc, &MsgFreeze{}, "lbm-sdk/token/MsgFreeze")
+	legacy.RegisterAminoMsg(cdc, &MsgUnfreeze{}, "lbm-sdk/token/MsgUnfreeze")
+	legacy.RegisterAminoMsg(cdc, &MsgApprove{}, "lbm-sdk/token/MsgApprove")
+	legacy.RegisterAminoMsg(cdc, &MsgIncreaseAllowance{}, "lbm-sdk/token/MsgIncreaseAllowance")
+	legacy.RegisterAminoMsg(cdc, &MsgDecreaseAllowance{}, "lbm-sdk/token/MsgDecreaseAllowance")
+	legacy.RegisterAminoMsg(cdc, &MsgRegisterDenom{}, "lbm-sdk/token/MsgRegisterDenom")
+	legacy.RegisterAminoMsg(cdc, &MsgLock{}, "lbm-sdk/token/MsgLock")
+	legacy.RegisterAminoMsg(cdc, &MsgUnlock{}, "lbm-sdk/token/MsgUnlock")
+	legacy.RegisterAminoMsg(cdc, &MsgBatchSend{}, "lbm-sdk/token/MsgBatchSend")
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -40,6 +51,17 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgOperatorBurn{},
 		&MsgGrantPermission{},
 		&MsgRevokePermission{},
+		&MsgPause{},
+		&MsgUnpause{},
+		&MsgFreeze{},
+		&MsgUnfreeze{},
+		&MsgApprove{},
+		&MsgIncreaseAllowance{},
+		&MsgDecreaseAllowance{},
+		&MsgRegisterDenom{},
+		&MsgLock{},
+		&MsgUnlock{},
+		&MsgBatchSend{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
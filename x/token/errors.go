@@ -26,8 +26,15 @@ var (
 	ErrInvalidChangesField      = sdkerrors.Register(tokenCodespace, 18, "invalid field of changes")
 	ErrDuplicateChangesField    = sdkerrors.Register(tokenCodespace, 19, "invalid field of changes")
 	ErrInvalidMetaLength        = sdkerrors.Register(tokenCodespace, 20, "invalid meta length")
-	ErrSupplyOverflow           = sdkerrors.Register(tokenCodespace, 21, "supply for token reached maximum") // Currently never used
+	ErrSupplyOverflow           = sdkerrors.Register(tokenCodespace, 21, "supply for token reached maximum")
 	ErrApproverProxySame        = sdkerrors.Register(tokenCodespace, 22, "approver is same with proxy")
 	ErrTokenNotApproved         = sdkerrors.Register(tokenCodespace, 23, "proxy is not approved on the token")
 	ErrTokenAlreadyApproved     = sdkerrors.Register(tokenCodespace, 24, "proxy is already approved on the token")
+	ErrTokenPaused              = sdkerrors.Register(tokenCodespace, 25, "token is paused")
+	ErrTokenNotPaused           = sdkerrors.Register(tokenCodespace, 26, "token is not paused")
+	ErrHolderFrozen             = sdkerrors.Register(tokenCodespace, 27, "holder is frozen")
+	ErrHolderNotFrozen          = sdkerrors.Register(tokenCodespace, 28, "holder is not frozen")
+	ErrInsufficientAllowance    = sdkerrors.Register(tokenCodespace, 29, "insufficient allowance")
+	ErrDenomAlreadyRegistered   = sdkerrors.Register(tokenCodespace, 30, "denom is already registered")
+	ErrDenomNotRegistered       = sdkerrors.Register(tokenCodespace, 31, "denom is not registered")
 )
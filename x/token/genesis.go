@@ -33,6 +33,7 @@ func ValidateGenesis(data GenesisState) error {
 		}
 	}
 
+	maxSupplies := map[string]sdk.Int{}
 	for _, c := range data.Classes {
 		if err := ValidateContractID(c.Id); err != nil {
 			return err
@@ -52,6 +53,18 @@ func ValidateGenesis(data GenesisState) error {
 		if err := validateDecimals(c.Decimals); err != nil {
 			return err
 		}
+		if err := validateMaxSupply(c.MaxSupply); err != nil {
+			return err
+		}
+		if HasMaxSupply(c.MaxSupply) {
+			maxSupplies[c.Id] = c.MaxSupply
+		}
+	}
+
+	for _, supply := range data.Supplies {
+		if maxSupply, ok := maxSupplies[supply.ContractId]; ok && supply.Amount.GT(maxSupply) {
+			return ErrSupplyOverflow.Wrapf("supply %s of %s exceeds max supply %s", supply.Amount, supply.ContractId, maxSupply)
+		}
 	}
 
 	for _, contractGrants := range data.Grants {
@@ -90,6 +103,51 @@ func ValidateGenesis(data GenesisState) error {
 		}
 	}
 
+	for _, contractFrozenHolders := range data.FrozenHolders {
+		if err := ValidateContractID(contractFrozenHolders.ContractId); err != nil {
+			return err
+		}
+
+		if len(contractFrozenHolders.Holders) == 0 {
+			return sdkerrors.ErrInvalidRequest.Wrap("frozen holders cannot be empty")
+		}
+		for _, holder := range contractFrozenHolders.Holders {
+			if _, err := sdk.AccAddressFromBech32(holder.Address); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, contractAllowances := range data.Allowances {
+		if err := ValidateContractID(contractAllowances.ContractId); err != nil {
+			return err
+		}
+
+		if len(contractAllowances.Allowances) == 0 {
+			return sdkerrors.ErrInvalidRequest.Wrap("allowances cannot be empty")
+		}
+		for _, allowance := range contractAllowances.Allowances {
+			if _, err := sdk.AccAddressFromBech32(allowance.Holder); err != nil {
+				return err
+			}
+			if _, err := sdk.AccAddressFromBech32(allowance.Spender); err != nil {
+				return err
+			}
+			if err := validateAmount(allowance.Amount); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, denom := range data.Denoms {
+		if err := ValidateContractID(denom.ContractId); err != nil {
+			return err
+		}
+		if err := sdk.ValidateDenom(denom.Denom); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
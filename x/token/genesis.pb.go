@@ -46,6 +46,12 @@ type GenesisState struct {
 	Mints []ContractCoin `protobuf:"bytes,8,rep,name=mints,proto3" json:"mints"`
 	// burns represents the total burns of tokens.
 	Burns []ContractCoin `protobuf:"bytes,9,rep,name=burns,proto3" json:"burns"`
+	// frozen_holders defines the frozen holders of the contracts.
+	FrozenHolders []ContractFrozenHolders `protobuf:"bytes,10,rep,name=frozen_holders,json=frozenHolders,proto3" json:"frozen_holders"`
+	// allowances defines the allowances given to the spenders.
+	Allowances []ContractAllowances `protobuf:"bytes,11,rep,name=allowances,proto3" json:"allowances"`
+	// denoms defines the bank denoms registered for the contracts.
+	Denoms []ContractDenom `protobuf:"bytes,12,rep,name=denoms,proto3" json:"denoms"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -144,6 +150,27 @@ func (m *GenesisState) GetBurns() []ContractCoin {
 	return nil
 }
 
+func (m *GenesisState) GetFrozenHolders() []ContractFrozenHolders {
+	if m != nil {
+		return m.FrozenHolders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAllowances() []ContractAllowances {
+	if m != nil {
+		return m.Allowances
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDenoms() []ContractDenom {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
 // ClassGenesisState defines the classs keeper's genesis state.
 //
 // Deprecated: Do not use.
@@ -416,6 +443,177 @@ func (m *ContractGrants) GetGrants() []Grant {
 	return nil
 }
 
+// ContractFrozenHolders defines frozen holders belong to a contract.
+//
+// Deprecated: Do not use.
+type ContractFrozenHolders struct {
+	// contract id associated with the token class.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// frozen holders of the contract.
+	Holders []FrozenHolder `protobuf:"bytes,2,rep,name=holders,proto3" json:"holders"`
+}
+
+func (m *ContractFrozenHolders) Reset()         { *m = ContractFrozenHolders{} }
+func (m *ContractFrozenHolders) String() string { return proto.CompactTextString(m) }
+func (*ContractFrozenHolders) ProtoMessage()    {}
+func (*ContractFrozenHolders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4528f1ba25ef9938, []int{6}
+}
+func (m *ContractFrozenHolders) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractFrozenHolders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractFrozenHolders.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractFrozenHolders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractFrozenHolders.Merge(m, src)
+}
+func (m *ContractFrozenHolders) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractFrozenHolders) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractFrozenHolders.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractFrozenHolders proto.InternalMessageInfo
+
+func (m *ContractFrozenHolders) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
+}
+
+func (m *ContractFrozenHolders) GetHolders() []FrozenHolder {
+	if m != nil {
+		return m.Holders
+	}
+	return nil
+}
+
+// ContractAllowances defines allowances belong to a contract.
+//
+// Deprecated: Do not use.
+type ContractAllowances struct {
+	// contract id associated with the token class.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// allowances of the contract.
+	Allowances []Allowance `protobuf:"bytes,2,rep,name=allowances,proto3" json:"allowances"`
+}
+
+func (m *ContractAllowances) Reset()         { *m = ContractAllowances{} }
+func (m *ContractAllowances) String() string { return proto.CompactTextString(m) }
+func (*ContractAllowances) ProtoMessage()    {}
+func (*ContractAllowances) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4528f1ba25ef9938, []int{7}
+}
+func (m *ContractAllowances) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractAllowances) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractAllowances.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractAllowances) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractAllowances.Merge(m, src)
+}
+func (m *ContractAllowances) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractAllowances) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractAllowances.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractAllowances proto.InternalMessageInfo
+
+func (m *ContractAllowances) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
+}
+
+func (m *ContractAllowances) GetAllowances() []Allowance {
+	if m != nil {
+		return m.Allowances
+	}
+	return nil
+}
+
+// ContractDenom defines the bank denom registered for a contract.
+//
+// Deprecated: Do not use.
+type ContractDenom struct {
+	// contract id associated with the token class.
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// the registered bank denom.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *ContractDenom) Reset()         { *m = ContractDenom{} }
+func (m *ContractDenom) String() string { return proto.CompactTextString(m) }
+func (*ContractDenom) ProtoMessage()    {}
+func (*ContractDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4528f1ba25ef9938, []int{8}
+}
+func (m *ContractDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractDenom.Merge(m, src)
+}
+func (m *ContractDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractDenom proto.InternalMessageInfo
+
+func (m *ContractDenom) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
+}
+
+func (m *ContractDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 // Deprecated: Do not use.
 type ContractCoin struct {
 	// contract id associated with the token class.
@@ -428,7 +626,7 @@ func (m *ContractCoin) Reset()         { *m = ContractCoin{} }
 func (m *ContractCoin) String() string { return proto.CompactTextString(m) }
 func (*ContractCoin) ProtoMessage()    {}
 func (*ContractCoin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4528f1ba25ef9938, []int{6}
+	return fileDescriptor_4528f1ba25ef9938, []int{9}
 }
 func (m *ContractCoin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -471,50 +669,62 @@ func init() {
 	proto.RegisterType((*Balance)(nil), "lbm.token.v1.Balance")
 	proto.RegisterType((*ContractAuthorizations)(nil), "lbm.token.v1.ContractAuthorizations")
 	proto.RegisterType((*ContractGrants)(nil), "lbm.token.v1.ContractGrants")
+	proto.RegisterType((*ContractFrozenHolders)(nil), "lbm.token.v1.ContractFrozenHolders")
+	proto.RegisterType((*ContractAllowances)(nil), "lbm.token.v1.ContractAllowances")
+	proto.RegisterType((*ContractDenom)(nil), "lbm.token.v1.ContractDenom")
 	proto.RegisterType((*ContractCoin)(nil), "lbm.token.v1.ContractCoin")
 }
 
 func init() { proto.RegisterFile("lbm/token/v1/genesis.proto", fileDescriptor_4528f1ba25ef9938) }
 
 var fileDescriptor_4528f1ba25ef9938 = []byte{
-	// 592 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x3f, 0x6f, 0xd3, 0x4e,
-	0x1c, 0xc6, 0xe3, 0xa4, 0x75, 0x9a, 0x6f, 0xaa, 0xaa, 0xbf, 0xfb, 0x95, 0xea, 0x14, 0x90, 0x53,
-	0x55, 0x0c, 0x11, 0x08, 0x5b, 0x49, 0xa5, 0x22, 0x45, 0x0c, 0xc5, 0x95, 0xa8, 0xc2, 0x84, 0x8c,
-	0x58, 0x58, 0xaa, 0xf3, 0x1f, 0x92, 0x53, 0xe3, 0x3b, 0xcb, 0x77, 0x29, 0x7f, 0x16, 0x56, 0x46,
-	0x5e, 0x02, 0x2f, 0x85, 0xb1, 0x63, 0x47, 0xc4, 0x50, 0xa1, 0x64, 0xe1, 0x65, 0x20, 0xdf, 0xd9,
-	0x55, 0x9c, 0x06, 0x25, 0x03, 0xdb, 0xc5, 0xf7, 0x3c, 0x9f, 0xc7, 0xcf, 0xe5, 0x7b, 0x86, 0xd6,
-	0xd8, 0x8f, 0x1d, 0xc9, 0x2f, 0x22, 0xe6, 0x5c, 0x76, 0x9d, 0x61, 0xc4, 0x22, 0x41, 0x85, 0x9d,
-	0xa4, 0x5c, 0x72, 0xb4, 0x3d, 0xf6, 0x63, 0x5b, 0xed, 0xd9, 0x97, 0xdd, 0xd6, 0xde, 0x90, 0x0f,
-	0xb9, 0xda, 0x70, 0xb2, 0x95, 0xd6, 0xb4, 0x70, 0xc9, 0xaf, 0xc5, 0x6a, 0xe7, 0xf0, 0xfb, 0x06,
-	0x6c, 0x9f, 0x69, 0xde, 0x6b, 0x49, 0x64, 0x84, 0x7a, 0x60, 0x26, 0x24, 0x25, 0xb1, 0xc0, 0xc6,
-	0x81, 0xd1, 0x69, 0xf6, 0xf6, 0xec, 0x79, 0xbe, 0xfd, 0x4a, 0xed, 0xb9, 0x1b, 0x57, 0x37, 0xed,
-	0x8a, 0x97, 0x2b, 0xd1, 0x09, 0x34, 0x83, 0x31, 0x11, 0xe2, 0x5c, 0x64, 0x08, 0x5c, 0x55, 0xc6,
-	0x76, 0xd9, 0x78, 0x9a, 0x09, 0xe6, 0x93, 0x3c, 0x50, 0x1e, 0x9d, 0x7a, 0x02, 0x5b, 0x3e, 0x19,
-	0x13, 0x16, 0x44, 0x02, 0xd7, 0x0e, 0x6a, 0x9d, 0x66, 0xcf, 0x5a, 0xb0, 0x73, 0x26, 0x53, 0x12,
-	0x48, 0x37, 0x57, 0xe5, 0x6f, 0x70, 0xeb, 0x42, 0xc7, 0x50, 0x57, 0xbc, 0x48, 0xe0, 0x0d, 0x05,
-	0xd8, 0xff, 0x0b, 0x40, 0x1b, 0x0b, 0x31, 0xea, 0x83, 0x39, 0x4c, 0x09, 0x93, 0x02, 0x6f, 0x2a,
-	0xdb, 0x83, 0xe5, 0xb6, 0x33, 0xa5, 0x29, 0x7a, 0x6b, 0x07, 0xf2, 0x60, 0x87, 0x4c, 0xe4, 0x88,
-	0xa7, 0xf4, 0x13, 0x91, 0x94, 0x33, 0x81, 0x4d, 0xc5, 0x78, 0xb8, 0x9c, 0xf1, 0xbc, 0xa4, 0xcd,
-	0x59, 0x0b, 0x04, 0xf4, 0x0c, 0xb6, 0xc4, 0x24, 0x49, 0xc6, 0x34, 0x12, 0xb8, 0xae, 0x68, 0xad,
-	0xe5, 0xb4, 0x53, 0x4e, 0x59, 0x71, 0x0a, 0x85, 0x03, 0x1d, 0xc3, 0x66, 0x4c, 0xb3, 0x32, 0x5b,
-	0x6b, 0x5a, 0xb5, 0x3c, 0xf3, 0xf9, 0x93, 0x94, 0x09, 0xdc, 0x58, 0xd7, 0xa7, 0xe4, 0xfd, 0x2a,
-	0x36, 0x0e, 0x25, 0xfc, 0x77, 0xe7, 0xcf, 0x45, 0x03, 0xd8, 0x64, 0x9c, 0x05, 0x91, 0x9a, 0xa2,
-	0x86, 0x7b, 0x94, 0x99, 0x7e, 0xde, 0xb4, 0x1f, 0x0f, 0xa9, 0x1c, 0x4d, 0x7c, 0x3b, 0xe0, 0xb1,
-	0xf3, 0x82, 0x32, 0x11, 0x8c, 0x28, 0x71, 0xde, 0xe5, 0x8b, 0x27, 0x22, 0xbc, 0x70, 0xe4, 0xc7,
-	0x24, 0x12, 0xf6, 0x1b, 0xca, 0xa4, 0xa7, 0x09, 0x68, 0x17, 0x6a, 0x34, 0x14, 0xb8, 0x7a, 0x50,
-	0xeb, 0x34, 0xbc, 0x6c, 0xa9, 0x52, 0x13, 0xd8, 0x5d, 0x9c, 0x09, 0xd4, 0x86, 0x66, 0x90, 0x3f,
-	0x3b, 0xa7, 0xa1, 0x8e, 0xf6, 0xa0, 0x78, 0x34, 0x08, 0xd1, 0xd3, 0xb9, 0x31, 0xab, 0xaa, 0xa6,
-	0xf7, 0xca, 0x4d, 0x73, 0xd4, 0xe2, 0x74, 0xa9, 0xc4, 0xf7, 0x50, 0xcf, 0xb7, 0x11, 0x86, 0x3a,
-	0x09, 0xc3, 0x34, 0x12, 0x22, 0x0f, 0x29, 0x7e, 0xa2, 0x97, 0x60, 0x92, 0x98, 0x4f, 0x98, 0x54,
-	0xb7, 0xa0, 0xe1, 0xf6, 0xf2, 0xe2, 0x8f, 0xd6, 0x2c, 0x3e, 0x60, 0xd2, 0xcb, 0x09, 0x7d, 0xf3,
-	0xf7, 0xb7, 0xb6, 0x81, 0x8d, 0xc3, 0x2f, 0x06, 0xec, 0x2f, 0x9f, 0xa1, 0xd5, 0x8d, 0x07, 0x77,
-	0x46, 0x54, 0xf7, 0xbe, 0x5f, 0xee, 0x5d, 0xc2, 0x2e, 0x9f, 0x4c, 0x75, 0x06, 0x23, 0xd8, 0x29,
-	0xdf, 0x88, 0xd5, 0x6f, 0xd0, 0xbd, 0xbd, 0x60, 0x3a, 0xf9, 0xff, 0x72, 0xb2, 0xc2, 0x94, 0xef,
-	0x95, 0x4a, 0xfa, 0x0c, 0xdb, 0xf3, 0x63, 0xb7, 0x3a, 0xe7, 0x5f, 0x9e, 0x7c, 0x15, 0x1b, 0xae,
-	0x7b, 0x35, 0xb5, 0x8c, 0xeb, 0xa9, 0x65, 0xfc, 0x9a, 0x5a, 0xc6, 0xd7, 0x99, 0x55, 0xb9, 0x9e,
-	0x59, 0x95, 0x1f, 0x33, 0xab, 0xf2, 0xb6, 0xb3, 0x92, 0xf8, 0x41, 0x7f, 0x63, 0x7d, 0x53, 0x7d,
-	0x64, 0x8f, 0xfe, 0x04, 0x00, 0x00, 0xff, 0xff, 0x2a, 0xaf, 0x98, 0x1b, 0xc0, 0x05, 0x00, 0x00,
+	// 723 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x31, 0x4f, 0xdb, 0x4c,
+	0x1c, 0xc6, 0xe3, 0x84, 0x38, 0xe4, 0x9f, 0x80, 0x78, 0xef, 0x05, 0xde, 0x53, 0xde, 0x2a, 0x89,
+	0x68, 0x87, 0xa8, 0x55, 0x13, 0x11, 0x24, 0xaa, 0x46, 0xad, 0x44, 0x43, 0x05, 0x0d, 0x13, 0x72,
+	0xd5, 0xa5, 0x0b, 0x72, 0x62, 0x93, 0x58, 0x38, 0x77, 0x91, 0xef, 0x02, 0x94, 0xa5, 0x6b, 0xc7,
+	0x2e, 0xdd, 0xfb, 0x71, 0x18, 0x19, 0xab, 0x0e, 0xa8, 0x82, 0xa5, 0x1f, 0xa3, 0xf2, 0xff, 0xce,
+	0x28, 0x4e, 0x8c, 0xc2, 0xd0, 0xcd, 0xf6, 0x3d, 0xcf, 0xef, 0xf1, 0x9d, 0xef, 0x39, 0x43, 0xc9,
+	0xef, 0x0e, 0x1b, 0x92, 0x9f, 0xb8, 0xac, 0x71, 0xba, 0xd9, 0xe8, 0xbb, 0xcc, 0x15, 0x9e, 0xa8,
+	0x8f, 0x02, 0x2e, 0x39, 0x29, 0xfa, 0xdd, 0x61, 0x1d, 0xc7, 0xea, 0xa7, 0x9b, 0xa5, 0xd5, 0x3e,
+	0xef, 0x73, 0x1c, 0x68, 0x84, 0x57, 0x4a, 0x53, 0xa2, 0x31, 0xbf, 0x12, 0xe3, 0xc8, 0xc6, 0x37,
+	0x13, 0x8a, 0xfb, 0x8a, 0xf7, 0x5e, 0xda, 0xd2, 0x25, 0x4d, 0x30, 0x47, 0x76, 0x60, 0x0f, 0x05,
+	0x35, 0xaa, 0x46, 0xad, 0xd0, 0x5c, 0xad, 0x4f, 0xf2, 0xeb, 0x87, 0x38, 0xd6, 0x5e, 0xb8, 0xbc,
+	0xae, 0xa4, 0x2c, 0xad, 0x24, 0x3b, 0x50, 0xe8, 0xf9, 0xb6, 0x10, 0x47, 0x22, 0x44, 0xd0, 0x34,
+	0x1a, 0x2b, 0x71, 0xe3, 0x6e, 0x28, 0x98, 0x4c, 0xb2, 0x00, 0x3d, 0x2a, 0x75, 0x07, 0x16, 0xbb,
+	0xb6, 0x6f, 0xb3, 0x9e, 0x2b, 0x68, 0xa6, 0x9a, 0xa9, 0x15, 0x9a, 0xe5, 0x29, 0x3b, 0x67, 0x32,
+	0xb0, 0x7b, 0xb2, 0xad, 0x55, 0xfa, 0x0d, 0xee, 0x5c, 0x64, 0x1b, 0x72, 0xc8, 0x73, 0x05, 0x5d,
+	0x40, 0xc0, 0xfa, 0x3d, 0x00, 0x65, 0x8c, 0xc4, 0xa4, 0x05, 0x66, 0x3f, 0xb0, 0x99, 0x14, 0x34,
+	0x8b, 0xb6, 0x47, 0xc9, 0xb6, 0x7d, 0xd4, 0x44, 0xf3, 0x56, 0x0e, 0x62, 0xc1, 0xb2, 0x3d, 0x96,
+	0x03, 0x1e, 0x78, 0x17, 0xb6, 0xf4, 0x38, 0x13, 0xd4, 0x44, 0xc6, 0x93, 0x64, 0xc6, 0x9b, 0x98,
+	0x56, 0xb3, 0xa6, 0x08, 0xe4, 0x15, 0x2c, 0x8a, 0xf1, 0x68, 0xe4, 0x7b, 0xae, 0xa0, 0x39, 0xa4,
+	0x95, 0x92, 0x69, 0xbb, 0xdc, 0x63, 0xd1, 0x2a, 0x44, 0x0e, 0xb2, 0x0d, 0xd9, 0xa1, 0x17, 0x4e,
+	0x66, 0xf1, 0x81, 0x56, 0x25, 0x0f, 0x7d, 0xdd, 0x71, 0xc0, 0x04, 0xcd, 0x3f, 0xd4, 0x87, 0x72,
+	0x72, 0x08, 0xcb, 0xc7, 0x01, 0xbf, 0x70, 0xd9, 0xd1, 0x80, 0xfb, 0x8e, 0x1b, 0x08, 0x0a, 0x08,
+	0x78, 0x9c, 0x0c, 0xd8, 0x43, 0xed, 0x3b, 0x25, 0xd5, 0xa4, 0xa5, 0xe3, 0xc9, 0x87, 0x64, 0x0f,
+	0xc0, 0xf6, 0x7d, 0x7e, 0xa6, 0xf6, 0x42, 0x01, 0x69, 0xd5, 0x7b, 0xd6, 0xf3, 0x4e, 0xa7, 0x51,
+	0x13, 0x4e, 0xf2, 0x12, 0x4c, 0xc7, 0x65, 0x7c, 0x28, 0x68, 0x11, 0x19, 0xff, 0x27, 0x33, 0xde,
+	0x86, 0x9a, 0xe8, 0xb3, 0x2a, 0x43, 0x2b, 0x4d, 0x8d, 0x0d, 0x09, 0xff, 0xcc, 0xec, 0x58, 0xd2,
+	0x81, 0x2c, 0xe3, 0xac, 0xe7, 0x62, 0x35, 0xf2, 0xed, 0xad, 0xd0, 0xf5, 0xf3, 0xba, 0xf2, 0xac,
+	0xef, 0xc9, 0xc1, 0xb8, 0x5b, 0xef, 0xf1, 0x61, 0x63, 0xcf, 0x63, 0xa2, 0x37, 0xf0, 0xec, 0xc6,
+	0xb1, 0xbe, 0x78, 0x2e, 0x9c, 0x93, 0x86, 0xfc, 0x34, 0x72, 0x45, 0xfd, 0x83, 0xc7, 0xa4, 0xa5,
+	0x08, 0x64, 0x05, 0x32, 0x9e, 0x23, 0x68, 0xba, 0x9a, 0xa9, 0xe5, 0xad, 0xf0, 0x12, 0x53, 0x47,
+	0xb0, 0x32, 0xbd, 0xd1, 0x49, 0x05, 0x0a, 0x3d, 0xfd, 0xec, 0xc8, 0x73, 0x54, 0xb4, 0x05, 0xd1,
+	0xa3, 0x8e, 0x43, 0x5e, 0x4c, 0x74, 0x27, 0x8d, 0x73, 0x5d, 0x8b, 0xcf, 0x55, 0xa3, 0xa6, 0x2b,
+	0x83, 0x89, 0x67, 0x90, 0xd3, 0xc3, 0x84, 0x42, 0xce, 0x76, 0x9c, 0xc0, 0x15, 0x42, 0x87, 0x44,
+	0xb7, 0xe4, 0x00, 0x4c, 0x7b, 0xc8, 0xc7, 0x4c, 0x62, 0xb5, 0xf3, 0xed, 0xa6, 0x9e, 0xf8, 0xd3,
+	0x07, 0x4e, 0xbc, 0xc3, 0xa4, 0xa5, 0x09, 0x2d, 0xf3, 0xf7, 0xf7, 0x8a, 0x41, 0x8d, 0x8d, 0x2f,
+	0x06, 0xac, 0x27, 0x17, 0x63, 0xfe, 0x8c, 0x3b, 0x33, 0xbd, 0x4b, 0x27, 0x7d, 0xe3, 0x18, 0x36,
+	0xb9, 0x6e, 0xb8, 0x06, 0x03, 0x58, 0x8e, 0xd7, 0x7c, 0xfe, 0x1b, 0x6c, 0xde, 0x9d, 0x1a, 0x2a,
+	0xf9, 0xdf, 0x78, 0x32, 0x62, 0xe2, 0x87, 0x05, 0x26, 0x9d, 0xc3, 0x5a, 0x62, 0x15, 0xe6, 0x07,
+	0xb6, 0x20, 0x17, 0x35, 0x2c, 0x9d, 0x54, 0xd1, 0x49, 0x5c, 0x74, 0xc4, 0x69, 0x83, 0x4e, 0x26,
+	0xb3, 0xb5, 0x99, 0x1f, 0xfb, 0x3a, 0xd6, 0x46, 0x95, 0xfc, 0xdf, 0xd4, 0x2a, 0x47, 0xe3, 0xb3,
+	0x25, 0xc4, 0xe4, 0x03, 0x58, 0x8a, 0x95, 0x6d, 0x7e, 0xe8, 0x2a, 0x64, 0xb1, 0x89, 0x6a, 0xb7,
+	0x59, 0xea, 0x06, 0x59, 0x9f, 0xa1, 0x38, 0x79, 0x16, 0xcd, 0x47, 0xfd, 0xcd, 0x9d, 0x9b, 0xa6,
+	0x46, 0xbb, 0x7d, 0x79, 0x53, 0x36, 0xae, 0x6e, 0xca, 0xc6, 0xaf, 0x9b, 0xb2, 0xf1, 0xf5, 0xb6,
+	0x9c, 0xba, 0xba, 0x2d, 0xa7, 0x7e, 0xdc, 0x96, 0x53, 0x1f, 0x6b, 0x73, 0x89, 0xe7, 0xea, 0xc7,
+	0xdb, 0x35, 0xf1, 0xcf, 0xbb, 0xf5, 0x27, 0x00, 0x00, 0xff, 0xff, 0x8c, 0xf2, 0x5d, 0x98, 0xd5,
+	0x07, 0x00, 0x00,
 }
 
 func (this *Balance) Equal(that interface{}) bool {
@@ -564,6 +774,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Denoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Allowances) > 0 {
+		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.FrozenHolders) > 0 {
+		for iNdEx := len(m.FrozenHolders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FrozenHolders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.Burns) > 0 {
 		for iNdEx := len(m.Burns) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -901,7 +1153,7 @@ func (m *ContractGrants) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ContractCoin) Marshal() (dAtA []byte, err error) {
+func (m *ContractFrozenHolders) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -911,26 +1163,30 @@ func (m *ContractCoin) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContractCoin) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContractFrozenHolders) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContractCoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContractFrozenHolders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.Holders) > 0 {
+		for iNdEx := len(m.Holders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Holders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
 	if len(m.ContractId) > 0 {
 		i -= len(m.ContractId)
 		copy(dAtA[i:], m.ContractId)
@@ -941,58 +1197,179 @@ func (m *ContractCoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ContractAllowances) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ContractAllowances) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractAllowances) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if m.ClassState != nil {
-		l = m.ClassState.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if len(m.Balances) > 0 {
-		for _, e := range m.Balances {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.Classes) > 0 {
-		for _, e := range m.Classes {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.Grants) > 0 {
-		for _, e := range m.Grants {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Allowances) > 0 {
+		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Authorizations) > 0 {
-		for _, e := range m.Authorizations {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if len(m.Supplies) > 0 {
-		for _, e := range m.Supplies {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractCoin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCoin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ContractId) > 0 {
+		i -= len(m.ContractId)
+		copy(dAtA[i:], m.ContractId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.ClassState != nil {
+		l = m.ClassState.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Classes) > 0 {
+		for _, e := range m.Classes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Authorizations) > 0 {
+		for _, e := range m.Authorizations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Supplies) > 0 {
+		for _, e := range m.Supplies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
 	}
 	if len(m.Mints) > 0 {
 		for _, e := range m.Mints {
@@ -1006,6 +1383,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.FrozenHolders) > 0 {
+		for _, e := range m.FrozenHolders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Allowances) > 0 {
+		for _, e := range m.Allowances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Denoms) > 0 {
+		for _, e := range m.Denoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1098,6 +1493,61 @@ func (m *ContractGrants) Size() (n int) {
 	return n
 }
 
+func (m *ContractFrozenHolders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Holders) > 0 {
+		for _, e := range m.Holders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractAllowances) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Allowances) > 0 {
+		for _, e := range m.Allowances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
 func (m *ContractCoin) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1287,9 +1737,365 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grants = append(m.Grants, ContractGrants{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorizations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authorizations = append(m.Authorizations, ContractAuthorizations{})
+			if err := m.Authorizations[len(m.Authorizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supplies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Supplies = append(m.Supplies, ContractCoin{})
+			if err := m.Supplies[len(m.Supplies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mints = append(m.Mints, ContractCoin{})
+			if err := m.Mints[len(m.Mints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burns = append(m.Burns, ContractCoin{})
+			if err := m.Burns[len(m.Burns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenHolders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenHolders = append(m.FrozenHolders, ContractFrozenHolders{})
+			if err := m.FrozenHolders[len(m.FrozenHolders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowances = append(m.Allowances, ContractAllowances{})
+			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, ContractDenom{})
+			if err := m.Denoms[len(m.Denoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClassGenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClassGenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClassGenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Nonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1299,31 +2105,79 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grants = append(m.Grants, ContractGrants{})
-			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 6:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractBalances) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractBalances: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractBalances: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authorizations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1333,29 +2187,27 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authorizations = append(m.Authorizations, ContractAuthorizations{})
-			if err := m.Authorizations[len(m.Authorizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Supplies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1382,16 +2234,66 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Supplies = append(m.Supplies, ContractCoin{})
-			if err := m.Supplies[len(m.Supplies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Balances = append(m.Balances, Balance{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Balance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Balance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Balance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mints", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1401,31 +2303,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Mints = append(m.Mints, ContractCoin{})
-			if err := m.Mints[len(m.Mints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Burns", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1435,23 +2335,23 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Burns = append(m.Burns, ContractCoin{})
-			if err := m.Burns[len(m.Burns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1476,7 +2376,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClassGenesisState) Unmarshal(dAtA []byte) error {
+func (m *ContractAuthorizations) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1499,15 +2399,15 @@ func (m *ClassGenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClassGenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractAuthorizations: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClassGenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractAuthorizations: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1535,15 +2435,13 @@ func (m *ClassGenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Nonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ContractId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorizations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1553,23 +2451,25 @@ func (m *ClassGenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
+			m.Authorizations = append(m.Authorizations, Authorization{})
+			if err := m.Authorizations[len(m.Authorizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1592,7 +2492,7 @@ func (m *ClassGenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractBalances) Unmarshal(dAtA []byte) error {
+func (m *ContractGrants) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1615,10 +2515,10 @@ func (m *ContractBalances) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractBalances: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractGrants: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractBalances: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractGrants: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1655,7 +2555,7 @@ func (m *ContractBalances) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1682,8 +2582,8 @@ func (m *ContractBalances) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balances = append(m.Balances, Balance{})
-			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Grants = append(m.Grants, Grant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1708,7 +2608,7 @@ func (m *ContractBalances) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Balance) Unmarshal(dAtA []byte) error {
+func (m *ContractFrozenHolders) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1731,15 +2631,15 @@ func (m *Balance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Balance: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractFrozenHolders: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Balance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractFrozenHolders: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1767,13 +2667,13 @@ func (m *Balance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.ContractId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holders", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1783,23 +2683,23 @@ func (m *Balance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Holders = append(m.Holders, FrozenHolder{})
+			if err := m.Holders[len(m.Holders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1824,7 +2724,7 @@ func (m *Balance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractAuthorizations) Unmarshal(dAtA []byte) error {
+func (m *ContractAllowances) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1847,10 +2747,10 @@ func (m *ContractAuthorizations) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractAuthorizations: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractAllowances: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractAuthorizations: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractAllowances: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1887,7 +2787,7 @@ func (m *ContractAuthorizations) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authorizations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1914,8 +2814,8 @@ func (m *ContractAuthorizations) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authorizations = append(m.Authorizations, Authorization{})
-			if err := m.Authorizations[len(m.Authorizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Allowances = append(m.Allowances, Allowance{})
+			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1940,7 +2840,7 @@ func (m *ContractAuthorizations) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractGrants) Unmarshal(dAtA []byte) error {
+func (m *ContractDenom) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1963,10 +2863,10 @@ func (m *ContractGrants) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractGrants: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractDenom: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractGrants: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractDenom: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2003,9 +2903,9 @@ func (m *ContractGrants) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -2015,25 +2915,23 @@ func (m *ContractGrants) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grants = append(m.Grants, Grant{})
-			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
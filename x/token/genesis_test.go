@@ -147,6 +147,134 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			false,
 		},
+		"invalid max supply of class": {
+			&token.GenesisState{
+				Classes: []token.Contract{{
+					Id:        "deadbeef",
+					Name:      "test",
+					Symbol:    "TT",
+					MaxSupply: sdk.NewInt(-1),
+				}},
+			},
+			false,
+		},
+		"supply exceeds max supply": {
+			&token.GenesisState{
+				Classes: []token.Contract{{
+					Id:        "deadbeef",
+					Name:      "test",
+					Symbol:    "TT",
+					MaxSupply: sdk.OneInt(),
+				}},
+				Supplies: []token.ContractCoin{{
+					ContractId: "deadbeef",
+					Amount:     sdk.NewInt(2),
+				}},
+			},
+			false,
+		},
+		"frozen holders of invalid contract id": {
+			&token.GenesisState{
+				FrozenHolders: []token.ContractFrozenHolders{{
+					Holders: []token.FrozenHolder{{
+						Address: addr.String(),
+					}},
+				}},
+			},
+			false,
+		},
+		"empty frozen holders": {
+			&token.GenesisState{
+				FrozenHolders: []token.ContractFrozenHolders{{
+					ContractId: "deadbeef",
+				}},
+			},
+			false,
+		},
+		"invalid address of frozen holder": {
+			&token.GenesisState{
+				FrozenHolders: []token.ContractFrozenHolders{{
+					ContractId: "deadbeef",
+					Holders: []token.FrozenHolder{{
+						Incoming: true,
+					}},
+				}},
+			},
+			false,
+		},
+		"allowances of invalid contract id": {
+			&token.GenesisState{
+				Allowances: []token.ContractAllowances{{
+					Allowances: []token.Allowance{{
+						Holder:  addr.String(),
+						Spender: addr.String(),
+						Amount:  sdk.OneInt(),
+					}},
+				}},
+			},
+			false,
+		},
+		"empty allowances": {
+			&token.GenesisState{
+				Allowances: []token.ContractAllowances{{
+					ContractId: "deadbeef",
+				}},
+			},
+			false,
+		},
+		"invalid holder of allowance": {
+			&token.GenesisState{
+				Allowances: []token.ContractAllowances{{
+					ContractId: "deadbeef",
+					Allowances: []token.Allowance{{
+						Spender: addr.String(),
+						Amount:  sdk.OneInt(),
+					}},
+				}},
+			},
+			false,
+		},
+		"invalid spender of allowance": {
+			&token.GenesisState{
+				Allowances: []token.ContractAllowances{{
+					ContractId: "deadbeef",
+					Allowances: []token.Allowance{{
+						Holder: addr.String(),
+						Amount: sdk.OneInt(),
+					}},
+				}},
+			},
+			false,
+		},
+		"invalid amount of allowance": {
+			&token.GenesisState{
+				Allowances: []token.ContractAllowances{{
+					ContractId: "deadbeef",
+					Allowances: []token.Allowance{{
+						Holder:  addr.String(),
+						Spender: addr.String(),
+						Amount:  sdk.ZeroInt(),
+					}},
+				}},
+			},
+			false,
+		},
+		"denom of invalid contract id": {
+			&token.GenesisState{
+				Denoms: []token.ContractDenom{{
+					Denom: token.DenomFromContractID("deadbeef"),
+				}},
+			},
+			false,
+		},
+		"invalid denom": {
+			&token.GenesisState{
+				Denoms: []token.ContractDenom{{
+					ContractId: "deadbeef",
+				}},
+			},
+			false,
+		},
 		"grants of invalid contract id": {
 			&token.GenesisState{
 				Grants: []token.ContractGrants{{
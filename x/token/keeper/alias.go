@@ -139,3 +139,67 @@ func (k Keeper) iterateMinteds(ctx sdk.Context, fn func(contractID string, amoun
 func (k Keeper) iterateBurnts(ctx sdk.Context, fn func(contractID string, amount sdk.Int) (stop bool)) {
 	k.iterateStatistics(ctx, burnKeyPrefix, fn)
 }
+
+func (k Keeper) iterateContractFrozenHolders(ctx sdk.Context, contractID string, fn func(holder token.FrozenHolder) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, frozenHolderKeyPrefixByContractID(contractID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		_, holder := splitFrozenHolderKey(iterator.Key())
+		frozen := token.FrozenHolder{
+			Address:  holder.String(),
+			Incoming: decodeIncoming(iterator.Value()),
+		}
+
+		stop := fn(frozen)
+		if stop {
+			break
+		}
+	}
+}
+
+func (k Keeper) iterateContractAllowances(ctx sdk.Context, contractID string, fn func(allowance token.Allowance) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, allowanceKeyPrefixByContractID(contractID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		_, holder, spender := splitAllowanceKey(iterator.Key())
+
+		var amount sdk.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+
+		allowance := token.Allowance{
+			Holder:  holder.String(),
+			Spender: spender.String(),
+			Amount:  amount,
+		}
+
+		stop := fn(allowance)
+		if stop {
+			break
+		}
+	}
+}
+
+func (k Keeper) iterateDenoms(ctx sdk.Context, fn func(contractID, denom string) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, denomKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		contractID := splitDenomKey(iterator.Key())
+		denom := string(iterator.Value())
+
+		stop := fn(contractID, denom)
+		if stop {
+			break
+		}
+	}
+}
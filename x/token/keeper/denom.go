@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	authtypes "github.com/Finschia/finschia-sdk/x/auth/types"
+	"github.com/Finschia/finschia-sdk/x/token"
+)
+
+// escrowAddress returns the address holding the tokens locked for the bank denoms.
+func escrowAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(token.ModuleName)
+}
+
+// RegisterDenom registers the bank denom of a contract, with the metadata derived from the contract.
+func (k Keeper) RegisterDenom(ctx sdk.Context, contractID string, operator sdk.AccAddress) (string, error) {
+	if _, err := k.GetDenom(ctx, contractID); err == nil {
+		return "", token.ErrDenomAlreadyRegistered.Wrapf("contract %s", contractID)
+	}
+
+	class, err := k.GetClass(ctx, contractID)
+	if err != nil {
+		return "", err
+	}
+
+	metadata := token.DenomMetadata(*class)
+	if _, exists := k.bankKeeper.GetDenomMetaData(ctx, metadata.Base); exists {
+		return "", token.ErrDenomAlreadyRegistered.Wrapf("metadata of %s already exists", metadata.Base)
+	}
+	if err := metadata.Validate(); err != nil {
+		panic(err)
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+	k.setDenom(ctx, contractID, metadata.Base)
+
+	event := token.EventDenomRegistered{
+		ContractId: contractID,
+		Operator:   operator.String(),
+		Denom:      metadata.Base,
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		panic(err)
+	}
+	return metadata.Base, nil
+}
+
+// Lock locks the tokens of the holder and mints the same amount of the bank denom to the holder.
+func (k Keeper) Lock(ctx sdk.Context, contractID string, holder sdk.AccAddress, amount sdk.Int) error {
+	denom, err := k.GetDenom(ctx, contractID)
+	if err != nil {
+		return err
+	}
+
+	if err := k.Send(ctx, contractID, holder, escrowAddress(), amount); err != nil {
+		return err
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(denom, amount))
+	if err := k.bankKeeper.MintCoins(ctx, token.ModuleName, coins); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, token.ModuleName, holder, coins); err != nil {
+		return err
+	}
+
+	event := token.EventLocked{
+		ContractId: contractID,
+		Holder:     holder.String(),
+		Amount:     amount,
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		panic(err)
+	}
+	return nil
+}
+
+// Unlock burns the bank denom of the holder and unlocks the same amount of tokens to the holder.
+func (k Keeper) Unlock(ctx sdk.Context, contractID string, holder sdk.AccAddress, amount sdk.Int) error {
+	denom, err := k.GetDenom(ctx, contractID)
+	if err != nil {
+		return err
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(denom, amount))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, holder, token.ModuleName, coins); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, token.ModuleName, coins); err != nil {
+		return err
+	}
+
+	if err := k.Send(ctx, contractID, escrowAddress(), holder, amount); err != nil {
+		return err
+	}
+
+	event := token.EventUnlocked{
+		ContractId: contractID,
+		Holder:     holder.String(),
+		Amount:     amount,
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		panic(err)
+	}
+	return nil
+}
+
+// SendRestriction applies the pause of the contracts and the freeze of their holders to the transfers of
+// the bank denoms, as Send does to the transfers of the tokens.
+func (k Keeper) SendRestriction(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	for _, coin := range amt {
+		contractID, ok := token.ContractIDFromDenom(coin.Denom)
+		if !ok {
+			continue
+		}
+		if denom, err := k.GetDenom(ctx, contractID); err != nil || denom != coin.Denom {
+			continue
+		}
+
+		if err := k.assertNotPaused(ctx, contractID); err != nil {
+			return err
+		}
+		if _, err := k.GetFrozenHolder(ctx, contractID, fromAddr); err == nil {
+			return token.ErrHolderFrozen.Wrapf("%s is frozen", fromAddr)
+		}
+		if toAddr.Empty() {
+			continue
+		}
+		if frozen, err := k.GetFrozenHolder(ctx, contractID, toAddr); err == nil && frozen.Incoming {
+			return token.ErrHolderFrozen.Wrapf("incoming transfers to %s are frozen", toAddr)
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) GetDenom(ctx sdk.Context, contractID string) (string, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(denomKey(contractID))
+	if bz == nil {
+		return "", token.ErrDenomNotRegistered.Wrapf("contract %s", contractID)
+	}
+
+	return string(bz), nil
+}
+
+func (k Keeper) setDenom(ctx sdk.Context, contractID, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(denomKey(contractID), []byte(denom))
+}
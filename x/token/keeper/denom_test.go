@@ -0,0 +1,184 @@
+package keeper_test
+
+import (
+	sdk "github.com/Finschia/finschia-sdk/types"
+	sdkerrors "github.com/Finschia/finschia-sdk/types/errors"
+	authtypes "github.com/Finschia/finschia-sdk/x/auth/types"
+	"github.com/Finschia/finschia-sdk/x/token"
+	"github.com/Finschia/finschia-sdk/x/token/keeper"
+)
+
+func (s *KeeperTestSuite) TestRegisterDenom() {
+	ctx, _ := s.ctx.CacheContext()
+
+	_, err := s.keeper.GetDenom(ctx, s.contractID)
+	s.Require().ErrorIs(err, token.ErrDenomNotRegistered)
+
+	denom, err := s.keeper.RegisterDenom(ctx, s.contractID, s.vendor)
+	s.Require().NoError(err)
+	s.Require().Equal(token.DenomFromContractID(s.contractID), denom)
+
+	registered, err := s.keeper.GetDenom(ctx, s.contractID)
+	s.Require().NoError(err)
+	s.Require().Equal(denom, registered)
+
+	class, err := s.keeper.GetClass(ctx, s.contractID)
+	s.Require().NoError(err)
+	metadata, exists := s.bankKeeper.GetDenomMetaData(ctx, denom)
+	s.Require().True(exists)
+	s.Require().Equal(token.DenomMetadata(*class), metadata)
+
+	_, err = s.keeper.RegisterDenom(ctx, s.contractID, s.vendor)
+	s.Require().ErrorIs(err, token.ErrDenomAlreadyRegistered)
+}
+
+func (s *KeeperTestSuite) TestLock() {
+	testCases := map[string]struct {
+		registered bool
+		amount     sdk.Int
+		err        error
+	}{
+		"valid request": {
+			registered: true,
+			amount:     s.balance,
+		},
+		"denom not registered": {
+			amount: s.balance,
+			err:    token.ErrDenomNotRegistered,
+		},
+		"insufficient tokens": {
+			registered: true,
+			amount:     s.balance.Add(sdk.OneInt()),
+			err:        token.ErrInsufficientBalance,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			denom := token.DenomFromContractID(s.contractID)
+			if tc.registered {
+				_, err := s.keeper.RegisterDenom(ctx, s.contractID, s.vendor)
+				s.Require().NoError(err)
+			}
+
+			err := s.keeper.Lock(ctx, s.contractID, s.customer, tc.amount)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			s.Require().True(s.balance.Sub(tc.amount).Equal(s.keeper.GetBalance(ctx, s.contractID, s.customer)))
+			s.Require().Equal(sdk.NewCoin(denom, tc.amount), s.bankKeeper.GetBalance(ctx, s.customer, denom))
+			s.Require().Equal(sdk.NewCoin(denom, tc.amount), s.bankKeeper.GetSupply(ctx, denom))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestUnlock() {
+	testCases := map[string]struct {
+		amount sdk.Int
+		err    error
+	}{
+		"valid request": {
+			amount: s.balance,
+		},
+		"insufficient funds": {
+			amount: s.balance.Add(sdk.OneInt()),
+			err:    sdkerrors.ErrInsufficientFunds,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			denom, err := s.keeper.RegisterDenom(ctx, s.contractID, s.vendor)
+			s.Require().NoError(err)
+			err = s.keeper.Lock(ctx, s.contractID, s.customer, s.balance)
+			s.Require().NoError(err)
+
+			err = s.keeper.Unlock(ctx, s.contractID, s.customer, tc.amount)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			s.Require().Equal(tc.amount, s.keeper.GetBalance(ctx, s.contractID, s.customer))
+			s.Require().True(s.bankKeeper.GetBalance(ctx, s.customer, denom).IsZero())
+			s.Require().True(s.bankKeeper.GetSupply(ctx, denom).IsZero())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestLockedSupplyInvariant() {
+	ctx, _ := s.ctx.CacheContext()
+	_, err := s.keeper.RegisterDenom(ctx, s.contractID, s.vendor)
+	s.Require().NoError(err)
+	err = s.keeper.Lock(ctx, s.contractID, s.customer, s.balance)
+	s.Require().NoError(err)
+
+	invariant := keeper.LockedSupplyInvariant(s.keeper)
+	_, broken := invariant(ctx)
+	s.Require().False(broken)
+
+	_, broken = keeper.DenomsInvariant(s.keeper)(ctx)
+	s.Require().False(broken)
+
+	// take the locked tokens out of the escrow
+	escrow := authtypes.NewModuleAddress(token.ModuleName)
+	err = s.keeper.Send(ctx, s.contractID, escrow, s.stranger, sdk.OneInt())
+	s.Require().NoError(err)
+
+	_, broken = invariant(ctx)
+	s.Require().True(broken)
+}
+
+func (s *KeeperTestSuite) TestSendRestriction() {
+	testCases := map[string]struct {
+		malleate func(ctx sdk.Context)
+		err      error
+	}{
+		"valid request": {
+			malleate: func(ctx sdk.Context) {},
+		},
+		"contract paused": {
+			malleate: func(ctx sdk.Context) {
+				s.Require().NoError(s.keeper.Pause(ctx, s.contractID, s.vendor))
+			},
+			err: token.ErrTokenPaused,
+		},
+		"sender frozen": {
+			malleate: func(ctx sdk.Context) {
+				s.Require().NoError(s.keeper.Freeze(ctx, s.contractID, s.vendor, s.customer, false))
+			},
+			err: token.ErrHolderFrozen,
+		},
+		"incoming transfers to recipient frozen": {
+			malleate: func(ctx sdk.Context) {
+				s.Require().NoError(s.keeper.Freeze(ctx, s.contractID, s.vendor, s.stranger, true))
+			},
+			err: token.ErrHolderFrozen,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			denom, err := s.keeper.RegisterDenom(ctx, s.contractID, s.vendor)
+			s.Require().NoError(err)
+			s.Require().NoError(s.keeper.Lock(ctx, s.contractID, s.customer, s.balance))
+
+			tc.malleate(ctx)
+
+			coins := sdk.NewCoins(sdk.NewCoin(denom, s.balance))
+			err = s.bankKeeper.SendCoins(ctx, s.customer, s.stranger, coins)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				s.Require().Equal(coins, s.bankKeeper.GetAllBalances(ctx, s.customer))
+				return
+			}
+
+			s.Require().Equal(coins, s.bankKeeper.GetAllBalances(ctx, s.stranger))
+		})
+	}
+}
@@ -50,6 +50,34 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *token.GenesisState) {
 		}
 	}
 
+	for _, contractFrozenHolders := range data.FrozenHolders {
+		for _, frozen := range contractFrozenHolders.Holders {
+			holder, err := sdk.AccAddressFromBech32(frozen.Address)
+			if err != nil {
+				panic(err)
+			}
+			k.setFrozenHolder(ctx, contractFrozenHolders.ContractId, holder, frozen.Incoming)
+		}
+	}
+
+	for _, contractAllowances := range data.Allowances {
+		for _, allowance := range contractAllowances.Allowances {
+			holder, err := sdk.AccAddressFromBech32(allowance.Holder)
+			if err != nil {
+				panic(err)
+			}
+			spender, err := sdk.AccAddressFromBech32(allowance.Spender)
+			if err != nil {
+				panic(err)
+			}
+			k.setAllowance(ctx, contractAllowances.ContractId, holder, spender, allowance.Amount)
+		}
+	}
+
+	for _, denom := range data.Denoms {
+		k.setDenom(ctx, denom.ContractId, denom.Denom)
+	}
+
 	// TODO: remove it (derive it using mints and burns)
 	for _, amount := range data.Supplies {
 		k.setSupply(ctx, amount.ContractId, amount.Amount)
@@ -150,6 +178,47 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *token.GenesisState {
 		}
 	}
 
+	var frozenHolders []token.ContractFrozenHolders
+	for _, class := range classes {
+		id := class.Id
+		contractFrozenHolders := token.ContractFrozenHolders{
+			ContractId: id,
+		}
+
+		k.iterateContractFrozenHolders(ctx, id, func(holder token.FrozenHolder) (stop bool) {
+			contractFrozenHolders.Holders = append(contractFrozenHolders.Holders, holder)
+			return false
+		})
+		if len(contractFrozenHolders.Holders) != 0 {
+			frozenHolders = append(frozenHolders, contractFrozenHolders)
+		}
+	}
+
+	var allowances []token.ContractAllowances
+	for _, class := range classes {
+		id := class.Id
+		contractAllowances := token.ContractAllowances{
+			ContractId: id,
+		}
+
+		k.iterateContractAllowances(ctx, id, func(allowance token.Allowance) (stop bool) {
+			contractAllowances.Allowances = append(contractAllowances.Allowances, allowance)
+			return false
+		})
+		if len(contractAllowances.Allowances) != 0 {
+			allowances = append(allowances, contractAllowances)
+		}
+	}
+
+	var denoms []token.ContractDenom
+	k.iterateDenoms(ctx, func(contractID, denom string) (stop bool) {
+		denoms = append(denoms, token.ContractDenom{
+			ContractId: contractID,
+			Denom:      denom,
+		})
+		return false
+	})
+
 	return &token.GenesisState{
 		ClassState:     k.classKeeper.ExportGenesis(ctx),
 		Balances:       balances,
@@ -159,5 +228,8 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *token.GenesisState {
 		Supplies:       supplies,
 		Mints:          mints,
 		Burns:          burns,
+		FrozenHolders:  frozenHolders,
+		Allowances:     allowances,
+		Denoms:         denoms,
 	}
 }
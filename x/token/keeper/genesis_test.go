@@ -1,19 +1,32 @@
 package keeper_test
 
 import (
+	sdk "github.com/Finschia/finschia-sdk/types"
 	"github.com/Finschia/finschia-sdk/x/token"
 )
 
 func (s *KeeperTestSuite) TestImportExportGenesis() {
+	err := s.keeper.Freeze(s.ctx, s.contractID, s.vendor, s.stranger, true)
+	s.Require().NoError(err)
+	s.keeper.Approve(s.ctx, s.contractID, s.customer, s.stranger, s.balance)
+	_, err = s.keeper.RegisterDenom(s.ctx, s.contractID, s.vendor)
+	s.Require().NoError(err)
+
 	// export
 	genesis := s.keeper.ExportGenesis(s.ctx)
+	s.Require().Len(genesis.FrozenHolders, 1)
+	s.Require().Len(genesis.Allowances, 1)
+	s.Require().Len(genesis.Denoms, 1)
 
 	// forge
-	err := s.keeper.Burn(s.ctx, s.contractID, s.vendor, s.balance)
+	err = s.keeper.Burn(s.ctx, s.contractID, s.vendor, s.balance)
 	s.Require().NoError(err)
 	err = s.keeper.Mint(s.ctx, s.contractID, s.vendor, s.customer, s.balance)
 	s.Require().NoError(err)
 	s.keeper.Abandon(s.ctx, s.contractID, s.vendor, token.PermissionMint)
+	err = s.keeper.Unfreeze(s.ctx, s.contractID, s.vendor, s.stranger)
+	s.Require().NoError(err)
+	s.keeper.Approve(s.ctx, s.contractID, s.customer, s.stranger, sdk.ZeroInt())
 
 	// restore
 	s.keeper.InitGenesis(s.ctx, genesis)
@@ -207,3 +207,71 @@ func (s queryServer) HoldersByOperator(c context.Context, req *token.QueryHolder
 
 	return &token.QueryHoldersByOperatorResponse{Holders: holders, Pagination: pageRes}, nil
 }
+
+func (s queryServer) FrozenHolders(c context.Context, req *token.QueryFrozenHoldersRequest) (*token.QueryFrozenHoldersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := token.ValidateContractID(req.ContractId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(s.keeper.storeKey)
+	frozenStore := prefix.NewStore(store, frozenHolderKeyPrefixByContractID(req.ContractId))
+	var holders []token.FrozenHolder
+	pageRes, err := query.Paginate(frozenStore, req.Pagination, func(key, value []byte) error {
+		holders = append(holders, token.FrozenHolder{
+			Address:  sdk.AccAddress(key).String(),
+			Incoming: decodeIncoming(value),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &token.QueryFrozenHoldersResponse{Holders: holders, Pagination: pageRes}, nil
+}
+
+func (s queryServer) Allowance(c context.Context, req *token.QueryAllowanceRequest) (*token.QueryAllowanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := token.ValidateContractID(req.ContractId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	holder, err := s.addressFromBech32GRPC(req.Holder, "holder")
+	if err != nil {
+		return nil, err
+	}
+	spender, err := s.addressFromBech32GRPC(req.Spender, "spender")
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	allowance := s.keeper.GetAllowance(ctx, req.ContractId, holder, spender)
+
+	return &token.QueryAllowanceResponse{Amount: allowance}, nil
+}
+
+func (s queryServer) Denom(c context.Context, req *token.QueryDenomRequest) (*token.QueryDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := token.ValidateContractID(req.ContractId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	denom, err := s.keeper.GetDenom(ctx, req.ContractId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &token.QueryDenomResponse{Denom: denom}, nil
+}
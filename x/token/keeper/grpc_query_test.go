@@ -240,7 +240,7 @@ func (s *KeeperTestSuite) TestQueryGranteeGrants() {
 			grantee:    s.vendor,
 			valid:      true,
 			postTest: func(res *token.QueryGranteeGrantsResponse) {
-				s.Require().Equal(3, len(res.Grants))
+				s.Require().Equal(5, len(res.Grants))
 			},
 		},
 		"class not found": {
@@ -407,3 +407,184 @@ func (s *KeeperTestSuite) TestQueryHoldersByOperator() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestQueryFrozenHolders() {
+	// empty request
+	_, err := s.queryServer.FrozenHolders(s.goCtx, nil)
+	s.Require().Error(err)
+
+	ctx, _ := s.ctx.CacheContext()
+	err = s.keeper.Freeze(ctx, s.contractID, s.vendor, s.customer, true)
+	s.Require().NoError(err)
+	err = s.keeper.Freeze(ctx, s.contractID, s.vendor, s.stranger, false)
+	s.Require().NoError(err)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	testCases := map[string]struct {
+		contractID string
+		valid      bool
+		count      uint64
+		postTest   func(res *token.QueryFrozenHoldersResponse)
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			valid:      true,
+			postTest: func(res *token.QueryFrozenHoldersResponse) {
+				expected := []token.FrozenHolder{
+					{Address: s.customer.String(), Incoming: true},
+					{Address: s.stranger.String(), Incoming: false},
+				}
+				s.Require().ElementsMatch(expected, res.Holders)
+			},
+		},
+		"valid request with limit": {
+			contractID: s.contractID,
+			valid:      true,
+			count:      1,
+			postTest: func(res *token.QueryFrozenHoldersResponse) {
+				s.Require().Equal(1, len(res.Holders))
+			},
+		},
+		"class not found": {
+			contractID: "fee1dead",
+			valid:      true,
+			postTest: func(res *token.QueryFrozenHoldersResponse) {
+				s.Require().Equal(0, len(res.Holders))
+			},
+		},
+		"invalid contract id": {},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			pageReq := &query.PageRequest{}
+			if tc.count != 0 {
+				pageReq.Limit = tc.count
+			}
+			req := &token.QueryFrozenHoldersRequest{
+				ContractId: tc.contractID,
+				Pagination: pageReq,
+			}
+			res, err := s.queryServer.FrozenHolders(goCtx, req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+			tc.postTest(res)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryAllowance() {
+	// empty request
+	_, err := s.queryServer.Allowance(s.goCtx, nil)
+	s.Require().Error(err)
+
+	ctx, _ := s.ctx.CacheContext()
+	s.keeper.Approve(ctx, s.contractID, s.customer, s.stranger, s.balance)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	testCases := map[string]struct {
+		contractID string
+		holder     sdk.AccAddress
+		spender    sdk.AccAddress
+		valid      bool
+		postTest   func(res *token.QueryAllowanceResponse)
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			holder:     s.customer,
+			spender:    s.stranger,
+			valid:      true,
+			postTest: func(res *token.QueryAllowanceResponse) {
+				s.Require().Equal(s.balance, res.Amount)
+			},
+		},
+		"no allowance": {
+			contractID: s.contractID,
+			holder:     s.stranger,
+			spender:    s.customer,
+			valid:      true,
+			postTest: func(res *token.QueryAllowanceResponse) {
+				s.Require().Equal(sdk.ZeroInt(), res.Amount)
+			},
+		},
+		"invalid contract id": {
+			holder:  s.customer,
+			spender: s.stranger,
+		},
+		"invalid holder": {
+			contractID: s.contractID,
+			spender:    s.stranger,
+		},
+		"invalid spender": {
+			contractID: s.contractID,
+			holder:     s.customer,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			req := &token.QueryAllowanceRequest{
+				ContractId: tc.contractID,
+				Holder:     tc.holder.String(),
+				Spender:    tc.spender.String(),
+			}
+			res, err := s.queryServer.Allowance(goCtx, req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+			tc.postTest(res)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryDenom() {
+	// empty request
+	_, err := s.queryServer.Denom(s.goCtx, nil)
+	s.Require().Error(err)
+
+	ctx, _ := s.ctx.CacheContext()
+	_, err = s.keeper.RegisterDenom(ctx, s.contractID, s.vendor)
+	s.Require().NoError(err)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	testCases := map[string]struct {
+		contractID string
+		valid      bool
+		postTest   func(res *token.QueryDenomResponse)
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			valid:      true,
+			postTest: func(res *token.QueryDenomResponse) {
+				s.Require().Equal(token.DenomFromContractID(s.contractID), res.Denom)
+			},
+		},
+		"denom not registered": {
+			contractID: s.unmintableContractId,
+		},
+		"invalid contract id": {},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			req := &token.QueryDenomRequest{
+				ContractId: tc.contractID,
+			}
+			res, err := s.queryServer.Denom(goCtx, req)
+			if !tc.valid {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+			tc.postTest(res)
+		})
+	}
+}
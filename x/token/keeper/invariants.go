@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/token"
+)
+
+const (
+	lockedSupplyInvariant = "locked-supply"
+	denomsInvariant       = "denoms"
+)
+
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	for name, invariant := range map[string]func(k Keeper) sdk.Invariant{
+		lockedSupplyInvariant: LockedSupplyInvariant,
+		denomsInvariant:       DenomsInvariant,
+	} {
+		ir.RegisterRoute(token.ModuleName, name, invariant(k))
+	}
+}
+
+// LockedSupplyInvariant checks for every registered denom that its bank supply is backed by the locked tokens.
+func LockedSupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg := ""
+		broken := false
+
+		escrow := escrowAddress()
+		k.iterateDenoms(ctx, func(contractID, denom string) bool {
+			locked := k.GetBalance(ctx, contractID, escrow)
+			supply := k.bankKeeper.GetSupply(ctx, denom)
+			if locked.LT(supply.Amount) {
+				msg += fmt.Sprintf("supply %s exceeds the locked %s of %s\n", supply, locked, contractID)
+				broken = true
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(token.ModuleName, lockedSupplyInvariant, msg), broken
+	}
+}
+
+// DenomsInvariant checks that every registered denom belongs to an existing contract and has its bank metadata.
+func DenomsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg := ""
+		broken := false
+
+		k.iterateDenoms(ctx, func(contractID, denom string) bool {
+			if _, err := k.GetClass(ctx, contractID); err != nil {
+				msg += fmt.Sprintf("denom %s: %s\n", denom, err)
+				broken = true
+			}
+			if _, exists := k.bankKeeper.GetDenomMetaData(ctx, denom); !exists {
+				msg += fmt.Sprintf("denom %s of %s has no metadata\n", denom, contractID)
+				broken = true
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(token.ModuleName, denomsInvariant, msg), broken
+	}
+}
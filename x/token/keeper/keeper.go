@@ -10,6 +10,7 @@ import (
 // Keeper defines the token module Keeper
 type Keeper struct {
 	classKeeper token.ClassKeeper
+	bankKeeper  token.BankKeeper
 
 	// The (unexposed) keys used to access the stores from the Context.
 	storeKey sdk.StoreKey
@@ -23,9 +24,11 @@ func NewKeeper(
 	cdc codec.Codec,
 	key sdk.StoreKey,
 	ck token.ClassKeeper,
+	bk token.BankKeeper,
 ) Keeper {
 	return Keeper{
 		classKeeper: ck,
+		bankKeeper:  bk,
 		storeKey:    key,
 		cdc:         cdc,
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/Finschia/finschia-sdk/crypto/keys/secp256k1"
 	"github.com/Finschia/finschia-sdk/simapp"
 	sdk "github.com/Finschia/finschia-sdk/types"
+	bankkeeper "github.com/Finschia/finschia-sdk/x/bank/keeper"
 	"github.com/Finschia/finschia-sdk/x/token"
 	"github.com/Finschia/finschia-sdk/x/token/keeper"
 )
@@ -20,6 +21,7 @@ type KeeperTestSuite struct {
 	ctx         sdk.Context
 	goCtx       context.Context
 	keeper      keeper.Keeper
+	bankKeeper  bankkeeper.Keeper
 	queryServer token.QueryServer
 	msgServer   token.MsgServer
 
@@ -58,6 +60,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	s.ctx = app.BaseApp.NewContext(checkTx, tmproto.Header{})
 	s.goCtx = sdk.WrapSDKContext(s.ctx)
 	s.keeper = app.TokenKeeper
+	s.bankKeeper = app.BankKeeper
 
 	s.queryServer = keeper.NewQueryServer(s.keeper)
 	s.msgServer = keeper.NewMsgServer(s.keeper)
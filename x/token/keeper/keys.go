@@ -15,6 +15,10 @@ var (
 	supplyKeyPrefix = []byte{0x04}
 	mintKeyPrefix   = []byte{0x05}
 	burnKeyPrefix   = []byte{0x06}
+
+	frozenHolderKeyPrefix = []byte{0x07}
+	allowanceKeyPrefix    = []byte{0x08}
+	denomKeyPrefix        = []byte{0x09}
 )
 
 func classKey(id string) []byte {
@@ -24,6 +28,17 @@ func classKey(id string) []byte {
 	return key
 }
 
+func denomKey(contractID string) []byte {
+	key := make([]byte, len(denomKeyPrefix)+len(contractID))
+	copy(key, denomKeyPrefix)
+	copy(key[len(denomKeyPrefix):], contractID)
+	return key
+}
+
+func splitDenomKey(key []byte) (contractID string) {
+	return string(key[len(denomKeyPrefix):])
+}
+
 func balanceKey(contractID string, address sdk.AccAddress) []byte {
 	prefix := balanceKeyPrefixByContractID(contractID)
 	key := make([]byte, len(prefix)+len(address))
@@ -206,3 +221,95 @@ func splitAuthorizationKey(key []byte) (contractID string, operator, holder sdk.
 
 	return
 }
+
+func frozenHolderKey(contractID string, holder sdk.AccAddress) []byte {
+	prefix := frozenHolderKeyPrefixByContractID(contractID)
+	key := make([]byte, len(prefix)+len(holder))
+
+	copy(key, prefix)
+	copy(key[len(prefix):], holder)
+
+	return key
+}
+
+func frozenHolderKeyPrefixByContractID(contractID string) []byte {
+	key := make([]byte, len(frozenHolderKeyPrefix)+1+len(contractID))
+
+	begin := 0
+	copy(key, frozenHolderKeyPrefix)
+
+	begin += len(frozenHolderKeyPrefix)
+	key[begin] = byte(len(contractID))
+
+	begin++
+	copy(key[begin:], contractID)
+
+	return key
+}
+
+func splitFrozenHolderKey(key []byte) (contractID string, holder sdk.AccAddress) {
+	begin := len(frozenHolderKeyPrefix) + 1
+	end := begin + int(key[begin-1])
+	contractID = string(key[begin:end])
+
+	begin = end
+	holder = key[begin:]
+
+	return
+}
+
+func allowanceKey(contractID string, holder, spender sdk.AccAddress) []byte {
+	prefix := allowanceKeyPrefixByHolder(contractID, holder)
+	key := make([]byte, len(prefix)+len(spender))
+
+	copy(key, prefix)
+	copy(key[len(prefix):], spender)
+
+	return key
+}
+
+func allowanceKeyPrefixByHolder(contractID string, holder sdk.AccAddress) []byte {
+	prefix := allowanceKeyPrefixByContractID(contractID)
+	key := make([]byte, len(prefix)+1+len(holder))
+
+	begin := 0
+	copy(key, prefix)
+
+	begin += len(prefix)
+	key[begin] = byte(len(holder))
+
+	begin++
+	copy(key[begin:], holder)
+
+	return key
+}
+
+func allowanceKeyPrefixByContractID(contractID string) []byte {
+	key := make([]byte, len(allowanceKeyPrefix)+1+len(contractID))
+
+	begin := 0
+	copy(key, allowanceKeyPrefix)
+
+	begin += len(allowanceKeyPrefix)
+	key[begin] = byte(len(contractID))
+
+	begin++
+	copy(key[begin:], contractID)
+
+	return key
+}
+
+func splitAllowanceKey(key []byte) (contractID string, holder, spender sdk.AccAddress) {
+	begin := len(allowanceKeyPrefix) + 1
+	end := begin + int(key[begin-1])
+	contractID = string(key[begin:end])
+
+	begin = end + 1
+	end = begin + int(key[begin-1])
+	holder = key[begin:end]
+
+	begin = end
+	spender = key[begin:]
+
+	return
+}
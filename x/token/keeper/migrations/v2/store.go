@@ -0,0 +1,46 @@
+package v2
+
+import (
+	storetypes "github.com/Finschia/finschia-sdk/store/types"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/token"
+)
+
+// MigrateStore performs in-place store migrations from v1 to v2.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey) error {
+	store := ctx.KVStore(storeKey)
+
+	// grant the permissions added since v1
+	grantNewPermissions(store)
+
+	return nil
+}
+
+// grantNewPermissions grants the permissions which Issue grants to the owner
+// of a new contract, but the existing contracts lack, to the holders of
+// PermissionModify.
+func grantNewPermissions(store storetypes.KVStore) {
+	newPermissions := []token.Permission{
+		token.PermissionPause,
+		token.PermissionFreeze,
+	}
+
+	iterator := sdk.KVStorePrefixIterator(store, grantKeyPrefix)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		contractID, grantee, permission := splitGrantKey(iterator.Key())
+		if permission != token.PermissionModify {
+			continue
+		}
+
+		for _, newPermission := range newPermissions {
+			keys = append(keys, GrantKey(contractID, grantee, newPermission))
+		}
+	}
+
+	for _, key := range keys {
+		store.Set(key, []byte{})
+	}
+}
@@ -0,0 +1,43 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/finschia-sdk/testutil"
+	sdk "github.com/Finschia/finschia-sdk/types"
+	"github.com/Finschia/finschia-sdk/x/token"
+	v2 "github.com/Finschia/finschia-sdk/x/token/keeper/migrations/v2"
+)
+
+func TestMigrateStore(t *testing.T) {
+	tokenKey := sdk.NewKVStoreKey(token.StoreKey)
+	newKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(tokenKey, newKey)
+
+	// set state
+	store := ctx.KVStore(tokenKey)
+
+	contractID := "deadbeef"
+	fennec := sdk.AccAddress("fennec")
+	penguin := sdk.AccAddress("penguin")
+	store.Set(v2.GrantKey(contractID, fennec, token.PermissionModify), []byte{})
+	store.Set(v2.GrantKey(contractID, fennec, token.PermissionMint), []byte{})
+	store.Set(v2.GrantKey(contractID, penguin, token.PermissionMint), []byte{})
+
+	// migrate
+	err := v2.MigrateStore(ctx, tokenKey)
+	require.NoError(t, err)
+
+	newPermissions := []token.Permission{
+		token.PermissionPause,
+		token.PermissionFreeze,
+	}
+	for _, permission := range newPermissions {
+		require.True(t, store.Has(v2.GrantKey(contractID, fennec, permission)))
+		require.False(t, store.Has(v2.GrantKey(contractID, penguin, permission)))
+	}
+	require.True(t, store.Has(v2.GrantKey(contractID, fennec, token.PermissionMint)))
+	require.False(t, store.Has(v2.GrantKey(contractID, penguin, token.PermissionModify)))
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/Finschia/finschia-sdk/x/token"
 )
 
+const gasCostPerIteration = uint64(20)
+
 type msgServer struct {
 	keeper Keeper
 }
@@ -62,8 +64,11 @@ func (s msgServer) OperatorSend(c context.Context, req *token.MsgOperatorSend) (
 	operator := sdk.MustAccAddressFromBech32(req.Operator)
 	to := sdk.MustAccAddressFromBech32(req.To)
 
+	// an authorized operator may send any amount, otherwise it spends the allowance
 	if _, err := s.keeper.GetAuthorization(ctx, req.ContractId, from, operator); err != nil {
-		return nil, token.ErrTokenNotApproved.Wrap(err.Error())
+		if err := s.keeper.SpendAllowance(ctx, req.ContractId, from, operator, req.Amount); err != nil {
+			return nil, err
+		}
 	}
 
 	if err := s.keeper.Send(ctx, req.ContractId, from, to, req.Amount); err != nil {
@@ -140,13 +145,19 @@ func (s msgServer) AuthorizeOperator(c context.Context, req *token.MsgAuthorizeO
 // Issue defines a method to issue a token
 func (s msgServer) Issue(c context.Context, req *token.MsgIssue) (*token.MsgIssueResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+
+	maxSupply := sdk.ZeroInt()
+	if req.MaxSupply != nil {
+		maxSupply = *req.MaxSupply
+	}
 	class := token.Contract{
-		Name:     req.Name,
-		Symbol:   req.Symbol,
-		Uri:      req.Uri,
-		Meta:     req.Meta,
-		Decimals: req.Decimals,
-		Mintable: req.Mintable,
+		Name:      req.Name,
+		Symbol:    req.Symbol,
+		Uri:       req.Uri,
+		Meta:      req.Meta,
+		Decimals:  req.Decimals,
+		Mintable:  req.Mintable,
+		MaxSupply: maxSupply,
 	}
 
 	owner := sdk.MustAccAddressFromBech32(req.Owner)
@@ -270,3 +281,229 @@ func (s msgServer) Modify(c context.Context, req *token.MsgModify) (*token.MsgMo
 
 	return &token.MsgModifyResponse{}, nil
 }
+
+// Pause defines a method to suspend the transfers and burns of a contract
+func (s msgServer) Pause(c context.Context, req *token.MsgPause) (*token.MsgPauseResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ValidateLegacyContract(s.keeper, ctx, req.ContractId); err != nil {
+		return nil, err
+	}
+
+	operator := sdk.MustAccAddressFromBech32(req.Operator)
+
+	if _, err := s.keeper.GetGrant(ctx, req.ContractId, operator, token.PermissionPause); err != nil {
+		return nil, token.ErrTokenNoPermission.Wrap(err.Error())
+	}
+
+	if err := s.keeper.Pause(ctx, req.ContractId, operator); err != nil {
+		return nil, err
+	}
+
+	return &token.MsgPauseResponse{}, nil
+}
+
+// Unpause defines a method to resume the transfers and burns of a contract
+func (s msgServer) Unpause(c context.Context, req *token.MsgUnpause) (*token.MsgUnpauseResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ValidateLegacyContract(s.keeper, ctx, req.ContractId); err != nil {
+		return nil, err
+	}
+
+	operator := sdk.MustAccAddressFromBech32(req.Operator)
+
+	if _, err := s.keeper.GetGrant(ctx, req.ContractId, operator, token.PermissionPause); err != nil {
+		return nil, token.ErrTokenNoPermission.Wrap(err.Error())
+	}
+
+	if err := s.keeper.Unpause(ctx, req.ContractId, operator); err != nil {
+		return nil, err
+	}
+
+	return &token.MsgUnpauseResponse{}, nil
+}
+
+// Freeze defines a method to block the outgoing (and optionally incoming) transfers of a holder
+func (s msgServer) Freeze(c context.Context, req *token.MsgFreeze) (*token.MsgFreezeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ValidateLegacyContract(s.keeper, ctx, req.ContractId); err != nil {
+		return nil, err
+	}
+
+	operator := sdk.MustAccAddressFromBech32(req.Operator)
+	holder := sdk.MustAccAddressFromBech32(req.Holder)
+
+	if _, err := s.keeper.GetGrant(ctx, req.ContractId, operator, token.PermissionFreeze); err != nil {
+		return nil, token.ErrTokenNoPermission.Wrap(err.Error())
+	}
+
+	if err := s.keeper.Freeze(ctx, req.ContractId, operator, holder, req.Incoming); err != nil {
+		return nil, err
+	}
+
+	return &token.MsgFreezeResponse{}, nil
+}
+
+// Unfreeze defines a method to lift the freeze on a holder
+func (s msgServer) Unfreeze(c context.Context, req *token.MsgUnfreeze) (*token.MsgUnfreezeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ValidateLegacyContract(s.keeper, ctx, req.ContractId); err != nil {
+		return nil, err
+	}
+
+	operator := sdk.MustAccAddressFromBech32(req.Operator)
+	holder := sdk.MustAccAddressFromBech32(req.Holder)
+
+	if _, err := s.keeper.GetGrant(ctx, req.ContractId, operator, token.PermissionFreeze); err != nil {
+		return nil, token.ErrTokenNoPermission.Wrap(err.Error())
+	}
+
+	if err := s.keeper.Unfreeze(ctx, req.ContractId, operator, holder); err != nil {
+		return nil, err
+	}
+
+	return &token.MsgUnfreezeResponse{}, nil
+}
+
+// Approve sets the allowance of the spender
+func (s msgServer) Approve(c context.Context, req *token.MsgApprove) (*token.MsgApproveResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ValidateLegacyContract(s.keeper, ctx, req.ContractId); err != nil {
+		return nil, err
+	}
+
+	holder := sdk.MustAccAddressFromBech32(req.Holder)
+	spender := sdk.MustAccAddressFromBech32(req.Spender)
+
+	s.keeper.Approve(ctx, req.ContractId, holder, spender, req.Amount)
+
+	return &token.MsgApproveResponse{}, nil
+}
+
+// IncreaseAllowance increases the allowance of the spender
+func (s msgServer) IncreaseAllowance(c context.Context, req *token.MsgIncreaseAllowance) (*token.MsgIncreaseAllowanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ValidateLegacyContract(s.keeper, ctx, req.ContractId); err != nil {
+		return nil, err
+	}
+
+	holder := sdk.MustAccAddressFromBech32(req.Holder)
+	spender := sdk.MustAccAddressFromBech32(req.Spender)
+
+	s.keeper.IncreaseAllowance(ctx, req.ContractId, holder, spender, req.Amount)
+
+	return &token.MsgIncreaseAllowanceResponse{}, nil
+}
+
+// DecreaseAllowance decreases the allowance of the spender
+func (s msgServer) DecreaseAllowance(c context.Context, req *token.MsgDecreaseAllowance) (*token.MsgDecreaseAllowanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ValidateLegacyContract(s.keeper, ctx, req.ContractId); err != nil {
+		return nil, err
+	}
+
+	holder := sdk.MustAccAddressFromBech32(req.Holder)
+	spender := sdk.MustAccAddressFromBech32(req.Spender)
+
+	if err := s.keeper.DecreaseAllowance(ctx, req.ContractId, holder, spender, req.Amount); err != nil {
+		return nil, err
+	}
+
+	return &token.MsgDecreaseAllowanceResponse{}, nil
+}
+
+// RegisterDenom registers the bank denom of a contract
+func (s msgServer) RegisterDenom(c context.Context, req *token.MsgRegisterDenom) (*token.MsgRegisterDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ValidateLegacyContract(s.keeper, ctx, req.ContractId); err != nil {
+		return nil, err
+	}
+
+	grantee := sdk.MustAccAddressFromBech32(req.Owner)
+
+	if _, err := s.keeper.GetGrant(ctx, req.ContractId, grantee, token.PermissionModify); err != nil {
+		return nil, token.ErrTokenNoPermission.Wrap(err.Error())
+	}
+
+	denom, err := s.keeper.RegisterDenom(ctx, req.ContractId, grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	return &token.MsgRegisterDenomResponse{Denom: denom}, nil
+}
+
+// Lock locks tokens to mint the bank denom
+func (s msgServer) Lock(c context.Context, req *token.MsgLock) (*token.MsgLockResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ValidateLegacyContract(s.keeper, ctx, req.ContractId); err != nil {
+		return nil, err
+	}
+
+	holder := sdk.MustAccAddressFromBech32(req.Holder)
+
+	if err := s.keeper.Lock(ctx, req.ContractId, holder, req.Amount); err != nil {
+		return nil, err
+	}
+
+	return &token.MsgLockResponse{}, nil
+}
+
+// Unlock burns the bank denom to unlock tokens
+func (s msgServer) Unlock(c context.Context, req *token.MsgUnlock) (*token.MsgUnlockResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ValidateLegacyContract(s.keeper, ctx, req.ContractId); err != nil {
+		return nil, err
+	}
+
+	holder := sdk.MustAccAddressFromBech32(req.Holder)
+
+	if err := s.keeper.Unlock(ctx, req.ContractId, holder, req.Amount); err != nil {
+		return nil, err
+	}
+
+	return &token.MsgUnlockResponse{}, nil
+}
+
+// BatchSend defines a method to send tokens from one account to many accounts
+func (s msgServer) BatchSend(c context.Context, req *token.MsgBatchSend) (*token.MsgBatchSendResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if err := ValidateLegacyContract(s.keeper, ctx, req.ContractId); err != nil {
+		return nil, err
+	}
+
+	from := sdk.MustAccAddressFromBech32(req.From)
+
+	for _, entry := range req.Entries {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "batch send")
+
+		to := sdk.MustAccAddressFromBech32(entry.To)
+
+		if err := s.keeper.Send(ctx, req.ContractId, from, to, entry.Amount); err != nil {
+			return nil, err
+		}
+
+		event := token.EventSent{
+			ContractId: req.ContractId,
+			Operator:   req.From,
+			From:       req.From,
+			To:         entry.To,
+			Amount:     entry.Amount,
+		}
+		if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+			panic(err)
+		}
+	}
+
+	return &token.MsgBatchSendResponse{}, nil
+}
@@ -273,6 +273,7 @@ func (s *KeeperTestSuite) TestMsgIssue() {
 						{Key: []uint8("contract_id"), Value: testutil.W("ca8bfd79"), Index: false},
 						{Key: []uint8("creator"), Value: testutil.W(s.vendor), Index: false},
 						{Key: []uint8("decimals"), Value: []byte("0"), Index: false},
+						{Key: []uint8("max_supply"), Value: testutil.W("0"), Index: false},
 						{Key: []uint8("meta"), Value: testutil.W(""), Index: false},
 						{Key: []uint8("mintable"), Value: []byte("true"), Index: false},
 						{Key: []uint8("name"), Value: testutil.W("test"), Index: false},
@@ -289,6 +290,24 @@ func (s *KeeperTestSuite) TestMsgIssue() {
 						{Key: []uint8("permission"), Value: testutil.W("PERMISSION_MODIFY"), Index: false},
 					},
 				},
+				sdk.Event{
+					Type: "lbm.token.v1.EventGranted",
+					Attributes: []abci.EventAttribute{
+						{Key: []uint8("contract_id"), Value: testutil.W("ca8bfd79"), Index: false},
+						{Key: []uint8("grantee"), Value: testutil.W(s.vendor), Index: false},
+						{Key: []uint8("granter"), Value: testutil.W(""), Index: false},
+						{Key: []uint8("permission"), Value: testutil.W("PERMISSION_PAUSE"), Index: false},
+					},
+				},
+				sdk.Event{
+					Type: "lbm.token.v1.EventGranted",
+					Attributes: []abci.EventAttribute{
+						{Key: []uint8("contract_id"), Value: testutil.W("ca8bfd79"), Index: false},
+						{Key: []uint8("grantee"), Value: testutil.W(s.vendor), Index: false},
+						{Key: []uint8("granter"), Value: testutil.W(""), Index: false},
+						{Key: []uint8("permission"), Value: testutil.W("PERMISSION_FREEZE"), Index: false},
+					},
+				},
 				sdk.Event{
 					Type: "lbm.token.v1.EventGranted",
 					Attributes: []abci.EventAttribute{
@@ -328,6 +347,7 @@ func (s *KeeperTestSuite) TestMsgIssue() {
 						{Key: []uint8("contract_id"), Value: testutil.W("ca8bfd79"), Index: false},
 						{Key: []uint8("creator"), Value: testutil.W(s.vendor), Index: false},
 						{Key: []uint8("decimals"), Value: []byte("0"), Index: false},
+						{Key: []uint8("max_supply"), Value: testutil.W("0"), Index: false},
 						{Key: []uint8("meta"), Value: testutil.W(""), Index: false},
 						{Key: []uint8("mintable"), Value: []byte("false"), Index: false},
 						{Key: []uint8("name"), Value: testutil.W("test"), Index: false},
@@ -344,6 +364,24 @@ func (s *KeeperTestSuite) TestMsgIssue() {
 						{Key: []uint8("permission"), Value: testutil.W("PERMISSION_MODIFY"), Index: false},
 					},
 				},
+				sdk.Event{
+					Type: "lbm.token.v1.EventGranted",
+					Attributes: []abci.EventAttribute{
+						{Key: []uint8("contract_id"), Value: testutil.W("ca8bfd79"), Index: false},
+						{Key: []uint8("grantee"), Value: testutil.W(s.vendor), Index: false},
+						{Key: []uint8("granter"), Value: testutil.W(""), Index: false},
+						{Key: []uint8("permission"), Value: testutil.W("PERMISSION_PAUSE"), Index: false},
+					},
+				},
+				sdk.Event{
+					Type: "lbm.token.v1.EventGranted",
+					Attributes: []abci.EventAttribute{
+						{Key: []uint8("contract_id"), Value: testutil.W("ca8bfd79"), Index: false},
+						{Key: []uint8("grantee"), Value: testutil.W(s.vendor), Index: false},
+						{Key: []uint8("granter"), Value: testutil.W(""), Index: false},
+						{Key: []uint8("permission"), Value: testutil.W("PERMISSION_FREEZE"), Index: false},
+					},
+				},
 				sdk.Event{
 					Type: "lbm.token.v1.EventMinted",
 					Attributes: []abci.EventAttribute{
@@ -1071,3 +1109,795 @@ func (s *KeeperTestSuite) TestMsgModify() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestMsgPause() {
+	testCases := map[string]struct {
+		contractID string
+		operator   sdk.AccAddress
+		paused     bool
+		err        error
+		events     sdk.Events
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			operator:   s.vendor,
+			events: sdk.Events{
+				sdk.Event{
+					Type: "lbm.token.v1.EventPaused",
+					Attributes: []abci.EventAttribute{
+						{Key: []byte("contract_id"), Value: testutil.W(s.contractID), Index: false},
+						{Key: []byte("operator"), Value: testutil.W(s.vendor), Index: false},
+					},
+				},
+			},
+		},
+		"contract not found": {
+			contractID: "fee1dead",
+			operator:   s.vendor,
+			err:        class.ErrContractNotExist,
+		},
+		"no permission": {
+			contractID: s.contractID,
+			operator:   s.operator,
+			err:        token.ErrTokenNoPermission,
+		},
+		"already paused": {
+			contractID: s.contractID,
+			operator:   s.vendor,
+			paused:     true,
+			err:        token.ErrTokenPaused,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			if tc.paused {
+				err := s.keeper.Pause(ctx, tc.contractID, s.vendor)
+				s.Require().NoError(err)
+				ctx = ctx.WithEventManager(sdk.NewEventManager())
+			}
+
+			req := &token.MsgPause{
+				ContractId: tc.contractID,
+				Operator:   tc.operator.String(),
+			}
+			res, err := s.msgServer.Pause(sdk.WrapSDKContext(ctx), req)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			s.Require().NotNil(res)
+			s.Require().Equal(tc.events, ctx.EventManager().Events())
+
+			// transfers are suspended
+			send := &token.MsgSend{
+				ContractId: tc.contractID,
+				From:       s.vendor.String(),
+				To:         s.customer.String(),
+				Amount:     sdk.OneInt(),
+			}
+			_, err = s.msgServer.Send(sdk.WrapSDKContext(ctx), send)
+			s.Require().ErrorIs(err, token.ErrTokenPaused)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgUnpause() {
+	testCases := map[string]struct {
+		contractID string
+		operator   sdk.AccAddress
+		paused     bool
+		err        error
+		events     sdk.Events
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			operator:   s.vendor,
+			paused:     true,
+			events: sdk.Events{
+				sdk.Event{
+					Type: "lbm.token.v1.EventUnpaused",
+					Attributes: []abci.EventAttribute{
+						{Key: []byte("contract_id"), Value: testutil.W(s.contractID), Index: false},
+						{Key: []byte("operator"), Value: testutil.W(s.vendor), Index: false},
+					},
+				},
+			},
+		},
+		"contract not found": {
+			contractID: "fee1dead",
+			operator:   s.vendor,
+			err:        class.ErrContractNotExist,
+		},
+		"no permission": {
+			contractID: s.contractID,
+			operator:   s.operator,
+			paused:     true,
+			err:        token.ErrTokenNoPermission,
+		},
+		"not paused": {
+			contractID: s.contractID,
+			operator:   s.vendor,
+			err:        token.ErrTokenNotPaused,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			if tc.paused {
+				err := s.keeper.Pause(ctx, tc.contractID, s.vendor)
+				s.Require().NoError(err)
+				ctx = ctx.WithEventManager(sdk.NewEventManager())
+			}
+
+			req := &token.MsgUnpause{
+				ContractId: tc.contractID,
+				Operator:   tc.operator.String(),
+			}
+			res, err := s.msgServer.Unpause(sdk.WrapSDKContext(ctx), req)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			s.Require().NotNil(res)
+			s.Require().Equal(tc.events, ctx.EventManager().Events())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgFreeze() {
+	testCases := map[string]struct {
+		contractID string
+		operator   sdk.AccAddress
+		frozen     bool
+		err        error
+		events     sdk.Events
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			operator:   s.vendor,
+			events: sdk.Events{
+				sdk.Event{
+					Type: "lbm.token.v1.EventFrozen",
+					Attributes: []abci.EventAttribute{
+						{Key: []byte("contract_id"), Value: testutil.W(s.contractID), Index: false},
+						{Key: []byte("holder"), Value: testutil.W(s.customer), Index: false},
+						{Key: []byte("incoming"), Value: []byte("true"), Index: false},
+						{Key: []byte("operator"), Value: testutil.W(s.vendor), Index: false},
+					},
+				},
+			},
+		},
+		"contract not found": {
+			contractID: "fee1dead",
+			operator:   s.vendor,
+			err:        class.ErrContractNotExist,
+		},
+		"no permission": {
+			contractID: s.contractID,
+			operator:   s.operator,
+			err:        token.ErrTokenNoPermission,
+		},
+		"already frozen": {
+			contractID: s.contractID,
+			operator:   s.vendor,
+			frozen:     true,
+			err:        token.ErrHolderFrozen,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			if tc.frozen {
+				err := s.keeper.Freeze(ctx, tc.contractID, s.vendor, s.customer, false)
+				s.Require().NoError(err)
+				ctx = ctx.WithEventManager(sdk.NewEventManager())
+			}
+
+			req := &token.MsgFreeze{
+				ContractId: tc.contractID,
+				Operator:   tc.operator.String(),
+				Holder:     s.customer.String(),
+				Incoming:   true,
+			}
+			res, err := s.msgServer.Freeze(sdk.WrapSDKContext(ctx), req)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			s.Require().NotNil(res)
+			s.Require().Equal(tc.events, ctx.EventManager().Events())
+
+			// outgoing transfers are blocked
+			send := &token.MsgOperatorSend{
+				ContractId: tc.contractID,
+				Operator:   s.operator.String(),
+				From:       s.customer.String(),
+				To:         s.vendor.String(),
+				Amount:     sdk.OneInt(),
+			}
+			_, err = s.msgServer.OperatorSend(sdk.WrapSDKContext(ctx), send)
+			s.Require().ErrorIs(err, token.ErrHolderFrozen)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgUnfreeze() {
+	testCases := map[string]struct {
+		contractID string
+		operator   sdk.AccAddress
+		frozen     bool
+		err        error
+		events     sdk.Events
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			operator:   s.vendor,
+			frozen:     true,
+			events: sdk.Events{
+				sdk.Event{
+					Type: "lbm.token.v1.EventUnfrozen",
+					Attributes: []abci.EventAttribute{
+						{Key: []byte("contract_id"), Value: testutil.W(s.contractID), Index: false},
+						{Key: []byte("holder"), Value: testutil.W(s.customer), Index: false},
+						{Key: []byte("operator"), Value: testutil.W(s.vendor), Index: false},
+					},
+				},
+			},
+		},
+		"contract not found": {
+			contractID: "fee1dead",
+			operator:   s.vendor,
+			err:        class.ErrContractNotExist,
+		},
+		"no permission": {
+			contractID: s.contractID,
+			operator:   s.operator,
+			frozen:     true,
+			err:        token.ErrTokenNoPermission,
+		},
+		"not frozen": {
+			contractID: s.contractID,
+			operator:   s.vendor,
+			err:        token.ErrHolderNotFrozen,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			if tc.frozen {
+				err := s.keeper.Freeze(ctx, tc.contractID, s.vendor, s.customer, false)
+				s.Require().NoError(err)
+				ctx = ctx.WithEventManager(sdk.NewEventManager())
+			}
+
+			req := &token.MsgUnfreeze{
+				ContractId: tc.contractID,
+				Operator:   tc.operator.String(),
+				Holder:     s.customer.String(),
+			}
+			res, err := s.msgServer.Unfreeze(sdk.WrapSDKContext(ctx), req)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			s.Require().NotNil(res)
+			s.Require().Equal(tc.events, ctx.EventManager().Events())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgOperatorSendByAllowance() {
+	testCases := map[string]struct {
+		amount sdk.Int
+		err    error
+		events sdk.Events
+	}{
+		"valid request": {
+			amount: sdk.OneInt(),
+			events: sdk.Events{
+				sdk.Event{
+					Type: "lbm.token.v1.EventAllowanceUpdated",
+					Attributes: []abci.EventAttribute{
+						{Key: []byte("amount"), Value: testutil.W(s.balance.Sub(sdk.OneInt())), Index: false},
+						{Key: []byte("contract_id"), Value: testutil.W(s.contractID), Index: false},
+						{Key: []byte("holder"), Value: testutil.W(s.customer), Index: false},
+						{Key: []byte("spender"), Value: testutil.W(s.stranger), Index: false},
+					},
+				},
+				sdk.Event{
+					Type: "lbm.token.v1.EventSent",
+					Attributes: []abci.EventAttribute{
+						{Key: []byte("amount"), Value: testutil.W(sdk.OneInt()), Index: false},
+						{Key: []byte("contract_id"), Value: testutil.W(s.contractID), Index: false},
+						{Key: []byte("from"), Value: testutil.W(s.customer), Index: false},
+						{Key: []byte("operator"), Value: testutil.W(s.stranger), Index: false},
+						{Key: []byte("to"), Value: testutil.W(s.vendor), Index: false},
+					},
+				},
+			},
+		},
+		"insufficient allowance": {
+			amount: s.balance.Add(sdk.OneInt()),
+			err:    token.ErrInsufficientAllowance,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			s.keeper.Approve(ctx, s.contractID, s.customer, s.stranger, s.balance)
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+			req := &token.MsgOperatorSend{
+				ContractId: s.contractID,
+				Operator:   s.stranger.String(),
+				From:       s.customer.String(),
+				To:         s.vendor.String(),
+				Amount:     tc.amount,
+			}
+			res, err := s.msgServer.OperatorSend(sdk.WrapSDKContext(ctx), req)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			s.Require().NotNil(res)
+			s.Require().Equal(tc.events, ctx.EventManager().Events())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgApprove() {
+	testCases := map[string]struct {
+		contractID string
+		amount     sdk.Int
+		err        error
+		events     sdk.Events
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			amount:     s.balance,
+			events: sdk.Events{
+				sdk.Event{
+					Type: "lbm.token.v1.EventAllowanceUpdated",
+					Attributes: []abci.EventAttribute{
+						{Key: []byte("amount"), Value: testutil.W(s.balance), Index: false},
+						{Key: []byte("contract_id"), Value: testutil.W(s.contractID), Index: false},
+						{Key: []byte("holder"), Value: testutil.W(s.customer), Index: false},
+						{Key: []byte("spender"), Value: testutil.W(s.stranger), Index: false},
+					},
+				},
+			},
+		},
+		"remove allowance": {
+			contractID: s.contractID,
+			amount:     sdk.ZeroInt(),
+			events: sdk.Events{
+				sdk.Event{
+					Type: "lbm.token.v1.EventAllowanceUpdated",
+					Attributes: []abci.EventAttribute{
+						{Key: []byte("amount"), Value: testutil.W(sdk.ZeroInt()), Index: false},
+						{Key: []byte("contract_id"), Value: testutil.W(s.contractID), Index: false},
+						{Key: []byte("holder"), Value: testutil.W(s.customer), Index: false},
+						{Key: []byte("spender"), Value: testutil.W(s.stranger), Index: false},
+					},
+				},
+			},
+		},
+		"contract not found": {
+			contractID: "fee1dead",
+			amount:     s.balance,
+			err:        class.ErrContractNotExist,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			req := &token.MsgApprove{
+				ContractId: tc.contractID,
+				Holder:     s.customer.String(),
+				Spender:    s.stranger.String(),
+				Amount:     tc.amount,
+			}
+			res, err := s.msgServer.Approve(sdk.WrapSDKContext(ctx), req)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			s.Require().NotNil(res)
+			s.Require().Equal(tc.events, ctx.EventManager().Events())
+			s.Require().Equal(tc.amount, s.keeper.GetAllowance(ctx, tc.contractID, s.customer, s.stranger))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgIncreaseAllowance() {
+	testCases := map[string]struct {
+		contractID string
+		err        error
+		events     sdk.Events
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			events: sdk.Events{
+				sdk.Event{
+					Type: "lbm.token.v1.EventAllowanceUpdated",
+					Attributes: []abci.EventAttribute{
+						{Key: []byte("amount"), Value: testutil.W(s.balance.Add(sdk.OneInt())), Index: false},
+						{Key: []byte("contract_id"), Value: testutil.W(s.contractID), Index: false},
+						{Key: []byte("holder"), Value: testutil.W(s.customer), Index: false},
+						{Key: []byte("spender"), Value: testutil.W(s.stranger), Index: false},
+					},
+				},
+			},
+		},
+		"contract not found": {
+			contractID: "fee1dead",
+			err:        class.ErrContractNotExist,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			s.keeper.Approve(ctx, s.contractID, s.customer, s.stranger, s.balance)
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+			req := &token.MsgIncreaseAllowance{
+				ContractId: tc.contractID,
+				Holder:     s.customer.String(),
+				Spender:    s.stranger.String(),
+				Amount:     sdk.OneInt(),
+			}
+			res, err := s.msgServer.IncreaseAllowance(sdk.WrapSDKContext(ctx), req)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			s.Require().NotNil(res)
+			s.Require().Equal(tc.events, ctx.EventManager().Events())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgDecreaseAllowance() {
+	testCases := map[string]struct {
+		contractID string
+		amount     sdk.Int
+		err        error
+		events     sdk.Events
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			amount:     sdk.OneInt(),
+			events: sdk.Events{
+				sdk.Event{
+					Type: "lbm.token.v1.EventAllowanceUpdated",
+					Attributes: []abci.EventAttribute{
+						{Key: []byte("amount"), Value: testutil.W(s.balance.Sub(sdk.OneInt())), Index: false},
+						{Key: []byte("contract_id"), Value: testutil.W(s.contractID), Index: false},
+						{Key: []byte("holder"), Value: testutil.W(s.customer), Index: false},
+						{Key: []byte("spender"), Value: testutil.W(s.stranger), Index: false},
+					},
+				},
+			},
+		},
+		"contract not found": {
+			contractID: "fee1dead",
+			amount:     sdk.OneInt(),
+			err:        class.ErrContractNotExist,
+		},
+		"insufficient allowance": {
+			contractID: s.contractID,
+			amount:     s.balance.Add(sdk.OneInt()),
+			err:        token.ErrInsufficientAllowance,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			s.keeper.Approve(ctx, s.contractID, s.customer, s.stranger, s.balance)
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+			req := &token.MsgDecreaseAllowance{
+				ContractId: tc.contractID,
+				Holder:     s.customer.String(),
+				Spender:    s.stranger.String(),
+				Amount:     tc.amount,
+			}
+			res, err := s.msgServer.DecreaseAllowance(sdk.WrapSDKContext(ctx), req)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			s.Require().NotNil(res)
+			s.Require().Equal(tc.events, ctx.EventManager().Events())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgRegisterDenom() {
+	testCases := map[string]struct {
+		contractID string
+		owner      sdk.AccAddress
+		registered bool
+		err        error
+		events     sdk.Events
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			owner:      s.vendor,
+			events: sdk.Events{
+				sdk.Event{
+					Type: "lbm.token.v1.EventDenomRegistered",
+					Attributes: []abci.EventAttribute{
+						{Key: []byte("contract_id"), Value: testutil.W(s.contractID), Index: false},
+						{Key: []byte("denom"), Value: testutil.W(token.DenomFromContractID(s.contractID)), Index: false},
+						{Key: []byte("operator"), Value: testutil.W(s.vendor), Index: false},
+					},
+				},
+			},
+		},
+		"contract not found": {
+			contractID: "fee1dead",
+			owner:      s.vendor,
+			err:        class.ErrContractNotExist,
+		},
+		"no permission": {
+			contractID: s.contractID,
+			owner:      s.operator,
+			err:        token.ErrTokenNoPermission,
+		},
+		"already registered": {
+			contractID: s.contractID,
+			owner:      s.vendor,
+			registered: true,
+			err:        token.ErrDenomAlreadyRegistered,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			if tc.registered {
+				_, err := s.keeper.RegisterDenom(ctx, tc.contractID, s.vendor)
+				s.Require().NoError(err)
+				ctx = ctx.WithEventManager(sdk.NewEventManager())
+			}
+
+			req := &token.MsgRegisterDenom{
+				ContractId: tc.contractID,
+				Owner:      tc.owner.String(),
+			}
+			res, err := s.msgServer.RegisterDenom(sdk.WrapSDKContext(ctx), req)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			s.Require().NotNil(res)
+			s.Require().Equal(token.DenomFromContractID(tc.contractID), res.Denom)
+			s.Require().Equal(tc.events, ctx.EventManager().Events())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgLock() {
+	testCases := map[string]struct {
+		contractID string
+		amount     sdk.Int
+		err        error
+		event      sdk.Event
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			amount:     s.balance,
+			event: sdk.Event{
+				Type: "lbm.token.v1.EventLocked",
+				Attributes: []abci.EventAttribute{
+					{Key: []byte("amount"), Value: testutil.W(s.balance), Index: false},
+					{Key: []byte("contract_id"), Value: testutil.W(s.contractID), Index: false},
+					{Key: []byte("holder"), Value: testutil.W(s.customer), Index: false},
+				},
+			},
+		},
+		"contract not found": {
+			contractID: "fee1dead",
+			amount:     s.balance,
+			err:        class.ErrContractNotExist,
+		},
+		"insufficient tokens": {
+			contractID: s.contractID,
+			amount:     s.balance.Add(sdk.OneInt()),
+			err:        token.ErrInsufficientBalance,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			_, err := s.keeper.RegisterDenom(ctx, s.contractID, s.vendor)
+			s.Require().NoError(err)
+
+			req := &token.MsgLock{
+				ContractId: tc.contractID,
+				Holder:     s.customer.String(),
+				Amount:     tc.amount,
+			}
+			res, err := s.msgServer.Lock(sdk.WrapSDKContext(ctx), req)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			s.Require().NotNil(res)
+			s.Require().Contains(ctx.EventManager().Events(), tc.event)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgUnlock() {
+	testCases := map[string]struct {
+		contractID string
+		amount     sdk.Int
+		err        error
+		event      sdk.Event
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			amount:     s.balance,
+			event: sdk.Event{
+				Type: "lbm.token.v1.EventUnlocked",
+				Attributes: []abci.EventAttribute{
+					{Key: []byte("amount"), Value: testutil.W(s.balance), Index: false},
+					{Key: []byte("contract_id"), Value: testutil.W(s.contractID), Index: false},
+					{Key: []byte("holder"), Value: testutil.W(s.customer), Index: false},
+				},
+			},
+		},
+		"contract not found": {
+			contractID: "fee1dead",
+			amount:     s.balance,
+			err:        class.ErrContractNotExist,
+		},
+		"denom not registered": {
+			contractID: s.unmintableContractId,
+			amount:     s.balance,
+			err:        token.ErrDenomNotRegistered,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			_, err := s.keeper.RegisterDenom(ctx, s.contractID, s.vendor)
+			s.Require().NoError(err)
+			err = s.keeper.Lock(ctx, s.contractID, s.customer, s.balance)
+			s.Require().NoError(err)
+
+			req := &token.MsgUnlock{
+				ContractId: tc.contractID,
+				Holder:     s.customer.String(),
+				Amount:     tc.amount,
+			}
+			res, err := s.msgServer.Unlock(sdk.WrapSDKContext(ctx), req)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			s.Require().NotNil(res)
+			s.Require().Contains(ctx.EventManager().Events(), tc.event)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgBatchSend() {
+	testCases := map[string]struct {
+		contractID string
+		amounts    []sdk.Int
+		err        error
+		events     sdk.Events
+	}{
+		"valid request": {
+			contractID: s.contractID,
+			amounts:    []sdk.Int{sdk.OneInt(), s.balance.Sub(sdk.OneInt())},
+			events: sdk.Events{
+				sdk.Event{
+					Type: "lbm.token.v1.EventSent",
+					Attributes: []abci.EventAttribute{
+						{Key: []byte("amount"), Value: testutil.W(sdk.OneInt()), Index: false},
+						{Key: []byte("contract_id"), Value: testutil.W(s.contractID), Index: false},
+						{Key: []byte("from"), Value: testutil.W(s.vendor), Index: false},
+						{Key: []byte("operator"), Value: testutil.W(s.vendor), Index: false},
+						{Key: []byte("to"), Value: testutil.W(s.customer), Index: false},
+					},
+				},
+				sdk.Event{
+					Type: "lbm.token.v1.EventSent",
+					Attributes: []abci.EventAttribute{
+						{Key: []byte("amount"), Value: testutil.W(s.balance.Sub(sdk.OneInt())), Index: false},
+						{Key: []byte("contract_id"), Value: testutil.W(s.contractID), Index: false},
+						{Key: []byte("from"), Value: testutil.W(s.vendor), Index: false},
+						{Key: []byte("operator"), Value: testutil.W(s.vendor), Index: false},
+						{Key: []byte("to"), Value: testutil.W(s.stranger), Index: false},
+					},
+				},
+			},
+		},
+		"contract not found": {
+			contractID: "fee1dead",
+			amounts:    []sdk.Int{sdk.OneInt(), sdk.OneInt()},
+			err:        class.ErrContractNotExist,
+		},
+		"insufficient funds": {
+			contractID: s.contractID,
+			amounts:    []sdk.Int{sdk.OneInt(), s.balance},
+			err:        token.ErrInsufficientBalance,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			req := &token.MsgBatchSend{
+				ContractId: tc.contractID,
+				From:       s.vendor.String(),
+				Entries: []token.BatchSendEntry{
+					{To: s.customer.String(), Amount: tc.amounts[0]},
+					{To: s.stranger.String(), Amount: tc.amounts[1]},
+				},
+			}
+			res, err := s.msgServer.BatchSend(sdk.WrapSDKContext(ctx), req)
+			s.Require().ErrorIs(err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			s.Require().NotNil(res)
+			s.Require().Equal(tc.events, ctx.EventManager().Events())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgBatchSendGas() {
+	gasConsumed := func(numEntries int) uint64 {
+		ctx, _ := s.ctx.CacheContext()
+		ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+		entries := make([]token.BatchSendEntry, numEntries)
+		for i := range entries {
+			entries[i] = token.BatchSendEntry{To: s.customer.String(), Amount: sdk.OneInt()}
+		}
+		req := &token.MsgBatchSend{
+			ContractId: s.contractID,
+			From:       s.vendor.String(),
+			Entries:    entries,
+		}
+		_, err := s.msgServer.BatchSend(sdk.WrapSDKContext(ctx), req)
+		s.Require().NoError(err)
+
+		return ctx.GasMeter().GasConsumed()
+	}
+
+	// the gas grows by the same amount for each additional entry
+	perEntry := gasConsumed(3) - gasConsumed(2)
+	s.Require().Positive(perEntry)
+	s.Require().Equal(perEntry, gasConsumed(4)-gasConsumed(3))
+}
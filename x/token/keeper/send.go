@@ -10,6 +10,15 @@ func (k Keeper) Send(ctx sdk.Context, contractID string, from, to sdk.AccAddress
 	if !amount.IsPositive() {
 		panic(sdkerrors.ErrInvalidRequest.Wrap("amount must be positive"))
 	}
+	if err := k.assertNotPaused(ctx, contractID); err != nil {
+		return err
+	}
+	if _, err := k.GetFrozenHolder(ctx, contractID, from); err == nil {
+		return token.ErrHolderFrozen.Wrapf("%s is frozen", from)
+	}
+	if frozen, err := k.GetFrozenHolder(ctx, contractID, to); err == nil && frozen.Incoming {
+		return token.ErrHolderFrozen.Wrapf("incoming transfers to %s are frozen", to)
+	}
 
 	if err := k.subtractToken(ctx, contractID, from, amount); err != nil {
 		return err
@@ -112,3 +121,150 @@ func (k Keeper) setBalance(ctx sdk.Context, contractID string, addr sdk.AccAddre
 		store.Set(key, bz)
 	}
 }
+
+func (k Keeper) Freeze(ctx sdk.Context, contractID string, operator, holder sdk.AccAddress, incoming bool) error {
+	if _, err := k.GetFrozenHolder(ctx, contractID, holder); err == nil {
+		return token.ErrHolderFrozen.Wrapf("%s is already frozen", holder)
+	}
+
+	k.setFrozenHolder(ctx, contractID, holder, incoming)
+
+	event := token.EventFrozen{
+		ContractId: contractID,
+		Operator:   operator.String(),
+		Holder:     holder.String(),
+		Incoming:   incoming,
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		panic(err)
+	}
+	return nil
+}
+
+func (k Keeper) Unfreeze(ctx sdk.Context, contractID string, operator, holder sdk.AccAddress) error {
+	if _, err := k.GetFrozenHolder(ctx, contractID, holder); err != nil {
+		return err
+	}
+
+	k.deleteFrozenHolder(ctx, contractID, holder)
+
+	event := token.EventUnfrozen{
+		ContractId: contractID,
+		Operator:   operator.String(),
+		Holder:     holder.String(),
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		panic(err)
+	}
+	return nil
+}
+
+func (k Keeper) GetFrozenHolder(ctx sdk.Context, contractID string, holder sdk.AccAddress) (*token.FrozenHolder, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(frozenHolderKey(contractID, holder))
+	if bz == nil {
+		return nil, token.ErrHolderNotFrozen.Wrapf("%s is not frozen", holder)
+	}
+
+	return &token.FrozenHolder{
+		Address:  holder.String(),
+		Incoming: decodeIncoming(bz),
+	}, nil
+}
+
+func (k Keeper) setFrozenHolder(ctx sdk.Context, contractID string, holder sdk.AccAddress, incoming bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := frozenHolderKey(contractID, holder)
+	store.Set(key, encodeIncoming(incoming))
+}
+
+func (k Keeper) deleteFrozenHolder(ctx sdk.Context, contractID string, holder sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	key := frozenHolderKey(contractID, holder)
+	store.Delete(key)
+}
+
+func encodeIncoming(incoming bool) []byte {
+	if incoming {
+		return []byte{0x01}
+	}
+	return []byte{0x00}
+}
+
+func decodeIncoming(bz []byte) bool {
+	return len(bz) != 0 && bz[0] != 0x00
+}
+
+func (k Keeper) Approve(ctx sdk.Context, contractID string, holder, spender sdk.AccAddress, amount sdk.Int) {
+	k.setAllowance(ctx, contractID, holder, spender, amount)
+	k.emitAllowanceUpdated(ctx, contractID, holder, spender, amount)
+}
+
+func (k Keeper) IncreaseAllowance(ctx sdk.Context, contractID string, holder, spender sdk.AccAddress, amount sdk.Int) {
+	allowance := k.GetAllowance(ctx, contractID, holder, spender).Add(amount)
+	k.Approve(ctx, contractID, holder, spender, allowance)
+}
+
+func (k Keeper) DecreaseAllowance(ctx sdk.Context, contractID string, holder, spender sdk.AccAddress, amount sdk.Int) error {
+	allowance := k.GetAllowance(ctx, contractID, holder, spender)
+	if allowance.LT(amount) {
+		return token.ErrInsufficientAllowance.Wrapf("%s is smaller than %s", allowance, amount)
+	}
+
+	k.Approve(ctx, contractID, holder, spender, allowance.Sub(amount))
+	return nil
+}
+
+// SpendAllowance deducts amount from the allowance of the spender.
+func (k Keeper) SpendAllowance(ctx sdk.Context, contractID string, holder, spender sdk.AccAddress, amount sdk.Int) error {
+	allowance := k.GetAllowance(ctx, contractID, holder, spender)
+	if allowance.IsZero() {
+		return token.ErrTokenNotApproved.Wrapf("no allowance to %s by %s", spender, holder)
+	}
+	if allowance.LT(amount) {
+		return token.ErrInsufficientAllowance.Wrapf("%s is smaller than %s", allowance, amount)
+	}
+
+	k.Approve(ctx, contractID, holder, spender, allowance.Sub(amount))
+	return nil
+}
+
+func (k Keeper) emitAllowanceUpdated(ctx sdk.Context, contractID string, holder, spender sdk.AccAddress, amount sdk.Int) {
+	event := token.EventAllowanceUpdated{
+		ContractId: contractID,
+		Holder:     holder.String(),
+		Spender:    spender.String(),
+		Amount:     amount,
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		panic(err)
+	}
+}
+
+func (k Keeper) GetAllowance(ctx sdk.Context, contractID string, holder, spender sdk.AccAddress) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+	amount := sdk.ZeroInt()
+	bz := store.Get(allowanceKey(contractID, holder, spender))
+	if bz != nil {
+		if err := amount.Unmarshal(bz); err != nil {
+			panic(err)
+		}
+	}
+	return amount
+}
+
+// setAllowance sets allowance.
+// The caller must validate `amount`.
+func (k Keeper) setAllowance(ctx sdk.Context, contractID string, holder, spender sdk.AccAddress, amount sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	key := allowanceKey(contractID, holder, spender)
+	if amount.IsZero() {
+		store.Delete(key)
+	} else {
+		bz, err := amount.Marshal()
+		if err != nil {
+			panic(err)
+		}
+		store.Set(key, bz)
+	}
+}
@@ -40,6 +40,67 @@ func (s *KeeperTestSuite) TestSend() {
 	}
 }
 
+func (s *KeeperTestSuite) TestFreeze() {
+	testCases := map[string]struct {
+		frozen   sdk.AccAddress
+		incoming bool
+		err      error
+	}{
+		"sender frozen": {
+			frozen: s.vendor,
+			err:    token.ErrHolderFrozen,
+		},
+		"sender frozen including incoming": {
+			frozen:   s.vendor,
+			incoming: true,
+			err:      token.ErrHolderFrozen,
+		},
+		"recipient frozen": {
+			frozen: s.operator,
+		},
+		"recipient frozen including incoming": {
+			frozen:   s.operator,
+			incoming: true,
+			err:      token.ErrHolderFrozen,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			err := s.keeper.Freeze(ctx, s.contractID, s.vendor, tc.frozen, tc.incoming)
+			s.Require().NoError(err)
+
+			frozen, err := s.keeper.GetFrozenHolder(ctx, s.contractID, tc.frozen)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.incoming, frozen.Incoming)
+
+			err = s.keeper.Freeze(ctx, s.contractID, s.vendor, tc.frozen, tc.incoming)
+			s.Require().ErrorIs(err, token.ErrHolderFrozen)
+
+			err = s.keeper.Send(ctx, s.contractID, s.vendor, s.operator, sdk.OneInt())
+			s.Require().ErrorIs(err, tc.err)
+
+			// other contracts are not affected
+			err = s.keeper.Send(ctx, s.unmintableContractId, s.vendor, s.operator, sdk.OneInt())
+			s.Require().NoError(err)
+
+			err = s.keeper.Unfreeze(ctx, s.contractID, s.vendor, tc.frozen)
+			s.Require().NoError(err)
+
+			_, err = s.keeper.GetFrozenHolder(ctx, s.contractID, tc.frozen)
+			s.Require().ErrorIs(err, token.ErrHolderNotFrozen)
+
+			err = s.keeper.Unfreeze(ctx, s.contractID, s.vendor, tc.frozen)
+			s.Require().ErrorIs(err, token.ErrHolderNotFrozen)
+
+			err = s.keeper.Send(ctx, s.contractID, s.vendor, s.operator, sdk.OneInt())
+			s.Require().NoError(err)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestAuthorizeOperator() {
 	userDescriptions := map[string]string{
 		s.vendor.String():   "vendor",
@@ -102,3 +163,33 @@ func (s *KeeperTestSuite) TestRevokeOperator() {
 		}
 	}
 }
+
+func (s *KeeperTestSuite) TestAllowance() {
+	ctx, _ := s.ctx.CacheContext()
+
+	s.Require().Equal(sdk.ZeroInt(), s.keeper.GetAllowance(ctx, s.contractID, s.customer, s.stranger))
+
+	s.keeper.Approve(ctx, s.contractID, s.customer, s.stranger, s.balance)
+	s.Require().Equal(s.balance, s.keeper.GetAllowance(ctx, s.contractID, s.customer, s.stranger))
+
+	s.keeper.IncreaseAllowance(ctx, s.contractID, s.customer, s.stranger, sdk.OneInt())
+	s.Require().Equal(s.balance.Add(sdk.OneInt()), s.keeper.GetAllowance(ctx, s.contractID, s.customer, s.stranger))
+
+	err := s.keeper.DecreaseAllowance(ctx, s.contractID, s.customer, s.stranger, s.balance.Add(sdk.NewInt(2)))
+	s.Require().ErrorIs(err, token.ErrInsufficientAllowance)
+
+	err = s.keeper.DecreaseAllowance(ctx, s.contractID, s.customer, s.stranger, sdk.OneInt())
+	s.Require().NoError(err)
+	s.Require().Equal(s.balance, s.keeper.GetAllowance(ctx, s.contractID, s.customer, s.stranger))
+
+	err = s.keeper.SpendAllowance(ctx, s.contractID, s.customer, s.stranger, s.balance.Add(sdk.OneInt()))
+	s.Require().ErrorIs(err, token.ErrInsufficientAllowance)
+
+	err = s.keeper.SpendAllowance(ctx, s.contractID, s.customer, s.stranger, s.balance)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.ZeroInt(), s.keeper.GetAllowance(ctx, s.contractID, s.customer, s.stranger))
+
+	// the allowance is used up
+	err = s.keeper.SpendAllowance(ctx, s.contractID, s.customer, s.stranger, sdk.OneInt())
+	s.Require().ErrorIs(err, token.ErrTokenNotApproved)
+}
@@ -18,6 +18,7 @@ func (k Keeper) Issue(ctx sdk.Context, class token.Contract, owner, to sdk.AccAd
 		Meta:       class.Meta,
 		Decimals:   class.Decimals,
 		Mintable:   class.Mintable,
+		MaxSupply:  class.MaxSupply,
 	}
 	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
 		panic(err)
@@ -25,6 +26,8 @@ func (k Keeper) Issue(ctx sdk.Context, class token.Contract, owner, to sdk.AccAd
 
 	permissions := []token.Permission{
 		token.PermissionModify,
+		token.PermissionPause,
+		token.PermissionFreeze,
 	}
 	if class.Mintable {
 		permissions = append(permissions,
@@ -106,6 +109,17 @@ func (k Keeper) mint(ctx sdk.Context, contractID string, grantee, to sdk.AccAddr
 		return token.ErrTokenNoPermission.Wrap(err.Error())
 	}
 
+	class, err := k.GetClass(ctx, contractID)
+	if err != nil {
+		return err
+	}
+	if token.HasMaxSupply(class.MaxSupply) {
+		supply := k.GetSupply(ctx, contractID)
+		if supply.Add(amount).GT(class.MaxSupply) {
+			return token.ErrSupplyOverflow.Wrapf("cannot mint %s over max supply %s; current supply: %s", amount, class.MaxSupply, supply)
+		}
+	}
+
 	k.mintToken(ctx, contractID, to, amount)
 
 	return nil
@@ -144,6 +158,9 @@ func (k Keeper) burn(ctx sdk.Context, contractID string, from sdk.AccAddress, am
 	if _, err := k.GetGrant(ctx, contractID, from, token.PermissionBurn); err != nil {
 		return token.ErrTokenNoPermission.Wrap(err.Error())
 	}
+	if err := k.assertNotPaused(ctx, contractID); err != nil {
+		return err
+	}
 
 	if err := k.burnToken(ctx, contractID, from, amount); err != nil {
 		return err
@@ -177,6 +194,9 @@ func (k Keeper) operatorBurn(ctx sdk.Context, contractID string, operator, from
 	if _, err := k.GetAuthorization(ctx, contractID, from, operator); err != nil {
 		return token.ErrTokenNotApproved.Wrap(err.Error())
 	}
+	if err := k.assertNotPaused(ctx, contractID); err != nil {
+		return err
+	}
 
 	if err := k.burnToken(ctx, contractID, from, amount); err != nil {
 		return err
@@ -296,6 +316,68 @@ func (k Keeper) modify(ctx sdk.Context, contractID string, changes []token.Attri
 	return nil
 }
 
+func (k Keeper) Pause(ctx sdk.Context, contractID string, operator sdk.AccAddress) error {
+	if err := k.setPaused(ctx, contractID, true); err != nil {
+		return err
+	}
+
+	event := token.EventPaused{
+		ContractId: contractID,
+		Operator:   operator.String(),
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		panic(err)
+	}
+	return nil
+}
+
+func (k Keeper) Unpause(ctx sdk.Context, contractID string, operator sdk.AccAddress) error {
+	if err := k.setPaused(ctx, contractID, false); err != nil {
+		return err
+	}
+
+	event := token.EventUnpaused{
+		ContractId: contractID,
+		Operator:   operator.String(),
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		panic(err)
+	}
+	return nil
+}
+
+func (k Keeper) setPaused(ctx sdk.Context, contractID string, paused bool) error {
+	class, err := k.GetClass(ctx, contractID)
+	if err != nil {
+		return err
+	}
+
+	if class.Paused == paused {
+		if paused {
+			return token.ErrTokenPaused.Wrap(contractID)
+		}
+		return token.ErrTokenNotPaused.Wrap(contractID)
+	}
+
+	class.Paused = paused
+	k.setClass(ctx, *class)
+
+	return nil
+}
+
+// assertNotPaused returns an error if the transfers and burns of the contract are suspended.
+func (k Keeper) assertNotPaused(ctx sdk.Context, contractID string) error {
+	class, err := k.GetClass(ctx, contractID)
+	if err != nil {
+		return err
+	}
+	if class.Paused {
+		return token.ErrTokenPaused.Wrap(contractID)
+	}
+
+	return nil
+}
+
 func (k Keeper) Grant(ctx sdk.Context, contractID string, granter, grantee sdk.AccAddress, permission token.Permission) {
 	k.grant(ctx, contractID, grantee, permission)
 
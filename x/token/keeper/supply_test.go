@@ -24,6 +24,8 @@ func (s *KeeperTestSuite) TestIssue() {
 		s.Require().Nil(s.keeper.GetGrant(ctx, contractID, s.vendor, permission))
 	}
 	s.Require().NotNil(s.keeper.GetGrant(ctx, contractID, s.vendor, token.PermissionModify))
+	s.Require().NotNil(s.keeper.GetGrant(ctx, contractID, s.vendor, token.PermissionPause))
+	s.Require().NotNil(s.keeper.GetGrant(ctx, contractID, s.vendor, token.PermissionFreeze))
 }
 
 func (s *KeeperTestSuite) TestMint() {
@@ -53,6 +55,34 @@ func (s *KeeperTestSuite) TestMint() {
 	}
 }
 
+func (s *KeeperTestSuite) TestMintOverMaxSupply() {
+	ctx, _ := s.ctx.CacheContext()
+
+	class := token.Contract{
+		Name:      "Capped",
+		Symbol:    "CAP",
+		Mintable:  true,
+		MaxSupply: s.balance,
+	}
+	contractID := s.keeper.Issue(ctx, class, s.vendor, s.vendor, s.balance.Sub(sdk.OneInt()))
+
+	// the supply reaches max supply
+	err := s.keeper.Mint(ctx, contractID, s.vendor, s.customer, sdk.OneInt())
+	s.Require().NoError(err)
+	s.Require().Equal(s.balance, s.keeper.GetSupply(ctx, contractID))
+
+	err = s.keeper.Mint(ctx, contractID, s.vendor, s.customer, sdk.OneInt())
+	s.Require().ErrorIs(err, token.ErrSupplyOverflow)
+
+	// burning frees room under max supply
+	err = s.keeper.Burn(ctx, contractID, s.vendor, sdk.OneInt())
+	s.Require().NoError(err)
+
+	err = s.keeper.Mint(ctx, contractID, s.vendor, s.customer, sdk.OneInt())
+	s.Require().NoError(err)
+	s.Require().Equal(s.balance, s.keeper.GetSupply(ctx, contractID))
+}
+
 func (s *KeeperTestSuite) TestBurn() {
 	testCases := map[string]struct {
 		from   sdk.AccAddress
@@ -152,3 +182,38 @@ func (s *KeeperTestSuite) TestModify() {
 	s.Require().Equal(changes[1].Value, class.Uri)
 	s.Require().Equal(changes[2].Value, class.Meta)
 }
+
+func (s *KeeperTestSuite) TestPause() {
+	ctx, _ := s.ctx.CacheContext()
+
+	err := s.keeper.Pause(ctx, s.contractID, s.vendor)
+	s.Require().NoError(err)
+
+	class, err := s.keeper.GetClass(ctx, s.contractID)
+	s.Require().NoError(err)
+	s.Require().True(class.Paused)
+
+	err = s.keeper.Pause(ctx, s.contractID, s.vendor)
+	s.Require().ErrorIs(err, token.ErrTokenPaused)
+
+	// transfers and burns are suspended
+	err = s.keeper.Send(ctx, s.contractID, s.customer, s.stranger, sdk.OneInt())
+	s.Require().ErrorIs(err, token.ErrTokenPaused)
+	err = s.keeper.Burn(ctx, s.contractID, s.vendor, sdk.OneInt())
+	s.Require().ErrorIs(err, token.ErrTokenPaused)
+	err = s.keeper.OperatorBurn(ctx, s.contractID, s.operator, s.customer, sdk.OneInt())
+	s.Require().ErrorIs(err, token.ErrTokenPaused)
+
+	// mint is not affected
+	err = s.keeper.Mint(ctx, s.contractID, s.vendor, s.stranger, sdk.OneInt())
+	s.Require().NoError(err)
+
+	err = s.keeper.Unpause(ctx, s.contractID, s.vendor)
+	s.Require().NoError(err)
+
+	err = s.keeper.Unpause(ctx, s.contractID, s.vendor)
+	s.Require().ErrorIs(err, token.ErrTokenNotPaused)
+
+	err = s.keeper.Send(ctx, s.contractID, s.customer, s.stranger, sdk.OneInt())
+	s.Require().NoError(err)
+}
@@ -297,16 +297,20 @@ func TestMsgIssue(t *testing.T) {
 		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
 	}
 
+	oneInt := sdk.OneInt()
+	negativeInt := sdk.NewInt(-1)
+
 	testCases := map[string]struct {
-		owner    sdk.AccAddress
-		to       sdk.AccAddress
-		name     string
-		symbol   string
-		imageUri string
-		meta     string
-		decimals int32
-		amount   sdk.Int
-		err      error
+		owner     sdk.AccAddress
+		to        sdk.AccAddress
+		name      string
+		symbol    string
+		imageUri  string
+		meta      string
+		decimals  int32
+		amount    sdk.Int
+		maxSupply *sdk.Int
+		err       error
 	}{
 		"valid msg": {
 			owner:    addrs[0],
@@ -318,6 +322,35 @@ func TestMsgIssue(t *testing.T) {
 			decimals: 8,
 			amount:   sdk.OneInt(),
 		},
+		"valid msg with max supply": {
+			owner:     addrs[0],
+			to:        addrs[1],
+			name:      "test",
+			symbol:    "TT",
+			decimals:  8,
+			amount:    sdk.OneInt(),
+			maxSupply: &oneInt,
+		},
+		"invalid max supply": {
+			owner:     addrs[0],
+			to:        addrs[1],
+			name:      "test",
+			symbol:    "TT",
+			decimals:  8,
+			amount:    sdk.OneInt(),
+			maxSupply: &negativeInt,
+			err:       token.ErrInvalidAmount,
+		},
+		"amount exceeds max supply": {
+			owner:     addrs[0],
+			to:        addrs[1],
+			name:      "test",
+			symbol:    "TT",
+			decimals:  8,
+			amount:    sdk.NewInt(2),
+			maxSupply: &oneInt,
+			err:       token.ErrSupplyOverflow,
+		},
 		"invalid owner": {
 			to:       addrs[1],
 			name:     "test",
@@ -473,14 +506,15 @@ func TestMsgIssue(t *testing.T) {
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
 			msg := token.MsgIssue{
-				Owner:    tc.owner.String(),
-				To:       tc.to.String(),
-				Name:     tc.name,
-				Symbol:   tc.symbol,
-				Uri:      tc.imageUri,
-				Meta:     tc.meta,
-				Decimals: tc.decimals,
-				Amount:   tc.amount,
+				Owner:     tc.owner.String(),
+				To:        tc.to.String(),
+				Name:      tc.name,
+				Symbol:    tc.symbol,
+				Uri:       tc.imageUri,
+				Meta:      tc.meta,
+				Decimals:  tc.decimals,
+				Amount:    tc.amount,
+				MaxSupply: tc.maxSupply,
 			}
 
 			err := msg.ValidateBasic()
@@ -898,92 +932,853 @@ func TestMsgRevokePermission(t *testing.T) {
 	}
 }
 
-func TestAminoJSON(t *testing.T) {
-	tx := legacytx.StdTx{}
-	contractId := "deadbeef"
+func TestMsgPause(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 1)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
 
-	addrs := make([]sdk.AccAddress, 3)
+	testCases := map[string]struct {
+		contractID string
+		operator   sdk.AccAddress
+		err        error
+	}{
+		"valid msg": {
+			contractID: "deadbeef",
+			operator:   addrs[0],
+		},
+		"invalid contract id": {
+			operator: addrs[0],
+			err:      class.ErrInvalidContractID,
+		},
+		"invalid operator": {
+			contractID: "deadbeef",
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := token.MsgPause{
+				ContractId: tc.contractID,
+				Operator:   tc.operator.String(),
+			}
+
+			err := msg.ValidateBasic()
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{tc.operator}, msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgUnpause(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 1)
 	for i := range addrs {
 		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
 	}
 
 	testCases := map[string]struct {
-		msg          legacytx.LegacyMsg
-		expectedType string
-		expected     string
+		contractID string
+		operator   sdk.AccAddress
+		err        error
 	}{
-		"MsgSend": {
-			&token.MsgSend{
-				ContractId: contractId,
-				From:       addrs[0].String(),
-				To:         addrs[1].String(),
-				Amount:     sdk.OneInt(),
-			},
-			"/lbm.token.v1.MsgSend",
-			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgSend\",\"value\":{\"amount\":\"1\",\"contract_id\":\"deadbeef\",\"from\":\"%s\",\"to\":\"%s\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String(), addrs[1].String()),
+		"valid msg": {
+			contractID: "deadbeef",
+			operator:   addrs[0],
 		},
-		"MsgOperatorSend": {
-			&token.MsgOperatorSend{
-				ContractId: contractId,
-				Operator:   addrs[0].String(),
-				From:       addrs[1].String(),
-				To:         addrs[2].String(),
-				Amount:     sdk.OneInt(),
-			},
-			"/lbm.token.v1.MsgOperatorSend",
-			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgOperatorSend\",\"value\":{\"amount\":\"1\",\"contract_id\":\"deadbeef\",\"from\":\"%s\",\"operator\":\"%s\",\"to\":\"%s\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[1].String(), addrs[0].String(), addrs[2].String()),
+		"invalid contract id": {
+			operator: addrs[0],
+			err:      class.ErrInvalidContractID,
 		},
-		"MsgRevokeOperator": {
-			&token.MsgRevokeOperator{
-				ContractId: contractId,
-				Holder:     addrs[0].String(),
-				Operator:   addrs[1].String(),
-			},
-			"/lbm.token.v1.MsgRevokeOperator",
-			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/token/MsgRevokeOperator\",\"value\":{\"contract_id\":\"deadbeef\",\"holder\":\"%s\",\"operator\":\"%s\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String(), addrs[1].String()),
+		"invalid operator": {
+			contractID: "deadbeef",
+			err:        sdkerrors.ErrInvalidAddress,
 		},
-		"MsgAuthorizeOperator": {
-			&token.MsgAuthorizeOperator{
-				ContractId: contractId,
-				Holder:     addrs[0].String(),
-				Operator:   addrs[1].String(),
-			},
-			"/lbm.token.v1.MsgAuthorizeOperator",
-			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/token/MsgAuthorizeOperator\",\"value\":{\"contract_id\":\"deadbeef\",\"holder\":\"%s\",\"operator\":\"%s\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String(), addrs[1].String()),
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := token.MsgUnpause{
+				ContractId: tc.contractID,
+				Operator:   tc.operator.String(),
+			}
+
+			err := msg.ValidateBasic()
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{tc.operator}, msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgFreeze(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 2)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := map[string]struct {
+		contractID string
+		operator   sdk.AccAddress
+		holder     sdk.AccAddress
+		err        error
+	}{
+		"valid msg": {
+			contractID: "deadbeef",
+			operator:   addrs[0],
+			holder:     addrs[1],
 		},
-		"MsgIssue": {
-			&token.MsgIssue{
-				Name:     "Test Name",
-				Symbol:   "LN",
-				Uri:      "http://image.url",
-				Meta:     "This is test",
-				Decimals: 6,
-				Mintable: false,
-				Owner:    addrs[0].String(),
-				To:       addrs[1].String(),
-				Amount:   sdk.NewInt(1000000),
-			},
-			"/lbm.token.v1.MsgIssue",
-			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgIssue\",\"value\":{\"amount\":\"1000000\",\"decimals\":6,\"meta\":\"This is test\",\"name\":\"Test Name\",\"owner\":\"%s\",\"symbol\":\"LN\",\"to\":\"%s\",\"uri\":\"http://image.url\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String(), addrs[1].String()),
+		"invalid contract id": {
+			operator: addrs[0],
+			holder:   addrs[1],
+			err:      class.ErrInvalidContractID,
 		},
-		"MsgGrantPermission": {
-			&token.MsgGrantPermission{
-				ContractId: contractId,
-				From:       addrs[0].String(),
-				To:         addrs[1].String(),
-				Permission: token.LegacyPermissionMint.String(),
-			},
-			"/lbm.token.v1.MsgGrantPermission",
-			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/token/MsgGrantPermission\",\"value\":{\"contract_id\":\"deadbeef\",\"from\":\"%s\",\"permission\":\"mint\",\"to\":\"%s\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String(), addrs[1].String()),
+		"invalid operator": {
+			contractID: "deadbeef",
+			holder:     addrs[1],
+			err:        sdkerrors.ErrInvalidAddress,
 		},
-		"MsgRevokePermission": {
-			&token.MsgRevokePermission{
-				ContractId: contractId,
-				From:       addrs[0].String(),
-				Permission: token.LegacyPermissionMint.String(),
-			},
-			"/lbm.token.v1.MsgRevokePermission",
-			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/token/MsgRevokePermission\",\"value\":{\"contract_id\":\"deadbeef\",\"from\":\"%s\",\"permission\":\"mint\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String()),
+		"invalid holder": {
+			contractID: "deadbeef",
+			operator:   addrs[0],
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := token.MsgFreeze{
+				ContractId: tc.contractID,
+				Operator:   tc.operator.String(),
+				Holder:     tc.holder.String(),
+			}
+
+			err := msg.ValidateBasic()
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{tc.operator}, msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgUnfreeze(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 2)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := map[string]struct {
+		contractID string
+		operator   sdk.AccAddress
+		holder     sdk.AccAddress
+		err        error
+	}{
+		"valid msg": {
+			contractID: "deadbeef",
+			operator:   addrs[0],
+			holder:     addrs[1],
+		},
+		"invalid contract id": {
+			operator: addrs[0],
+			holder:   addrs[1],
+			err:      class.ErrInvalidContractID,
+		},
+		"invalid operator": {
+			contractID: "deadbeef",
+			holder:     addrs[1],
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"invalid holder": {
+			contractID: "deadbeef",
+			operator:   addrs[0],
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := token.MsgUnfreeze{
+				ContractId: tc.contractID,
+				Operator:   tc.operator.String(),
+				Holder:     tc.holder.String(),
+			}
+
+			err := msg.ValidateBasic()
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{tc.operator}, msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgApprove(t *testing.T) {
+	addrs := make([]string, 2)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	}
+
+	testCases := map[string]struct {
+		contractID string
+		holder     string
+		spender    string
+		amount     sdk.Int
+		err        error
+	}{
+		"valid msg": {
+			contractID: "deadbeef",
+			holder:     addrs[0],
+			spender:    addrs[1],
+			amount:     sdk.OneInt(),
+		},
+		"invalid contract id": {
+			holder:  addrs[0],
+			spender: addrs[1],
+			amount:  sdk.OneInt(),
+			err:     class.ErrInvalidContractID,
+		},
+		"invalid holder": {
+			contractID: "deadbeef",
+			spender:    addrs[1],
+			amount:     sdk.OneInt(),
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"invalid spender": {
+			contractID: "deadbeef",
+			holder:     addrs[0],
+			amount:     sdk.OneInt(),
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"spender and holder should be different": {
+			contractID: "deadbeef",
+			holder:     addrs[0],
+			spender:    addrs[0],
+			amount:     sdk.OneInt(),
+			err:        token.ErrApproverProxySame,
+		},
+		"zero amount": {
+			contractID: "deadbeef",
+			holder:     addrs[0],
+			spender:    addrs[1],
+			amount:     sdk.ZeroInt(),
+		},
+		"negative amount": {
+			contractID: "deadbeef",
+			holder:     addrs[0],
+			spender:    addrs[1],
+			amount:     sdk.NewInt(-1),
+			err:        token.ErrInvalidAmount,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := token.MsgApprove{
+				ContractId: tc.contractID,
+				Holder:     tc.holder,
+				Spender:    tc.spender,
+				Amount:     tc.amount,
+			}
+
+			err := msg.ValidateBasic()
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{sdk.MustAccAddressFromBech32(tc.holder)}, msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgIncreaseAllowance(t *testing.T) {
+	addrs := make([]string, 2)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	}
+
+	testCases := map[string]struct {
+		contractID string
+		holder     string
+		spender    string
+		amount     sdk.Int
+		err        error
+	}{
+		"valid msg": {
+			contractID: "deadbeef",
+			holder:     addrs[0],
+			spender:    addrs[1],
+			amount:     sdk.OneInt(),
+		},
+		"invalid contract id": {
+			holder:  addrs[0],
+			spender: addrs[1],
+			amount:  sdk.OneInt(),
+			err:     class.ErrInvalidContractID,
+		},
+		"invalid holder": {
+			contractID: "deadbeef",
+			spender:    addrs[1],
+			amount:     sdk.OneInt(),
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"invalid spender": {
+			contractID: "deadbeef",
+			holder:     addrs[0],
+			amount:     sdk.OneInt(),
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"spender and holder should be different": {
+			contractID: "deadbeef",
+			holder:     addrs[0],
+			spender:    addrs[0],
+			amount:     sdk.OneInt(),
+			err:        token.ErrApproverProxySame,
+		},
+		"zero amount": {
+			contractID: "deadbeef",
+			holder:     addrs[0],
+			spender:    addrs[1],
+			amount:     sdk.ZeroInt(),
+			err:        token.ErrInvalidAmount,
+		},
+		"negative amount": {
+			contractID: "deadbeef",
+			holder:     addrs[0],
+			spender:    addrs[1],
+			amount:     sdk.NewInt(-1),
+			err:        token.ErrInvalidAmount,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := token.MsgIncreaseAllowance{
+				ContractId: tc.contractID,
+				Holder:     tc.holder,
+				Spender:    tc.spender,
+				Amount:     tc.amount,
+			}
+
+			err := msg.ValidateBasic()
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{sdk.MustAccAddressFromBech32(tc.holder)}, msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgDecreaseAllowance(t *testing.T) {
+	addrs := make([]string, 2)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	}
+
+	testCases := map[string]struct {
+		contractID string
+		holder     string
+		spender    string
+		amount     sdk.Int
+		err        error
+	}{
+		"valid msg": {
+			contractID: "deadbeef",
+			holder:     addrs[0],
+			spender:    addrs[1],
+			amount:     sdk.OneInt(),
+		},
+		"invalid contract id": {
+			holder:  addrs[0],
+			spender: addrs[1],
+			amount:  sdk.OneInt(),
+			err:     class.ErrInvalidContractID,
+		},
+		"invalid holder": {
+			contractID: "deadbeef",
+			spender:    addrs[1],
+			amount:     sdk.OneInt(),
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"invalid spender": {
+			contractID: "deadbeef",
+			holder:     addrs[0],
+			amount:     sdk.OneInt(),
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"spender and holder should be different": {
+			contractID: "deadbeef",
+			holder:     addrs[0],
+			spender:    addrs[0],
+			amount:     sdk.OneInt(),
+			err:        token.ErrApproverProxySame,
+		},
+		"zero amount": {
+			contractID: "deadbeef",
+			holder:     addrs[0],
+			spender:    addrs[1],
+			amount:     sdk.ZeroInt(),
+			err:        token.ErrInvalidAmount,
+		},
+		"negative amount": {
+			contractID: "deadbeef",
+			holder:     addrs[0],
+			spender:    addrs[1],
+			amount:     sdk.NewInt(-1),
+			err:        token.ErrInvalidAmount,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := token.MsgDecreaseAllowance{
+				ContractId: tc.contractID,
+				Holder:     tc.holder,
+				Spender:    tc.spender,
+				Amount:     tc.amount,
+			}
+
+			err := msg.ValidateBasic()
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{sdk.MustAccAddressFromBech32(tc.holder)}, msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgRegisterDenom(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 1)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := map[string]struct {
+		contractID string
+		owner      sdk.AccAddress
+		err        error
+	}{
+		"valid msg": {
+			contractID: "deadbeef",
+			owner:      addrs[0],
+		},
+		"invalid contract id": {
+			owner: addrs[0],
+			err:   class.ErrInvalidContractID,
+		},
+		"invalid owner": {
+			contractID: "deadbeef",
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := token.MsgRegisterDenom{
+				ContractId: tc.contractID,
+				Owner:      tc.owner.String(),
+			}
+
+			err := msg.ValidateBasic()
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{tc.owner}, msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgLock(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 1)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := map[string]struct {
+		contractID string
+		holder     sdk.AccAddress
+		amount     sdk.Int
+		err        error
+	}{
+		"valid msg": {
+			contractID: "deadbeef",
+			holder:     addrs[0],
+			amount:     sdk.OneInt(),
+		},
+		"invalid contract id": {
+			holder: addrs[0],
+			amount: sdk.OneInt(),
+			err:    class.ErrInvalidContractID,
+		},
+		"invalid holder": {
+			contractID: "deadbeef",
+			amount:     sdk.OneInt(),
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"zero amount": {
+			contractID: "deadbeef",
+			holder:     addrs[0],
+			amount:     sdk.ZeroInt(),
+			err:        token.ErrInvalidAmount,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := token.MsgLock{
+				ContractId: tc.contractID,
+				Holder:     tc.holder.String(),
+				Amount:     tc.amount,
+			}
+
+			err := msg.ValidateBasic()
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{tc.holder}, msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgUnlock(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 1)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := map[string]struct {
+		contractID string
+		holder     sdk.AccAddress
+		amount     sdk.Int
+		err        error
+	}{
+		"valid msg": {
+			contractID: "deadbeef",
+			holder:     addrs[0],
+			amount:     sdk.OneInt(),
+		},
+		"invalid contract id": {
+			holder: addrs[0],
+			amount: sdk.OneInt(),
+			err:    class.ErrInvalidContractID,
+		},
+		"invalid holder": {
+			contractID: "deadbeef",
+			amount:     sdk.OneInt(),
+			err:        sdkerrors.ErrInvalidAddress,
+		},
+		"zero amount": {
+			contractID: "deadbeef",
+			holder:     addrs[0],
+			amount:     sdk.ZeroInt(),
+			err:        token.ErrInvalidAmount,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := token.MsgUnlock{
+				ContractId: tc.contractID,
+				Holder:     tc.holder.String(),
+				Amount:     tc.amount,
+			}
+
+			err := msg.ValidateBasic()
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{tc.holder}, msg.GetSigners())
+		})
+	}
+}
+
+func TestMsgBatchSend(t *testing.T) {
+	addrs := make([]sdk.AccAddress, 3)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := map[string]struct {
+		contractID string
+		from       sdk.AccAddress
+		entries    []token.BatchSendEntry
+		err        error
+	}{
+		"valid msg": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			entries: []token.BatchSendEntry{
+				{To: addrs[1].String(), Amount: sdk.OneInt()},
+				{To: addrs[2].String(), Amount: sdk.OneInt()},
+			},
+		},
+		"invalid contract id": {
+			from: addrs[0],
+			entries: []token.BatchSendEntry{
+				{To: addrs[1].String(), Amount: sdk.OneInt()},
+			},
+			err: class.ErrInvalidContractID,
+		},
+		"invalid from": {
+			contractID: "deadbeef",
+			entries: []token.BatchSendEntry{
+				{To: addrs[1].String(), Amount: sdk.OneInt()},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		"empty entries": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			err:        sdkerrors.ErrInvalidRequest,
+		},
+		"invalid to": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			entries: []token.BatchSendEntry{
+				{To: addrs[1].String(), Amount: sdk.OneInt()},
+				{Amount: sdk.OneInt()},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		"zero amount": {
+			contractID: "deadbeef",
+			from:       addrs[0],
+			entries: []token.BatchSendEntry{
+				{To: addrs[1].String(), Amount: sdk.ZeroInt()},
+			},
+			err: token.ErrInvalidAmount,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			msg := token.MsgBatchSend{
+				ContractId: tc.contractID,
+				From:       tc.from.String(),
+				Entries:    tc.entries,
+			}
+
+			err := msg.ValidateBasic()
+			require.ErrorIs(t, err, tc.err)
+			if tc.err != nil {
+				return
+			}
+
+			require.Equal(t, []sdk.AccAddress{tc.from}, msg.GetSigners())
+		})
+	}
+}
+
+func TestAminoJSON(t *testing.T) {
+	tx := legacytx.StdTx{}
+	contractId := "deadbeef"
+
+	addrs := make([]sdk.AccAddress, 3)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := map[string]struct {
+		msg          legacytx.LegacyMsg
+		expectedType string
+		expected     string
+	}{
+		"MsgSend": {
+			&token.MsgSend{
+				ContractId: contractId,
+				From:       addrs[0].String(),
+				To:         addrs[1].String(),
+				Amount:     sdk.OneInt(),
+			},
+			"/lbm.token.v1.MsgSend",
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgSend\",\"value\":{\"amount\":\"1\",\"contract_id\":\"deadbeef\",\"from\":\"%s\",\"to\":\"%s\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String(), addrs[1].String()),
+		},
+		"MsgOperatorSend": {
+			&token.MsgOperatorSend{
+				ContractId: contractId,
+				Operator:   addrs[0].String(),
+				From:       addrs[1].String(),
+				To:         addrs[2].String(),
+				Amount:     sdk.OneInt(),
+			},
+			"/lbm.token.v1.MsgOperatorSend",
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgOperatorSend\",\"value\":{\"amount\":\"1\",\"contract_id\":\"deadbeef\",\"from\":\"%s\",\"operator\":\"%s\",\"to\":\"%s\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[1].String(), addrs[0].String(), addrs[2].String()),
+		},
+		"MsgRevokeOperator": {
+			&token.MsgRevokeOperator{
+				ContractId: contractId,
+				Holder:     addrs[0].String(),
+				Operator:   addrs[1].String(),
+			},
+			"/lbm.token.v1.MsgRevokeOperator",
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/token/MsgRevokeOperator\",\"value\":{\"contract_id\":\"deadbeef\",\"holder\":\"%s\",\"operator\":\"%s\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String(), addrs[1].String()),
+		},
+		"MsgAuthorizeOperator": {
+			&token.MsgAuthorizeOperator{
+				ContractId: contractId,
+				Holder:     addrs[0].String(),
+				Operator:   addrs[1].String(),
+			},
+			"/lbm.token.v1.MsgAuthorizeOperator",
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/token/MsgAuthorizeOperator\",\"value\":{\"contract_id\":\"deadbeef\",\"holder\":\"%s\",\"operator\":\"%s\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String(), addrs[1].String()),
+		},
+		"MsgIssue": {
+			&token.MsgIssue{
+				Name:     "Test Name",
+				Symbol:   "LN",
+				Uri:      "http://image.url",
+				Meta:     "This is test",
+				Decimals: 6,
+				Mintable: false,
+				Owner:    addrs[0].String(),
+				To:       addrs[1].String(),
+				Amount:   sdk.NewInt(1000000),
+			},
+			"/lbm.token.v1.MsgIssue",
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/MsgIssue\",\"value\":{\"amount\":\"1000000\",\"decimals\":6,\"meta\":\"This is test\",\"name\":\"Test Name\",\"owner\":\"%s\",\"symbol\":\"LN\",\"to\":\"%s\",\"uri\":\"http://image.url\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String(), addrs[1].String()),
+		},
+		"MsgGrantPermission": {
+			&token.MsgGrantPermission{
+				ContractId: contractId,
+				From:       addrs[0].String(),
+				To:         addrs[1].String(),
+				Permission: token.LegacyPermissionMint.String(),
+			},
+			"/lbm.token.v1.MsgGrantPermission",
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/token/MsgGrantPermission\",\"value\":{\"contract_id\":\"deadbeef\",\"from\":\"%s\",\"permission\":\"mint\",\"to\":\"%s\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String(), addrs[1].String()),
+		},
+		"MsgRevokePermission": {
+			&token.MsgRevokePermission{
+				ContractId: contractId,
+				From:       addrs[0].String(),
+				Permission: token.LegacyPermissionMint.String(),
+			},
+			"/lbm.token.v1.MsgRevokePermission",
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/token/MsgRevokePermission\",\"value\":{\"contract_id\":\"deadbeef\",\"from\":\"%s\",\"permission\":\"mint\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String()),
+		},
+		"MsgPause": {
+			&token.MsgPause{
+				ContractId: contractId,
+				Operator:   addrs[0].String(),
+			},
+			"/lbm.token.v1.MsgPause",
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/token/MsgPause\",\"value\":{\"contract_id\":\"deadbeef\",\"operator\":\"%s\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String()),
+		},
+		"MsgUnpause": {
+			&token.MsgUnpause{
+				ContractId: contractId,
+				Operator:   addrs[0].String(),
+			},
+			"/lbm.token.v1.MsgUnpause",
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/token/MsgUnpause\",\"value\":{\"contract_id\":\"deadbeef\",\"operator\":\"%s\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String()),
+		},
+		"MsgFreeze": {
+			&token.MsgFreeze{
+				ContractId: contractId,
+				Operator:   addrs[0].String(),
+				Holder:     addrs[1].String(),
+				Incoming:   true,
+			},
+			"/lbm.token.v1.MsgFreeze",
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/token/MsgFreeze\",\"value\":{\"contract_id\":\"deadbeef\",\"holder\":\"%s\",\"incoming\":true,\"operator\":\"%s\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[1].String(), addrs[0].String()),
+		},
+		"MsgUnfreeze": {
+			&token.MsgUnfreeze{
+				ContractId: contractId,
+				Operator:   addrs[0].String(),
+				Holder:     addrs[1].String(),
+			},
+			"/lbm.token.v1.MsgUnfreeze",
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/token/MsgUnfreeze\",\"value\":{\"contract_id\":\"deadbeef\",\"holder\":\"%s\",\"operator\":\"%s\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[1].String(), addrs[0].String()),
+		},
+		"MsgApprove": {
+			&token.MsgApprove{
+				ContractId: contractId,
+				Holder:     addrs[0].String(),
+				Spender:    addrs[1].String(),
+				Amount:     sdk.OneInt(),
+			},
+			"/lbm.token.v1.MsgApprove",
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/token/MsgApprove\",\"value\":{\"amount\":\"1\",\"contract_id\":\"deadbeef\",\"holder\":\"%s\",\"spender\":\"%s\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String(), addrs[1].String()),
+		},
+		"MsgIncreaseAllowance": {
+			&token.MsgIncreaseAllowance{
+				ContractId: contractId,
+				Holder:     addrs[0].String(),
+				Spender:    addrs[1].String(),
+				Amount:     sdk.OneInt(),
+			},
+			"/lbm.token.v1.MsgIncreaseAllowance",
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/token/MsgIncreaseAllowance\",\"value\":{\"amount\":\"1\",\"contract_id\":\"deadbeef\",\"holder\":\"%s\",\"spender\":\"%s\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String(), addrs[1].String()),
+		},
+		"MsgDecreaseAllowance": {
+			&token.MsgDecreaseAllowance{
+				ContractId: contractId,
+				Holder:     addrs[0].String(),
+				Spender:    addrs[1].String(),
+				Amount:     sdk.OneInt(),
+			},
+			"/lbm.token.v1.MsgDecreaseAllowance",
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/token/MsgDecreaseAllowance\",\"value\":{\"amount\":\"1\",\"contract_id\":\"deadbeef\",\"holder\":\"%s\",\"spender\":\"%s\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String(), addrs[1].String()),
+		},
+		"MsgRegisterDenom": {
+			&token.MsgRegisterDenom{
+				ContractId: contractId,
+				Owner:      addrs[0].String(),
+			},
+			"/lbm.token.v1.MsgRegisterDenom",
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/token/MsgRegisterDenom\",\"value\":{\"contract_id\":\"deadbeef\",\"owner\":\"%s\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String()),
+		},
+		"MsgLock": {
+			&token.MsgLock{
+				ContractId: contractId,
+				Holder:     addrs[0].String(),
+				Amount:     sdk.OneInt(),
+			},
+			"/lbm.token.v1.MsgLock",
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/token/MsgLock\",\"value\":{\"amount\":\"1\",\"contract_id\":\"deadbeef\",\"holder\":\"%s\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String()),
+		},
+		"MsgUnlock": {
+			&token.MsgUnlock{
+				ContractId: contractId,
+				Holder:     addrs[0].String(),
+				Amount:     sdk.OneInt(),
+			},
+			"/lbm.token.v1.MsgUnlock",
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/token/MsgUnlock\",\"value\":{\"amount\":\"1\",\"contract_id\":\"deadbeef\",\"holder\":\"%s\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[0].String()),
+		},
+		"MsgBatchSend": {
+			&token.MsgBatchSend{
+				ContractId: contractId,
+				From:       addrs[0].String(),
+				Entries: []token.BatchSendEntry{
+					{To: addrs[1].String(), Amount: sdk.OneInt()},
+				},
+			},
+			"/lbm.token.v1.MsgBatchSend",
+			fmt.Sprintf("{\"account_number\":\"1\",\"chain_id\":\"foo\",\"fee\":{\"amount\":[],\"gas\":\"0\"},\"memo\":\"memo\",\"msgs\":[{\"type\":\"lbm-sdk/token/MsgBatchSend\",\"value\":{\"contract_id\":\"deadbeef\",\"entries\":[{\"amount\":\"1\",\"to\":\"%s\"}],\"from\":\"%s\"}}],\"sequence\":\"1\",\"timeout_height\":\"1\"}", addrs[1].String(), addrs[0].String()),
 		},
 		"MsgMint": {
 			&token.MsgMint{
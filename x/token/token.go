@@ -1,7 +1,11 @@
 package token
 
 import (
+	"fmt"
 	"strings"
+
+	sdk "github.com/Finschia/finschia-sdk/types"
+	banktypes "github.com/Finschia/finschia-sdk/x/bank/types"
 )
 
 const (
@@ -17,3 +21,46 @@ func LegacyPermissionFromString(name string) LegacyPermission {
 	legacyPermissionName := prefixLegacyPermission + strings.ToUpper(name)
 	return LegacyPermission(LegacyPermission_value[legacyPermissionName])
 }
+
+// HasMaxSupply returns whether the given max supply limits the supply of a contract.
+// Zero (or unset) max supply means no limit.
+func HasMaxSupply(maxSupply sdk.Int) bool {
+	return !maxSupply.IsNil() && maxSupply.IsPositive()
+}
+
+// DenomFromContractID returns the bank denom of a contract.
+func DenomFromContractID(contractID string) string {
+	return fmt.Sprintf("%s/%s", ModuleName, contractID)
+}
+
+// ContractIDFromDenom returns the contract of a bank denom, if the denom is derived from a contract.
+func ContractIDFromDenom(denom string) (string, bool) {
+	return strings.CutPrefix(denom, ModuleName+"/")
+}
+
+// DenomMetadata returns the bank metadata of the denom derived from a contract.
+// The display unit is `{denom}/{symbol}` scaled by the decimals of the contract.
+func DenomMetadata(class Contract) banktypes.Metadata {
+	base := DenomFromContractID(class.Id)
+	metadata := banktypes.Metadata{
+		Description: fmt.Sprintf("bank denom of the token contract %s", class.Id),
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: base, Exponent: 0},
+		},
+		Base:    base,
+		Display: base,
+		Name:    class.Name,
+		Symbol:  class.Symbol,
+	}
+
+	if class.Decimals > 0 {
+		display := fmt.Sprintf("%s/%s", base, class.Symbol)
+		metadata.DenomUnits = append(metadata.DenomUnits, &banktypes.DenomUnit{
+			Denom:    display,
+			Exponent: uint32(class.Decimals),
+		})
+		metadata.Display = display
+	}
+
+	return metadata
+}
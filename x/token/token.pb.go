@@ -5,6 +5,7 @@ package token
 
 import (
 	fmt "fmt"
+	github_com_Finschia_finschia_sdk_types "github.com/Finschia/finschia-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -35,6 +36,10 @@ const (
 	PermissionMint Permission = 2
 	// PERMISSION_BURN defines a permission to burn tokens of a contract.
 	PermissionBurn Permission = 3
+	// PERMISSION_PAUSE defines a permission to pause or unpause a contract.
+	PermissionPause Permission = 4
+	// PERMISSION_FREEZE defines a permission to freeze or unfreeze the tokens of holders.
+	PermissionFreeze Permission = 5
 )
 
 var Permission_name = map[int32]string{
@@ -42,6 +47,8 @@ var Permission_name = map[int32]string{
 	1: "PERMISSION_MODIFY",
 	2: "PERMISSION_MINT",
 	3: "PERMISSION_BURN",
+	4: "PERMISSION_PAUSE",
+	5: "PERMISSION_FREEZE",
 }
 
 var Permission_value = map[string]int32{
@@ -49,6 +56,8 @@ var Permission_value = map[string]int32{
 	"PERMISSION_MODIFY":      1,
 	"PERMISSION_MINT":        2,
 	"PERMISSION_BURN":        3,
+	"PERMISSION_PAUSE":       4,
+	"PERMISSION_FREEZE":      5,
 }
 
 func (x Permission) String() string {
@@ -73,6 +82,10 @@ const (
 	LegacyPermissionMint LegacyPermission = 2
 	// burn defines a permission to burn tokens of a contract.
 	LegacyPermissionBurn LegacyPermission = 3
+	// pause defines a permission to pause or unpause a contract.
+	LegacyPermissionPause LegacyPermission = 4
+	// freeze defines a permission to freeze or unfreeze the tokens of holders.
+	LegacyPermissionFreeze LegacyPermission = 5
 )
 
 var LegacyPermission_name = map[int32]string{
@@ -80,6 +93,8 @@ var LegacyPermission_name = map[int32]string{
 	1: "LEGACY_PERMISSION_MODIFY",
 	2: "LEGACY_PERMISSION_MINT",
 	3: "LEGACY_PERMISSION_BURN",
+	4: "LEGACY_PERMISSION_PAUSE",
+	5: "LEGACY_PERMISSION_FREEZE",
 }
 
 var LegacyPermission_value = map[string]int32{
@@ -87,6 +102,8 @@ var LegacyPermission_value = map[string]int32{
 	"LEGACY_PERMISSION_MODIFY":      1,
 	"LEGACY_PERMISSION_MINT":        2,
 	"LEGACY_PERMISSION_BURN":        3,
+	"LEGACY_PERMISSION_PAUSE":       4,
+	"LEGACY_PERMISSION_FREEZE":      5,
 }
 
 func (LegacyPermission) EnumDescriptor() ([]byte, []int) {
@@ -150,6 +167,10 @@ type Contract struct {
 	Decimals int32 `protobuf:"varint,6,opt,name=decimals,proto3" json:"decimals,omitempty"`
 	// mintable represents whether the token is allowed to mint or burn.
 	Mintable bool `protobuf:"varint,7,opt,name=mintable,proto3" json:"mintable,omitempty"`
+	// max_supply is the maximum supply of the token. zero means no limit.
+	MaxSupply github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,8,opt,name=max_supply,json=maxSupply,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"max_supply"`
+	// paused represents whether the transfers and burns of the token are suspended.
+	Paused bool `protobuf:"varint,9,opt,name=paused,proto3" json:"paused,omitempty"`
 }
 
 func (m *Contract) Reset()         { *m = Contract{} }
@@ -312,6 +333,94 @@ func (m *Grant) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Grant proto.InternalMessageInfo
 
+// FrozenHolder defines a holder whose tokens are frozen.
+//
+// Deprecated: Do not use.
+type FrozenHolder struct {
+	// address of the holder.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// incoming represents whether the incoming transfers to the holder are also blocked.
+	Incoming bool `protobuf:"varint,2,opt,name=incoming,proto3" json:"incoming,omitempty"`
+}
+
+func (m *FrozenHolder) Reset()         { *m = FrozenHolder{} }
+func (m *FrozenHolder) String() string { return proto.CompactTextString(m) }
+func (*FrozenHolder) ProtoMessage()    {}
+func (*FrozenHolder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cc82dfde9e68378, []int{5}
+}
+func (m *FrozenHolder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FrozenHolder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FrozenHolder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FrozenHolder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrozenHolder.Merge(m, src)
+}
+func (m *FrozenHolder) XXX_Size() int {
+	return m.Size()
+}
+func (m *FrozenHolder) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrozenHolder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FrozenHolder proto.InternalMessageInfo
+
+// Allowance defines the number of tokens a spender is allowed to send on behalf of the holder.
+//
+// Deprecated: Do not use.
+type Allowance struct {
+	// address of the token holder which approves the allowance.
+	Holder string `protobuf:"bytes,1,opt,name=holder,proto3" json:"holder,omitempty"`
+	// address of the spender which the allowance is given to.
+	Spender string `protobuf:"bytes,2,opt,name=spender,proto3" json:"spender,omitempty"`
+	// remaining number of tokens the spender can send.
+	Amount github_com_Finschia_finschia_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/Finschia/finschia-sdk/types.Int" json:"amount"`
+}
+
+func (m *Allowance) Reset()         { *m = Allowance{} }
+func (m *Allowance) String() string { return proto.CompactTextString(m) }
+func (*Allowance) ProtoMessage()    {}
+func (*Allowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cc82dfde9e68378, []int{6}
+}
+func (m *Allowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Allowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Allowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Allowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Allowance.Merge(m, src)
+}
+func (m *Allowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *Allowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_Allowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Allowance proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("lbm.token.v1.Permission", Permission_name, Permission_value)
 	proto.RegisterEnum("lbm.token.v1.LegacyPermission", LegacyPermission_name, LegacyPermission_value)
@@ -320,49 +429,64 @@ func init() {
 	proto.RegisterType((*Attribute)(nil), "lbm.token.v1.Attribute")
 	proto.RegisterType((*Authorization)(nil), "lbm.token.v1.Authorization")
 	proto.RegisterType((*Grant)(nil), "lbm.token.v1.Grant")
+	proto.RegisterType((*FrozenHolder)(nil), "lbm.token.v1.FrozenHolder")
+	proto.RegisterType((*Allowance)(nil), "lbm.token.v1.Allowance")
 }
 
 func init() { proto.RegisterFile("lbm/token/v1/token.proto", fileDescriptor_1cc82dfde9e68378) }
 
 var fileDescriptor_1cc82dfde9e68378 = []byte{
-	// 590 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x53, 0x4d, 0x4f, 0xdb, 0x40,
-	0x10, 0xb5, 0x03, 0x09, 0x61, 0x44, 0xa9, 0xbb, 0x4a, 0xa3, 0xad, 0xab, 0xba, 0x16, 0x97, 0x46,
-	0x54, 0x4d, 0x44, 0x3f, 0x54, 0xd4, 0x1b, 0x81, 0x80, 0x52, 0x41, 0x88, 0x42, 0x39, 0xd0, 0x0b,
-	0x5a, 0xc7, 0x4b, 0xb2, 0xc2, 0xf6, 0x46, 0xeb, 0x0d, 0x6a, 0xfa, 0x03, 0xaa, 0x2a, 0xa7, 0xfe,
-	0x01, 0x9f, 0xda, 0x03, 0x3f, 0x85, 0x23, 0xc7, 0x1e, 0xdb, 0xf0, 0x47, 0xaa, 0xb5, 0x4d, 0xb0,
-	0x42, 0x7a, 0x7b, 0x6f, 0xe6, 0xbd, 0xd9, 0x79, 0xbb, 0x5a, 0xc0, 0x9e, 0xe3, 0xd7, 0x24, 0x3f,
-	0xa7, 0x41, 0xed, 0x62, 0x23, 0x01, 0xd5, 0x81, 0xe0, 0x92, 0xa3, 0x15, 0xcf, 0xf1, 0xab, 0x49,
-	0xe1, 0x62, 0xc3, 0x2c, 0xf5, 0x78, 0x8f, 0xc7, 0x8d, 0x9a, 0x42, 0x89, 0x66, 0x6d, 0x05, 0x0a,
-	0x6d, 0x22, 0x88, 0x1f, 0x7e, 0xc8, 0x61, 0x7d, 0xed, 0x52, 0x87, 0xe2, 0x36, 0x0f, 0xa4, 0x20,
-	0x5d, 0x89, 0x56, 0x21, 0xc7, 0x5c, 0xac, 0xdb, 0x7a, 0x65, 0xb9, 0x93, 0x63, 0x2e, 0x42, 0xb0,
-	0x18, 0x10, 0x9f, 0xe2, 0x5c, 0x5c, 0x89, 0x31, 0x2a, 0x43, 0x21, 0x1c, 0xf9, 0x0e, 0xf7, 0xf0,
-	0x42, 0x5c, 0x4d, 0x19, 0x32, 0x60, 0x61, 0x28, 0x18, 0x5e, 0x8c, 0x8b, 0x0a, 0x2a, 0xb7, 0x4f,
-	0x25, 0xc1, 0xf9, 0xc4, 0xad, 0x30, 0x32, 0xa1, 0xe8, 0xd2, 0x2e, 0xf3, 0x89, 0x17, 0xe2, 0x82,
-	0xad, 0x57, 0xf2, 0x9d, 0x29, 0x57, 0x3d, 0x9f, 0x05, 0x92, 0x38, 0x1e, 0xc5, 0x4b, 0xb6, 0x5e,
-	0x29, 0x76, 0xa6, 0x3c, 0x5e, 0xf5, 0x3d, 0x2c, 0x6f, 0x49, 0x29, 0x98, 0x33, 0x94, 0x54, 0x1d,
-	0x77, 0x4e, 0x47, 0xe9, 0xae, 0x0a, 0xa2, 0x12, 0xe4, 0x2f, 0x88, 0x37, 0xbc, 0xdd, 0x36, 0x21,
-	0xb1, 0x71, 0x0f, 0x1e, 0x6c, 0x0d, 0x65, 0x9f, 0x0b, 0xf6, 0x95, 0x48, 0xc6, 0x03, 0x95, 0xa1,
-	0xcf, 0x3d, 0x97, 0x8a, 0xd4, 0x9f, 0x32, 0xb5, 0x01, 0x1f, 0x50, 0x41, 0x24, 0x17, 0xe9, 0x94,
-	0x29, 0x8f, 0x07, 0x9d, 0x42, 0x7e, 0x4f, 0x90, 0x40, 0x22, 0x0c, 0x4b, 0x3d, 0x05, 0x28, 0x4d,
-	0x27, 0xdc, 0x52, 0xb4, 0x09, 0x30, 0xa0, 0xc2, 0x67, 0x61, 0xc8, 0x78, 0x10, 0x0f, 0x59, 0x7d,
-	0x8d, 0xab, 0xd9, 0x67, 0xa9, 0xb6, 0xa7, 0xfd, 0x4e, 0x46, 0xab, 0x0e, 0x58, 0xbf, 0xd2, 0x01,
-	0xee, 0xda, 0xe8, 0x1d, 0x94, 0xdb, 0x8d, 0xce, 0x41, 0xf3, 0xe8, 0xa8, 0x79, 0xd8, 0x3a, 0x3d,
-	0x6e, 0x1d, 0xb5, 0x1b, 0xdb, 0xcd, 0xdd, 0x66, 0x63, 0xc7, 0xd0, 0xcc, 0x27, 0xe3, 0xc8, 0x7e,
-	0x7c, 0xa7, 0x3d, 0x0e, 0xc2, 0x01, 0xed, 0xb2, 0x33, 0x46, 0x5d, 0xf4, 0x12, 0x1e, 0x65, 0x6c,
-	0x07, 0x87, 0x3b, 0xcd, 0xdd, 0x13, 0x43, 0x37, 0x4b, 0xe3, 0xc8, 0x36, 0xee, 0x1c, 0x07, 0xdc,
-	0x65, 0x67, 0x23, 0xf4, 0x02, 0x1e, 0x66, 0xc5, 0xcd, 0xd6, 0x27, 0x23, 0x67, 0xa2, 0x71, 0x64,
-	0xaf, 0x66, 0xa4, 0x2c, 0x90, 0x33, 0xc2, 0xfa, 0x71, 0xa7, 0x65, 0x2c, 0xcc, 0x0a, 0xeb, 0x43,
-	0x11, 0x98, 0x8b, 0xdf, 0x7f, 0x5a, 0xda, 0xfa, 0xb7, 0x1c, 0x18, 0xfb, 0xb4, 0x47, 0xba, 0xa3,
-	0x4c, 0xa0, 0x3a, 0x3c, 0xdb, 0x6f, 0xec, 0x6d, 0x6d, 0x9f, 0x9c, 0xfe, 0x37, 0xd7, 0xf3, 0x71,
-	0x64, 0x3f, 0x9d, 0x35, 0x66, 0xd3, 0x6d, 0x02, 0xbe, 0x3f, 0x63, 0x1a, 0xd2, 0x1c, 0x47, 0x76,
-	0x79, 0xd6, 0x9e, 0x46, 0x7d, 0x0b, 0xe5, 0x39, 0xce, 0x24, 0x31, 0x1e, 0x47, 0x76, 0xe9, 0x9e,
-	0x4f, 0xe5, 0x9e, 0xeb, 0x4a, 0xe3, 0xcf, 0x75, 0xc5, 0x97, 0x50, 0x54, 0x97, 0x70, 0xf9, 0xcb,
-	0xd2, 0xea, 0x1f, 0xaf, 0xfe, 0x5a, 0xda, 0xe5, 0xc4, 0xd2, 0xae, 0x26, 0x96, 0x7e, 0x3d, 0xb1,
-	0xf4, 0x3f, 0x13, 0x4b, 0xff, 0x71, 0x63, 0x69, 0xd7, 0x37, 0x96, 0xf6, 0xfb, 0xc6, 0xd2, 0x3e,
-	0x57, 0x7a, 0x4c, 0xf6, 0x87, 0x4e, 0xb5, 0xcb, 0xfd, 0xda, 0x2e, 0x0b, 0xc2, 0x6e, 0x9f, 0x91,
-	0xda, 0x59, 0x0a, 0x5e, 0x85, 0xee, 0x79, 0xed, 0x4b, 0xf2, 0xcb, 0x9d, 0x42, 0xfc, 0x85, 0xdf,
-	0xfc, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x05, 0x39, 0x38, 0xea, 0x02, 0x04, 0x00, 0x00,
+	// 793 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xb6, 0xd3, 0x26, 0x4d, 0x9e, 0x4a, 0xd7, 0x0c, 0xa5, 0x0c, 0x41, 0xb8, 0xd6, 0x5e, 0x28,
+	0x45, 0x24, 0xda, 0xe5, 0xd7, 0x8a, 0x5b, 0xd2, 0x4d, 0xba, 0x59, 0x6d, 0xbb, 0xc1, 0xa1, 0x87,
+	0xdd, 0x4b, 0x35, 0xb1, 0xa7, 0xe9, 0xa8, 0xf6, 0x8c, 0x65, 0x8f, 0x4b, 0xd3, 0xbf, 0x00, 0x45,
+	0x42, 0x42, 0xe2, 0x1c, 0x09, 0x09, 0x0e, 0xfb, 0xa7, 0xf4, 0xb8, 0x47, 0xc4, 0x61, 0x05, 0xed,
+	0x3f, 0xc1, 0x11, 0xcd, 0xd8, 0x49, 0xad, 0x34, 0x2b, 0x24, 0x6e, 0xef, 0x9b, 0xf9, 0xbe, 0x6f,
+	0xde, 0xfb, 0xfc, 0x64, 0xc0, 0xc1, 0x30, 0x6c, 0x4a, 0x71, 0x46, 0x79, 0xf3, 0xfc, 0x41, 0x56,
+	0x34, 0xa2, 0x58, 0x48, 0x81, 0xd6, 0x83, 0x61, 0xd8, 0xc8, 0x0e, 0xce, 0x1f, 0xd4, 0x37, 0x47,
+	0x62, 0x24, 0xf4, 0x45, 0x53, 0x55, 0x19, 0xe7, 0xfe, 0x3a, 0x54, 0xfa, 0x24, 0x26, 0x61, 0xf2,
+	0x6d, 0x09, 0x9b, 0xf7, 0x7f, 0x29, 0x41, 0x75, 0x4f, 0x70, 0x19, 0x13, 0x4f, 0xa2, 0x0d, 0x28,
+	0x31, 0x1f, 0x9b, 0x8e, 0xb9, 0x53, 0x73, 0x4b, 0xcc, 0x47, 0x08, 0x56, 0x39, 0x09, 0x29, 0x2e,
+	0xe9, 0x13, 0x5d, 0xa3, 0x2d, 0xa8, 0x24, 0xe3, 0x70, 0x28, 0x02, 0xbc, 0xa2, 0x4f, 0x73, 0x84,
+	0x2c, 0x58, 0x49, 0x63, 0x86, 0x57, 0xf5, 0xa1, 0x2a, 0x95, 0x3a, 0xa4, 0x92, 0xe0, 0x72, 0xa6,
+	0x56, 0x35, 0xaa, 0x43, 0xd5, 0xa7, 0x1e, 0x0b, 0x49, 0x90, 0xe0, 0x8a, 0x63, 0xee, 0x94, 0xdd,
+	0x39, 0x56, 0x77, 0x21, 0xe3, 0x92, 0x0c, 0x03, 0x8a, 0xd7, 0x1c, 0x73, 0xa7, 0xea, 0xce, 0x31,
+	0xfa, 0x0e, 0x20, 0x24, 0x17, 0xc7, 0x49, 0x1a, 0x45, 0xc1, 0x18, 0x57, 0x95, 0x63, 0xfb, 0xe1,
+	0xd5, 0x9b, 0x6d, 0xe3, 0xcf, 0x37, 0xdb, 0xbb, 0x23, 0x26, 0x4f, 0xd3, 0x61, 0xc3, 0x13, 0x61,
+	0xb3, 0xcb, 0x78, 0xe2, 0x9d, 0x32, 0xd2, 0x3c, 0xc9, 0x8b, 0xcf, 0x13, 0xff, 0xac, 0x29, 0xc7,
+	0x11, 0x4d, 0x1a, 0x3d, 0x2e, 0xdd, 0x5a, 0x48, 0x2e, 0x06, 0xda, 0x44, 0x0d, 0x12, 0x91, 0x34,
+	0xa1, 0x3e, 0xae, 0xe9, 0xc7, 0x72, 0xa4, 0x53, 0xf9, 0x06, 0x6a, 0x2d, 0x29, 0x63, 0x36, 0x4c,
+	0x25, 0x55, 0x93, 0x9d, 0xd1, 0x71, 0x1e, 0x8b, 0x2a, 0xd1, 0x26, 0x94, 0xcf, 0x49, 0x90, 0xce,
+	0x82, 0xc9, 0x80, 0x16, 0xee, 0xc3, 0x3b, 0xad, 0x54, 0x9e, 0x8a, 0x98, 0x5d, 0x12, 0xc9, 0x04,
+	0x57, 0xaf, 0x9c, 0x8a, 0xc0, 0xa7, 0x71, 0xae, 0xcf, 0x91, 0x1a, 0x56, 0x44, 0x34, 0x26, 0x52,
+	0xc4, 0xb9, 0xcb, 0x1c, 0x6b, 0xa3, 0x63, 0x28, 0xef, 0xc7, 0x84, 0x4b, 0x84, 0x61, 0x6d, 0xa4,
+	0x0a, 0x4a, 0x73, 0x87, 0x19, 0x44, 0x8f, 0x00, 0x22, 0x1a, 0x87, 0x2c, 0x49, 0x98, 0xe0, 0xda,
+	0x64, 0xe3, 0x21, 0x6e, 0x14, 0x37, 0xa0, 0xd1, 0x9f, 0xdf, 0xbb, 0x05, 0xae, 0x7e, 0xe0, 0x09,
+	0xac, 0x77, 0x63, 0x71, 0x49, 0xf9, 0x93, 0xac, 0x21, 0x0c, 0x6b, 0xc4, 0xf7, 0x63, 0x9a, 0x24,
+	0xb3, 0x77, 0x72, 0xa8, 0x5a, 0x65, 0xdc, 0x13, 0x21, 0xe3, 0x23, 0xfd, 0x4a, 0xd5, 0x9d, 0x63,
+	0xed, 0xf4, 0x93, 0x09, 0xb5, 0x56, 0x10, 0x88, 0x1f, 0x08, 0xf7, 0xe8, 0x5b, 0x07, 0xc6, 0xb0,
+	0x96, 0x44, 0x94, 0xab, 0x8b, 0x6c, 0xde, 0x19, 0x44, 0x4f, 0xa1, 0x42, 0x42, 0x91, 0x72, 0x99,
+	0x6d, 0xd4, 0xff, 0xfa, 0xae, 0xb9, 0x83, 0xea, 0x67, 0xf7, 0xd7, 0x12, 0xc0, 0xed, 0xe0, 0xe8,
+	0x2b, 0xd8, 0xea, 0x77, 0xdc, 0x83, 0xde, 0x60, 0xd0, 0x7b, 0x7e, 0x78, 0x7c, 0x74, 0x38, 0xe8,
+	0x77, 0xf6, 0x7a, 0xdd, 0x5e, 0xe7, 0xb1, 0x65, 0xd4, 0x3f, 0x9c, 0x4c, 0x9d, 0xf7, 0x6f, 0xb9,
+	0x47, 0x3c, 0x89, 0xa8, 0xc7, 0x4e, 0x18, 0xf5, 0xd1, 0x67, 0xf0, 0x6e, 0x41, 0x76, 0xf0, 0xfc,
+	0x71, 0xaf, 0xfb, 0xc2, 0x32, 0xeb, 0x9b, 0x93, 0xa9, 0x63, 0xdd, 0x2a, 0x0e, 0x84, 0xcf, 0x4e,
+	0xc6, 0xe8, 0x13, 0xb8, 0x57, 0x24, 0xf7, 0x0e, 0xbf, 0xb7, 0x4a, 0x75, 0x34, 0x99, 0x3a, 0x1b,
+	0x05, 0x2a, 0xe3, 0x72, 0x81, 0xd8, 0x3e, 0x72, 0x0f, 0xad, 0x95, 0x45, 0x62, 0x3b, 0x8d, 0x39,
+	0xfa, 0x14, 0xac, 0x02, 0xb1, 0xdf, 0x3a, 0x1a, 0x74, 0xac, 0xd5, 0xfa, 0x7b, 0x93, 0xa9, 0x73,
+	0xef, 0x96, 0xd9, 0x57, 0x1b, 0xbb, 0xd0, 0x69, 0xd7, 0xed, 0x74, 0x5e, 0x76, 0xac, 0xf2, 0x62,
+	0xa7, 0xdd, 0x98, 0xd2, 0x4b, 0x5a, 0x5f, 0xfd, 0xf1, 0x37, 0xdb, 0xd8, 0xfd, 0xa7, 0x04, 0xd6,
+	0x33, 0x3a, 0x22, 0xde, 0xb8, 0x10, 0x54, 0x1b, 0x3e, 0x7e, 0xd6, 0xd9, 0x6f, 0xed, 0xbd, 0x38,
+	0x7e, 0x6b, 0x5e, 0xdb, 0x93, 0xa9, 0xf3, 0xd1, 0xa2, 0xb0, 0x98, 0xda, 0x23, 0xc0, 0x77, 0x3d,
+	0xe6, 0xe1, 0xd5, 0x27, 0x53, 0x67, 0x6b, 0x51, 0x9e, 0x47, 0xf8, 0x25, 0x6c, 0x2d, 0x51, 0x66,
+	0x49, 0xe2, 0xc9, 0xd4, 0xd9, 0xbc, 0xa3, 0x53, 0x79, 0x2e, 0x55, 0xe5, 0xb1, 0x2e, 0x55, 0xe9,
+	0x70, 0xbf, 0x86, 0x0f, 0xee, 0xaa, 0x66, 0x19, 0xeb, 0x9d, 0x58, 0x94, 0x65, 0x49, 0x2f, 0x9d,
+	0x6e, 0x1e, 0xf8, 0xd2, 0xe9, 0xf2, 0xd8, 0xab, 0x2a, 0xf6, 0x57, 0xbf, 0xdb, 0x46, 0xfb, 0xe9,
+	0xd5, 0xdf, 0xb6, 0xf1, 0xea, 0xda, 0x36, 0xae, 0xae, 0x6d, 0xf3, 0xf5, 0xb5, 0x6d, 0xfe, 0x75,
+	0x6d, 0x9b, 0x3f, 0xdf, 0xd8, 0xc6, 0xeb, 0x1b, 0xdb, 0xf8, 0xe3, 0xc6, 0x36, 0x5e, 0xee, 0xfc,
+	0xe7, 0xde, 0x5f, 0x64, 0x3f, 0xfd, 0x61, 0x45, 0xff, 0xd1, 0xbf, 0xf8, 0x37, 0x00, 0x00, 0xff,
+	0xff, 0x4f, 0x18, 0xa1, 0xc1, 0x11, 0x06, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -408,6 +532,26 @@ func (m *Contract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
 	if m.Mintable {
 		i--
 		if m.Mintable {
@@ -570,6 +714,93 @@ func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FrozenHolder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FrozenHolder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FrozenHolder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Incoming {
+		i--
+		if m.Incoming {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Allowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Allowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Allowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Spender) > 0 {
+		i -= len(m.Spender)
+		copy(dAtA[i:], m.Spender)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Spender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintToken(dAtA []byte, offset int, v uint64) int {
 	offset -= sovToken(v)
 	base := offset
@@ -622,6 +853,11 @@ func (m *Contract) Size() (n int) {
 	if m.Mintable {
 		n += 2
 	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovToken(uint64(l))
+	if m.Paused {
+		n += 2
+	}
 	return n
 }
 
@@ -675,6 +911,41 @@ func (m *Grant) Size() (n int) {
 	return n
 }
 
+func (m *FrozenHolder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	if m.Incoming {
+		n += 2
+	}
+	return n
+}
+
+func (m *Allowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Spender)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovToken(uint64(l))
+	return n
+}
+
 func sovToken(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -959,6 +1230,60 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Mintable = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipToken(dAtA[iNdEx:])
@@ -1309,6 +1634,256 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FrozenHolder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FrozenHolder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FrozenHolder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Incoming", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Incoming = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Allowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Allowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Allowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipToken(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
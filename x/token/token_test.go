@@ -0,0 +1,45 @@
+package token_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Finschia/finschia-sdk/x/token"
+)
+
+func TestDenomMetadata(t *testing.T) {
+	testCases := map[string]struct {
+		decimals int32
+		display  string
+		units    int
+	}{
+		"no decimals": {
+			decimals: 0,
+			display:  "token/deadbeef",
+			units:    1,
+		},
+		"with decimals": {
+			decimals: 8,
+			display:  "token/deadbeef/OK",
+			units:    2,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			class := token.Contract{
+				Id:       "deadbeef",
+				Name:     "test",
+				Symbol:   "OK",
+				Decimals: tc.decimals,
+			}
+
+			metadata := token.DenomMetadata(class)
+			require.NoError(t, metadata.Validate())
+			require.Equal(t, token.DenomFromContractID(class.Id), metadata.Base)
+			require.Equal(t, tc.display, metadata.Display)
+			require.Len(t, metadata.DenomUnits, tc.units)
+		})
+	}
+}
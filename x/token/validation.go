@@ -69,6 +69,16 @@ func validateAmount(amount sdk.Int) error {
 	return nil
 }
 
+func validateMaxSupply(maxSupply sdk.Int) error {
+	if maxSupply.IsNil() {
+		return nil
+	}
+	if maxSupply.IsNegative() {
+		return ErrInvalidAmount.Wrapf("max supply cannot be negative: %s", maxSupply)
+	}
+	return nil
+}
+
 func validateLegacyPermission(permission string) error {
 	return ValidatePermission(Permission(LegacyPermissionFromString(permission)))
 }